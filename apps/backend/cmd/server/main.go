@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -23,7 +24,10 @@ import (
 	userpb "github.com/datifyy/backend/gen/user/v1"
 	"github.com/datifyy/backend/internal/config"
 	"github.com/datifyy/backend/internal/email"
+	"github.com/datifyy/backend/internal/ical"
+	"github.com/datifyy/backend/internal/jobs"
 	"github.com/datifyy/backend/internal/middleware"
+	"github.com/datifyy/backend/internal/repository"
 	"github.com/datifyy/backend/internal/service"
 	"github.com/datifyy/backend/internal/slack"
 	"github.com/datifyy/backend/internal/util/converter"
@@ -31,7 +35,9 @@ import (
 	"github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -77,21 +83,52 @@ func main() {
 	}
 
 	// Start gRPC server in a goroutine
-	go startGRPCServer(cfg.GRPCPort, db, redisClient)
+	go startGRPCServer(cfg, db, redisClient)
 
 	// Start HTTP server in a goroutine
 	go startHTTPServer(cfg, httpServer, db, redisClient)
 
+	// Start periodic background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	startBackgroundJobs(jobsCtx, cfg, db, redisClient)
+
 	// Wait for interrupt signal to gracefully shutdown the servers
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	log.Println("Shutting down servers...")
+	stopJobs()
+}
+
+// startBackgroundJobs registers and starts periodic background jobs
+func startBackgroundJobs(ctx context.Context, cfg *config.Config, db *sql.DB, redisClient *redis.Client) {
+	if db == nil {
+		log.Println("⚠ Background jobs disabled (no database connection)")
+		return
+	}
+
+	emailClient := email.NewMailerSendClient(cfg.MailerSendAPIKey, cfg.EmailFrom, cfg.EmailFromName)
+	userService := service.NewUserService(db, redisClient, emailClient, cfg)
+
+	runner := jobs.NewRunner()
+	runner.Every("data-exports", time.Minute, userService.ProcessPendingDataExports)
+	runner.Every("account-purge", time.Hour, userService.PurgeDeletedAccounts)
+	runner.Every("suspension-expiry", 5*time.Minute, userService.LiftExpiredSuspensions)
+
+	availabilityService := service.NewAvailabilityService(db, emailClient)
+	runner.Every("availability-rule-expansion", time.Hour, availabilityService.ExpandAvailabilityRules)
+	runner.Every("availability-slot-archive", time.Hour, availabilityService.ArchiveExpiredSlots)
+	runner.Every("availability-reminders", time.Hour, availabilityService.SendAvailabilityReminders)
+
+	// Change-logged writes made by jobs are attributed to the system
+	ctx = repository.WithChangeActor(ctx, repository.ChangeActor{Source: repository.ChangeSourceSystem})
+	runner.Start(ctx)
 }
 
 // startGRPCServer starts the gRPC server
-func startGRPCServer(port string, db *sql.DB, redisClient *redis.Client) {
+func startGRPCServer(cfg *config.Config, db *sql.DB, redisClient *redis.Client) {
+	port := cfg.GRPCPort
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
 	if err != nil {
 		log.Fatalf("Failed to listen on port %s: %v", port, err)
@@ -101,17 +138,16 @@ func startGRPCServer(port string, db *sql.DB, redisClient *redis.Client) {
 	grpcServer := grpc.NewServer()
 
 	// Initialize email client
-	cfg := config.Load()
 	emailClient := email.NewMailerSendClient(cfg.MailerSendAPIKey, cfg.EmailFrom, cfg.EmailFromName)
 
 	// Register services
 	authService := service.NewAuthService(db, redisClient, emailClient)
 	authpb.RegisterAuthServiceServer(grpcServer, authService)
 
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, emailClient, cfg)
 	userpb.RegisterUserServiceServer(grpcServer, userService)
 
-	availabilityService := service.NewAvailabilityService(db)
+	availabilityService := service.NewAvailabilityService(db, emailClient)
 	availabilitypb.RegisterAvailabilityServiceServer(grpcServer, availabilityService)
 
 	adminService, err := service.NewAdminService(db, redisClient)
@@ -164,13 +200,29 @@ func startHTTPServer(cfg *config.Config, server *Server, db *sql.DB, redisClient
 	mux.HandleFunc("/api/v1/auth/token/revoke", createRevokeTokenHandler(authService))
 
 	// User REST endpoints (wrapper around gRPC)
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, emailClient, cfg)
 	mux.HandleFunc("/api/v1/user/me", createUserProfileHandler(userService))
+	mux.HandleFunc("/api/v1/prompts", createListPromptsHandler(userService))
 	mux.HandleFunc("/api/v1/partner-preferences", createPartnerPreferencesHandler(userService))
+	mux.HandleFunc("/api/v1/user/data-export", createDataExportRequestHandler(userService))
+	mux.HandleFunc("/api/v1/user/data-export/download", createDataExportDownloadHandler(userService))
+	mux.HandleFunc("/api/v1/user/appeal", createAppealSuspensionHandler(userService))
+	mux.HandleFunc("/api/v1/user/profile-views", createProfileViewersHandler(userService))
+	mux.HandleFunc("/api/v1/user/likes", createUserLikeHandler(userService))
+	mux.HandleFunc("/api/v1/user/likes/received", createLikesReceivedHandler(userService))
+	mux.HandleFunc("/api/v1/user/verification", createVerificationStatusHandler(userService))
+	mux.HandleFunc("/api/v1/user/verification/work-email", createSendWorkEmailVerificationHandler(userService))
+	mux.HandleFunc("/api/v1/user/verification/work-email/verify", createVerifyWorkEmailHandler(userService))
+	mux.HandleFunc("/api/v1/user/verification/id", createSubmitIdVerificationHandler(userService))
 
 	// Availability REST endpoints
-	availabilityService := service.NewAvailabilityService(db)
+	availabilityService := service.NewAvailabilityService(db, emailClient)
 	mux.HandleFunc("/api/v1/availability", createAvailabilityHandler(availabilityService))
+	mux.HandleFunc("/api/v1/availability/clear", createClearAvailabilityHandler(availabilityService))
+	mux.HandleFunc("/api/v1/availability/status", createAvailabilityStatusHandler(availabilityService))
+	mux.HandleFunc("/api/v1/availability/calendar-feed", createCalendarFeedTokenHandler(availabilityService))
+	mux.HandleFunc("/api/v1/availability/import", createImportCalendarHandler(availabilityService))
+	mux.HandleFunc("/api/v1/calendar/feed/", createCalendarFeedHandler(availabilityService))
 
 	// Admin REST endpoints
 	adminService, err := service.NewAdminService(db, redisClient)
@@ -190,10 +242,24 @@ func startHTTPServer(cfg *config.Config, server *Server, db *sql.DB, redisClient
 	mux.HandleFunc("/api/v1/admin/users/search", createAdminSearchUsersHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/users/bulk", createAdminBulkUserActionHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/users/", createAdminGetUserDetailsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/profile-changes/", createAdminRevertProfileChangeHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/suggestions/", createAdminGetSuggestionsHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/dates", createAdminDatesHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/dates/", createAdminDateStatusHandler(adminService))
 
+	// Admin Report Moderation endpoints
+	mux.HandleFunc("/api/v1/admin/reports", createAdminListReportsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/reports/", createAdminReportActionHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/enforcement/", createAdminEnforcementHistoryHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/appeals", createAdminListAppealsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/appeals/", createAdminReviewAppealHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/verifications", createAdminListIdVerificationsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/verifications/", createAdminIdVerificationHandler(adminService))
+
+	// Admin Profile Prompts endpoints
+	mux.HandleFunc("/api/v1/admin/prompts", createAdminPromptsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/prompts/", createAdminPromptByIdHandler(adminService))
+
 	// Admin Curation endpoints (AI-powered matching)
 	mux.HandleFunc("/api/v1/admin/curation/candidates", createAdminGetCurationCandidatesHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/curation/analyze", createAdminCurateDatesHandler(adminService, db))
@@ -201,6 +267,7 @@ func startHTTPServer(cfg *config.Config, server *Server, db *sql.DB, redisClient
 	mux.HandleFunc("/api/v1/admin/curation/matches", createAdminGetCuratedMatchesByStatusHandler(datesService))
 	mux.HandleFunc("/api/v1/admin/curation/matches/", createAdminCreateSuggestionsHandler(datesService))
 	mux.HandleFunc("/api/v1/admin/dates/schedule", createAdminScheduleDateHandler(datesService))
+	mux.HandleFunc("/api/v1/admin/dates/common-availability", createAdminCommonAvailabilityHandler(adminService))
 
 	// User Date Suggestions endpoints
 	mux.HandleFunc("/api/v1/user/suggestions", createUserGetSuggestionsHandler(datesService))
@@ -214,6 +281,9 @@ func startHTTPServer(cfg *config.Config, server *Server, db *sql.DB, redisClient
 	mux.HandleFunc("/api/v1/user/love-zone/past", createLoveZonePastHandler(loveZoneService))
 	mux.HandleFunc("/api/v1/user/love-zone/rejected", createLoveZoneRejectedHandler(loveZoneService))
 	mux.HandleFunc("/api/v1/user/love-zone/statistics", createLoveZoneStatisticsHandler(loveZoneService))
+	mux.HandleFunc("/api/v1/user/love-zone/dates/cancel", createCancelDateHandler(userService))
+	mux.HandleFunc("/api/v1/user/love-zone/dates/reschedule", createRequestRescheduleHandler(userService))
+	mux.HandleFunc("/api/v1/user/love-zone/reschedule/respond", createRespondToRescheduleHandler(userService))
 
 	// Admin Analytics endpoints
 	mux.HandleFunc("/api/v1/admin/analytics/platform", createAdminGetPlatformStatsHandler(adminService))
@@ -223,6 +293,7 @@ func startHTTPServer(cfg *config.Config, server *Server, db *sql.DB, redisClient
 	mux.HandleFunc("/api/v1/admin/analytics/demographics", createAdminGetDemographicsHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/analytics/locations", createAdminGetLocationStatsHandler(adminService))
 	mux.HandleFunc("/api/v1/admin/analytics/availability", createAdminGetAvailabilityStatsHandler(adminService))
+	mux.HandleFunc("/api/v1/admin/analytics/availability/heatmap", createAdminGetAvailabilityHeatmapHandler(adminService))
 
 	// Admin Management endpoints
 	mux.HandleFunc("/api/v1/admin/admins", createAdminManageAdminsHandler(adminService))
@@ -385,6 +456,11 @@ func createLoginHandler(authService *service.AuthService) http.HandlerFunc {
 		// Call gRPC service
 		resp, err := authService.LoginWithEmail(r.Context(), grpcReq)
 		if err != nil {
+			var restricted *service.AccountRestrictedError
+			if errors.As(err, &restricted) {
+				writeAccountRestrictedError(w, restricted)
+				return
+			}
 			http.Error(w, fmt.Sprintf("Login failed: %v", err), http.StatusUnauthorized)
 			return
 		}
@@ -423,6 +499,7 @@ func createLoginHandler(authService *service.AuthService) http.HandlerFunc {
 				"sessionId": resp.Session.SessionId,
 				"userId":    resp.Session.UserId,
 			},
+			"accountRestored": resp.AccountRestored,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -431,6 +508,23 @@ func createLoginHandler(authService *service.AuthService) http.HandlerFunc {
 	}
 }
 
+// writeAccountRestrictedError tells a suspended or banned user why they cannot sign in
+func writeAccountRestrictedError(w http.ResponseWriter, restricted *service.AccountRestrictedError) {
+	jsonResp := map[string]interface{}{
+		"error":         restricted.Error(),
+		"accountStatus": restricted.Status,
+		"reason":        restricted.Reason,
+		"appealable":    restricted.Appealable,
+	}
+	if restricted.Until != nil {
+		jsonResp["suspendedUntil"] = restricted.Until.UTC().Format(time.RFC3339)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	json.NewEncoder(w).Encode(jsonResp)
+}
+
 // createRefreshTokenHandler creates HTTP handler for token refresh
 func createRefreshTokenHandler(authService *service.AuthService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -636,6 +730,35 @@ func createUserProfileHandler(userService *service.UserService) http.HandlerFunc
 	}
 }
 
+// convertHoroscopeMatchToJSON converts a Guna Milan result to JSON, nil when unknown
+func convertHoroscopeMatchToJSON(match *userpb.HoroscopeMatch) map[string]interface{} {
+	if match == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"gunas":             match.Gunas,
+		"maxGunas":          match.MaxGunas,
+		"manglikCompatible": match.ManglikCompatible,
+		"acceptable":        match.Acceptable,
+	}
+}
+
+// convertPreferenceRulesToJSON converts partner preference rule results to JSON
+func convertPreferenceRulesToJSON(results []*userpb.PreferenceRuleResult) []map[string]interface{} {
+	rules := make([]map[string]interface{}, len(results))
+	for i, r := range results {
+		rules[i] = map[string]interface{}{
+			"rule":        r.Rule,
+			"hard":        r.Hard,
+			"passed":      r.Passed,
+			"known":       r.Known,
+			"weight":      r.Weight,
+			"explanation": r.Explanation,
+		}
+	}
+	return rules
+}
+
 // convertUserProfileToJSON converts UserProfile protobuf to JSON-compatible map (camelCase)
 func convertUserProfileToJSON(profile *userpb.UserProfile) map[string]interface{} {
 	if profile == nil {
@@ -647,6 +770,9 @@ func convertUserProfileToJSON(profile *userpb.UserProfile) map[string]interface{
 		"completionPercentage": profile.CompletionPercentage,
 		"isPublic":             profile.IsPublic,
 		"isVerified":           profile.IsVerified,
+		"workEmailVerified":    profile.WorkEmailVerified,
+		"distanceKm":           profile.DistanceKm,
+		"compatibilityScore":   profile.CompatibilityScore,
 	}
 
 	// Basic Info
@@ -697,6 +823,23 @@ func convertUserProfileToJSON(profile *userpb.UserProfile) map[string]interface{
 		}
 	}
 
+	// Cultural Info (birth details are only present for the owner)
+	if profile.CulturalInfo != nil {
+		result["culturalInfo"] = map[string]interface{}{
+			"birthTime":          profile.CulturalInfo.BirthTime,
+			"birthPlace":         profile.CulturalInfo.BirthPlace,
+			"birthTimezone":      profile.CulturalInfo.BirthTimezone,
+			"nakshatra":          profile.CulturalInfo.Nakshatra,
+			"raasi":              profile.CulturalInfo.Raasi,
+			"manglikStatus":      profile.CulturalInfo.ManglikStatus.String(),
+			"horoscopeAvailable": profile.CulturalInfo.HoroscopeAvailable,
+		}
+	}
+
+	if profile.HoroscopeMatch != nil {
+		result["horoscopeMatch"] = convertHoroscopeMatchToJSON(profile.HoroscopeMatch)
+	}
+
 	// Photos
 	if len(profile.Photos) > 0 {
 		photos := make([]map[string]interface{}, len(profile.Photos))
@@ -912,9 +1055,14 @@ func createPartnerPreferencesHandler(userService *service.UserService) http.Hand
 	}
 }
 
-// createAvailabilityHandler creates HTTP handler for availability (GET, POST, DELETE)
-func createAvailabilityHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+// createDataExportRequestHandler creates HTTP handler for requesting a personal data export
+func createDataExportRequestHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
 		// Extract access token from Authorization header
 		authHeader := r.Header.Get("Authorization")
 		if authHeader == "" {
@@ -940,233 +1088,37 @@ func createAvailabilityHandler(availabilityService *service.AvailabilityService)
 		// Add userID to context
 		ctx := context.WithValue(r.Context(), "userID", userID)
 
-		// Handle GET request (GetAvailability)
-		if r.Method == http.MethodGet {
-			// Parse query parameters
-			fromTime := int64(0)
-			toTime := int64(0)
-			if ft := r.URL.Query().Get("from_time"); ft != "" {
-				fromTime, _ = parseInt64(ft)
-			}
-			if tt := r.URL.Query().Get("to_time"); tt != "" {
-				toTime, _ = parseInt64(tt)
-			}
-
-			grpcReq := &availabilitypb.GetAvailabilityRequest{
-				FromTime: fromTime,
-				ToTime:   toTime,
-			}
-
-			resp, err := availabilityService.GetAvailability(ctx, grpcReq)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Failed to get availability: %v", err), http.StatusInternalServerError)
-				return
-			}
-
-			// Convert to camelCase JSON
-			slots := converter.AvailabilitySlotsToJSON(resp.Slots)
-
-			jsonResp := map[string]interface{}{
-				"slots":      slots,
-				"totalCount": len(slots),
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(jsonResp)
-			return
-		}
-
-		// Handle POST request (SubmitAvailability)
-		if r.Method == http.MethodPost {
-			var reqBody struct {
-				Slots []struct {
-					StartTime       int64  `json:"startTime"`
-					EndTime         int64  `json:"endTime"`
-					DateType        string `json:"dateType"`
-					Notes           string `json:"notes"`
-					OfflineLocation *struct {
-						PlaceName     string  `json:"placeName"`
-						Address       string  `json:"address"`
-						City          string  `json:"city"`
-						State         string  `json:"state"`
-						Country       string  `json:"country"`
-						Zipcode       string  `json:"zipcode"`
-						Latitude      float64 `json:"latitude"`
-						Longitude     float64 `json:"longitude"`
-						GooglePlaceId string  `json:"googlePlaceId"`
-						GoogleMapsUrl string  `json:"googleMapsUrl"`
-					} `json:"offlineLocation"`
-				} `json:"slots"`
-			}
-
-			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
-				return
-			}
-
-			// Convert to gRPC request
-			pbSlots := make([]*availabilitypb.AvailabilitySlotInput, len(reqBody.Slots))
-			for i, slot := range reqBody.Slots {
-				pbSlot := &availabilitypb.AvailabilitySlotInput{
-					StartTime: slot.StartTime,
-					EndTime:   slot.EndTime,
-					DateType:  stringToDateTypeEnum(slot.DateType),
-					Notes:     slot.Notes,
-				}
-
-				if slot.OfflineLocation != nil {
-					pbSlot.OfflineLocation = &availabilitypb.OfflineLocation{
-						PlaceName:     slot.OfflineLocation.PlaceName,
-						Address:       slot.OfflineLocation.Address,
-						City:          slot.OfflineLocation.City,
-						State:         slot.OfflineLocation.State,
-						Country:       slot.OfflineLocation.Country,
-						Zipcode:       slot.OfflineLocation.Zipcode,
-						Latitude:      slot.OfflineLocation.Latitude,
-						Longitude:     slot.OfflineLocation.Longitude,
-						GooglePlaceId: slot.OfflineLocation.GooglePlaceId,
-						GoogleMapsUrl: slot.OfflineLocation.GoogleMapsUrl,
-					}
-				}
-
-				pbSlots[i] = pbSlot
-			}
-
-			grpcReq := &availabilitypb.SubmitAvailabilityRequest{
-				Slots: pbSlots,
-			}
-
-			resp, err := availabilityService.SubmitAvailability(ctx, grpcReq)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Failed to submit availability: %v", err), http.StatusBadRequest)
+		resp, err := userService.RequestDataExport(ctx, &userpb.RequestDataExportRequest{})
+		if err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				http.Error(w, status.Convert(err).Message(), http.StatusTooManyRequests)
 				return
 			}
-
-			// Convert to camelCase JSON
-			createdSlots := make([]map[string]interface{}, len(resp.CreatedSlots))
-			for i, slot := range resp.CreatedSlots {
-				createdSlots[i] = convertSlotToJSON(slot)
-			}
-
-			// Convert validation errors map
-			validationErrors := make(map[string]string)
-			for k, v := range resp.ValidationErrors {
-				validationErrors[fmt.Sprintf("%d", k)] = v
-			}
-
-			jsonResp := map[string]interface{}{
-				"createdSlots":     createdSlots,
-				"createdCount":     resp.CreatedCount,
-				"validationErrors": validationErrors,
-				"message":          resp.Message,
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(jsonResp)
+			http.Error(w, fmt.Sprintf("Failed to request data export: %v", err), http.StatusInternalServerError)
 			return
 		}
 
-		// Handle DELETE request (DeleteAvailability)
-		if r.Method == http.MethodDelete {
-			slotID := r.URL.Query().Get("slot_id")
-			if slotID == "" {
-				http.Error(w, "slot_id query parameter required", http.StatusBadRequest)
-				return
-			}
-
-			grpcReq := &availabilitypb.DeleteAvailabilityRequest{
-				SlotId: slotID,
-			}
-
-			resp, err := availabilityService.DeleteAvailability(ctx, grpcReq)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Failed to delete availability: %v", err), http.StatusBadRequest)
-				return
-			}
-
-			jsonResp := map[string]interface{}{
-				"success": resp.Success,
-				"message": resp.Message,
-			}
-
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(jsonResp)
-			return
+		jsonResp := map[string]interface{}{
+			"exportId": resp.ExportId,
+			"status":   resp.Status,
+			"message":  resp.Message,
 		}
-
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-	}
-}
-
-// Helper functions for availability
-func parseInt64(s string) (int64, error) {
-	var n int64
-	_, err := fmt.Sscanf(s, "%d", &n)
-	return n, err
-}
-
-func stringToDateTypeEnum(s string) availabilitypb.DateType {
-	switch s {
-	case "online", "DATE_TYPE_ONLINE":
-		return availabilitypb.DateType_DATE_TYPE_ONLINE
-	case "offline", "DATE_TYPE_OFFLINE":
-		return availabilitypb.DateType_DATE_TYPE_OFFLINE
-	case "offline_event", "DATE_TYPE_OFFLINE_EVENT":
-		return availabilitypb.DateType_DATE_TYPE_OFFLINE_EVENT
-	default:
-		return availabilitypb.DateType_DATE_TYPE_ONLINE
-	}
-}
-
-func convertSlotToJSON(slot *availabilitypb.AvailabilitySlot) map[string]interface{} {
-	result := map[string]interface{}{
-		"slotId":    slot.SlotId,
-		"userId":    slot.UserId,
-		"startTime": slot.StartTime,
-		"endTime":   slot.EndTime,
-		"dateType":  slot.DateType.String(),
-		"notes":     slot.Notes,
-	}
-
-	if slot.CreatedAt != nil {
-		result["createdAt"] = map[string]int64{
-			"seconds": slot.CreatedAt.Seconds,
+		if resp.RequestedAt != nil {
+			jsonResp["requestedAt"] = resp.RequestedAt.Seconds
 		}
-	}
-
-	if slot.UpdatedAt != nil {
-		result["updatedAt"] = map[string]int64{
-			"seconds": slot.UpdatedAt.Seconds,
+		if resp.NextAllowedAt != nil {
+			jsonResp["nextAllowedAt"] = resp.NextAllowedAt.Seconds
 		}
-	}
 
-	if slot.OfflineLocation != nil {
-		result["offlineLocation"] = map[string]interface{}{
-			"placeName":     slot.OfflineLocation.PlaceName,
-			"address":       slot.OfflineLocation.Address,
-			"city":          slot.OfflineLocation.City,
-			"state":         slot.OfflineLocation.State,
-			"country":       slot.OfflineLocation.Country,
-			"zipcode":       slot.OfflineLocation.Zipcode,
-			"latitude":      slot.OfflineLocation.Latitude,
-			"longitude":     slot.OfflineLocation.Longitude,
-			"googlePlaceId": slot.OfflineLocation.GooglePlaceId,
-			"googleMapsUrl": slot.OfflineLocation.GoogleMapsUrl,
-		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(jsonResp)
 	}
-
-	return result
 }
 
-// =============================================================================
-// Admin HTTP Handlers
-// =============================================================================
-
-// createAdminLoginHandler handles admin login
-func createAdminLoginHandler(adminService *service.AdminService) http.HandlerFunc {
+// createAppealSuspensionHandler lets a suspended or banned user appeal.
+// Restricted users cannot sign in, so the body carries their credentials.
+func createAppealSuspensionHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -1176,110 +1128,82 @@ func createAdminLoginHandler(adminService *service.AdminService) http.HandlerFun
 		var reqBody struct {
 			Email    string `json:"email"`
 			Password string `json:"password"`
+			Message  string `json:"message"`
 		}
-
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
 			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		grpcReq := &adminpb.AdminLoginRequest{
+		resp, err := userService.AppealSuspension(r.Context(), &userpb.AppealSuspensionRequest{
 			Email:    reqBody.Email,
 			Password: reqBody.Password,
-		}
-
-		resp, err := adminService.AdminLogin(r.Context(), grpcReq)
+			Message:  reqBody.Message,
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Login failed: %v", err), http.StatusUnauthorized)
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			case codes.Unauthenticated:
+				http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+			case codes.FailedPrecondition, codes.AlreadyExists:
+				http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+			default:
+				http.Error(w, fmt.Sprintf("Failed to submit appeal: %v", err), http.StatusInternalServerError)
+			}
 			return
 		}
 
 		jsonResp := map[string]interface{}{
-			"admin": map[string]interface{}{
-				"adminId": resp.Admin.AdminId,
-				"userId":  resp.Admin.UserId,
-				"email":   resp.Admin.Email,
-				"name":    resp.Admin.Name,
-				"role":    resp.Admin.Role.String(),
-				"isGenie": resp.Admin.IsGenie,
-			},
-			"tokens": map[string]interface{}{
-				"accessToken":  resp.Tokens.AccessToken,
-				"refreshToken": resp.Tokens.RefreshToken,
-				"expiresIn":    resp.Tokens.ExpiresIn,
-			},
+			"appealId": resp.AppealId,
+			"message":  resp.Message,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
+		w.WriteHeader(http.StatusCreated)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminGetAllUsersHandler handles fetching all users with pagination
-func createAdminGetAllUsersHandler(adminService *service.AdminService) http.HandlerFunc {
+// createListPromptsHandler lists the prompts that can be answered on a profile
+// GET /api/v1/prompts?locale=hi&category=dating
+func createListPromptsHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Parse query params
-		page := 1
-		pageSize := 20
-		if p := r.URL.Query().Get("page"); p != "" {
-			fmt.Sscanf(p, "%d", &page)
+		locale := r.URL.Query().Get("locale")
+		if locale == "" {
+			// Accept-Language is e.g. "hi-IN,hi;q=0.9,en;q=0.8"; the first tag is preferred
+			locale, _, _ = strings.Cut(r.Header.Get("Accept-Language"), ",")
+			locale, _, _ = strings.Cut(locale, ";")
 		}
-		if ps := r.URL.Query().Get("page_size"); ps != "" {
-			fmt.Sscanf(ps, "%d", &pageSize)
+
+		resp, err := userService.ListPrompts(r.Context(), &userpb.ListPromptsRequest{
+			Locale:   locale,
+			Category: r.URL.Query().Get("category"),
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to list prompts: %v", err), http.StatusInternalServerError)
+			return
 		}
 
-		sortBy := adminpb.UserSortField_USER_SORT_FIELD_CREATED_AT
-		if sb := r.URL.Query().Get("sort_by"); sb != "" {
-			switch sb {
-			case "name":
-				sortBy = adminpb.UserSortField_USER_SORT_FIELD_NAME
-			case "email":
-				sortBy = adminpb.UserSortField_USER_SORT_FIELD_EMAIL
-			case "last_login":
-				sortBy = adminpb.UserSortField_USER_SORT_FIELD_LAST_LOGIN
-			case "age":
-				sortBy = adminpb.UserSortField_USER_SORT_FIELD_AGE
-			}
-		}
-
-		sortOrder := adminpb.SortOrder_SORT_ORDER_DESC
-		if so := r.URL.Query().Get("sort_order"); so == "asc" {
-			sortOrder = adminpb.SortOrder_SORT_ORDER_ASC
-		}
-
-		grpcReq := &adminpb.GetAllUsersRequest{
-			Page:                int32(page),
-			PageSize:            int32(pageSize),
-			SortBy:              sortBy,
-			SortOrder:           sortOrder,
-			AccountStatusFilter: r.URL.Query().Get("account_status"),
-			GenderFilter:        r.URL.Query().Get("gender"),
-		}
-
-		resp, err := adminService.GetAllUsers(r.Context(), grpcReq)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get users: %v", err), http.StatusInternalServerError)
-			return
-		}
-
-		// Convert users to JSON
-		users := make([]map[string]interface{}, len(resp.Users))
-		for i, user := range resp.Users {
-			users[i] = convertUserFullDetailsToJSON(user)
+		prompts := make([]map[string]interface{}, 0, len(resp.Prompts))
+		for _, p := range resp.Prompts {
+			prompts = append(prompts, map[string]interface{}{
+				"promptId":        p.PromptId,
+				"text":            p.Text,
+				"category":        p.Category,
+				"minAnswerLength": p.MinAnswerLength,
+				"maxAnswerLength": p.MaxAnswerLength,
+				"question":        p.Question.String(),
+			})
 		}
 
 		jsonResp := map[string]interface{}{
-			"users":      users,
-			"totalCount": resp.TotalCount,
-			"page":       resp.Page,
-			"pageSize":   resp.PageSize,
-			"totalPages": resp.TotalPages,
+			"prompts": prompts,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -1288,17 +1212,18 @@ func createAdminGetAllUsersHandler(adminService *service.AdminService) http.Hand
 	}
 }
 
-// createAdminSearchUsersHandler handles searching users
-func createAdminSearchUsersHandler(adminService *service.AdminService) http.HandlerFunc {
+// createProfileViewersHandler lists users who viewed the caller's profile
+// GET /api/v1/user/profile-views?page=1&page_size=20
+func createProfileViewersHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		query := r.URL.Query().Get("q")
-		if query == "" {
-			http.Error(w, "Search query required", http.StatusBadRequest)
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
 			return
 		}
 
@@ -1311,28 +1236,31 @@ func createAdminSearchUsersHandler(adminService *service.AdminService) http.Hand
 			fmt.Sscanf(ps, "%d", &pageSize)
 		}
 
-		grpcReq := &adminpb.SearchUsersRequest{
-			Query:    query,
-			Page:     int32(page),
-			PageSize: int32(pageSize),
-		}
-
-		resp, err := adminService.SearchUsers(r.Context(), grpcReq)
+		resp, err := userService.ListProfileViewers(ctx, &userpb.ListProfileViewersRequest{
+			Pagination: &commonpb.PaginationRequest{
+				Page:     int32(page),
+				PageSize: int32(pageSize),
+			},
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Search failed: %v", err), http.StatusInternalServerError)
+			http.Error(w, fmt.Sprintf("Failed to get profile viewers: %v", err), http.StatusInternalServerError)
 			return
 		}
 
-		users := make([]map[string]interface{}, len(resp.Users))
-		for i, user := range resp.Users {
-			users[i] = convertUserFullDetailsToJSON(user)
+		viewers := make([]map[string]interface{}, 0, len(resp.Viewers))
+		for _, viewer := range resp.Viewers {
+			viewers = append(viewers, map[string]interface{}{
+				"profile":  convertUserProfileToJSON(viewer.Profile),
+				"viewedAt": viewer.ViewedAt.GetSeconds(),
+			})
 		}
 
 		jsonResp := map[string]interface{}{
-			"users":      users,
-			"totalCount": resp.TotalCount,
-			"page":       resp.Page,
-			"pageSize":   resp.PageSize,
+			"viewers":    viewers,
+			"page":       resp.Pagination.Page,
+			"pageSize":   resp.Pagination.PageSize,
+			"totalCount": resp.Pagination.TotalCount,
+			"totalPages": resp.Pagination.TotalPages,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -1341,46 +1269,68 @@ func createAdminSearchUsersHandler(adminService *service.AdminService) http.Hand
 	}
 }
 
-// createAdminGetUserDetailsHandler handles getting user details
-func createAdminGetUserDetailsHandler(adminService *service.AdminService) http.HandlerFunc {
+// createUserLikeHandler likes, super likes or passes on a user
+// POST /api/v1/user/likes {"userId": "42", "action": "like" | "super_like" | "pass"}
+func createUserLikeHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
+		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Extract user ID from path: /api/v1/admin/users/{id}
-		userID := r.URL.Path[len("/api/v1/admin/users/"):]
-		if userID == "" {
-			http.Error(w, "User ID required", http.StatusBadRequest)
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
 			return
 		}
 
-		grpcReq := &adminpb.GetUserDetailsRequest{
-			UserId: userID,
+		var reqBody struct {
+			UserID string `json:"userId"`
+			Action string `json:"action"`
 		}
-
-		resp, err := adminService.GetUserDetails(r.Context(), grpcReq)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get user details: %v", err), http.StatusInternalServerError)
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		jsonResp := map[string]interface{}{
-			"user": convertUserFullDetailsToJSON(resp.User),
-		}
-
-		// Add availability, past dates, upcoming dates if present
-		if len(resp.Availability) > 0 {
-			slots := make([]map[string]interface{}, len(resp.Availability))
-			for i, slot := range resp.Availability {
-				slots[i] = map[string]interface{}{
-					"startTime": slot.StartTime.Seconds,
-					"endTime":   slot.EndTime.Seconds,
-					"dateType":  slot.DateType,
-				}
+		var jsonResp map[string]interface{}
+		switch reqBody.Action {
+		case "like", "":
+			resp, err := userService.LikeUser(ctx, &userpb.LikeUserRequest{UserId: reqBody.UserID})
+			if err != nil {
+				writeLikeError(w, err)
+				return
 			}
-			jsonResp["availability"] = slots
+			jsonResp = map[string]interface{}{
+				"isMutual":       resp.IsMutual,
+				"matchId":        resp.MatchId,
+				"remainingToday": resp.RemainingToday,
+				"message":        resp.Message,
+			}
+		case "super_like":
+			resp, err := userService.SuperLikeUser(ctx, &userpb.SuperLikeUserRequest{UserId: reqBody.UserID})
+			if err != nil {
+				writeLikeError(w, err)
+				return
+			}
+			jsonResp = map[string]interface{}{
+				"isMutual":       resp.IsMutual,
+				"matchId":        resp.MatchId,
+				"remainingToday": resp.RemainingToday,
+				"message":        resp.Message,
+			}
+		case "pass":
+			resp, err := userService.PassUser(ctx, &userpb.PassUserRequest{UserId: reqBody.UserID})
+			if err != nil {
+				writeLikeError(w, err)
+				return
+			}
+			jsonResp = map[string]interface{}{
+				"message": resp.Message,
+			}
+		default:
+			http.Error(w, "action must be like, super_like or pass", http.StatusBadRequest)
+			return
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -1389,71 +1339,56 @@ func createAdminGetUserDetailsHandler(adminService *service.AdminService) http.H
 	}
 }
 
-// createAdminGetSuggestionsHandler handles getting date suggestions for a user
-func createAdminGetSuggestionsHandler(adminService *service.AdminService) http.HandlerFunc {
+// createLikesReceivedHandler lists likes the user hasn't responded to
+// GET /api/v1/user/likes/received?page=1&page_size=20
+func createLikesReceivedHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Extract user ID from path: /api/v1/admin/suggestions/{id}
-		userID := r.URL.Path[len("/api/v1/admin/suggestions/"):]
-		if userID == "" {
-			http.Error(w, "User ID required", http.StatusBadRequest)
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
 			return
 		}
 
-		limit := 10
-		if l := r.URL.Query().Get("limit"); l != "" {
-			fmt.Sscanf(l, "%d", &limit)
+		page := 1
+		pageSize := 20
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
 		}
-
-		grpcReq := &adminpb.GetDateSuggestionsRequest{
-			UserId: userID,
-			Limit:  int32(limit),
+		if ps := r.URL.Query().Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
 		}
 
-		resp, err := adminService.GetDateSuggestions(r.Context(), grpcReq)
+		resp, err := userService.ListLikesReceived(ctx, &userpb.ListLikesReceivedRequest{
+			Pagination: &commonpb.PaginationRequest{
+				Page:     int32(page),
+				PageSize: int32(pageSize),
+			},
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get suggestions: %v", err), http.StatusInternalServerError)
+			writeLikeError(w, err)
 			return
 		}
 
-		suggestions := make([]map[string]interface{}, len(resp.Suggestions))
-		for i, suggestion := range resp.Suggestions {
-			suggestions[i] = map[string]interface{}{
-				"user": map[string]interface{}{
-					"userId":     suggestion.User.UserId,
-					"name":       suggestion.User.Name,
-					"email":      suggestion.User.Email,
-					"phone":      suggestion.User.Phone,
-					"photoUrl":   suggestion.User.PhotoUrl,
-					"age":        suggestion.User.Age,
-					"gender":     suggestion.User.Gender,
-					"city":       suggestion.User.City,
-					"occupation": suggestion.User.Occupation,
-				},
-				"compatibilityScore": suggestion.CompatibilityScore,
-				"commonInterests":    suggestion.CommonInterests,
-				"suggestedDateType":  suggestion.SuggestedDateType,
-			}
-
-			if len(suggestion.MatchingSlots) > 0 {
-				slots := make([]map[string]interface{}, len(suggestion.MatchingSlots))
-				for j, slot := range suggestion.MatchingSlots {
-					slots[j] = map[string]interface{}{
-						"startTime": slot.StartTime.Seconds,
-						"endTime":   slot.EndTime.Seconds,
-						"dateType":  slot.DateType,
-					}
-				}
-				suggestions[i]["matchingSlots"] = slots
-			}
+		likes := make([]map[string]interface{}, 0, len(resp.Likes))
+		for _, like := range resp.Likes {
+			likes = append(likes, map[string]interface{}{
+				"profile":     convertUserProfileToJSON(like.Profile),
+				"isSuperLike": like.IsSuperLike,
+				"likedAt":     like.LikedAt.GetSeconds(),
+			})
 		}
 
 		jsonResp := map[string]interface{}{
-			"suggestions": suggestions,
+			"likes":      likes,
+			"page":       resp.Pagination.Page,
+			"pageSize":   resp.Pagination.PageSize,
+			"totalCount": resp.Pagination.TotalCount,
+			"totalPages": resp.Pagination.TotalPages,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -1462,195 +1397,192 @@ func createAdminGetSuggestionsHandler(adminService *service.AdminService) http.H
 	}
 }
 
-// createAdminDatesHandler handles scheduling dates and getting genie dates
-func createAdminDatesHandler(adminService *service.AdminService) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		// POST - Schedule a date
-		if r.Method == http.MethodPost {
-			var reqBody struct {
-				User1ID         string `json:"user1Id"`
-				User2ID         string `json:"user2Id"`
-				ScheduledTime   int64  `json:"scheduledTime"`
-				DurationMinutes int64  `json:"durationMinutes"`
-				DateType        string `json:"dateType"`
-				Notes           string `json:"notes"`
-				Location        *struct {
-					PlaceName string  `json:"placeName"`
-					Address   string  `json:"address"`
-					City      string  `json:"city"`
-					State     string  `json:"state"`
-					Country   string  `json:"country"`
-					Zipcode   string  `json:"zipcode"`
-					Latitude  float64 `json:"latitude"`
-					Longitude float64 `json:"longitude"`
-				} `json:"location"`
-			}
+func writeLikeError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unauthenticated:
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+	case codes.NotFound:
+		http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+	case codes.ResourceExhausted:
+		http.Error(w, status.Convert(err).Message(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, fmt.Sprintf("Request failed: %v", err), http.StatusInternalServerError)
+	}
+}
 
-			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
-				return
-			}
+// authenticatedContext parses the Bearer access token and returns a context carrying the user ID
+func authenticatedContext(r *http.Request) (context.Context, bool) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return nil, false
+	}
 
-			grpcReq := &adminpb.ScheduleDateRequest{
-				User1Id:         reqBody.User1ID,
-				User2Id:         reqBody.User2ID,
-				ScheduledTime:   &commonpb.Timestamp{Seconds: reqBody.ScheduledTime},
-				DurationMinutes: reqBody.DurationMinutes,
-				DateType:        reqBody.DateType,
-				Notes:           reqBody.Notes,
-			}
+	// Remove "Bearer " prefix
+	accessToken := authHeader
+	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
+		accessToken = authHeader[7:]
+	}
 
-			if reqBody.Location != nil {
-				grpcReq.Location = &adminpb.OfflineLocation{
-					PlaceName: reqBody.Location.PlaceName,
-					Address:   reqBody.Location.Address,
-					City:      reqBody.Location.City,
-					State:     reqBody.Location.State,
-					Country:   reqBody.Location.Country,
-					Zipcode:   reqBody.Location.Zipcode,
-					Latitude:  reqBody.Location.Latitude,
-					Longitude: reqBody.Location.Longitude,
-				}
-			}
+	var userID int
+	var timestamp int64
+	if _, err := fmt.Sscanf(accessToken, "access_token_%d_%d", &userID, &timestamp); err != nil {
+		return nil, false
+	}
 
-			resp, err := adminService.ScheduleDate(r.Context(), grpcReq)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Failed to schedule date: %v", err), http.StatusBadRequest)
-				return
-			}
+	return context.WithValue(r.Context(), "userID", userID), true
+}
 
-			jsonResp := map[string]interface{}{
-				"date": convertScheduledDateToJSON(resp.Date),
-			}
+// authenticatedAdminContext parses the Bearer admin access token and returns a context carrying the admin ID
+func authenticatedAdminContext(r *http.Request) (context.Context, bool) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return nil, false
+	}
 
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusCreated)
-			json.NewEncoder(w).Encode(jsonResp)
+	// Remove "Bearer " prefix
+	accessToken := authHeader
+	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
+		accessToken = authHeader[7:]
+	}
+
+	var adminID int
+	var timestamp int64
+	if _, err := fmt.Sscanf(accessToken, "admin_access_%d_%d", &adminID, &timestamp); err != nil {
+		return nil, false
+	}
+
+	return context.WithValue(r.Context(), "adminID", adminID), true
+}
+
+func writeVerificationError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unauthenticated:
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+	case codes.ResourceExhausted:
+		http.Error(w, status.Convert(err).Message(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, fmt.Sprintf("Verification failed: %v", err), http.StatusInternalServerError)
+	}
+}
+
+// createVerificationStatusHandler returns the user's verification badges
+// GET /api/v1/user/verification
+func createVerificationStatusHandler(userService *service.UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// GET - Get genie dates
-		if r.Method == http.MethodGet {
-			genieID := r.URL.Query().Get("genie_id")
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
 
-			page := 1
-			pageSize := 20
-			if p := r.URL.Query().Get("page"); p != "" {
-				fmt.Sscanf(p, "%d", &page)
-			}
-			if ps := r.URL.Query().Get("page_size"); ps != "" {
-				fmt.Sscanf(ps, "%d", &pageSize)
-			}
+		resp, err := userService.GetVerificationStatus(ctx, &userpb.GetVerificationStatusRequest{})
+		if err != nil {
+			writeVerificationError(w, err)
+			return
+		}
 
-			var statusFilter adminpb.DateStatus
-			if sf := r.URL.Query().Get("status"); sf != "" {
-				switch sf {
-				case "scheduled":
-					statusFilter = adminpb.DateStatus_DATE_STATUS_SCHEDULED
-				case "confirmed":
-					statusFilter = adminpb.DateStatus_DATE_STATUS_CONFIRMED
-				case "in_progress":
-					statusFilter = adminpb.DateStatus_DATE_STATUS_IN_PROGRESS
-				case "completed":
-					statusFilter = adminpb.DateStatus_DATE_STATUS_COMPLETED
-				case "cancelled":
-					statusFilter = adminpb.DateStatus_DATE_STATUS_CANCELLED
-				}
-			}
+		jsonResp := map[string]interface{}{
+			"workEmailVerified":    resp.WorkEmailVerified,
+			"workEmailDomain":      resp.WorkEmailDomain,
+			"idVerified":           resp.IdVerified,
+			"idVerificationStatus": strings.ToLower(strings.TrimPrefix(resp.IdVerificationStatus.String(), "ID_VERIFICATION_STATUS_")),
+			"idNumberMasked":       resp.IdNumberMasked,
+			"rejectionReason":      resp.RejectionReason,
+		}
 
-			grpcReq := &adminpb.GetGenieDatesRequest{
-				GenieId:      genieID,
-				StatusFilter: statusFilter,
-				Page:         int32(page),
-				PageSize:     int32(pageSize),
-			}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
 
-			resp, err := adminService.GetGenieDates(r.Context(), grpcReq)
-			if err != nil {
-				http.Error(w, fmt.Sprintf("Failed to get dates: %v", err), http.StatusInternalServerError)
-				return
-			}
+// createSendWorkEmailVerificationHandler emails a code to the user's work address
+// POST /api/v1/user/verification/work-email
+func createSendWorkEmailVerificationHandler(userService *service.UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
 
-			dates := make([]map[string]interface{}, len(resp.Dates))
-			for i, date := range resp.Dates {
-				dates[i] = convertScheduledDateToJSON(date)
-			}
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
 
-			jsonResp := map[string]interface{}{
-				"dates":      dates,
-				"totalCount": resp.TotalCount,
-				"page":       resp.Page,
-				"pageSize":   resp.PageSize,
-			}
+		var reqBody struct {
+			WorkEmail string `json:"workEmail"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
 
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(jsonResp)
+		resp, err := userService.SendWorkEmailVerification(ctx, &userpb.SendWorkEmailVerificationRequest{
+			WorkEmail: reqBody.WorkEmail,
+		})
+		if err != nil {
+			writeVerificationError(w, err)
 			return
 		}
 
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		jsonResp := map[string]interface{}{
+			"message": resp.Message,
+		}
+		if resp.ExpiresAt != nil {
+			jsonResp["expiresAt"] = resp.ExpiresAt.Seconds
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminDateStatusHandler handles updating date status
-func createAdminDateStatusHandler(adminService *service.AdminService) http.HandlerFunc {
+// createVerifyWorkEmailHandler confirms a work email code
+// POST /api/v1/user/verification/work-email/verify
+func createVerifyWorkEmailHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPut {
+		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Extract date ID from path: /api/v1/admin/dates/{id}
-		dateID := r.URL.Path[len("/api/v1/admin/dates/"):]
-		if dateID == "" {
-			http.Error(w, "Date ID required", http.StatusBadRequest)
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
 			return
 		}
 
 		var reqBody struct {
-			Status string `json:"status"`
-			Notes  string `json:"notes"`
+			Code string `json:"code"`
 		}
-
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
 			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		var status adminpb.DateStatus
-		switch reqBody.Status {
-		case "scheduled":
-			status = adminpb.DateStatus_DATE_STATUS_SCHEDULED
-		case "confirmed":
-			status = adminpb.DateStatus_DATE_STATUS_CONFIRMED
-		case "in_progress":
-			status = adminpb.DateStatus_DATE_STATUS_IN_PROGRESS
-		case "completed":
-			status = adminpb.DateStatus_DATE_STATUS_COMPLETED
-		case "cancelled":
-			status = adminpb.DateStatus_DATE_STATUS_CANCELLED
-		case "no_show":
-			status = adminpb.DateStatus_DATE_STATUS_NO_SHOW
-		default:
-			http.Error(w, "Invalid status", http.StatusBadRequest)
-			return
-		}
-
-		grpcReq := &adminpb.UpdateDateStatusRequest{
-			DateId: dateID,
-			Status: status,
-			Notes:  reqBody.Notes,
-		}
-
-		resp, err := adminService.UpdateDateStatus(r.Context(), grpcReq)
+		resp, err := userService.VerifyWorkEmail(ctx, &userpb.VerifyWorkEmailRequest{Code: reqBody.Code})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to update status: %v", err), http.StatusBadRequest)
+			writeVerificationError(w, err)
 			return
 		}
 
 		jsonResp := map[string]interface{}{
-			"date": convertScheduledDateToJSON(resp.Date),
+			"domain":  resp.Domain,
+			"message": resp.Message,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -1659,265 +1591,1960 @@ func createAdminDateStatusHandler(adminService *service.AdminService) http.Handl
 	}
 }
 
-// Helper functions for admin handlers
-func convertUserFullDetailsToJSON(user *adminpb.UserFullDetails) map[string]interface{} {
-	if user == nil {
-		return nil
-	}
+// createSubmitIdVerificationHandler uploads a government ID for review.
+// Images are sent as base64 strings in the JSON body.
+// POST /api/v1/user/verification/id
+func createSubmitIdVerificationHandler(userService *service.UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
 
-	result := map[string]interface{}{
-		"userId":            user.UserId,
-		"email":             user.Email,
-		"name":              user.Name,
-		"phone":             user.Phone,
-		"photoUrl":          user.PhotoUrl,
-		"age":               user.Age,
-		"gender":            user.Gender,
-		"accountStatus":     user.AccountStatus,
-		"emailVerified":     user.EmailVerified,
-		"phoneVerified":     user.PhoneVerified,
-		"photoCount":        user.PhotoCount,
-		"availabilityCount": user.AvailabilityCount,
-	}
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
 
-	if user.DateOfBirth != nil {
-		result["dateOfBirth"] = user.DateOfBirth.Seconds
-	}
-	if user.CreatedAt != nil {
-		result["createdAt"] = user.CreatedAt.Seconds
-	}
-	if user.LastLoginAt != nil {
-		result["lastLoginAt"] = user.LastLoginAt.Seconds
-	}
+		var reqBody struct {
+			DocumentType string `json:"documentType"`
+			IDNumber     string `json:"idNumber"`
+			FrontImage   []byte `json:"frontImage"`
+			BackImage    []byte `json:"backImage"`
+			SelfieImage  []byte `json:"selfieImage"`
+			ContentType  string `json:"contentType"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
 
-	return result
-}
+		resp, err := userService.SubmitIdVerification(ctx, &userpb.SubmitIdVerificationRequest{
+			DocumentType: userpb.IdDocumentType(userpb.IdDocumentType_value["ID_DOCUMENT_TYPE_"+strings.ToUpper(reqBody.DocumentType)]),
+			IdNumber:     reqBody.IDNumber,
+			FrontImage:   reqBody.FrontImage,
+			BackImage:    reqBody.BackImage,
+			SelfieImage:  reqBody.SelfieImage,
+			ContentType:  reqBody.ContentType,
+		})
+		if err != nil {
+			writeVerificationError(w, err)
+			return
+		}
 
-func convertScheduledDateToJSON(date *adminpb.ScheduledDate) map[string]interface{} {
-	if date == nil {
-		return nil
-	}
+		jsonResp := map[string]interface{}{
+			"verificationId": resp.VerificationId,
+			"status":         strings.ToLower(strings.TrimPrefix(resp.Status.String(), "ID_VERIFICATION_STATUS_")),
+			"message":        resp.Message,
+		}
 
-	result := map[string]interface{}{
-		"dateId":          date.DateId,
-		"user1Id":         date.User1Id,
-		"user2Id":         date.User2Id,
-		"genieId":         date.GenieId,
-		"durationMinutes": date.DurationMinutes,
-		"status":          date.Status.String(),
-		"dateType":        date.DateType,
-		"notes":           date.Notes,
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(jsonResp)
 	}
+}
 
-	if date.User1 != nil {
-		result["user1"] = map[string]interface{}{
-			"userId":     date.User1.UserId,
-			"name":       date.User1.Name,
-			"email":      date.User1.Email,
-			"phone":      date.User1.Phone,
-			"photoUrl":   date.User1.PhotoUrl,
-			"age":        date.User1.Age,
-			"gender":     date.User1.Gender,
-			"city":       date.User1.City,
-			"occupation": date.User1.Occupation,
-		}
+// writeDateChangeError maps cancel and reschedule errors to HTTP statuses
+func writeDateChangeError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unauthenticated:
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+	case codes.NotFound:
+		http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+	default:
+		http.Error(w, fmt.Sprintf("Failed to update date: %v", err), http.StatusInternalServerError)
 	}
+}
 
-	if date.User2 != nil {
-		result["user2"] = map[string]interface{}{
-			"userId":     date.User2.UserId,
-			"name":       date.User2.Name,
-			"email":      date.User2.Email,
-			"phone":      date.User2.Phone,
-			"photoUrl":   date.User2.PhotoUrl,
-			"age":        date.User2.Age,
-			"gender":     date.User2.Gender,
-			"city":       date.User2.City,
-			"occupation": date.User2.Occupation,
-		}
+// rescheduleRequestJSON converts a reschedule request for a JSON response,
+// with times as Unix seconds
+func rescheduleRequestJSON(req *userpb.RescheduleRequest) map[string]interface{} {
+	proposedTimes := make([]int64, 0, len(req.ProposedTimes))
+	for _, t := range req.ProposedTimes {
+		proposedTimes = append(proposedTimes, t.Seconds)
 	}
 
-	if date.ScheduledTime != nil {
-		result["scheduledTime"] = date.ScheduledTime.Seconds
+	jsonReq := map[string]interface{}{
+		"id":            req.Id,
+		"dateId":        req.DateId,
+		"requestedBy":   req.RequestedBy,
+		"proposedTimes": proposedTimes,
+		"reason":        req.Reason,
+		"status":        req.Status,
 	}
-	if date.CreatedAt != nil {
-		result["createdAt"] = date.CreatedAt.Seconds
+	if req.AcceptedTime != nil {
+		jsonReq["acceptedTime"] = req.AcceptedTime.Seconds
 	}
-	if date.UpdatedAt != nil {
-		result["updatedAt"] = date.UpdatedAt.Seconds
+	if req.CreatedAt != nil {
+		jsonReq["createdAt"] = req.CreatedAt.Seconds
 	}
-
-	if date.Location != nil {
-		result["location"] = map[string]interface{}{
-			"placeName": date.Location.PlaceName,
-			"address":   date.Location.Address,
-			"city":      date.Location.City,
-			"state":     date.Location.State,
-			"country":   date.Location.Country,
-			"zipcode":   date.Location.Zipcode,
-			"latitude":  date.Location.Latitude,
-			"longitude": date.Location.Longitude,
-		}
+	if req.RespondedAt != nil {
+		jsonReq["respondedAt"] = req.RespondedAt.Seconds
 	}
-
-	return result
+	return jsonReq
 }
 
-// =============================================================================
-// Admin Curation HTTP Handlers (AI-Powered Matching)
-// =============================================================================
-
-// createAdminGetCurationCandidatesHandler handles getting users available for curation
-func createAdminGetCurationCandidatesHandler(adminService *service.AdminService) http.HandlerFunc {
+// createCancelDateHandler cancels one of the user's upcoming dates
+// POST /api/v1/user/love-zone/dates/cancel
+func createCancelDateHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodGet {
+		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		grpcReq := &adminpb.GetCurationCandidatesRequest{}
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
 
-		resp, err := adminService.GetCurationCandidates(r.Context(), grpcReq)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get curation candidates: %v", err), http.StatusInternalServerError)
+		var reqBody struct {
+			DateID int32  `json:"dateId"`
+			Reason string `json:"reason"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		// Convert candidates to JSON (camelCase)
-		var candidates []map[string]interface{}
-		for _, candidate := range resp.Candidates {
-			candidates = append(candidates, map[string]interface{}{
-				"userId":              candidate.UserId,
-				"email":               candidate.Email,
-				"name":                candidate.Name,
-				"age":                 candidate.Age,
-				"gender":              candidate.Gender,
-				"profileCompletion":   candidate.ProfileCompletion,
-				"emailVerified":       candidate.EmailVerified,
-				"aadharVerified":      candidate.AadharVerified,
-				"workEmailVerified":   candidate.WorkEmailVerified,
-				"availableSlotsCount": candidate.AvailableSlotsCount,
-				"nextAvailableDate":   candidate.NextAvailableDate,
-			})
+		resp, err := userService.CancelDate(ctx, &userpb.CancelDateRequest{
+			DateId: reqBody.DateID,
+			Reason: reqBody.Reason,
+		})
+		if err != nil {
+			writeDateChangeError(w, err)
+			return
 		}
 
 		jsonResp := map[string]interface{}{
-			"candidates": candidates,
+			"dateId":           resp.DateId,
+			"status":           resp.Status,
+			"lateCancellation": resp.LateCancellation,
+			"message":          resp.Message,
+		}
+		if resp.CancelledAt != nil {
+			jsonResp["cancelledAt"] = resp.CancelledAt.Seconds
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminCurateDatesHandler handles AI-powered compatibility analysis
-func createAdminCurateDatesHandler(adminService *service.AdminService, db *sql.DB) http.HandlerFunc {
+// createRequestRescheduleHandler proposes new times for one of the user's
+// upcoming dates. Times are Unix seconds; leave them out to propose the
+// best times both users are free.
+// POST /api/v1/user/love-zone/dates/reschedule
+func createRequestRescheduleHandler(userService *service.UserService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		var reqBody struct {
-			UserID string `json:"userId"`
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
 		}
 
+		var reqBody struct {
+			DateID        int32   `json:"dateId"`
+			ProposedTimes []int64 `json:"proposedTimes"`
+			Reason        string  `json:"reason"`
+		}
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
 			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		// Get user's partner preferences (preferred genders)
-		userID, err := strconv.Atoi(reqBody.UserID)
+		req := &userpb.RequestRescheduleRequest{
+			DateId: reqBody.DateID,
+			Reason: reqBody.Reason,
+		}
+		for _, t := range reqBody.ProposedTimes {
+			req.ProposedTimes = append(req.ProposedTimes, &commonpb.Timestamp{Seconds: t})
+		}
+
+		resp, err := userService.RequestReschedule(ctx, req)
 		if err != nil {
-			http.Error(w, "Invalid user ID", http.StatusBadRequest)
+			writeDateChangeError(w, err)
 			return
 		}
 
-		var preferredGendersJSON []byte
-		err = db.QueryRowContext(r.Context(),
-			`SELECT looking_for_gender FROM datifyy_v2_partner_preferences WHERE user_id = $1`,
-			userID,
-		).Scan(&preferredGendersJSON)
+		jsonResp := map[string]interface{}{
+			"request": rescheduleRequestJSON(resp.Request),
+			"message": resp.Message,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createRespondToRescheduleHandler accepts one of the times proposed for a
+// date, or declines them
+// POST /api/v1/user/love-zone/reschedule/respond
+func createRespondToRescheduleHandler(userService *service.UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		var reqBody struct {
+			RequestID    int32 `json:"requestId"`
+			Accept       bool  `json:"accept"`
+			SelectedTime int64 `json:"selectedTime"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		req := &userpb.RespondToRescheduleRequest{
+			RequestId: reqBody.RequestID,
+			Accept:    reqBody.Accept,
+		}
+		if reqBody.SelectedTime != 0 {
+			req.SelectedTime = &commonpb.Timestamp{Seconds: reqBody.SelectedTime}
+		}
 
+		resp, err := userService.RespondToReschedule(ctx, req)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get partner preferences: %v", err), http.StatusInternalServerError)
+			writeDateChangeError(w, err)
 			return
 		}
 
-		// Parse preferred genders from JSONB (stored as strings like "MALE", "FEMALE", "NON_BINARY")
-		var preferredGenders []string
-		if err := json.Unmarshal(preferredGendersJSON, &preferredGenders); err != nil {
-			http.Error(w, fmt.Sprintf("Failed to parse preferred genders: %v", err), http.StatusBadRequest)
+		jsonResp := map[string]interface{}{
+			"request":       rescheduleRequestJSON(resp.Request),
+			"scheduledTime": resp.ScheduledTime.Seconds,
+			"message":       resp.Message,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createDataExportDownloadHandler serves a data export archive from a signed, expiring link
+func createDataExportDownloadHandler(userService *service.UserService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		if len(preferredGenders) == 0 {
-			http.Error(w, "User has no gender preferences set", http.StatusBadRequest)
+		// The signature authorizes the download, so no access token is required
+		query := r.URL.Query()
+		exportID, err := strconv.Atoi(query.Get("id"))
+		if err != nil {
+			http.Error(w, "Invalid export ID", http.StatusBadRequest)
+			return
+		}
+		expires, err := parseInt64(query.Get("expires"))
+		if err != nil {
+			http.Error(w, "Invalid expiry", http.StatusBadRequest)
 			return
 		}
 
-		// Find users matching the preferred genders who have availability starting tomorrow
-		tomorrow := time.Now().AddDate(0, 0, 1)
-		startOfDay := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, tomorrow.Location())
+		archive, err := userService.OpenDataExport(r.Context(), exportID, expires, query.Get("sig"))
+		if err != nil {
+			switch status.Code(err) {
+			case codes.PermissionDenied:
+				http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+			case codes.NotFound:
+				http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+			case codes.FailedPrecondition:
+				http.Error(w, status.Convert(err).Message(), http.StatusGone)
+			default:
+				http.Error(w, "Failed to download data export", http.StatusInternalServerError)
+			}
+			return
+		}
 
-		// Build query to find matching users with availability
-		query := `
-			SELECT DISTINCT u.id
-			FROM datifyy_v2_users u
-			INNER JOIN availability_slots a ON u.id = a.user_id
-			WHERE u.id != $1
-			  AND u.account_status = 'ACTIVE'
-			  AND u.date_of_birth IS NOT NULL
-			  AND u.gender = ANY($2)
-			  AND a.start_time >= $3
-			ORDER BY u.id
-		`
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="datifyy-export-%d.zip"`, exportID))
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+		w.Write(archive)
+	}
+}
 
-		rows, err := db.QueryContext(r.Context(), query, userID, pq.Array(preferredGenders), startOfDay.Unix())
+// createAvailabilityHandler creates HTTP handler for availability (GET, POST, PUT, DELETE)
+func createAvailabilityHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Extract access token from Authorization header
+		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			http.Error(w, "Authorization header required", http.StatusUnauthorized)
+			return
+		}
+
+		// Remove "Bearer " prefix
+		accessToken := authHeader
+		if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
+			accessToken = authHeader[7:]
+		}
+
+		// Parse access token to get user ID
+		var userID int
+		var timestamp int64
+		_, err := fmt.Sscanf(accessToken, "access_token_%d_%d", &userID, &timestamp)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to fetch candidate users: %v", err), http.StatusInternalServerError)
+			http.Error(w, "Invalid access token format", http.StatusUnauthorized)
 			return
 		}
-		defer rows.Close()
 
-		var candidateIDs []string
-		for rows.Next() {
-			var id int
-			if err := rows.Scan(&id); err != nil {
-				http.Error(w, fmt.Sprintf("Failed to scan candidate ID: %v", err), http.StatusInternalServerError)
+		// Add userID to context
+		ctx := context.WithValue(r.Context(), "userID", userID)
+
+		// Handle GET request (GetAvailability)
+		if r.Method == http.MethodGet {
+			// Parse query parameters
+			fromTime := int64(0)
+			toTime := int64(0)
+			if ft := r.URL.Query().Get("from_time"); ft != "" {
+				fromTime, _ = parseInt64(ft)
+			}
+			if tt := r.URL.Query().Get("to_time"); tt != "" {
+				toTime, _ = parseInt64(tt)
+			}
+
+			grpcReq := &availabilitypb.GetAvailabilityRequest{
+				FromTime: fromTime,
+				ToTime:   toTime,
+				Timezone: r.URL.Query().Get("timezone"),
+			}
+
+			resp, err := availabilityService.GetAvailability(ctx, grpcReq)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to get availability: %v", err), http.StatusInternalServerError)
 				return
 			}
-			candidateIDs = append(candidateIDs, strconv.Itoa(id))
+
+			// Convert to camelCase JSON
+			slots := converter.AvailabilitySlotsToJSON(resp.Slots)
+
+			jsonResp := map[string]interface{}{
+				"slots":      slots,
+				"totalCount": len(slots),
+				"rules":      converter.AvailabilityRulesToJSON(resp.Rules),
+				"exclusions": converter.AvailabilityExclusionsToJSON(resp.Exclusions),
+				"timezone":   resp.Timezone,
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
+			return
 		}
 
-		if len(candidateIDs) == 0 {
+		// Handle POST request (SubmitAvailability)
+		if r.Method == http.MethodPost {
+			var reqBody struct {
+				Timezone string                     `json:"timezone"`
+				Mode     string                     `json:"mode"`
+				Slots    []availabilitySlotJSONBody `json:"slots"`
+				Rules    []struct {
+					RRule           string                   `json:"rrule"`
+					StartTime       int64                    `json:"startTime"`
+					LocalStartTime  string                   `json:"localStartTime"`
+					DurationMinutes int32                    `json:"durationMinutes"`
+					Timezone        string                   `json:"timezone"`
+					Exdates         []int64                  `json:"exdates"`
+					DateType        string                   `json:"dateType"`
+					Notes           string                   `json:"notes"`
+					OfflineLocation *offlineLocationJSONBody `json:"offlineLocation"`
+				} `json:"rules"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			// Convert to gRPC request
+			pbSlots := make([]*availabilitypb.AvailabilitySlotInput, len(reqBody.Slots))
+			for i, slot := range reqBody.Slots {
+				pbSlots[i] = slot.toProto()
+			}
+
+			pbRules := make([]*availabilitypb.AvailabilityRuleInput, len(reqBody.Rules))
+			for i, rule := range reqBody.Rules {
+				pbRules[i] = &availabilitypb.AvailabilityRuleInput{
+					Rrule:           rule.RRule,
+					StartTime:       rule.StartTime,
+					LocalStartTime:  rule.LocalStartTime,
+					DurationMinutes: rule.DurationMinutes,
+					Timezone:        rule.Timezone,
+					Exdates:         rule.Exdates,
+					DateType:        stringToDateTypeEnum(rule.DateType),
+					Notes:           rule.Notes,
+					OfflineLocation: rule.OfflineLocation.toProto(),
+				}
+			}
+
+			mode := availabilitypb.SubmitMode_SUBMIT_MODE_BEST_EFFORT
+			switch reqBody.Mode {
+			case "", "best_effort":
+			case "all_or_nothing":
+				mode = availabilitypb.SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING
+			default:
+				http.Error(w, "mode must be best_effort or all_or_nothing", http.StatusBadRequest)
+				return
+			}
+
+			grpcReq := &availabilitypb.SubmitAvailabilityRequest{
+				Slots:    pbSlots,
+				Rules:    pbRules,
+				Timezone: reqBody.Timezone,
+				Mode:     mode,
+			}
+
+			resp, err := availabilityService.SubmitAvailability(ctx, grpcReq)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to submit availability: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			// Convert to camelCase JSON
+			createdSlots := make([]map[string]interface{}, len(resp.CreatedSlots))
+			for i, slot := range resp.CreatedSlots {
+				createdSlots[i] = convertSlotToJSON(slot)
+			}
+
+			// Convert validation errors map
+			validationErrors := make(map[string]string)
+			for k, v := range resp.ValidationErrors {
+				validationErrors[fmt.Sprintf("%d", k)] = v
+			}
+
+			ruleValidationErrors := make(map[string]string)
+			for k, v := range resp.RuleValidationErrors {
+				ruleValidationErrors[fmt.Sprintf("%d", k)] = v
+			}
+
 			jsonResp := map[string]interface{}{
-				"matches": []map[string]interface{}{},
+				"createdSlots":         createdSlots,
+				"createdCount":         resp.CreatedCount,
+				"validationErrors":     validationErrors,
+				"message":              resp.Message,
+				"createdRules":         converter.AvailabilityRulesToJSON(resp.CreatedRules),
+				"expandedSlots":        converter.AvailabilitySlotsToJSON(resp.ExpandedSlots),
+				"ruleValidationErrors": ruleValidationErrors,
+				"slotResults":          submitItemResultsToJSON(resp.SlotResults),
+				"ruleResults":          submitItemResultsToJSON(resp.RuleResults),
+				"rolledBack":           resp.RolledBack,
 			}
+
 			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(jsonResp)
 			return
 		}
 
-		// Call AI curation service with matched candidates
-		grpcReq := &adminpb.CurateDatesRequest{
-			UserId:       reqBody.UserID,
-			CandidateIds: candidateIDs,
+		// Handle PUT request (UpdateAvailability)
+		if r.Method == http.MethodPut {
+			slotID := r.URL.Query().Get("slot_id")
+			if slotID == "" {
+				http.Error(w, "slot_id query parameter required", http.StatusBadRequest)
+				return
+			}
+
+			var reqBody struct {
+				availabilitySlotJSONBody
+				Timezone string `json:"timezone"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			resp, err := availabilityService.UpdateAvailability(ctx, &availabilitypb.UpdateAvailabilityRequest{
+				SlotId:   slotID,
+				Slot:     reqBody.availabilitySlotJSONBody.toProto(),
+				Timezone: reqBody.Timezone,
+			})
+			if err != nil {
+				writeAvailabilityError(w, "Failed to update availability", err)
+				return
+			}
+
+			jsonResp := map[string]interface{}{
+				"slot":    convertSlotToJSON(resp.Slot),
+				"message": resp.Message,
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
+			return
 		}
 
-		resp, err := adminService.CurateDates(r.Context(), grpcReq)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to curate dates: %v", err), http.StatusInternalServerError)
+		// Handle DELETE request (DeleteAvailability)
+		if r.Method == http.MethodDelete {
+			slotID := r.URL.Query().Get("slot_id")
+			ruleID := r.URL.Query().Get("rule_id")
+			exclusionID := r.URL.Query().Get("exclusion_id")
+			if slotID == "" && ruleID == "" && exclusionID == "" {
+				http.Error(w, "slot_id, rule_id or exclusion_id query parameter required", http.StatusBadRequest)
+				return
+			}
+
+			grpcReq := &availabilitypb.DeleteAvailabilityRequest{
+				SlotId:      slotID,
+				RuleId:      ruleID,
+				ExclusionId: exclusionID,
+			}
+
+			resp, err := availabilityService.DeleteAvailability(ctx, grpcReq)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to delete availability: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			jsonResp := map[string]interface{}{
+				"success": resp.Success,
+				"message": resp.Message,
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
 			return
 		}
 
-		// Convert matches to JSON (camelCase)
-		var matches []map[string]interface{}
-		for _, match := range resp.Matches {
-			matches = append(matches, map[string]interface{}{
-				"userId":             match.UserId,
-				"name":               match.Name,
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// createClearAvailabilityHandler deletes the user's unreserved slots in a range
+// POST /api/v1/availability/clear
+func createClearAvailabilityHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		var reqBody struct {
+			FromTime      int64  `json:"fromTime"`
+			ToTime        int64  `json:"toTime"`
+			LocalFromTime string `json:"localFromTime"`
+			LocalToTime   string `json:"localToTime"`
+			Timezone      string `json:"timezone"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := availabilityService.ClearAvailability(ctx, &availabilitypb.ClearAvailabilityRequest{
+			FromTime:      reqBody.FromTime,
+			ToTime:        reqBody.ToTime,
+			LocalFromTime: reqBody.LocalFromTime,
+			LocalToTime:   reqBody.LocalToTime,
+			Timezone:      reqBody.Timezone,
+		})
+		if err != nil {
+			writeAvailabilityError(w, "Failed to clear availability", err)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"deletedCount":  resp.DeletedCount,
+			"reservedSlots": converter.AvailabilitySlotsToJSON(resp.ReservedSlots),
+			"message":       resp.Message,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAvailabilityStatusHandler summarizes the user's upcoming availability
+// GET /api/v1/availability/status?timezone=Asia/Kolkata
+func createAvailabilityStatusHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		resp, err := availabilityService.GetAvailabilityStatus(ctx, &availabilitypb.GetAvailabilityStatusRequest{
+			Timezone: r.URL.Query().Get("timezone"),
+		})
+		if err != nil {
+			writeAvailabilityError(w, "Failed to get availability status", err)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"hasSubmitted":           resp.HasSubmitted,
+			"openSlotCount":          resp.OpenSlotCount,
+			"reservedSlotCount":      resp.ReservedSlotCount,
+			"ruleCount":              resp.RuleCount,
+			"nextAvailableTime":      resp.NextAvailableTime,
+			"availableUntil":         resp.AvailableUntil,
+			"localNextAvailableTime": resp.LocalNextAvailableTime,
+			"localAvailableUntil":    resp.LocalAvailableUntil,
+			"needsAvailability":      resp.NeedsAvailability,
+			"timezone":               resp.Timezone,
+			"message":                resp.Message,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createCalendarFeedTokenHandler creates (POST) or revokes (DELETE) the user's iCal feed URL
+// POST/DELETE /api/v1/availability/calendar-feed
+func createCalendarFeedTokenHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		var jsonResp map[string]interface{}
+		switch r.Method {
+		case http.MethodPost:
+			resp, err := availabilityService.CreateCalendarFeed(ctx, &availabilitypb.CreateCalendarFeedRequest{})
+			if err != nil {
+				writeAvailabilityError(w, "Failed to create calendar feed", err)
+				return
+			}
+			jsonResp = map[string]interface{}{
+				"feedUrl": resp.FeedUrl,
+				"message": resp.Message,
+			}
+		case http.MethodDelete:
+			resp, err := availabilityService.RevokeCalendarFeed(ctx, &availabilitypb.RevokeCalendarFeedRequest{})
+			if err != nil {
+				writeAvailabilityError(w, "Failed to revoke calendar feed", err)
+				return
+			}
+			jsonResp = map[string]interface{}{
+				"success": resp.Success,
+				"message": resp.Message,
+			}
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createCalendarFeedHandler serves a user's iCal feed to calendar apps
+// GET /api/v1/calendar/feed/{token}.ics
+func createCalendarFeedHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// The secret token in the URL authorizes the feed, so no access token is required
+		token := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/calendar/feed/"), ".ics")
+		if token == "" || strings.Contains(token, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		feed, err := availabilityService.CalendarFeed(r.Context(), token)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				http.NotFound(w, r)
+				return
+			}
+			http.Error(w, "Failed to get calendar feed", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", ical.ContentType)
+		w.Header().Set("Content-Disposition", `inline; filename="datifyy.ics"`)
+		w.Header().Set("Cache-Control", "private, max-age=900")
+		w.WriteHeader(http.StatusOK)
+		w.Write(feed)
+	}
+}
+
+// createImportCalendarHandler imports busy periods from an iCalendar file.
+// The body is either the .ics file itself (Content-Type text/calendar, with
+// timezone and replace_existing as query parameters) or JSON.
+// POST /api/v1/availability/import
+func createImportCalendarHandler(availabilityService *service.AvailabilityService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx, ok := authenticatedContext(r)
+		if !ok {
+			http.Error(w, "Valid authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		// Allow a little over the service's limit so it reports the size
+		body, err := io.ReadAll(io.LimitReader(r.Body, 2<<20))
+		if err != nil {
+			http.Error(w, "Failed to read request body", http.StatusBadRequest)
+			return
+		}
+
+		grpcReq := &availabilitypb.ImportCalendarRequest{}
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			var reqBody struct {
+				IcsData         string `json:"icsData"`
+				Timezone        string `json:"timezone"`
+				ReplaceExisting bool   `json:"replaceExisting"`
+			}
+			if err := json.Unmarshal(body, &reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			grpcReq.IcsData = reqBody.IcsData
+			grpcReq.Timezone = reqBody.Timezone
+			grpcReq.ReplaceExisting = reqBody.ReplaceExisting
+		} else {
+			grpcReq.IcsData = string(body)
+			grpcReq.Timezone = r.URL.Query().Get("timezone")
+			grpcReq.ReplaceExisting = r.URL.Query().Get("replace_existing") == "true"
+		}
+
+		resp, err := availabilityService.ImportCalendar(ctx, grpcReq)
+		if err != nil {
+			writeAvailabilityError(w, "Failed to import calendar", err)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"importedCount":    resp.ImportedCount,
+			"removedCount":     resp.RemovedCount,
+			"exclusions":       converter.AvailabilityExclusionsToJSON(resp.Exclusions),
+			"conflictingSlots": converter.AvailabilitySlotsToJSON(resp.ConflictingSlots),
+			"warnings":         resp.Warnings,
+			"message":          resp.Message,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+func writeAvailabilityError(w http.ResponseWriter, prefix string, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unauthenticated:
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+	case codes.NotFound:
+		http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+	case codes.FailedPrecondition, codes.AlreadyExists:
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+	default:
+		http.Error(w, fmt.Sprintf("%s: %v", prefix, err), http.StatusInternalServerError)
+	}
+}
+
+// availabilitySlotJSONBody is the camelCase JSON form of a slot input
+type availabilitySlotJSONBody struct {
+	StartTime       int64                    `json:"startTime"`
+	EndTime         int64                    `json:"endTime"`
+	LocalStartTime  string                   `json:"localStartTime"`
+	LocalEndTime    string                   `json:"localEndTime"`
+	DateType        string                   `json:"dateType"`
+	Notes           string                   `json:"notes"`
+	OfflineLocation *offlineLocationJSONBody `json:"offlineLocation"`
+}
+
+func (b availabilitySlotJSONBody) toProto() *availabilitypb.AvailabilitySlotInput {
+	return &availabilitypb.AvailabilitySlotInput{
+		StartTime:       b.StartTime,
+		EndTime:         b.EndTime,
+		LocalStartTime:  b.LocalStartTime,
+		LocalEndTime:    b.LocalEndTime,
+		DateType:        stringToDateTypeEnum(b.DateType),
+		Notes:           b.Notes,
+		OfflineLocation: b.OfflineLocation.toProto(),
+	}
+}
+
+func submitItemResultsToJSON(results []*availabilitypb.SubmitItemResult) []map[string]interface{} {
+	items := make([]map[string]interface{}, len(results))
+	for i, result := range results {
+		item := map[string]interface{}{
+			"index":   result.Index,
+			"created": result.Created,
+		}
+		if result.Error != "" {
+			item["error"] = result.Error
+		}
+		if result.Slot != nil {
+			item["slot"] = convertSlotToJSON(result.Slot)
+		}
+		if result.Rule != nil {
+			item["rule"] = converter.AvailabilityRuleToJSON(result.Rule)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// offlineLocationJSONBody is the camelCase JSON form of an offline location
+type offlineLocationJSONBody struct {
+	PlaceName     string  `json:"placeName"`
+	Address       string  `json:"address"`
+	City          string  `json:"city"`
+	State         string  `json:"state"`
+	Country       string  `json:"country"`
+	Zipcode       string  `json:"zipcode"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	GooglePlaceId string  `json:"googlePlaceId"`
+	GoogleMapsUrl string  `json:"googleMapsUrl"`
+}
+
+func (l *offlineLocationJSONBody) toProto() *availabilitypb.OfflineLocation {
+	if l == nil {
+		return nil
+	}
+	return &availabilitypb.OfflineLocation{
+		PlaceName:     l.PlaceName,
+		Address:       l.Address,
+		City:          l.City,
+		State:         l.State,
+		Country:       l.Country,
+		Zipcode:       l.Zipcode,
+		Latitude:      l.Latitude,
+		Longitude:     l.Longitude,
+		GooglePlaceId: l.GooglePlaceId,
+		GoogleMapsUrl: l.GoogleMapsUrl,
+	}
+}
+
+// Helper functions for availability
+func parseInt64(s string) (int64, error) {
+	var n int64
+	_, err := fmt.Sscanf(s, "%d", &n)
+	return n, err
+}
+
+func stringToDateTypeEnum(s string) availabilitypb.DateType {
+	switch s {
+	case "online", "DATE_TYPE_ONLINE":
+		return availabilitypb.DateType_DATE_TYPE_ONLINE
+	case "offline", "DATE_TYPE_OFFLINE":
+		return availabilitypb.DateType_DATE_TYPE_OFFLINE
+	case "offline_event", "DATE_TYPE_OFFLINE_EVENT":
+		return availabilitypb.DateType_DATE_TYPE_OFFLINE_EVENT
+	default:
+		return availabilitypb.DateType_DATE_TYPE_ONLINE
+	}
+}
+
+func convertSlotToJSON(slot *availabilitypb.AvailabilitySlot) map[string]interface{} {
+	result := map[string]interface{}{
+		"slotId":    slot.SlotId,
+		"userId":    slot.UserId,
+		"startTime": slot.StartTime,
+		"endTime":   slot.EndTime,
+		"dateType":  slot.DateType.String(),
+		"notes":     slot.Notes,
+	}
+
+	if slot.RuleId != "" {
+		result["ruleId"] = slot.RuleId
+	}
+
+	if slot.LocalStartTime != "" {
+		result["localStartTime"] = slot.LocalStartTime
+		result["localEndTime"] = slot.LocalEndTime
+	}
+
+	if slot.Reserved {
+		result["reserved"] = true
+	}
+
+	if slot.CreatedAt != nil {
+		result["createdAt"] = map[string]int64{
+			"seconds": slot.CreatedAt.Seconds,
+		}
+	}
+
+	if slot.UpdatedAt != nil {
+		result["updatedAt"] = map[string]int64{
+			"seconds": slot.UpdatedAt.Seconds,
+		}
+	}
+
+	if slot.OfflineLocation != nil {
+		result["offlineLocation"] = map[string]interface{}{
+			"placeName":     slot.OfflineLocation.PlaceName,
+			"address":       slot.OfflineLocation.Address,
+			"city":          slot.OfflineLocation.City,
+			"state":         slot.OfflineLocation.State,
+			"country":       slot.OfflineLocation.Country,
+			"zipcode":       slot.OfflineLocation.Zipcode,
+			"latitude":      slot.OfflineLocation.Latitude,
+			"longitude":     slot.OfflineLocation.Longitude,
+			"googlePlaceId": slot.OfflineLocation.GooglePlaceId,
+			"googleMapsUrl": slot.OfflineLocation.GoogleMapsUrl,
+		}
+	}
+
+	return result
+}
+
+// =============================================================================
+// Admin HTTP Handlers
+// =============================================================================
+
+// createAdminLoginHandler handles admin login
+func createAdminLoginHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var reqBody struct {
+			Email    string `json:"email"`
+			Password string `json:"password"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		grpcReq := &adminpb.AdminLoginRequest{
+			Email:    reqBody.Email,
+			Password: reqBody.Password,
+		}
+
+		resp, err := adminService.AdminLogin(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Login failed: %v", err), http.StatusUnauthorized)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"admin": map[string]interface{}{
+				"adminId": resp.Admin.AdminId,
+				"userId":  resp.Admin.UserId,
+				"email":   resp.Admin.Email,
+				"name":    resp.Admin.Name,
+				"role":    resp.Admin.Role.String(),
+				"isGenie": resp.Admin.IsGenie,
+			},
+			"tokens": map[string]interface{}{
+				"accessToken":  resp.Tokens.AccessToken,
+				"refreshToken": resp.Tokens.RefreshToken,
+				"expiresIn":    resp.Tokens.ExpiresIn,
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminGetAllUsersHandler handles fetching all users with pagination
+func createAdminGetAllUsersHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Parse query params
+		page := 1
+		pageSize := 20
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if ps := r.URL.Query().Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
+		}
+
+		sortBy := adminpb.UserSortField_USER_SORT_FIELD_CREATED_AT
+		if sb := r.URL.Query().Get("sort_by"); sb != "" {
+			switch sb {
+			case "name":
+				sortBy = adminpb.UserSortField_USER_SORT_FIELD_NAME
+			case "email":
+				sortBy = adminpb.UserSortField_USER_SORT_FIELD_EMAIL
+			case "last_login":
+				sortBy = adminpb.UserSortField_USER_SORT_FIELD_LAST_LOGIN
+			case "age":
+				sortBy = adminpb.UserSortField_USER_SORT_FIELD_AGE
+			}
+		}
+
+		sortOrder := adminpb.SortOrder_SORT_ORDER_DESC
+		if so := r.URL.Query().Get("sort_order"); so == "asc" {
+			sortOrder = adminpb.SortOrder_SORT_ORDER_ASC
+		}
+
+		grpcReq := &adminpb.GetAllUsersRequest{
+			Page:                int32(page),
+			PageSize:            int32(pageSize),
+			SortBy:              sortBy,
+			SortOrder:           sortOrder,
+			AccountStatusFilter: r.URL.Query().Get("account_status"),
+			GenderFilter:        r.URL.Query().Get("gender"),
+		}
+
+		resp, err := adminService.GetAllUsers(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to get users: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Convert users to JSON
+		users := make([]map[string]interface{}, len(resp.Users))
+		for i, user := range resp.Users {
+			users[i] = convertUserFullDetailsToJSON(user)
+		}
+
+		jsonResp := map[string]interface{}{
+			"users":      users,
+			"totalCount": resp.TotalCount,
+			"page":       resp.Page,
+			"pageSize":   resp.PageSize,
+			"totalPages": resp.TotalPages,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminSearchUsersHandler handles searching users
+func createAdminSearchUsersHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query().Get("q")
+		if query == "" {
+			http.Error(w, "Search query required", http.StatusBadRequest)
+			return
+		}
+
+		page := 1
+		pageSize := 20
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if ps := r.URL.Query().Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
+		}
+
+		grpcReq := &adminpb.SearchUsersRequest{
+			Query:    query,
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		}
+
+		resp, err := adminService.SearchUsers(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Search failed: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		users := make([]map[string]interface{}, len(resp.Users))
+		for i, user := range resp.Users {
+			users[i] = convertUserFullDetailsToJSON(user)
+		}
+
+		jsonResp := map[string]interface{}{
+			"users":      users,
+			"totalCount": resp.TotalCount,
+			"page":       resp.Page,
+			"pageSize":   resp.PageSize,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminGetUserDetailsHandler handles getting user details
+func createAdminGetUserDetailsHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Extract user ID from path: /api/v1/admin/users/{id}
+		userID := r.URL.Path[len("/api/v1/admin/users/"):]
+		if userID == "" {
+			http.Error(w, "User ID required", http.StatusBadRequest)
+			return
+		}
+
+		grpcReq := &adminpb.GetUserDetailsRequest{
+			UserId: userID,
+		}
+
+		resp, err := adminService.GetUserDetails(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to get user details: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"user": convertUserFullDetailsToJSON(resp.User),
+		}
+
+		// Add availability, past dates, upcoming dates if present
+		if len(resp.Availability) > 0 {
+			slots := make([]map[string]interface{}, len(resp.Availability))
+			for i, slot := range resp.Availability {
+				slots[i] = map[string]interface{}{
+					"startTime": slot.StartTime.Seconds,
+					"endTime":   slot.EndTime.Seconds,
+					"dateType":  slot.DateType,
+				}
+			}
+			jsonResp["availability"] = slots
+		}
+
+		changes := make([]map[string]interface{}, len(resp.ProfileChanges))
+		for i, change := range resp.ProfileChanges {
+			changes[i] = convertProfileChangeToJSON(change)
+		}
+		jsonResp["profileChanges"] = changes
+
+		if resp.Reliability != nil {
+			jsonResp["reliability"] = map[string]interface{}{
+				"cancellations":     resp.Reliability.Cancellations,
+				"lateCancellations": resp.Reliability.LateCancellations,
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminRevertProfileChangeHandler reverts a single profile change
+// POST /api/v1/admin/profile-changes/{id}/revert
+func createAdminRevertProfileChangeHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) != 6 || pathParts[5] != "revert" {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
+			return
+		}
+
+		ctx, ok := authenticatedAdminContext(r)
+		if !ok {
+			http.Error(w, "Admin authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		resp, err := adminService.RevertProfileChange(ctx, &adminpb.RevertProfileChangeRequest{
+			ChangeId: pathParts[4],
+		})
+		if err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+				return
+			}
+			writeReportModerationError(w, err)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"change": convertProfileChangeToJSON(resp.Change),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+func convertProfileChangeToJSON(c *adminpb.ProfileChange) map[string]interface{} {
+	if c == nil {
+		return nil
+	}
+
+	// Values are JSON encoded, so they're passed through as raw JSON
+	result := map[string]interface{}{
+		"changeId":        c.ChangeId,
+		"userId":          c.UserId,
+		"tableName":       c.TableName,
+		"fieldName":       c.FieldName,
+		"oldValue":        json.RawMessage(c.OldValue),
+		"newValue":        json.RawMessage(c.NewValue),
+		"source":          c.Source,
+		"actorId":         c.ActorId,
+		"revertsChangeId": c.RevertsChangeId,
+		"revertedBy":      c.RevertedBy,
+	}
+
+	if c.RevertedAt != nil {
+		result["revertedAt"] = c.RevertedAt.Seconds
+	}
+	if c.CreatedAt != nil {
+		result["createdAt"] = c.CreatedAt.Seconds
+	}
+
+	return result
+}
+
+// createAdminGetSuggestionsHandler handles getting date suggestions for a user
+func createAdminGetSuggestionsHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Extract user ID from path: /api/v1/admin/suggestions/{id}
+		userID := r.URL.Path[len("/api/v1/admin/suggestions/"):]
+		if userID == "" {
+			http.Error(w, "User ID required", http.StatusBadRequest)
+			return
+		}
+
+		limit := 10
+		if l := r.URL.Query().Get("limit"); l != "" {
+			fmt.Sscanf(l, "%d", &limit)
+		}
+
+		grpcReq := &adminpb.GetDateSuggestionsRequest{
+			UserId: userID,
+			Limit:  int32(limit),
+		}
+
+		resp, err := adminService.GetDateSuggestions(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to get suggestions: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		suggestions := make([]map[string]interface{}, len(resp.Suggestions))
+		for i, suggestion := range resp.Suggestions {
+			suggestions[i] = map[string]interface{}{
+				"user": map[string]interface{}{
+					"userId":     suggestion.User.UserId,
+					"name":       suggestion.User.Name,
+					"email":      suggestion.User.Email,
+					"phone":      suggestion.User.Phone,
+					"photoUrl":   suggestion.User.PhotoUrl,
+					"age":        suggestion.User.Age,
+					"gender":     suggestion.User.Gender,
+					"city":       suggestion.User.City,
+					"occupation": suggestion.User.Occupation,
+				},
+				"compatibilityScore": suggestion.CompatibilityScore,
+				"commonInterests":    suggestion.CommonInterests,
+				"suggestedDateType":  suggestion.SuggestedDateType,
+			}
+
+			if len(suggestion.MatchingSlots) > 0 {
+				slots := make([]map[string]interface{}, len(suggestion.MatchingSlots))
+				for j, slot := range suggestion.MatchingSlots {
+					slots[j] = map[string]interface{}{
+						"startTime": slot.StartTime.Seconds,
+						"endTime":   slot.EndTime.Seconds,
+						"dateType":  slot.DateType,
+					}
+				}
+				suggestions[i]["matchingSlots"] = slots
+			}
+		}
+
+		jsonResp := map[string]interface{}{
+			"suggestions": suggestions,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminDatesHandler handles scheduling dates and getting genie dates
+func createAdminDatesHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// POST - Schedule a date
+		if r.Method == http.MethodPost {
+			var reqBody struct {
+				User1ID         string `json:"user1Id"`
+				User2ID         string `json:"user2Id"`
+				ScheduledTime   int64  `json:"scheduledTime"`
+				DurationMinutes int64  `json:"durationMinutes"`
+				DateType        string `json:"dateType"`
+				Notes           string `json:"notes"`
+				Location        *struct {
+					PlaceName string  `json:"placeName"`
+					Address   string  `json:"address"`
+					City      string  `json:"city"`
+					State     string  `json:"state"`
+					Country   string  `json:"country"`
+					Zipcode   string  `json:"zipcode"`
+					Latitude  float64 `json:"latitude"`
+					Longitude float64 `json:"longitude"`
+				} `json:"location"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			grpcReq := &adminpb.ScheduleDateRequest{
+				User1Id:         reqBody.User1ID,
+				User2Id:         reqBody.User2ID,
+				ScheduledTime:   &commonpb.Timestamp{Seconds: reqBody.ScheduledTime},
+				DurationMinutes: reqBody.DurationMinutes,
+				DateType:        reqBody.DateType,
+				Notes:           reqBody.Notes,
+			}
+
+			if reqBody.Location != nil {
+				grpcReq.Location = &adminpb.OfflineLocation{
+					PlaceName: reqBody.Location.PlaceName,
+					Address:   reqBody.Location.Address,
+					City:      reqBody.Location.City,
+					State:     reqBody.Location.State,
+					Country:   reqBody.Location.Country,
+					Zipcode:   reqBody.Location.Zipcode,
+					Latitude:  reqBody.Location.Latitude,
+					Longitude: reqBody.Location.Longitude,
+				}
+			}
+
+			resp, err := adminService.ScheduleDate(r.Context(), grpcReq)
+			if err != nil {
+				if status.Code(err) == codes.AlreadyExists {
+					http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+					return
+				}
+				http.Error(w, fmt.Sprintf("Failed to schedule date: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			jsonResp := map[string]interface{}{
+				"date": convertScheduledDateToJSON(resp.Date),
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(jsonResp)
+			return
+		}
+
+		// GET - Get genie dates
+		if r.Method == http.MethodGet {
+			genieID := r.URL.Query().Get("genie_id")
+
+			page := 1
+			pageSize := 20
+			if p := r.URL.Query().Get("page"); p != "" {
+				fmt.Sscanf(p, "%d", &page)
+			}
+			if ps := r.URL.Query().Get("page_size"); ps != "" {
+				fmt.Sscanf(ps, "%d", &pageSize)
+			}
+
+			var statusFilter adminpb.DateStatus
+			if sf := r.URL.Query().Get("status"); sf != "" {
+				switch sf {
+				case "scheduled":
+					statusFilter = adminpb.DateStatus_DATE_STATUS_SCHEDULED
+				case "confirmed":
+					statusFilter = adminpb.DateStatus_DATE_STATUS_CONFIRMED
+				case "in_progress":
+					statusFilter = adminpb.DateStatus_DATE_STATUS_IN_PROGRESS
+				case "completed":
+					statusFilter = adminpb.DateStatus_DATE_STATUS_COMPLETED
+				case "cancelled":
+					statusFilter = adminpb.DateStatus_DATE_STATUS_CANCELLED
+				}
+			}
+
+			grpcReq := &adminpb.GetGenieDatesRequest{
+				GenieId:      genieID,
+				StatusFilter: statusFilter,
+				Page:         int32(page),
+				PageSize:     int32(pageSize),
+			}
+
+			resp, err := adminService.GetGenieDates(r.Context(), grpcReq)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to get dates: %v", err), http.StatusInternalServerError)
+				return
+			}
+
+			dates := make([]map[string]interface{}, len(resp.Dates))
+			for i, date := range resp.Dates {
+				dates[i] = convertScheduledDateToJSON(date)
+			}
+
+			jsonResp := map[string]interface{}{
+				"dates":      dates,
+				"totalCount": resp.TotalCount,
+				"page":       resp.Page,
+				"pageSize":   resp.PageSize,
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
+			return
+		}
+
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// createAdminDateStatusHandler handles updating date status, and getting a
+// date's history at /api/v1/admin/dates/{id}/history
+func createAdminDateStatusHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Extract date ID from path: /api/v1/admin/dates/{id}
+		dateID := r.URL.Path[len("/api/v1/admin/dates/"):]
+		if historyDateID := strings.TrimSuffix(dateID, "/history"); historyDateID != dateID {
+			handleAdminDateHistory(w, r, adminService, historyDateID)
+			return
+		}
+
+		if r.Method != http.MethodPut {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if dateID == "" {
+			http.Error(w, "Date ID required", http.StatusBadRequest)
+			return
+		}
+
+		var reqBody struct {
+			Status  string `json:"status"`
+			Notes   string `json:"notes"`
+			AdminID string `json:"adminId"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		var dateStatus adminpb.DateStatus
+		switch reqBody.Status {
+		case "scheduled":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_SCHEDULED
+		case "confirmed":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_CONFIRMED
+		case "in_progress":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_IN_PROGRESS
+		case "completed":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_COMPLETED
+		case "cancelled":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_CANCELLED
+		case "no_show":
+			dateStatus = adminpb.DateStatus_DATE_STATUS_NO_SHOW
+		default:
+			http.Error(w, "Invalid status", http.StatusBadRequest)
+			return
+		}
+
+		grpcReq := &adminpb.UpdateDateStatusRequest{
+			DateId:  dateID,
+			Status:  dateStatus,
+			Notes:   reqBody.Notes,
+			AdminId: reqBody.AdminID,
+		}
+
+		resp, err := adminService.UpdateDateStatus(r.Context(), grpcReq)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+			case codes.FailedPrecondition:
+				http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+			default:
+				http.Error(w, fmt.Sprintf("Failed to update status: %v", err), http.StatusBadRequest)
+			}
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"date": convertScheduledDateToJSON(resp.Date),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// handleAdminDateHistory handles getting a date's status changes
+func handleAdminDateHistory(w http.ResponseWriter, r *http.Request, adminService *service.AdminService, dateID string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	resp, err := adminService.GetDateHistory(r.Context(), &adminpb.GetDateHistoryRequest{DateId: dateID})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+		default:
+			http.Error(w, fmt.Sprintf("Failed to get date history: %v", err), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	activity := make([]map[string]interface{}, len(resp.Activity))
+	for i, a := range resp.Activity {
+		activity[i] = map[string]interface{}{
+			"activityId": a.ActivityId,
+			"action":     a.Action,
+			"fromStatus": a.FromStatus.String(),
+			"toStatus":   a.ToStatus.String(),
+			"notes":      a.Notes,
+			"actorType":  a.ActorType,
+			"actorId":    a.ActorId,
+			"actorName":  a.ActorName,
+			"createdAt":  a.CreatedAt.Seconds,
+			"oldValue":   a.OldValue,
+			"newValue":   a.NewValue,
+		}
+	}
+	nextStatuses := make([]string, len(resp.NextStatuses))
+	for i, next := range resp.NextStatuses {
+		nextStatuses[i] = next.String()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"date":         convertScheduledDateToJSON(resp.Date),
+		"activity":     activity,
+		"nextStatuses": nextStatuses,
+	})
+}
+
+// Helper functions for admin handlers
+func convertUserFullDetailsToJSON(user *adminpb.UserFullDetails) map[string]interface{} {
+	if user == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"userId":            user.UserId,
+		"email":             user.Email,
+		"name":              user.Name,
+		"phone":             user.Phone,
+		"photoUrl":          user.PhotoUrl,
+		"age":               user.Age,
+		"gender":            user.Gender,
+		"accountStatus":     user.AccountStatus,
+		"emailVerified":     user.EmailVerified,
+		"phoneVerified":     user.PhoneVerified,
+		"photoCount":        user.PhotoCount,
+		"availabilityCount": user.AvailabilityCount,
+	}
+
+	if user.DateOfBirth != nil {
+		result["dateOfBirth"] = user.DateOfBirth.Seconds
+	}
+	if user.CreatedAt != nil {
+		result["createdAt"] = user.CreatedAt.Seconds
+	}
+	if user.LastLoginAt != nil {
+		result["lastLoginAt"] = user.LastLoginAt.Seconds
+	}
+
+	return result
+}
+
+func convertScheduledDateToJSON(date *adminpb.ScheduledDate) map[string]interface{} {
+	if date == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"dateId":          date.DateId,
+		"user1Id":         date.User1Id,
+		"user2Id":         date.User2Id,
+		"genieId":         date.GenieId,
+		"durationMinutes": date.DurationMinutes,
+		"status":          date.Status.String(),
+		"dateType":        date.DateType,
+		"notes":           date.Notes,
+	}
+
+	if date.User1 != nil {
+		result["user1"] = map[string]interface{}{
+			"userId":     date.User1.UserId,
+			"name":       date.User1.Name,
+			"email":      date.User1.Email,
+			"phone":      date.User1.Phone,
+			"photoUrl":   date.User1.PhotoUrl,
+			"age":        date.User1.Age,
+			"gender":     date.User1.Gender,
+			"city":       date.User1.City,
+			"occupation": date.User1.Occupation,
+		}
+	}
+
+	if date.User2 != nil {
+		result["user2"] = map[string]interface{}{
+			"userId":     date.User2.UserId,
+			"name":       date.User2.Name,
+			"email":      date.User2.Email,
+			"phone":      date.User2.Phone,
+			"photoUrl":   date.User2.PhotoUrl,
+			"age":        date.User2.Age,
+			"gender":     date.User2.Gender,
+			"city":       date.User2.City,
+			"occupation": date.User2.Occupation,
+		}
+	}
+
+	if date.ScheduledTime != nil {
+		result["scheduledTime"] = date.ScheduledTime.Seconds
+	}
+	if date.CreatedAt != nil {
+		result["createdAt"] = date.CreatedAt.Seconds
+	}
+	if date.UpdatedAt != nil {
+		result["updatedAt"] = date.UpdatedAt.Seconds
+	}
+	if date.ConfirmedAt != nil {
+		result["confirmedAt"] = date.ConfirmedAt.Seconds
+	}
+	if date.CompletedAt != nil {
+		result["completedAt"] = date.CompletedAt.Seconds
+	}
+	if date.CancelledAt != nil {
+		result["cancelledAt"] = date.CancelledAt.Seconds
+	}
+
+	if date.Location != nil {
+		result["location"] = convertAdminLocationToJSON(date.Location)
+	}
+
+	return result
+}
+
+func convertAdminLocationToJSON(location *adminpb.OfflineLocation) map[string]interface{} {
+	if location == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"placeName": location.PlaceName,
+		"address":   location.Address,
+		"city":      location.City,
+		"state":     location.State,
+		"country":   location.Country,
+		"zipcode":   location.Zipcode,
+		"latitude":  location.Latitude,
+		"longitude": location.Longitude,
+	}
+}
+
+// createAdminCommonAvailabilityHandler finds times two users are both free to meet
+func createAdminCommonAvailabilityHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		grpcReq := &adminpb.FindCommonAvailabilityRequest{
+			User1Id:  query.Get("user1Id"),
+			User2Id:  query.Get("user2Id"),
+			DateType: query.Get("dateType"),
+		}
+		if ft, err := parseInt64(query.Get("fromTime")); err == nil {
+			grpcReq.FromTime = &commonpb.Timestamp{Seconds: ft}
+		}
+		if tt, err := parseInt64(query.Get("toTime")); err == nil {
+			grpcReq.ToTime = &commonpb.Timestamp{Seconds: tt}
+		}
+		if v, err := strconv.Atoi(query.Get("minDurationMinutes")); err == nil {
+			grpcReq.MinDurationMinutes = int32(v)
+		}
+		if v, err := strconv.Atoi(query.Get("bufferMinutes")); err == nil {
+			grpcReq.BufferMinutes = int32(v)
+		}
+		if v, err := strconv.ParseFloat(query.Get("maxDistanceKm"), 64); err == nil {
+			grpcReq.MaxDistanceKm = v
+		}
+		if v, err := strconv.Atoi(query.Get("limit")); err == nil {
+			grpcReq.Limit = int32(v)
+		}
+
+		resp, err := adminService.FindCommonAvailability(r.Context(), grpcReq)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			case codes.FailedPrecondition:
+				http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+			default:
+				http.Error(w, fmt.Sprintf("Failed to find common availability: %v", err), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		candidates := make([]map[string]interface{}, len(resp.Candidates))
+		for i, candidate := range resp.Candidates {
+			candidates[i] = map[string]interface{}{
+				"startTime":       candidate.StartTime.Seconds,
+				"endTime":         candidate.EndTime.Seconds,
+				"durationMinutes": candidate.DurationMinutes,
+				"dateType":        candidate.DateType,
+				"user1SlotId":     candidate.User1SlotId,
+				"user2SlotId":     candidate.User2SlotId,
+				"user1Location":   convertAdminLocationToJSON(candidate.User1Location),
+				"user2Location":   convertAdminLocationToJSON(candidate.User2Location),
+				"distanceKm":      candidate.DistanceKm,
+				"sameCity":        candidate.SameCity,
+				"score":           candidate.Score,
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"candidates": candidates,
+		})
+	}
+}
+
+// =============================================================================
+// Admin Curation HTTP Handlers (AI-Powered Matching)
+// =============================================================================
+
+// createAdminGetCurationCandidatesHandler handles getting users available for curation
+func createAdminGetCurationCandidatesHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		grpcReq := &adminpb.GetCurationCandidatesRequest{
+			ForUserId: r.URL.Query().Get("userId"),
+		}
+
+		resp, err := adminService.GetCurationCandidates(r.Context(), grpcReq)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			case codes.FailedPrecondition:
+				http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+			default:
+				http.Error(w, fmt.Sprintf("Failed to get curation candidates: %v", err), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		// Convert candidates to JSON (camelCase)
+		var candidates []map[string]interface{}
+		for _, candidate := range resp.Candidates {
+			candidates = append(candidates, map[string]interface{}{
+				"userId":              candidate.UserId,
+				"email":               candidate.Email,
+				"name":                candidate.Name,
+				"age":                 candidate.Age,
+				"gender":              candidate.Gender,
+				"profileCompletion":   candidate.ProfileCompletion,
+				"emailVerified":       candidate.EmailVerified,
+				"aadharVerified":      candidate.AadharVerified,
+				"workEmailVerified":   candidate.WorkEmailVerified,
+				"availableSlotsCount": candidate.AvailableSlotsCount,
+				"nextAvailableDate":   candidate.NextAvailableDate,
+				"distanceKm":          candidate.DistanceKm,
+				"horoscopeMatch":      convertHoroscopeMatchToJSON(candidate.HoroscopeMatch),
+				"preferenceRules":     convertPreferenceRulesToJSON(candidate.PreferenceRules),
+				"preferenceScore":     candidate.PreferenceScore,
+			})
+		}
+
+		jsonResp := map[string]interface{}{
+			"candidates": candidates,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminCurateDatesHandler handles AI-powered compatibility analysis
+func createAdminCurateDatesHandler(adminService *service.AdminService, db *sql.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var reqBody struct {
+			UserID string `json:"userId"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Get user's partner preferences (preferred genders)
+		userID, err := strconv.Atoi(reqBody.UserID)
+		if err != nil {
+			http.Error(w, "Invalid user ID", http.StatusBadRequest)
+			return
+		}
+
+		var lookingFor repository.EnumList
+		err = db.QueryRowContext(r.Context(),
+			`SELECT looking_for_gender FROM datifyy_v2_partner_preferences WHERE user_id = $1`,
+			userID,
+		).Scan(&lookingFor)
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to get partner preferences: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Preferences hold Gender enum numbers; users.gender holds names with
+		// or without the GENDER_ prefix
+		var preferredGenders []string
+		for _, g := range lookingFor {
+			name := userpb.Gender(g).String()
+			preferredGenders = append(preferredGenders, name, strings.TrimPrefix(name, "GENDER_"))
+		}
+
+		if len(preferredGenders) == 0 {
+			http.Error(w, "User has no gender preferences set", http.StatusBadRequest)
+			return
+		}
+
+		// Find users matching the preferred genders who have availability starting tomorrow
+		tomorrow := time.Now().AddDate(0, 0, 1)
+		startOfDay := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, tomorrow.Location())
+
+		// Build query to find matching users with availability
+		query := `
+			SELECT DISTINCT u.id
+			FROM datifyy_v2_users u
+			INNER JOIN availability_slots a ON u.id = a.user_id
+			WHERE u.id != $1
+			  AND u.account_status = 'ACTIVE'
+			  AND u.date_of_birth IS NOT NULL
+			  AND u.gender = ANY($2)
+			  AND a.start_time >= $3
+			ORDER BY u.id
+		`
+
+		rows, err := db.QueryContext(r.Context(), query, userID, pq.Array(preferredGenders), startOfDay.Unix())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to fetch candidate users: %v", err), http.StatusInternalServerError)
+			return
+		}
+		defer rows.Close()
+
+		var candidateIDs []string
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				http.Error(w, fmt.Sprintf("Failed to scan candidate ID: %v", err), http.StatusInternalServerError)
+				return
+			}
+			candidateIDs = append(candidateIDs, strconv.Itoa(id))
+		}
+
+		if len(candidateIDs) == 0 {
+			jsonResp := map[string]interface{}{
+				"matches": []map[string]interface{}{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(jsonResp)
+			return
+		}
+
+		// Call AI curation service with matched candidates
+		grpcReq := &adminpb.CurateDatesRequest{
+			UserId:       reqBody.UserID,
+			CandidateIds: candidateIDs,
+		}
+
+		resp, err := adminService.CurateDates(r.Context(), grpcReq)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to curate dates: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Convert matches to JSON (camelCase)
+		var matches []map[string]interface{}
+		for _, match := range resp.Matches {
+			matches = append(matches, map[string]interface{}{
+				"userId":             match.UserId,
+				"name":               match.Name,
 				"age":                match.Age,
 				"gender":             match.Gender,
 				"compatibilityScore": match.CompatibilityScore,
@@ -1925,388 +3552,1132 @@ func createAdminCurateDatesHandler(adminService *service.AdminService, db *sql.D
 				"reasoning":          match.Reasoning,
 				"matchedAspects":     match.MatchedAspects,
 				"mismatchedAspects":  match.MismatchedAspects,
+				"userRules":          convertPreferenceRulesToJSON(match.UserRules),
+				"candidateRules":     convertPreferenceRulesToJSON(match.CandidateRules),
+				"dealbreakersPassed": match.DealbreakersPassed,
+			})
+		}
+
+		jsonResp := map[string]interface{}{
+			"matches": matches,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminUpdateCuratedMatchActionHandler handles admin actions on curated matches
+func createAdminUpdateCuratedMatchActionHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var reqBody struct {
+			CuratedMatchID int    `json:"curatedMatchId"`
+			Action         string `json:"action"`
+			Notes          string `json:"notes,omitempty"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.CuratedMatchID <= 0 {
+			http.Error(w, "Invalid curated match ID", http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.Action == "" {
+			http.Error(w, "Action is required", http.StatusBadRequest)
+			return
+		}
+
+		// Update the curated match status
+		newStatus, err := datesService.UpdateCuratedMatchAction(r.Context(), reqBody.CuratedMatchID, reqBody.Action)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to update curated match: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"success":   true,
+			"message":   fmt.Sprintf("Curated match %s successfully", newStatus),
+			"newStatus": newStatus,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminGetCuratedMatchesByStatusHandler handles fetching curated matches by status
+func createAdminGetCuratedMatchesByStatusHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Parse query parameters
+		status := r.URL.Query().Get("status")
+		if status == "" {
+			status = "accepted" // Default to accepted matches
+		}
+
+		page := 1
+		pageSize := 20
+		if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+			if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+				page = p
+			}
+		}
+		if sizeStr := r.URL.Query().Get("pageSize"); sizeStr != "" {
+			if s, err := strconv.Atoi(sizeStr); err == nil && s > 0 && s <= 100 {
+				pageSize = s
+			}
+		}
+
+		offset := (page - 1) * pageSize
+
+		// Fetch matches with user details
+		matches, totalCount, err := datesService.GetCuratedMatchesByStatus(r.Context(), status, pageSize, offset)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to fetch curated matches: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Convert to JSON response
+		var matchesJSON []map[string]interface{}
+		for _, match := range matches {
+			matchesJSON = append(matchesJSON, map[string]interface{}{
+				"id": match.ID,
+				"user1": map[string]interface{}{
+					"userId": match.User1ID,
+					"name":   match.User1Name,
+					"email":  match.User1Email,
+					"age":    match.User1Age,
+					"gender": match.User1Gender,
+				},
+				"user2": map[string]interface{}{
+					"userId": match.User2ID,
+					"name":   match.User2Name,
+					"email":  match.User2Email,
+					"age":    match.User2Age,
+					"gender": match.User2Gender,
+				},
+				"compatibilityScore": match.CompatibilityScore,
+				"isMatch":            match.IsMatch,
+				"reasoning":          match.Reasoning,
+				"matchedAspects":     match.MatchedAspects,
+				"mismatchedAspects":  match.MismatchedAspects,
+				"status":             match.Status,
+				"source":             match.Source,
+				"createdByAdmin":     match.CreatedByAdmin,
+				"scheduledDateId":    match.ScheduledDateID,
+				"createdAt":          match.CreatedAt.Unix(),
+				"updatedAt":          match.UpdatedAt.Unix(),
+			})
+		}
+
+		totalPages := (totalCount + pageSize - 1) / pageSize
+
+		jsonResp := map[string]interface{}{
+			"matches":    matchesJSON,
+			"totalCount": totalCount,
+			"page":       page,
+			"pageSize":   pageSize,
+			"totalPages": totalPages,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminCreateSuggestionsHandler creates date suggestions from an accepted match
+func createAdminCreateSuggestionsHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Extract match ID from path /api/v1/admin/curation/matches/{id}/suggest
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) < 6 {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
+			return
+		}
+
+		matchIDStr := pathParts[5]
+		matchID, err := strconv.Atoi(matchIDStr)
+		if err != nil {
+			http.Error(w, "Invalid match ID", http.StatusBadRequest)
+			return
+		}
+
+		// Create suggestions for both users
+		err = datesService.CreateSuggestionsFromMatch(r.Context(), matchID)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to create suggestions: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Date suggestions created for match %d", matchID),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// =============================================================================
+// User Date Suggestion HTTP Handlers
+// =============================================================================
+
+// createUserGetSuggestionsHandler gets date suggestions for a user
+func createUserGetSuggestionsHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// TODO: Get user ID from authentication token
+		// For now, get from query parameter
+		userIDStr := r.URL.Query().Get("userId")
+		if userIDStr == "" {
+			http.Error(w, "User ID is required", http.StatusBadRequest)
+			return
+		}
+
+		userID, err := strconv.Atoi(userIDStr)
+		if err != nil {
+			http.Error(w, "Invalid user ID", http.StatusBadRequest)
+			return
+		}
+
+		status := r.URL.Query().Get("status") // Optional: pending, accepted, rejected
+
+		// Get suggestions
+		suggestions, err := datesService.GetUserSuggestions(r.Context(), userID, status)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to get suggestions: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Convert to JSON
+		var suggestionsJSON []map[string]interface{}
+		for _, sugg := range suggestions {
+			suggestionsJSON = append(suggestionsJSON, map[string]interface{}{
+				"id": sugg.ID,
+				"suggestedUser": map[string]interface{}{
+					"userId": sugg.SuggestedUserID,
+					"name":   sugg.SuggestedUserName,
+					"age":    sugg.SuggestedUserAge,
+					"gender": sugg.SuggestedUserGender,
+				},
+				"compatibilityScore": sugg.CompatibilityScore,
+				"reasoning":          sugg.Reasoning,
+				"status":             sugg.Status,
+				"createdAt":          sugg.CreatedAt.Unix(),
 			})
 		}
 
 		jsonResp := map[string]interface{}{
-			"matches": matches,
+			"suggestions": suggestionsJSON,
+			"count":       len(suggestionsJSON),
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createUserRespondToSuggestionHandler allows users to accept/reject suggestions
+func createUserRespondToSuggestionHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		// Extract suggestion ID from path /api/v1/user/suggestions/{id}/respond
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) < 5 {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
+			return
+		}
+
+		suggestionIDStr := pathParts[4]
+		suggestionID, err := strconv.Atoi(suggestionIDStr)
+		if err != nil {
+			http.Error(w, "Invalid suggestion ID", http.StatusBadRequest)
+			return
+		}
+
+		var reqBody struct {
+			UserID int  `json:"userId"` // TODO: Get from auth token
+			Accept bool `json:"accept"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.UserID <= 0 {
+			http.Error(w, "User ID is required", http.StatusBadRequest)
+			return
+		}
+
+		// Respond to suggestion
+		err = datesService.RespondToSuggestion(r.Context(), suggestionID, reqBody.UserID, reqBody.Accept)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to respond to suggestion: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		action := "rejected"
+		if reqBody.Accept {
+			action = "accepted"
+		}
+
+		jsonResp := map[string]interface{}{
+			"success": true,
+			"message": fmt.Sprintf("Suggestion %s successfully", action),
+			"action":  action,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminScheduleDateHandler schedules a date from accepted match
+func createAdminScheduleDateHandler(datesService *service.DatesService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var reqBody struct {
+			MatchID         int    `json:"matchId"`
+			GenieID         int    `json:"genieId"`
+			ScheduledTime   string `json:"scheduledTime"` // RFC3339 format
+			DurationMinutes int    `json:"durationMinutes"`
+			DateType        string `json:"dateType"` // "online" or "offline"
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.MatchID <= 0 {
+			http.Error(w, "Match ID is required", http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.GenieID <= 0 {
+			http.Error(w, "Genie ID is required", http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.DurationMinutes <= 0 {
+			reqBody.DurationMinutes = 60 // Default 1 hour
+		}
+
+		if reqBody.DateType == "" {
+			reqBody.DateType = "online" // Default to online
+		}
+
+		// Parse scheduled time
+		scheduledTime, err := time.Parse(time.RFC3339, reqBody.ScheduledTime)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid scheduled time format (use RFC3339): %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Schedule the date
+		scheduledDate, err := datesService.ScheduleDateFromMatch(
+			r.Context(), reqBody.MatchID, reqBody.GenieID,
+			scheduledTime, reqBody.DurationMinutes, reqBody.DateType,
+		)
+		if errors.Is(err, repository.ErrSlotReserved) || errors.Is(err, repository.ErrDateConflict) {
+			http.Error(w, fmt.Sprintf("Failed to schedule date: %v", err), http.StatusConflict)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to schedule date: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		jsonResp := map[string]interface{}{
+			"success": true,
+			"message": "Date scheduled successfully",
+			"scheduledDate": map[string]interface{}{
+				"id":              scheduledDate.ID,
+				"user1Id":         scheduledDate.User1ID,
+				"user2Id":         scheduledDate.User2ID,
+				"genieId":         scheduledDate.GenieID.Int64,
+				"scheduledTime":   scheduledDate.ScheduledTime.Format(time.RFC3339),
+				"durationMinutes": scheduledDate.DurationMinutes,
+				"status":          scheduledDate.Status,
+				"dateType":        scheduledDate.DateType,
+				"notes":           scheduledDate.Notes.String, // Contains Google Meet link and calendar info
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// =============================================================================
+// Admin Report Moderation HTTP Handlers
+// =============================================================================
+
+// createAdminListReportsHandler lists user reports for the moderation queue
+func createAdminListReportsHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		page := 1
+		pageSize := 20
+		if p := query.Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+		if ps := query.Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
+		}
+
+		grpcReq := &adminpb.ListUserReportsRequest{
+			Status:         adminpb.ReportStatus(adminpb.ReportStatus_value["REPORT_STATUS_"+strings.ToUpper(query.Get("status"))]),
+			Severity:       adminpb.ReportSeverity(adminpb.ReportSeverity_value["REPORT_SEVERITY_"+strings.ToUpper(query.Get("severity"))]),
+			ReportedUserId: query.Get("reportedUserId"),
+			ClaimedBy:      query.Get("claimedBy"),
+			Page:           int32(page),
+			PageSize:       int32(pageSize),
+		}
+
+		resp, err := adminService.ListUserReports(r.Context(), grpcReq)
+		if err != nil {
+			writeReportModerationError(w, err)
+			return
+		}
+
+		reports := make([]map[string]interface{}, len(resp.Reports))
+		for i, report := range resp.Reports {
+			reports[i] = convertUserReportToJSON(report)
+		}
+
+		jsonResp := map[string]interface{}{
+			"reports":    reports,
+			"totalCount": resp.TotalCount,
+			"page":       resp.Page,
+			"pageSize":   resp.PageSize,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminUpdateCuratedMatchActionHandler handles admin actions on curated matches
-func createAdminUpdateCuratedMatchActionHandler(datesService *service.DatesService) http.HandlerFunc {
+// createAdminReportActionHandler claims, resolves or dismisses a report
+// POST /api/v1/admin/reports/{reportId}/{claim|resolve|dismiss}
+func createAdminReportActionHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		var reqBody struct {
-			CuratedMatchID int    `json:"curatedMatchId"`
-			Action         string `json:"action"`
-			Notes          string `json:"notes,omitempty"`
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) != 6 {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
+			return
 		}
+		reportID := pathParts[4]
 
-		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+		ctx, ok := authenticatedAdminContext(r)
+		if !ok {
+			http.Error(w, "Admin authorization required", http.StatusUnauthorized)
+			return
+		}
+
+		// Claims carry no body
+		var reqBody struct {
+			Notes          string `json:"notes"`
+			Action         string `json:"action"` // warn, suspend, ban
+			SuspensionDays int32  `json:"suspensionDays"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil && !errors.Is(err, io.EOF) {
 			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
 			return
 		}
 
-		if reqBody.CuratedMatchID <= 0 {
-			http.Error(w, "Invalid curated match ID", http.StatusBadRequest)
+		jsonResp := map[string]interface{}{}
+		switch pathParts[5] {
+		case "claim":
+			resp, err := adminService.ClaimUserReport(ctx, &adminpb.ClaimUserReportRequest{
+				ReportId: reportID,
+			})
+			if err != nil {
+				writeReportModerationError(w, err)
+				return
+			}
+			jsonResp["report"] = convertUserReportToJSON(resp.Report)
+
+		case "resolve":
+			var action adminpb.EnforcementAction
+			switch reqBody.Action {
+			case "":
+				action = adminpb.EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED
+			case "warn":
+				action = adminpb.EnforcementAction_ENFORCEMENT_ACTION_WARN
+			case "suspend":
+				action = adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND
+			case "ban":
+				action = adminpb.EnforcementAction_ENFORCEMENT_ACTION_BAN
+			default:
+				http.Error(w, "Invalid action", http.StatusBadRequest)
+				return
+			}
+
+			resp, err := adminService.ResolveUserReport(ctx, &adminpb.ResolveUserReportRequest{
+				ReportId:       reportID,
+				Notes:          reqBody.Notes,
+				Action:         action,
+				SuspensionDays: reqBody.SuspensionDays,
+			})
+			if err != nil {
+				writeReportModerationError(w, err)
+				return
+			}
+			jsonResp["report"] = convertUserReportToJSON(resp.Report)
+			if resp.Enforcement != nil {
+				jsonResp["enforcement"] = convertModerationActionToJSON(resp.Enforcement)
+			}
+
+		case "dismiss":
+			resp, err := adminService.DismissUserReport(ctx, &adminpb.DismissUserReportRequest{
+				ReportId: reportID,
+				Notes:    reqBody.Notes,
+			})
+			if err != nil {
+				writeReportModerationError(w, err)
+				return
+			}
+			jsonResp["report"] = convertUserReportToJSON(resp.Report)
+
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
 			return
 		}
 
-		if reqBody.Action == "" {
-			http.Error(w, "Action is required", http.StatusBadRequest)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// writeReportModerationError maps moderation gRPC errors to HTTP status codes
+func writeReportModerationError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.Unauthenticated:
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+	case codes.NotFound:
+		http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+	default:
+		http.Error(w, fmt.Sprintf("Report moderation failed: %v", err), http.StatusInternalServerError)
+	}
+}
+
+func convertUserReportToJSON(report *adminpb.UserReport) map[string]interface{} {
+	if report == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"reportId":               report.ReportId,
+		"reporterUserId":         report.ReporterUserId,
+		"reportedUserId":         report.ReportedUserId,
+		"reportedUserName":       report.ReportedUserName,
+		"reason":                 report.Reason,
+		"details":                report.Details,
+		"evidenceUrls":           report.EvidenceUrls,
+		"status":                 strings.ToLower(strings.TrimPrefix(report.Status.String(), "REPORT_STATUS_")),
+		"severity":               strings.ToLower(strings.TrimPrefix(report.Severity.String(), "REPORT_SEVERITY_")),
+		"claimedBy":              report.ClaimedBy,
+		"reviewedBy":             report.ReviewedBy,
+		"resolutionNotes":        report.ResolutionNotes,
+		"escalated":              report.Escalated,
+		"openReportsAgainstUser": report.OpenReportsAgainstUser,
+	}
+
+	if report.ClaimedAt != nil {
+		result["claimedAt"] = report.ClaimedAt.Seconds
+	}
+	if report.ReviewedAt != nil {
+		result["reviewedAt"] = report.ReviewedAt.Seconds
+	}
+	if report.CreatedAt != nil {
+		result["createdAt"] = report.CreatedAt.Seconds
+	}
+
+	return result
+}
+
+// createAdminEnforcementHistoryHandler returns a user's enforcement history
+// GET /api/v1/admin/enforcement/{userId}
+func createAdminEnforcementHistoryHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Update the curated match status
-		newStatus, err := datesService.UpdateCuratedMatchAction(r.Context(), reqBody.CuratedMatchID, reqBody.Action)
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) != 5 {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := adminService.GetUserEnforcementHistory(r.Context(), &adminpb.GetUserEnforcementHistoryRequest{
+			UserId: pathParts[4],
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to update curated match: %v", err), http.StatusInternalServerError)
+			writeReportModerationError(w, err)
 			return
 		}
 
+		actions := make([]map[string]interface{}, len(resp.Actions))
+		for i, action := range resp.Actions {
+			actions[i] = convertModerationActionToJSON(action)
+		}
+
 		jsonResp := map[string]interface{}{
-			"success":   true,
-			"message":   fmt.Sprintf("Curated match %s successfully", newStatus),
-			"newStatus": newStatus,
+			"actions": actions,
+			"active":  convertModerationActionToJSON(resp.Active),
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminGetCuratedMatchesByStatusHandler handles fetching curated matches by status
-func createAdminGetCuratedMatchesByStatusHandler(datesService *service.DatesService) http.HandlerFunc {
+// createAdminListAppealsHandler lists enforcement appeals for review
+func createAdminListAppealsHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Parse query parameters
-		status := r.URL.Query().Get("status")
-		if status == "" {
-			status = "accepted" // Default to accepted matches
-		}
-
+		query := r.URL.Query()
 		page := 1
 		pageSize := 20
-		if pageStr := r.URL.Query().Get("page"); pageStr != "" {
-			if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-				page = p
-			}
+		if p := query.Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
 		}
-		if sizeStr := r.URL.Query().Get("pageSize"); sizeStr != "" {
-			if s, err := strconv.Atoi(sizeStr); err == nil && s > 0 && s <= 100 {
-				pageSize = s
-			}
+		if ps := query.Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
 		}
 
-		offset := (page - 1) * pageSize
-
-		// Fetch matches with user details
-		matches, totalCount, err := datesService.GetCuratedMatchesByStatus(r.Context(), status, pageSize, offset)
+		resp, err := adminService.ListEnforcementAppeals(r.Context(), &adminpb.ListEnforcementAppealsRequest{
+			Status:   adminpb.AppealStatus(adminpb.AppealStatus_value["APPEAL_STATUS_"+strings.ToUpper(query.Get("status"))]),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to fetch curated matches: %v", err), http.StatusInternalServerError)
+			writeReportModerationError(w, err)
 			return
 		}
 
-		// Convert to JSON response
-		var matchesJSON []map[string]interface{}
-		for _, match := range matches {
-			matchesJSON = append(matchesJSON, map[string]interface{}{
-				"id": match.ID,
-				"user1": map[string]interface{}{
-					"userId": match.User1ID,
-					"name":   match.User1Name,
-					"email":  match.User1Email,
-					"age":    match.User1Age,
-					"gender": match.User1Gender,
-				},
-				"user2": map[string]interface{}{
-					"userId": match.User2ID,
-					"name":   match.User2Name,
-					"email":  match.User2Email,
-					"age":    match.User2Age,
-					"gender": match.User2Gender,
-				},
-				"compatibilityScore": match.CompatibilityScore,
-				"isMatch":            match.IsMatch,
-				"reasoning":          match.Reasoning,
-				"matchedAspects":     match.MatchedAspects,
-				"mismatchedAspects":  match.MismatchedAspects,
-				"status":             match.Status,
-				"createdByAdmin":     match.CreatedByAdmin,
-				"scheduledDateId":    match.ScheduledDateID,
-				"createdAt":          match.CreatedAt.Unix(),
-				"updatedAt":          match.UpdatedAt.Unix(),
-			})
+		appeals := make([]map[string]interface{}, len(resp.Appeals))
+		for i, appeal := range resp.Appeals {
+			appeals[i] = convertEnforcementAppealToJSON(appeal)
 		}
 
-		totalPages := (totalCount + pageSize - 1) / pageSize
-
 		jsonResp := map[string]interface{}{
-			"matches":    matchesJSON,
-			"totalCount": totalCount,
-			"page":       page,
-			"pageSize":   pageSize,
-			"totalPages": totalPages,
+			"appeals":    appeals,
+			"totalCount": resp.TotalCount,
+			"page":       resp.Page,
+			"pageSize":   resp.PageSize,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createAdminCreateSuggestionsHandler creates date suggestions from an accepted match
-func createAdminCreateSuggestionsHandler(datesService *service.DatesService) http.HandlerFunc {
+// createAdminReviewAppealHandler approves or rejects an appeal
+// POST /api/v1/admin/appeals/{appealId}/review
+func createAdminReviewAppealHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// Extract match ID from path /api/v1/admin/curation/matches/{id}/suggest
 		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
-		if len(pathParts) < 6 {
+		if len(pathParts) != 6 || pathParts[5] != "review" {
 			http.Error(w, "Invalid URL format", http.StatusBadRequest)
 			return
 		}
 
-		matchIDStr := pathParts[5]
-		matchID, err := strconv.Atoi(matchIDStr)
-		if err != nil {
-			http.Error(w, "Invalid match ID", http.StatusBadRequest)
+		ctx, ok := authenticatedAdminContext(r)
+		if !ok {
+			http.Error(w, "Admin authorization required", http.StatusUnauthorized)
 			return
 		}
 
-		// Create suggestions for both users
-		err = datesService.CreateSuggestionsFromMatch(r.Context(), matchID)
+		var reqBody struct {
+			Approve bool   `json:"approve"`
+			Notes   string `json:"notes"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := adminService.ReviewEnforcementAppeal(ctx, &adminpb.ReviewEnforcementAppealRequest{
+			AppealId: pathParts[4],
+			Approve:  reqBody.Approve,
+			Notes:    reqBody.Notes,
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to create suggestions: %v", err), http.StatusInternalServerError)
+			writeReportModerationError(w, err)
 			return
 		}
 
 		jsonResp := map[string]interface{}{
-			"success": true,
-			"message": fmt.Sprintf("Date suggestions created for match %d", matchID),
+			"appeal": convertEnforcementAppealToJSON(resp.Appeal),
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// =============================================================================
-// User Date Suggestion HTTP Handlers
-// =============================================================================
+func convertModerationActionToJSON(action *adminpb.ModerationAction) map[string]interface{} {
+	if action == nil {
+		return nil
+	}
 
-// createUserGetSuggestionsHandler gets date suggestions for a user
-func createUserGetSuggestionsHandler(datesService *service.DatesService) http.HandlerFunc {
+	result := map[string]interface{}{
+		"actionId":     action.ActionId,
+		"userId":       action.UserId,
+		"reportId":     action.ReportId,
+		"adminId":      action.AdminId,
+		"action":       strings.ToLower(strings.TrimPrefix(action.Action.String(), "ENFORCEMENT_ACTION_")),
+		"durationDays": action.DurationDays,
+		"notes":        action.Notes,
+		"liftedReason": action.LiftedReason,
+	}
+
+	if action.ExpiresAt != nil {
+		result["expiresAt"] = action.ExpiresAt.Seconds
+	}
+	if action.LiftedAt != nil {
+		result["liftedAt"] = action.LiftedAt.Seconds
+	}
+	if action.CreatedAt != nil {
+		result["createdAt"] = action.CreatedAt.Seconds
+	}
+
+	return result
+}
+
+func convertEnforcementAppealToJSON(appeal *adminpb.EnforcementAppeal) map[string]interface{} {
+	if appeal == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"appealId":    appeal.AppealId,
+		"userId":      appeal.UserId,
+		"userName":    appeal.UserName,
+		"userEmail":   appeal.UserEmail,
+		"enforcement": convertModerationActionToJSON(appeal.Enforcement),
+		"message":     appeal.Message,
+		"status":      strings.ToLower(strings.TrimPrefix(appeal.Status.String(), "APPEAL_STATUS_")),
+		"reviewedBy":  appeal.ReviewedBy,
+		"reviewNotes": appeal.ReviewNotes,
+	}
+
+	if appeal.ReviewedAt != nil {
+		result["reviewedAt"] = appeal.ReviewedAt.Seconds
+	}
+	if appeal.CreatedAt != nil {
+		result["createdAt"] = appeal.CreatedAt.Seconds
+	}
+
+	return result
+}
+
+// createAdminListIdVerificationsHandler returns the ID verification review queue
+// GET /api/v1/admin/verifications?status=pending
+func createAdminListIdVerificationsHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		// TODO: Get user ID from authentication token
-		// For now, get from query parameter
-		userIDStr := r.URL.Query().Get("userId")
-		if userIDStr == "" {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
-			return
+		query := r.URL.Query()
+		page := 1
+		pageSize := 20
+		if p := query.Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
 		}
-
-		userID, err := strconv.Atoi(userIDStr)
-		if err != nil {
-			http.Error(w, "Invalid user ID", http.StatusBadRequest)
-			return
+		if ps := query.Get("page_size"); ps != "" {
+			fmt.Sscanf(ps, "%d", &pageSize)
 		}
 
-		status := r.URL.Query().Get("status") // Optional: pending, accepted, rejected
-
-		// Get suggestions
-		suggestions, err := datesService.GetUserSuggestions(r.Context(), userID, status)
+		resp, err := adminService.ListIdVerifications(r.Context(), &adminpb.ListIdVerificationsRequest{
+			Status:   userpb.IdVerificationStatus(userpb.IdVerificationStatus_value["ID_VERIFICATION_STATUS_"+strings.ToUpper(query.Get("status"))]),
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		})
 		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to get suggestions: %v", err), http.StatusInternalServerError)
-			return
-		}
-
-		// Convert to JSON
-		var suggestionsJSON []map[string]interface{}
-		for _, sugg := range suggestions {
-			suggestionsJSON = append(suggestionsJSON, map[string]interface{}{
-				"id": sugg.ID,
-				"suggestedUser": map[string]interface{}{
-					"userId": sugg.SuggestedUserID,
-					"name":   sugg.SuggestedUserName,
-					"email":  sugg.SuggestedUserEmail,
-					"age":    sugg.SuggestedUserAge,
-					"gender": sugg.SuggestedUserGender,
-				},
-				"compatibilityScore": sugg.CompatibilityScore,
-				"reasoning":          sugg.Reasoning,
-				"status":             sugg.Status,
-				"createdAt":          sugg.CreatedAt.Unix(),
-			})
+			writeReportModerationError(w, err)
+			return
+		}
+
+		verifications := make([]map[string]interface{}, len(resp.Verifications))
+		for i, v := range resp.Verifications {
+			verifications[i] = convertIdVerificationToJSON(v)
 		}
 
 		jsonResp := map[string]interface{}{
-			"suggestions": suggestionsJSON,
-			"count":       len(suggestionsJSON),
+			"verifications": verifications,
+			"totalCount":    resp.TotalCount,
+			"page":          resp.Page,
+			"pageSize":      resp.PageSize,
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
-// createUserRespondToSuggestionHandler allows users to accept/reject suggestions
-func createUserRespondToSuggestionHandler(datesService *service.DatesService) http.HandlerFunc {
+// createAdminIdVerificationHandler shows or reviews a single ID submission
+// GET  /api/v1/admin/verifications/{id}
+// POST /api/v1/admin/verifications/{id}/review
+func createAdminIdVerificationHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+		switch {
+		case len(pathParts) == 5 && r.Method == http.MethodGet:
+			resp, err := adminService.GetIdVerification(r.Context(), &adminpb.GetIdVerificationRequest{
+				VerificationId: pathParts[4],
+			})
+			if err != nil {
+				writeReportModerationError(w, err)
+				return
+			}
+
+			// Images are base64 encoded by the JSON encoder
+			jsonResp := map[string]interface{}{
+				"verification": convertIdVerificationToJSON(resp.Verification),
+				"frontImage":   resp.FrontImage,
+				"backImage":    resp.BackImage,
+				"selfieImage":  resp.SelfieImage,
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
+
+		case len(pathParts) == 6 && pathParts[5] == "review" && r.Method == http.MethodPost:
+			ctx, ok := authenticatedAdminContext(r)
+			if !ok {
+				http.Error(w, "Admin authorization required", http.StatusUnauthorized)
+				return
+			}
+
+			var reqBody struct {
+				Approve         bool   `json:"approve"`
+				RejectionReason string `json:"rejectionReason"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			resp, err := adminService.ReviewIdVerification(ctx, &adminpb.ReviewIdVerificationRequest{
+				VerificationId:  pathParts[4],
+				Approve:         reqBody.Approve,
+				RejectionReason: reqBody.RejectionReason,
+			})
+			if err != nil {
+				writeReportModerationError(w, err)
+				return
+			}
+
+			jsonResp := map[string]interface{}{
+				"verification": convertIdVerificationToJSON(resp.Verification),
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(jsonResp)
+
+		case len(pathParts) == 5 || len(pathParts) == 6:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
 
-		// Extract suggestion ID from path /api/v1/user/suggestions/{id}/respond
-		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
-		if len(pathParts) < 5 {
+		default:
 			http.Error(w, "Invalid URL format", http.StatusBadRequest)
-			return
-		}
-
-		suggestionIDStr := pathParts[4]
-		suggestionID, err := strconv.Atoi(suggestionIDStr)
-		if err != nil {
-			http.Error(w, "Invalid suggestion ID", http.StatusBadRequest)
-			return
 		}
+	}
+}
 
-		var reqBody struct {
-			UserID int  `json:"userId"` // TODO: Get from auth token
-			Accept bool `json:"accept"`
-		}
+func convertIdVerificationToJSON(v *adminpb.IdVerification) map[string]interface{} {
+	if v == nil {
+		return nil
+	}
 
-		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
-			return
-		}
+	result := map[string]interface{}{
+		"verificationId":  v.VerificationId,
+		"userId":          v.UserId,
+		"userName":        v.UserName,
+		"userEmail":       v.UserEmail,
+		"documentType":    strings.ToLower(strings.TrimPrefix(v.DocumentType.String(), "ID_DOCUMENT_TYPE_")),
+		"idNumberMasked":  v.IdNumberMasked,
+		"status":          strings.ToLower(strings.TrimPrefix(v.Status.String(), "ID_VERIFICATION_STATUS_")),
+		"reviewedBy":      v.ReviewedBy,
+		"rejectionReason": v.RejectionReason,
+		"hasBackImage":    v.HasBackImage,
+		"hasSelfieImage":  v.HasSelfieImage,
+	}
 
-		if reqBody.UserID <= 0 {
-			http.Error(w, "User ID is required", http.StatusBadRequest)
-			return
-		}
+	if v.ReviewedAt != nil {
+		result["reviewedAt"] = v.ReviewedAt.Seconds
+	}
+	if v.CreatedAt != nil {
+		result["createdAt"] = v.CreatedAt.Seconds
+	}
 
-		// Respond to suggestion
-		err = datesService.RespondToSuggestion(r.Context(), suggestionID, reqBody.UserID, reqBody.Accept)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to respond to suggestion: %v", err), http.StatusInternalServerError)
-			return
-		}
+	return result
+}
 
-		action := "rejected"
-		if reqBody.Accept {
-			action = "accepted"
-		}
+// adminPromptBody is the JSON form of a catalog prompt sent by admins.
+// Active window bounds are Unix seconds; zero leaves the bound open.
+type adminPromptBody struct {
+	PromptID        string            `json:"promptId"`
+	Category        string            `json:"category"`
+	Text            map[string]string `json:"text"`
+	MinAnswerLength int32             `json:"minAnswerLength"`
+	MaxAnswerLength int32             `json:"maxAnswerLength"`
+	DisplayOrder    int32             `json:"displayOrder"`
+	ActiveFrom      int64             `json:"activeFrom"`
+	ActiveUntil     int64             `json:"activeUntil"`
+	LegacyQuestion  string            `json:"legacyQuestion"`
+}
 
-		jsonResp := map[string]interface{}{
-			"success": true,
-			"message": fmt.Sprintf("Suggestion %s successfully", action),
-			"action":  action,
-		}
+func (b *adminPromptBody) toProto() *adminpb.Prompt {
+	p := &adminpb.Prompt{
+		PromptId:        b.PromptID,
+		Category:        b.Category,
+		Text:            b.Text,
+		MinAnswerLength: b.MinAnswerLength,
+		MaxAnswerLength: b.MaxAnswerLength,
+		DisplayOrder:    b.DisplayOrder,
+		LegacyQuestion:  userpb.PromptQuestion(userpb.PromptQuestion_value[b.LegacyQuestion]),
+	}
+	if b.ActiveFrom != 0 {
+		p.ActiveFrom = &commonpb.Timestamp{Seconds: b.ActiveFrom}
+	}
+	if b.ActiveUntil != 0 {
+		p.ActiveUntil = &commonpb.Timestamp{Seconds: b.ActiveUntil}
+	}
+	return p
+}
 
-		w.Header().Set("Content-Type", "application/json")
-		json.NewEncoder(w).Encode(jsonResp)
+// writeAdminPromptError maps prompt catalog gRPC errors to HTTP status codes
+func writeAdminPromptError(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.AlreadyExists {
+		http.Error(w, status.Convert(err).Message(), http.StatusConflict)
+		return
 	}
+	writeReportModerationError(w, err)
 }
 
-// createAdminScheduleDateHandler schedules a date from accepted match
-func createAdminScheduleDateHandler(datesService *service.DatesService) http.HandlerFunc {
+// createAdminPromptsHandler lists or creates catalog prompts
+// GET  /api/v1/admin/prompts?category=dating&include_inactive=true
+// POST /api/v1/admin/prompts
+func createAdminPromptsHandler(adminService *service.AdminService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if r.Method != http.MethodPost {
-			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-			return
-		}
+		var jsonResp map[string]interface{}
 
-		var reqBody struct {
-			MatchID         int    `json:"matchId"`
-			GenieID         int    `json:"genieId"`
-			ScheduledTime   string `json:"scheduledTime"` // RFC3339 format
-			DurationMinutes int    `json:"durationMinutes"`
-			DateType        string `json:"dateType"` // "online" or "offline"
-		}
+		switch r.Method {
+		case http.MethodGet:
+			resp, err := adminService.ListPrompts(r.Context(), &adminpb.ListPromptsRequest{
+				Category:        r.URL.Query().Get("category"),
+				IncludeInactive: r.URL.Query().Get("include_inactive") == "true",
+			})
+			if err != nil {
+				writeAdminPromptError(w, err)
+				return
+			}
 
-		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
-			http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+			prompts := make([]map[string]interface{}, len(resp.Prompts))
+			for i, p := range resp.Prompts {
+				prompts[i] = convertAdminPromptToJSON(p)
+			}
+			jsonResp = map[string]interface{}{"prompts": prompts}
+
+		case http.MethodPost:
+			ctx, ok := authenticatedAdminContext(r)
+			if !ok {
+				http.Error(w, "Admin authorization required", http.StatusUnauthorized)
+				return
+			}
+
+			var reqBody adminPromptBody
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+
+			resp, err := adminService.CreatePrompt(ctx, &adminpb.CreatePromptRequest{
+				Prompt: reqBody.toProto(),
+			})
+			if err != nil {
+				writeAdminPromptError(w, err)
+				return
+			}
+			jsonResp = map[string]interface{}{"prompt": convertAdminPromptToJSON(resp.Prompt)}
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
-		if reqBody.MatchID <= 0 {
-			http.Error(w, "Match ID is required", http.StatusBadRequest)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
+// createAdminPromptByIdHandler updates or retires a catalog prompt
+// PUT    /api/v1/admin/prompts/{id}
+// DELETE /api/v1/admin/prompts/{id}
+func createAdminPromptByIdHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pathParts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		if len(pathParts) != 5 {
+			http.Error(w, "Invalid URL format", http.StatusBadRequest)
 			return
 		}
+		promptID := pathParts[4]
 
-		if reqBody.GenieID <= 0 {
-			http.Error(w, "Genie ID is required", http.StatusBadRequest)
+		ctx, ok := authenticatedAdminContext(r)
+		if !ok {
+			http.Error(w, "Admin authorization required", http.StatusUnauthorized)
 			return
 		}
 
-		if reqBody.DurationMinutes <= 0 {
-			reqBody.DurationMinutes = 60 // Default 1 hour
-		}
+		var prompt *adminpb.Prompt
+		switch r.Method {
+		case http.MethodPut:
+			var reqBody adminPromptBody
+			if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+				http.Error(w, fmt.Sprintf("Invalid JSON: %v", err), http.StatusBadRequest)
+				return
+			}
+			reqBody.PromptID = promptID
 
-		if reqBody.DateType == "" {
-			reqBody.DateType = "online" // Default to online
-		}
+			resp, err := adminService.UpdatePrompt(ctx, &adminpb.UpdatePromptRequest{
+				Prompt: reqBody.toProto(),
+			})
+			if err != nil {
+				writeAdminPromptError(w, err)
+				return
+			}
+			prompt = resp.Prompt
 
-		// Parse scheduled time
-		scheduledTime, err := time.Parse(time.RFC3339, reqBody.ScheduledTime)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid scheduled time format (use RFC3339): %v", err), http.StatusBadRequest)
-			return
-		}
+		case http.MethodDelete:
+			resp, err := adminService.DeletePrompt(ctx, &adminpb.DeletePromptRequest{
+				PromptId: promptID,
+			})
+			if err != nil {
+				writeAdminPromptError(w, err)
+				return
+			}
+			prompt = resp.Prompt
 
-		// Schedule the date
-		scheduledDate, err := datesService.ScheduleDateFromMatch(
-			r.Context(), reqBody.MatchID, reqBody.GenieID,
-			scheduledTime, reqBody.DurationMinutes, reqBody.DateType,
-		)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Failed to schedule date: %v", err), http.StatusInternalServerError)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 			return
 		}
 
 		jsonResp := map[string]interface{}{
-			"success": true,
-			"message": "Date scheduled successfully",
-			"scheduledDate": map[string]interface{}{
-				"id":              scheduledDate.ID,
-				"user1Id":         scheduledDate.User1ID,
-				"user2Id":         scheduledDate.User2ID,
-				"genieId":         scheduledDate.GenieID.Int64,
-				"scheduledTime":   scheduledDate.ScheduledTime.Format(time.RFC3339),
-				"durationMinutes": scheduledDate.DurationMinutes,
-				"status":          scheduledDate.Status,
-				"dateType":        scheduledDate.DateType,
-				"notes":           scheduledDate.Notes.String, // Contains Google Meet link and calendar info
-			},
+			"prompt": convertAdminPromptToJSON(prompt),
 		}
 
 		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(jsonResp)
 	}
 }
 
+func convertAdminPromptToJSON(p *adminpb.Prompt) map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"promptId":        p.PromptId,
+		"category":        p.Category,
+		"text":            p.Text,
+		"minAnswerLength": p.MinAnswerLength,
+		"maxAnswerLength": p.MaxAnswerLength,
+		"displayOrder":    p.DisplayOrder,
+		"legacyQuestion":  p.LegacyQuestion.String(),
+		"active":          p.Active,
+		"updatedBy":       p.UpdatedBy,
+	}
+
+	if p.ActiveFrom != nil {
+		result["activeFrom"] = p.ActiveFrom.Seconds
+	}
+	if p.ActiveUntil != nil {
+		result["activeUntil"] = p.ActiveUntil.Seconds
+	}
+	if p.CreatedAt != nil {
+		result["createdAt"] = p.CreatedAt.Seconds
+	}
+	if p.UpdatedAt != nil {
+		result["updatedAt"] = p.UpdatedAt.Seconds
+	}
+
+	return result
+}
+
 // =============================================================================
 // Admin Analytics HTTP Handlers
 // =============================================================================
@@ -2660,6 +5031,114 @@ func createAdminGetAvailabilityStatsHandler(adminService *service.AdminService)
 	}
 }
 
+// createAdminGetAvailabilityHeatmapHandler handles the availability heatmap,
+// as JSON or, with format=csv, as a CSV download
+func createAdminGetAvailabilityHeatmapHandler(adminService *service.AdminService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		grpcReq := &adminpb.AvailabilityHeatmapRequest{
+			Timezone: query.Get("timezone"),
+			City:     query.Get("city"),
+			DateType: query.Get("date_type"),
+		}
+
+		var startTime, endTime int64
+		if st := query.Get("start_time"); st != "" {
+			fmt.Sscanf(st, "%d", &startTime)
+		}
+		if et := query.Get("end_time"); et != "" {
+			fmt.Sscanf(et, "%d", &endTime)
+		}
+		if startTime > 0 || endTime > 0 {
+			grpcReq.TimeRange = &adminpb.TimeRange{}
+			if startTime > 0 {
+				grpcReq.TimeRange.StartTime = &commonpb.Timestamp{Seconds: startTime}
+			}
+			if endTime > 0 {
+				grpcReq.TimeRange.EndTime = &commonpb.Timestamp{Seconds: endTime}
+			}
+		}
+
+		resp, err := adminService.GetAvailabilityHeatmap(r.Context(), grpcReq)
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, fmt.Sprintf("Failed to get availability heatmap: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		if query.Get("format") == "csv" {
+			data, err := service.AvailabilityHeatmapCSV(resp)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Failed to export availability heatmap: %v", err), http.StatusInternalServerError)
+				return
+			}
+			filename := fmt.Sprintf("availability-heatmap-%s.csv",
+				time.Unix(resp.TimeRange.StartTime.Seconds, 0).UTC().Format("2006-01-02"))
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+			w.WriteHeader(http.StatusOK)
+			w.Write(data)
+			return
+		}
+
+		cells := make([]map[string]interface{}, len(resp.Cells))
+		for i, cell := range resp.Cells {
+			cells[i] = map[string]interface{}{
+				"city":            cell.City,
+				"dateType":        cell.DateType,
+				"weekday":         cell.Weekday,
+				"hour":            cell.Hour,
+				"maleSlots":       cell.MaleSlots,
+				"femaleSlots":     cell.FemaleSlots,
+				"otherSlots":      cell.OtherSlots,
+				"totalSlots":      cell.TotalSlots,
+				"maleUsers":       cell.MaleUsers,
+				"femaleUsers":     cell.FemaleUsers,
+				"otherUsers":      cell.OtherUsers,
+				"reservedSlots":   cell.ReservedSlots,
+				"genderImbalance": cell.GenderImbalance,
+				"utilization":     cell.Utilization,
+				"indicator":       cell.Indicator,
+			}
+		}
+
+		cities := make([]map[string]interface{}, len(resp.Cities))
+		for i, city := range resp.Cities {
+			cities[i] = map[string]interface{}{
+				"city":            city.City,
+				"totalSlots":      city.TotalSlots,
+				"maleUsers":       city.MaleUsers,
+				"femaleUsers":     city.FemaleUsers,
+				"otherUsers":      city.OtherUsers,
+				"reservedSlots":   city.ReservedSlots,
+				"genderImbalance": city.GenderImbalance,
+				"utilization":     city.Utilization,
+				"indicator":       city.Indicator,
+			}
+		}
+
+		jsonResp := map[string]interface{}{
+			"cells":     cells,
+			"cities":    cities,
+			"startTime": resp.TimeRange.StartTime.Seconds,
+			"endTime":   resp.TimeRange.EndTime.Seconds,
+			"timezone":  resp.Timezone,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(jsonResp)
+	}
+}
+
 // =============================================================================
 // Admin Bulk Actions HTTP Handlers
 // =============================================================================
@@ -2673,9 +5152,11 @@ func createAdminBulkUserActionHandler(adminService *service.AdminService) http.H
 		}
 
 		var reqBody struct {
-			UserIds []string `json:"userIds"`
-			Action  string   `json:"action"`
-			Reason  string   `json:"reason"`
+			UserIds        []string `json:"userIds"`
+			Action         string   `json:"action"`
+			Reason         string   `json:"reason"`
+			AdminID        string   `json:"adminId"`
+			SuspensionDays int32    `json:"suspensionDays"`
 		}
 
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
@@ -2701,9 +5182,11 @@ func createAdminBulkUserActionHandler(adminService *service.AdminService) http.H
 		}
 
 		grpcReq := &adminpb.BulkUserActionRequest{
-			UserIds: reqBody.UserIds,
-			Action:  action,
-			Reason:  reqBody.Reason,
+			UserIds:        reqBody.UserIds,
+			Action:         action,
+			Reason:         reqBody.Reason,
+			AdminId:        reqBody.AdminID,
+			SuspensionDays: reqBody.SuspensionDays,
 		}
 
 		resp, err := adminService.BulkUserAction(r.Context(), grpcReq)
@@ -357,6 +357,222 @@ func (BulkUserAction) EnumDescriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{5}
 }
 
+// Report Moderation
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_PENDING     ReportStatus = 1
+	ReportStatus_REPORT_STATUS_IN_REVIEW   ReportStatus = 2 // Claimed by a moderator
+	ReportStatus_REPORT_STATUS_RESOLVED    ReportStatus = 3
+	ReportStatus_REPORT_STATUS_DISMISSED   ReportStatus = 4
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_PENDING",
+		2: "REPORT_STATUS_IN_REVIEW",
+		3: "REPORT_STATUS_RESOLVED",
+		4: "REPORT_STATUS_DISMISSED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_PENDING":     1,
+		"REPORT_STATUS_IN_REVIEW":   2,
+		"REPORT_STATUS_RESOLVED":    3,
+		"REPORT_STATUS_DISMISSED":   4,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[6].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[6]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type ReportSeverity int32
+
+const (
+	ReportSeverity_REPORT_SEVERITY_UNSPECIFIED ReportSeverity = 0
+	ReportSeverity_REPORT_SEVERITY_LOW         ReportSeverity = 1
+	ReportSeverity_REPORT_SEVERITY_MEDIUM      ReportSeverity = 2
+	ReportSeverity_REPORT_SEVERITY_HIGH        ReportSeverity = 3
+)
+
+// Enum value maps for ReportSeverity.
+var (
+	ReportSeverity_name = map[int32]string{
+		0: "REPORT_SEVERITY_UNSPECIFIED",
+		1: "REPORT_SEVERITY_LOW",
+		2: "REPORT_SEVERITY_MEDIUM",
+		3: "REPORT_SEVERITY_HIGH",
+	}
+	ReportSeverity_value = map[string]int32{
+		"REPORT_SEVERITY_UNSPECIFIED": 0,
+		"REPORT_SEVERITY_LOW":         1,
+		"REPORT_SEVERITY_MEDIUM":      2,
+		"REPORT_SEVERITY_HIGH":        3,
+	}
+)
+
+func (x ReportSeverity) Enum() *ReportSeverity {
+	p := new(ReportSeverity)
+	*p = x
+	return p
+}
+
+func (x ReportSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[7].Descriptor()
+}
+
+func (ReportSeverity) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[7]
+}
+
+func (x ReportSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportSeverity.Descriptor instead.
+func (ReportSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type EnforcementAction int32
+
+const (
+	EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED EnforcementAction = 0 // Resolve without enforcement
+	EnforcementAction_ENFORCEMENT_ACTION_WARN        EnforcementAction = 1
+	EnforcementAction_ENFORCEMENT_ACTION_SUSPEND     EnforcementAction = 2
+	EnforcementAction_ENFORCEMENT_ACTION_BAN         EnforcementAction = 3
+	EnforcementAction_ENFORCEMENT_ACTION_REINSTATE   EnforcementAction = 4 // History only: account restored by an admin
+)
+
+// Enum value maps for EnforcementAction.
+var (
+	EnforcementAction_name = map[int32]string{
+		0: "ENFORCEMENT_ACTION_UNSPECIFIED",
+		1: "ENFORCEMENT_ACTION_WARN",
+		2: "ENFORCEMENT_ACTION_SUSPEND",
+		3: "ENFORCEMENT_ACTION_BAN",
+		4: "ENFORCEMENT_ACTION_REINSTATE",
+	}
+	EnforcementAction_value = map[string]int32{
+		"ENFORCEMENT_ACTION_UNSPECIFIED": 0,
+		"ENFORCEMENT_ACTION_WARN":        1,
+		"ENFORCEMENT_ACTION_SUSPEND":     2,
+		"ENFORCEMENT_ACTION_BAN":         3,
+		"ENFORCEMENT_ACTION_REINSTATE":   4,
+	}
+)
+
+func (x EnforcementAction) Enum() *EnforcementAction {
+	p := new(EnforcementAction)
+	*p = x
+	return p
+}
+
+func (x EnforcementAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnforcementAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[8].Descriptor()
+}
+
+func (EnforcementAction) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[8]
+}
+
+func (x EnforcementAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnforcementAction.Descriptor instead.
+func (EnforcementAction) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+// Enforcement History & Appeals
+type AppealStatus int32
+
+const (
+	AppealStatus_APPEAL_STATUS_UNSPECIFIED AppealStatus = 0
+	AppealStatus_APPEAL_STATUS_PENDING     AppealStatus = 1
+	AppealStatus_APPEAL_STATUS_APPROVED    AppealStatus = 2
+	AppealStatus_APPEAL_STATUS_REJECTED    AppealStatus = 3
+)
+
+// Enum value maps for AppealStatus.
+var (
+	AppealStatus_name = map[int32]string{
+		0: "APPEAL_STATUS_UNSPECIFIED",
+		1: "APPEAL_STATUS_PENDING",
+		2: "APPEAL_STATUS_APPROVED",
+		3: "APPEAL_STATUS_REJECTED",
+	}
+	AppealStatus_value = map[string]int32{
+		"APPEAL_STATUS_UNSPECIFIED": 0,
+		"APPEAL_STATUS_PENDING":     1,
+		"APPEAL_STATUS_APPROVED":    2,
+		"APPEAL_STATUS_REJECTED":    3,
+	}
+)
+
+func (x AppealStatus) Enum() *AppealStatus {
+	p := new(AppealStatus)
+	*p = x
+	return p
+}
+
+func (x AppealStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppealStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_v1_admin_proto_enumTypes[9].Descriptor()
+}
+
+func (AppealStatus) Type() protoreflect.EnumType {
+	return &file_admin_v1_admin_proto_enumTypes[9]
+}
+
+func (x AppealStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppealStatus.Descriptor instead.
+func (AppealStatus) EnumDescriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
 type AnalyticsPeriod int32
 
 const (
@@ -396,11 +612,11 @@ func (x AnalyticsPeriod) String() string {
 }
 
 func (AnalyticsPeriod) Descriptor() protoreflect.EnumDescriptor {
-	return file_admin_v1_admin_proto_enumTypes[6].Descriptor()
+	return file_admin_v1_admin_proto_enumTypes[10].Descriptor()
 }
 
 func (AnalyticsPeriod) Type() protoreflect.EnumType {
-	return &file_admin_v1_admin_proto_enumTypes[6]
+	return &file_admin_v1_admin_proto_enumTypes[10]
 }
 
 func (x AnalyticsPeriod) Number() protoreflect.EnumNumber {
@@ -409,7 +625,7 @@ func (x AnalyticsPeriod) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AnalyticsPeriod.Descriptor instead.
 func (AnalyticsPeriod) EnumDescriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{10}
 }
 
 type AdminUser struct {
@@ -589,6 +805,9 @@ type ScheduledDate struct {
 	Notes           string                 `protobuf:"bytes,13,opt,name=notes,proto3" json:"notes,omitempty"`
 	CreatedAt       *v1.Timestamp          `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *v1.Timestamp          `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ConfirmedAt     *v1.Timestamp          `protobuf:"bytes,16,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	CompletedAt     *v1.Timestamp          `protobuf:"bytes,17,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CancelledAt     *v1.Timestamp          `protobuf:"bytes,18,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -728,6 +947,27 @@ func (x *ScheduledDate) GetUpdatedAt() *v1.Timestamp {
 	return nil
 }
 
+func (x *ScheduledDate) GetConfirmedAt() *v1.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *ScheduledDate) GetCompletedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *ScheduledDate) GetCancelledAt() *v1.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
 type UserSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1693,13 +1933,15 @@ func (x *GetUserDetailsRequest) GetUserId() string {
 }
 
 type GetUserDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserFullDetails       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Availability  []*AvailableSlot       `protobuf:"bytes,2,rep,name=availability,proto3" json:"availability,omitempty"`
-	PastDates     []*ScheduledDate       `protobuf:"bytes,3,rep,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
-	UpcomingDates []*ScheduledDate       `protobuf:"bytes,4,rep,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *UserFullDetails       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Availability   []*AvailableSlot       `protobuf:"bytes,2,rep,name=availability,proto3" json:"availability,omitempty"`
+	PastDates      []*ScheduledDate       `protobuf:"bytes,3,rep,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
+	UpcomingDates  []*ScheduledDate       `protobuf:"bytes,4,rep,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
+	ProfileChanges []*ProfileChange       `protobuf:"bytes,5,rep,name=profile_changes,json=profileChanges,proto3" json:"profile_changes,omitempty"` // Most recent first
+	Reliability    *DateReliability       `protobuf:"bytes,6,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetUserDetailsResponse) Reset() {
@@ -1760,29 +2002,43 @@ func (x *GetUserDetailsResponse) GetUpcomingDates() []*ScheduledDate {
 	return nil
 }
 
-// Get Date Suggestions (Opposite Sex)
-type GetDateSuggestionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetUserDetailsResponse) GetProfileChanges() []*ProfileChange {
+	if x != nil {
+		return x.ProfileChanges
+	}
+	return nil
 }
 
-func (x *GetDateSuggestionsRequest) Reset() {
-	*x = GetDateSuggestionsRequest{}
+func (x *GetUserDetailsResponse) GetReliability() *DateReliability {
+	if x != nil {
+		return x.Reliability
+	}
+	return nil
+}
+
+// Dates a user has cancelled, for reliability scoring
+type DateReliability struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Cancellations     int32                  `protobuf:"varint,1,opt,name=cancellations,proto3" json:"cancellations,omitempty"`
+	LateCancellations int32                  `protobuf:"varint,2,opt,name=late_cancellations,json=lateCancellations,proto3" json:"late_cancellations,omitempty"` // within the late-cancellation window
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DateReliability) Reset() {
+	*x = DateReliability{}
 	mi := &file_admin_v1_admin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDateSuggestionsRequest) String() string {
+func (x *DateReliability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDateSuggestionsRequest) ProtoMessage() {}
+func (*DateReliability) ProtoMessage() {}
 
-func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
+func (x *DateReliability) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_admin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1794,46 +2050,58 @@ func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDateSuggestionsRequest.ProtoReflect.Descriptor instead.
-func (*GetDateSuggestionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DateReliability.ProtoReflect.Descriptor instead.
+func (*DateReliability) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetDateSuggestionsRequest) GetUserId() string {
+func (x *DateReliability) GetCancellations() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Cancellations
 	}
-	return ""
+	return 0
 }
 
-func (x *GetDateSuggestionsRequest) GetLimit() int32 {
+func (x *DateReliability) GetLateCancellations() int32 {
 	if x != nil {
-		return x.Limit
+		return x.LateCancellations
 	}
 	return 0
 }
 
-type GetDateSuggestionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Suggestions   []*DateSuggestion      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A field-level change to a user's basic info, profile or preferences
+type ProfileChange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChangeId        string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableName       string                 `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	FieldName       string                 `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	OldValue        string                 `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`                        // JSON encoded
+	NewValue        string                 `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`                        // JSON encoded
+	Source          string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`                                            // user, admin or system
+	ActorId         string                 `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                           // User or admin ID; empty for system changes
+	RevertsChangeId string                 `protobuf:"bytes,9,opt,name=reverts_change_id,json=revertsChangeId,proto3" json:"reverts_change_id,omitempty"` // Set when this change reverted another
+	RevertedAt      *v1.Timestamp          `protobuf:"bytes,10,opt,name=reverted_at,json=revertedAt,proto3" json:"reverted_at,omitempty"`
+	RevertedBy      string                 `protobuf:"bytes,11,opt,name=reverted_by,json=revertedBy,proto3" json:"reverted_by,omitempty"`
+	CreatedAt       *v1.Timestamp          `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetDateSuggestionsResponse) Reset() {
-	*x = GetDateSuggestionsResponse{}
+func (x *ProfileChange) Reset() {
+	*x = ProfileChange{}
 	mi := &file_admin_v1_admin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDateSuggestionsResponse) String() string {
+func (x *ProfileChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDateSuggestionsResponse) ProtoMessage() {}
+func (*ProfileChange) ProtoMessage() {}
 
-func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfileChange) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_admin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1845,133 +2113,119 @@ func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDateSuggestionsResponse.ProtoReflect.Descriptor instead.
-func (*GetDateSuggestionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileChange.ProtoReflect.Descriptor instead.
+func (*ProfileChange) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetDateSuggestionsResponse) GetSuggestions() []*DateSuggestion {
+func (x *ProfileChange) GetChangeId() string {
 	if x != nil {
-		return x.Suggestions
+		return x.ChangeId
 	}
-	return nil
-}
-
-// Schedule Date
-type ScheduleDateRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	User1Id         string                 `protobuf:"bytes,1,opt,name=user1_id,json=user1Id,proto3" json:"user1_id,omitempty"`
-	User2Id         string                 `protobuf:"bytes,2,opt,name=user2_id,json=user2Id,proto3" json:"user2_id,omitempty"`
-	ScheduledTime   *v1.Timestamp          `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
-	DurationMinutes int64                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	DateType        string                 `protobuf:"bytes,5,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
-	Location        *OfflineLocation       `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	Notes           string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *ScheduleDateRequest) Reset() {
-	*x = ScheduleDateRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ScheduleDateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProfileChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (*ScheduleDateRequest) ProtoMessage() {}
-
-func (x *ScheduleDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[18]
+func (x *ProfileChange) GetTableName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TableName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ScheduleDateRequest.ProtoReflect.Descriptor instead.
-func (*ScheduleDateRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+func (x *ProfileChange) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
 }
 
-func (x *ScheduleDateRequest) GetUser1Id() string {
+func (x *ProfileChange) GetOldValue() string {
 	if x != nil {
-		return x.User1Id
+		return x.OldValue
 	}
 	return ""
 }
 
-func (x *ScheduleDateRequest) GetUser2Id() string {
+func (x *ProfileChange) GetNewValue() string {
 	if x != nil {
-		return x.User2Id
+		return x.NewValue
 	}
 	return ""
 }
 
-func (x *ScheduleDateRequest) GetScheduledTime() *v1.Timestamp {
+func (x *ProfileChange) GetSource() string {
 	if x != nil {
-		return x.ScheduledTime
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *ScheduleDateRequest) GetDurationMinutes() int64 {
+func (x *ProfileChange) GetActorId() string {
 	if x != nil {
-		return x.DurationMinutes
+		return x.ActorId
 	}
-	return 0
+	return ""
 }
 
-func (x *ScheduleDateRequest) GetDateType() string {
+func (x *ProfileChange) GetRevertsChangeId() string {
 	if x != nil {
-		return x.DateType
+		return x.RevertsChangeId
 	}
 	return ""
 }
 
-func (x *ScheduleDateRequest) GetLocation() *OfflineLocation {
+func (x *ProfileChange) GetRevertedAt() *v1.Timestamp {
 	if x != nil {
-		return x.Location
+		return x.RevertedAt
 	}
 	return nil
 }
 
-func (x *ScheduleDateRequest) GetNotes() string {
+func (x *ProfileChange) GetRevertedBy() string {
 	if x != nil {
-		return x.Notes
+		return x.RevertedBy
 	}
 	return ""
 }
 
-type ScheduleDateResponse struct {
+func (x *ProfileChange) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Revert Profile Change (super admins only)
+// The acting admin is taken from the admin access token
+type RevertProfileChangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          *ScheduledDate         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduleDateResponse) Reset() {
-	*x = ScheduleDateResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[19]
+func (x *RevertProfileChangeRequest) Reset() {
+	*x = RevertProfileChangeRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleDateResponse) String() string {
+func (x *RevertProfileChangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleDateResponse) ProtoMessage() {}
+func (*RevertProfileChangeRequest) ProtoMessage() {}
 
-func (x *ScheduleDateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[19]
+func (x *RevertProfileChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1982,39 +2236,85 @@ func (x *ScheduleDateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleDateResponse.ProtoReflect.Descriptor instead.
-func (*ScheduleDateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevertProfileChangeRequest.ProtoReflect.Descriptor instead.
+func (*RevertProfileChangeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevertProfileChangeRequest) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+type RevertProfileChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ProfileChange         `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // The change written by the revert
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertProfileChangeResponse) Reset() {
+	*x = RevertProfileChangeResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertProfileChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertProfileChangeResponse) ProtoMessage() {}
+
+func (x *RevertProfileChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertProfileChangeResponse.ProtoReflect.Descriptor instead.
+func (*RevertProfileChangeResponse) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ScheduleDateResponse) GetDate() *ScheduledDate {
+func (x *RevertProfileChangeResponse) GetChange() *ProfileChange {
 	if x != nil {
-		return x.Date
+		return x.Change
 	}
 	return nil
 }
 
-// Get Curation Candidates (Users available for dates tomorrow)
-type GetCurationCandidatesRequest struct {
+// Get Date Suggestions (Opposite Sex)
+type GetDateSuggestionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurationCandidatesRequest) Reset() {
-	*x = GetCurationCandidatesRequest{}
+func (x *GetDateSuggestionsRequest) Reset() {
+	*x = GetDateSuggestionsRequest{}
 	mi := &file_admin_v1_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurationCandidatesRequest) String() string {
+func (x *GetDateSuggestionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurationCandidatesRequest) ProtoMessage() {}
+func (*GetDateSuggestionsRequest) ProtoMessage() {}
 
-func (x *GetCurationCandidatesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,42 +2326,46 @@ func (x *GetCurationCandidatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurationCandidatesRequest.ProtoReflect.Descriptor instead.
-func (*GetCurationCandidatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDateSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDateSuggestionsRequest) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
 }
 
-type CurationCandidate struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email               string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Age                 int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
-	Gender              string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
-	ProfileCompletion   int32                  `protobuf:"varint,6,opt,name=profile_completion,json=profileCompletion,proto3" json:"profile_completion,omitempty"`
-	EmailVerified       bool                   `protobuf:"varint,7,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
-	AadharVerified      bool                   `protobuf:"varint,8,opt,name=aadhar_verified,json=aadharVerified,proto3" json:"aadhar_verified,omitempty"`
-	WorkEmailVerified   bool                   `protobuf:"varint,9,opt,name=work_email_verified,json=workEmailVerified,proto3" json:"work_email_verified,omitempty"`
-	AvailableSlotsCount int32                  `protobuf:"varint,10,opt,name=available_slots_count,json=availableSlotsCount,proto3" json:"available_slots_count,omitempty"`
-	NextAvailableDate   *v1.Timestamp          `protobuf:"bytes,11,opt,name=next_available_date,json=nextAvailableDate,proto3" json:"next_available_date,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *GetDateSuggestionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *CurationCandidate) Reset() {
-	*x = CurationCandidate{}
+func (x *GetDateSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDateSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*DateSuggestion      `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDateSuggestionsResponse) Reset() {
+	*x = GetDateSuggestionsResponse{}
 	mi := &file_admin_v1_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurationCandidate) String() string {
+func (x *GetDateSuggestionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurationCandidate) ProtoMessage() {}
+func (*GetDateSuggestionsResponse) ProtoMessage() {}
 
-func (x *CurationCandidate) ProtoReflect() protoreflect.Message {
+func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_v1_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2073,110 +2377,3417 @@ func (x *CurationCandidate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurationCandidate.ProtoReflect.Descriptor instead.
-func (*CurationCandidate) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDateSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDateSuggestionsResponse) Descriptor() ([]byte, []int) {
 	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CurationCandidate) GetUserId() string {
+func (x *GetDateSuggestionsResponse) GetSuggestions() []*DateSuggestion {
 	if x != nil {
-		return x.UserId
+		return x.Suggestions
+	}
+	return nil
+}
+
+// Schedule Date
+type ScheduleDateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	User1Id         string                 `protobuf:"bytes,1,opt,name=user1_id,json=user1Id,proto3" json:"user1_id,omitempty"`
+	User2Id         string                 `protobuf:"bytes,2,opt,name=user2_id,json=user2Id,proto3" json:"user2_id,omitempty"`
+	ScheduledTime   *v1.Timestamp          `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	DurationMinutes int64                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	DateType        string                 `protobuf:"bytes,5,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
+	Location        *OfflineLocation       `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	Notes           string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduleDateRequest) Reset() {
+	*x = ScheduleDateRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDateRequest) ProtoMessage() {}
+
+func (x *ScheduleDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleDateRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleDateRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ScheduleDateRequest) GetUser1Id() string {
+	if x != nil {
+		return x.User1Id
 	}
 	return ""
 }
 
-func (x *CurationCandidate) GetEmail() string {
+func (x *ScheduleDateRequest) GetUser2Id() string {
 	if x != nil {
-		return x.Email
+		return x.User2Id
 	}
 	return ""
 }
 
-func (x *CurationCandidate) GetName() string {
+func (x *ScheduleDateRequest) GetScheduledTime() *v1.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *ScheduleDateRequest) GetDurationMinutes() int64 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *ScheduleDateRequest) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *ScheduleDateRequest) GetLocation() *OfflineLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ScheduleDateRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type ScheduleDateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *ScheduledDate         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleDateResponse) Reset() {
+	*x = ScheduleDateResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDateResponse) ProtoMessage() {}
+
+func (x *ScheduleDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleDateResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleDateResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScheduleDateResponse) GetDate() *ScheduledDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// Find Common Availability (times both users are free to meet)
+type FindCommonAvailabilityRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	User1Id            string                 `protobuf:"bytes,1,opt,name=user1_id,json=user1Id,proto3" json:"user1_id,omitempty"`
+	User2Id            string                 `protobuf:"bytes,2,opt,name=user2_id,json=user2Id,proto3" json:"user2_id,omitempty"`
+	DateType           string                 `protobuf:"bytes,3,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`                                  // online, offline, offline_event; empty for any
+	FromTime           *v1.Timestamp          `protobuf:"bytes,4,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`                                  // Defaults to now
+	ToTime             *v1.Timestamp          `protobuf:"bytes,5,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`                                        // Defaults to 14 days after from_time
+	MinDurationMinutes int32                  `protobuf:"varint,6,opt,name=min_duration_minutes,json=minDurationMinutes,proto3" json:"min_duration_minutes,omitempty"` // Shortest useful window; defaults to 60
+	BufferMinutes      int32                  `protobuf:"varint,7,opt,name=buffer_minutes,json=bufferMinutes,proto3" json:"buffer_minutes,omitempty"`                  // Kept free around already-scheduled dates; defaults to 30
+	MaxDistanceKm      float64                `protobuf:"fixed64,8,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`               // For offline slots; defaults to 25
+	Limit              int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`                                                       // Defaults to 20, max 100
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FindCommonAvailabilityRequest) Reset() {
+	*x = FindCommonAvailabilityRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCommonAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommonAvailabilityRequest) ProtoMessage() {}
+
+func (x *FindCommonAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommonAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*FindCommonAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FindCommonAvailabilityRequest) GetUser1Id() string {
+	if x != nil {
+		return x.User1Id
+	}
+	return ""
+}
+
+func (x *FindCommonAvailabilityRequest) GetUser2Id() string {
+	if x != nil {
+		return x.User2Id
+	}
+	return ""
+}
+
+func (x *FindCommonAvailabilityRequest) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *FindCommonAvailabilityRequest) GetFromTime() *v1.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *FindCommonAvailabilityRequest) GetToTime() *v1.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *FindCommonAvailabilityRequest) GetMinDurationMinutes() int32 {
+	if x != nil {
+		return x.MinDurationMinutes
+	}
+	return 0
+}
+
+func (x *FindCommonAvailabilityRequest) GetBufferMinutes() int32 {
+	if x != nil {
+		return x.BufferMinutes
+	}
+	return 0
+}
+
+func (x *FindCommonAvailabilityRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+func (x *FindCommonAvailabilityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A window in which both users are available, with compatible date types and places
+type CommonAvailabilityCandidate struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartTime       *v1.Timestamp          `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime         *v1.Timestamp          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	DurationMinutes int64                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	DateType        string                 `protobuf:"bytes,4,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
+	User1SlotId     string                 `protobuf:"bytes,5,opt,name=user1_slot_id,json=user1SlotId,proto3" json:"user1_slot_id,omitempty"`
+	User2SlotId     string                 `protobuf:"bytes,6,opt,name=user2_slot_id,json=user2SlotId,proto3" json:"user2_slot_id,omitempty"`
+	User1Location   *OfflineLocation       `protobuf:"bytes,7,opt,name=user1_location,json=user1Location,proto3" json:"user1_location,omitempty"` // Offline slots only
+	User2Location   *OfflineLocation       `protobuf:"bytes,8,opt,name=user2_location,json=user2Location,proto3" json:"user2_location,omitempty"`
+	DistanceKm      float64                `protobuf:"fixed64,9,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"` // Between the two places, when both have coordinates
+	SameCity        bool                   `protobuf:"varint,10,opt,name=same_city,json=sameCity,proto3" json:"same_city,omitempty"`
+	Score           float64                `protobuf:"fixed64,11,opt,name=score,proto3" json:"score,omitempty"` // 0-1, higher is better; candidates are sorted by it
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CommonAvailabilityCandidate) Reset() {
+	*x = CommonAvailabilityCandidate{}
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommonAvailabilityCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonAvailabilityCandidate) ProtoMessage() {}
+
+func (x *CommonAvailabilityCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonAvailabilityCandidate.ProtoReflect.Descriptor instead.
+func (*CommonAvailabilityCandidate) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CommonAvailabilityCandidate) GetStartTime() *v1.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CommonAvailabilityCandidate) GetEndTime() *v1.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CommonAvailabilityCandidate) GetDurationMinutes() int64 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CommonAvailabilityCandidate) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *CommonAvailabilityCandidate) GetUser1SlotId() string {
+	if x != nil {
+		return x.User1SlotId
+	}
+	return ""
+}
+
+func (x *CommonAvailabilityCandidate) GetUser2SlotId() string {
+	if x != nil {
+		return x.User2SlotId
+	}
+	return ""
+}
+
+func (x *CommonAvailabilityCandidate) GetUser1Location() *OfflineLocation {
+	if x != nil {
+		return x.User1Location
+	}
+	return nil
+}
+
+func (x *CommonAvailabilityCandidate) GetUser2Location() *OfflineLocation {
+	if x != nil {
+		return x.User2Location
+	}
+	return nil
+}
+
+func (x *CommonAvailabilityCandidate) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *CommonAvailabilityCandidate) GetSameCity() bool {
+	if x != nil {
+		return x.SameCity
+	}
+	return false
+}
+
+func (x *CommonAvailabilityCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type FindCommonAvailabilityResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Candidates    []*CommonAvailabilityCandidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindCommonAvailabilityResponse) Reset() {
+	*x = FindCommonAvailabilityResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindCommonAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindCommonAvailabilityResponse) ProtoMessage() {}
+
+func (x *FindCommonAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindCommonAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*FindCommonAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindCommonAvailabilityResponse) GetCandidates() []*CommonAvailabilityCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Get Curation Candidates (Users available for dates tomorrow)
+type GetCurationCandidatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional - when set, excludes this user and users blocked in either direction
+	ForUserId     string `protobuf:"bytes,1,opt,name=for_user_id,json=forUserId,proto3" json:"for_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurationCandidatesRequest) Reset() {
+	*x = GetCurationCandidatesRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurationCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurationCandidatesRequest) ProtoMessage() {}
+
+func (x *GetCurationCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurationCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*GetCurationCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetCurationCandidatesRequest) GetForUserId() string {
+	if x != nil {
+		return x.ForUserId
+	}
+	return ""
+}
+
+type CurationCandidate struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email               string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Age                 int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	Gender              string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	ProfileCompletion   int32                  `protobuf:"varint,6,opt,name=profile_completion,json=profileCompletion,proto3" json:"profile_completion,omitempty"`
+	EmailVerified       bool                   `protobuf:"varint,7,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	AadharVerified      bool                   `protobuf:"varint,8,opt,name=aadhar_verified,json=aadharVerified,proto3" json:"aadhar_verified,omitempty"`
+	WorkEmailVerified   bool                   `protobuf:"varint,9,opt,name=work_email_verified,json=workEmailVerified,proto3" json:"work_email_verified,omitempty"`
+	AvailableSlotsCount int32                  `protobuf:"varint,10,opt,name=available_slots_count,json=availableSlotsCount,proto3" json:"available_slots_count,omitempty"`
+	NextAvailableDate   *v1.Timestamp          `protobuf:"bytes,11,opt,name=next_available_date,json=nextAvailableDate,proto3" json:"next_available_date,omitempty"`
+	// Rounded distance from the for_user_id user in km (0 when unknown)
+	DistanceKm int32 `protobuf:"varint,12,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// Horoscope compatibility with the for_user_id user, when both have birth details
+	HoroscopeMatch *v11.HoroscopeMatch `protobuf:"bytes,13,opt,name=horoscope_match,json=horoscopeMatch,proto3" json:"horoscope_match,omitempty"`
+	// The for_user_id user's partner-preference rules checked against this candidate
+	PreferenceRules []*v11.PreferenceRuleResult `protobuf:"bytes,14,rep,name=preference_rules,json=preferenceRules,proto3" json:"preference_rules,omitempty"`
+	// Weighted share (0-100) of those soft rules met; 0 when none could be checked
+	PreferenceScore int32 `protobuf:"varint,15,opt,name=preference_score,json=preferenceScore,proto3" json:"preference_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurationCandidate) Reset() {
+	*x = CurationCandidate{}
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurationCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurationCandidate) ProtoMessage() {}
+
+func (x *CurationCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurationCandidate.ProtoReflect.Descriptor instead.
+func (*CurationCandidate) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CurationCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CurationCandidate) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CurationCandidate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CurationCandidate) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *CurationCandidate) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *CurationCandidate) GetProfileCompletion() int32 {
+	if x != nil {
+		return x.ProfileCompletion
+	}
+	return 0
+}
+
+func (x *CurationCandidate) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *CurationCandidate) GetAadharVerified() bool {
+	if x != nil {
+		return x.AadharVerified
+	}
+	return false
+}
+
+func (x *CurationCandidate) GetWorkEmailVerified() bool {
+	if x != nil {
+		return x.WorkEmailVerified
+	}
+	return false
+}
+
+func (x *CurationCandidate) GetAvailableSlotsCount() int32 {
+	if x != nil {
+		return x.AvailableSlotsCount
+	}
+	return 0
+}
+
+func (x *CurationCandidate) GetNextAvailableDate() *v1.Timestamp {
+	if x != nil {
+		return x.NextAvailableDate
+	}
+	return nil
+}
+
+func (x *CurationCandidate) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *CurationCandidate) GetHoroscopeMatch() *v11.HoroscopeMatch {
+	if x != nil {
+		return x.HoroscopeMatch
+	}
+	return nil
+}
+
+func (x *CurationCandidate) GetPreferenceRules() []*v11.PreferenceRuleResult {
+	if x != nil {
+		return x.PreferenceRules
+	}
+	return nil
+}
+
+func (x *CurationCandidate) GetPreferenceScore() int32 {
+	if x != nil {
+		return x.PreferenceScore
+	}
+	return 0
+}
+
+type GetCurationCandidatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*CurationCandidate   `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurationCandidatesResponse) Reset() {
+	*x = GetCurationCandidatesResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurationCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurationCandidatesResponse) ProtoMessage() {}
+
+func (x *GetCurationCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurationCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*GetCurationCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCurationCandidatesResponse) GetCandidates() []*CurationCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Curate Dates (AI-powered compatibility matching)
+type CurateDatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // User to find matches for
+	CandidateIds  []string               `protobuf:"bytes,2,rep,name=candidate_ids,json=candidateIds,proto3" json:"candidate_ids,omitempty"` // Candidate user IDs to analyze
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurateDatesRequest) Reset() {
+	*x = CurateDatesRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurateDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurateDatesRequest) ProtoMessage() {}
+
+func (x *CurateDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurateDatesRequest.ProtoReflect.Descriptor instead.
+func (*CurateDatesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CurateDatesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CurateDatesRequest) GetCandidateIds() []string {
+	if x != nil {
+		return x.CandidateIds
+	}
+	return nil
+}
+
+type MatchResult struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Age                int32                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	Gender             string                 `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	CompatibilityScore float64                `protobuf:"fixed64,5,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"` // 0-100
+	IsMatch            bool                   `protobuf:"varint,6,opt,name=is_match,json=isMatch,proto3" json:"is_match,omitempty"`                                   // true if score >= 60
+	Reasoning          string                 `protobuf:"bytes,7,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	MatchedAspects     []string               `protobuf:"bytes,8,rep,name=matched_aspects,json=matchedAspects,proto3" json:"matched_aspects,omitempty"`
+	MismatchedAspects  []string               `protobuf:"bytes,9,rep,name=mismatched_aspects,json=mismatchedAspects,proto3" json:"mismatched_aspects,omitempty"`
+	// The user's partner-preference rules checked against the candidate
+	UserRules []*v11.PreferenceRuleResult `protobuf:"bytes,10,rep,name=user_rules,json=userRules,proto3" json:"user_rules,omitempty"`
+	// The candidate's partner-preference rules checked against the user
+	CandidateRules []*v11.PreferenceRuleResult `protobuf:"bytes,11,rep,name=candidate_rules,json=candidateRules,proto3" json:"candidate_rules,omitempty"`
+	// False when either side's deal-breakers fail
+	DealbreakersPassed bool `protobuf:"varint,12,opt,name=dealbreakers_passed,json=dealbreakersPassed,proto3" json:"dealbreakers_passed,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MatchResult) Reset() {
+	*x = MatchResult{}
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchResult) ProtoMessage() {}
+
+func (x *MatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
+func (*MatchResult) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MatchResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MatchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatchResult) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *MatchResult) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *MatchResult) GetCompatibilityScore() float64 {
+	if x != nil {
+		return x.CompatibilityScore
+	}
+	return 0
+}
+
+func (x *MatchResult) GetIsMatch() bool {
+	if x != nil {
+		return x.IsMatch
+	}
+	return false
+}
+
+func (x *MatchResult) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+func (x *MatchResult) GetMatchedAspects() []string {
+	if x != nil {
+		return x.MatchedAspects
+	}
+	return nil
+}
+
+func (x *MatchResult) GetMismatchedAspects() []string {
+	if x != nil {
+		return x.MismatchedAspects
+	}
+	return nil
+}
+
+func (x *MatchResult) GetUserRules() []*v11.PreferenceRuleResult {
+	if x != nil {
+		return x.UserRules
+	}
+	return nil
+}
+
+func (x *MatchResult) GetCandidateRules() []*v11.PreferenceRuleResult {
+	if x != nil {
+		return x.CandidateRules
+	}
+	return nil
+}
+
+func (x *MatchResult) GetDealbreakersPassed() bool {
+	if x != nil {
+		return x.DealbreakersPassed
+	}
+	return false
+}
+
+type CurateDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*MatchResult         `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurateDatesResponse) Reset() {
+	*x = CurateDatesResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurateDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurateDatesResponse) ProtoMessage() {}
+
+func (x *CurateDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurateDatesResponse.ProtoReflect.Descriptor instead.
+func (*CurateDatesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CurateDatesResponse) GetMatches() []*MatchResult {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// Update Curated Match Action (Admin accept/reject/review-later)
+type UpdateCuratedMatchActionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CuratedMatchId int32                  `protobuf:"varint,1,opt,name=curated_match_id,json=curatedMatchId,proto3" json:"curated_match_id,omitempty"`  // ID of the curated match
+	Action         CuratedMatchAction     `protobuf:"varint,2,opt,name=action,proto3,enum=datifyy.admin.v1.CuratedMatchAction" json:"action,omitempty"` // Action to take
+	Notes          string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`                                             // Optional notes
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCuratedMatchActionRequest) Reset() {
+	*x = UpdateCuratedMatchActionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCuratedMatchActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCuratedMatchActionRequest) ProtoMessage() {}
+
+func (x *UpdateCuratedMatchActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCuratedMatchActionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCuratedMatchActionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateCuratedMatchActionRequest) GetCuratedMatchId() int32 {
+	if x != nil {
+		return x.CuratedMatchId
+	}
+	return 0
+}
+
+func (x *UpdateCuratedMatchActionRequest) GetAction() CuratedMatchAction {
+	if x != nil {
+		return x.Action
+	}
+	return CuratedMatchAction_CURATED_MATCH_ACTION_UNSPECIFIED
+}
+
+func (x *UpdateCuratedMatchActionRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type UpdateCuratedMatchActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"` // The new status after action
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCuratedMatchActionResponse) Reset() {
+	*x = UpdateCuratedMatchActionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCuratedMatchActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCuratedMatchActionResponse) ProtoMessage() {}
+
+func (x *UpdateCuratedMatchActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCuratedMatchActionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCuratedMatchActionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateCuratedMatchActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateCuratedMatchActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateCuratedMatchActionResponse) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+// Get Curated Matches by Status
+type GetCuratedMatchesByStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Status filter (accepted, rejected, pending, review_later, scheduled)
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCuratedMatchesByStatusRequest) Reset() {
+	*x = GetCuratedMatchesByStatusRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCuratedMatchesByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCuratedMatchesByStatusRequest) ProtoMessage() {}
+
+func (x *GetCuratedMatchesByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCuratedMatchesByStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetCuratedMatchesByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCuratedMatchesByStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetCuratedMatchesByStatusRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCuratedMatchesByStatusRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CuratedMatchDetail struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	User1              *UserSummary           `protobuf:"bytes,2,opt,name=user1,proto3" json:"user1,omitempty"`
+	User2              *UserSummary           `protobuf:"bytes,3,opt,name=user2,proto3" json:"user2,omitempty"`
+	CompatibilityScore float64                `protobuf:"fixed64,4,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
+	IsMatch            bool                   `protobuf:"varint,5,opt,name=is_match,json=isMatch,proto3" json:"is_match,omitempty"`
+	Reasoning          string                 `protobuf:"bytes,6,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	MatchedAspects     []string               `protobuf:"bytes,7,rep,name=matched_aspects,json=matchedAspects,proto3" json:"matched_aspects,omitempty"`
+	MismatchedAspects  []string               `protobuf:"bytes,8,rep,name=mismatched_aspects,json=mismatchedAspects,proto3" json:"mismatched_aspects,omitempty"`
+	Status             string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedByAdmin     int32                  `protobuf:"varint,10,opt,name=created_by_admin,json=createdByAdmin,proto3" json:"created_by_admin,omitempty"`
+	ScheduledDateId    int32                  `protobuf:"varint,11,opt,name=scheduled_date_id,json=scheduledDateId,proto3" json:"scheduled_date_id,omitempty"`
+	CreatedAt          *v1.Timestamp          `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *v1.Timestamp          `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CuratedMatchDetail) Reset() {
+	*x = CuratedMatchDetail{}
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CuratedMatchDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CuratedMatchDetail) ProtoMessage() {}
+
+func (x *CuratedMatchDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CuratedMatchDetail.ProtoReflect.Descriptor instead.
+func (*CuratedMatchDetail) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CuratedMatchDetail) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CuratedMatchDetail) GetUser1() *UserSummary {
+	if x != nil {
+		return x.User1
+	}
+	return nil
+}
+
+func (x *CuratedMatchDetail) GetUser2() *UserSummary {
+	if x != nil {
+		return x.User2
+	}
+	return nil
+}
+
+func (x *CuratedMatchDetail) GetCompatibilityScore() float64 {
+	if x != nil {
+		return x.CompatibilityScore
+	}
+	return 0
+}
+
+func (x *CuratedMatchDetail) GetIsMatch() bool {
+	if x != nil {
+		return x.IsMatch
+	}
+	return false
+}
+
+func (x *CuratedMatchDetail) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+func (x *CuratedMatchDetail) GetMatchedAspects() []string {
+	if x != nil {
+		return x.MatchedAspects
+	}
+	return nil
+}
+
+func (x *CuratedMatchDetail) GetMismatchedAspects() []string {
+	if x != nil {
+		return x.MismatchedAspects
+	}
+	return nil
+}
+
+func (x *CuratedMatchDetail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CuratedMatchDetail) GetCreatedByAdmin() int32 {
+	if x != nil {
+		return x.CreatedByAdmin
+	}
+	return 0
+}
+
+func (x *CuratedMatchDetail) GetScheduledDateId() int32 {
+	if x != nil {
+		return x.ScheduledDateId
+	}
+	return 0
+}
+
+func (x *CuratedMatchDetail) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CuratedMatchDetail) GetUpdatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCuratedMatchesByStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*CuratedMatchDetail  `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCuratedMatchesByStatusResponse) Reset() {
+	*x = GetCuratedMatchesByStatusResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCuratedMatchesByStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCuratedMatchesByStatusResponse) ProtoMessage() {}
+
+func (x *GetCuratedMatchesByStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCuratedMatchesByStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetCuratedMatchesByStatusResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetCuratedMatchesByStatusResponse) GetMatches() []*CuratedMatchDetail {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *GetCuratedMatchesByStatusResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetCuratedMatchesByStatusResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCuratedMatchesByStatusResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCuratedMatchesByStatusResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Get Genie Dates (Dates assigned to admin)
+type GetGenieDatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GenieId       string                 `protobuf:"bytes,1,opt,name=genie_id,json=genieId,proto3" json:"genie_id,omitempty"`
+	StatusFilter  DateStatus             `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=datifyy.admin.v1.DateStatus" json:"status_filter,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenieDatesRequest) Reset() {
+	*x = GetGenieDatesRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenieDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenieDatesRequest) ProtoMessage() {}
+
+func (x *GetGenieDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenieDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetGenieDatesRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetGenieDatesRequest) GetGenieId() string {
+	if x != nil {
+		return x.GenieId
+	}
+	return ""
+}
+
+func (x *GetGenieDatesRequest) GetStatusFilter() DateStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return DateStatus_DATE_STATUS_UNSPECIFIED
+}
+
+func (x *GetGenieDatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGenieDatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetGenieDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dates         []*ScheduledDate       `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGenieDatesResponse) Reset() {
+	*x = GetGenieDatesResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGenieDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGenieDatesResponse) ProtoMessage() {}
+
+func (x *GetGenieDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGenieDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetGenieDatesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetGenieDatesResponse) GetDates() []*ScheduledDate {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *GetGenieDatesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetGenieDatesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetGenieDatesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Update Date Status. Only moves the date lifecycle allows are accepted:
+// scheduled -> confirmed -> in_progress -> completed, cancellation before the
+// date starts, and no_show before it completes.
+type UpdateDateStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateId        string                 `protobuf:"bytes,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	Status        DateStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=datifyy.admin.v1.DateStatus" json:"status,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`                    // cancellation reason when cancelling
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"` // recorded in the date's history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDateStatusRequest) Reset() {
+	*x = UpdateDateStatusRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDateStatusRequest) ProtoMessage() {}
+
+func (x *UpdateDateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDateStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateDateStatusRequest) GetDateId() string {
+	if x != nil {
+		return x.DateId
+	}
+	return ""
+}
+
+func (x *UpdateDateStatusRequest) GetStatus() DateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DateStatus_DATE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateDateStatusRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateDateStatusRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type UpdateDateStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *ScheduledDate         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDateStatusResponse) Reset() {
+	*x = UpdateDateStatusResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDateStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDateStatusResponse) ProtoMessage() {}
+
+func (x *UpdateDateStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDateStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDateStatusResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateDateStatusResponse) GetDate() *ScheduledDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+// Get Date History
+type GetDateHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DateId        string                 `protobuf:"bytes,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDateHistoryRequest) Reset() {
+	*x = GetDateHistoryRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDateHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDateHistoryRequest) ProtoMessage() {}
+
+func (x *GetDateHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDateHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDateHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDateHistoryRequest) GetDateId() string {
+	if x != nil {
+		return x.DateId
+	}
+	return ""
+}
+
+type DateActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActivityId    string                 `protobuf:"bytes,1,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                                             // created, status_changed, rescheduled
+	FromStatus    DateStatus             `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=datifyy.admin.v1.DateStatus" json:"from_status,omitempty"` // unspecified for created
+	ToStatus      DateStatus             `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=datifyy.admin.v1.DateStatus" json:"to_status,omitempty"`
+	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	ActorType     string                 `protobuf:"bytes,6,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // admin, user, system
+	ActorId       string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`       // admin or user ID; empty for system and unknown admins
+	ActorName     string                 `protobuf:"bytes,8,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	CreatedAt     *v1.Timestamp          `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OldValue      string                 `protobuf:"bytes,10,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // previous status, or start time (RFC 3339) for rescheduled
+	NewValue      string                 `protobuf:"bytes,11,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateActivity) Reset() {
+	*x = DateActivity{}
+	mi := &file_admin_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateActivity) ProtoMessage() {}
+
+func (x *DateActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateActivity.ProtoReflect.Descriptor instead.
+func (*DateActivity) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DateActivity) GetActivityId() string {
+	if x != nil {
+		return x.ActivityId
+	}
+	return ""
+}
+
+func (x *DateActivity) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DateActivity) GetFromStatus() DateStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return DateStatus_DATE_STATUS_UNSPECIFIED
+}
+
+func (x *DateActivity) GetToStatus() DateStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return DateStatus_DATE_STATUS_UNSPECIFIED
+}
+
+func (x *DateActivity) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *DateActivity) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *DateActivity) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *DateActivity) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *DateActivity) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DateActivity) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *DateActivity) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type GetDateHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *ScheduledDate         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Activity      []*DateActivity        `protobuf:"bytes,2,rep,name=activity,proto3" json:"activity,omitempty"`                                                                      // oldest first
+	NextStatuses  []DateStatus           `protobuf:"varint,3,rep,packed,name=next_statuses,json=nextStatuses,proto3,enum=datifyy.admin.v1.DateStatus" json:"next_statuses,omitempty"` // statuses the date can move to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDateHistoryResponse) Reset() {
+	*x = GetDateHistoryResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDateHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDateHistoryResponse) ProtoMessage() {}
+
+func (x *GetDateHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDateHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDateHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDateHistoryResponse) GetDate() *ScheduledDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetDateHistoryResponse) GetActivity() []*DateActivity {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *GetDateHistoryResponse) GetNextStatuses() []DateStatus {
+	if x != nil {
+		return x.NextStatuses
+	}
+	return nil
+}
+
+// Create Admin User
+type CreateAdminUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role          AdminRole              `protobuf:"varint,4,opt,name=role,proto3,enum=datifyy.admin.v1.AdminRole" json:"role,omitempty"`
+	IsGenie       bool                   `protobuf:"varint,5,opt,name=is_genie,json=isGenie,proto3" json:"is_genie,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminUserRequest) Reset() {
+	*x = CreateAdminUserRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminUserRequest) ProtoMessage() {}
+
+func (x *CreateAdminUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateAdminUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateAdminUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateAdminUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateAdminUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAdminUserRequest) GetRole() AdminRole {
+	if x != nil {
+		return x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+func (x *CreateAdminUserRequest) GetIsGenie() bool {
+	if x != nil {
+		return x.IsGenie
+	}
+	return false
+}
+
+type CreateAdminUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAdminUserResponse) Reset() {
+	*x = CreateAdminUserResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAdminUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAdminUserResponse) ProtoMessage() {}
+
+func (x *CreateAdminUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAdminUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateAdminUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateAdminUserResponse) GetAdmin() *AdminUser {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+// Get All Admins
+type GetAllAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllAdminsRequest) Reset() {
+	*x = GetAllAdminsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAdminsRequest) ProtoMessage() {}
+
+func (x *GetAllAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetAllAdminsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAllAdminsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetAllAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*AdminUser           `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllAdminsResponse) Reset() {
+	*x = GetAllAdminsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllAdminsResponse) ProtoMessage() {}
+
+func (x *GetAllAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetAllAdminsResponse) GetAdmins() []*AdminUser {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *GetAllAdminsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Update Admin
+type UpdateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          AdminRole              `protobuf:"varint,4,opt,name=role,proto3,enum=datifyy.admin.v1.AdminRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRequest) Reset() {
+	*x = UpdateAdminRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRequest) ProtoMessage() {}
+
+func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateAdminRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetRole() AdminRole {
+	if x != nil {
+		return x.Role
+	}
+	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+}
+
+type UpdateAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminResponse) Reset() {
+	*x = UpdateAdminResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminResponse) ProtoMessage() {}
+
+func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateAdminResponse) GetAdmin() *AdminUser {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+// Delete Admin
+type DeleteAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdminRequest) Reset() {
+	*x = DeleteAdminRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdminRequest) ProtoMessage() {}
+
+func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdminRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteAdminRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type DeleteAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAdminResponse) Reset() {
+	*x = DeleteAdminResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAdminResponse) ProtoMessage() {}
+
+func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAdminResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteAdminResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Update Admin Profile
+type UpdateAdminProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminProfileRequest) Reset() {
+	*x = UpdateAdminProfileRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminProfileRequest) ProtoMessage() {}
+
+func (x *UpdateAdminProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminProfileRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateAdminProfileRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *UpdateAdminProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAdminProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type UpdateAdminProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminProfileResponse) Reset() {
+	*x = UpdateAdminProfileResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminProfileResponse) ProtoMessage() {}
+
+func (x *UpdateAdminProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAdminProfileResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateAdminProfileResponse) GetAdmin() *AdminUser {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+type BulkUserActionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserIds        []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Action         BulkUserAction         `protobuf:"varint,2,opt,name=action,proto3,enum=datifyy.admin.v1.BulkUserAction" json:"action,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdminId        string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	SuspensionDays int32                  `protobuf:"varint,5,opt,name=suspension_days,json=suspensionDays,proto3" json:"suspension_days,omitempty"` // For BULK_USER_ACTION_SUSPEND; 0 suspends indefinitely
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkUserActionRequest) Reset() {
+	*x = BulkUserActionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUserActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUserActionRequest) ProtoMessage() {}
+
+func (x *BulkUserActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUserActionRequest.ProtoReflect.Descriptor instead.
+func (*BulkUserActionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BulkUserActionRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BulkUserActionRequest) GetAction() BulkUserAction {
+	if x != nil {
+		return x.Action
+	}
+	return BulkUserAction_BULK_USER_ACTION_UNSPECIFIED
+}
+
+func (x *BulkUserActionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BulkUserActionRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *BulkUserActionRequest) GetSuspensionDays() int32 {
+	if x != nil {
+		return x.SuspensionDays
+	}
+	return 0
+}
+
+type BulkUserActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SuccessCount  int32                  `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailedCount   int32                  `protobuf:"varint,2,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	FailedUserIds []string               `protobuf:"bytes,3,rep,name=failed_user_ids,json=failedUserIds,proto3" json:"failed_user_ids,omitempty"`
+	ErrorMessages []string               `protobuf:"bytes,4,rep,name=error_messages,json=errorMessages,proto3" json:"error_messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUserActionResponse) Reset() {
+	*x = BulkUserActionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUserActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUserActionResponse) ProtoMessage() {}
+
+func (x *BulkUserActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUserActionResponse.ProtoReflect.Descriptor instead.
+func (*BulkUserActionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BulkUserActionResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BulkUserActionResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *BulkUserActionResponse) GetFailedUserIds() []string {
+	if x != nil {
+		return x.FailedUserIds
+	}
+	return nil
+}
+
+func (x *BulkUserActionResponse) GetErrorMessages() []string {
+	if x != nil {
+		return x.ErrorMessages
+	}
+	return nil
+}
+
+type UserReport struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ReportId               string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	ReporterUserId         string                 `protobuf:"bytes,2,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
+	ReportedUserId         string                 `protobuf:"bytes,3,opt,name=reported_user_id,json=reportedUserId,proto3" json:"reported_user_id,omitempty"`
+	ReportedUserName       string                 `protobuf:"bytes,4,opt,name=reported_user_name,json=reportedUserName,proto3" json:"reported_user_name,omitempty"`
+	Reason                 string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details                string                 `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	EvidenceUrls           []string               `protobuf:"bytes,7,rep,name=evidence_urls,json=evidenceUrls,proto3" json:"evidence_urls,omitempty"`
+	Status                 ReportStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=datifyy.admin.v1.ReportStatus" json:"status,omitempty"`
+	Severity               ReportSeverity         `protobuf:"varint,9,opt,name=severity,proto3,enum=datifyy.admin.v1.ReportSeverity" json:"severity,omitempty"`
+	ClaimedBy              string                 `protobuf:"bytes,10,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"` // Admin ID
+	ClaimedAt              *v1.Timestamp          `protobuf:"bytes,11,opt,name=claimed_at,json=claimedAt,proto3" json:"claimed_at,omitempty"`
+	ReviewedBy             string                 `protobuf:"bytes,12,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"` // Admin ID
+	ReviewedAt             *v1.Timestamp          `protobuf:"bytes,13,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ResolutionNotes        string                 `protobuf:"bytes,14,opt,name=resolution_notes,json=resolutionNotes,proto3" json:"resolution_notes,omitempty"`
+	Escalated              bool                   `protobuf:"varint,15,opt,name=escalated,proto3" json:"escalated,omitempty"` // Reported user crossed the report threshold
+	CreatedAt              *v1.Timestamp          `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OpenReportsAgainstUser int32                  `protobuf:"varint,17,opt,name=open_reports_against_user,json=openReportsAgainstUser,proto3" json:"open_reports_against_user,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UserReport) Reset() {
+	*x = UserReport{}
+	mi := &file_admin_v1_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReport) ProtoMessage() {}
+
+func (x *UserReport) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReport.ProtoReflect.Descriptor instead.
+func (*UserReport) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UserReport) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *UserReport) GetReporterUserId() string {
+	if x != nil {
+		return x.ReporterUserId
+	}
+	return ""
+}
+
+func (x *UserReport) GetReportedUserId() string {
+	if x != nil {
+		return x.ReportedUserId
+	}
+	return ""
+}
+
+func (x *UserReport) GetReportedUserName() string {
+	if x != nil {
+		return x.ReportedUserName
+	}
+	return ""
+}
+
+func (x *UserReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserReport) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *UserReport) GetEvidenceUrls() []string {
+	if x != nil {
+		return x.EvidenceUrls
+	}
+	return nil
+}
+
+func (x *UserReport) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *UserReport) GetSeverity() ReportSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ReportSeverity_REPORT_SEVERITY_UNSPECIFIED
+}
+
+func (x *UserReport) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *UserReport) GetClaimedAt() *v1.Timestamp {
+	if x != nil {
+		return x.ClaimedAt
+	}
+	return nil
+}
+
+func (x *UserReport) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *UserReport) GetReviewedAt() *v1.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *UserReport) GetResolutionNotes() string {
+	if x != nil {
+		return x.ResolutionNotes
+	}
+	return ""
+}
+
+func (x *UserReport) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
+func (x *UserReport) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserReport) GetOpenReportsAgainstUser() int32 {
+	if x != nil {
+		return x.OpenReportsAgainstUser
+	}
+	return 0
+}
+
+type ModerationAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionId      string                 `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportId      string                 `protobuf:"bytes,3,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Action        EnforcementAction      `protobuf:"varint,5,opt,name=action,proto3,enum=datifyy.admin.v1.EnforcementAction" json:"action,omitempty"`
+	DurationDays  int32                  `protobuf:"varint,6,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	ExpiresAt     *v1.Timestamp          `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Notes         string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *v1.Timestamp          `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LiftedAt      *v1.Timestamp          `protobuf:"bytes,10,opt,name=lifted_at,json=liftedAt,proto3" json:"lifted_at,omitempty"` // Set once a suspension or ban stops applying
+	LiftedReason  string                 `protobuf:"bytes,11,opt,name=lifted_reason,json=liftedReason,proto3" json:"lifted_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_admin_v1_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ModerationAction) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetAction() EnforcementAction {
+	if x != nil {
+		return x.Action
+	}
+	return EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED
+}
+
+func (x *ModerationAction) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetExpiresAt() *v1.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetLiftedAt() *v1.Timestamp {
+	if x != nil {
+		return x.LiftedAt
+	}
+	return nil
+}
+
+func (x *ModerationAction) GetLiftedReason() string {
+	if x != nil {
+		return x.LiftedReason
+	}
+	return ""
+}
+
+type ListUserReportsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         ReportStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=datifyy.admin.v1.ReportStatus" json:"status,omitempty"`
+	Severity       ReportSeverity         `protobuf:"varint,2,opt,name=severity,proto3,enum=datifyy.admin.v1.ReportSeverity" json:"severity,omitempty"`
+	ReportedUserId string                 `protobuf:"bytes,3,opt,name=reported_user_id,json=reportedUserId,proto3" json:"reported_user_id,omitempty"`
+	ClaimedBy      string                 `protobuf:"bytes,4,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`
+	Page           int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListUserReportsRequest) Reset() {
+	*x = ListUserReportsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReportsRequest) ProtoMessage() {}
+
+func (x *ListUserReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserReportsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListUserReportsRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListUserReportsRequest) GetSeverity() ReportSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ReportSeverity_REPORT_SEVERITY_UNSPECIFIED
+}
+
+func (x *ListUserReportsRequest) GetReportedUserId() string {
+	if x != nil {
+		return x.ReportedUserId
+	}
+	return ""
+}
+
+func (x *ListUserReportsRequest) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *ListUserReportsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListUserReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*UserReport          `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserReportsResponse) Reset() {
+	*x = ListUserReportsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReportsResponse) ProtoMessage() {}
+
+func (x *ListUserReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserReportsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListUserReportsResponse) GetReports() []*UserReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ListUserReportsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListUserReportsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserReportsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// The acting admin is taken from the admin access token
+type ClaimUserReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimUserReportRequest) Reset() {
+	*x = ClaimUserReportRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimUserReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimUserReportRequest) ProtoMessage() {}
+
+func (x *ClaimUserReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimUserReportRequest.ProtoReflect.Descriptor instead.
+func (*ClaimUserReportRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ClaimUserReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+type ClaimUserReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *UserReport            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimUserReportResponse) Reset() {
+	*x = ClaimUserReportResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimUserReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimUserReportResponse) ProtoMessage() {}
+
+func (x *ClaimUserReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimUserReportResponse.ProtoReflect.Descriptor instead.
+func (*ClaimUserReportResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ClaimUserReportResponse) GetReport() *UserReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// The acting admin is taken from the admin access token
+type ResolveUserReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ReportId       string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Notes          string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Action         EnforcementAction      `protobuf:"varint,4,opt,name=action,proto3,enum=datifyy.admin.v1.EnforcementAction" json:"action,omitempty"`
+	SuspensionDays int32                  `protobuf:"varint,5,opt,name=suspension_days,json=suspensionDays,proto3" json:"suspension_days,omitempty"` // Required for ENFORCEMENT_ACTION_SUSPEND
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolveUserReportRequest) Reset() {
+	*x = ResolveUserReportRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUserReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUserReportRequest) ProtoMessage() {}
+
+func (x *ResolveUserReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUserReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveUserReportRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ResolveUserReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ResolveUserReportRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ResolveUserReportRequest) GetAction() EnforcementAction {
+	if x != nil {
+		return x.Action
+	}
+	return EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED
+}
+
+func (x *ResolveUserReportRequest) GetSuspensionDays() int32 {
+	if x != nil {
+		return x.SuspensionDays
+	}
+	return 0
+}
+
+type ResolveUserReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *UserReport            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Enforcement   *ModerationAction      `protobuf:"bytes,2,opt,name=enforcement,proto3" json:"enforcement,omitempty"` // Set when an action was taken
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveUserReportResponse) Reset() {
+	*x = ResolveUserReportResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveUserReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveUserReportResponse) ProtoMessage() {}
+
+func (x *ResolveUserReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveUserReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveUserReportResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ResolveUserReportResponse) GetReport() *UserReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ResolveUserReportResponse) GetEnforcement() *ModerationAction {
+	if x != nil {
+		return x.Enforcement
+	}
+	return nil
+}
+
+// The acting admin is taken from the admin access token
+type DismissUserReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissUserReportRequest) Reset() {
+	*x = DismissUserReportRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissUserReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissUserReportRequest) ProtoMessage() {}
+
+func (x *DismissUserReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissUserReportRequest.ProtoReflect.Descriptor instead.
+func (*DismissUserReportRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DismissUserReportRequest) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *DismissUserReportRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type DismissUserReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *UserReport            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissUserReportResponse) Reset() {
+	*x = DismissUserReportResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissUserReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissUserReportResponse) ProtoMessage() {}
+
+func (x *DismissUserReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissUserReportResponse.ProtoReflect.Descriptor instead.
+func (*DismissUserReportResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DismissUserReportResponse) GetReport() *UserReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type EnforcementAppeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      string                 `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail     string                 `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Enforcement   *ModerationAction      `protobuf:"bytes,5,opt,name=enforcement,proto3" json:"enforcement,omitempty"` // The suspension or ban being appealed
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Status        AppealStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=datifyy.admin.v1.AppealStatus" json:"status,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *v1.Timestamp          `protobuf:"bytes,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewNotes   string                 `protobuf:"bytes,10,opt,name=review_notes,json=reviewNotes,proto3" json:"review_notes,omitempty"`
+	CreatedAt     *v1.Timestamp          `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnforcementAppeal) Reset() {
+	*x = EnforcementAppeal{}
+	mi := &file_admin_v1_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnforcementAppeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnforcementAppeal) ProtoMessage() {}
+
+func (x *EnforcementAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnforcementAppeal.ProtoReflect.Descriptor instead.
+func (*EnforcementAppeal) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EnforcementAppeal) GetAppealId() string {
+	if x != nil {
+		return x.AppealId
+	}
+	return ""
+}
+
+func (x *EnforcementAppeal) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnforcementAppeal) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *EnforcementAppeal) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *EnforcementAppeal) GetEnforcement() *ModerationAction {
+	if x != nil {
+		return x.Enforcement
+	}
+	return nil
+}
+
+func (x *EnforcementAppeal) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *CurationCandidate) GetAge() int32 {
+func (x *EnforcementAppeal) GetStatus() AppealStatus {
 	if x != nil {
-		return x.Age
+		return x.Status
 	}
-	return 0
+	return AppealStatus_APPEAL_STATUS_UNSPECIFIED
 }
 
-func (x *CurationCandidate) GetGender() string {
+func (x *EnforcementAppeal) GetReviewedBy() string {
 	if x != nil {
-		return x.Gender
+		return x.ReviewedBy
 	}
 	return ""
 }
 
-func (x *CurationCandidate) GetProfileCompletion() int32 {
+func (x *EnforcementAppeal) GetReviewedAt() *v1.Timestamp {
 	if x != nil {
-		return x.ProfileCompletion
+		return x.ReviewedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CurationCandidate) GetEmailVerified() bool {
+func (x *EnforcementAppeal) GetReviewNotes() string {
 	if x != nil {
-		return x.EmailVerified
+		return x.ReviewNotes
 	}
-	return false
+	return ""
 }
 
-func (x *CurationCandidate) GetAadharVerified() bool {
+func (x *EnforcementAppeal) GetCreatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.AadharVerified
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *CurationCandidate) GetWorkEmailVerified() bool {
-	if x != nil {
-		return x.WorkEmailVerified
-	}
-	return false
+type GetUserEnforcementHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurationCandidate) GetAvailableSlotsCount() int32 {
+func (x *GetUserEnforcementHistoryRequest) Reset() {
+	*x = GetUserEnforcementHistoryRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEnforcementHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEnforcementHistoryRequest) ProtoMessage() {}
+
+func (x *GetUserEnforcementHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[68]
 	if x != nil {
-		return x.AvailableSlotsCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CurationCandidate) GetNextAvailableDate() *v1.Timestamp {
+// Deprecated: Use GetUserEnforcementHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetUserEnforcementHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUserEnforcementHistoryRequest) GetUserId() string {
 	if x != nil {
-		return x.NextAvailableDate
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetCurationCandidatesResponse struct {
+type GetUserEnforcementHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Candidates    []*CurationCandidate   `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Actions       []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"` // Newest first
+	Active        *ModerationAction      `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`   // Suspension or ban currently in force
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurationCandidatesResponse) Reset() {
-	*x = GetCurationCandidatesResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[22]
+func (x *GetUserEnforcementHistoryResponse) Reset() {
+	*x = GetUserEnforcementHistoryResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurationCandidatesResponse) String() string {
+func (x *GetUserEnforcementHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurationCandidatesResponse) ProtoMessage() {}
+func (*GetUserEnforcementHistoryResponse) ProtoMessage() {}
 
-func (x *GetCurationCandidatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[22]
+func (x *GetUserEnforcementHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,42 +5798,49 @@ func (x *GetCurationCandidatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurationCandidatesResponse.ProtoReflect.Descriptor instead.
-func (*GetCurationCandidatesResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetUserEnforcementHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetUserEnforcementHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetCurationCandidatesResponse) GetCandidates() []*CurationCandidate {
+func (x *GetUserEnforcementHistoryResponse) GetActions() []*ModerationAction {
 	if x != nil {
-		return x.Candidates
+		return x.Actions
 	}
 	return nil
 }
 
-// Curate Dates (AI-powered compatibility matching)
-type CurateDatesRequest struct {
+func (x *GetUserEnforcementHistoryResponse) GetActive() *ModerationAction {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+type ListEnforcementAppealsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // User to find matches for
-	CandidateIds  []string               `protobuf:"bytes,2,rep,name=candidate_ids,json=candidateIds,proto3" json:"candidate_ids,omitempty"` // Candidate user IDs to analyze
+	Status        AppealStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=datifyy.admin.v1.AppealStatus" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurateDatesRequest) Reset() {
-	*x = CurateDatesRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[23]
+func (x *ListEnforcementAppealsRequest) Reset() {
+	*x = ListEnforcementAppealsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurateDatesRequest) String() string {
+func (x *ListEnforcementAppealsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurateDatesRequest) ProtoMessage() {}
+func (*ListEnforcementAppealsRequest) ProtoMessage() {}
 
-func (x *CurateDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[23]
+func (x *ListEnforcementAppealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,55 +5851,57 @@ func (x *CurateDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurateDatesRequest.ProtoReflect.Descriptor instead.
-func (*CurateDatesRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListEnforcementAppealsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnforcementAppealsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CurateDatesRequest) GetUserId() string {
+func (x *ListEnforcementAppealsRequest) GetStatus() AppealStatus {
 	if x != nil {
-		return x.UserId
+		return x.Status
 	}
-	return ""
+	return AppealStatus_APPEAL_STATUS_UNSPECIFIED
 }
 
-func (x *CurateDatesRequest) GetCandidateIds() []string {
+func (x *ListEnforcementAppealsRequest) GetPage() int32 {
 	if x != nil {
-		return x.CandidateIds
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type MatchResult struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Age                int32                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
-	Gender             string                 `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
-	CompatibilityScore float64                `protobuf:"fixed64,5,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"` // 0-100
-	IsMatch            bool                   `protobuf:"varint,6,opt,name=is_match,json=isMatch,proto3" json:"is_match,omitempty"`                                   // true if score >= 60
-	Reasoning          string                 `protobuf:"bytes,7,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
-	MatchedAspects     []string               `protobuf:"bytes,8,rep,name=matched_aspects,json=matchedAspects,proto3" json:"matched_aspects,omitempty"`
-	MismatchedAspects  []string               `protobuf:"bytes,9,rep,name=mismatched_aspects,json=mismatchedAspects,proto3" json:"mismatched_aspects,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ListEnforcementAppealsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *MatchResult) Reset() {
-	*x = MatchResult{}
-	mi := &file_admin_v1_admin_proto_msgTypes[24]
+type ListEnforcementAppealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*EnforcementAppeal   `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEnforcementAppealsResponse) Reset() {
+	*x = ListEnforcementAppealsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchResult) String() string {
+func (x *ListEnforcementAppealsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchResult) ProtoMessage() {}
+func (*ListEnforcementAppealsResponse) ProtoMessage() {}
 
-func (x *MatchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[24]
+func (x *ListEnforcementAppealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2292,96 +5912,122 @@ func (x *MatchResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchResult.ProtoReflect.Descriptor instead.
-func (*MatchResult) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListEnforcementAppealsResponse.ProtoReflect.Descriptor instead.
+func (*ListEnforcementAppealsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *MatchResult) GetUserId() string {
+func (x *ListEnforcementAppealsResponse) GetAppeals() []*EnforcementAppeal {
 	if x != nil {
-		return x.UserId
+		return x.Appeals
 	}
-	return ""
+	return nil
 }
 
-func (x *MatchResult) GetName() string {
+func (x *ListEnforcementAppealsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Name
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchResult) GetAge() int32 {
+func (x *ListEnforcementAppealsResponse) GetPage() int32 {
 	if x != nil {
-		return x.Age
+		return x.Page
 	}
 	return 0
 }
 
-func (x *MatchResult) GetGender() string {
+func (x *ListEnforcementAppealsResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.Gender
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *MatchResult) GetCompatibilityScore() float64 {
-	if x != nil {
-		return x.CompatibilityScore
-	}
-	return 0
+// The acting admin is taken from the admin access token
+type ReviewEnforcementAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      string                 `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"` // Approving lifts the suspension or ban
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchResult) GetIsMatch() bool {
+func (x *ReviewEnforcementAppealRequest) Reset() {
+	*x = ReviewEnforcementAppealRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewEnforcementAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewEnforcementAppealRequest) ProtoMessage() {}
+
+func (x *ReviewEnforcementAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[72]
 	if x != nil {
-		return x.IsMatch
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *MatchResult) GetReasoning() string {
+// Deprecated: Use ReviewEnforcementAppealRequest.ProtoReflect.Descriptor instead.
+func (*ReviewEnforcementAppealRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReviewEnforcementAppealRequest) GetAppealId() string {
 	if x != nil {
-		return x.Reasoning
+		return x.AppealId
 	}
 	return ""
 }
 
-func (x *MatchResult) GetMatchedAspects() []string {
+func (x *ReviewEnforcementAppealRequest) GetApprove() bool {
 	if x != nil {
-		return x.MatchedAspects
+		return x.Approve
 	}
-	return nil
+	return false
 }
 
-func (x *MatchResult) GetMismatchedAspects() []string {
+func (x *ReviewEnforcementAppealRequest) GetNotes() string {
 	if x != nil {
-		return x.MismatchedAspects
+		return x.Notes
 	}
-	return nil
+	return ""
 }
 
-type CurateDatesResponse struct {
+type ReviewEnforcementAppealResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*MatchResult         `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Appeal        *EnforcementAppeal     `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurateDatesResponse) Reset() {
-	*x = CurateDatesResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[25]
+func (x *ReviewEnforcementAppealResponse) Reset() {
+	*x = ReviewEnforcementAppealResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurateDatesResponse) String() string {
+func (x *ReviewEnforcementAppealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurateDatesResponse) ProtoMessage() {}
+func (*ReviewEnforcementAppealResponse) ProtoMessage() {}
 
-func (x *CurateDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[25]
+func (x *ReviewEnforcementAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,103 +6038,183 @@ func (x *CurateDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurateDatesResponse.ProtoReflect.Descriptor instead.
-func (*CurateDatesResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReviewEnforcementAppealResponse.ProtoReflect.Descriptor instead.
+func (*ReviewEnforcementAppealResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *CurateDatesResponse) GetMatches() []*MatchResult {
+func (x *ReviewEnforcementAppealResponse) GetAppeal() *EnforcementAppeal {
 	if x != nil {
-		return x.Matches
+		return x.Appeal
 	}
 	return nil
 }
 
-// Update Curated Match Action (Admin accept/reject/review-later)
-type UpdateCuratedMatchActionRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CuratedMatchId int32                  `protobuf:"varint,1,opt,name=curated_match_id,json=curatedMatchId,proto3" json:"curated_match_id,omitempty"`  // ID of the curated match
-	Action         CuratedMatchAction     `protobuf:"varint,2,opt,name=action,proto3,enum=datifyy.admin.v1.CuratedMatchAction" json:"action,omitempty"` // Action to take
-	Notes          string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`                                             // Optional notes
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Identity Verification
+type IdVerification struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	VerificationId  string                   `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	UserId          string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName        string                   `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserEmail       string                   `protobuf:"bytes,4,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	DocumentType    v11.IdDocumentType       `protobuf:"varint,5,opt,name=document_type,json=documentType,proto3,enum=datifyy.user.v1.IdDocumentType" json:"document_type,omitempty"`
+	IdNumberMasked  string                   `protobuf:"bytes,6,opt,name=id_number_masked,json=idNumberMasked,proto3" json:"id_number_masked,omitempty"`
+	Status          v11.IdVerificationStatus `protobuf:"varint,7,opt,name=status,proto3,enum=datifyy.user.v1.IdVerificationStatus" json:"status,omitempty"`
+	ReviewedBy      string                   `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt      *v1.Timestamp            `protobuf:"bytes,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	RejectionReason string                   `protobuf:"bytes,10,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	CreatedAt       *v1.Timestamp            `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HasBackImage    bool                     `protobuf:"varint,12,opt,name=has_back_image,json=hasBackImage,proto3" json:"has_back_image,omitempty"`
+	HasSelfieImage  bool                     `protobuf:"varint,13,opt,name=has_selfie_image,json=hasSelfieImage,proto3" json:"has_selfie_image,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IdVerification) Reset() {
+	*x = IdVerification{}
+	mi := &file_admin_v1_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdVerification) ProtoMessage() {}
+
+func (x *IdVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdVerification.ProtoReflect.Descriptor instead.
+func (*IdVerification) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *IdVerification) GetVerificationId() string {
+	if x != nil {
+		return x.VerificationId
+	}
+	return ""
+}
+
+func (x *IdVerification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IdVerification) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *IdVerification) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *IdVerification) GetDocumentType() v11.IdDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return v11.IdDocumentType(0)
 }
 
-func (x *UpdateCuratedMatchActionRequest) Reset() {
-	*x = UpdateCuratedMatchActionRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *IdVerification) GetIdNumberMasked() string {
+	if x != nil {
+		return x.IdNumberMasked
+	}
+	return ""
 }
 
-func (x *UpdateCuratedMatchActionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IdVerification) GetStatus() v11.IdVerificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v11.IdVerificationStatus(0)
 }
 
-func (*UpdateCuratedMatchActionRequest) ProtoMessage() {}
+func (x *IdVerification) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
 
-func (x *UpdateCuratedMatchActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[26]
+func (x *IdVerification) GetReviewedAt() *v1.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReviewedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateCuratedMatchActionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCuratedMatchActionRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+func (x *IdVerification) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
 }
 
-func (x *UpdateCuratedMatchActionRequest) GetCuratedMatchId() int32 {
+func (x *IdVerification) GetCreatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.CuratedMatchId
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateCuratedMatchActionRequest) GetAction() CuratedMatchAction {
+func (x *IdVerification) GetHasBackImage() bool {
 	if x != nil {
-		return x.Action
+		return x.HasBackImage
 	}
-	return CuratedMatchAction_CURATED_MATCH_ACTION_UNSPECIFIED
+	return false
 }
 
-func (x *UpdateCuratedMatchActionRequest) GetNotes() string {
+func (x *IdVerification) GetHasSelfieImage() bool {
 	if x != nil {
-		return x.Notes
+		return x.HasSelfieImage
 	}
-	return ""
+	return false
 }
 
-type UpdateCuratedMatchActionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	NewStatus     string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"` // The new status after action
+type ListIdVerificationsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        v11.IdVerificationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=datifyy.user.v1.IdVerificationStatus" json:"status,omitempty"`
+	Page          int32                    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCuratedMatchActionResponse) Reset() {
-	*x = UpdateCuratedMatchActionResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[27]
+func (x *ListIdVerificationsRequest) Reset() {
+	*x = ListIdVerificationsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCuratedMatchActionResponse) String() string {
+func (x *ListIdVerificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCuratedMatchActionResponse) ProtoMessage() {}
+func (*ListIdVerificationsRequest) ProtoMessage() {}
 
-func (x *UpdateCuratedMatchActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[27]
+func (x *ListIdVerificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2499,57 +6225,57 @@ func (x *UpdateCuratedMatchActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCuratedMatchActionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCuratedMatchActionResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListIdVerificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListIdVerificationsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UpdateCuratedMatchActionResponse) GetSuccess() bool {
+func (x *ListIdVerificationsRequest) GetStatus() v11.IdVerificationStatus {
 	if x != nil {
-		return x.Success
+		return x.Status
 	}
-	return false
+	return v11.IdVerificationStatus(0)
 }
 
-func (x *UpdateCuratedMatchActionResponse) GetMessage() string {
+func (x *ListIdVerificationsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Message
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCuratedMatchActionResponse) GetNewStatus() string {
+func (x *ListIdVerificationsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.NewStatus
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// Get Curated Matches by Status
-type GetCuratedMatchesByStatusRequest struct {
+type ListIdVerificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Status filter (accepted, rejected, pending, review_later, scheduled)
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Verifications []*IdVerification      `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCuratedMatchesByStatusRequest) Reset() {
-	*x = GetCuratedMatchesByStatusRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[28]
+func (x *ListIdVerificationsResponse) Reset() {
+	*x = ListIdVerificationsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCuratedMatchesByStatusRequest) String() string {
+func (x *ListIdVerificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCuratedMatchesByStatusRequest) ProtoMessage() {}
+func (*ListIdVerificationsResponse) ProtoMessage() {}
 
-func (x *GetCuratedMatchesByStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[28]
+func (x *ListIdVerificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,66 +6286,61 @@ func (x *GetCuratedMatchesByStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCuratedMatchesByStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetCuratedMatchesByStatusRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListIdVerificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListIdVerificationsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetCuratedMatchesByStatusRequest) GetStatus() string {
+func (x *ListIdVerificationsResponse) GetVerifications() []*IdVerification {
 	if x != nil {
-		return x.Status
+		return x.Verifications
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCuratedMatchesByStatusRequest) GetPage() int32 {
+func (x *ListIdVerificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListIdVerificationsResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetCuratedMatchesByStatusRequest) GetPageSize() int32 {
+func (x *ListIdVerificationsResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type CuratedMatchDetail struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	User1              *UserSummary           `protobuf:"bytes,2,opt,name=user1,proto3" json:"user1,omitempty"`
-	User2              *UserSummary           `protobuf:"bytes,3,opt,name=user2,proto3" json:"user2,omitempty"`
-	CompatibilityScore float64                `protobuf:"fixed64,4,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
-	IsMatch            bool                   `protobuf:"varint,5,opt,name=is_match,json=isMatch,proto3" json:"is_match,omitempty"`
-	Reasoning          string                 `protobuf:"bytes,6,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
-	MatchedAspects     []string               `protobuf:"bytes,7,rep,name=matched_aspects,json=matchedAspects,proto3" json:"matched_aspects,omitempty"`
-	MismatchedAspects  []string               `protobuf:"bytes,8,rep,name=mismatched_aspects,json=mismatchedAspects,proto3" json:"mismatched_aspects,omitempty"`
-	Status             string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedByAdmin     int32                  `protobuf:"varint,10,opt,name=created_by_admin,json=createdByAdmin,proto3" json:"created_by_admin,omitempty"`
-	ScheduledDateId    int32                  `protobuf:"varint,11,opt,name=scheduled_date_id,json=scheduledDateId,proto3" json:"scheduled_date_id,omitempty"`
-	CreatedAt          *v1.Timestamp          `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *v1.Timestamp          `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetIdVerificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CuratedMatchDetail) Reset() {
-	*x = CuratedMatchDetail{}
-	mi := &file_admin_v1_admin_proto_msgTypes[29]
+func (x *GetIdVerificationRequest) Reset() {
+	*x = GetIdVerificationRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CuratedMatchDetail) String() string {
+func (x *GetIdVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CuratedMatchDetail) ProtoMessage() {}
+func (*GetIdVerificationRequest) ProtoMessage() {}
 
-func (x *CuratedMatchDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[29]
+func (x *GetIdVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2630,128 +6351,111 @@ func (x *CuratedMatchDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CuratedMatchDetail.ProtoReflect.Descriptor instead.
-func (*CuratedMatchDetail) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetIdVerificationRequest.ProtoReflect.Descriptor instead.
+func (*GetIdVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *CuratedMatchDetail) GetId() int32 {
+func (x *GetIdVerificationRequest) GetVerificationId() string {
 	if x != nil {
-		return x.Id
+		return x.VerificationId
 	}
-	return 0
+	return ""
 }
 
-func (x *CuratedMatchDetail) GetUser1() *UserSummary {
-	if x != nil {
-		return x.User1
-	}
-	return nil
+type GetIdVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *IdVerification        `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	FrontImage    []byte                 `protobuf:"bytes,2,opt,name=front_image,json=frontImage,proto3" json:"front_image,omitempty"`
+	BackImage     []byte                 `protobuf:"bytes,3,opt,name=back_image,json=backImage,proto3" json:"back_image,omitempty"`
+	SelfieImage   []byte                 `protobuf:"bytes,4,opt,name=selfie_image,json=selfieImage,proto3" json:"selfie_image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CuratedMatchDetail) GetUser2() *UserSummary {
-	if x != nil {
-		return x.User2
-	}
-	return nil
+func (x *GetIdVerificationResponse) Reset() {
+	*x = GetIdVerificationResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CuratedMatchDetail) GetCompatibilityScore() float64 {
-	if x != nil {
-		return x.CompatibilityScore
-	}
-	return 0
+func (x *GetIdVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CuratedMatchDetail) GetIsMatch() bool {
-	if x != nil {
-		return x.IsMatch
-	}
-	return false
-}
+func (*GetIdVerificationResponse) ProtoMessage() {}
 
-func (x *CuratedMatchDetail) GetReasoning() string {
+func (x *GetIdVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[78]
 	if x != nil {
-		return x.Reasoning
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CuratedMatchDetail) GetMatchedAspects() []string {
-	if x != nil {
-		return x.MatchedAspects
-	}
-	return nil
+// Deprecated: Use GetIdVerificationResponse.ProtoReflect.Descriptor instead.
+func (*GetIdVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *CuratedMatchDetail) GetMismatchedAspects() []string {
+func (x *GetIdVerificationResponse) GetVerification() *IdVerification {
 	if x != nil {
-		return x.MismatchedAspects
+		return x.Verification
 	}
 	return nil
 }
 
-func (x *CuratedMatchDetail) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *CuratedMatchDetail) GetCreatedByAdmin() int32 {
-	if x != nil {
-		return x.CreatedByAdmin
-	}
-	return 0
-}
-
-func (x *CuratedMatchDetail) GetScheduledDateId() int32 {
+func (x *GetIdVerificationResponse) GetFrontImage() []byte {
 	if x != nil {
-		return x.ScheduledDateId
+		return x.FrontImage
 	}
-	return 0
+	return nil
 }
 
-func (x *CuratedMatchDetail) GetCreatedAt() *v1.Timestamp {
+func (x *GetIdVerificationResponse) GetBackImage() []byte {
 	if x != nil {
-		return x.CreatedAt
+		return x.BackImage
 	}
 	return nil
 }
 
-func (x *CuratedMatchDetail) GetUpdatedAt() *v1.Timestamp {
+func (x *GetIdVerificationResponse) GetSelfieImage() []byte {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SelfieImage
 	}
 	return nil
 }
 
-type GetCuratedMatchesByStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*CuratedMatchDetail  `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// The reviewing admin is taken from the admin access token
+type ReviewIdVerificationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VerificationId  string                 `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	Approve         bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`                                       // Approving grants the verified badge
+	RejectionReason string                 `protobuf:"bytes,4,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"` // Required when rejecting; shown to the user
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetCuratedMatchesByStatusResponse) Reset() {
-	*x = GetCuratedMatchesByStatusResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[30]
+func (x *ReviewIdVerificationRequest) Reset() {
+	*x = ReviewIdVerificationRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCuratedMatchesByStatusResponse) String() string {
+func (x *ReviewIdVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCuratedMatchesByStatusResponse) ProtoMessage() {}
+func (*ReviewIdVerificationRequest) ProtoMessage() {}
 
-func (x *GetCuratedMatchesByStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[30]
+func (x *ReviewIdVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,72 +6466,111 @@ func (x *GetCuratedMatchesByStatusResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCuratedMatchesByStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetCuratedMatchesByStatusResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ReviewIdVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewIdVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCuratedMatchesByStatusResponse) GetMatches() []*CuratedMatchDetail {
+func (x *ReviewIdVerificationRequest) GetVerificationId() string {
 	if x != nil {
-		return x.Matches
+		return x.VerificationId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCuratedMatchesByStatusResponse) GetTotalCount() int32 {
+func (x *ReviewIdVerificationRequest) GetApprove() bool {
 	if x != nil {
-		return x.TotalCount
+		return x.Approve
 	}
-	return 0
+	return false
 }
 
-func (x *GetCuratedMatchesByStatusResponse) GetPage() int32 {
+func (x *ReviewIdVerificationRequest) GetRejectionReason() string {
 	if x != nil {
-		return x.Page
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type ReviewIdVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Verification  *IdVerification        `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewIdVerificationResponse) Reset() {
+	*x = ReviewIdVerificationResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewIdVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewIdVerificationResponse) ProtoMessage() {}
+
+func (x *ReviewIdVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetCuratedMatchesByStatusResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use ReviewIdVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewIdVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetCuratedMatchesByStatusResponse) GetTotalPages() int32 {
+func (x *ReviewIdVerificationResponse) GetVerification() *IdVerification {
 	if x != nil {
-		return x.TotalPages
+		return x.Verification
 	}
-	return 0
+	return nil
 }
 
-// Get Genie Dates (Dates assigned to admin)
-type GetGenieDatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GenieId       string                 `protobuf:"bytes,1,opt,name=genie_id,json=genieId,proto3" json:"genie_id,omitempty"`
-	StatusFilter  DateStatus             `protobuf:"varint,2,opt,name=status_filter,json=statusFilter,proto3,enum=datifyy.admin.v1.DateStatus" json:"status_filter,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Profile Prompts Catalog
+type Prompt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PromptId        string                 `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"` // Referenced by answers; can't be changed
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Text            map[string]string      `protobuf:"bytes,3,rep,name=text,proto3" json:"text,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Question text by locale; "en" is required
+	MinAnswerLength int32                  `protobuf:"varint,4,opt,name=min_answer_length,json=minAnswerLength,proto3" json:"min_answer_length,omitempty"`
+	MaxAnswerLength int32                  `protobuf:"varint,5,opt,name=max_answer_length,json=maxAnswerLength,proto3" json:"max_answer_length,omitempty"`
+	DisplayOrder    int32                  `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	ActiveFrom      *v1.Timestamp          `protobuf:"bytes,7,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`                                                  // Unset means active straight away
+	ActiveUntil     *v1.Timestamp          `protobuf:"bytes,8,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`                                               // Unset means no end
+	LegacyQuestion  v11.PromptQuestion     `protobuf:"varint,9,opt,name=legacy_question,json=legacyQuestion,proto3,enum=datifyy.user.v1.PromptQuestion" json:"legacy_question,omitempty"` // Set on prompts that predate the catalog
+	Active          bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`                                                                          // Currently inside its active window
+	UpdatedBy       string                 `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt       *v1.Timestamp          `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *v1.Timestamp          `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetGenieDatesRequest) Reset() {
-	*x = GetGenieDatesRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[31]
+func (x *Prompt) Reset() {
+	*x = Prompt{}
+	mi := &file_admin_v1_admin_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGenieDatesRequest) String() string {
+func (x *Prompt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGenieDatesRequest) ProtoMessage() {}
+func (*Prompt) ProtoMessage() {}
 
-func (x *GetGenieDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[31]
+func (x *Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2838,132 +6581,125 @@ func (x *GetGenieDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGenieDatesRequest.ProtoReflect.Descriptor instead.
-func (*GetGenieDatesRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Prompt.ProtoReflect.Descriptor instead.
+func (*Prompt) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetGenieDatesRequest) GetGenieId() string {
+func (x *Prompt) GetPromptId() string {
 	if x != nil {
-		return x.GenieId
+		return x.PromptId
 	}
 	return ""
 }
 
-func (x *GetGenieDatesRequest) GetStatusFilter() DateStatus {
+func (x *Prompt) GetCategory() string {
 	if x != nil {
-		return x.StatusFilter
+		return x.Category
 	}
-	return DateStatus_DATE_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *GetGenieDatesRequest) GetPage() int32 {
+func (x *Prompt) GetText() map[string]string {
 	if x != nil {
-		return x.Page
+		return x.Text
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGenieDatesRequest) GetPageSize() int32 {
+func (x *Prompt) GetMinAnswerLength() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.MinAnswerLength
 	}
 	return 0
 }
 
-type GetGenieDatesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dates         []*ScheduledDate       `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Prompt) GetMaxAnswerLength() int32 {
+	if x != nil {
+		return x.MaxAnswerLength
+	}
+	return 0
 }
 
-func (x *GetGenieDatesResponse) Reset() {
-	*x = GetGenieDatesResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Prompt) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
 }
 
-func (x *GetGenieDatesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Prompt) GetActiveFrom() *v1.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
 }
 
-func (*GetGenieDatesResponse) ProtoMessage() {}
-
-func (x *GetGenieDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[32]
+func (x *Prompt) GetActiveUntil() *v1.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ActiveUntil
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetGenieDatesResponse.ProtoReflect.Descriptor instead.
-func (*GetGenieDatesResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+func (x *Prompt) GetLegacyQuestion() v11.PromptQuestion {
+	if x != nil {
+		return x.LegacyQuestion
+	}
+	return v11.PromptQuestion(0)
 }
 
-func (x *GetGenieDatesResponse) GetDates() []*ScheduledDate {
+func (x *Prompt) GetActive() bool {
 	if x != nil {
-		return x.Dates
+		return x.Active
 	}
-	return nil
+	return false
 }
 
-func (x *GetGenieDatesResponse) GetTotalCount() int32 {
+func (x *Prompt) GetUpdatedBy() string {
 	if x != nil {
-		return x.TotalCount
+		return x.UpdatedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGenieDatesResponse) GetPage() int32 {
+func (x *Prompt) GetCreatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetGenieDatesResponse) GetPageSize() int32 {
+func (x *Prompt) GetUpdatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-// Update Date Status
-type UpdateDateStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DateId        string                 `protobuf:"bytes,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
-	Status        DateStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=datifyy.admin.v1.DateStatus" json:"status,omitempty"`
-	Notes         string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListPromptsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Category        string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`                                       // Optional
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Include retired and scheduled prompts
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateDateStatusRequest) Reset() {
-	*x = UpdateDateStatusRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[33]
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDateStatusRequest) String() string {
+func (x *ListPromptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDateStatusRequest) ProtoMessage() {}
+func (*ListPromptsRequest) ProtoMessage() {}
 
-func (x *UpdateDateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[33]
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,54 +6710,47 @@ func (x *UpdateDateStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDateStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *UpdateDateStatusRequest) GetDateId() string {
+func (x *ListPromptsRequest) GetCategory() string {
 	if x != nil {
-		return x.DateId
+		return x.Category
 	}
 	return ""
 }
 
-func (x *UpdateDateStatusRequest) GetStatus() DateStatus {
-	if x != nil {
-		return x.Status
-	}
-	return DateStatus_DATE_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateDateStatusRequest) GetNotes() string {
+func (x *ListPromptsRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.Notes
+		return x.IncludeInactive
 	}
-	return ""
+	return false
 }
 
-type UpdateDateStatusResponse struct {
+type ListPromptsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          *ScheduledDate         `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Prompts       []*Prompt              `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDateStatusResponse) Reset() {
-	*x = UpdateDateStatusResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[34]
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDateStatusResponse) String() string {
+func (x *ListPromptsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDateStatusResponse) ProtoMessage() {}
+func (*ListPromptsResponse) ProtoMessage() {}
 
-func (x *UpdateDateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[34]
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3032,45 +6761,41 @@ func (x *UpdateDateStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDateStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *UpdateDateStatusResponse) GetDate() *ScheduledDate {
+func (x *ListPromptsResponse) GetPrompts() []*Prompt {
 	if x != nil {
-		return x.Date
+		return x.Prompts
 	}
 	return nil
 }
 
-// Create Admin User
-type CreateAdminUserRequest struct {
+// The acting admin is taken from the admin access token
+type CreatePromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Role          AdminRole              `protobuf:"varint,4,opt,name=role,proto3,enum=datifyy.admin.v1.AdminRole" json:"role,omitempty"`
-	IsGenie       bool                   `protobuf:"varint,5,opt,name=is_genie,json=isGenie,proto3" json:"is_genie,omitempty"`
+	Prompt        *Prompt                `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdminUserRequest) Reset() {
-	*x = CreateAdminUserRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[35]
+func (x *CreatePromptRequest) Reset() {
+	*x = CreatePromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdminUserRequest) String() string {
+func (x *CreatePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdminUserRequest) ProtoMessage() {}
+func (*CreatePromptRequest) ProtoMessage() {}
 
-func (x *CreateAdminUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[35]
+func (x *CreatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3081,68 +6806,85 @@ func (x *CreateAdminUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdminUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateAdminUserRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreatePromptRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CreateAdminUserRequest) GetEmail() string {
+func (x *CreatePromptRequest) GetPrompt() *Prompt {
 	if x != nil {
-		return x.Email
+		return x.Prompt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateAdminUserRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+type CreatePromptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdminUserRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *CreatePromptResponse) Reset() {
+	*x = CreatePromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdminUserRequest) GetRole() AdminRole {
+func (x *CreatePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromptResponse) ProtoMessage() {}
+
+func (x *CreatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[85]
 	if x != nil {
-		return x.Role
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *CreateAdminUserRequest) GetIsGenie() bool {
+// Deprecated: Use CreatePromptResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreatePromptResponse) GetPrompt() *Prompt {
 	if x != nil {
-		return x.IsGenie
+		return x.Prompt
 	}
-	return false
+	return nil
 }
 
-type CreateAdminUserResponse struct {
+// The acting admin is taken from the admin access token
+type UpdatePromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Prompt        *Prompt                `protobuf:"bytes,2,opt,name=prompt,proto3" json:"prompt,omitempty"` // Identified by prompt_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAdminUserResponse) Reset() {
-	*x = CreateAdminUserResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[36]
+func (x *UpdatePromptRequest) Reset() {
+	*x = UpdatePromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAdminUserResponse) String() string {
+func (x *UpdatePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAdminUserResponse) ProtoMessage() {}
+func (*UpdatePromptRequest) ProtoMessage() {}
 
-func (x *CreateAdminUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[36]
+func (x *UpdatePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3153,42 +6895,40 @@ func (x *CreateAdminUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAdminUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateAdminUserResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UpdatePromptRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *CreateAdminUserResponse) GetAdmin() *AdminUser {
+func (x *UpdatePromptRequest) GetPrompt() *Prompt {
 	if x != nil {
-		return x.Admin
+		return x.Prompt
 	}
 	return nil
 }
 
-// Get All Admins
-type GetAllAdminsRequest struct {
+type UpdatePromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllAdminsRequest) Reset() {
-	*x = GetAllAdminsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[37]
+func (x *UpdatePromptResponse) Reset() {
+	*x = UpdatePromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllAdminsRequest) String() string {
+func (x *UpdatePromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllAdminsRequest) ProtoMessage() {}
+func (*UpdatePromptResponse) ProtoMessage() {}
 
-func (x *GetAllAdminsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[37]
+func (x *UpdatePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3199,48 +6939,42 @@ func (x *GetAllAdminsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllAdminsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllAdminsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetAllAdminsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use UpdatePromptResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetAllAdminsRequest) GetPageSize() int32 {
+func (x *UpdatePromptResponse) GetPrompt() *Prompt {
 	if x != nil {
-		return x.PageSize
+		return x.Prompt
 	}
-	return 0
+	return nil
 }
 
-type GetAllAdminsResponse struct {
+// Retires a prompt; it stays in the catalog so existing answers keep their text
+// The acting admin is taken from the admin access token
+type DeletePromptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admins        []*AdminUser           `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PromptId      string                 `protobuf:"bytes,2,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllAdminsResponse) Reset() {
-	*x = GetAllAdminsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[38]
+func (x *DeletePromptRequest) Reset() {
+	*x = DeletePromptRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllAdminsResponse) String() string {
+func (x *DeletePromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllAdminsResponse) ProtoMessage() {}
+func (*DeletePromptRequest) ProtoMessage() {}
 
-func (x *GetAllAdminsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[38]
+func (x *DeletePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,51 +6985,40 @@ func (x *GetAllAdminsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllAdminsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllAdminsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *GetAllAdminsResponse) GetAdmins() []*AdminUser {
-	if x != nil {
-		return x.Admins
-	}
-	return nil
+// Deprecated: Use DeletePromptRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromptRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetAllAdminsResponse) GetTotalCount() int32 {
+func (x *DeletePromptRequest) GetPromptId() string {
 	if x != nil {
-		return x.TotalCount
+		return x.PromptId
 	}
-	return 0
+	return ""
 }
 
-// Update Admin
-type UpdateAdminRequest struct {
+type DeletePromptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Role          AdminRole              `protobuf:"varint,4,opt,name=role,proto3,enum=datifyy.admin.v1.AdminRole" json:"role,omitempty"`
+	Prompt        *Prompt                `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminRequest) Reset() {
-	*x = UpdateAdminRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[39]
+func (x *DeletePromptResponse) Reset() {
+	*x = DeletePromptResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminRequest) String() string {
+func (x *DeletePromptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdminRequest) ProtoMessage() {}
+func (*DeletePromptResponse) ProtoMessage() {}
 
-func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[39]
+func (x *DeletePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3306,61 +7029,42 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *UpdateAdminRequest) GetAdminId() string {
-	if x != nil {
-		return x.AdminId
-	}
-	return ""
-}
-
-func (x *UpdateAdminRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateAdminRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use DeletePromptResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromptResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateAdminRequest) GetRole() AdminRole {
+func (x *DeletePromptResponse) GetPrompt() *Prompt {
 	if x != nil {
-		return x.Role
+		return x.Prompt
 	}
-	return AdminRole_ADMIN_ROLE_UNSPECIFIED
+	return nil
 }
 
-type UpdateAdminResponse struct {
+// Analytics Messages
+type TimeRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	StartTime     *v1.Timestamp          `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *v1.Timestamp          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminResponse) Reset() {
-	*x = UpdateAdminResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[40]
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	mi := &file_admin_v1_admin_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminResponse) String() string {
+func (x *TimeRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdminResponse) ProtoMessage() {}
+func (*TimeRange) ProtoMessage() {}
 
-func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[40]
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,41 +7075,49 @@ func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdminResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UpdateAdminResponse) GetAdmin() *AdminUser {
+func (x *TimeRange) GetStartTime() *v1.Timestamp {
 	if x != nil {
-		return x.Admin
+		return x.StartTime
 	}
 	return nil
 }
 
-// Delete Admin
-type DeleteAdminRequest struct {
+func (x *TimeRange) GetEndTime() *v1.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DataPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // e.g., "Jan", "Mon", "2024-01-01"
+	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp     *v1.Timestamp          `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdminRequest) Reset() {
-	*x = DeleteAdminRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[41]
+func (x *DataPoint) Reset() {
+	*x = DataPoint{}
+	mi := &file_admin_v1_admin_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdminRequest) String() string {
+func (x *DataPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdminRequest) ProtoMessage() {}
+func (*DataPoint) ProtoMessage() {}
 
-func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[41]
+func (x *DataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3416,40 +7128,55 @@ func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdminRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DataPoint.ProtoReflect.Descriptor instead.
+func (*DataPoint) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *DeleteAdminRequest) GetAdminId() string {
+func (x *DataPoint) GetLabel() string {
 	if x != nil {
-		return x.AdminId
+		return x.Label
 	}
 	return ""
 }
 
-type DeleteAdminResponse struct {
+func (x *DataPoint) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DataPoint) GetTimestamp() *v1.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type UserGrowthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
+	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAdminResponse) Reset() {
-	*x = DeleteAdminResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[42]
+func (x *UserGrowthRequest) Reset() {
+	*x = UserGrowthRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAdminResponse) String() string {
+func (x *UserGrowthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAdminResponse) ProtoMessage() {}
+func (*UserGrowthRequest) ProtoMessage() {}
 
-func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[42]
+func (x *UserGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3460,43 +7187,49 @@ func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAdminResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use UserGrowthRequest.ProtoReflect.Descriptor instead.
+func (*UserGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *DeleteAdminResponse) GetSuccess() bool {
+func (x *UserGrowthRequest) GetPeriod() AnalyticsPeriod {
 	if x != nil {
-		return x.Success
+		return x.Period
 	}
-	return false
+	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
 }
 
-// Update Admin Profile
-type UpdateAdminProfileRequest struct {
+func (x *UserGrowthRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+type UserGrowthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	TotalUsers    int64                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	GrowthRate    float64                `protobuf:"fixed64,3,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminProfileRequest) Reset() {
-	*x = UpdateAdminProfileRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[43]
+func (x *UserGrowthResponse) Reset() {
+	*x = UserGrowthResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminProfileRequest) String() string {
+func (x *UserGrowthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdminProfileRequest) ProtoMessage() {}
+func (*UserGrowthResponse) ProtoMessage() {}
 
-func (x *UpdateAdminProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[43]
+func (x *UserGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3507,54 +7240,55 @@ func (x *UpdateAdminProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdminProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAdminProfileRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UserGrowthResponse.ProtoReflect.Descriptor instead.
+func (*UserGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *UpdateAdminProfileRequest) GetAdminId() string {
+func (x *UserGrowthResponse) GetDataPoints() []*DataPoint {
 	if x != nil {
-		return x.AdminId
+		return x.DataPoints
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateAdminProfileRequest) GetName() string {
+func (x *UserGrowthResponse) GetTotalUsers() int64 {
 	if x != nil {
-		return x.Name
+		return x.TotalUsers
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAdminProfileRequest) GetEmail() string {
+func (x *UserGrowthResponse) GetGrowthRate() float64 {
 	if x != nil {
-		return x.Email
+		return x.GrowthRate
 	}
-	return ""
+	return 0
 }
 
-type UpdateAdminProfileResponse struct {
+type ActiveUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admin         *AdminUser             `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
+	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminProfileResponse) Reset() {
-	*x = UpdateAdminProfileResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[44]
+func (x *ActiveUsersRequest) Reset() {
+	*x = ActiveUsersRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminProfileResponse) String() string {
+func (x *ActiveUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdminProfileResponse) ProtoMessage() {}
+func (*ActiveUsersRequest) ProtoMessage() {}
 
-func (x *UpdateAdminProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[44]
+func (x *ActiveUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3565,42 +7299,49 @@ func (x *UpdateAdminProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdminProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAdminProfileResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ActiveUsersRequest.ProtoReflect.Descriptor instead.
+func (*ActiveUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateAdminProfileResponse) GetAdmin() *AdminUser {
+func (x *ActiveUsersRequest) GetPeriod() AnalyticsPeriod {
 	if x != nil {
-		return x.Admin
+		return x.Period
+	}
+	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
+}
+
+func (x *ActiveUsersRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
 	}
 	return nil
 }
 
-type BulkUserActionRequest struct {
+type ActiveUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	Action        BulkUserAction         `protobuf:"varint,2,opt,name=action,proto3,enum=datifyy.admin.v1.BulkUserAction" json:"action,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	CurrentActive int64                  `protobuf:"varint,2,opt,name=current_active,json=currentActive,proto3" json:"current_active,omitempty"`
+	ActivityRate  float64                `protobuf:"fixed64,3,opt,name=activity_rate,json=activityRate,proto3" json:"activity_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *BulkUserActionRequest) Reset() {
-	*x = BulkUserActionRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[45]
+}
+
+func (x *ActiveUsersResponse) Reset() {
+	*x = ActiveUsersResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUserActionRequest) String() string {
+func (x *ActiveUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUserActionRequest) ProtoMessage() {}
+func (*ActiveUsersResponse) ProtoMessage() {}
 
-func (x *BulkUserActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[45]
+func (x *ActiveUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3611,57 +7352,55 @@ func (x *BulkUserActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUserActionRequest.ProtoReflect.Descriptor instead.
-func (*BulkUserActionRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ActiveUsersResponse.ProtoReflect.Descriptor instead.
+func (*ActiveUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *BulkUserActionRequest) GetUserIds() []string {
+func (x *ActiveUsersResponse) GetDataPoints() []*DataPoint {
 	if x != nil {
-		return x.UserIds
+		return x.DataPoints
 	}
 	return nil
 }
 
-func (x *BulkUserActionRequest) GetAction() BulkUserAction {
+func (x *ActiveUsersResponse) GetCurrentActive() int64 {
 	if x != nil {
-		return x.Action
+		return x.CurrentActive
 	}
-	return BulkUserAction_BULK_USER_ACTION_UNSPECIFIED
+	return 0
 }
 
-func (x *BulkUserActionRequest) GetReason() string {
+func (x *ActiveUsersResponse) GetActivityRate() float64 {
 	if x != nil {
-		return x.Reason
+		return x.ActivityRate
 	}
-	return ""
+	return 0
 }
 
-type BulkUserActionResponse struct {
+type SignupsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SuccessCount  int32                  `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailedCount   int32                  `protobuf:"varint,2,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
-	FailedUserIds []string               `protobuf:"bytes,3,rep,name=failed_user_ids,json=failedUserIds,proto3" json:"failed_user_ids,omitempty"`
-	ErrorMessages []string               `protobuf:"bytes,4,rep,name=error_messages,json=errorMessages,proto3" json:"error_messages,omitempty"`
+	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
+	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUserActionResponse) Reset() {
-	*x = BulkUserActionResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[46]
+func (x *SignupsRequest) Reset() {
+	*x = SignupsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUserActionResponse) String() string {
+func (x *SignupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUserActionResponse) ProtoMessage() {}
+func (*SignupsRequest) ProtoMessage() {}
 
-func (x *BulkUserActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[46]
+func (x *SignupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,63 +7411,48 @@ func (x *BulkUserActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUserActionResponse.ProtoReflect.Descriptor instead.
-func (*BulkUserActionResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *BulkUserActionResponse) GetSuccessCount() int32 {
-	if x != nil {
-		return x.SuccessCount
-	}
-	return 0
-}
-
-func (x *BulkUserActionResponse) GetFailedCount() int32 {
-	if x != nil {
-		return x.FailedCount
-	}
-	return 0
+// Deprecated: Use SignupsRequest.ProtoReflect.Descriptor instead.
+func (*SignupsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *BulkUserActionResponse) GetFailedUserIds() []string {
+func (x *SignupsRequest) GetPeriod() AnalyticsPeriod {
 	if x != nil {
-		return x.FailedUserIds
+		return x.Period
 	}
-	return nil
+	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
 }
 
-func (x *BulkUserActionResponse) GetErrorMessages() []string {
+func (x *SignupsRequest) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.ErrorMessages
+		return x.TimeRange
 	}
 	return nil
 }
 
-// Analytics Messages
-type TimeRange struct {
+type SignupsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     *v1.Timestamp          `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *v1.Timestamp          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	TotalSignups  int64                  `protobuf:"varint,2,opt,name=total_signups,json=totalSignups,proto3" json:"total_signups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeRange) Reset() {
-	*x = TimeRange{}
-	mi := &file_admin_v1_admin_proto_msgTypes[47]
+func (x *SignupsResponse) Reset() {
+	*x = SignupsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeRange) String() string {
+func (x *SignupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRange) ProtoMessage() {}
+func (*SignupsResponse) ProtoMessage() {}
 
-func (x *TimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[47]
+func (x *SignupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3739,49 +7463,47 @@ func (x *TimeRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
-func (*TimeRange) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SignupsResponse.ProtoReflect.Descriptor instead.
+func (*SignupsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *TimeRange) GetStartTime() *v1.Timestamp {
+func (x *SignupsResponse) GetDataPoints() []*DataPoint {
 	if x != nil {
-		return x.StartTime
+		return x.DataPoints
 	}
 	return nil
 }
 
-func (x *TimeRange) GetEndTime() *v1.Timestamp {
+func (x *SignupsResponse) GetTotalSignups() int64 {
 	if x != nil {
-		return x.EndTime
+		return x.TotalSignups
 	}
-	return nil
+	return 0
 }
 
-type DataPoint struct {
+type DemographicsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // e.g., "Jan", "Mon", "2024-01-01"
-	Value         int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	Timestamp     *v1.Timestamp          `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MetricType    string                 `protobuf:"bytes,1,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"` // gender, age_group, location
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataPoint) Reset() {
-	*x = DataPoint{}
-	mi := &file_admin_v1_admin_proto_msgTypes[48]
+func (x *DemographicsRequest) Reset() {
+	*x = DemographicsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataPoint) String() string {
+func (x *DemographicsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataPoint) ProtoMessage() {}
+func (*DemographicsRequest) ProtoMessage() {}
 
-func (x *DataPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[48]
+func (x *DemographicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3792,55 +7514,86 @@ func (x *DataPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataPoint.ProtoReflect.Descriptor instead.
-func (*DataPoint) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DemographicsRequest.ProtoReflect.Descriptor instead.
+func (*DemographicsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *DataPoint) GetLabel() string {
+func (x *DemographicsRequest) GetMetricType() string {
 	if x != nil {
-		return x.Label
+		return x.MetricType
 	}
 	return ""
 }
 
-func (x *DataPoint) GetValue() int64 {
+type DemographicsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DemographicData     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DemographicsResponse) Reset() {
+	*x = DemographicsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemographicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemographicsResponse) ProtoMessage() {}
+
+func (x *DemographicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[99]
 	if x != nil {
-		return x.Value
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DataPoint) GetTimestamp() *v1.Timestamp {
+// Deprecated: Use DemographicsResponse.ProtoReflect.Descriptor instead.
+func (*DemographicsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DemographicsResponse) GetData() []*DemographicData {
 	if x != nil {
-		return x.Timestamp
+		return x.Data
 	}
 	return nil
 }
 
-type UserGrowthRequest struct {
+type DemographicData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
-	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserGrowthRequest) Reset() {
-	*x = UserGrowthRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[49]
+func (x *DemographicData) Reset() {
+	*x = DemographicData{}
+	mi := &file_admin_v1_admin_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGrowthRequest) String() string {
+func (x *DemographicData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGrowthRequest) ProtoMessage() {}
+func (*DemographicData) ProtoMessage() {}
 
-func (x *UserGrowthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[49]
+func (x *DemographicData) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3851,108 +7604,106 @@ func (x *UserGrowthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserGrowthRequest.ProtoReflect.Descriptor instead.
-func (*UserGrowthRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DemographicData.ProtoReflect.Descriptor instead.
+func (*DemographicData) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UserGrowthRequest) GetPeriod() AnalyticsPeriod {
+func (x *DemographicData) GetCategory() string {
 	if x != nil {
-		return x.Period
+		return x.Category
 	}
-	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
+	return ""
 }
 
-func (x *UserGrowthRequest) GetTimeRange() *TimeRange {
+func (x *DemographicData) GetCount() int64 {
 	if x != nil {
-		return x.TimeRange
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type UserGrowthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
-	TotalUsers    int64                  `protobuf:"varint,2,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	GrowthRate    float64                `protobuf:"fixed64,3,opt,name=growth_rate,json=growthRate,proto3" json:"growth_rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DemographicData) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
 }
 
-func (x *UserGrowthResponse) Reset() {
-	*x = UserGrowthResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[50]
+type LocationStatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Level          string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`                                         // country, state, city
+	ParentLocation string                 `protobuf:"bytes,2,opt,name=parent_location,json=parentLocation,proto3" json:"parent_location,omitempty"` // Filter by parent (e.g., country for state stats)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LocationStatsRequest) Reset() {
+	*x = LocationStatsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserGrowthResponse) String() string {
+func (x *LocationStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserGrowthResponse) ProtoMessage() {}
+func (*LocationStatsRequest) ProtoMessage() {}
 
-func (x *UserGrowthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[50]
+func (x *LocationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserGrowthResponse.ProtoReflect.Descriptor instead.
-func (*UserGrowthResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{50}
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UserGrowthResponse) GetDataPoints() []*DataPoint {
-	if x != nil {
-		return x.DataPoints
-	}
-	return nil
+// Deprecated: Use LocationStatsRequest.ProtoReflect.Descriptor instead.
+func (*LocationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *UserGrowthResponse) GetTotalUsers() int64 {
+func (x *LocationStatsRequest) GetLevel() string {
 	if x != nil {
-		return x.TotalUsers
+		return x.Level
 	}
-	return 0
+	return ""
 }
 
-func (x *UserGrowthResponse) GetGrowthRate() float64 {
+func (x *LocationStatsRequest) GetParentLocation() string {
 	if x != nil {
-		return x.GrowthRate
+		return x.ParentLocation
 	}
-	return 0
+	return ""
 }
 
-type ActiveUsersRequest struct {
+type LocationStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
-	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Locations     []*LocationData        `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActiveUsersRequest) Reset() {
-	*x = ActiveUsersRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[51]
+func (x *LocationStatsResponse) Reset() {
+	*x = LocationStatsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActiveUsersRequest) String() string {
+func (x *LocationStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveUsersRequest) ProtoMessage() {}
+func (*LocationStatsResponse) ProtoMessage() {}
 
-func (x *ActiveUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[51]
+func (x *LocationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3963,49 +7714,43 @@ func (x *ActiveUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveUsersRequest.ProtoReflect.Descriptor instead.
-func (*ActiveUsersRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *ActiveUsersRequest) GetPeriod() AnalyticsPeriod {
-	if x != nil {
-		return x.Period
-	}
-	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
+// Deprecated: Use LocationStatsResponse.ProtoReflect.Descriptor instead.
+func (*LocationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ActiveUsersRequest) GetTimeRange() *TimeRange {
+func (x *LocationStatsResponse) GetLocations() []*LocationData {
 	if x != nil {
-		return x.TimeRange
+		return x.Locations
 	}
 	return nil
 }
 
-type ActiveUsersResponse struct {
+type LocationData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
-	CurrentActive int64                  `protobuf:"varint,2,opt,name=current_active,json=currentActive,proto3" json:"current_active,omitempty"`
-	ActivityRate  float64                `protobuf:"fixed64,3,opt,name=activity_rate,json=activityRate,proto3" json:"activity_rate,omitempty"`
+	LocationName  string                 `protobuf:"bytes,1,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	LocationCode  string                 `protobuf:"bytes,2,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty"` // ISO code
+	UserCount     int64                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActiveUsersResponse) Reset() {
-	*x = ActiveUsersResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[52]
+func (x *LocationData) Reset() {
+	*x = LocationData{}
+	mi := &file_admin_v1_admin_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActiveUsersResponse) String() string {
+func (x *LocationData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveUsersResponse) ProtoMessage() {}
+func (*LocationData) ProtoMessage() {}
 
-func (x *ActiveUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[52]
+func (x *LocationData) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4016,55 +7761,60 @@ func (x *ActiveUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveUsersResponse.ProtoReflect.Descriptor instead.
-func (*ActiveUsersResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use LocationData.ProtoReflect.Descriptor instead.
+func (*LocationData) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ActiveUsersResponse) GetDataPoints() []*DataPoint {
+func (x *LocationData) GetLocationName() string {
 	if x != nil {
-		return x.DataPoints
+		return x.LocationName
 	}
-	return nil
+	return ""
 }
 
-func (x *ActiveUsersResponse) GetCurrentActive() int64 {
+func (x *LocationData) GetLocationCode() string {
 	if x != nil {
-		return x.CurrentActive
+		return x.LocationCode
+	}
+	return ""
+}
+
+func (x *LocationData) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
 	}
 	return 0
 }
 
-func (x *ActiveUsersResponse) GetActivityRate() float64 {
+func (x *LocationData) GetPercentage() float64 {
 	if x != nil {
-		return x.ActivityRate
+		return x.Percentage
 	}
 	return 0
 }
 
-type SignupsRequest struct {
+type AvailabilityStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        AnalyticsPeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=datifyy.admin.v1.AnalyticsPeriod" json:"period,omitempty"`
-	TimeRange     *TimeRange             `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignupsRequest) Reset() {
-	*x = SignupsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[53]
+func (x *AvailabilityStatsRequest) Reset() {
+	*x = AvailabilityStatsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignupsRequest) String() string {
+func (x *AvailabilityStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignupsRequest) ProtoMessage() {}
+func (*AvailabilityStatsRequest) ProtoMessage() {}
 
-func (x *SignupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[53]
+func (x *AvailabilityStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4075,48 +7825,35 @@ func (x *SignupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignupsRequest.ProtoReflect.Descriptor instead.
-func (*SignupsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *SignupsRequest) GetPeriod() AnalyticsPeriod {
-	if x != nil {
-		return x.Period
-	}
-	return AnalyticsPeriod_ANALYTICS_PERIOD_UNSPECIFIED
-}
-
-func (x *SignupsRequest) GetTimeRange() *TimeRange {
-	if x != nil {
-		return x.TimeRange
-	}
-	return nil
+// Deprecated: Use AvailabilityStatsRequest.ProtoReflect.Descriptor instead.
+func (*AvailabilityStatsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{104}
 }
 
-type SignupsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
-	TotalSignups  int64                  `protobuf:"varint,2,opt,name=total_signups,json=totalSignups,proto3" json:"total_signups,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AvailabilityStatsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AvailableUsers   int64                  `protobuf:"varint,1,opt,name=available_users,json=availableUsers,proto3" json:"available_users,omitempty"`
+	UnavailableUsers int64                  `protobuf:"varint,2,opt,name=unavailable_users,json=unavailableUsers,proto3" json:"unavailable_users,omitempty"`
+	AvailabilityRate float64                `protobuf:"fixed64,3,opt,name=availability_rate,json=availabilityRate,proto3" json:"availability_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SignupsResponse) Reset() {
-	*x = SignupsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[54]
+func (x *AvailabilityStatsResponse) Reset() {
+	*x = AvailabilityStatsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignupsResponse) String() string {
+func (x *AvailabilityStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignupsResponse) ProtoMessage() {}
+func (*AvailabilityStatsResponse) ProtoMessage() {}
 
-func (x *SignupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[54]
+func (x *AvailabilityStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4127,47 +7864,62 @@ func (x *SignupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignupsResponse.ProtoReflect.Descriptor instead.
-func (*SignupsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use AvailabilityStatsResponse.ProtoReflect.Descriptor instead.
+func (*AvailabilityStatsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *SignupsResponse) GetDataPoints() []*DataPoint {
+func (x *AvailabilityStatsResponse) GetAvailableUsers() int64 {
 	if x != nil {
-		return x.DataPoints
+		return x.AvailableUsers
 	}
-	return nil
+	return 0
 }
 
-func (x *SignupsResponse) GetTotalSignups() int64 {
+func (x *AvailabilityStatsResponse) GetUnavailableUsers() int64 {
 	if x != nil {
-		return x.TotalSignups
+		return x.UnavailableUsers
 	}
 	return 0
 }
 
-type DemographicsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MetricType    string                 `protobuf:"bytes,1,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"` // gender, age_group, location
+func (x *AvailabilityStatsResponse) GetAvailabilityRate() float64 {
+	if x != nil {
+		return x.AvailabilityRate
+	}
+	return 0
+}
+
+// Availability heatmap request
+type AvailabilityHeatmapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Range of slots to aggregate (defaults to the next 4 weeks; at most 92 days)
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// IANA timezone weekdays and hours are bucketed in (defaults to UTC)
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Optional city filter (case-insensitive)
+	City string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	// Optional date type filter: online, offline or offline_event
+	DateType      string `protobuf:"bytes,4,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DemographicsRequest) Reset() {
-	*x = DemographicsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[55]
+func (x *AvailabilityHeatmapRequest) Reset() {
+	*x = AvailabilityHeatmapRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DemographicsRequest) String() string {
+func (x *AvailabilityHeatmapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemographicsRequest) ProtoMessage() {}
+func (*AvailabilityHeatmapRequest) ProtoMessage() {}
 
-func (x *DemographicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[55]
+func (x *AvailabilityHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4178,86 +7930,84 @@ func (x *DemographicsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemographicsRequest.ProtoReflect.Descriptor instead.
-func (*DemographicsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use AvailabilityHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*AvailabilityHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *DemographicsRequest) GetMetricType() string {
+func (x *AvailabilityHeatmapRequest) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.MetricType
+		return x.TimeRange
 	}
-	return ""
-}
-
-type DemographicsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*DemographicData     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DemographicsResponse) Reset() {
-	*x = DemographicsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[56]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DemographicsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*DemographicsResponse) ProtoMessage() {}
-
-func (x *DemographicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[56]
+func (x *AvailabilityHeatmapRequest) GetTimezone() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Timezone
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DemographicsResponse.ProtoReflect.Descriptor instead.
-func (*DemographicsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{56}
+func (x *AvailabilityHeatmapRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
 }
 
-func (x *DemographicsResponse) GetData() []*DemographicData {
+func (x *AvailabilityHeatmapRequest) GetDateType() string {
 	if x != nil {
-		return x.Data
+		return x.DateType
 	}
-	return nil
+	return ""
 }
 
-type DemographicData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+// Availability in a city for one date type, weekday and local hour, summed
+// over the weeks of the range. Slots are counted in every hour they overlap.
+type AvailabilityHeatmapCell struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	City     string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	DateType string                 `protobuf:"bytes,2,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
+	Weekday  int32                  `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"` // 0 = Sunday
+	Hour     int32                  `protobuf:"varint,4,opt,name=hour,proto3" json:"hour,omitempty"`       // 0-23, local
+	// Slots by their owner's gender
+	MaleSlots   int64 `protobuf:"varint,5,opt,name=male_slots,json=maleSlots,proto3" json:"male_slots,omitempty"`
+	FemaleSlots int64 `protobuf:"varint,6,opt,name=female_slots,json=femaleSlots,proto3" json:"female_slots,omitempty"`
+	OtherSlots  int64 `protobuf:"varint,7,opt,name=other_slots,json=otherSlots,proto3" json:"other_slots,omitempty"`
+	TotalSlots  int64 `protobuf:"varint,8,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
+	// Distinct users with a slot, by gender
+	MaleUsers   int64 `protobuf:"varint,9,opt,name=male_users,json=maleUsers,proto3" json:"male_users,omitempty"`
+	FemaleUsers int64 `protobuf:"varint,10,opt,name=female_users,json=femaleUsers,proto3" json:"female_users,omitempty"`
+	OtherUsers  int64 `protobuf:"varint,11,opt,name=other_users,json=otherUsers,proto3" json:"other_users,omitempty"`
+	// Slots already reserved by scheduled dates
+	ReservedSlots int64 `protobuf:"varint,12,opt,name=reserved_slots,json=reservedSlots,proto3" json:"reserved_slots,omitempty"`
+	// (male_users - female_users) / (male_users + female_users), from -1 (only
+	// women available) to 1 (only men available)
+	GenderImbalance float64 `protobuf:"fixed64,13,opt,name=gender_imbalance,json=genderImbalance,proto3" json:"gender_imbalance,omitempty"`
+	// Share of slots already reserved by dates
+	Utilization float64 `protobuf:"fixed64,14,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// Supply/demand indicator: balanced, male_heavy, female_heavy or low_supply
+	Indicator     string `protobuf:"bytes,15,opt,name=indicator,proto3" json:"indicator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DemographicData) Reset() {
-	*x = DemographicData{}
-	mi := &file_admin_v1_admin_proto_msgTypes[57]
+func (x *AvailabilityHeatmapCell) Reset() {
+	*x = AvailabilityHeatmapCell{}
+	mi := &file_admin_v1_admin_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DemographicData) String() string {
+func (x *AvailabilityHeatmapCell) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemographicData) ProtoMessage() {}
+func (*AvailabilityHeatmapCell) ProtoMessage() {}
 
-func (x *DemographicData) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[57]
+func (x *AvailabilityHeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4268,153 +8018,147 @@ func (x *DemographicData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemographicData.ProtoReflect.Descriptor instead.
-func (*DemographicData) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use AvailabilityHeatmapCell.ProtoReflect.Descriptor instead.
+func (*AvailabilityHeatmapCell) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *DemographicData) GetCategory() string {
+func (x *AvailabilityHeatmapCell) GetCity() string {
 	if x != nil {
-		return x.Category
+		return x.City
 	}
 	return ""
 }
 
-func (x *DemographicData) GetCount() int64 {
+func (x *AvailabilityHeatmapCell) GetDateType() string {
 	if x != nil {
-		return x.Count
+		return x.DateType
 	}
-	return 0
+	return ""
 }
 
-func (x *DemographicData) GetPercentage() float64 {
+func (x *AvailabilityHeatmapCell) GetWeekday() int32 {
 	if x != nil {
-		return x.Percentage
+		return x.Weekday
 	}
 	return 0
 }
 
-type LocationStatsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Level          string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`                                         // country, state, city
-	ParentLocation string                 `protobuf:"bytes,2,opt,name=parent_location,json=parentLocation,proto3" json:"parent_location,omitempty"` // Filter by parent (e.g., country for state stats)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *LocationStatsRequest) Reset() {
-	*x = LocationStatsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AvailabilityHeatmapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
 }
 
-func (x *LocationStatsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AvailabilityHeatmapCell) GetMaleSlots() int64 {
+	if x != nil {
+		return x.MaleSlots
+	}
+	return 0
 }
 
-func (*LocationStatsRequest) ProtoMessage() {}
-
-func (x *LocationStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[58]
+func (x *AvailabilityHeatmapCell) GetFemaleSlots() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FemaleSlots
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use LocationStatsRequest.ProtoReflect.Descriptor instead.
-func (*LocationStatsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{58}
+func (x *AvailabilityHeatmapCell) GetOtherSlots() int64 {
+	if x != nil {
+		return x.OtherSlots
+	}
+	return 0
 }
 
-func (x *LocationStatsRequest) GetLevel() string {
+func (x *AvailabilityHeatmapCell) GetTotalSlots() int64 {
 	if x != nil {
-		return x.Level
+		return x.TotalSlots
 	}
-	return ""
+	return 0
 }
 
-func (x *LocationStatsRequest) GetParentLocation() string {
+func (x *AvailabilityHeatmapCell) GetMaleUsers() int64 {
 	if x != nil {
-		return x.ParentLocation
+		return x.MaleUsers
 	}
-	return ""
+	return 0
 }
 
-type LocationStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locations     []*LocationData        `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AvailabilityHeatmapCell) GetFemaleUsers() int64 {
+	if x != nil {
+		return x.FemaleUsers
+	}
+	return 0
 }
 
-func (x *LocationStatsResponse) Reset() {
-	*x = LocationStatsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[59]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AvailabilityHeatmapCell) GetOtherUsers() int64 {
+	if x != nil {
+		return x.OtherUsers
+	}
+	return 0
 }
 
-func (x *LocationStatsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AvailabilityHeatmapCell) GetReservedSlots() int64 {
+	if x != nil {
+		return x.ReservedSlots
+	}
+	return 0
 }
 
-func (*LocationStatsResponse) ProtoMessage() {}
-
-func (x *LocationStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[59]
+func (x *AvailabilityHeatmapCell) GetGenderImbalance() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GenderImbalance
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use LocationStatsResponse.ProtoReflect.Descriptor instead.
-func (*LocationStatsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{59}
+func (x *AvailabilityHeatmapCell) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
 }
 
-func (x *LocationStatsResponse) GetLocations() []*LocationData {
+func (x *AvailabilityHeatmapCell) GetIndicator() string {
 	if x != nil {
-		return x.Locations
+		return x.Indicator
 	}
-	return nil
+	return ""
 }
 
-type LocationData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LocationName  string                 `protobuf:"bytes,1,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
-	LocationCode  string                 `protobuf:"bytes,2,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty"` // ISO code
-	UserCount     int64                  `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
-	Percentage    float64                `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Availability in a city over the whole range
+type AvailabilityCitySummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	City            string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	TotalSlots      int64                  `protobuf:"varint,2,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
+	MaleUsers       int64                  `protobuf:"varint,3,opt,name=male_users,json=maleUsers,proto3" json:"male_users,omitempty"`
+	FemaleUsers     int64                  `protobuf:"varint,4,opt,name=female_users,json=femaleUsers,proto3" json:"female_users,omitempty"`
+	OtherUsers      int64                  `protobuf:"varint,5,opt,name=other_users,json=otherUsers,proto3" json:"other_users,omitempty"`
+	ReservedSlots   int64                  `protobuf:"varint,6,opt,name=reserved_slots,json=reservedSlots,proto3" json:"reserved_slots,omitempty"`
+	GenderImbalance float64                `protobuf:"fixed64,7,opt,name=gender_imbalance,json=genderImbalance,proto3" json:"gender_imbalance,omitempty"`
+	Utilization     float64                `protobuf:"fixed64,8,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	Indicator       string                 `protobuf:"bytes,9,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LocationData) Reset() {
-	*x = LocationData{}
-	mi := &file_admin_v1_admin_proto_msgTypes[60]
+func (x *AvailabilityCitySummary) Reset() {
+	*x = AvailabilityCitySummary{}
+	mi := &file_admin_v1_admin_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocationData) String() string {
+func (x *AvailabilityCitySummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocationData) ProtoMessage() {}
+func (*AvailabilityCitySummary) ProtoMessage() {}
 
-func (x *LocationData) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[60]
+func (x *AvailabilityCitySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,99 +8169,103 @@ func (x *LocationData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocationData.ProtoReflect.Descriptor instead.
-func (*LocationData) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use AvailabilityCitySummary.ProtoReflect.Descriptor instead.
+func (*AvailabilityCitySummary) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *LocationData) GetLocationName() string {
+func (x *AvailabilityCitySummary) GetCity() string {
 	if x != nil {
-		return x.LocationName
+		return x.City
 	}
 	return ""
 }
 
-func (x *LocationData) GetLocationCode() string {
+func (x *AvailabilityCitySummary) GetTotalSlots() int64 {
 	if x != nil {
-		return x.LocationCode
+		return x.TotalSlots
 	}
-	return ""
+	return 0
 }
 
-func (x *LocationData) GetUserCount() int64 {
+func (x *AvailabilityCitySummary) GetMaleUsers() int64 {
 	if x != nil {
-		return x.UserCount
+		return x.MaleUsers
 	}
 	return 0
 }
 
-func (x *LocationData) GetPercentage() float64 {
+func (x *AvailabilityCitySummary) GetFemaleUsers() int64 {
 	if x != nil {
-		return x.Percentage
+		return x.FemaleUsers
 	}
 	return 0
 }
 
-type AvailabilityStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AvailabilityCitySummary) GetOtherUsers() int64 {
+	if x != nil {
+		return x.OtherUsers
+	}
+	return 0
 }
 
-func (x *AvailabilityStatsRequest) Reset() {
-	*x = AvailabilityStatsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[61]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AvailabilityCitySummary) GetReservedSlots() int64 {
+	if x != nil {
+		return x.ReservedSlots
+	}
+	return 0
 }
 
-func (x *AvailabilityStatsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AvailabilityCitySummary) GetGenderImbalance() float64 {
+	if x != nil {
+		return x.GenderImbalance
+	}
+	return 0
 }
 
-func (*AvailabilityStatsRequest) ProtoMessage() {}
-
-func (x *AvailabilityStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[61]
+func (x *AvailabilityCitySummary) GetUtilization() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Utilization
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AvailabilityStatsRequest.ProtoReflect.Descriptor instead.
-func (*AvailabilityStatsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{61}
+func (x *AvailabilityCitySummary) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
 }
 
-type AvailabilityStatsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AvailableUsers   int64                  `protobuf:"varint,1,opt,name=available_users,json=availableUsers,proto3" json:"available_users,omitempty"`
-	UnavailableUsers int64                  `protobuf:"varint,2,opt,name=unavailable_users,json=unavailableUsers,proto3" json:"unavailable_users,omitempty"`
-	AvailabilityRate float64                `protobuf:"fixed64,3,opt,name=availability_rate,json=availabilityRate,proto3" json:"availability_rate,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Availability heatmap response
+type AvailabilityHeatmapResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Non-empty cells, ordered by city, date type, weekday and hour
+	Cells []*AvailabilityHeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	// Per-city totals, ordered by total slots
+	Cities []*AvailabilityCitySummary `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
+	// Range and timezone the heatmap covers
+	TimeRange     *TimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	Timezone      string     `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvailabilityStatsResponse) Reset() {
-	*x = AvailabilityStatsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[62]
+func (x *AvailabilityHeatmapResponse) Reset() {
+	*x = AvailabilityHeatmapResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailabilityStatsResponse) String() string {
+func (x *AvailabilityHeatmapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailabilityStatsResponse) ProtoMessage() {}
+func (*AvailabilityHeatmapResponse) ProtoMessage() {}
 
-func (x *AvailabilityStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[62]
+func (x *AvailabilityHeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4528,30 +8276,37 @@ func (x *AvailabilityStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailabilityStatsResponse.ProtoReflect.Descriptor instead.
-func (*AvailabilityStatsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use AvailabilityHeatmapResponse.ProtoReflect.Descriptor instead.
+func (*AvailabilityHeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *AvailabilityStatsResponse) GetAvailableUsers() int64 {
+func (x *AvailabilityHeatmapResponse) GetCells() []*AvailabilityHeatmapCell {
 	if x != nil {
-		return x.AvailableUsers
+		return x.Cells
 	}
-	return 0
+	return nil
 }
 
-func (x *AvailabilityStatsResponse) GetUnavailableUsers() int64 {
+func (x *AvailabilityHeatmapResponse) GetCities() []*AvailabilityCitySummary {
 	if x != nil {
-		return x.UnavailableUsers
+		return x.Cities
 	}
-	return 0
+	return nil
 }
 
-func (x *AvailabilityStatsResponse) GetAvailabilityRate() float64 {
+func (x *AvailabilityHeatmapResponse) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.AvailabilityRate
+		return x.TimeRange
 	}
-	return 0
+	return nil
+}
+
+func (x *AvailabilityHeatmapResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
 }
 
 type PlatformStatsRequest struct {
@@ -4562,7 +8317,7 @@ type PlatformStatsRequest struct {
 
 func (x *PlatformStatsRequest) Reset() {
 	*x = PlatformStatsRequest{}
-	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	mi := &file_admin_v1_admin_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4574,7 +8329,7 @@ func (x *PlatformStatsRequest) String() string {
 func (*PlatformStatsRequest) ProtoMessage() {}
 
 func (x *PlatformStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[63]
+	mi := &file_admin_v1_admin_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4587,7 +8342,7 @@ func (x *PlatformStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformStatsRequest.ProtoReflect.Descriptor instead.
 func (*PlatformStatsRequest) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{63}
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{110}
 }
 
 type PlatformStatsResponse struct {
@@ -4607,7 +8362,7 @@ type PlatformStatsResponse struct {
 
 func (x *PlatformStatsResponse) Reset() {
 	*x = PlatformStatsResponse{}
-	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	mi := &file_admin_v1_admin_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4619,7 +8374,7 @@ func (x *PlatformStatsResponse) String() string {
 func (*PlatformStatsResponse) ProtoMessage() {}
 
 func (x *PlatformStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_v1_admin_proto_msgTypes[64]
+	mi := &file_admin_v1_admin_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4632,7 +8387,7 @@ func (x *PlatformStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlatformStatsResponse.ProtoReflect.Descriptor instead.
 func (*PlatformStatsResponse) Descriptor() ([]byte, []int) {
-	return file_admin_v1_admin_proto_rawDescGZIP(), []int{64}
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *PlatformStatsResponse) GetTotalUsers() int64 {
@@ -4717,7 +8472,7 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xa8\x05\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\xeb\x06\n" +
 	"\rScheduledDate\x12\x17\n" +
 	"\adate_id\x18\x01 \x01(\tR\x06dateId\x12\x19\n" +
 	"\buser1_id\x18\x02 \x01(\tR\auser1Id\x12\x19\n" +
@@ -4736,7 +8491,10 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12;\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\"\xe1\x01\n" +
+	"updated_at\x18\x0f \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\x12?\n" +
+	"\fconfirmed_at\x18\x10 \x01(\v2\x1c.datifyy.common.v1.TimestampR\vconfirmedAt\x12?\n" +
+	"\fcompleted_at\x18\x11 \x01(\v2\x1c.datifyy.common.v1.TimestampR\vcompletedAt\x12?\n" +
+	"\fcancelled_at\x18\x12 \x01(\v2\x1c.datifyy.common.v1.TimestampR\vcancelledAt\"\xe1\x01\n" +
 	"\vUserSummary\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -4825,13 +8583,41 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"0\n" +
 	"\x15GetUserDetailsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9c\x02\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xab\x03\n" +
 	"\x16GetUserDetailsResponse\x125\n" +
 	"\x04user\x18\x01 \x01(\v2!.datifyy.admin.v1.UserFullDetailsR\x04user\x12C\n" +
 	"\favailability\x18\x02 \x03(\v2\x1f.datifyy.admin.v1.AvailableSlotR\favailability\x12>\n" +
 	"\n" +
 	"past_dates\x18\x03 \x03(\v2\x1f.datifyy.admin.v1.ScheduledDateR\tpastDates\x12F\n" +
-	"\x0eupcoming_dates\x18\x04 \x03(\v2\x1f.datifyy.admin.v1.ScheduledDateR\rupcomingDates\"J\n" +
+	"\x0eupcoming_dates\x18\x04 \x03(\v2\x1f.datifyy.admin.v1.ScheduledDateR\rupcomingDates\x12H\n" +
+	"\x0fprofile_changes\x18\x05 \x03(\v2\x1f.datifyy.admin.v1.ProfileChangeR\x0eprofileChanges\x12C\n" +
+	"\vreliability\x18\x06 \x01(\v2!.datifyy.admin.v1.DateReliabilityR\vreliability\"f\n" +
+	"\x0fDateReliability\x12$\n" +
+	"\rcancellations\x18\x01 \x01(\x05R\rcancellations\x12-\n" +
+	"\x12late_cancellations\x18\x02 \x01(\x05R\x11lateCancellations\"\xb9\x03\n" +
+	"\rProfileChange\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x03 \x01(\tR\ttableName\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x04 \x01(\tR\tfieldName\x12\x1b\n" +
+	"\told_value\x18\x05 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x06 \x01(\tR\bnewValue\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x12\x19\n" +
+	"\bactor_id\x18\b \x01(\tR\aactorId\x12*\n" +
+	"\x11reverts_change_id\x18\t \x01(\tR\x0frevertsChangeId\x12=\n" +
+	"\vreverted_at\x18\n" +
+	" \x01(\v2\x1c.datifyy.common.v1.TimestampR\n" +
+	"revertedAt\x12\x1f\n" +
+	"\vreverted_by\x18\v \x01(\tR\n" +
+	"revertedBy\x12;\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\"I\n" +
+	"\x1aRevertProfileChangeRequest\x12\x1b\n" +
+	"\tchange_id\x18\x01 \x01(\tR\bchangeIdJ\x04\b\x02\x10\x03R\badmin_id\"V\n" +
+	"\x1bRevertProfileChangeResponse\x127\n" +
+	"\x06change\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ProfileChangeR\x06change\"J\n" +
 	"\x19GetDateSuggestionsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"`\n" +
@@ -4846,8 +8632,38 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\blocation\x18\x06 \x01(\v2!.datifyy.admin.v1.OfflineLocationR\blocation\x12\x14\n" +
 	"\x05notes\x18\a \x01(\tR\x05notes\"K\n" +
 	"\x14ScheduleDateResponse\x123\n" +
-	"\x04date\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ScheduledDateR\x04date\"\x1e\n" +
-	"\x1cGetCurationCandidatesRequest\"\xb1\x03\n" +
+	"\x04date\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ScheduledDateR\x04date\"\xfb\x02\n" +
+	"\x1dFindCommonAvailabilityRequest\x12\x19\n" +
+	"\buser1_id\x18\x01 \x01(\tR\auser1Id\x12\x19\n" +
+	"\buser2_id\x18\x02 \x01(\tR\auser2Id\x12\x1b\n" +
+	"\tdate_type\x18\x03 \x01(\tR\bdateType\x129\n" +
+	"\tfrom_time\x18\x04 \x01(\v2\x1c.datifyy.common.v1.TimestampR\bfromTime\x125\n" +
+	"\ato_time\x18\x05 \x01(\v2\x1c.datifyy.common.v1.TimestampR\x06toTime\x120\n" +
+	"\x14min_duration_minutes\x18\x06 \x01(\x05R\x12minDurationMinutes\x12%\n" +
+	"\x0ebuffer_minutes\x18\a \x01(\x05R\rbufferMinutes\x12&\n" +
+	"\x0fmax_distance_km\x18\b \x01(\x01R\rmaxDistanceKm\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\"\x8b\x04\n" +
+	"\x1bCommonAvailabilityCandidate\x12;\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1c.datifyy.common.v1.TimestampR\tstartTime\x127\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1c.datifyy.common.v1.TimestampR\aendTime\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x03R\x0fdurationMinutes\x12\x1b\n" +
+	"\tdate_type\x18\x04 \x01(\tR\bdateType\x12\"\n" +
+	"\ruser1_slot_id\x18\x05 \x01(\tR\vuser1SlotId\x12\"\n" +
+	"\ruser2_slot_id\x18\x06 \x01(\tR\vuser2SlotId\x12H\n" +
+	"\x0euser1_location\x18\a \x01(\v2!.datifyy.admin.v1.OfflineLocationR\ruser1Location\x12H\n" +
+	"\x0euser2_location\x18\b \x01(\v2!.datifyy.admin.v1.OfflineLocationR\ruser2Location\x12\x1f\n" +
+	"\vdistance_km\x18\t \x01(\x01R\n" +
+	"distanceKm\x12\x1b\n" +
+	"\tsame_city\x18\n" +
+	" \x01(\bR\bsameCity\x12\x14\n" +
+	"\x05score\x18\v \x01(\x01R\x05score\"o\n" +
+	"\x1eFindCommonAvailabilityResponse\x12M\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2-.datifyy.admin.v1.CommonAvailabilityCandidateR\n" +
+	"candidates\">\n" +
+	"\x1cGetCurationCandidatesRequest\x12\x1e\n" +
+	"\vfor_user_id\x18\x01 \x01(\tR\tforUserId\"\x99\x05\n" +
 	"\x11CurationCandidate\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -4860,14 +8676,19 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x13work_email_verified\x18\t \x01(\bR\x11workEmailVerified\x122\n" +
 	"\x15available_slots_count\x18\n" +
 	" \x01(\x05R\x13availableSlotsCount\x12L\n" +
-	"\x13next_available_date\x18\v \x01(\v2\x1c.datifyy.common.v1.TimestampR\x11nextAvailableDate\"d\n" +
+	"\x13next_available_date\x18\v \x01(\v2\x1c.datifyy.common.v1.TimestampR\x11nextAvailableDate\x12\x1f\n" +
+	"\vdistance_km\x18\f \x01(\x05R\n" +
+	"distanceKm\x12H\n" +
+	"\x0fhoroscope_match\x18\r \x01(\v2\x1f.datifyy.user.v1.HoroscopeMatchR\x0ehoroscopeMatch\x12P\n" +
+	"\x10preference_rules\x18\x0e \x03(\v2%.datifyy.user.v1.PreferenceRuleResultR\x0fpreferenceRules\x12)\n" +
+	"\x10preference_score\x18\x0f \x01(\x05R\x0fpreferenceScore\"d\n" +
 	"\x1dGetCurationCandidatesResponse\x12C\n" +
 	"\n" +
 	"candidates\x18\x01 \x03(\v2#.datifyy.admin.v1.CurationCandidateR\n" +
 	"candidates\"R\n" +
 	"\x12CurateDatesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
-	"\rcandidate_ids\x18\x02 \x03(\tR\fcandidateIds\"\xa6\x02\n" +
+	"\rcandidate_ids\x18\x02 \x03(\tR\fcandidateIds\"\xed\x03\n" +
 	"\vMatchResult\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
@@ -4877,7 +8698,12 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\bis_match\x18\x06 \x01(\bR\aisMatch\x12\x1c\n" +
 	"\treasoning\x18\a \x01(\tR\treasoning\x12'\n" +
 	"\x0fmatched_aspects\x18\b \x03(\tR\x0ematchedAspects\x12-\n" +
-	"\x12mismatched_aspects\x18\t \x03(\tR\x11mismatchedAspects\"N\n" +
+	"\x12mismatched_aspects\x18\t \x03(\tR\x11mismatchedAspects\x12D\n" +
+	"\n" +
+	"user_rules\x18\n" +
+	" \x03(\v2%.datifyy.user.v1.PreferenceRuleResultR\tuserRules\x12N\n" +
+	"\x0fcandidate_rules\x18\v \x03(\v2%.datifyy.user.v1.PreferenceRuleResultR\x0ecandidateRules\x12/\n" +
+	"\x13dealbreakers_passed\x18\f \x01(\bR\x12dealbreakersPassed\"N\n" +
 	"\x13CurateDatesResponse\x127\n" +
 	"\amatches\x18\x01 \x03(\v2\x1d.datifyy.admin.v1.MatchResultR\amatches\"\x9f\x01\n" +
 	"\x1fUpdateCuratedMatchActionRequest\x12(\n" +
@@ -4928,13 +8754,38 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"~\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x99\x01\n" +
 	"\x17UpdateDateStatusRequest\x12\x17\n" +
 	"\adate_id\x18\x01 \x01(\tR\x06dateId\x124\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1c.datifyy.admin.v1.DateStatusR\x06status\x12\x14\n" +
-	"\x05notes\x18\x03 \x01(\tR\x05notes\"O\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\"O\n" +
 	"\x18UpdateDateStatusResponse\x123\n" +
-	"\x04date\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ScheduledDateR\x04date\"\xaa\x01\n" +
+	"\x04date\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ScheduledDateR\x04date\"0\n" +
+	"\x15GetDateHistoryRequest\x12\x17\n" +
+	"\adate_id\x18\x01 \x01(\tR\x06dateId\"\xa7\x03\n" +
+	"\fDateActivity\x12\x1f\n" +
+	"\vactivity_id\x18\x01 \x01(\tR\n" +
+	"activityId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12=\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x1c.datifyy.admin.v1.DateStatusR\n" +
+	"fromStatus\x129\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x1c.datifyy.admin.v1.DateStatusR\btoStatus\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x06 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\b \x01(\tR\tactorName\x12;\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\told_value\x18\n" +
+	" \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\v \x01(\tR\bnewValue\"\xcc\x01\n" +
+	"\x16GetDateHistoryResponse\x123\n" +
+	"\x04date\x18\x01 \x01(\v2\x1f.datifyy.admin.v1.ScheduledDateR\x04date\x12:\n" +
+	"\bactivity\x18\x02 \x03(\v2\x1e.datifyy.admin.v1.DateActivityR\bactivity\x12A\n" +
+	"\rnext_statuses\x18\x03 \x03(\x0e2\x1c.datifyy.admin.v1.DateStatusR\fnextStatuses\"\xaa\x01\n" +
 	"\x16CreateAdminUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
@@ -4966,16 +8817,210 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\"O\n" +
 	"\x1aUpdateAdminProfileResponse\x121\n" +
-	"\x05admin\x18\x01 \x01(\v2\x1b.datifyy.admin.v1.AdminUserR\x05admin\"\x84\x01\n" +
+	"\x05admin\x18\x01 \x01(\v2\x1b.datifyy.admin.v1.AdminUserR\x05admin\"\xc8\x01\n" +
 	"\x15BulkUserActionRequest\x12\x19\n" +
 	"\buser_ids\x18\x01 \x03(\tR\auserIds\x128\n" +
 	"\x06action\x18\x02 \x01(\x0e2 .datifyy.admin.v1.BulkUserActionR\x06action\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xaf\x01\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\x12'\n" +
+	"\x0fsuspension_days\x18\x05 \x01(\x05R\x0esuspensionDays\"\xaf\x01\n" +
 	"\x16BulkUserActionResponse\x12#\n" +
 	"\rsuccess_count\x18\x01 \x01(\x05R\fsuccessCount\x12!\n" +
 	"\ffailed_count\x18\x02 \x01(\x05R\vfailedCount\x12&\n" +
 	"\x0ffailed_user_ids\x18\x03 \x03(\tR\rfailedUserIds\x12%\n" +
-	"\x0eerror_messages\x18\x04 \x03(\tR\rerrorMessages\"\x81\x01\n" +
+	"\x0eerror_messages\x18\x04 \x03(\tR\rerrorMessages\"\xf5\x05\n" +
+	"\n" +
+	"UserReport\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12(\n" +
+	"\x10reporter_user_id\x18\x02 \x01(\tR\x0ereporterUserId\x12(\n" +
+	"\x10reported_user_id\x18\x03 \x01(\tR\x0ereportedUserId\x12,\n" +
+	"\x12reported_user_name\x18\x04 \x01(\tR\x10reportedUserName\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\adetails\x18\x06 \x01(\tR\adetails\x12#\n" +
+	"\revidence_urls\x18\a \x03(\tR\fevidenceUrls\x126\n" +
+	"\x06status\x18\b \x01(\x0e2\x1e.datifyy.admin.v1.ReportStatusR\x06status\x12<\n" +
+	"\bseverity\x18\t \x01(\x0e2 .datifyy.admin.v1.ReportSeverityR\bseverity\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\n" +
+	" \x01(\tR\tclaimedBy\x12;\n" +
+	"\n" +
+	"claimed_at\x18\v \x01(\v2\x1c.datifyy.common.v1.TimestampR\tclaimedAt\x12\x1f\n" +
+	"\vreviewed_by\x18\f \x01(\tR\n" +
+	"reviewedBy\x12=\n" +
+	"\vreviewed_at\x18\r \x01(\v2\x1c.datifyy.common.v1.TimestampR\n" +
+	"reviewedAt\x12)\n" +
+	"\x10resolution_notes\x18\x0e \x01(\tR\x0fresolutionNotes\x12\x1c\n" +
+	"\tescalated\x18\x0f \x01(\bR\tescalated\x12;\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x129\n" +
+	"\x19open_reports_against_user\x18\x11 \x01(\x05R\x16openReportsAgainstUser\"\xd2\x03\n" +
+	"\x10ModerationAction\x12\x1b\n" +
+	"\taction_id\x18\x01 \x01(\tR\bactionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\treport_id\x18\x03 \x01(\tR\breportId\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId\x12;\n" +
+	"\x06action\x18\x05 \x01(\x0e2#.datifyy.admin.v1.EnforcementActionR\x06action\x12#\n" +
+	"\rduration_days\x18\x06 \x01(\x05R\fdurationDays\x12;\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1c.datifyy.common.v1.TimestampR\texpiresAt\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12;\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x129\n" +
+	"\tlifted_at\x18\n" +
+	" \x01(\v2\x1c.datifyy.common.v1.TimestampR\bliftedAt\x12#\n" +
+	"\rlifted_reason\x18\v \x01(\tR\fliftedReason\"\x88\x02\n" +
+	"\x16ListUserReportsRequest\x126\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1e.datifyy.admin.v1.ReportStatusR\x06status\x12<\n" +
+	"\bseverity\x18\x02 \x01(\x0e2 .datifyy.admin.v1.ReportSeverityR\bseverity\x12(\n" +
+	"\x10reported_user_id\x18\x03 \x01(\tR\x0ereportedUserId\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\x04 \x01(\tR\tclaimedBy\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\"\xa3\x01\n" +
+	"\x17ListUserReportsResponse\x126\n" +
+	"\areports\x18\x01 \x03(\v2\x1c.datifyy.admin.v1.UserReportR\areports\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"E\n" +
+	"\x16ClaimUserReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportIdJ\x04\b\x02\x10\x03R\badmin_id\"O\n" +
+	"\x17ClaimUserReportResponse\x124\n" +
+	"\x06report\x18\x01 \x01(\v2\x1c.datifyy.admin.v1.UserReportR\x06report\"\xc3\x01\n" +
+	"\x18ResolveUserReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12;\n" +
+	"\x06action\x18\x04 \x01(\x0e2#.datifyy.admin.v1.EnforcementActionR\x06action\x12'\n" +
+	"\x0fsuspension_days\x18\x05 \x01(\x05R\x0esuspensionDaysJ\x04\b\x02\x10\x03R\badmin_id\"\x97\x01\n" +
+	"\x19ResolveUserReportResponse\x124\n" +
+	"\x06report\x18\x01 \x01(\v2\x1c.datifyy.admin.v1.UserReportR\x06report\x12D\n" +
+	"\venforcement\x18\x02 \x01(\v2\".datifyy.admin.v1.ModerationActionR\venforcement\"]\n" +
+	"\x18DismissUserReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notesJ\x04\b\x02\x10\x03R\badmin_id\"Q\n" +
+	"\x19DismissUserReportResponse\x124\n" +
+	"\x06report\x18\x01 \x01(\v2\x1c.datifyy.admin.v1.UserReportR\x06report\"\xdd\x03\n" +
+	"\x11EnforcementAppeal\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x04 \x01(\tR\tuserEmail\x12D\n" +
+	"\venforcement\x18\x05 \x01(\v2\".datifyy.admin.v1.ModerationActionR\venforcement\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.datifyy.admin.v1.AppealStatusR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\b \x01(\tR\n" +
+	"reviewedBy\x12=\n" +
+	"\vreviewed_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\n" +
+	"reviewedAt\x12!\n" +
+	"\freview_notes\x18\n" +
+	" \x01(\tR\vreviewNotes\x12;\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\";\n" +
+	" GetUserEnforcementHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9d\x01\n" +
+	"!GetUserEnforcementHistoryResponse\x12<\n" +
+	"\aactions\x18\x01 \x03(\v2\".datifyy.admin.v1.ModerationActionR\aactions\x12:\n" +
+	"\x06active\x18\x02 \x01(\v2\".datifyy.admin.v1.ModerationActionR\x06active\"\x88\x01\n" +
+	"\x1dListEnforcementAppealsRequest\x126\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1e.datifyy.admin.v1.AppealStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xb1\x01\n" +
+	"\x1eListEnforcementAppealsResponse\x12=\n" +
+	"\aappeals\x18\x01 \x03(\v2#.datifyy.admin.v1.EnforcementAppealR\aappeals\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"}\n" +
+	"\x1eReviewEnforcementAppealRequest\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notesJ\x04\b\x02\x10\x03R\badmin_id\"^\n" +
+	"\x1fReviewEnforcementAppealResponse\x12;\n" +
+	"\x06appeal\x18\x01 \x01(\v2#.datifyy.admin.v1.EnforcementAppealR\x06appeal\"\xd5\x04\n" +
+	"\x0eIdVerification\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x04 \x01(\tR\tuserEmail\x12D\n" +
+	"\rdocument_type\x18\x05 \x01(\x0e2\x1f.datifyy.user.v1.IdDocumentTypeR\fdocumentType\x12(\n" +
+	"\x10id_number_masked\x18\x06 \x01(\tR\x0eidNumberMasked\x12=\n" +
+	"\x06status\x18\a \x01(\x0e2%.datifyy.user.v1.IdVerificationStatusR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\b \x01(\tR\n" +
+	"reviewedBy\x12=\n" +
+	"\vreviewed_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\n" +
+	"reviewedAt\x12)\n" +
+	"\x10rejection_reason\x18\n" +
+	" \x01(\tR\x0frejectionReason\x12;\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12$\n" +
+	"\x0ehas_back_image\x18\f \x01(\bR\fhasBackImage\x12(\n" +
+	"\x10has_selfie_image\x18\r \x01(\bR\x0ehasSelfieImage\"\x8c\x01\n" +
+	"\x1aListIdVerificationsRequest\x12=\n" +
+	"\x06status\x18\x01 \x01(\x0e2%.datifyy.user.v1.IdVerificationStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xb7\x01\n" +
+	"\x1bListIdVerificationsResponse\x12F\n" +
+	"\rverifications\x18\x01 \x03(\v2 .datifyy.admin.v1.IdVerificationR\rverifications\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"C\n" +
+	"\x18GetIdVerificationRequest\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\"\xc4\x01\n" +
+	"\x19GetIdVerificationResponse\x12D\n" +
+	"\fverification\x18\x01 \x01(\v2 .datifyy.admin.v1.IdVerificationR\fverification\x12\x1f\n" +
+	"\vfront_image\x18\x02 \x01(\fR\n" +
+	"frontImage\x12\x1d\n" +
+	"\n" +
+	"back_image\x18\x03 \x01(\fR\tbackImage\x12!\n" +
+	"\fselfie_image\x18\x04 \x01(\fR\vselfieImage\"\x9b\x01\n" +
+	"\x1bReviewIdVerificationRequest\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12)\n" +
+	"\x10rejection_reason\x18\x04 \x01(\tR\x0frejectionReasonJ\x04\b\x02\x10\x03R\badmin_id\"d\n" +
+	"\x1cReviewIdVerificationResponse\x12D\n" +
+	"\fverification\x18\x01 \x01(\v2 .datifyy.admin.v1.IdVerificationR\fverification\"\xaa\x05\n" +
+	"\x06Prompt\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x126\n" +
+	"\x04text\x18\x03 \x03(\v2\".datifyy.admin.v1.Prompt.TextEntryR\x04text\x12*\n" +
+	"\x11min_answer_length\x18\x04 \x01(\x05R\x0fminAnswerLength\x12*\n" +
+	"\x11max_answer_length\x18\x05 \x01(\x05R\x0fmaxAnswerLength\x12#\n" +
+	"\rdisplay_order\x18\x06 \x01(\x05R\fdisplayOrder\x12=\n" +
+	"\vactive_from\x18\a \x01(\v2\x1c.datifyy.common.v1.TimestampR\n" +
+	"activeFrom\x12?\n" +
+	"\factive_until\x18\b \x01(\v2\x1c.datifyy.common.v1.TimestampR\vactiveUntil\x12H\n" +
+	"\x0flegacy_question\x18\t \x01(\x0e2\x1f.datifyy.user.v1.PromptQuestionR\x0elegacyQuestion\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\v \x01(\tR\tupdatedBy\x12;\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12;\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\x1a7\n" +
+	"\tTextEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
+	"\x12ListPromptsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"I\n" +
+	"\x13ListPromptsResponse\x122\n" +
+	"\aprompts\x18\x01 \x03(\v2\x18.datifyy.admin.v1.PromptR\aprompts\"W\n" +
+	"\x13CreatePromptRequest\x120\n" +
+	"\x06prompt\x18\x02 \x01(\v2\x18.datifyy.admin.v1.PromptR\x06promptJ\x04\b\x01\x10\x02R\badmin_id\"H\n" +
+	"\x14CreatePromptResponse\x120\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x18.datifyy.admin.v1.PromptR\x06prompt\"W\n" +
+	"\x13UpdatePromptRequest\x120\n" +
+	"\x06prompt\x18\x02 \x01(\v2\x18.datifyy.admin.v1.PromptR\x06promptJ\x04\b\x01\x10\x02R\badmin_id\"H\n" +
+	"\x14UpdatePromptResponse\x120\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x18.datifyy.admin.v1.PromptR\x06prompt\"B\n" +
+	"\x13DeletePromptRequest\x12\x1b\n" +
+	"\tprompt_id\x18\x02 \x01(\tR\bpromptIdJ\x04\b\x01\x10\x02R\badmin_id\"H\n" +
+	"\x14DeletePromptResponse\x120\n" +
+	"\x06prompt\x18\x01 \x01(\v2\x18.datifyy.admin.v1.PromptR\x06prompt\"\x81\x01\n" +
 	"\tTimeRange\x12;\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\v2\x1c.datifyy.common.v1.TimestampR\tstartTime\x127\n" +
@@ -5040,7 +9085,54 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x19AvailabilityStatsResponse\x12'\n" +
 	"\x0favailable_users\x18\x01 \x01(\x03R\x0eavailableUsers\x12+\n" +
 	"\x11unavailable_users\x18\x02 \x01(\x03R\x10unavailableUsers\x12+\n" +
-	"\x11availability_rate\x18\x03 \x01(\x01R\x10availabilityRate\"\x16\n" +
+	"\x11availability_rate\x18\x03 \x01(\x01R\x10availabilityRate\"\xa5\x01\n" +
+	"\x1aAvailabilityHeatmapRequest\x12:\n" +
+	"\n" +
+	"time_range\x18\x01 \x01(\v2\x1b.datifyy.admin.v1.TimeRangeR\ttimeRange\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1b\n" +
+	"\tdate_type\x18\x04 \x01(\tR\bdateType\"\xf1\x03\n" +
+	"\x17AvailabilityHeatmapCell\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x1b\n" +
+	"\tdate_type\x18\x02 \x01(\tR\bdateType\x12\x18\n" +
+	"\aweekday\x18\x03 \x01(\x05R\aweekday\x12\x12\n" +
+	"\x04hour\x18\x04 \x01(\x05R\x04hour\x12\x1d\n" +
+	"\n" +
+	"male_slots\x18\x05 \x01(\x03R\tmaleSlots\x12!\n" +
+	"\ffemale_slots\x18\x06 \x01(\x03R\vfemaleSlots\x12\x1f\n" +
+	"\vother_slots\x18\a \x01(\x03R\n" +
+	"otherSlots\x12\x1f\n" +
+	"\vtotal_slots\x18\b \x01(\x03R\n" +
+	"totalSlots\x12\x1d\n" +
+	"\n" +
+	"male_users\x18\t \x01(\x03R\tmaleUsers\x12!\n" +
+	"\ffemale_users\x18\n" +
+	" \x01(\x03R\vfemaleUsers\x12\x1f\n" +
+	"\vother_users\x18\v \x01(\x03R\n" +
+	"otherUsers\x12%\n" +
+	"\x0ereserved_slots\x18\f \x01(\x03R\rreservedSlots\x12)\n" +
+	"\x10gender_imbalance\x18\r \x01(\x01R\x0fgenderImbalance\x12 \n" +
+	"\vutilization\x18\x0e \x01(\x01R\vutilization\x12\x1c\n" +
+	"\tindicator\x18\x0f \x01(\tR\tindicator\"\xc3\x02\n" +
+	"\x17AvailabilityCitySummary\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x1f\n" +
+	"\vtotal_slots\x18\x02 \x01(\x03R\n" +
+	"totalSlots\x12\x1d\n" +
+	"\n" +
+	"male_users\x18\x03 \x01(\x03R\tmaleUsers\x12!\n" +
+	"\ffemale_users\x18\x04 \x01(\x03R\vfemaleUsers\x12\x1f\n" +
+	"\vother_users\x18\x05 \x01(\x03R\n" +
+	"otherUsers\x12%\n" +
+	"\x0ereserved_slots\x18\x06 \x01(\x03R\rreservedSlots\x12)\n" +
+	"\x10gender_imbalance\x18\a \x01(\x01R\x0fgenderImbalance\x12 \n" +
+	"\vutilization\x18\b \x01(\x01R\vutilization\x12\x1c\n" +
+	"\tindicator\x18\t \x01(\tR\tindicator\"\xf9\x01\n" +
+	"\x1bAvailabilityHeatmapResponse\x12?\n" +
+	"\x05cells\x18\x01 \x03(\v2).datifyy.admin.v1.AvailabilityHeatmapCellR\x05cells\x12A\n" +
+	"\x06cities\x18\x02 \x03(\v2).datifyy.admin.v1.AvailabilityCitySummaryR\x06cities\x12:\n" +
+	"\n" +
+	"time_range\x18\x03 \x01(\v2\x1b.datifyy.admin.v1.TimeRangeR\ttimeRange\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x16\n" +
 	"\x14PlatformStatsRequest\"\x9b\x03\n" +
 	"\x15PlatformStatsResponse\x12\x1f\n" +
 	"\vtotal_users\x18\x01 \x01(\x03R\n" +
@@ -5090,28 +9182,67 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"\x18BULK_USER_ACTION_SUSPEND\x10\x02\x12\x1b\n" +
 	"\x17BULK_USER_ACTION_DELETE\x10\x03\x12\x1b\n" +
 	"\x17BULK_USER_ACTION_VERIFY\x10\x04\x12\x1d\n" +
-	"\x19BULK_USER_ACTION_UNVERIFY\x10\x05*\xa7\x01\n" +
+	"\x19BULK_USER_ACTION_UNVERIFY\x10\x05*\x9e\x01\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REPORT_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17REPORT_STATUS_IN_REVIEW\x10\x02\x12\x1a\n" +
+	"\x16REPORT_STATUS_RESOLVED\x10\x03\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x04*\x80\x01\n" +
+	"\x0eReportSeverity\x12\x1f\n" +
+	"\x1bREPORT_SEVERITY_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REPORT_SEVERITY_LOW\x10\x01\x12\x1a\n" +
+	"\x16REPORT_SEVERITY_MEDIUM\x10\x02\x12\x18\n" +
+	"\x14REPORT_SEVERITY_HIGH\x10\x03*\xb2\x01\n" +
+	"\x11EnforcementAction\x12\"\n" +
+	"\x1eENFORCEMENT_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ENFORCEMENT_ACTION_WARN\x10\x01\x12\x1e\n" +
+	"\x1aENFORCEMENT_ACTION_SUSPEND\x10\x02\x12\x1a\n" +
+	"\x16ENFORCEMENT_ACTION_BAN\x10\x03\x12 \n" +
+	"\x1cENFORCEMENT_ACTION_REINSTATE\x10\x04*\x80\x01\n" +
+	"\fAppealStatus\x12\x1d\n" +
+	"\x19APPEAL_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15APPEAL_STATUS_PENDING\x10\x01\x12\x1a\n" +
+	"\x16APPEAL_STATUS_APPROVED\x10\x02\x12\x1a\n" +
+	"\x16APPEAL_STATUS_REJECTED\x10\x03*\xa7\x01\n" +
 	"\x0fAnalyticsPeriod\x12 \n" +
 	"\x1cANALYTICS_PERIOD_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16ANALYTICS_PERIOD_DAILY\x10\x01\x12\x1b\n" +
 	"\x17ANALYTICS_PERIOD_WEEKLY\x10\x02\x12\x1c\n" +
 	"\x18ANALYTICS_PERIOD_MONTHLY\x10\x03\x12\x1b\n" +
-	"\x17ANALYTICS_PERIOD_YEARLY\x10\x042\x82\x14\n" +
+	"\x17ANALYTICS_PERIOD_YEARLY\x10\x042\xd1#\n" +
 	"\fAdminService\x12W\n" +
 	"\n" +
 	"AdminLogin\x12#.datifyy.admin.v1.AdminLoginRequest\x1a$.datifyy.admin.v1.AdminLoginResponse\x12Z\n" +
 	"\vGetAllUsers\x12$.datifyy.admin.v1.GetAllUsersRequest\x1a%.datifyy.admin.v1.GetAllUsersResponse\x12Z\n" +
 	"\vSearchUsers\x12$.datifyy.admin.v1.SearchUsersRequest\x1a%.datifyy.admin.v1.SearchUsersResponse\x12c\n" +
-	"\x0eGetUserDetails\x12'.datifyy.admin.v1.GetUserDetailsRequest\x1a(.datifyy.admin.v1.GetUserDetailsResponse\x12c\n" +
-	"\x0eBulkUserAction\x12'.datifyy.admin.v1.BulkUserActionRequest\x1a(.datifyy.admin.v1.BulkUserActionResponse\x12o\n" +
+	"\x0eGetUserDetails\x12'.datifyy.admin.v1.GetUserDetailsRequest\x1a(.datifyy.admin.v1.GetUserDetailsResponse\x12r\n" +
+	"\x13RevertProfileChange\x12,.datifyy.admin.v1.RevertProfileChangeRequest\x1a-.datifyy.admin.v1.RevertProfileChangeResponse\x12c\n" +
+	"\x0eBulkUserAction\x12'.datifyy.admin.v1.BulkUserActionRequest\x1a(.datifyy.admin.v1.BulkUserActionResponse\x12f\n" +
+	"\x0fListUserReports\x12(.datifyy.admin.v1.ListUserReportsRequest\x1a).datifyy.admin.v1.ListUserReportsResponse\x12f\n" +
+	"\x0fClaimUserReport\x12(.datifyy.admin.v1.ClaimUserReportRequest\x1a).datifyy.admin.v1.ClaimUserReportResponse\x12l\n" +
+	"\x11ResolveUserReport\x12*.datifyy.admin.v1.ResolveUserReportRequest\x1a+.datifyy.admin.v1.ResolveUserReportResponse\x12l\n" +
+	"\x11DismissUserReport\x12*.datifyy.admin.v1.DismissUserReportRequest\x1a+.datifyy.admin.v1.DismissUserReportResponse\x12\x84\x01\n" +
+	"\x19GetUserEnforcementHistory\x122.datifyy.admin.v1.GetUserEnforcementHistoryRequest\x1a3.datifyy.admin.v1.GetUserEnforcementHistoryResponse\x12{\n" +
+	"\x16ListEnforcementAppeals\x12/.datifyy.admin.v1.ListEnforcementAppealsRequest\x1a0.datifyy.admin.v1.ListEnforcementAppealsResponse\x12~\n" +
+	"\x17ReviewEnforcementAppeal\x120.datifyy.admin.v1.ReviewEnforcementAppealRequest\x1a1.datifyy.admin.v1.ReviewEnforcementAppealResponse\x12r\n" +
+	"\x13ListIdVerifications\x12,.datifyy.admin.v1.ListIdVerificationsRequest\x1a-.datifyy.admin.v1.ListIdVerificationsResponse\x12l\n" +
+	"\x11GetIdVerification\x12*.datifyy.admin.v1.GetIdVerificationRequest\x1a+.datifyy.admin.v1.GetIdVerificationResponse\x12u\n" +
+	"\x14ReviewIdVerification\x12-.datifyy.admin.v1.ReviewIdVerificationRequest\x1a..datifyy.admin.v1.ReviewIdVerificationResponse\x12Z\n" +
+	"\vListPrompts\x12$.datifyy.admin.v1.ListPromptsRequest\x1a%.datifyy.admin.v1.ListPromptsResponse\x12]\n" +
+	"\fCreatePrompt\x12%.datifyy.admin.v1.CreatePromptRequest\x1a&.datifyy.admin.v1.CreatePromptResponse\x12]\n" +
+	"\fUpdatePrompt\x12%.datifyy.admin.v1.UpdatePromptRequest\x1a&.datifyy.admin.v1.UpdatePromptResponse\x12]\n" +
+	"\fDeletePrompt\x12%.datifyy.admin.v1.DeletePromptRequest\x1a&.datifyy.admin.v1.DeletePromptResponse\x12o\n" +
 	"\x12GetDateSuggestions\x12+.datifyy.admin.v1.GetDateSuggestionsRequest\x1a,.datifyy.admin.v1.GetDateSuggestionsResponse\x12]\n" +
-	"\fScheduleDate\x12%.datifyy.admin.v1.ScheduleDateRequest\x1a&.datifyy.admin.v1.ScheduleDateResponse\x12x\n" +
+	"\fScheduleDate\x12%.datifyy.admin.v1.ScheduleDateRequest\x1a&.datifyy.admin.v1.ScheduleDateResponse\x12{\n" +
+	"\x16FindCommonAvailability\x12/.datifyy.admin.v1.FindCommonAvailabilityRequest\x1a0.datifyy.admin.v1.FindCommonAvailabilityResponse\x12x\n" +
 	"\x15GetCurationCandidates\x12..datifyy.admin.v1.GetCurationCandidatesRequest\x1a/.datifyy.admin.v1.GetCurationCandidatesResponse\x12Z\n" +
 	"\vCurateDates\x12$.datifyy.admin.v1.CurateDatesRequest\x1a%.datifyy.admin.v1.CurateDatesResponse\x12\x81\x01\n" +
 	"\x18UpdateCuratedMatchAction\x121.datifyy.admin.v1.UpdateCuratedMatchActionRequest\x1a2.datifyy.admin.v1.UpdateCuratedMatchActionResponse\x12\x84\x01\n" +
 	"\x19GetCuratedMatchesByStatus\x122.datifyy.admin.v1.GetCuratedMatchesByStatusRequest\x1a3.datifyy.admin.v1.GetCuratedMatchesByStatusResponse\x12`\n" +
 	"\rGetGenieDates\x12&.datifyy.admin.v1.GetGenieDatesRequest\x1a'.datifyy.admin.v1.GetGenieDatesResponse\x12i\n" +
-	"\x10UpdateDateStatus\x12).datifyy.admin.v1.UpdateDateStatusRequest\x1a*.datifyy.admin.v1.UpdateDateStatusResponse\x12f\n" +
+	"\x10UpdateDateStatus\x12).datifyy.admin.v1.UpdateDateStatusRequest\x1a*.datifyy.admin.v1.UpdateDateStatusResponse\x12c\n" +
+	"\x0eGetDateHistory\x12'.datifyy.admin.v1.GetDateHistoryRequest\x1a(.datifyy.admin.v1.GetDateHistoryResponse\x12f\n" +
 	"\x0fCreateAdminUser\x12(.datifyy.admin.v1.CreateAdminUserRequest\x1a).datifyy.admin.v1.CreateAdminUserResponse\x12]\n" +
 	"\fGetAllAdmins\x12%.datifyy.admin.v1.GetAllAdminsRequest\x1a&.datifyy.admin.v1.GetAllAdminsResponse\x12Z\n" +
 	"\vUpdateAdmin\x12$.datifyy.admin.v1.UpdateAdminRequest\x1a%.datifyy.admin.v1.UpdateAdminResponse\x12Z\n" +
@@ -5124,7 +9255,8 @@ const file_admin_v1_admin_proto_rawDesc = "" +
 	"GetSignups\x12 .datifyy.admin.v1.SignupsRequest\x1a!.datifyy.admin.v1.SignupsResponse\x12`\n" +
 	"\x0fGetDemographics\x12%.datifyy.admin.v1.DemographicsRequest\x1a&.datifyy.admin.v1.DemographicsResponse\x12c\n" +
 	"\x10GetLocationStats\x12&.datifyy.admin.v1.LocationStatsRequest\x1a'.datifyy.admin.v1.LocationStatsResponse\x12o\n" +
-	"\x14GetAvailabilityStats\x12*.datifyy.admin.v1.AvailabilityStatsRequest\x1a+.datifyy.admin.v1.AvailabilityStatsResponseB\xb5\x01\n" +
+	"\x14GetAvailabilityStats\x12*.datifyy.admin.v1.AvailabilityStatsRequest\x1a+.datifyy.admin.v1.AvailabilityStatsResponse\x12u\n" +
+	"\x16GetAvailabilityHeatmap\x12,.datifyy.admin.v1.AvailabilityHeatmapRequest\x1a-.datifyy.admin.v1.AvailabilityHeatmapResponseB\xb5\x01\n" +
 	"\x14com.datifyy.admin.v1B\n" +
 	"AdminProtoP\x01Z/github.com/datifyy/backend/gen/admin/v1;adminv1\xa2\x02\x03DAX\xaa\x02\x10Datifyy.Admin.V1\xca\x02\x10Datifyy\\Admin\\V1\xe2\x02\x1cDatifyy\\Admin\\V1\\GPBMetadata\xea\x02\x12Datifyy::Admin::V1b\x06proto3"
 
@@ -5140,8 +9272,8 @@ func file_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_admin_v1_admin_proto_rawDescData
 }
 
-var file_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_admin_v1_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
 var file_admin_v1_admin_proto_goTypes = []any{
 	(AdminRole)(0),                            // 0: datifyy.admin.v1.AdminRole
 	(DateStatus)(0),                           // 1: datifyy.admin.v1.DateStatus
@@ -5149,200 +9281,368 @@ var file_admin_v1_admin_proto_goTypes = []any{
 	(SortOrder)(0),                            // 3: datifyy.admin.v1.SortOrder
 	(UserSortField)(0),                        // 4: datifyy.admin.v1.UserSortField
 	(BulkUserAction)(0),                       // 5: datifyy.admin.v1.BulkUserAction
-	(AnalyticsPeriod)(0),                      // 6: datifyy.admin.v1.AnalyticsPeriod
-	(*AdminUser)(nil),                         // 7: datifyy.admin.v1.AdminUser
-	(*AdminTokenPair)(nil),                    // 8: datifyy.admin.v1.AdminTokenPair
-	(*ScheduledDate)(nil),                     // 9: datifyy.admin.v1.ScheduledDate
-	(*UserSummary)(nil),                       // 10: datifyy.admin.v1.UserSummary
-	(*OfflineLocation)(nil),                   // 11: datifyy.admin.v1.OfflineLocation
-	(*DateSuggestion)(nil),                    // 12: datifyy.admin.v1.DateSuggestion
-	(*AvailableSlot)(nil),                     // 13: datifyy.admin.v1.AvailableSlot
-	(*AdminLoginRequest)(nil),                 // 14: datifyy.admin.v1.AdminLoginRequest
-	(*AdminLoginResponse)(nil),                // 15: datifyy.admin.v1.AdminLoginResponse
-	(*GetAllUsersRequest)(nil),                // 16: datifyy.admin.v1.GetAllUsersRequest
-	(*GetAllUsersResponse)(nil),               // 17: datifyy.admin.v1.GetAllUsersResponse
-	(*UserFullDetails)(nil),                   // 18: datifyy.admin.v1.UserFullDetails
-	(*SearchUsersRequest)(nil),                // 19: datifyy.admin.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),               // 20: datifyy.admin.v1.SearchUsersResponse
-	(*GetUserDetailsRequest)(nil),             // 21: datifyy.admin.v1.GetUserDetailsRequest
-	(*GetUserDetailsResponse)(nil),            // 22: datifyy.admin.v1.GetUserDetailsResponse
-	(*GetDateSuggestionsRequest)(nil),         // 23: datifyy.admin.v1.GetDateSuggestionsRequest
-	(*GetDateSuggestionsResponse)(nil),        // 24: datifyy.admin.v1.GetDateSuggestionsResponse
-	(*ScheduleDateRequest)(nil),               // 25: datifyy.admin.v1.ScheduleDateRequest
-	(*ScheduleDateResponse)(nil),              // 26: datifyy.admin.v1.ScheduleDateResponse
-	(*GetCurationCandidatesRequest)(nil),      // 27: datifyy.admin.v1.GetCurationCandidatesRequest
-	(*CurationCandidate)(nil),                 // 28: datifyy.admin.v1.CurationCandidate
-	(*GetCurationCandidatesResponse)(nil),     // 29: datifyy.admin.v1.GetCurationCandidatesResponse
-	(*CurateDatesRequest)(nil),                // 30: datifyy.admin.v1.CurateDatesRequest
-	(*MatchResult)(nil),                       // 31: datifyy.admin.v1.MatchResult
-	(*CurateDatesResponse)(nil),               // 32: datifyy.admin.v1.CurateDatesResponse
-	(*UpdateCuratedMatchActionRequest)(nil),   // 33: datifyy.admin.v1.UpdateCuratedMatchActionRequest
-	(*UpdateCuratedMatchActionResponse)(nil),  // 34: datifyy.admin.v1.UpdateCuratedMatchActionResponse
-	(*GetCuratedMatchesByStatusRequest)(nil),  // 35: datifyy.admin.v1.GetCuratedMatchesByStatusRequest
-	(*CuratedMatchDetail)(nil),                // 36: datifyy.admin.v1.CuratedMatchDetail
-	(*GetCuratedMatchesByStatusResponse)(nil), // 37: datifyy.admin.v1.GetCuratedMatchesByStatusResponse
-	(*GetGenieDatesRequest)(nil),              // 38: datifyy.admin.v1.GetGenieDatesRequest
-	(*GetGenieDatesResponse)(nil),             // 39: datifyy.admin.v1.GetGenieDatesResponse
-	(*UpdateDateStatusRequest)(nil),           // 40: datifyy.admin.v1.UpdateDateStatusRequest
-	(*UpdateDateStatusResponse)(nil),          // 41: datifyy.admin.v1.UpdateDateStatusResponse
-	(*CreateAdminUserRequest)(nil),            // 42: datifyy.admin.v1.CreateAdminUserRequest
-	(*CreateAdminUserResponse)(nil),           // 43: datifyy.admin.v1.CreateAdminUserResponse
-	(*GetAllAdminsRequest)(nil),               // 44: datifyy.admin.v1.GetAllAdminsRequest
-	(*GetAllAdminsResponse)(nil),              // 45: datifyy.admin.v1.GetAllAdminsResponse
-	(*UpdateAdminRequest)(nil),                // 46: datifyy.admin.v1.UpdateAdminRequest
-	(*UpdateAdminResponse)(nil),               // 47: datifyy.admin.v1.UpdateAdminResponse
-	(*DeleteAdminRequest)(nil),                // 48: datifyy.admin.v1.DeleteAdminRequest
-	(*DeleteAdminResponse)(nil),               // 49: datifyy.admin.v1.DeleteAdminResponse
-	(*UpdateAdminProfileRequest)(nil),         // 50: datifyy.admin.v1.UpdateAdminProfileRequest
-	(*UpdateAdminProfileResponse)(nil),        // 51: datifyy.admin.v1.UpdateAdminProfileResponse
-	(*BulkUserActionRequest)(nil),             // 52: datifyy.admin.v1.BulkUserActionRequest
-	(*BulkUserActionResponse)(nil),            // 53: datifyy.admin.v1.BulkUserActionResponse
-	(*TimeRange)(nil),                         // 54: datifyy.admin.v1.TimeRange
-	(*DataPoint)(nil),                         // 55: datifyy.admin.v1.DataPoint
-	(*UserGrowthRequest)(nil),                 // 56: datifyy.admin.v1.UserGrowthRequest
-	(*UserGrowthResponse)(nil),                // 57: datifyy.admin.v1.UserGrowthResponse
-	(*ActiveUsersRequest)(nil),                // 58: datifyy.admin.v1.ActiveUsersRequest
-	(*ActiveUsersResponse)(nil),               // 59: datifyy.admin.v1.ActiveUsersResponse
-	(*SignupsRequest)(nil),                    // 60: datifyy.admin.v1.SignupsRequest
-	(*SignupsResponse)(nil),                   // 61: datifyy.admin.v1.SignupsResponse
-	(*DemographicsRequest)(nil),               // 62: datifyy.admin.v1.DemographicsRequest
-	(*DemographicsResponse)(nil),              // 63: datifyy.admin.v1.DemographicsResponse
-	(*DemographicData)(nil),                   // 64: datifyy.admin.v1.DemographicData
-	(*LocationStatsRequest)(nil),              // 65: datifyy.admin.v1.LocationStatsRequest
-	(*LocationStatsResponse)(nil),             // 66: datifyy.admin.v1.LocationStatsResponse
-	(*LocationData)(nil),                      // 67: datifyy.admin.v1.LocationData
-	(*AvailabilityStatsRequest)(nil),          // 68: datifyy.admin.v1.AvailabilityStatsRequest
-	(*AvailabilityStatsResponse)(nil),         // 69: datifyy.admin.v1.AvailabilityStatsResponse
-	(*PlatformStatsRequest)(nil),              // 70: datifyy.admin.v1.PlatformStatsRequest
-	(*PlatformStatsResponse)(nil),             // 71: datifyy.admin.v1.PlatformStatsResponse
-	(*v1.Timestamp)(nil),                      // 72: datifyy.common.v1.Timestamp
-	(*v11.UserProfile)(nil),                   // 73: datifyy.user.v1.UserProfile
-	(*v11.PartnerPreferences)(nil),            // 74: datifyy.user.v1.PartnerPreferences
+	(ReportStatus)(0),                         // 6: datifyy.admin.v1.ReportStatus
+	(ReportSeverity)(0),                       // 7: datifyy.admin.v1.ReportSeverity
+	(EnforcementAction)(0),                    // 8: datifyy.admin.v1.EnforcementAction
+	(AppealStatus)(0),                         // 9: datifyy.admin.v1.AppealStatus
+	(AnalyticsPeriod)(0),                      // 10: datifyy.admin.v1.AnalyticsPeriod
+	(*AdminUser)(nil),                         // 11: datifyy.admin.v1.AdminUser
+	(*AdminTokenPair)(nil),                    // 12: datifyy.admin.v1.AdminTokenPair
+	(*ScheduledDate)(nil),                     // 13: datifyy.admin.v1.ScheduledDate
+	(*UserSummary)(nil),                       // 14: datifyy.admin.v1.UserSummary
+	(*OfflineLocation)(nil),                   // 15: datifyy.admin.v1.OfflineLocation
+	(*DateSuggestion)(nil),                    // 16: datifyy.admin.v1.DateSuggestion
+	(*AvailableSlot)(nil),                     // 17: datifyy.admin.v1.AvailableSlot
+	(*AdminLoginRequest)(nil),                 // 18: datifyy.admin.v1.AdminLoginRequest
+	(*AdminLoginResponse)(nil),                // 19: datifyy.admin.v1.AdminLoginResponse
+	(*GetAllUsersRequest)(nil),                // 20: datifyy.admin.v1.GetAllUsersRequest
+	(*GetAllUsersResponse)(nil),               // 21: datifyy.admin.v1.GetAllUsersResponse
+	(*UserFullDetails)(nil),                   // 22: datifyy.admin.v1.UserFullDetails
+	(*SearchUsersRequest)(nil),                // 23: datifyy.admin.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),               // 24: datifyy.admin.v1.SearchUsersResponse
+	(*GetUserDetailsRequest)(nil),             // 25: datifyy.admin.v1.GetUserDetailsRequest
+	(*GetUserDetailsResponse)(nil),            // 26: datifyy.admin.v1.GetUserDetailsResponse
+	(*DateReliability)(nil),                   // 27: datifyy.admin.v1.DateReliability
+	(*ProfileChange)(nil),                     // 28: datifyy.admin.v1.ProfileChange
+	(*RevertProfileChangeRequest)(nil),        // 29: datifyy.admin.v1.RevertProfileChangeRequest
+	(*RevertProfileChangeResponse)(nil),       // 30: datifyy.admin.v1.RevertProfileChangeResponse
+	(*GetDateSuggestionsRequest)(nil),         // 31: datifyy.admin.v1.GetDateSuggestionsRequest
+	(*GetDateSuggestionsResponse)(nil),        // 32: datifyy.admin.v1.GetDateSuggestionsResponse
+	(*ScheduleDateRequest)(nil),               // 33: datifyy.admin.v1.ScheduleDateRequest
+	(*ScheduleDateResponse)(nil),              // 34: datifyy.admin.v1.ScheduleDateResponse
+	(*FindCommonAvailabilityRequest)(nil),     // 35: datifyy.admin.v1.FindCommonAvailabilityRequest
+	(*CommonAvailabilityCandidate)(nil),       // 36: datifyy.admin.v1.CommonAvailabilityCandidate
+	(*FindCommonAvailabilityResponse)(nil),    // 37: datifyy.admin.v1.FindCommonAvailabilityResponse
+	(*GetCurationCandidatesRequest)(nil),      // 38: datifyy.admin.v1.GetCurationCandidatesRequest
+	(*CurationCandidate)(nil),                 // 39: datifyy.admin.v1.CurationCandidate
+	(*GetCurationCandidatesResponse)(nil),     // 40: datifyy.admin.v1.GetCurationCandidatesResponse
+	(*CurateDatesRequest)(nil),                // 41: datifyy.admin.v1.CurateDatesRequest
+	(*MatchResult)(nil),                       // 42: datifyy.admin.v1.MatchResult
+	(*CurateDatesResponse)(nil),               // 43: datifyy.admin.v1.CurateDatesResponse
+	(*UpdateCuratedMatchActionRequest)(nil),   // 44: datifyy.admin.v1.UpdateCuratedMatchActionRequest
+	(*UpdateCuratedMatchActionResponse)(nil),  // 45: datifyy.admin.v1.UpdateCuratedMatchActionResponse
+	(*GetCuratedMatchesByStatusRequest)(nil),  // 46: datifyy.admin.v1.GetCuratedMatchesByStatusRequest
+	(*CuratedMatchDetail)(nil),                // 47: datifyy.admin.v1.CuratedMatchDetail
+	(*GetCuratedMatchesByStatusResponse)(nil), // 48: datifyy.admin.v1.GetCuratedMatchesByStatusResponse
+	(*GetGenieDatesRequest)(nil),              // 49: datifyy.admin.v1.GetGenieDatesRequest
+	(*GetGenieDatesResponse)(nil),             // 50: datifyy.admin.v1.GetGenieDatesResponse
+	(*UpdateDateStatusRequest)(nil),           // 51: datifyy.admin.v1.UpdateDateStatusRequest
+	(*UpdateDateStatusResponse)(nil),          // 52: datifyy.admin.v1.UpdateDateStatusResponse
+	(*GetDateHistoryRequest)(nil),             // 53: datifyy.admin.v1.GetDateHistoryRequest
+	(*DateActivity)(nil),                      // 54: datifyy.admin.v1.DateActivity
+	(*GetDateHistoryResponse)(nil),            // 55: datifyy.admin.v1.GetDateHistoryResponse
+	(*CreateAdminUserRequest)(nil),            // 56: datifyy.admin.v1.CreateAdminUserRequest
+	(*CreateAdminUserResponse)(nil),           // 57: datifyy.admin.v1.CreateAdminUserResponse
+	(*GetAllAdminsRequest)(nil),               // 58: datifyy.admin.v1.GetAllAdminsRequest
+	(*GetAllAdminsResponse)(nil),              // 59: datifyy.admin.v1.GetAllAdminsResponse
+	(*UpdateAdminRequest)(nil),                // 60: datifyy.admin.v1.UpdateAdminRequest
+	(*UpdateAdminResponse)(nil),               // 61: datifyy.admin.v1.UpdateAdminResponse
+	(*DeleteAdminRequest)(nil),                // 62: datifyy.admin.v1.DeleteAdminRequest
+	(*DeleteAdminResponse)(nil),               // 63: datifyy.admin.v1.DeleteAdminResponse
+	(*UpdateAdminProfileRequest)(nil),         // 64: datifyy.admin.v1.UpdateAdminProfileRequest
+	(*UpdateAdminProfileResponse)(nil),        // 65: datifyy.admin.v1.UpdateAdminProfileResponse
+	(*BulkUserActionRequest)(nil),             // 66: datifyy.admin.v1.BulkUserActionRequest
+	(*BulkUserActionResponse)(nil),            // 67: datifyy.admin.v1.BulkUserActionResponse
+	(*UserReport)(nil),                        // 68: datifyy.admin.v1.UserReport
+	(*ModerationAction)(nil),                  // 69: datifyy.admin.v1.ModerationAction
+	(*ListUserReportsRequest)(nil),            // 70: datifyy.admin.v1.ListUserReportsRequest
+	(*ListUserReportsResponse)(nil),           // 71: datifyy.admin.v1.ListUserReportsResponse
+	(*ClaimUserReportRequest)(nil),            // 72: datifyy.admin.v1.ClaimUserReportRequest
+	(*ClaimUserReportResponse)(nil),           // 73: datifyy.admin.v1.ClaimUserReportResponse
+	(*ResolveUserReportRequest)(nil),          // 74: datifyy.admin.v1.ResolveUserReportRequest
+	(*ResolveUserReportResponse)(nil),         // 75: datifyy.admin.v1.ResolveUserReportResponse
+	(*DismissUserReportRequest)(nil),          // 76: datifyy.admin.v1.DismissUserReportRequest
+	(*DismissUserReportResponse)(nil),         // 77: datifyy.admin.v1.DismissUserReportResponse
+	(*EnforcementAppeal)(nil),                 // 78: datifyy.admin.v1.EnforcementAppeal
+	(*GetUserEnforcementHistoryRequest)(nil),  // 79: datifyy.admin.v1.GetUserEnforcementHistoryRequest
+	(*GetUserEnforcementHistoryResponse)(nil), // 80: datifyy.admin.v1.GetUserEnforcementHistoryResponse
+	(*ListEnforcementAppealsRequest)(nil),     // 81: datifyy.admin.v1.ListEnforcementAppealsRequest
+	(*ListEnforcementAppealsResponse)(nil),    // 82: datifyy.admin.v1.ListEnforcementAppealsResponse
+	(*ReviewEnforcementAppealRequest)(nil),    // 83: datifyy.admin.v1.ReviewEnforcementAppealRequest
+	(*ReviewEnforcementAppealResponse)(nil),   // 84: datifyy.admin.v1.ReviewEnforcementAppealResponse
+	(*IdVerification)(nil),                    // 85: datifyy.admin.v1.IdVerification
+	(*ListIdVerificationsRequest)(nil),        // 86: datifyy.admin.v1.ListIdVerificationsRequest
+	(*ListIdVerificationsResponse)(nil),       // 87: datifyy.admin.v1.ListIdVerificationsResponse
+	(*GetIdVerificationRequest)(nil),          // 88: datifyy.admin.v1.GetIdVerificationRequest
+	(*GetIdVerificationResponse)(nil),         // 89: datifyy.admin.v1.GetIdVerificationResponse
+	(*ReviewIdVerificationRequest)(nil),       // 90: datifyy.admin.v1.ReviewIdVerificationRequest
+	(*ReviewIdVerificationResponse)(nil),      // 91: datifyy.admin.v1.ReviewIdVerificationResponse
+	(*Prompt)(nil),                            // 92: datifyy.admin.v1.Prompt
+	(*ListPromptsRequest)(nil),                // 93: datifyy.admin.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),               // 94: datifyy.admin.v1.ListPromptsResponse
+	(*CreatePromptRequest)(nil),               // 95: datifyy.admin.v1.CreatePromptRequest
+	(*CreatePromptResponse)(nil),              // 96: datifyy.admin.v1.CreatePromptResponse
+	(*UpdatePromptRequest)(nil),               // 97: datifyy.admin.v1.UpdatePromptRequest
+	(*UpdatePromptResponse)(nil),              // 98: datifyy.admin.v1.UpdatePromptResponse
+	(*DeletePromptRequest)(nil),               // 99: datifyy.admin.v1.DeletePromptRequest
+	(*DeletePromptResponse)(nil),              // 100: datifyy.admin.v1.DeletePromptResponse
+	(*TimeRange)(nil),                         // 101: datifyy.admin.v1.TimeRange
+	(*DataPoint)(nil),                         // 102: datifyy.admin.v1.DataPoint
+	(*UserGrowthRequest)(nil),                 // 103: datifyy.admin.v1.UserGrowthRequest
+	(*UserGrowthResponse)(nil),                // 104: datifyy.admin.v1.UserGrowthResponse
+	(*ActiveUsersRequest)(nil),                // 105: datifyy.admin.v1.ActiveUsersRequest
+	(*ActiveUsersResponse)(nil),               // 106: datifyy.admin.v1.ActiveUsersResponse
+	(*SignupsRequest)(nil),                    // 107: datifyy.admin.v1.SignupsRequest
+	(*SignupsResponse)(nil),                   // 108: datifyy.admin.v1.SignupsResponse
+	(*DemographicsRequest)(nil),               // 109: datifyy.admin.v1.DemographicsRequest
+	(*DemographicsResponse)(nil),              // 110: datifyy.admin.v1.DemographicsResponse
+	(*DemographicData)(nil),                   // 111: datifyy.admin.v1.DemographicData
+	(*LocationStatsRequest)(nil),              // 112: datifyy.admin.v1.LocationStatsRequest
+	(*LocationStatsResponse)(nil),             // 113: datifyy.admin.v1.LocationStatsResponse
+	(*LocationData)(nil),                      // 114: datifyy.admin.v1.LocationData
+	(*AvailabilityStatsRequest)(nil),          // 115: datifyy.admin.v1.AvailabilityStatsRequest
+	(*AvailabilityStatsResponse)(nil),         // 116: datifyy.admin.v1.AvailabilityStatsResponse
+	(*AvailabilityHeatmapRequest)(nil),        // 117: datifyy.admin.v1.AvailabilityHeatmapRequest
+	(*AvailabilityHeatmapCell)(nil),           // 118: datifyy.admin.v1.AvailabilityHeatmapCell
+	(*AvailabilityCitySummary)(nil),           // 119: datifyy.admin.v1.AvailabilityCitySummary
+	(*AvailabilityHeatmapResponse)(nil),       // 120: datifyy.admin.v1.AvailabilityHeatmapResponse
+	(*PlatformStatsRequest)(nil),              // 121: datifyy.admin.v1.PlatformStatsRequest
+	(*PlatformStatsResponse)(nil),             // 122: datifyy.admin.v1.PlatformStatsResponse
+	nil,                                       // 123: datifyy.admin.v1.Prompt.TextEntry
+	(*v1.Timestamp)(nil),                      // 124: datifyy.common.v1.Timestamp
+	(*v11.UserProfile)(nil),                   // 125: datifyy.user.v1.UserProfile
+	(*v11.PartnerPreferences)(nil),            // 126: datifyy.user.v1.PartnerPreferences
+	(*v11.HoroscopeMatch)(nil),                // 127: datifyy.user.v1.HoroscopeMatch
+	(*v11.PreferenceRuleResult)(nil),          // 128: datifyy.user.v1.PreferenceRuleResult
+	(v11.IdDocumentType)(0),                   // 129: datifyy.user.v1.IdDocumentType
+	(v11.IdVerificationStatus)(0),             // 130: datifyy.user.v1.IdVerificationStatus
+	(v11.PromptQuestion)(0),                   // 131: datifyy.user.v1.PromptQuestion
 }
 var file_admin_v1_admin_proto_depIdxs = []int32{
-	0,  // 0: datifyy.admin.v1.AdminUser.role:type_name -> datifyy.admin.v1.AdminRole
-	72, // 1: datifyy.admin.v1.AdminUser.created_at:type_name -> datifyy.common.v1.Timestamp
-	72, // 2: datifyy.admin.v1.AdminUser.last_login_at:type_name -> datifyy.common.v1.Timestamp
-	10, // 3: datifyy.admin.v1.ScheduledDate.user1:type_name -> datifyy.admin.v1.UserSummary
-	10, // 4: datifyy.admin.v1.ScheduledDate.user2:type_name -> datifyy.admin.v1.UserSummary
-	7,  // 5: datifyy.admin.v1.ScheduledDate.genie:type_name -> datifyy.admin.v1.AdminUser
-	72, // 6: datifyy.admin.v1.ScheduledDate.scheduled_time:type_name -> datifyy.common.v1.Timestamp
-	1,  // 7: datifyy.admin.v1.ScheduledDate.status:type_name -> datifyy.admin.v1.DateStatus
-	11, // 8: datifyy.admin.v1.ScheduledDate.location:type_name -> datifyy.admin.v1.OfflineLocation
-	72, // 9: datifyy.admin.v1.ScheduledDate.created_at:type_name -> datifyy.common.v1.Timestamp
-	72, // 10: datifyy.admin.v1.ScheduledDate.updated_at:type_name -> datifyy.common.v1.Timestamp
-	10, // 11: datifyy.admin.v1.DateSuggestion.user:type_name -> datifyy.admin.v1.UserSummary
-	13, // 12: datifyy.admin.v1.DateSuggestion.matching_slots:type_name -> datifyy.admin.v1.AvailableSlot
-	72, // 13: datifyy.admin.v1.AvailableSlot.start_time:type_name -> datifyy.common.v1.Timestamp
-	72, // 14: datifyy.admin.v1.AvailableSlot.end_time:type_name -> datifyy.common.v1.Timestamp
-	7,  // 15: datifyy.admin.v1.AdminLoginResponse.admin:type_name -> datifyy.admin.v1.AdminUser
-	8,  // 16: datifyy.admin.v1.AdminLoginResponse.tokens:type_name -> datifyy.admin.v1.AdminTokenPair
-	4,  // 17: datifyy.admin.v1.GetAllUsersRequest.sort_by:type_name -> datifyy.admin.v1.UserSortField
-	3,  // 18: datifyy.admin.v1.GetAllUsersRequest.sort_order:type_name -> datifyy.admin.v1.SortOrder
-	18, // 19: datifyy.admin.v1.GetAllUsersResponse.users:type_name -> datifyy.admin.v1.UserFullDetails
-	72, // 20: datifyy.admin.v1.UserFullDetails.date_of_birth:type_name -> datifyy.common.v1.Timestamp
-	72, // 21: datifyy.admin.v1.UserFullDetails.created_at:type_name -> datifyy.common.v1.Timestamp
-	72, // 22: datifyy.admin.v1.UserFullDetails.last_login_at:type_name -> datifyy.common.v1.Timestamp
-	73, // 23: datifyy.admin.v1.UserFullDetails.profile:type_name -> datifyy.user.v1.UserProfile
-	74, // 24: datifyy.admin.v1.UserFullDetails.partner_preferences:type_name -> datifyy.user.v1.PartnerPreferences
-	18, // 25: datifyy.admin.v1.SearchUsersResponse.users:type_name -> datifyy.admin.v1.UserFullDetails
-	18, // 26: datifyy.admin.v1.GetUserDetailsResponse.user:type_name -> datifyy.admin.v1.UserFullDetails
-	13, // 27: datifyy.admin.v1.GetUserDetailsResponse.availability:type_name -> datifyy.admin.v1.AvailableSlot
-	9,  // 28: datifyy.admin.v1.GetUserDetailsResponse.past_dates:type_name -> datifyy.admin.v1.ScheduledDate
-	9,  // 29: datifyy.admin.v1.GetUserDetailsResponse.upcoming_dates:type_name -> datifyy.admin.v1.ScheduledDate
-	12, // 30: datifyy.admin.v1.GetDateSuggestionsResponse.suggestions:type_name -> datifyy.admin.v1.DateSuggestion
-	72, // 31: datifyy.admin.v1.ScheduleDateRequest.scheduled_time:type_name -> datifyy.common.v1.Timestamp
-	11, // 32: datifyy.admin.v1.ScheduleDateRequest.location:type_name -> datifyy.admin.v1.OfflineLocation
-	9,  // 33: datifyy.admin.v1.ScheduleDateResponse.date:type_name -> datifyy.admin.v1.ScheduledDate
-	72, // 34: datifyy.admin.v1.CurationCandidate.next_available_date:type_name -> datifyy.common.v1.Timestamp
-	28, // 35: datifyy.admin.v1.GetCurationCandidatesResponse.candidates:type_name -> datifyy.admin.v1.CurationCandidate
-	31, // 36: datifyy.admin.v1.CurateDatesResponse.matches:type_name -> datifyy.admin.v1.MatchResult
-	2,  // 37: datifyy.admin.v1.UpdateCuratedMatchActionRequest.action:type_name -> datifyy.admin.v1.CuratedMatchAction
-	10, // 38: datifyy.admin.v1.CuratedMatchDetail.user1:type_name -> datifyy.admin.v1.UserSummary
-	10, // 39: datifyy.admin.v1.CuratedMatchDetail.user2:type_name -> datifyy.admin.v1.UserSummary
-	72, // 40: datifyy.admin.v1.CuratedMatchDetail.created_at:type_name -> datifyy.common.v1.Timestamp
-	72, // 41: datifyy.admin.v1.CuratedMatchDetail.updated_at:type_name -> datifyy.common.v1.Timestamp
-	36, // 42: datifyy.admin.v1.GetCuratedMatchesByStatusResponse.matches:type_name -> datifyy.admin.v1.CuratedMatchDetail
-	1,  // 43: datifyy.admin.v1.GetGenieDatesRequest.status_filter:type_name -> datifyy.admin.v1.DateStatus
-	9,  // 44: datifyy.admin.v1.GetGenieDatesResponse.dates:type_name -> datifyy.admin.v1.ScheduledDate
-	1,  // 45: datifyy.admin.v1.UpdateDateStatusRequest.status:type_name -> datifyy.admin.v1.DateStatus
-	9,  // 46: datifyy.admin.v1.UpdateDateStatusResponse.date:type_name -> datifyy.admin.v1.ScheduledDate
-	0,  // 47: datifyy.admin.v1.CreateAdminUserRequest.role:type_name -> datifyy.admin.v1.AdminRole
-	7,  // 48: datifyy.admin.v1.CreateAdminUserResponse.admin:type_name -> datifyy.admin.v1.AdminUser
-	7,  // 49: datifyy.admin.v1.GetAllAdminsResponse.admins:type_name -> datifyy.admin.v1.AdminUser
-	0,  // 50: datifyy.admin.v1.UpdateAdminRequest.role:type_name -> datifyy.admin.v1.AdminRole
-	7,  // 51: datifyy.admin.v1.UpdateAdminResponse.admin:type_name -> datifyy.admin.v1.AdminUser
-	7,  // 52: datifyy.admin.v1.UpdateAdminProfileResponse.admin:type_name -> datifyy.admin.v1.AdminUser
-	5,  // 53: datifyy.admin.v1.BulkUserActionRequest.action:type_name -> datifyy.admin.v1.BulkUserAction
-	72, // 54: datifyy.admin.v1.TimeRange.start_time:type_name -> datifyy.common.v1.Timestamp
-	72, // 55: datifyy.admin.v1.TimeRange.end_time:type_name -> datifyy.common.v1.Timestamp
-	72, // 56: datifyy.admin.v1.DataPoint.timestamp:type_name -> datifyy.common.v1.Timestamp
-	6,  // 57: datifyy.admin.v1.UserGrowthRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
-	54, // 58: datifyy.admin.v1.UserGrowthRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
-	55, // 59: datifyy.admin.v1.UserGrowthResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
-	6,  // 60: datifyy.admin.v1.ActiveUsersRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
-	54, // 61: datifyy.admin.v1.ActiveUsersRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
-	55, // 62: datifyy.admin.v1.ActiveUsersResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
-	6,  // 63: datifyy.admin.v1.SignupsRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
-	54, // 64: datifyy.admin.v1.SignupsRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
-	55, // 65: datifyy.admin.v1.SignupsResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
-	64, // 66: datifyy.admin.v1.DemographicsResponse.data:type_name -> datifyy.admin.v1.DemographicData
-	67, // 67: datifyy.admin.v1.LocationStatsResponse.locations:type_name -> datifyy.admin.v1.LocationData
-	14, // 68: datifyy.admin.v1.AdminService.AdminLogin:input_type -> datifyy.admin.v1.AdminLoginRequest
-	16, // 69: datifyy.admin.v1.AdminService.GetAllUsers:input_type -> datifyy.admin.v1.GetAllUsersRequest
-	19, // 70: datifyy.admin.v1.AdminService.SearchUsers:input_type -> datifyy.admin.v1.SearchUsersRequest
-	21, // 71: datifyy.admin.v1.AdminService.GetUserDetails:input_type -> datifyy.admin.v1.GetUserDetailsRequest
-	52, // 72: datifyy.admin.v1.AdminService.BulkUserAction:input_type -> datifyy.admin.v1.BulkUserActionRequest
-	23, // 73: datifyy.admin.v1.AdminService.GetDateSuggestions:input_type -> datifyy.admin.v1.GetDateSuggestionsRequest
-	25, // 74: datifyy.admin.v1.AdminService.ScheduleDate:input_type -> datifyy.admin.v1.ScheduleDateRequest
-	27, // 75: datifyy.admin.v1.AdminService.GetCurationCandidates:input_type -> datifyy.admin.v1.GetCurationCandidatesRequest
-	30, // 76: datifyy.admin.v1.AdminService.CurateDates:input_type -> datifyy.admin.v1.CurateDatesRequest
-	33, // 77: datifyy.admin.v1.AdminService.UpdateCuratedMatchAction:input_type -> datifyy.admin.v1.UpdateCuratedMatchActionRequest
-	35, // 78: datifyy.admin.v1.AdminService.GetCuratedMatchesByStatus:input_type -> datifyy.admin.v1.GetCuratedMatchesByStatusRequest
-	38, // 79: datifyy.admin.v1.AdminService.GetGenieDates:input_type -> datifyy.admin.v1.GetGenieDatesRequest
-	40, // 80: datifyy.admin.v1.AdminService.UpdateDateStatus:input_type -> datifyy.admin.v1.UpdateDateStatusRequest
-	42, // 81: datifyy.admin.v1.AdminService.CreateAdminUser:input_type -> datifyy.admin.v1.CreateAdminUserRequest
-	44, // 82: datifyy.admin.v1.AdminService.GetAllAdmins:input_type -> datifyy.admin.v1.GetAllAdminsRequest
-	46, // 83: datifyy.admin.v1.AdminService.UpdateAdmin:input_type -> datifyy.admin.v1.UpdateAdminRequest
-	48, // 84: datifyy.admin.v1.AdminService.DeleteAdmin:input_type -> datifyy.admin.v1.DeleteAdminRequest
-	50, // 85: datifyy.admin.v1.AdminService.UpdateAdminProfile:input_type -> datifyy.admin.v1.UpdateAdminProfileRequest
-	70, // 86: datifyy.admin.v1.AdminService.GetPlatformStats:input_type -> datifyy.admin.v1.PlatformStatsRequest
-	56, // 87: datifyy.admin.v1.AdminService.GetUserGrowth:input_type -> datifyy.admin.v1.UserGrowthRequest
-	58, // 88: datifyy.admin.v1.AdminService.GetActiveUsers:input_type -> datifyy.admin.v1.ActiveUsersRequest
-	60, // 89: datifyy.admin.v1.AdminService.GetSignups:input_type -> datifyy.admin.v1.SignupsRequest
-	62, // 90: datifyy.admin.v1.AdminService.GetDemographics:input_type -> datifyy.admin.v1.DemographicsRequest
-	65, // 91: datifyy.admin.v1.AdminService.GetLocationStats:input_type -> datifyy.admin.v1.LocationStatsRequest
-	68, // 92: datifyy.admin.v1.AdminService.GetAvailabilityStats:input_type -> datifyy.admin.v1.AvailabilityStatsRequest
-	15, // 93: datifyy.admin.v1.AdminService.AdminLogin:output_type -> datifyy.admin.v1.AdminLoginResponse
-	17, // 94: datifyy.admin.v1.AdminService.GetAllUsers:output_type -> datifyy.admin.v1.GetAllUsersResponse
-	20, // 95: datifyy.admin.v1.AdminService.SearchUsers:output_type -> datifyy.admin.v1.SearchUsersResponse
-	22, // 96: datifyy.admin.v1.AdminService.GetUserDetails:output_type -> datifyy.admin.v1.GetUserDetailsResponse
-	53, // 97: datifyy.admin.v1.AdminService.BulkUserAction:output_type -> datifyy.admin.v1.BulkUserActionResponse
-	24, // 98: datifyy.admin.v1.AdminService.GetDateSuggestions:output_type -> datifyy.admin.v1.GetDateSuggestionsResponse
-	26, // 99: datifyy.admin.v1.AdminService.ScheduleDate:output_type -> datifyy.admin.v1.ScheduleDateResponse
-	29, // 100: datifyy.admin.v1.AdminService.GetCurationCandidates:output_type -> datifyy.admin.v1.GetCurationCandidatesResponse
-	32, // 101: datifyy.admin.v1.AdminService.CurateDates:output_type -> datifyy.admin.v1.CurateDatesResponse
-	34, // 102: datifyy.admin.v1.AdminService.UpdateCuratedMatchAction:output_type -> datifyy.admin.v1.UpdateCuratedMatchActionResponse
-	37, // 103: datifyy.admin.v1.AdminService.GetCuratedMatchesByStatus:output_type -> datifyy.admin.v1.GetCuratedMatchesByStatusResponse
-	39, // 104: datifyy.admin.v1.AdminService.GetGenieDates:output_type -> datifyy.admin.v1.GetGenieDatesResponse
-	41, // 105: datifyy.admin.v1.AdminService.UpdateDateStatus:output_type -> datifyy.admin.v1.UpdateDateStatusResponse
-	43, // 106: datifyy.admin.v1.AdminService.CreateAdminUser:output_type -> datifyy.admin.v1.CreateAdminUserResponse
-	45, // 107: datifyy.admin.v1.AdminService.GetAllAdmins:output_type -> datifyy.admin.v1.GetAllAdminsResponse
-	47, // 108: datifyy.admin.v1.AdminService.UpdateAdmin:output_type -> datifyy.admin.v1.UpdateAdminResponse
-	49, // 109: datifyy.admin.v1.AdminService.DeleteAdmin:output_type -> datifyy.admin.v1.DeleteAdminResponse
-	51, // 110: datifyy.admin.v1.AdminService.UpdateAdminProfile:output_type -> datifyy.admin.v1.UpdateAdminProfileResponse
-	71, // 111: datifyy.admin.v1.AdminService.GetPlatformStats:output_type -> datifyy.admin.v1.PlatformStatsResponse
-	57, // 112: datifyy.admin.v1.AdminService.GetUserGrowth:output_type -> datifyy.admin.v1.UserGrowthResponse
-	59, // 113: datifyy.admin.v1.AdminService.GetActiveUsers:output_type -> datifyy.admin.v1.ActiveUsersResponse
-	61, // 114: datifyy.admin.v1.AdminService.GetSignups:output_type -> datifyy.admin.v1.SignupsResponse
-	63, // 115: datifyy.admin.v1.AdminService.GetDemographics:output_type -> datifyy.admin.v1.DemographicsResponse
-	66, // 116: datifyy.admin.v1.AdminService.GetLocationStats:output_type -> datifyy.admin.v1.LocationStatsResponse
-	69, // 117: datifyy.admin.v1.AdminService.GetAvailabilityStats:output_type -> datifyy.admin.v1.AvailabilityStatsResponse
-	93, // [93:118] is the sub-list for method output_type
-	68, // [68:93] is the sub-list for method input_type
-	68, // [68:68] is the sub-list for extension type_name
-	68, // [68:68] is the sub-list for extension extendee
-	0,  // [0:68] is the sub-list for field type_name
+	0,   // 0: datifyy.admin.v1.AdminUser.role:type_name -> datifyy.admin.v1.AdminRole
+	124, // 1: datifyy.admin.v1.AdminUser.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 2: datifyy.admin.v1.AdminUser.last_login_at:type_name -> datifyy.common.v1.Timestamp
+	14,  // 3: datifyy.admin.v1.ScheduledDate.user1:type_name -> datifyy.admin.v1.UserSummary
+	14,  // 4: datifyy.admin.v1.ScheduledDate.user2:type_name -> datifyy.admin.v1.UserSummary
+	11,  // 5: datifyy.admin.v1.ScheduledDate.genie:type_name -> datifyy.admin.v1.AdminUser
+	124, // 6: datifyy.admin.v1.ScheduledDate.scheduled_time:type_name -> datifyy.common.v1.Timestamp
+	1,   // 7: datifyy.admin.v1.ScheduledDate.status:type_name -> datifyy.admin.v1.DateStatus
+	15,  // 8: datifyy.admin.v1.ScheduledDate.location:type_name -> datifyy.admin.v1.OfflineLocation
+	124, // 9: datifyy.admin.v1.ScheduledDate.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 10: datifyy.admin.v1.ScheduledDate.updated_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 11: datifyy.admin.v1.ScheduledDate.confirmed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 12: datifyy.admin.v1.ScheduledDate.completed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 13: datifyy.admin.v1.ScheduledDate.cancelled_at:type_name -> datifyy.common.v1.Timestamp
+	14,  // 14: datifyy.admin.v1.DateSuggestion.user:type_name -> datifyy.admin.v1.UserSummary
+	17,  // 15: datifyy.admin.v1.DateSuggestion.matching_slots:type_name -> datifyy.admin.v1.AvailableSlot
+	124, // 16: datifyy.admin.v1.AvailableSlot.start_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 17: datifyy.admin.v1.AvailableSlot.end_time:type_name -> datifyy.common.v1.Timestamp
+	11,  // 18: datifyy.admin.v1.AdminLoginResponse.admin:type_name -> datifyy.admin.v1.AdminUser
+	12,  // 19: datifyy.admin.v1.AdminLoginResponse.tokens:type_name -> datifyy.admin.v1.AdminTokenPair
+	4,   // 20: datifyy.admin.v1.GetAllUsersRequest.sort_by:type_name -> datifyy.admin.v1.UserSortField
+	3,   // 21: datifyy.admin.v1.GetAllUsersRequest.sort_order:type_name -> datifyy.admin.v1.SortOrder
+	22,  // 22: datifyy.admin.v1.GetAllUsersResponse.users:type_name -> datifyy.admin.v1.UserFullDetails
+	124, // 23: datifyy.admin.v1.UserFullDetails.date_of_birth:type_name -> datifyy.common.v1.Timestamp
+	124, // 24: datifyy.admin.v1.UserFullDetails.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 25: datifyy.admin.v1.UserFullDetails.last_login_at:type_name -> datifyy.common.v1.Timestamp
+	125, // 26: datifyy.admin.v1.UserFullDetails.profile:type_name -> datifyy.user.v1.UserProfile
+	126, // 27: datifyy.admin.v1.UserFullDetails.partner_preferences:type_name -> datifyy.user.v1.PartnerPreferences
+	22,  // 28: datifyy.admin.v1.SearchUsersResponse.users:type_name -> datifyy.admin.v1.UserFullDetails
+	22,  // 29: datifyy.admin.v1.GetUserDetailsResponse.user:type_name -> datifyy.admin.v1.UserFullDetails
+	17,  // 30: datifyy.admin.v1.GetUserDetailsResponse.availability:type_name -> datifyy.admin.v1.AvailableSlot
+	13,  // 31: datifyy.admin.v1.GetUserDetailsResponse.past_dates:type_name -> datifyy.admin.v1.ScheduledDate
+	13,  // 32: datifyy.admin.v1.GetUserDetailsResponse.upcoming_dates:type_name -> datifyy.admin.v1.ScheduledDate
+	28,  // 33: datifyy.admin.v1.GetUserDetailsResponse.profile_changes:type_name -> datifyy.admin.v1.ProfileChange
+	27,  // 34: datifyy.admin.v1.GetUserDetailsResponse.reliability:type_name -> datifyy.admin.v1.DateReliability
+	124, // 35: datifyy.admin.v1.ProfileChange.reverted_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 36: datifyy.admin.v1.ProfileChange.created_at:type_name -> datifyy.common.v1.Timestamp
+	28,  // 37: datifyy.admin.v1.RevertProfileChangeResponse.change:type_name -> datifyy.admin.v1.ProfileChange
+	16,  // 38: datifyy.admin.v1.GetDateSuggestionsResponse.suggestions:type_name -> datifyy.admin.v1.DateSuggestion
+	124, // 39: datifyy.admin.v1.ScheduleDateRequest.scheduled_time:type_name -> datifyy.common.v1.Timestamp
+	15,  // 40: datifyy.admin.v1.ScheduleDateRequest.location:type_name -> datifyy.admin.v1.OfflineLocation
+	13,  // 41: datifyy.admin.v1.ScheduleDateResponse.date:type_name -> datifyy.admin.v1.ScheduledDate
+	124, // 42: datifyy.admin.v1.FindCommonAvailabilityRequest.from_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 43: datifyy.admin.v1.FindCommonAvailabilityRequest.to_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 44: datifyy.admin.v1.CommonAvailabilityCandidate.start_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 45: datifyy.admin.v1.CommonAvailabilityCandidate.end_time:type_name -> datifyy.common.v1.Timestamp
+	15,  // 46: datifyy.admin.v1.CommonAvailabilityCandidate.user1_location:type_name -> datifyy.admin.v1.OfflineLocation
+	15,  // 47: datifyy.admin.v1.CommonAvailabilityCandidate.user2_location:type_name -> datifyy.admin.v1.OfflineLocation
+	36,  // 48: datifyy.admin.v1.FindCommonAvailabilityResponse.candidates:type_name -> datifyy.admin.v1.CommonAvailabilityCandidate
+	124, // 49: datifyy.admin.v1.CurationCandidate.next_available_date:type_name -> datifyy.common.v1.Timestamp
+	127, // 50: datifyy.admin.v1.CurationCandidate.horoscope_match:type_name -> datifyy.user.v1.HoroscopeMatch
+	128, // 51: datifyy.admin.v1.CurationCandidate.preference_rules:type_name -> datifyy.user.v1.PreferenceRuleResult
+	39,  // 52: datifyy.admin.v1.GetCurationCandidatesResponse.candidates:type_name -> datifyy.admin.v1.CurationCandidate
+	128, // 53: datifyy.admin.v1.MatchResult.user_rules:type_name -> datifyy.user.v1.PreferenceRuleResult
+	128, // 54: datifyy.admin.v1.MatchResult.candidate_rules:type_name -> datifyy.user.v1.PreferenceRuleResult
+	42,  // 55: datifyy.admin.v1.CurateDatesResponse.matches:type_name -> datifyy.admin.v1.MatchResult
+	2,   // 56: datifyy.admin.v1.UpdateCuratedMatchActionRequest.action:type_name -> datifyy.admin.v1.CuratedMatchAction
+	14,  // 57: datifyy.admin.v1.CuratedMatchDetail.user1:type_name -> datifyy.admin.v1.UserSummary
+	14,  // 58: datifyy.admin.v1.CuratedMatchDetail.user2:type_name -> datifyy.admin.v1.UserSummary
+	124, // 59: datifyy.admin.v1.CuratedMatchDetail.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 60: datifyy.admin.v1.CuratedMatchDetail.updated_at:type_name -> datifyy.common.v1.Timestamp
+	47,  // 61: datifyy.admin.v1.GetCuratedMatchesByStatusResponse.matches:type_name -> datifyy.admin.v1.CuratedMatchDetail
+	1,   // 62: datifyy.admin.v1.GetGenieDatesRequest.status_filter:type_name -> datifyy.admin.v1.DateStatus
+	13,  // 63: datifyy.admin.v1.GetGenieDatesResponse.dates:type_name -> datifyy.admin.v1.ScheduledDate
+	1,   // 64: datifyy.admin.v1.UpdateDateStatusRequest.status:type_name -> datifyy.admin.v1.DateStatus
+	13,  // 65: datifyy.admin.v1.UpdateDateStatusResponse.date:type_name -> datifyy.admin.v1.ScheduledDate
+	1,   // 66: datifyy.admin.v1.DateActivity.from_status:type_name -> datifyy.admin.v1.DateStatus
+	1,   // 67: datifyy.admin.v1.DateActivity.to_status:type_name -> datifyy.admin.v1.DateStatus
+	124, // 68: datifyy.admin.v1.DateActivity.created_at:type_name -> datifyy.common.v1.Timestamp
+	13,  // 69: datifyy.admin.v1.GetDateHistoryResponse.date:type_name -> datifyy.admin.v1.ScheduledDate
+	54,  // 70: datifyy.admin.v1.GetDateHistoryResponse.activity:type_name -> datifyy.admin.v1.DateActivity
+	1,   // 71: datifyy.admin.v1.GetDateHistoryResponse.next_statuses:type_name -> datifyy.admin.v1.DateStatus
+	0,   // 72: datifyy.admin.v1.CreateAdminUserRequest.role:type_name -> datifyy.admin.v1.AdminRole
+	11,  // 73: datifyy.admin.v1.CreateAdminUserResponse.admin:type_name -> datifyy.admin.v1.AdminUser
+	11,  // 74: datifyy.admin.v1.GetAllAdminsResponse.admins:type_name -> datifyy.admin.v1.AdminUser
+	0,   // 75: datifyy.admin.v1.UpdateAdminRequest.role:type_name -> datifyy.admin.v1.AdminRole
+	11,  // 76: datifyy.admin.v1.UpdateAdminResponse.admin:type_name -> datifyy.admin.v1.AdminUser
+	11,  // 77: datifyy.admin.v1.UpdateAdminProfileResponse.admin:type_name -> datifyy.admin.v1.AdminUser
+	5,   // 78: datifyy.admin.v1.BulkUserActionRequest.action:type_name -> datifyy.admin.v1.BulkUserAction
+	6,   // 79: datifyy.admin.v1.UserReport.status:type_name -> datifyy.admin.v1.ReportStatus
+	7,   // 80: datifyy.admin.v1.UserReport.severity:type_name -> datifyy.admin.v1.ReportSeverity
+	124, // 81: datifyy.admin.v1.UserReport.claimed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 82: datifyy.admin.v1.UserReport.reviewed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 83: datifyy.admin.v1.UserReport.created_at:type_name -> datifyy.common.v1.Timestamp
+	8,   // 84: datifyy.admin.v1.ModerationAction.action:type_name -> datifyy.admin.v1.EnforcementAction
+	124, // 85: datifyy.admin.v1.ModerationAction.expires_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 86: datifyy.admin.v1.ModerationAction.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 87: datifyy.admin.v1.ModerationAction.lifted_at:type_name -> datifyy.common.v1.Timestamp
+	6,   // 88: datifyy.admin.v1.ListUserReportsRequest.status:type_name -> datifyy.admin.v1.ReportStatus
+	7,   // 89: datifyy.admin.v1.ListUserReportsRequest.severity:type_name -> datifyy.admin.v1.ReportSeverity
+	68,  // 90: datifyy.admin.v1.ListUserReportsResponse.reports:type_name -> datifyy.admin.v1.UserReport
+	68,  // 91: datifyy.admin.v1.ClaimUserReportResponse.report:type_name -> datifyy.admin.v1.UserReport
+	8,   // 92: datifyy.admin.v1.ResolveUserReportRequest.action:type_name -> datifyy.admin.v1.EnforcementAction
+	68,  // 93: datifyy.admin.v1.ResolveUserReportResponse.report:type_name -> datifyy.admin.v1.UserReport
+	69,  // 94: datifyy.admin.v1.ResolveUserReportResponse.enforcement:type_name -> datifyy.admin.v1.ModerationAction
+	68,  // 95: datifyy.admin.v1.DismissUserReportResponse.report:type_name -> datifyy.admin.v1.UserReport
+	69,  // 96: datifyy.admin.v1.EnforcementAppeal.enforcement:type_name -> datifyy.admin.v1.ModerationAction
+	9,   // 97: datifyy.admin.v1.EnforcementAppeal.status:type_name -> datifyy.admin.v1.AppealStatus
+	124, // 98: datifyy.admin.v1.EnforcementAppeal.reviewed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 99: datifyy.admin.v1.EnforcementAppeal.created_at:type_name -> datifyy.common.v1.Timestamp
+	69,  // 100: datifyy.admin.v1.GetUserEnforcementHistoryResponse.actions:type_name -> datifyy.admin.v1.ModerationAction
+	69,  // 101: datifyy.admin.v1.GetUserEnforcementHistoryResponse.active:type_name -> datifyy.admin.v1.ModerationAction
+	9,   // 102: datifyy.admin.v1.ListEnforcementAppealsRequest.status:type_name -> datifyy.admin.v1.AppealStatus
+	78,  // 103: datifyy.admin.v1.ListEnforcementAppealsResponse.appeals:type_name -> datifyy.admin.v1.EnforcementAppeal
+	78,  // 104: datifyy.admin.v1.ReviewEnforcementAppealResponse.appeal:type_name -> datifyy.admin.v1.EnforcementAppeal
+	129, // 105: datifyy.admin.v1.IdVerification.document_type:type_name -> datifyy.user.v1.IdDocumentType
+	130, // 106: datifyy.admin.v1.IdVerification.status:type_name -> datifyy.user.v1.IdVerificationStatus
+	124, // 107: datifyy.admin.v1.IdVerification.reviewed_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 108: datifyy.admin.v1.IdVerification.created_at:type_name -> datifyy.common.v1.Timestamp
+	130, // 109: datifyy.admin.v1.ListIdVerificationsRequest.status:type_name -> datifyy.user.v1.IdVerificationStatus
+	85,  // 110: datifyy.admin.v1.ListIdVerificationsResponse.verifications:type_name -> datifyy.admin.v1.IdVerification
+	85,  // 111: datifyy.admin.v1.GetIdVerificationResponse.verification:type_name -> datifyy.admin.v1.IdVerification
+	85,  // 112: datifyy.admin.v1.ReviewIdVerificationResponse.verification:type_name -> datifyy.admin.v1.IdVerification
+	123, // 113: datifyy.admin.v1.Prompt.text:type_name -> datifyy.admin.v1.Prompt.TextEntry
+	124, // 114: datifyy.admin.v1.Prompt.active_from:type_name -> datifyy.common.v1.Timestamp
+	124, // 115: datifyy.admin.v1.Prompt.active_until:type_name -> datifyy.common.v1.Timestamp
+	131, // 116: datifyy.admin.v1.Prompt.legacy_question:type_name -> datifyy.user.v1.PromptQuestion
+	124, // 117: datifyy.admin.v1.Prompt.created_at:type_name -> datifyy.common.v1.Timestamp
+	124, // 118: datifyy.admin.v1.Prompt.updated_at:type_name -> datifyy.common.v1.Timestamp
+	92,  // 119: datifyy.admin.v1.ListPromptsResponse.prompts:type_name -> datifyy.admin.v1.Prompt
+	92,  // 120: datifyy.admin.v1.CreatePromptRequest.prompt:type_name -> datifyy.admin.v1.Prompt
+	92,  // 121: datifyy.admin.v1.CreatePromptResponse.prompt:type_name -> datifyy.admin.v1.Prompt
+	92,  // 122: datifyy.admin.v1.UpdatePromptRequest.prompt:type_name -> datifyy.admin.v1.Prompt
+	92,  // 123: datifyy.admin.v1.UpdatePromptResponse.prompt:type_name -> datifyy.admin.v1.Prompt
+	92,  // 124: datifyy.admin.v1.DeletePromptResponse.prompt:type_name -> datifyy.admin.v1.Prompt
+	124, // 125: datifyy.admin.v1.TimeRange.start_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 126: datifyy.admin.v1.TimeRange.end_time:type_name -> datifyy.common.v1.Timestamp
+	124, // 127: datifyy.admin.v1.DataPoint.timestamp:type_name -> datifyy.common.v1.Timestamp
+	10,  // 128: datifyy.admin.v1.UserGrowthRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
+	101, // 129: datifyy.admin.v1.UserGrowthRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
+	102, // 130: datifyy.admin.v1.UserGrowthResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
+	10,  // 131: datifyy.admin.v1.ActiveUsersRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
+	101, // 132: datifyy.admin.v1.ActiveUsersRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
+	102, // 133: datifyy.admin.v1.ActiveUsersResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
+	10,  // 134: datifyy.admin.v1.SignupsRequest.period:type_name -> datifyy.admin.v1.AnalyticsPeriod
+	101, // 135: datifyy.admin.v1.SignupsRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
+	102, // 136: datifyy.admin.v1.SignupsResponse.data_points:type_name -> datifyy.admin.v1.DataPoint
+	111, // 137: datifyy.admin.v1.DemographicsResponse.data:type_name -> datifyy.admin.v1.DemographicData
+	114, // 138: datifyy.admin.v1.LocationStatsResponse.locations:type_name -> datifyy.admin.v1.LocationData
+	101, // 139: datifyy.admin.v1.AvailabilityHeatmapRequest.time_range:type_name -> datifyy.admin.v1.TimeRange
+	118, // 140: datifyy.admin.v1.AvailabilityHeatmapResponse.cells:type_name -> datifyy.admin.v1.AvailabilityHeatmapCell
+	119, // 141: datifyy.admin.v1.AvailabilityHeatmapResponse.cities:type_name -> datifyy.admin.v1.AvailabilityCitySummary
+	101, // 142: datifyy.admin.v1.AvailabilityHeatmapResponse.time_range:type_name -> datifyy.admin.v1.TimeRange
+	18,  // 143: datifyy.admin.v1.AdminService.AdminLogin:input_type -> datifyy.admin.v1.AdminLoginRequest
+	20,  // 144: datifyy.admin.v1.AdminService.GetAllUsers:input_type -> datifyy.admin.v1.GetAllUsersRequest
+	23,  // 145: datifyy.admin.v1.AdminService.SearchUsers:input_type -> datifyy.admin.v1.SearchUsersRequest
+	25,  // 146: datifyy.admin.v1.AdminService.GetUserDetails:input_type -> datifyy.admin.v1.GetUserDetailsRequest
+	29,  // 147: datifyy.admin.v1.AdminService.RevertProfileChange:input_type -> datifyy.admin.v1.RevertProfileChangeRequest
+	66,  // 148: datifyy.admin.v1.AdminService.BulkUserAction:input_type -> datifyy.admin.v1.BulkUserActionRequest
+	70,  // 149: datifyy.admin.v1.AdminService.ListUserReports:input_type -> datifyy.admin.v1.ListUserReportsRequest
+	72,  // 150: datifyy.admin.v1.AdminService.ClaimUserReport:input_type -> datifyy.admin.v1.ClaimUserReportRequest
+	74,  // 151: datifyy.admin.v1.AdminService.ResolveUserReport:input_type -> datifyy.admin.v1.ResolveUserReportRequest
+	76,  // 152: datifyy.admin.v1.AdminService.DismissUserReport:input_type -> datifyy.admin.v1.DismissUserReportRequest
+	79,  // 153: datifyy.admin.v1.AdminService.GetUserEnforcementHistory:input_type -> datifyy.admin.v1.GetUserEnforcementHistoryRequest
+	81,  // 154: datifyy.admin.v1.AdminService.ListEnforcementAppeals:input_type -> datifyy.admin.v1.ListEnforcementAppealsRequest
+	83,  // 155: datifyy.admin.v1.AdminService.ReviewEnforcementAppeal:input_type -> datifyy.admin.v1.ReviewEnforcementAppealRequest
+	86,  // 156: datifyy.admin.v1.AdminService.ListIdVerifications:input_type -> datifyy.admin.v1.ListIdVerificationsRequest
+	88,  // 157: datifyy.admin.v1.AdminService.GetIdVerification:input_type -> datifyy.admin.v1.GetIdVerificationRequest
+	90,  // 158: datifyy.admin.v1.AdminService.ReviewIdVerification:input_type -> datifyy.admin.v1.ReviewIdVerificationRequest
+	93,  // 159: datifyy.admin.v1.AdminService.ListPrompts:input_type -> datifyy.admin.v1.ListPromptsRequest
+	95,  // 160: datifyy.admin.v1.AdminService.CreatePrompt:input_type -> datifyy.admin.v1.CreatePromptRequest
+	97,  // 161: datifyy.admin.v1.AdminService.UpdatePrompt:input_type -> datifyy.admin.v1.UpdatePromptRequest
+	99,  // 162: datifyy.admin.v1.AdminService.DeletePrompt:input_type -> datifyy.admin.v1.DeletePromptRequest
+	31,  // 163: datifyy.admin.v1.AdminService.GetDateSuggestions:input_type -> datifyy.admin.v1.GetDateSuggestionsRequest
+	33,  // 164: datifyy.admin.v1.AdminService.ScheduleDate:input_type -> datifyy.admin.v1.ScheduleDateRequest
+	35,  // 165: datifyy.admin.v1.AdminService.FindCommonAvailability:input_type -> datifyy.admin.v1.FindCommonAvailabilityRequest
+	38,  // 166: datifyy.admin.v1.AdminService.GetCurationCandidates:input_type -> datifyy.admin.v1.GetCurationCandidatesRequest
+	41,  // 167: datifyy.admin.v1.AdminService.CurateDates:input_type -> datifyy.admin.v1.CurateDatesRequest
+	44,  // 168: datifyy.admin.v1.AdminService.UpdateCuratedMatchAction:input_type -> datifyy.admin.v1.UpdateCuratedMatchActionRequest
+	46,  // 169: datifyy.admin.v1.AdminService.GetCuratedMatchesByStatus:input_type -> datifyy.admin.v1.GetCuratedMatchesByStatusRequest
+	49,  // 170: datifyy.admin.v1.AdminService.GetGenieDates:input_type -> datifyy.admin.v1.GetGenieDatesRequest
+	51,  // 171: datifyy.admin.v1.AdminService.UpdateDateStatus:input_type -> datifyy.admin.v1.UpdateDateStatusRequest
+	53,  // 172: datifyy.admin.v1.AdminService.GetDateHistory:input_type -> datifyy.admin.v1.GetDateHistoryRequest
+	56,  // 173: datifyy.admin.v1.AdminService.CreateAdminUser:input_type -> datifyy.admin.v1.CreateAdminUserRequest
+	58,  // 174: datifyy.admin.v1.AdminService.GetAllAdmins:input_type -> datifyy.admin.v1.GetAllAdminsRequest
+	60,  // 175: datifyy.admin.v1.AdminService.UpdateAdmin:input_type -> datifyy.admin.v1.UpdateAdminRequest
+	62,  // 176: datifyy.admin.v1.AdminService.DeleteAdmin:input_type -> datifyy.admin.v1.DeleteAdminRequest
+	64,  // 177: datifyy.admin.v1.AdminService.UpdateAdminProfile:input_type -> datifyy.admin.v1.UpdateAdminProfileRequest
+	121, // 178: datifyy.admin.v1.AdminService.GetPlatformStats:input_type -> datifyy.admin.v1.PlatformStatsRequest
+	103, // 179: datifyy.admin.v1.AdminService.GetUserGrowth:input_type -> datifyy.admin.v1.UserGrowthRequest
+	105, // 180: datifyy.admin.v1.AdminService.GetActiveUsers:input_type -> datifyy.admin.v1.ActiveUsersRequest
+	107, // 181: datifyy.admin.v1.AdminService.GetSignups:input_type -> datifyy.admin.v1.SignupsRequest
+	109, // 182: datifyy.admin.v1.AdminService.GetDemographics:input_type -> datifyy.admin.v1.DemographicsRequest
+	112, // 183: datifyy.admin.v1.AdminService.GetLocationStats:input_type -> datifyy.admin.v1.LocationStatsRequest
+	115, // 184: datifyy.admin.v1.AdminService.GetAvailabilityStats:input_type -> datifyy.admin.v1.AvailabilityStatsRequest
+	117, // 185: datifyy.admin.v1.AdminService.GetAvailabilityHeatmap:input_type -> datifyy.admin.v1.AvailabilityHeatmapRequest
+	19,  // 186: datifyy.admin.v1.AdminService.AdminLogin:output_type -> datifyy.admin.v1.AdminLoginResponse
+	21,  // 187: datifyy.admin.v1.AdminService.GetAllUsers:output_type -> datifyy.admin.v1.GetAllUsersResponse
+	24,  // 188: datifyy.admin.v1.AdminService.SearchUsers:output_type -> datifyy.admin.v1.SearchUsersResponse
+	26,  // 189: datifyy.admin.v1.AdminService.GetUserDetails:output_type -> datifyy.admin.v1.GetUserDetailsResponse
+	30,  // 190: datifyy.admin.v1.AdminService.RevertProfileChange:output_type -> datifyy.admin.v1.RevertProfileChangeResponse
+	67,  // 191: datifyy.admin.v1.AdminService.BulkUserAction:output_type -> datifyy.admin.v1.BulkUserActionResponse
+	71,  // 192: datifyy.admin.v1.AdminService.ListUserReports:output_type -> datifyy.admin.v1.ListUserReportsResponse
+	73,  // 193: datifyy.admin.v1.AdminService.ClaimUserReport:output_type -> datifyy.admin.v1.ClaimUserReportResponse
+	75,  // 194: datifyy.admin.v1.AdminService.ResolveUserReport:output_type -> datifyy.admin.v1.ResolveUserReportResponse
+	77,  // 195: datifyy.admin.v1.AdminService.DismissUserReport:output_type -> datifyy.admin.v1.DismissUserReportResponse
+	80,  // 196: datifyy.admin.v1.AdminService.GetUserEnforcementHistory:output_type -> datifyy.admin.v1.GetUserEnforcementHistoryResponse
+	82,  // 197: datifyy.admin.v1.AdminService.ListEnforcementAppeals:output_type -> datifyy.admin.v1.ListEnforcementAppealsResponse
+	84,  // 198: datifyy.admin.v1.AdminService.ReviewEnforcementAppeal:output_type -> datifyy.admin.v1.ReviewEnforcementAppealResponse
+	87,  // 199: datifyy.admin.v1.AdminService.ListIdVerifications:output_type -> datifyy.admin.v1.ListIdVerificationsResponse
+	89,  // 200: datifyy.admin.v1.AdminService.GetIdVerification:output_type -> datifyy.admin.v1.GetIdVerificationResponse
+	91,  // 201: datifyy.admin.v1.AdminService.ReviewIdVerification:output_type -> datifyy.admin.v1.ReviewIdVerificationResponse
+	94,  // 202: datifyy.admin.v1.AdminService.ListPrompts:output_type -> datifyy.admin.v1.ListPromptsResponse
+	96,  // 203: datifyy.admin.v1.AdminService.CreatePrompt:output_type -> datifyy.admin.v1.CreatePromptResponse
+	98,  // 204: datifyy.admin.v1.AdminService.UpdatePrompt:output_type -> datifyy.admin.v1.UpdatePromptResponse
+	100, // 205: datifyy.admin.v1.AdminService.DeletePrompt:output_type -> datifyy.admin.v1.DeletePromptResponse
+	32,  // 206: datifyy.admin.v1.AdminService.GetDateSuggestions:output_type -> datifyy.admin.v1.GetDateSuggestionsResponse
+	34,  // 207: datifyy.admin.v1.AdminService.ScheduleDate:output_type -> datifyy.admin.v1.ScheduleDateResponse
+	37,  // 208: datifyy.admin.v1.AdminService.FindCommonAvailability:output_type -> datifyy.admin.v1.FindCommonAvailabilityResponse
+	40,  // 209: datifyy.admin.v1.AdminService.GetCurationCandidates:output_type -> datifyy.admin.v1.GetCurationCandidatesResponse
+	43,  // 210: datifyy.admin.v1.AdminService.CurateDates:output_type -> datifyy.admin.v1.CurateDatesResponse
+	45,  // 211: datifyy.admin.v1.AdminService.UpdateCuratedMatchAction:output_type -> datifyy.admin.v1.UpdateCuratedMatchActionResponse
+	48,  // 212: datifyy.admin.v1.AdminService.GetCuratedMatchesByStatus:output_type -> datifyy.admin.v1.GetCuratedMatchesByStatusResponse
+	50,  // 213: datifyy.admin.v1.AdminService.GetGenieDates:output_type -> datifyy.admin.v1.GetGenieDatesResponse
+	52,  // 214: datifyy.admin.v1.AdminService.UpdateDateStatus:output_type -> datifyy.admin.v1.UpdateDateStatusResponse
+	55,  // 215: datifyy.admin.v1.AdminService.GetDateHistory:output_type -> datifyy.admin.v1.GetDateHistoryResponse
+	57,  // 216: datifyy.admin.v1.AdminService.CreateAdminUser:output_type -> datifyy.admin.v1.CreateAdminUserResponse
+	59,  // 217: datifyy.admin.v1.AdminService.GetAllAdmins:output_type -> datifyy.admin.v1.GetAllAdminsResponse
+	61,  // 218: datifyy.admin.v1.AdminService.UpdateAdmin:output_type -> datifyy.admin.v1.UpdateAdminResponse
+	63,  // 219: datifyy.admin.v1.AdminService.DeleteAdmin:output_type -> datifyy.admin.v1.DeleteAdminResponse
+	65,  // 220: datifyy.admin.v1.AdminService.UpdateAdminProfile:output_type -> datifyy.admin.v1.UpdateAdminProfileResponse
+	122, // 221: datifyy.admin.v1.AdminService.GetPlatformStats:output_type -> datifyy.admin.v1.PlatformStatsResponse
+	104, // 222: datifyy.admin.v1.AdminService.GetUserGrowth:output_type -> datifyy.admin.v1.UserGrowthResponse
+	106, // 223: datifyy.admin.v1.AdminService.GetActiveUsers:output_type -> datifyy.admin.v1.ActiveUsersResponse
+	108, // 224: datifyy.admin.v1.AdminService.GetSignups:output_type -> datifyy.admin.v1.SignupsResponse
+	110, // 225: datifyy.admin.v1.AdminService.GetDemographics:output_type -> datifyy.admin.v1.DemographicsResponse
+	113, // 226: datifyy.admin.v1.AdminService.GetLocationStats:output_type -> datifyy.admin.v1.LocationStatsResponse
+	116, // 227: datifyy.admin.v1.AdminService.GetAvailabilityStats:output_type -> datifyy.admin.v1.AvailabilityStatsResponse
+	120, // 228: datifyy.admin.v1.AdminService.GetAvailabilityHeatmap:output_type -> datifyy.admin.v1.AvailabilityHeatmapResponse
+	186, // [186:229] is the sub-list for method output_type
+	143, // [143:186] is the sub-list for method input_type
+	143, // [143:143] is the sub-list for extension type_name
+	143, // [143:143] is the sub-list for extension extendee
+	0,   // [0:143] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_admin_proto_init() }
@@ -5355,8 +9655,8 @@ func file_admin_v1_admin_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   65,
+			NumEnums:      11,
+			NumMessages:   113,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
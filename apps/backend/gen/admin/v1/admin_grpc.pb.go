@@ -23,15 +23,32 @@ const (
 	AdminService_GetAllUsers_FullMethodName               = "/datifyy.admin.v1.AdminService/GetAllUsers"
 	AdminService_SearchUsers_FullMethodName               = "/datifyy.admin.v1.AdminService/SearchUsers"
 	AdminService_GetUserDetails_FullMethodName            = "/datifyy.admin.v1.AdminService/GetUserDetails"
+	AdminService_RevertProfileChange_FullMethodName       = "/datifyy.admin.v1.AdminService/RevertProfileChange"
 	AdminService_BulkUserAction_FullMethodName            = "/datifyy.admin.v1.AdminService/BulkUserAction"
+	AdminService_ListUserReports_FullMethodName           = "/datifyy.admin.v1.AdminService/ListUserReports"
+	AdminService_ClaimUserReport_FullMethodName           = "/datifyy.admin.v1.AdminService/ClaimUserReport"
+	AdminService_ResolveUserReport_FullMethodName         = "/datifyy.admin.v1.AdminService/ResolveUserReport"
+	AdminService_DismissUserReport_FullMethodName         = "/datifyy.admin.v1.AdminService/DismissUserReport"
+	AdminService_GetUserEnforcementHistory_FullMethodName = "/datifyy.admin.v1.AdminService/GetUserEnforcementHistory"
+	AdminService_ListEnforcementAppeals_FullMethodName    = "/datifyy.admin.v1.AdminService/ListEnforcementAppeals"
+	AdminService_ReviewEnforcementAppeal_FullMethodName   = "/datifyy.admin.v1.AdminService/ReviewEnforcementAppeal"
+	AdminService_ListIdVerifications_FullMethodName       = "/datifyy.admin.v1.AdminService/ListIdVerifications"
+	AdminService_GetIdVerification_FullMethodName         = "/datifyy.admin.v1.AdminService/GetIdVerification"
+	AdminService_ReviewIdVerification_FullMethodName      = "/datifyy.admin.v1.AdminService/ReviewIdVerification"
+	AdminService_ListPrompts_FullMethodName               = "/datifyy.admin.v1.AdminService/ListPrompts"
+	AdminService_CreatePrompt_FullMethodName              = "/datifyy.admin.v1.AdminService/CreatePrompt"
+	AdminService_UpdatePrompt_FullMethodName              = "/datifyy.admin.v1.AdminService/UpdatePrompt"
+	AdminService_DeletePrompt_FullMethodName              = "/datifyy.admin.v1.AdminService/DeletePrompt"
 	AdminService_GetDateSuggestions_FullMethodName        = "/datifyy.admin.v1.AdminService/GetDateSuggestions"
 	AdminService_ScheduleDate_FullMethodName              = "/datifyy.admin.v1.AdminService/ScheduleDate"
+	AdminService_FindCommonAvailability_FullMethodName    = "/datifyy.admin.v1.AdminService/FindCommonAvailability"
 	AdminService_GetCurationCandidates_FullMethodName     = "/datifyy.admin.v1.AdminService/GetCurationCandidates"
 	AdminService_CurateDates_FullMethodName               = "/datifyy.admin.v1.AdminService/CurateDates"
 	AdminService_UpdateCuratedMatchAction_FullMethodName  = "/datifyy.admin.v1.AdminService/UpdateCuratedMatchAction"
 	AdminService_GetCuratedMatchesByStatus_FullMethodName = "/datifyy.admin.v1.AdminService/GetCuratedMatchesByStatus"
 	AdminService_GetGenieDates_FullMethodName             = "/datifyy.admin.v1.AdminService/GetGenieDates"
 	AdminService_UpdateDateStatus_FullMethodName          = "/datifyy.admin.v1.AdminService/UpdateDateStatus"
+	AdminService_GetDateHistory_FullMethodName            = "/datifyy.admin.v1.AdminService/GetDateHistory"
 	AdminService_CreateAdminUser_FullMethodName           = "/datifyy.admin.v1.AdminService/CreateAdminUser"
 	AdminService_GetAllAdmins_FullMethodName              = "/datifyy.admin.v1.AdminService/GetAllAdmins"
 	AdminService_UpdateAdmin_FullMethodName               = "/datifyy.admin.v1.AdminService/UpdateAdmin"
@@ -44,6 +61,7 @@ const (
 	AdminService_GetDemographics_FullMethodName           = "/datifyy.admin.v1.AdminService/GetDemographics"
 	AdminService_GetLocationStats_FullMethodName          = "/datifyy.admin.v1.AdminService/GetLocationStats"
 	AdminService_GetAvailabilityStats_FullMethodName      = "/datifyy.admin.v1.AdminService/GetAvailabilityStats"
+	AdminService_GetAvailabilityHeatmap_FullMethodName    = "/datifyy.admin.v1.AdminService/GetAvailabilityHeatmap"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -56,10 +74,30 @@ type AdminServiceClient interface {
 	GetAllUsers(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	GetUserDetails(ctx context.Context, in *GetUserDetailsRequest, opts ...grpc.CallOption) (*GetUserDetailsResponse, error)
+	RevertProfileChange(ctx context.Context, in *RevertProfileChangeRequest, opts ...grpc.CallOption) (*RevertProfileChangeResponse, error)
 	BulkUserAction(ctx context.Context, in *BulkUserActionRequest, opts ...grpc.CallOption) (*BulkUserActionResponse, error)
+	// Report Moderation
+	ListUserReports(ctx context.Context, in *ListUserReportsRequest, opts ...grpc.CallOption) (*ListUserReportsResponse, error)
+	ClaimUserReport(ctx context.Context, in *ClaimUserReportRequest, opts ...grpc.CallOption) (*ClaimUserReportResponse, error)
+	ResolveUserReport(ctx context.Context, in *ResolveUserReportRequest, opts ...grpc.CallOption) (*ResolveUserReportResponse, error)
+	DismissUserReport(ctx context.Context, in *DismissUserReportRequest, opts ...grpc.CallOption) (*DismissUserReportResponse, error)
+	// Enforcement History & Appeals
+	GetUserEnforcementHistory(ctx context.Context, in *GetUserEnforcementHistoryRequest, opts ...grpc.CallOption) (*GetUserEnforcementHistoryResponse, error)
+	ListEnforcementAppeals(ctx context.Context, in *ListEnforcementAppealsRequest, opts ...grpc.CallOption) (*ListEnforcementAppealsResponse, error)
+	ReviewEnforcementAppeal(ctx context.Context, in *ReviewEnforcementAppealRequest, opts ...grpc.CallOption) (*ReviewEnforcementAppealResponse, error)
+	// Identity Verification
+	ListIdVerifications(ctx context.Context, in *ListIdVerificationsRequest, opts ...grpc.CallOption) (*ListIdVerificationsResponse, error)
+	GetIdVerification(ctx context.Context, in *GetIdVerificationRequest, opts ...grpc.CallOption) (*GetIdVerificationResponse, error)
+	ReviewIdVerification(ctx context.Context, in *ReviewIdVerificationRequest, opts ...grpc.CallOption) (*ReviewIdVerificationResponse, error)
+	// Profile Prompts Catalog
+	ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error)
+	CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error)
+	UpdatePrompt(ctx context.Context, in *UpdatePromptRequest, opts ...grpc.CallOption) (*UpdatePromptResponse, error)
+	DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error)
 	// Date Matching
 	GetDateSuggestions(ctx context.Context, in *GetDateSuggestionsRequest, opts ...grpc.CallOption) (*GetDateSuggestionsResponse, error)
 	ScheduleDate(ctx context.Context, in *ScheduleDateRequest, opts ...grpc.CallOption) (*ScheduleDateResponse, error)
+	FindCommonAvailability(ctx context.Context, in *FindCommonAvailabilityRequest, opts ...grpc.CallOption) (*FindCommonAvailabilityResponse, error)
 	// AI-Powered Date Curation
 	GetCurationCandidates(ctx context.Context, in *GetCurationCandidatesRequest, opts ...grpc.CallOption) (*GetCurationCandidatesResponse, error)
 	CurateDates(ctx context.Context, in *CurateDatesRequest, opts ...grpc.CallOption) (*CurateDatesResponse, error)
@@ -68,6 +106,7 @@ type AdminServiceClient interface {
 	// Genie Operations
 	GetGenieDates(ctx context.Context, in *GetGenieDatesRequest, opts ...grpc.CallOption) (*GetGenieDatesResponse, error)
 	UpdateDateStatus(ctx context.Context, in *UpdateDateStatusRequest, opts ...grpc.CallOption) (*UpdateDateStatusResponse, error)
+	GetDateHistory(ctx context.Context, in *GetDateHistoryRequest, opts ...grpc.CallOption) (*GetDateHistoryResponse, error)
 	// Admin User Management (Super Admin only)
 	CreateAdminUser(ctx context.Context, in *CreateAdminUserRequest, opts ...grpc.CallOption) (*CreateAdminUserResponse, error)
 	GetAllAdmins(ctx context.Context, in *GetAllAdminsRequest, opts ...grpc.CallOption) (*GetAllAdminsResponse, error)
@@ -82,6 +121,7 @@ type AdminServiceClient interface {
 	GetDemographics(ctx context.Context, in *DemographicsRequest, opts ...grpc.CallOption) (*DemographicsResponse, error)
 	GetLocationStats(ctx context.Context, in *LocationStatsRequest, opts ...grpc.CallOption) (*LocationStatsResponse, error)
 	GetAvailabilityStats(ctx context.Context, in *AvailabilityStatsRequest, opts ...grpc.CallOption) (*AvailabilityStatsResponse, error)
+	GetAvailabilityHeatmap(ctx context.Context, in *AvailabilityHeatmapRequest, opts ...grpc.CallOption) (*AvailabilityHeatmapResponse, error)
 }
 
 type adminServiceClient struct {
@@ -132,6 +172,16 @@ func (c *adminServiceClient) GetUserDetails(ctx context.Context, in *GetUserDeta
 	return out, nil
 }
 
+func (c *adminServiceClient) RevertProfileChange(ctx context.Context, in *RevertProfileChangeRequest, opts ...grpc.CallOption) (*RevertProfileChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertProfileChangeResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevertProfileChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) BulkUserAction(ctx context.Context, in *BulkUserActionRequest, opts ...grpc.CallOption) (*BulkUserActionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BulkUserActionResponse)
@@ -142,6 +192,146 @@ func (c *adminServiceClient) BulkUserAction(ctx context.Context, in *BulkUserAct
 	return out, nil
 }
 
+func (c *adminServiceClient) ListUserReports(ctx context.Context, in *ListUserReportsRequest, opts ...grpc.CallOption) (*ListUserReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserReportsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListUserReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ClaimUserReport(ctx context.Context, in *ClaimUserReportRequest, opts ...grpc.CallOption) (*ClaimUserReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimUserReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_ClaimUserReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResolveUserReport(ctx context.Context, in *ResolveUserReportRequest, opts ...grpc.CallOption) (*ResolveUserReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveUserReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_ResolveUserReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DismissUserReport(ctx context.Context, in *DismissUserReportRequest, opts ...grpc.CallOption) (*DismissUserReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissUserReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_DismissUserReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetUserEnforcementHistory(ctx context.Context, in *GetUserEnforcementHistoryRequest, opts ...grpc.CallOption) (*GetUserEnforcementHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserEnforcementHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetUserEnforcementHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListEnforcementAppeals(ctx context.Context, in *ListEnforcementAppealsRequest, opts ...grpc.CallOption) (*ListEnforcementAppealsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEnforcementAppealsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListEnforcementAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReviewEnforcementAppeal(ctx context.Context, in *ReviewEnforcementAppealRequest, opts ...grpc.CallOption) (*ReviewEnforcementAppealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewEnforcementAppealResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReviewEnforcementAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListIdVerifications(ctx context.Context, in *ListIdVerificationsRequest, opts ...grpc.CallOption) (*ListIdVerificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdVerificationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListIdVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetIdVerification(ctx context.Context, in *GetIdVerificationRequest, opts ...grpc.CallOption) (*GetIdVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIdVerificationResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetIdVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReviewIdVerification(ctx context.Context, in *ReviewIdVerificationRequest, opts ...grpc.CallOption) (*ReviewIdVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewIdVerificationResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReviewIdVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePrompt(ctx context.Context, in *CreatePromptRequest, opts ...grpc.CallOption) (*CreatePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreatePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdatePrompt(ctx context.Context, in *UpdatePromptRequest, opts ...grpc.CallOption) (*UpdatePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdatePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePrompt(ctx context.Context, in *DeletePromptRequest, opts ...grpc.CallOption) (*DeletePromptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromptResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeletePrompt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetDateSuggestions(ctx context.Context, in *GetDateSuggestionsRequest, opts ...grpc.CallOption) (*GetDateSuggestionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDateSuggestionsResponse)
@@ -162,6 +352,16 @@ func (c *adminServiceClient) ScheduleDate(ctx context.Context, in *ScheduleDateR
 	return out, nil
 }
 
+func (c *adminServiceClient) FindCommonAvailability(ctx context.Context, in *FindCommonAvailabilityRequest, opts ...grpc.CallOption) (*FindCommonAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindCommonAvailabilityResponse)
+	err := c.cc.Invoke(ctx, AdminService_FindCommonAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetCurationCandidates(ctx context.Context, in *GetCurationCandidatesRequest, opts ...grpc.CallOption) (*GetCurationCandidatesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCurationCandidatesResponse)
@@ -222,6 +422,16 @@ func (c *adminServiceClient) UpdateDateStatus(ctx context.Context, in *UpdateDat
 	return out, nil
 }
 
+func (c *adminServiceClient) GetDateHistory(ctx context.Context, in *GetDateHistoryRequest, opts ...grpc.CallOption) (*GetDateHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDateHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDateHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) CreateAdminUser(ctx context.Context, in *CreateAdminUserRequest, opts ...grpc.CallOption) (*CreateAdminUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateAdminUserResponse)
@@ -342,6 +552,16 @@ func (c *adminServiceClient) GetAvailabilityStats(ctx context.Context, in *Avail
 	return out, nil
 }
 
+func (c *adminServiceClient) GetAvailabilityHeatmap(ctx context.Context, in *AvailabilityHeatmapRequest, opts ...grpc.CallOption) (*AvailabilityHeatmapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityHeatmapResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAvailabilityHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -352,10 +572,30 @@ type AdminServiceServer interface {
 	GetAllUsers(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	GetUserDetails(context.Context, *GetUserDetailsRequest) (*GetUserDetailsResponse, error)
+	RevertProfileChange(context.Context, *RevertProfileChangeRequest) (*RevertProfileChangeResponse, error)
 	BulkUserAction(context.Context, *BulkUserActionRequest) (*BulkUserActionResponse, error)
+	// Report Moderation
+	ListUserReports(context.Context, *ListUserReportsRequest) (*ListUserReportsResponse, error)
+	ClaimUserReport(context.Context, *ClaimUserReportRequest) (*ClaimUserReportResponse, error)
+	ResolveUserReport(context.Context, *ResolveUserReportRequest) (*ResolveUserReportResponse, error)
+	DismissUserReport(context.Context, *DismissUserReportRequest) (*DismissUserReportResponse, error)
+	// Enforcement History & Appeals
+	GetUserEnforcementHistory(context.Context, *GetUserEnforcementHistoryRequest) (*GetUserEnforcementHistoryResponse, error)
+	ListEnforcementAppeals(context.Context, *ListEnforcementAppealsRequest) (*ListEnforcementAppealsResponse, error)
+	ReviewEnforcementAppeal(context.Context, *ReviewEnforcementAppealRequest) (*ReviewEnforcementAppealResponse, error)
+	// Identity Verification
+	ListIdVerifications(context.Context, *ListIdVerificationsRequest) (*ListIdVerificationsResponse, error)
+	GetIdVerification(context.Context, *GetIdVerificationRequest) (*GetIdVerificationResponse, error)
+	ReviewIdVerification(context.Context, *ReviewIdVerificationRequest) (*ReviewIdVerificationResponse, error)
+	// Profile Prompts Catalog
+	ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error)
+	CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error)
+	UpdatePrompt(context.Context, *UpdatePromptRequest) (*UpdatePromptResponse, error)
+	DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error)
 	// Date Matching
 	GetDateSuggestions(context.Context, *GetDateSuggestionsRequest) (*GetDateSuggestionsResponse, error)
 	ScheduleDate(context.Context, *ScheduleDateRequest) (*ScheduleDateResponse, error)
+	FindCommonAvailability(context.Context, *FindCommonAvailabilityRequest) (*FindCommonAvailabilityResponse, error)
 	// AI-Powered Date Curation
 	GetCurationCandidates(context.Context, *GetCurationCandidatesRequest) (*GetCurationCandidatesResponse, error)
 	CurateDates(context.Context, *CurateDatesRequest) (*CurateDatesResponse, error)
@@ -364,6 +604,7 @@ type AdminServiceServer interface {
 	// Genie Operations
 	GetGenieDates(context.Context, *GetGenieDatesRequest) (*GetGenieDatesResponse, error)
 	UpdateDateStatus(context.Context, *UpdateDateStatusRequest) (*UpdateDateStatusResponse, error)
+	GetDateHistory(context.Context, *GetDateHistoryRequest) (*GetDateHistoryResponse, error)
 	// Admin User Management (Super Admin only)
 	CreateAdminUser(context.Context, *CreateAdminUserRequest) (*CreateAdminUserResponse, error)
 	GetAllAdmins(context.Context, *GetAllAdminsRequest) (*GetAllAdminsResponse, error)
@@ -378,6 +619,7 @@ type AdminServiceServer interface {
 	GetDemographics(context.Context, *DemographicsRequest) (*DemographicsResponse, error)
 	GetLocationStats(context.Context, *LocationStatsRequest) (*LocationStatsResponse, error)
 	GetAvailabilityStats(context.Context, *AvailabilityStatsRequest) (*AvailabilityStatsResponse, error)
+	GetAvailabilityHeatmap(context.Context, *AvailabilityHeatmapRequest) (*AvailabilityHeatmapResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -400,15 +642,63 @@ func (UnimplementedAdminServiceServer) SearchUsers(context.Context, *SearchUsers
 func (UnimplementedAdminServiceServer) GetUserDetails(context.Context, *GetUserDetailsRequest) (*GetUserDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetails not implemented")
 }
+func (UnimplementedAdminServiceServer) RevertProfileChange(context.Context, *RevertProfileChangeRequest) (*RevertProfileChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertProfileChange not implemented")
+}
 func (UnimplementedAdminServiceServer) BulkUserAction(context.Context, *BulkUserActionRequest) (*BulkUserActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkUserAction not implemented")
 }
+func (UnimplementedAdminServiceServer) ListUserReports(context.Context, *ListUserReportsRequest) (*ListUserReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserReports not implemented")
+}
+func (UnimplementedAdminServiceServer) ClaimUserReport(context.Context, *ClaimUserReportRequest) (*ClaimUserReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimUserReport not implemented")
+}
+func (UnimplementedAdminServiceServer) ResolveUserReport(context.Context, *ResolveUserReportRequest) (*ResolveUserReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveUserReport not implemented")
+}
+func (UnimplementedAdminServiceServer) DismissUserReport(context.Context, *DismissUserReportRequest) (*DismissUserReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissUserReport not implemented")
+}
+func (UnimplementedAdminServiceServer) GetUserEnforcementHistory(context.Context, *GetUserEnforcementHistoryRequest) (*GetUserEnforcementHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEnforcementHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) ListEnforcementAppeals(context.Context, *ListEnforcementAppealsRequest) (*ListEnforcementAppealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnforcementAppeals not implemented")
+}
+func (UnimplementedAdminServiceServer) ReviewEnforcementAppeal(context.Context, *ReviewEnforcementAppealRequest) (*ReviewEnforcementAppealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewEnforcementAppeal not implemented")
+}
+func (UnimplementedAdminServiceServer) ListIdVerifications(context.Context, *ListIdVerificationsRequest) (*ListIdVerificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdVerifications not implemented")
+}
+func (UnimplementedAdminServiceServer) GetIdVerification(context.Context, *GetIdVerificationRequest) (*GetIdVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdVerification not implemented")
+}
+func (UnimplementedAdminServiceServer) ReviewIdVerification(context.Context, *ReviewIdVerificationRequest) (*ReviewIdVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewIdVerification not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrompts not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePrompt(context.Context, *CreatePromptRequest) (*CreatePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdatePrompt(context.Context, *UpdatePromptRequest) (*UpdatePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrompt not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePrompt(context.Context, *DeletePromptRequest) (*DeletePromptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePrompt not implemented")
+}
 func (UnimplementedAdminServiceServer) GetDateSuggestions(context.Context, *GetDateSuggestionsRequest) (*GetDateSuggestionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDateSuggestions not implemented")
 }
 func (UnimplementedAdminServiceServer) ScheduleDate(context.Context, *ScheduleDateRequest) (*ScheduleDateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDate not implemented")
 }
+func (UnimplementedAdminServiceServer) FindCommonAvailability(context.Context, *FindCommonAvailabilityRequest) (*FindCommonAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCommonAvailability not implemented")
+}
 func (UnimplementedAdminServiceServer) GetCurationCandidates(context.Context, *GetCurationCandidatesRequest) (*GetCurationCandidatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurationCandidates not implemented")
 }
@@ -427,6 +717,9 @@ func (UnimplementedAdminServiceServer) GetGenieDates(context.Context, *GetGenieD
 func (UnimplementedAdminServiceServer) UpdateDateStatus(context.Context, *UpdateDateStatusRequest) (*UpdateDateStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDateStatus not implemented")
 }
+func (UnimplementedAdminServiceServer) GetDateHistory(context.Context, *GetDateHistoryRequest) (*GetDateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDateHistory not implemented")
+}
 func (UnimplementedAdminServiceServer) CreateAdminUser(context.Context, *CreateAdminUserRequest) (*CreateAdminUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAdminUser not implemented")
 }
@@ -463,6 +756,9 @@ func (UnimplementedAdminServiceServer) GetLocationStats(context.Context, *Locati
 func (UnimplementedAdminServiceServer) GetAvailabilityStats(context.Context, *AvailabilityStatsRequest) (*AvailabilityStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityStats not implemented")
 }
+func (UnimplementedAdminServiceServer) GetAvailabilityHeatmap(context.Context, *AvailabilityHeatmapRequest) (*AvailabilityHeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityHeatmap not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -556,6 +852,24 @@ func _AdminService_GetUserDetails_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RevertProfileChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertProfileChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevertProfileChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevertProfileChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevertProfileChange(ctx, req.(*RevertProfileChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_BulkUserAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BulkUserActionRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +888,258 @@ func _AdminService_BulkUserAction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListUserReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListUserReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListUserReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListUserReports(ctx, req.(*ListUserReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ClaimUserReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimUserReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ClaimUserReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ClaimUserReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ClaimUserReport(ctx, req.(*ClaimUserReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResolveUserReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveUserReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResolveUserReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResolveUserReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResolveUserReport(ctx, req.(*ResolveUserReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DismissUserReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissUserReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DismissUserReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DismissUserReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DismissUserReport(ctx, req.(*DismissUserReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetUserEnforcementHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEnforcementHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetUserEnforcementHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetUserEnforcementHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetUserEnforcementHistory(ctx, req.(*GetUserEnforcementHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListEnforcementAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnforcementAppealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListEnforcementAppeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListEnforcementAppeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListEnforcementAppeals(ctx, req.(*ListEnforcementAppealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReviewEnforcementAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewEnforcementAppealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReviewEnforcementAppeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReviewEnforcementAppeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReviewEnforcementAppeal(ctx, req.(*ReviewEnforcementAppealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListIdVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListIdVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListIdVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListIdVerifications(ctx, req.(*ListIdVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetIdVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetIdVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetIdVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetIdVerification(ctx, req.(*GetIdVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReviewIdVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewIdVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReviewIdVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReviewIdVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReviewIdVerification(ctx, req.(*ReviewIdVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPrompts(ctx, req.(*ListPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePrompt(ctx, req.(*CreatePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdatePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdatePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdatePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdatePrompt(ctx, req.(*UpdatePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeletePrompt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeletePrompt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeletePrompt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeletePrompt(ctx, req.(*DeletePromptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetDateSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDateSuggestionsRequest)
 	if err := dec(in); err != nil {
@@ -610,6 +1176,24 @@ func _AdminService_ScheduleDate_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_FindCommonAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCommonAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).FindCommonAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_FindCommonAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).FindCommonAvailability(ctx, req.(*FindCommonAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetCurationCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurationCandidatesRequest)
 	if err := dec(in); err != nil {
@@ -718,6 +1302,24 @@ func _AdminService_UpdateDateStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetDateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDateHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDateHistory(ctx, req.(*GetDateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_CreateAdminUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAdminUserRequest)
 	if err := dec(in); err != nil {
@@ -934,6 +1536,24 @@ func _AdminService_GetAvailabilityStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetAvailabilityHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AvailabilityHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAvailabilityHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAvailabilityHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAvailabilityHeatmap(ctx, req.(*AvailabilityHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -957,10 +1577,70 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserDetails",
 			Handler:    _AdminService_GetUserDetails_Handler,
 		},
+		{
+			MethodName: "RevertProfileChange",
+			Handler:    _AdminService_RevertProfileChange_Handler,
+		},
 		{
 			MethodName: "BulkUserAction",
 			Handler:    _AdminService_BulkUserAction_Handler,
 		},
+		{
+			MethodName: "ListUserReports",
+			Handler:    _AdminService_ListUserReports_Handler,
+		},
+		{
+			MethodName: "ClaimUserReport",
+			Handler:    _AdminService_ClaimUserReport_Handler,
+		},
+		{
+			MethodName: "ResolveUserReport",
+			Handler:    _AdminService_ResolveUserReport_Handler,
+		},
+		{
+			MethodName: "DismissUserReport",
+			Handler:    _AdminService_DismissUserReport_Handler,
+		},
+		{
+			MethodName: "GetUserEnforcementHistory",
+			Handler:    _AdminService_GetUserEnforcementHistory_Handler,
+		},
+		{
+			MethodName: "ListEnforcementAppeals",
+			Handler:    _AdminService_ListEnforcementAppeals_Handler,
+		},
+		{
+			MethodName: "ReviewEnforcementAppeal",
+			Handler:    _AdminService_ReviewEnforcementAppeal_Handler,
+		},
+		{
+			MethodName: "ListIdVerifications",
+			Handler:    _AdminService_ListIdVerifications_Handler,
+		},
+		{
+			MethodName: "GetIdVerification",
+			Handler:    _AdminService_GetIdVerification_Handler,
+		},
+		{
+			MethodName: "ReviewIdVerification",
+			Handler:    _AdminService_ReviewIdVerification_Handler,
+		},
+		{
+			MethodName: "ListPrompts",
+			Handler:    _AdminService_ListPrompts_Handler,
+		},
+		{
+			MethodName: "CreatePrompt",
+			Handler:    _AdminService_CreatePrompt_Handler,
+		},
+		{
+			MethodName: "UpdatePrompt",
+			Handler:    _AdminService_UpdatePrompt_Handler,
+		},
+		{
+			MethodName: "DeletePrompt",
+			Handler:    _AdminService_DeletePrompt_Handler,
+		},
 		{
 			MethodName: "GetDateSuggestions",
 			Handler:    _AdminService_GetDateSuggestions_Handler,
@@ -969,6 +1649,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ScheduleDate",
 			Handler:    _AdminService_ScheduleDate_Handler,
 		},
+		{
+			MethodName: "FindCommonAvailability",
+			Handler:    _AdminService_FindCommonAvailability_Handler,
+		},
 		{
 			MethodName: "GetCurationCandidates",
 			Handler:    _AdminService_GetCurationCandidates_Handler,
@@ -993,6 +1677,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDateStatus",
 			Handler:    _AdminService_UpdateDateStatus_Handler,
 		},
+		{
+			MethodName: "GetDateHistory",
+			Handler:    _AdminService_GetDateHistory_Handler,
+		},
 		{
 			MethodName: "CreateAdminUser",
 			Handler:    _AdminService_CreateAdminUser_Handler,
@@ -1041,6 +1729,10 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailabilityStats",
 			Handler:    _AdminService_GetAvailabilityStats_Handler,
 		},
+		{
+			MethodName: "GetAvailabilityHeatmap",
+			Handler:    _AdminService_GetAvailabilityHeatmap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/v1/admin.proto",
@@ -315,9 +315,11 @@ type LoginWithEmailResponse struct {
 	// Authentication tokens
 	Tokens *TokenPair `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 	// Session information
-	Session       *SessionInfo `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Session *SessionInfo `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	// Whether this login cancelled a pending account deletion
+	AccountRestored bool `protobuf:"varint,4,opt,name=account_restored,json=accountRestored,proto3" json:"account_restored,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LoginWithEmailResponse) Reset() {
@@ -371,6 +373,13 @@ func (x *LoginWithEmailResponse) GetSession() *SessionInfo {
 	return nil
 }
 
+func (x *LoginWithEmailResponse) GetAccountRestored() bool {
+	if x != nil {
+		return x.AccountRestored
+	}
+	return false
+}
+
 type RequestPhoneOTPRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Phone number in E.164 format
@@ -530,9 +539,11 @@ type LoginWithPhoneResponse struct {
 	// Authentication tokens
 	Tokens *TokenPair `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 	// Session information
-	Session       *SessionInfo `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Session *SessionInfo `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	// Whether this login cancelled a pending account deletion
+	AccountRestored bool `protobuf:"varint,4,opt,name=account_restored,json=accountRestored,proto3" json:"account_restored,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LoginWithPhoneResponse) Reset() {
@@ -586,6 +597,13 @@ func (x *LoginWithPhoneResponse) GetSession() *SessionInfo {
 	return nil
 }
 
+func (x *LoginWithPhoneResponse) GetAccountRestored() bool {
+	if x != nil {
+		return x.AccountRestored
+	}
+	return false
+}
+
 type LoginWithOAuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Credentials   *OAuthCredentials      `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
@@ -639,9 +657,11 @@ type LoginWithOAuthResponse struct {
 	// Session information
 	Session *SessionInfo `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
 	// Whether this is a new user
-	IsNewUser     bool `protobuf:"varint,4,opt,name=is_new_user,json=isNewUser,proto3" json:"is_new_user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IsNewUser bool `protobuf:"varint,4,opt,name=is_new_user,json=isNewUser,proto3" json:"is_new_user,omitempty"`
+	// Whether this login cancelled a pending account deletion
+	AccountRestored bool `protobuf:"varint,5,opt,name=account_restored,json=accountRestored,proto3" json:"account_restored,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LoginWithOAuthResponse) Reset() {
@@ -702,6 +722,13 @@ func (x *LoginWithOAuthResponse) GetIsNewUser() bool {
 	return false
 }
 
+func (x *LoginWithOAuthResponse) GetAccountRestored() bool {
+	if x != nil {
+		return x.AccountRestored
+	}
+	return false
+}
+
 type RefreshTokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Refresh token
@@ -2694,11 +2721,12 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"tempUserId\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\"d\n" +
 	"\x15LoginWithEmailRequest\x12K\n" +
-	"\vcredentials\x18\x01 \x01(\v2).datifyy.auth.v1.EmailPasswordCredentialsR\vcredentials\"\xb6\x01\n" +
+	"\vcredentials\x18\x01 \x01(\v2).datifyy.auth.v1.EmailPasswordCredentialsR\vcredentials\"\xe1\x01\n" +
 	"\x16LoginWithEmailResponse\x120\n" +
 	"\x04user\x18\x01 \x01(\v2\x1c.datifyy.auth.v1.UserProfileR\x04user\x122\n" +
 	"\x06tokens\x18\x02 \x01(\v2\x1a.datifyy.auth.v1.TokenPairR\x06tokens\x126\n" +
-	"\asession\x18\x03 \x01(\v2\x1c.datifyy.auth.v1.SessionInfoR\asession\"y\n" +
+	"\asession\x18\x03 \x01(\v2\x1c.datifyy.auth.v1.SessionInfoR\asession\x12)\n" +
+	"\x10account_restored\x18\x04 \x01(\bR\x0faccountRestored\"y\n" +
 	"\x16RequestPhoneOTPRequest\x12!\n" +
 	"\fphone_number\x18\x01 \x01(\tR\vphoneNumber\x12<\n" +
 	"\vdevice_info\x18\x02 \x01(\v2\x1b.datifyy.auth.v1.DeviceInfoR\n" +
@@ -2707,18 +2735,20 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\fverification\x18\x01 \x01(\v2!.datifyy.auth.v1.VerificationCodeR\fverification\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"_\n" +
 	"\x15LoginWithPhoneRequest\x12F\n" +
-	"\vcredentials\x18\x01 \x01(\v2$.datifyy.auth.v1.PhoneOTPCredentialsR\vcredentials\"\xb6\x01\n" +
+	"\vcredentials\x18\x01 \x01(\v2$.datifyy.auth.v1.PhoneOTPCredentialsR\vcredentials\"\xe1\x01\n" +
 	"\x16LoginWithPhoneResponse\x120\n" +
 	"\x04user\x18\x01 \x01(\v2\x1c.datifyy.auth.v1.UserProfileR\x04user\x122\n" +
 	"\x06tokens\x18\x02 \x01(\v2\x1a.datifyy.auth.v1.TokenPairR\x06tokens\x126\n" +
-	"\asession\x18\x03 \x01(\v2\x1c.datifyy.auth.v1.SessionInfoR\asession\"\\\n" +
+	"\asession\x18\x03 \x01(\v2\x1c.datifyy.auth.v1.SessionInfoR\asession\x12)\n" +
+	"\x10account_restored\x18\x04 \x01(\bR\x0faccountRestored\"\\\n" +
 	"\x15LoginWithOAuthRequest\x12C\n" +
-	"\vcredentials\x18\x01 \x01(\v2!.datifyy.auth.v1.OAuthCredentialsR\vcredentials\"\xd6\x01\n" +
+	"\vcredentials\x18\x01 \x01(\v2!.datifyy.auth.v1.OAuthCredentialsR\vcredentials\"\x81\x02\n" +
 	"\x16LoginWithOAuthResponse\x120\n" +
 	"\x04user\x18\x01 \x01(\v2\x1c.datifyy.auth.v1.UserProfileR\x04user\x122\n" +
 	"\x06tokens\x18\x02 \x01(\v2\x1a.datifyy.auth.v1.TokenPairR\x06tokens\x126\n" +
 	"\asession\x18\x03 \x01(\v2\x1c.datifyy.auth.v1.SessionInfoR\asession\x12\x1e\n" +
-	"\vis_new_user\x18\x04 \x01(\bR\tisNewUser\"x\n" +
+	"\vis_new_user\x18\x04 \x01(\bR\tisNewUser\x12)\n" +
+	"\x10account_restored\x18\x05 \x01(\bR\x0faccountRestored\"x\n" +
 	"\x13RefreshTokenRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12<\n" +
 	"\vdevice_info\x18\x02 \x01(\v2\x1b.datifyy.auth.v1.DeviceInfoR\n" +
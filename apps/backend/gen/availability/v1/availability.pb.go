@@ -75,6 +75,56 @@ func (DateType) EnumDescriptor() ([]byte, []int) {
 	return file_availability_v1_availability_proto_rawDescGZIP(), []int{0}
 }
 
+// How a bulk submission handles items that fail
+type SubmitMode int32
+
+const (
+	SubmitMode_SUBMIT_MODE_UNSPECIFIED    SubmitMode = 0 // Same as SUBMIT_MODE_BEST_EFFORT
+	SubmitMode_SUBMIT_MODE_BEST_EFFORT    SubmitMode = 1 // Create every valid item, report the rest
+	SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING SubmitMode = 2 // Create nothing if any item fails
+)
+
+// Enum value maps for SubmitMode.
+var (
+	SubmitMode_name = map[int32]string{
+		0: "SUBMIT_MODE_UNSPECIFIED",
+		1: "SUBMIT_MODE_BEST_EFFORT",
+		2: "SUBMIT_MODE_ALL_OR_NOTHING",
+	}
+	SubmitMode_value = map[string]int32{
+		"SUBMIT_MODE_UNSPECIFIED":    0,
+		"SUBMIT_MODE_BEST_EFFORT":    1,
+		"SUBMIT_MODE_ALL_OR_NOTHING": 2,
+	}
+)
+
+func (x SubmitMode) Enum() *SubmitMode {
+	p := new(SubmitMode)
+	*p = x
+	return p
+}
+
+func (x SubmitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_availability_v1_availability_proto_enumTypes[1].Descriptor()
+}
+
+func (SubmitMode) Type() protoreflect.EnumType {
+	return &file_availability_v1_availability_proto_enumTypes[1]
+}
+
+func (x SubmitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmitMode.Descriptor instead.
+func (SubmitMode) EnumDescriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{1}
+}
+
 // Offline location details for in-person dates
 type OfflineLocation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +272,15 @@ type AvailabilitySlot struct {
 	// Created timestamp
 	CreatedAt *v1.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Updated timestamp
-	UpdatedAt     *v1.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *v1.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Recurring rule this slot is an occurrence of (empty for one-off slots)
+	RuleId string `protobuf:"bytes,10,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Start and end as local wall-clock times (YYYY-MM-DDTHH:MM) in the
+	// response's timezone
+	LocalStartTime string `protobuf:"bytes,11,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string `protobuf:"bytes,12,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	// Whether a scheduled date has reserved this slot
+	Reserved      bool `protobuf:"varint,13,opt,name=reserved,proto3" json:"reserved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -320,37 +378,70 @@ func (x *AvailabilitySlot) GetUpdatedAt() *v1.Timestamp {
 	return nil
 }
 
-// Input slot for submission (without generated fields)
-type AvailabilitySlotInput struct {
+func (x *AvailabilitySlot) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+func (x *AvailabilitySlot) GetReserved() bool {
+	if x != nil {
+		return x.Reserved
+	}
+	return false
+}
+
+// A busy period imported from the user's own calendar. Exclusions take
+// precedence over slots when finding times to meet.
+type AvailabilityExclusion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique exclusion ID
+	ExclusionId string `protobuf:"bytes,1,opt,name=exclusion_id,json=exclusionId,proto3" json:"exclusion_id,omitempty"`
 	// Start datetime (Unix timestamp in seconds)
-	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	// End datetime (Unix timestamp in seconds)
-	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Type of date (online/offline/offline_event)
-	DateType DateType `protobuf:"varint,3,opt,name=date_type,json=dateType,proto3,enum=datifyy.availability.v1.DateType" json:"date_type,omitempty"`
-	// Offline location details (required for OFFLINE and OFFLINE_EVENT types)
-	OfflineLocation *OfflineLocation `protobuf:"bytes,4,opt,name=offline_location,json=offlineLocation,proto3" json:"offline_location,omitempty"`
-	// Optional notes for the slot
-	Notes         string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AvailabilitySlotInput) Reset() {
-	*x = AvailabilitySlotInput{}
+	EndTime int64 `protobuf:"varint,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Where the exclusion came from (e.g. "ics_import")
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// UID of the imported calendar component
+	ExternalUid string `protobuf:"bytes,5,opt,name=external_uid,json=externalUid,proto3" json:"external_uid,omitempty"`
+	// Start and end as local wall-clock times (YYYY-MM-DDTHH:MM) in the
+	// response's timezone
+	LocalStartTime string `protobuf:"bytes,6,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string `protobuf:"bytes,7,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AvailabilityExclusion) Reset() {
+	*x = AvailabilityExclusion{}
 	mi := &file_availability_v1_availability_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvailabilitySlotInput) String() string {
+func (x *AvailabilityExclusion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvailabilitySlotInput) ProtoMessage() {}
+func (*AvailabilityExclusion) ProtoMessage() {}
 
-func (x *AvailabilitySlotInput) ProtoReflect() protoreflect.Message {
+func (x *AvailabilityExclusion) ProtoReflect() protoreflect.Message {
 	mi := &file_availability_v1_availability_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,75 +453,110 @@ func (x *AvailabilitySlotInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvailabilitySlotInput.ProtoReflect.Descriptor instead.
-func (*AvailabilitySlotInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailabilityExclusion.ProtoReflect.Descriptor instead.
+func (*AvailabilityExclusion) Descriptor() ([]byte, []int) {
 	return file_availability_v1_availability_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AvailabilitySlotInput) GetStartTime() int64 {
+func (x *AvailabilityExclusion) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+func (x *AvailabilityExclusion) GetStartTime() int64 {
 	if x != nil {
 		return x.StartTime
 	}
 	return 0
 }
 
-func (x *AvailabilitySlotInput) GetEndTime() int64 {
+func (x *AvailabilityExclusion) GetEndTime() int64 {
 	if x != nil {
 		return x.EndTime
 	}
 	return 0
 }
 
-func (x *AvailabilitySlotInput) GetDateType() DateType {
+func (x *AvailabilityExclusion) GetSource() string {
 	if x != nil {
-		return x.DateType
+		return x.Source
 	}
-	return DateType_DATE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *AvailabilitySlotInput) GetOfflineLocation() *OfflineLocation {
+func (x *AvailabilityExclusion) GetExternalUid() string {
 	if x != nil {
-		return x.OfflineLocation
+		return x.ExternalUid
 	}
-	return nil
+	return ""
 }
 
-func (x *AvailabilitySlotInput) GetNotes() string {
+func (x *AvailabilityExclusion) GetLocalStartTime() string {
 	if x != nil {
-		return x.Notes
+		return x.LocalStartTime
 	}
 	return ""
 }
 
-// Get availability request
-type GetAvailabilityRequest struct {
+func (x *AvailabilityExclusion) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+// A recurring availability rule. Its occurrences are expanded server-side
+// into availability slots over a rolling window.
+type AvailabilityRule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional user ID (if not provided, returns current user's availability)
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Optional start time filter (Unix timestamp)
-	FromTime int64 `protobuf:"varint,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
-	// Optional end time filter (Unix timestamp)
-	ToTime int64 `protobuf:"varint,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
-	// Pagination
-	Pagination    *v1.PaginationRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Unique rule ID
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// User ID who created this rule
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// RFC 5545 recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=SA" or
+	// "FREQ=MONTHLY;BYDAY=1SA;COUNT=6". Supports FREQ (DAILY/WEEKLY/MONTHLY),
+	// INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and WKST=MO.
+	Rrule string `protobuf:"bytes,3,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Start of the first occurrence (Unix timestamp in seconds)
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Length of each occurrence in minutes (30 to 720)
+	DurationMinutes int32 `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// IANA timezone whose wall-clock time every occurrence keeps
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Starts of excluded occurrences (Unix timestamps in seconds)
+	Exdates []int64 `protobuf:"varint,7,rep,packed,name=exdates,proto3" json:"exdates,omitempty"`
+	// Type of date (online/offline/offline_event)
+	DateType DateType `protobuf:"varint,8,opt,name=date_type,json=dateType,proto3,enum=datifyy.availability.v1.DateType" json:"date_type,omitempty"`
+	// Offline location details (required for OFFLINE and OFFLINE_EVENT types)
+	OfflineLocation *OfflineLocation `protobuf:"bytes,9,opt,name=offline_location,json=offlineLocation,proto3" json:"offline_location,omitempty"`
+	// Optional notes copied onto each occurrence
+	Notes string `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Occurrences have been expanded into slots up to this time (Unix timestamp)
+	ExpandedUntil int64 `protobuf:"varint,11,opt,name=expanded_until,json=expandedUntil,proto3" json:"expanded_until,omitempty"`
+	// Created timestamp
+	CreatedAt *v1.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Updated timestamp
+	UpdatedAt     *v1.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailabilityRequest) Reset() {
-	*x = GetAvailabilityRequest{}
+func (x *AvailabilityRule) Reset() {
+	*x = AvailabilityRule{}
 	mi := &file_availability_v1_availability_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailabilityRequest) String() string {
+func (x *AvailabilityRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailabilityRequest) ProtoMessage() {}
+func (*AvailabilityRule) ProtoMessage() {}
 
-func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+func (x *AvailabilityRule) ProtoReflect() protoreflect.Message {
 	mi := &file_availability_v1_availability_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -442,170 +568,144 @@ func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AvailabilityRule.ProtoReflect.Descriptor instead.
+func (*AvailabilityRule) Descriptor() ([]byte, []int) {
 	return file_availability_v1_availability_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetAvailabilityRequest) GetUserId() string {
+func (x *AvailabilityRule) GetRuleId() string {
 	if x != nil {
-		return x.UserId
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *GetAvailabilityRequest) GetFromTime() int64 {
+func (x *AvailabilityRule) GetUserId() string {
 	if x != nil {
-		return x.FromTime
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAvailabilityRequest) GetToTime() int64 {
+func (x *AvailabilityRule) GetRrule() string {
 	if x != nil {
-		return x.ToTime
+		return x.Rrule
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAvailabilityRequest) GetPagination() *v1.PaginationRequest {
+func (x *AvailabilityRule) GetStartTime() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.StartTime
 	}
-	return nil
-}
-
-// Get availability response
-type GetAvailabilityResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of availability slots
-	Slots []*AvailabilitySlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
-	// Pagination info
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetAvailabilityResponse) Reset() {
-	*x = GetAvailabilityResponse{}
-	mi := &file_availability_v1_availability_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetAvailabilityResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetAvailabilityResponse) ProtoMessage() {}
-
-func (x *GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_availability_v1_availability_proto_msgTypes[4]
+func (x *AvailabilityRule) GetDurationMinutes() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DurationMinutes
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_availability_v1_availability_proto_rawDescGZIP(), []int{4}
+	return 0
 }
 
-func (x *GetAvailabilityResponse) GetSlots() []*AvailabilitySlot {
+func (x *AvailabilityRule) GetTimezone() string {
 	if x != nil {
-		return x.Slots
+		return x.Timezone
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAvailabilityResponse) GetPagination() *v1.PaginationResponse {
+func (x *AvailabilityRule) GetExdates() []int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Exdates
 	}
 	return nil
 }
 
-// Submit availability request (bulk create)
-type SubmitAvailabilityRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of availability slots to create
-	Slots         []*AvailabilitySlotInput `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AvailabilityRule) GetDateType() DateType {
+	if x != nil {
+		return x.DateType
+	}
+	return DateType_DATE_TYPE_UNSPECIFIED
 }
 
-func (x *SubmitAvailabilityRequest) Reset() {
-	*x = SubmitAvailabilityRequest{}
-	mi := &file_availability_v1_availability_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AvailabilityRule) GetOfflineLocation() *OfflineLocation {
+	if x != nil {
+		return x.OfflineLocation
+	}
+	return nil
 }
 
-func (x *SubmitAvailabilityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AvailabilityRule) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
 }
 
-func (*SubmitAvailabilityRequest) ProtoMessage() {}
-
-func (x *SubmitAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_availability_v1_availability_proto_msgTypes[5]
+func (x *AvailabilityRule) GetExpandedUntil() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExpandedUntil
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SubmitAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*SubmitAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_availability_v1_availability_proto_rawDescGZIP(), []int{5}
+func (x *AvailabilityRule) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *SubmitAvailabilityRequest) GetSlots() []*AvailabilitySlotInput {
+func (x *AvailabilityRule) GetUpdatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.Slots
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-// Submit availability response
-type SubmitAvailabilityResponse struct {
+// Input rule for submission (without generated fields)
+type AvailabilityRuleInput struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Successfully created slots
-	CreatedSlots []*AvailabilitySlot `protobuf:"bytes,1,rep,name=created_slots,json=createdSlots,proto3" json:"created_slots,omitempty"`
-	// Number of slots created
-	CreatedCount int32 `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
-	// Validation errors for rejected slots (slot index -> error message)
-	ValidationErrors map[int32]string `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Success message
-	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SubmitAvailabilityResponse) Reset() {
-	*x = SubmitAvailabilityResponse{}
-	mi := &file_availability_v1_availability_proto_msgTypes[6]
+	// RFC 5545 recurrence rule (see AvailabilityRule.rrule)
+	Rrule string `protobuf:"bytes,1,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// Start of the first occurrence (Unix timestamp in seconds)
+	StartTime int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Length of each occurrence in minutes (30 to 720)
+	DurationMinutes int32 `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// IANA timezone whose wall-clock time every occurrence keeps (defaults to
+	// the request's timezone)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Starts of occurrences to exclude (Unix timestamps in seconds)
+	Exdates []int64 `protobuf:"varint,5,rep,packed,name=exdates,proto3" json:"exdates,omitempty"`
+	// Type of date (online/offline/offline_event)
+	DateType DateType `protobuf:"varint,6,opt,name=date_type,json=dateType,proto3,enum=datifyy.availability.v1.DateType" json:"date_type,omitempty"`
+	// Offline location details (required for OFFLINE and OFFLINE_EVENT types)
+	OfflineLocation *OfflineLocation `protobuf:"bytes,7,opt,name=offline_location,json=offlineLocation,proto3" json:"offline_location,omitempty"`
+	// Optional notes copied onto each occurrence
+	Notes string `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Start of the first occurrence as a local wall-clock time
+	// (YYYY-MM-DDTHH:MM) in timezone, instead of start_time
+	LocalStartTime string `protobuf:"bytes,9,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AvailabilityRuleInput) Reset() {
+	*x = AvailabilityRuleInput{}
+	mi := &file_availability_v1_availability_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAvailabilityResponse) String() string {
+func (x *AvailabilityRuleInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAvailabilityResponse) ProtoMessage() {}
+func (*AvailabilityRuleInput) ProtoMessage() {}
 
-func (x *SubmitAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_availability_v1_availability_proto_msgTypes[6]
+func (x *AvailabilityRuleInput) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,63 +716,1170 @@ func (x *SubmitAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*SubmitAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_availability_v1_availability_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AvailabilityRuleInput.ProtoReflect.Descriptor instead.
+func (*AvailabilityRuleInput) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SubmitAvailabilityResponse) GetCreatedSlots() []*AvailabilitySlot {
+func (x *AvailabilityRuleInput) GetRrule() string {
 	if x != nil {
-		return x.CreatedSlots
+		return x.Rrule
 	}
-	return nil
+	return ""
 }
 
-func (x *SubmitAvailabilityResponse) GetCreatedCount() int32 {
+func (x *AvailabilityRuleInput) GetStartTime() int64 {
 	if x != nil {
-		return x.CreatedCount
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *SubmitAvailabilityResponse) GetValidationErrors() map[int32]string {
+func (x *AvailabilityRuleInput) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.ValidationErrors
+		return x.DurationMinutes
 	}
-	return nil
+	return 0
 }
 
-func (x *SubmitAvailabilityResponse) GetMessage() string {
+func (x *AvailabilityRuleInput) GetTimezone() string {
 	if x != nil {
-		return x.Message
+		return x.Timezone
 	}
 	return ""
 }
 
-// Delete availability request
-type DeleteAvailabilityRequest struct {
+func (x *AvailabilityRuleInput) GetExdates() []int64 {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *AvailabilityRuleInput) GetDateType() DateType {
+	if x != nil {
+		return x.DateType
+	}
+	return DateType_DATE_TYPE_UNSPECIFIED
+}
+
+func (x *AvailabilityRuleInput) GetOfflineLocation() *OfflineLocation {
+	if x != nil {
+		return x.OfflineLocation
+	}
+	return nil
+}
+
+func (x *AvailabilityRuleInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AvailabilityRuleInput) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+// Input slot for submission (without generated fields)
+type AvailabilitySlotInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start datetime (Unix timestamp in seconds)
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End datetime (Unix timestamp in seconds, 30 minutes to 12 hours after start)
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Type of date (online/offline/offline_event)
+	DateType DateType `protobuf:"varint,3,opt,name=date_type,json=dateType,proto3,enum=datifyy.availability.v1.DateType" json:"date_type,omitempty"`
+	// Offline location details (required for OFFLINE and OFFLINE_EVENT types)
+	OfflineLocation *OfflineLocation `protobuf:"bytes,4,opt,name=offline_location,json=offlineLocation,proto3" json:"offline_location,omitempty"`
+	// Optional notes for the slot
+	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Start and end as local wall-clock times (YYYY-MM-DDTHH:MM) in the
+	// request's timezone, instead of start_time and end_time. Times skipped by a
+	// daylight saving change are rejected; repeated ones use the first instance.
+	LocalStartTime string `protobuf:"bytes,6,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	LocalEndTime   string `protobuf:"bytes,7,opt,name=local_end_time,json=localEndTime,proto3" json:"local_end_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AvailabilitySlotInput) Reset() {
+	*x = AvailabilitySlotInput{}
+	mi := &file_availability_v1_availability_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilitySlotInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilitySlotInput) ProtoMessage() {}
+
+func (x *AvailabilitySlotInput) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilitySlotInput.ProtoReflect.Descriptor instead.
+func (*AvailabilitySlotInput) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AvailabilitySlotInput) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AvailabilitySlotInput) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *AvailabilitySlotInput) GetDateType() DateType {
+	if x != nil {
+		return x.DateType
+	}
+	return DateType_DATE_TYPE_UNSPECIFIED
+}
+
+func (x *AvailabilitySlotInput) GetOfflineLocation() *OfflineLocation {
+	if x != nil {
+		return x.OfflineLocation
+	}
+	return nil
+}
+
+func (x *AvailabilitySlotInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AvailabilitySlotInput) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *AvailabilitySlotInput) GetLocalEndTime() string {
+	if x != nil {
+		return x.LocalEndTime
+	}
+	return ""
+}
+
+// Get availability request
+type GetAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional user ID (if not provided, returns current user's availability)
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional start time filter (Unix timestamp)
+	FromTime int64 `protobuf:"varint,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	// Optional end time filter (Unix timestamp)
+	ToTime int64 `protobuf:"varint,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// Pagination
+	Pagination *v1.PaginationRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Optional IANA timezone to render local times in (defaults to the
+	// requesting user's timezone preference)
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityRequest) Reset() {
+	*x = GetAvailabilityRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAvailabilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAvailabilityRequest) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *GetAvailabilityRequest) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *GetAvailabilityRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetAvailabilityRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Get availability response
+type GetAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of availability slots, including expanded occurrences of rules
+	Slots []*AvailabilitySlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// Pagination info
+	Pagination *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Recurring availability rules
+	Rules []*AvailabilityRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	// IANA timezone the slots' local times are rendered in
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Busy periods imported from the user's calendar
+	Exclusions    []*AvailabilityExclusion `protobuf:"bytes,5,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityResponse) Reset() {
+	*x = GetAvailabilityResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAvailabilityResponse) GetSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *GetAvailabilityResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetAvailabilityResponse) GetRules() []*AvailabilityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetAvailabilityResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAvailabilityResponse) GetExclusions() []*AvailabilityExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+// Submit availability request (bulk create)
+type SubmitAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of availability slots to create
+	Slots []*AvailabilitySlotInput `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	// List of recurring availability rules to create
+	Rules []*AvailabilityRuleInput `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Optional IANA timezone for local times (defaults to the user's timezone
+	// preference)
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// How items that fail are handled (defaults to best effort)
+	Mode          SubmitMode `protobuf:"varint,4,opt,name=mode,proto3,enum=datifyy.availability.v1.SubmitMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAvailabilityRequest) Reset() {
+	*x = SubmitAvailabilityRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAvailabilityRequest) ProtoMessage() {}
+
+func (x *SubmitAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubmitAvailabilityRequest) GetSlots() []*AvailabilitySlotInput {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityRequest) GetRules() []*AvailabilityRuleInput {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SubmitAvailabilityRequest) GetMode() SubmitMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SubmitMode_SUBMIT_MODE_UNSPECIFIED
+}
+
+// Outcome of one slot or rule of a bulk submission
+type SubmitItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index of the item in the request
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Whether the item was created
+	Created bool `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Why the item wasn't created
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Created slot (slot items)
+	Slot *AvailabilitySlot `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Created rule (rule items)
+	Rule          *AvailabilityRule `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitItemResult) Reset() {
+	*x = SubmitItemResult{}
+	mi := &file_availability_v1_availability_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitItemResult) ProtoMessage() {}
+
+func (x *SubmitItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitItemResult.ProtoReflect.Descriptor instead.
+func (*SubmitItemResult) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubmitItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SubmitItemResult) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *SubmitItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SubmitItemResult) GetSlot() *AvailabilitySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *SubmitItemResult) GetRule() *AvailabilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Submit availability response
+type SubmitAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Successfully created slots
+	CreatedSlots []*AvailabilitySlot `protobuf:"bytes,1,rep,name=created_slots,json=createdSlots,proto3" json:"created_slots,omitempty"`
+	// Number of slots created
+	CreatedCount int32 `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	// Validation errors for rejected slots (slot index -> error message)
+	ValidationErrors map[int32]string `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Success message
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Successfully created rules
+	CreatedRules []*AvailabilityRule `protobuf:"bytes,5,rep,name=created_rules,json=createdRules,proto3" json:"created_rules,omitempty"`
+	// Occurrences expanded from the created rules
+	ExpandedSlots []*AvailabilitySlot `protobuf:"bytes,6,rep,name=expanded_slots,json=expandedSlots,proto3" json:"expanded_slots,omitempty"`
+	// Validation errors for rejected rules (rule index -> error message)
+	RuleValidationErrors map[int32]string `protobuf:"bytes,7,rep,name=rule_validation_errors,json=ruleValidationErrors,proto3" json:"rule_validation_errors,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Outcome of each slot, in request order
+	SlotResults []*SubmitItemResult `protobuf:"bytes,8,rep,name=slot_results,json=slotResults,proto3" json:"slot_results,omitempty"`
+	// Outcome of each rule, in request order
+	RuleResults []*SubmitItemResult `protobuf:"bytes,9,rep,name=rule_results,json=ruleResults,proto3" json:"rule_results,omitempty"`
+	// True when an all-or-nothing submission was rejected and nothing was created
+	RolledBack    bool `protobuf:"varint,10,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAvailabilityResponse) Reset() {
+	*x = SubmitAvailabilityResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAvailabilityResponse) ProtoMessage() {}
+
+func (x *SubmitAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubmitAvailabilityResponse) GetCreatedSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.CreatedSlots
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *SubmitAvailabilityResponse) GetValidationErrors() map[int32]string {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitAvailabilityResponse) GetCreatedRules() []*AvailabilityRule {
+	if x != nil {
+		return x.CreatedRules
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetExpandedSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.ExpandedSlots
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetRuleValidationErrors() map[int32]string {
+	if x != nil {
+		return x.RuleValidationErrors
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetSlotResults() []*SubmitItemResult {
+	if x != nil {
+		return x.SlotResults
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetRuleResults() []*SubmitItemResult {
+	if x != nil {
+		return x.RuleResults
+	}
+	return nil
+}
+
+func (x *SubmitAvailabilityResponse) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+// Delete availability request
+type DeleteAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Slot ID to delete. Deleting an occurrence of a rule excludes it from the rule.
+	// Slots reserved by a scheduled date can't be deleted.
+	SlotId string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Rule ID to delete, along with its upcoming occurrences (instead of slot_id).
+	// Occurrences reserved by a scheduled date are kept.
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Exclusion ID to delete (instead of slot_id)
+	ExclusionId   string `protobuf:"bytes,3,opt,name=exclusion_id,json=exclusionId,proto3" json:"exclusion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAvailabilityRequest) Reset() {
+	*x = DeleteAvailabilityRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAvailabilityRequest) ProtoMessage() {}
+
+func (x *DeleteAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteAvailabilityRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *DeleteAvailabilityRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *DeleteAvailabilityRequest) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+// Delete availability response
+type DeleteAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success flag
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAvailabilityResponse) Reset() {
+	*x = DeleteAvailabilityResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAvailabilityResponse) ProtoMessage() {}
+
+func (x *DeleteAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteAvailabilityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Update availability request
+type UpdateAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Slot ID to update. Updating an occurrence of a rule detaches it from the
+	// rule.
+	SlotId string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// New values for the slot
+	Slot *AvailabilitySlotInput `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Optional IANA timezone for local times (defaults to the user's timezone
+	// preference)
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAvailabilityRequest) Reset() {
+	*x = UpdateAvailabilityRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAvailabilityRequest) ProtoMessage() {}
+
+func (x *UpdateAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateAvailabilityRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *UpdateAvailabilityRequest) GetSlot() *AvailabilitySlotInput {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *UpdateAvailabilityRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Update availability response
+type UpdateAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated slot
+	Slot *AvailabilitySlot `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAvailabilityResponse) Reset() {
+	*x = UpdateAvailabilityResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAvailabilityResponse) ProtoMessage() {}
+
+func (x *UpdateAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateAvailabilityResponse) GetSlot() *AvailabilitySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *UpdateAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Clear availability request. Slots overlapping [from_time, to_time) that
+// haven't started are deleted, and recurring rules skip their occurrences in
+// the range.
+type ClearAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the range (Unix timestamp in seconds)
+	FromTime int64 `protobuf:"varint,1,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	// End of the range (Unix timestamp in seconds)
+	ToTime int64 `protobuf:"varint,2,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	// Range as local wall-clock times (YYYY-MM-DDTHH:MM) in timezone, instead
+	// of from_time and to_time
+	LocalFromTime string `protobuf:"bytes,3,opt,name=local_from_time,json=localFromTime,proto3" json:"local_from_time,omitempty"`
+	LocalToTime   string `protobuf:"bytes,4,opt,name=local_to_time,json=localToTime,proto3" json:"local_to_time,omitempty"`
+	// Optional IANA timezone for local times (defaults to the user's timezone
+	// preference)
+	Timezone      string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAvailabilityRequest) Reset() {
+	*x = ClearAvailabilityRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAvailabilityRequest) ProtoMessage() {}
+
+func (x *ClearAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ClearAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ClearAvailabilityRequest) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *ClearAvailabilityRequest) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *ClearAvailabilityRequest) GetLocalFromTime() string {
+	if x != nil {
+		return x.LocalFromTime
+	}
+	return ""
+}
+
+func (x *ClearAvailabilityRequest) GetLocalToTime() string {
+	if x != nil {
+		return x.LocalToTime
+	}
+	return ""
+}
+
+func (x *ClearAvailabilityRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Clear availability response
+type ClearAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of slots deleted
+	DeletedCount int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	// Slots in the range kept because a scheduled date reserves them
+	ReservedSlots []*AvailabilitySlot `protobuf:"bytes,2,rep,name=reserved_slots,json=reservedSlots,proto3" json:"reserved_slots,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAvailabilityResponse) Reset() {
+	*x = ClearAvailabilityResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAvailabilityResponse) ProtoMessage() {}
+
+func (x *ClearAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ClearAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearAvailabilityResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *ClearAvailabilityResponse) GetReservedSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.ReservedSlots
+	}
+	return nil
+}
+
+func (x *ClearAvailabilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Create calendar feed request
+type CreateCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
+
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{17}
+}
+
+// Create calendar feed response
+type CreateCalendarFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret feed URL to subscribe to from a calendar app. It is only shown
+	// once; creating a new feed invalidates it.
+	FeedUrl string `protobuf:"bytes,1,opt,name=feed_url,json=feedUrl,proto3" json:"feed_url,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedResponse) Reset() {
+	*x = CreateCalendarFeedResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedResponse) ProtoMessage() {}
+
+func (x *CreateCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateCalendarFeedResponse) GetFeedUrl() string {
+	if x != nil {
+		return x.FeedUrl
+	}
+	return ""
+}
+
+func (x *CreateCalendarFeedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Revoke calendar feed request
+type RevokeCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCalendarFeedRequest) Reset() {
+	*x = RevokeCalendarFeedRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCalendarFeedRequest) ProtoMessage() {}
+
+func (x *RevokeCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{19}
+}
+
+// Revoke calendar feed response
+type RevokeCalendarFeedResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Slot ID to delete
-	SlotId        string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Whether there was a feed to revoke
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAvailabilityRequest) Reset() {
-	*x = DeleteAvailabilityRequest{}
-	mi := &file_availability_v1_availability_proto_msgTypes[7]
+func (x *RevokeCalendarFeedResponse) Reset() {
+	*x = RevokeCalendarFeedResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAvailabilityRequest) String() string {
+func (x *RevokeCalendarFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAvailabilityRequest) ProtoMessage() {}
+func (*RevokeCalendarFeedResponse) ProtoMessage() {}
 
-func (x *DeleteAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_availability_v1_availability_proto_msgTypes[7]
+func (x *RevokeCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,44 +1890,125 @@ func (x *DeleteAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_availability_v1_availability_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RevokeCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteAvailabilityRequest) GetSlotId() string {
+func (x *RevokeCalendarFeedResponse) GetSuccess() bool {
 	if x != nil {
-		return x.SlotId
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeCalendarFeedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-// Delete availability response
-type DeleteAvailabilityResponse struct {
+// Import calendar request. Busy VEVENTs (including recurring ones) and
+// VFREEBUSY periods in the coming months become availability exclusions.
+type ImportCalendarRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success flag
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// iCalendar (.ics) file contents
+	IcsData string `protobuf:"bytes,1,opt,name=ics_data,json=icsData,proto3" json:"ics_data,omitempty"`
+	// Optional IANA timezone for floating times and all-day events (defaults to
+	// the user's timezone preference)
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Remove previously imported exclusions that aren't in this file
+	ReplaceExisting bool `protobuf:"varint,3,opt,name=replace_existing,json=replaceExisting,proto3" json:"replace_existing,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportCalendarRequest) Reset() {
+	*x = ImportCalendarRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCalendarRequest) ProtoMessage() {}
+
+func (x *ImportCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportCalendarRequest) GetIcsData() string {
+	if x != nil {
+		return x.IcsData
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportCalendarRequest) GetReplaceExisting() bool {
+	if x != nil {
+		return x.ReplaceExisting
+	}
+	return false
+}
+
+// Import calendar response
+type ImportCalendarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of busy periods imported
+	ImportedCount int32 `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// Number of previously imported exclusions removed (replace_existing)
+	RemovedCount int32 `protobuf:"varint,2,opt,name=removed_count,json=removedCount,proto3" json:"removed_count,omitempty"`
+	// Imported exclusions
+	Exclusions []*AvailabilityExclusion `protobuf:"bytes,3,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	// The user's slots that overlap an imported busy period
+	ConflictingSlots []*AvailabilitySlot `protobuf:"bytes,4,rep,name=conflicting_slots,json=conflictingSlots,proto3" json:"conflicting_slots,omitempty"`
+	// Components that were skipped and why
+	Warnings []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAvailabilityResponse) Reset() {
-	*x = DeleteAvailabilityResponse{}
-	mi := &file_availability_v1_availability_proto_msgTypes[8]
+func (x *ImportCalendarResponse) Reset() {
+	*x = ImportCalendarResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAvailabilityResponse) String() string {
+func (x *ImportCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAvailabilityResponse) ProtoMessage() {}
+func (*ImportCalendarResponse) ProtoMessage() {}
 
-func (x *DeleteAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_availability_v1_availability_proto_msgTypes[8]
+func (x *ImportCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,19 +2019,232 @@ func (x *DeleteAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_availability_v1_availability_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ImportCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ImportCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteAvailabilityResponse) GetSuccess() bool {
+func (x *ImportCalendarResponse) GetImportedCount() int32 {
 	if x != nil {
-		return x.Success
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetRemovedCount() int32 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+func (x *ImportCalendarResponse) GetExclusions() []*AvailabilityExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *ImportCalendarResponse) GetConflictingSlots() []*AvailabilitySlot {
+	if x != nil {
+		return x.ConflictingSlots
+	}
+	return nil
+}
+
+func (x *ImportCalendarResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportCalendarResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get availability status request
+type GetAvailabilityStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional IANA timezone to render local times in (defaults to the user's
+	// timezone preference)
+	Timezone      string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityStatusRequest) Reset() {
+	*x = GetAvailabilityStatusRequest{}
+	mi := &file_availability_v1_availability_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityStatusRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityStatusRequest) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAvailabilityStatusRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Get availability status response
+type GetAvailabilityStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the user has ever submitted availability
+	HasSubmitted bool `protobuf:"varint,1,opt,name=has_submitted,json=hasSubmitted,proto3" json:"has_submitted,omitempty"`
+	// Number of upcoming slots not reserved by a date. Users without any
+	// aren't considered for new matches.
+	OpenSlotCount int32 `protobuf:"varint,2,opt,name=open_slot_count,json=openSlotCount,proto3" json:"open_slot_count,omitempty"`
+	// Number of upcoming slots reserved by a scheduled date
+	ReservedSlotCount int32 `protobuf:"varint,3,opt,name=reserved_slot_count,json=reservedSlotCount,proto3" json:"reserved_slot_count,omitempty"`
+	// Number of recurring availability rules
+	RuleCount int32 `protobuf:"varint,4,opt,name=rule_count,json=ruleCount,proto3" json:"rule_count,omitempty"`
+	// Start of the earliest and end of the latest open slot (Unix timestamps
+	// in seconds, 0 if there are none)
+	NextAvailableTime int64 `protobuf:"varint,5,opt,name=next_available_time,json=nextAvailableTime,proto3" json:"next_available_time,omitempty"`
+	AvailableUntil    int64 `protobuf:"varint,6,opt,name=available_until,json=availableUntil,proto3" json:"available_until,omitempty"`
+	// The same as local wall-clock times (YYYY-MM-DDTHH:MM) in the response's
+	// timezone
+	LocalNextAvailableTime string `protobuf:"bytes,7,opt,name=local_next_available_time,json=localNextAvailableTime,proto3" json:"local_next_available_time,omitempty"`
+	LocalAvailableUntil    string `protobuf:"bytes,8,opt,name=local_available_until,json=localAvailableUntil,proto3" json:"local_available_until,omitempty"`
+	// Whether the user should be prompted to add availability: they have no
+	// open slots, or the last one is less than a week away
+	NeedsAvailability bool `protobuf:"varint,9,opt,name=needs_availability,json=needsAvailability,proto3" json:"needs_availability,omitempty"`
+	// IANA timezone the local times are rendered in
+	Timezone string `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Message to show the user
+	Message       string `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityStatusResponse) Reset() {
+	*x = GetAvailabilityStatusResponse{}
+	mi := &file_availability_v1_availability_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityStatusResponse) ProtoMessage() {}
+
+func (x *GetAvailabilityStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_availability_v1_availability_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityStatusResponse) Descriptor() ([]byte, []int) {
+	return file_availability_v1_availability_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetAvailabilityStatusResponse) GetHasSubmitted() bool {
+	if x != nil {
+		return x.HasSubmitted
 	}
 	return false
 }
 
-func (x *DeleteAvailabilityResponse) GetMessage() string {
+func (x *GetAvailabilityStatusResponse) GetOpenSlotCount() int32 {
+	if x != nil {
+		return x.OpenSlotCount
+	}
+	return 0
+}
+
+func (x *GetAvailabilityStatusResponse) GetReservedSlotCount() int32 {
+	if x != nil {
+		return x.ReservedSlotCount
+	}
+	return 0
+}
+
+func (x *GetAvailabilityStatusResponse) GetRuleCount() int32 {
+	if x != nil {
+		return x.RuleCount
+	}
+	return 0
+}
+
+func (x *GetAvailabilityStatusResponse) GetNextAvailableTime() int64 {
+	if x != nil {
+		return x.NextAvailableTime
+	}
+	return 0
+}
+
+func (x *GetAvailabilityStatusResponse) GetAvailableUntil() int64 {
+	if x != nil {
+		return x.AvailableUntil
+	}
+	return 0
+}
+
+func (x *GetAvailabilityStatusResponse) GetLocalNextAvailableTime() string {
+	if x != nil {
+		return x.LocalNextAvailableTime
+	}
+	return ""
+}
+
+func (x *GetAvailabilityStatusResponse) GetLocalAvailableUntil() string {
+	if x != nil {
+		return x.LocalAvailableUntil
+	}
+	return ""
+}
+
+func (x *GetAvailabilityStatusResponse) GetNeedsAvailability() bool {
+	if x != nil {
+		return x.NeedsAvailability
+	}
+	return false
+}
+
+func (x *GetAvailabilityStatusResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAvailabilityStatusResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -767,7 +2268,7 @@ const file_availability_v1_availability_proto_rawDesc = "" +
 	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12&\n" +
 	"\x0fgoogle_place_id\x18\t \x01(\tR\rgooglePlaceId\x12&\n" +
 	"\x0fgoogle_maps_url\x18\n" +
-	" \x01(\tR\rgoogleMapsUrl\"\xa3\x03\n" +
+	" \x01(\tR\rgoogleMapsUrl\"\xa8\x04\n" +
 	"\x10AvailabilitySlot\x12\x17\n" +
 	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
@@ -780,50 +2281,188 @@ const file_availability_v1_availability_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12;\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\"\xfc\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\x12\x17\n" +
+	"\arule_id\x18\n" +
+	" \x01(\tR\x06ruleId\x12(\n" +
+	"\x10local_start_time\x18\v \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\f \x01(\tR\flocalEndTime\x12\x1a\n" +
+	"\breserved\x18\r \x01(\bR\breserved\"\xff\x01\n" +
+	"\x15AvailabilityExclusion\x12!\n" +
+	"\fexclusion_id\x18\x01 \x01(\tR\vexclusionId\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x03R\aendTime\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12!\n" +
+	"\fexternal_uid\x18\x05 \x01(\tR\vexternalUid\x12(\n" +
+	"\x10local_start_time\x18\x06 \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\a \x01(\tR\flocalEndTime\"\xa6\x04\n" +
+	"\x10AvailabilityRule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05rrule\x18\x03 \x01(\tR\x05rrule\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x03R\tstartTime\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12\x18\n" +
+	"\aexdates\x18\a \x03(\x03R\aexdates\x12>\n" +
+	"\tdate_type\x18\b \x01(\x0e2!.datifyy.availability.v1.DateTypeR\bdateType\x12S\n" +
+	"\x10offline_location\x18\t \x01(\v2(.datifyy.availability.v1.OfflineLocationR\x0fofflineLocation\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x12%\n" +
+	"\x0eexpanded_until\x18\v \x01(\x03R\rexpandedUntil\x12;\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12;\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1c.datifyy.common.v1.TimestampR\tupdatedAt\"\x82\x03\n" +
+	"\x15AvailabilityRuleInput\x12\x14\n" +
+	"\x05rrule\x18\x01 \x01(\tR\x05rrule\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x03R\tstartTime\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x18\n" +
+	"\aexdates\x18\x05 \x03(\x03R\aexdates\x12>\n" +
+	"\tdate_type\x18\x06 \x01(\x0e2!.datifyy.availability.v1.DateTypeR\bdateType\x12S\n" +
+	"\x10offline_location\x18\a \x01(\v2(.datifyy.availability.v1.OfflineLocationR\x0fofflineLocation\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12(\n" +
+	"\x10local_start_time\x18\t \x01(\tR\x0elocalStartTime\"\xcc\x02\n" +
 	"\x15AvailabilitySlotInput\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\x03R\tstartTime\x12\x19\n" +
 	"\bend_time\x18\x02 \x01(\x03R\aendTime\x12>\n" +
 	"\tdate_type\x18\x03 \x01(\x0e2!.datifyy.availability.v1.DateTypeR\bdateType\x12S\n" +
 	"\x10offline_location\x18\x04 \x01(\v2(.datifyy.availability.v1.OfflineLocationR\x0fofflineLocation\x12\x14\n" +
-	"\x05notes\x18\x05 \x01(\tR\x05notes\"\xad\x01\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12(\n" +
+	"\x10local_start_time\x18\x06 \x01(\tR\x0elocalStartTime\x12$\n" +
+	"\x0elocal_end_time\x18\a \x01(\tR\flocalEndTime\"\xc9\x01\n" +
 	"\x16GetAvailabilityRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tfrom_time\x18\x02 \x01(\x03R\bfromTime\x12\x17\n" +
 	"\ato_time\x18\x03 \x01(\x03R\x06toTime\x12D\n" +
 	"\n" +
 	"pagination\x18\x04 \x01(\v2$.datifyy.common.v1.PaginationRequestR\n" +
-	"pagination\"\xa1\x01\n" +
+	"pagination\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\xce\x02\n" +
 	"\x17GetAvailabilityResponse\x12?\n" +
 	"\x05slots\x18\x01 \x03(\v2).datifyy.availability.v1.AvailabilitySlotR\x05slots\x12E\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2%.datifyy.common.v1.PaginationResponseR\n" +
-	"pagination\"a\n" +
+	"pagination\x12?\n" +
+	"\x05rules\x18\x03 \x03(\v2).datifyy.availability.v1.AvailabilityRuleR\x05rules\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12N\n" +
+	"\n" +
+	"exclusions\x18\x05 \x03(\v2..datifyy.availability.v1.AvailabilityExclusionR\n" +
+	"exclusions\"\xfc\x01\n" +
 	"\x19SubmitAvailabilityRequest\x12D\n" +
-	"\x05slots\x18\x01 \x03(\v2..datifyy.availability.v1.AvailabilitySlotInputR\x05slots\"\xe8\x02\n" +
+	"\x05slots\x18\x01 \x03(\v2..datifyy.availability.v1.AvailabilitySlotInputR\x05slots\x12D\n" +
+	"\x05rules\x18\x02 \x03(\v2..datifyy.availability.v1.AvailabilityRuleInputR\x05rules\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x127\n" +
+	"\x04mode\x18\x04 \x01(\x0e2#.datifyy.availability.v1.SubmitModeR\x04mode\"\xd6\x01\n" +
+	"\x10SubmitItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12=\n" +
+	"\x04slot\x18\x04 \x01(\v2).datifyy.availability.v1.AvailabilitySlotR\x04slot\x12=\n" +
+	"\x04rule\x18\x05 \x01(\v2).datifyy.availability.v1.AvailabilityRuleR\x04rule\"\x96\a\n" +
 	"\x1aSubmitAvailabilityResponse\x12N\n" +
 	"\rcreated_slots\x18\x01 \x03(\v2).datifyy.availability.v1.AvailabilitySlotR\fcreatedSlots\x12#\n" +
 	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12v\n" +
 	"\x11validation_errors\x18\x03 \x03(\v2I.datifyy.availability.v1.SubmitAvailabilityResponse.ValidationErrorsEntryR\x10validationErrors\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\x1aC\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12N\n" +
+	"\rcreated_rules\x18\x05 \x03(\v2).datifyy.availability.v1.AvailabilityRuleR\fcreatedRules\x12P\n" +
+	"\x0eexpanded_slots\x18\x06 \x03(\v2).datifyy.availability.v1.AvailabilitySlotR\rexpandedSlots\x12\x83\x01\n" +
+	"\x16rule_validation_errors\x18\a \x03(\v2M.datifyy.availability.v1.SubmitAvailabilityResponse.RuleValidationErrorsEntryR\x14ruleValidationErrors\x12L\n" +
+	"\fslot_results\x18\b \x03(\v2).datifyy.availability.v1.SubmitItemResultR\vslotResults\x12L\n" +
+	"\frule_results\x18\t \x03(\v2).datifyy.availability.v1.SubmitItemResultR\vruleResults\x12\x1f\n" +
+	"\vrolled_back\x18\n" +
+	" \x01(\bR\n" +
+	"rolledBack\x1aC\n" +
 	"\x15ValidationErrorsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aG\n" +
+	"\x19RuleValidationErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"p\n" +
 	"\x19DeleteAvailabilityRequest\x12\x17\n" +
-	"\aslot_id\x18\x01 \x01(\tR\x06slotId\"P\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\tR\x06ruleId\x12!\n" +
+	"\fexclusion_id\x18\x03 \x01(\tR\vexclusionId\"P\n" +
 	"\x1aDeleteAvailabilityResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*o\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x94\x01\n" +
+	"\x19UpdateAvailabilityRequest\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12B\n" +
+	"\x04slot\x18\x02 \x01(\v2..datifyy.availability.v1.AvailabilitySlotInputR\x04slot\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\"u\n" +
+	"\x1aUpdateAvailabilityResponse\x12=\n" +
+	"\x04slot\x18\x01 \x01(\v2).datifyy.availability.v1.AvailabilitySlotR\x04slot\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb8\x01\n" +
+	"\x18ClearAvailabilityRequest\x12\x1b\n" +
+	"\tfrom_time\x18\x01 \x01(\x03R\bfromTime\x12\x17\n" +
+	"\ato_time\x18\x02 \x01(\x03R\x06toTime\x12&\n" +
+	"\x0flocal_from_time\x18\x03 \x01(\tR\rlocalFromTime\x12\"\n" +
+	"\rlocal_to_time\x18\x04 \x01(\tR\vlocalToTime\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\"\xac\x01\n" +
+	"\x19ClearAvailabilityResponse\x12#\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\x12P\n" +
+	"\x0ereserved_slots\x18\x02 \x03(\v2).datifyy.availability.v1.AvailabilitySlotR\rreservedSlots\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x1b\n" +
+	"\x19CreateCalendarFeedRequest\"Q\n" +
+	"\x1aCreateCalendarFeedResponse\x12\x19\n" +
+	"\bfeed_url\x18\x01 \x01(\tR\afeedUrl\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x1b\n" +
+	"\x19RevokeCalendarFeedRequest\"P\n" +
+	"\x1aRevokeCalendarFeedResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"y\n" +
+	"\x15ImportCalendarRequest\x12\x19\n" +
+	"\bics_data\x18\x01 \x01(\tR\aicsData\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12)\n" +
+	"\x10replace_existing\x18\x03 \x01(\bR\x0freplaceExisting\"\xc2\x02\n" +
+	"\x16ImportCalendarResponse\x12%\n" +
+	"\x0eimported_count\x18\x01 \x01(\x05R\rimportedCount\x12#\n" +
+	"\rremoved_count\x18\x02 \x01(\x05R\fremovedCount\x12N\n" +
+	"\n" +
+	"exclusions\x18\x03 \x03(\v2..datifyy.availability.v1.AvailabilityExclusionR\n" +
+	"exclusions\x12V\n" +
+	"\x11conflicting_slots\x18\x04 \x03(\v2).datifyy.availability.v1.AvailabilitySlotR\x10conflictingSlots\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\":\n" +
+	"\x1cGetAvailabilityStatusRequest\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\"\xe8\x03\n" +
+	"\x1dGetAvailabilityStatusResponse\x12#\n" +
+	"\rhas_submitted\x18\x01 \x01(\bR\fhasSubmitted\x12&\n" +
+	"\x0fopen_slot_count\x18\x02 \x01(\x05R\ropenSlotCount\x12.\n" +
+	"\x13reserved_slot_count\x18\x03 \x01(\x05R\x11reservedSlotCount\x12\x1d\n" +
+	"\n" +
+	"rule_count\x18\x04 \x01(\x05R\truleCount\x12.\n" +
+	"\x13next_available_time\x18\x05 \x01(\x03R\x11nextAvailableTime\x12'\n" +
+	"\x0favailable_until\x18\x06 \x01(\x03R\x0eavailableUntil\x129\n" +
+	"\x19local_next_available_time\x18\a \x01(\tR\x16localNextAvailableTime\x122\n" +
+	"\x15local_available_until\x18\b \x01(\tR\x13localAvailableUntil\x12-\n" +
+	"\x12needs_availability\x18\t \x01(\bR\x11needsAvailability\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12\x18\n" +
+	"\amessage\x18\v \x01(\tR\amessage*o\n" +
 	"\bDateType\x12\x19\n" +
 	"\x15DATE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10DATE_TYPE_ONLINE\x10\x01\x12\x15\n" +
 	"\x11DATE_TYPE_OFFLINE\x10\x02\x12\x1b\n" +
-	"\x17DATE_TYPE_OFFLINE_EVENT\x10\x032\x89\x03\n" +
+	"\x17DATE_TYPE_OFFLINE_EVENT\x10\x03*f\n" +
+	"\n" +
+	"SubmitMode\x12\x1b\n" +
+	"\x17SUBMIT_MODE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SUBMIT_MODE_BEST_EFFORT\x10\x01\x12\x1e\n" +
+	"\x1aSUBMIT_MODE_ALL_OR_NOTHING\x10\x022\xfe\b\n" +
 	"\x13AvailabilityService\x12t\n" +
 	"\x0fGetAvailability\x12/.datifyy.availability.v1.GetAvailabilityRequest\x1a0.datifyy.availability.v1.GetAvailabilityResponse\x12}\n" +
 	"\x12SubmitAvailability\x122.datifyy.availability.v1.SubmitAvailabilityRequest\x1a3.datifyy.availability.v1.SubmitAvailabilityResponse\x12}\n" +
-	"\x12DeleteAvailability\x122.datifyy.availability.v1.DeleteAvailabilityRequest\x1a3.datifyy.availability.v1.DeleteAvailabilityResponseB\xed\x01\n" +
+	"\x12DeleteAvailability\x122.datifyy.availability.v1.DeleteAvailabilityRequest\x1a3.datifyy.availability.v1.DeleteAvailabilityResponse\x12}\n" +
+	"\x12UpdateAvailability\x122.datifyy.availability.v1.UpdateAvailabilityRequest\x1a3.datifyy.availability.v1.UpdateAvailabilityResponse\x12z\n" +
+	"\x11ClearAvailability\x121.datifyy.availability.v1.ClearAvailabilityRequest\x1a2.datifyy.availability.v1.ClearAvailabilityResponse\x12}\n" +
+	"\x12CreateCalendarFeed\x122.datifyy.availability.v1.CreateCalendarFeedRequest\x1a3.datifyy.availability.v1.CreateCalendarFeedResponse\x12}\n" +
+	"\x12RevokeCalendarFeed\x122.datifyy.availability.v1.RevokeCalendarFeedRequest\x1a3.datifyy.availability.v1.RevokeCalendarFeedResponse\x12q\n" +
+	"\x0eImportCalendar\x12..datifyy.availability.v1.ImportCalendarRequest\x1a/.datifyy.availability.v1.ImportCalendarResponse\x12\x86\x01\n" +
+	"\x15GetAvailabilityStatus\x125.datifyy.availability.v1.GetAvailabilityStatusRequest\x1a6.datifyy.availability.v1.GetAvailabilityStatusResponseB\xed\x01\n" +
 	"\x1bcom.datifyy.availability.v1B\x11AvailabilityProtoP\x01Z=github.com/datifyy/backend/gen/availability/v1;availabilityv1\xa2\x02\x03DAX\xaa\x02\x17Datifyy.Availability.V1\xca\x02\x17Datifyy\\Availability\\V1\xe2\x02#Datifyy\\Availability\\V1\\GPBMetadata\xea\x02\x19Datifyy::Availability::V1b\x06proto3"
 
 var (
@@ -838,48 +2477,100 @@ func file_availability_v1_availability_proto_rawDescGZIP() []byte {
 	return file_availability_v1_availability_proto_rawDescData
 }
 
-var file_availability_v1_availability_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_availability_v1_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_availability_v1_availability_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_availability_v1_availability_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_availability_v1_availability_proto_goTypes = []any{
-	(DateType)(0),                      // 0: datifyy.availability.v1.DateType
-	(*OfflineLocation)(nil),            // 1: datifyy.availability.v1.OfflineLocation
-	(*AvailabilitySlot)(nil),           // 2: datifyy.availability.v1.AvailabilitySlot
-	(*AvailabilitySlotInput)(nil),      // 3: datifyy.availability.v1.AvailabilitySlotInput
-	(*GetAvailabilityRequest)(nil),     // 4: datifyy.availability.v1.GetAvailabilityRequest
-	(*GetAvailabilityResponse)(nil),    // 5: datifyy.availability.v1.GetAvailabilityResponse
-	(*SubmitAvailabilityRequest)(nil),  // 6: datifyy.availability.v1.SubmitAvailabilityRequest
-	(*SubmitAvailabilityResponse)(nil), // 7: datifyy.availability.v1.SubmitAvailabilityResponse
-	(*DeleteAvailabilityRequest)(nil),  // 8: datifyy.availability.v1.DeleteAvailabilityRequest
-	(*DeleteAvailabilityResponse)(nil), // 9: datifyy.availability.v1.DeleteAvailabilityResponse
-	nil,                                // 10: datifyy.availability.v1.SubmitAvailabilityResponse.ValidationErrorsEntry
-	(*v1.Timestamp)(nil),               // 11: datifyy.common.v1.Timestamp
-	(*v1.PaginationRequest)(nil),       // 12: datifyy.common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),      // 13: datifyy.common.v1.PaginationResponse
+	(DateType)(0),                         // 0: datifyy.availability.v1.DateType
+	(SubmitMode)(0),                       // 1: datifyy.availability.v1.SubmitMode
+	(*OfflineLocation)(nil),               // 2: datifyy.availability.v1.OfflineLocation
+	(*AvailabilitySlot)(nil),              // 3: datifyy.availability.v1.AvailabilitySlot
+	(*AvailabilityExclusion)(nil),         // 4: datifyy.availability.v1.AvailabilityExclusion
+	(*AvailabilityRule)(nil),              // 5: datifyy.availability.v1.AvailabilityRule
+	(*AvailabilityRuleInput)(nil),         // 6: datifyy.availability.v1.AvailabilityRuleInput
+	(*AvailabilitySlotInput)(nil),         // 7: datifyy.availability.v1.AvailabilitySlotInput
+	(*GetAvailabilityRequest)(nil),        // 8: datifyy.availability.v1.GetAvailabilityRequest
+	(*GetAvailabilityResponse)(nil),       // 9: datifyy.availability.v1.GetAvailabilityResponse
+	(*SubmitAvailabilityRequest)(nil),     // 10: datifyy.availability.v1.SubmitAvailabilityRequest
+	(*SubmitItemResult)(nil),              // 11: datifyy.availability.v1.SubmitItemResult
+	(*SubmitAvailabilityResponse)(nil),    // 12: datifyy.availability.v1.SubmitAvailabilityResponse
+	(*DeleteAvailabilityRequest)(nil),     // 13: datifyy.availability.v1.DeleteAvailabilityRequest
+	(*DeleteAvailabilityResponse)(nil),    // 14: datifyy.availability.v1.DeleteAvailabilityResponse
+	(*UpdateAvailabilityRequest)(nil),     // 15: datifyy.availability.v1.UpdateAvailabilityRequest
+	(*UpdateAvailabilityResponse)(nil),    // 16: datifyy.availability.v1.UpdateAvailabilityResponse
+	(*ClearAvailabilityRequest)(nil),      // 17: datifyy.availability.v1.ClearAvailabilityRequest
+	(*ClearAvailabilityResponse)(nil),     // 18: datifyy.availability.v1.ClearAvailabilityResponse
+	(*CreateCalendarFeedRequest)(nil),     // 19: datifyy.availability.v1.CreateCalendarFeedRequest
+	(*CreateCalendarFeedResponse)(nil),    // 20: datifyy.availability.v1.CreateCalendarFeedResponse
+	(*RevokeCalendarFeedRequest)(nil),     // 21: datifyy.availability.v1.RevokeCalendarFeedRequest
+	(*RevokeCalendarFeedResponse)(nil),    // 22: datifyy.availability.v1.RevokeCalendarFeedResponse
+	(*ImportCalendarRequest)(nil),         // 23: datifyy.availability.v1.ImportCalendarRequest
+	(*ImportCalendarResponse)(nil),        // 24: datifyy.availability.v1.ImportCalendarResponse
+	(*GetAvailabilityStatusRequest)(nil),  // 25: datifyy.availability.v1.GetAvailabilityStatusRequest
+	(*GetAvailabilityStatusResponse)(nil), // 26: datifyy.availability.v1.GetAvailabilityStatusResponse
+	nil,                                   // 27: datifyy.availability.v1.SubmitAvailabilityResponse.ValidationErrorsEntry
+	nil,                                   // 28: datifyy.availability.v1.SubmitAvailabilityResponse.RuleValidationErrorsEntry
+	(*v1.Timestamp)(nil),                  // 29: datifyy.common.v1.Timestamp
+	(*v1.PaginationRequest)(nil),          // 30: datifyy.common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),         // 31: datifyy.common.v1.PaginationResponse
 }
 var file_availability_v1_availability_proto_depIdxs = []int32{
 	0,  // 0: datifyy.availability.v1.AvailabilitySlot.date_type:type_name -> datifyy.availability.v1.DateType
-	1,  // 1: datifyy.availability.v1.AvailabilitySlot.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
-	11, // 2: datifyy.availability.v1.AvailabilitySlot.created_at:type_name -> datifyy.common.v1.Timestamp
-	11, // 3: datifyy.availability.v1.AvailabilitySlot.updated_at:type_name -> datifyy.common.v1.Timestamp
-	0,  // 4: datifyy.availability.v1.AvailabilitySlotInput.date_type:type_name -> datifyy.availability.v1.DateType
-	1,  // 5: datifyy.availability.v1.AvailabilitySlotInput.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
-	12, // 6: datifyy.availability.v1.GetAvailabilityRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
-	2,  // 7: datifyy.availability.v1.GetAvailabilityResponse.slots:type_name -> datifyy.availability.v1.AvailabilitySlot
-	13, // 8: datifyy.availability.v1.GetAvailabilityResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
-	3,  // 9: datifyy.availability.v1.SubmitAvailabilityRequest.slots:type_name -> datifyy.availability.v1.AvailabilitySlotInput
-	2,  // 10: datifyy.availability.v1.SubmitAvailabilityResponse.created_slots:type_name -> datifyy.availability.v1.AvailabilitySlot
-	10, // 11: datifyy.availability.v1.SubmitAvailabilityResponse.validation_errors:type_name -> datifyy.availability.v1.SubmitAvailabilityResponse.ValidationErrorsEntry
-	4,  // 12: datifyy.availability.v1.AvailabilityService.GetAvailability:input_type -> datifyy.availability.v1.GetAvailabilityRequest
-	6,  // 13: datifyy.availability.v1.AvailabilityService.SubmitAvailability:input_type -> datifyy.availability.v1.SubmitAvailabilityRequest
-	8,  // 14: datifyy.availability.v1.AvailabilityService.DeleteAvailability:input_type -> datifyy.availability.v1.DeleteAvailabilityRequest
-	5,  // 15: datifyy.availability.v1.AvailabilityService.GetAvailability:output_type -> datifyy.availability.v1.GetAvailabilityResponse
-	7,  // 16: datifyy.availability.v1.AvailabilityService.SubmitAvailability:output_type -> datifyy.availability.v1.SubmitAvailabilityResponse
-	9,  // 17: datifyy.availability.v1.AvailabilityService.DeleteAvailability:output_type -> datifyy.availability.v1.DeleteAvailabilityResponse
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 1: datifyy.availability.v1.AvailabilitySlot.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
+	29, // 2: datifyy.availability.v1.AvailabilitySlot.created_at:type_name -> datifyy.common.v1.Timestamp
+	29, // 3: datifyy.availability.v1.AvailabilitySlot.updated_at:type_name -> datifyy.common.v1.Timestamp
+	0,  // 4: datifyy.availability.v1.AvailabilityRule.date_type:type_name -> datifyy.availability.v1.DateType
+	2,  // 5: datifyy.availability.v1.AvailabilityRule.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
+	29, // 6: datifyy.availability.v1.AvailabilityRule.created_at:type_name -> datifyy.common.v1.Timestamp
+	29, // 7: datifyy.availability.v1.AvailabilityRule.updated_at:type_name -> datifyy.common.v1.Timestamp
+	0,  // 8: datifyy.availability.v1.AvailabilityRuleInput.date_type:type_name -> datifyy.availability.v1.DateType
+	2,  // 9: datifyy.availability.v1.AvailabilityRuleInput.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
+	0,  // 10: datifyy.availability.v1.AvailabilitySlotInput.date_type:type_name -> datifyy.availability.v1.DateType
+	2,  // 11: datifyy.availability.v1.AvailabilitySlotInput.offline_location:type_name -> datifyy.availability.v1.OfflineLocation
+	30, // 12: datifyy.availability.v1.GetAvailabilityRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
+	3,  // 13: datifyy.availability.v1.GetAvailabilityResponse.slots:type_name -> datifyy.availability.v1.AvailabilitySlot
+	31, // 14: datifyy.availability.v1.GetAvailabilityResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
+	5,  // 15: datifyy.availability.v1.GetAvailabilityResponse.rules:type_name -> datifyy.availability.v1.AvailabilityRule
+	4,  // 16: datifyy.availability.v1.GetAvailabilityResponse.exclusions:type_name -> datifyy.availability.v1.AvailabilityExclusion
+	7,  // 17: datifyy.availability.v1.SubmitAvailabilityRequest.slots:type_name -> datifyy.availability.v1.AvailabilitySlotInput
+	6,  // 18: datifyy.availability.v1.SubmitAvailabilityRequest.rules:type_name -> datifyy.availability.v1.AvailabilityRuleInput
+	1,  // 19: datifyy.availability.v1.SubmitAvailabilityRequest.mode:type_name -> datifyy.availability.v1.SubmitMode
+	3,  // 20: datifyy.availability.v1.SubmitItemResult.slot:type_name -> datifyy.availability.v1.AvailabilitySlot
+	5,  // 21: datifyy.availability.v1.SubmitItemResult.rule:type_name -> datifyy.availability.v1.AvailabilityRule
+	3,  // 22: datifyy.availability.v1.SubmitAvailabilityResponse.created_slots:type_name -> datifyy.availability.v1.AvailabilitySlot
+	27, // 23: datifyy.availability.v1.SubmitAvailabilityResponse.validation_errors:type_name -> datifyy.availability.v1.SubmitAvailabilityResponse.ValidationErrorsEntry
+	5,  // 24: datifyy.availability.v1.SubmitAvailabilityResponse.created_rules:type_name -> datifyy.availability.v1.AvailabilityRule
+	3,  // 25: datifyy.availability.v1.SubmitAvailabilityResponse.expanded_slots:type_name -> datifyy.availability.v1.AvailabilitySlot
+	28, // 26: datifyy.availability.v1.SubmitAvailabilityResponse.rule_validation_errors:type_name -> datifyy.availability.v1.SubmitAvailabilityResponse.RuleValidationErrorsEntry
+	11, // 27: datifyy.availability.v1.SubmitAvailabilityResponse.slot_results:type_name -> datifyy.availability.v1.SubmitItemResult
+	11, // 28: datifyy.availability.v1.SubmitAvailabilityResponse.rule_results:type_name -> datifyy.availability.v1.SubmitItemResult
+	7,  // 29: datifyy.availability.v1.UpdateAvailabilityRequest.slot:type_name -> datifyy.availability.v1.AvailabilitySlotInput
+	3,  // 30: datifyy.availability.v1.UpdateAvailabilityResponse.slot:type_name -> datifyy.availability.v1.AvailabilitySlot
+	3,  // 31: datifyy.availability.v1.ClearAvailabilityResponse.reserved_slots:type_name -> datifyy.availability.v1.AvailabilitySlot
+	4,  // 32: datifyy.availability.v1.ImportCalendarResponse.exclusions:type_name -> datifyy.availability.v1.AvailabilityExclusion
+	3,  // 33: datifyy.availability.v1.ImportCalendarResponse.conflicting_slots:type_name -> datifyy.availability.v1.AvailabilitySlot
+	8,  // 34: datifyy.availability.v1.AvailabilityService.GetAvailability:input_type -> datifyy.availability.v1.GetAvailabilityRequest
+	10, // 35: datifyy.availability.v1.AvailabilityService.SubmitAvailability:input_type -> datifyy.availability.v1.SubmitAvailabilityRequest
+	13, // 36: datifyy.availability.v1.AvailabilityService.DeleteAvailability:input_type -> datifyy.availability.v1.DeleteAvailabilityRequest
+	15, // 37: datifyy.availability.v1.AvailabilityService.UpdateAvailability:input_type -> datifyy.availability.v1.UpdateAvailabilityRequest
+	17, // 38: datifyy.availability.v1.AvailabilityService.ClearAvailability:input_type -> datifyy.availability.v1.ClearAvailabilityRequest
+	19, // 39: datifyy.availability.v1.AvailabilityService.CreateCalendarFeed:input_type -> datifyy.availability.v1.CreateCalendarFeedRequest
+	21, // 40: datifyy.availability.v1.AvailabilityService.RevokeCalendarFeed:input_type -> datifyy.availability.v1.RevokeCalendarFeedRequest
+	23, // 41: datifyy.availability.v1.AvailabilityService.ImportCalendar:input_type -> datifyy.availability.v1.ImportCalendarRequest
+	25, // 42: datifyy.availability.v1.AvailabilityService.GetAvailabilityStatus:input_type -> datifyy.availability.v1.GetAvailabilityStatusRequest
+	9,  // 43: datifyy.availability.v1.AvailabilityService.GetAvailability:output_type -> datifyy.availability.v1.GetAvailabilityResponse
+	12, // 44: datifyy.availability.v1.AvailabilityService.SubmitAvailability:output_type -> datifyy.availability.v1.SubmitAvailabilityResponse
+	14, // 45: datifyy.availability.v1.AvailabilityService.DeleteAvailability:output_type -> datifyy.availability.v1.DeleteAvailabilityResponse
+	16, // 46: datifyy.availability.v1.AvailabilityService.UpdateAvailability:output_type -> datifyy.availability.v1.UpdateAvailabilityResponse
+	18, // 47: datifyy.availability.v1.AvailabilityService.ClearAvailability:output_type -> datifyy.availability.v1.ClearAvailabilityResponse
+	20, // 48: datifyy.availability.v1.AvailabilityService.CreateCalendarFeed:output_type -> datifyy.availability.v1.CreateCalendarFeedResponse
+	22, // 49: datifyy.availability.v1.AvailabilityService.RevokeCalendarFeed:output_type -> datifyy.availability.v1.RevokeCalendarFeedResponse
+	24, // 50: datifyy.availability.v1.AvailabilityService.ImportCalendar:output_type -> datifyy.availability.v1.ImportCalendarResponse
+	26, // 51: datifyy.availability.v1.AvailabilityService.GetAvailabilityStatus:output_type -> datifyy.availability.v1.GetAvailabilityStatusResponse
+	43, // [43:52] is the sub-list for method output_type
+	34, // [34:43] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_availability_v1_availability_proto_init() }
@@ -892,8 +2583,8 @@ func file_availability_v1_availability_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_availability_v1_availability_proto_rawDesc), len(file_availability_v1_availability_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
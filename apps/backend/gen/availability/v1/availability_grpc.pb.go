@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AvailabilityService_GetAvailability_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/GetAvailability"
-	AvailabilityService_SubmitAvailability_FullMethodName = "/datifyy.availability.v1.AvailabilityService/SubmitAvailability"
-	AvailabilityService_DeleteAvailability_FullMethodName = "/datifyy.availability.v1.AvailabilityService/DeleteAvailability"
+	AvailabilityService_GetAvailability_FullMethodName       = "/datifyy.availability.v1.AvailabilityService/GetAvailability"
+	AvailabilityService_SubmitAvailability_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/SubmitAvailability"
+	AvailabilityService_DeleteAvailability_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/DeleteAvailability"
+	AvailabilityService_UpdateAvailability_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/UpdateAvailability"
+	AvailabilityService_ClearAvailability_FullMethodName     = "/datifyy.availability.v1.AvailabilityService/ClearAvailability"
+	AvailabilityService_CreateCalendarFeed_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/CreateCalendarFeed"
+	AvailabilityService_RevokeCalendarFeed_FullMethodName    = "/datifyy.availability.v1.AvailabilityService/RevokeCalendarFeed"
+	AvailabilityService_ImportCalendar_FullMethodName        = "/datifyy.availability.v1.AvailabilityService/ImportCalendar"
+	AvailabilityService_GetAvailabilityStatus_FullMethodName = "/datifyy.availability.v1.AvailabilityService/GetAvailabilityStatus"
 )
 
 // AvailabilityServiceClient is the client API for AvailabilityService service.
@@ -32,10 +38,25 @@ const (
 type AvailabilityServiceClient interface {
 	// Get user's availability slots
 	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*GetAvailabilityResponse, error)
-	// Submit multiple availability slots (bulk create)
+	// Submit multiple availability slots and recurring rules (bulk create)
 	SubmitAvailability(ctx context.Context, in *SubmitAvailabilityRequest, opts ...grpc.CallOption) (*SubmitAvailabilityResponse, error)
-	// Delete an availability slot
+	// Delete an availability slot or recurring rule
 	DeleteAvailability(ctx context.Context, in *DeleteAvailabilityRequest, opts ...grpc.CallOption) (*DeleteAvailabilityResponse, error)
+	// Move or edit an availability slot. Slots reserved by a scheduled date
+	// can't be changed.
+	UpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest, opts ...grpc.CallOption) (*UpdateAvailabilityResponse, error)
+	// Delete all unreserved slots in a time range (e.g. for a vacation)
+	ClearAvailability(ctx context.Context, in *ClearAvailabilityRequest, opts ...grpc.CallOption) (*ClearAvailabilityResponse, error)
+	// Create a secret iCal feed URL of the user's upcoming dates, replacing any
+	// previous one
+	CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CreateCalendarFeedResponse, error)
+	// Revoke the user's iCal feed URL
+	RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedResponse, error)
+	// Import busy periods from an iCalendar file as availability exclusions
+	ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*ImportCalendarResponse, error)
+	// Summarize the user's upcoming availability, e.g. to prompt them to add
+	// more before it runs out
+	GetAvailabilityStatus(ctx context.Context, in *GetAvailabilityStatusRequest, opts ...grpc.CallOption) (*GetAvailabilityStatusResponse, error)
 }
 
 type availabilityServiceClient struct {
@@ -76,6 +97,66 @@ func (c *availabilityServiceClient) DeleteAvailability(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *availabilityServiceClient) UpdateAvailability(ctx context.Context, in *UpdateAvailabilityRequest, opts ...grpc.CallOption) (*UpdateAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAvailabilityResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_UpdateAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) ClearAvailability(ctx context.Context, in *ClearAvailabilityRequest, opts ...grpc.CallOption) (*ClearAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearAvailabilityResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_ClearAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CreateCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_CreateCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) RevokeCalendarFeed(ctx context.Context, in *RevokeCalendarFeedRequest, opts ...grpc.CallOption) (*RevokeCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_RevokeCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) ImportCalendar(ctx context.Context, in *ImportCalendarRequest, opts ...grpc.CallOption) (*ImportCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportCalendarResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_ImportCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *availabilityServiceClient) GetAvailabilityStatus(ctx context.Context, in *GetAvailabilityStatusRequest, opts ...grpc.CallOption) (*GetAvailabilityStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailabilityStatusResponse)
+	err := c.cc.Invoke(ctx, AvailabilityService_GetAvailabilityStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AvailabilityServiceServer is the server API for AvailabilityService service.
 // All implementations must embed UnimplementedAvailabilityServiceServer
 // for forward compatibility.
@@ -84,10 +165,25 @@ func (c *availabilityServiceClient) DeleteAvailability(ctx context.Context, in *
 type AvailabilityServiceServer interface {
 	// Get user's availability slots
 	GetAvailability(context.Context, *GetAvailabilityRequest) (*GetAvailabilityResponse, error)
-	// Submit multiple availability slots (bulk create)
+	// Submit multiple availability slots and recurring rules (bulk create)
 	SubmitAvailability(context.Context, *SubmitAvailabilityRequest) (*SubmitAvailabilityResponse, error)
-	// Delete an availability slot
+	// Delete an availability slot or recurring rule
 	DeleteAvailability(context.Context, *DeleteAvailabilityRequest) (*DeleteAvailabilityResponse, error)
+	// Move or edit an availability slot. Slots reserved by a scheduled date
+	// can't be changed.
+	UpdateAvailability(context.Context, *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error)
+	// Delete all unreserved slots in a time range (e.g. for a vacation)
+	ClearAvailability(context.Context, *ClearAvailabilityRequest) (*ClearAvailabilityResponse, error)
+	// Create a secret iCal feed URL of the user's upcoming dates, replacing any
+	// previous one
+	CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error)
+	// Revoke the user's iCal feed URL
+	RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error)
+	// Import busy periods from an iCalendar file as availability exclusions
+	ImportCalendar(context.Context, *ImportCalendarRequest) (*ImportCalendarResponse, error)
+	// Summarize the user's upcoming availability, e.g. to prompt them to add
+	// more before it runs out
+	GetAvailabilityStatus(context.Context, *GetAvailabilityStatusRequest) (*GetAvailabilityStatusResponse, error)
 	mustEmbedUnimplementedAvailabilityServiceServer()
 }
 
@@ -107,6 +203,24 @@ func (UnimplementedAvailabilityServiceServer) SubmitAvailability(context.Context
 func (UnimplementedAvailabilityServiceServer) DeleteAvailability(context.Context, *DeleteAvailabilityRequest) (*DeleteAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAvailability not implemented")
 }
+func (UnimplementedAvailabilityServiceServer) UpdateAvailability(context.Context, *UpdateAvailabilityRequest) (*UpdateAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAvailability not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) ClearAvailability(context.Context, *ClearAvailabilityRequest) (*ClearAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearAvailability not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CreateCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarFeed not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) RevokeCalendarFeed(context.Context, *RevokeCalendarFeedRequest) (*RevokeCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeed not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) ImportCalendar(context.Context, *ImportCalendarRequest) (*ImportCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCalendar not implemented")
+}
+func (UnimplementedAvailabilityServiceServer) GetAvailabilityStatus(context.Context, *GetAvailabilityStatusRequest) (*GetAvailabilityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityStatus not implemented")
+}
 func (UnimplementedAvailabilityServiceServer) mustEmbedUnimplementedAvailabilityServiceServer() {}
 func (UnimplementedAvailabilityServiceServer) testEmbeddedByValue()                             {}
 
@@ -182,6 +296,114 @@ func _AvailabilityService_DeleteAvailability_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AvailabilityService_UpdateAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).UpdateAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_UpdateAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).UpdateAvailability(ctx, req.(*UpdateAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_ClearAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).ClearAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_ClearAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).ClearAvailability(ctx, req.(*ClearAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_CreateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).CreateCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_CreateCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).CreateCalendarFeed(ctx, req.(*CreateCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_RevokeCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).RevokeCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_RevokeCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).RevokeCalendarFeed(ctx, req.(*RevokeCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_ImportCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).ImportCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_ImportCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).ImportCalendar(ctx, req.(*ImportCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AvailabilityService_GetAvailabilityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AvailabilityServiceServer).GetAvailabilityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AvailabilityService_GetAvailabilityStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AvailabilityServiceServer).GetAvailabilityStatus(ctx, req.(*GetAvailabilityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AvailabilityService_ServiceDesc is the grpc.ServiceDesc for AvailabilityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +423,30 @@ var AvailabilityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAvailability",
 			Handler:    _AvailabilityService_DeleteAvailability_Handler,
 		},
+		{
+			MethodName: "UpdateAvailability",
+			Handler:    _AvailabilityService_UpdateAvailability_Handler,
+		},
+		{
+			MethodName: "ClearAvailability",
+			Handler:    _AvailabilityService_ClearAvailability_Handler,
+		},
+		{
+			MethodName: "CreateCalendarFeed",
+			Handler:    _AvailabilityService_CreateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeed",
+			Handler:    _AvailabilityService_RevokeCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ImportCalendar",
+			Handler:    _AvailabilityService_ImportCalendar_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityStatus",
+			Handler:    _AvailabilityService_GetAvailabilityStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "availability/v1/availability.proto",
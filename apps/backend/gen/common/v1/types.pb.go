@@ -25,12 +25,13 @@ const (
 type AccountStatus int32
 
 const (
-	AccountStatus_ACCOUNT_STATUS_UNSPECIFIED AccountStatus = 0
-	AccountStatus_ACCOUNT_STATUS_ACTIVE      AccountStatus = 1 // Active and verified
-	AccountStatus_ACCOUNT_STATUS_PENDING     AccountStatus = 2 // Created but email not verified
-	AccountStatus_ACCOUNT_STATUS_SUSPENDED   AccountStatus = 3 // Temporarily suspended
-	AccountStatus_ACCOUNT_STATUS_BANNED      AccountStatus = 4 // Permanently banned
-	AccountStatus_ACCOUNT_STATUS_DELETED     AccountStatus = 5 // Soft deleted
+	AccountStatus_ACCOUNT_STATUS_UNSPECIFIED      AccountStatus = 0
+	AccountStatus_ACCOUNT_STATUS_ACTIVE           AccountStatus = 1 // Active and verified
+	AccountStatus_ACCOUNT_STATUS_PENDING          AccountStatus = 2 // Created but email not verified
+	AccountStatus_ACCOUNT_STATUS_SUSPENDED        AccountStatus = 3 // Temporarily suspended
+	AccountStatus_ACCOUNT_STATUS_BANNED           AccountStatus = 4 // Permanently banned
+	AccountStatus_ACCOUNT_STATUS_DELETED          AccountStatus = 5 // Soft deleted
+	AccountStatus_ACCOUNT_STATUS_PENDING_DELETION AccountStatus = 6 // Deletion requested, within grace period
 )
 
 // Enum value maps for AccountStatus.
@@ -42,14 +43,16 @@ var (
 		3: "ACCOUNT_STATUS_SUSPENDED",
 		4: "ACCOUNT_STATUS_BANNED",
 		5: "ACCOUNT_STATUS_DELETED",
+		6: "ACCOUNT_STATUS_PENDING_DELETION",
 	}
 	AccountStatus_value = map[string]int32{
-		"ACCOUNT_STATUS_UNSPECIFIED": 0,
-		"ACCOUNT_STATUS_ACTIVE":      1,
-		"ACCOUNT_STATUS_PENDING":     2,
-		"ACCOUNT_STATUS_SUSPENDED":   3,
-		"ACCOUNT_STATUS_BANNED":      4,
-		"ACCOUNT_STATUS_DELETED":     5,
+		"ACCOUNT_STATUS_UNSPECIFIED":      0,
+		"ACCOUNT_STATUS_ACTIVE":           1,
+		"ACCOUNT_STATUS_PENDING":          2,
+		"ACCOUNT_STATUS_SUSPENDED":        3,
+		"ACCOUNT_STATUS_BANNED":           4,
+		"ACCOUNT_STATUS_DELETED":          5,
+		"ACCOUNT_STATUS_PENDING_DELETION": 6,
 	}
 )
 
@@ -582,14 +585,15 @@ const file_common_v1_types_proto_rawDesc = "" +
 	"\acountry\x18\x02 \x01(\tR\acountry\x12\x12\n" +
 	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1a\n" +
 	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x05 \x01(\x01R\tlongitude*\xbb\x01\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude*\xe0\x01\n" +
 	"\rAccountStatus\x12\x1e\n" +
 	"\x1aACCOUNT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ACCOUNT_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
 	"\x16ACCOUNT_STATUS_PENDING\x10\x02\x12\x1c\n" +
 	"\x18ACCOUNT_STATUS_SUSPENDED\x10\x03\x12\x19\n" +
 	"\x15ACCOUNT_STATUS_BANNED\x10\x04\x12\x1a\n" +
-	"\x16ACCOUNT_STATUS_DELETED\x10\x05*\xc1\x01\n" +
+	"\x16ACCOUNT_STATUS_DELETED\x10\x05\x12#\n" +
+	"\x1fACCOUNT_STATUS_PENDING_DELETION\x10\x06*\xc1\x01\n" +
 	"\x12VerificationStatus\x12#\n" +
 	"\x1fVERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eVERIFICATION_STATUS_UNVERIFIED\x10\x01\x12\x1f\n" +
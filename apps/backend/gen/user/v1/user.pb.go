@@ -1756,6 +1756,121 @@ func (ReportReason) EnumDescriptor() ([]byte, []int) {
 	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
+// Government ID document type
+type IdDocumentType int32
+
+const (
+	IdDocumentType_ID_DOCUMENT_TYPE_UNSPECIFIED     IdDocumentType = 0
+	IdDocumentType_ID_DOCUMENT_TYPE_AADHAAR         IdDocumentType = 1
+	IdDocumentType_ID_DOCUMENT_TYPE_PASSPORT        IdDocumentType = 2
+	IdDocumentType_ID_DOCUMENT_TYPE_DRIVING_LICENSE IdDocumentType = 3
+	IdDocumentType_ID_DOCUMENT_TYPE_VOTER_ID        IdDocumentType = 4
+	IdDocumentType_ID_DOCUMENT_TYPE_PAN             IdDocumentType = 5
+)
+
+// Enum value maps for IdDocumentType.
+var (
+	IdDocumentType_name = map[int32]string{
+		0: "ID_DOCUMENT_TYPE_UNSPECIFIED",
+		1: "ID_DOCUMENT_TYPE_AADHAAR",
+		2: "ID_DOCUMENT_TYPE_PASSPORT",
+		3: "ID_DOCUMENT_TYPE_DRIVING_LICENSE",
+		4: "ID_DOCUMENT_TYPE_VOTER_ID",
+		5: "ID_DOCUMENT_TYPE_PAN",
+	}
+	IdDocumentType_value = map[string]int32{
+		"ID_DOCUMENT_TYPE_UNSPECIFIED":     0,
+		"ID_DOCUMENT_TYPE_AADHAAR":         1,
+		"ID_DOCUMENT_TYPE_PASSPORT":        2,
+		"ID_DOCUMENT_TYPE_DRIVING_LICENSE": 3,
+		"ID_DOCUMENT_TYPE_VOTER_ID":        4,
+		"ID_DOCUMENT_TYPE_PAN":             5,
+	}
+)
+
+func (x IdDocumentType) Enum() *IdDocumentType {
+	p := new(IdDocumentType)
+	*p = x
+	return p
+}
+
+func (x IdDocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdDocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[22].Descriptor()
+}
+
+func (IdDocumentType) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[22]
+}
+
+func (x IdDocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdDocumentType.Descriptor instead.
+func (IdDocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+// Government ID verification status
+type IdVerificationStatus int32
+
+const (
+	IdVerificationStatus_ID_VERIFICATION_STATUS_UNSPECIFIED   IdVerificationStatus = 0
+	IdVerificationStatus_ID_VERIFICATION_STATUS_NOT_SUBMITTED IdVerificationStatus = 1
+	IdVerificationStatus_ID_VERIFICATION_STATUS_PENDING       IdVerificationStatus = 2 // Awaiting moderator review
+	IdVerificationStatus_ID_VERIFICATION_STATUS_APPROVED      IdVerificationStatus = 3
+	IdVerificationStatus_ID_VERIFICATION_STATUS_REJECTED      IdVerificationStatus = 4
+)
+
+// Enum value maps for IdVerificationStatus.
+var (
+	IdVerificationStatus_name = map[int32]string{
+		0: "ID_VERIFICATION_STATUS_UNSPECIFIED",
+		1: "ID_VERIFICATION_STATUS_NOT_SUBMITTED",
+		2: "ID_VERIFICATION_STATUS_PENDING",
+		3: "ID_VERIFICATION_STATUS_APPROVED",
+		4: "ID_VERIFICATION_STATUS_REJECTED",
+	}
+	IdVerificationStatus_value = map[string]int32{
+		"ID_VERIFICATION_STATUS_UNSPECIFIED":   0,
+		"ID_VERIFICATION_STATUS_NOT_SUBMITTED": 1,
+		"ID_VERIFICATION_STATUS_PENDING":       2,
+		"ID_VERIFICATION_STATUS_APPROVED":      3,
+		"ID_VERIFICATION_STATUS_REJECTED":      4,
+	}
+)
+
+func (x IdVerificationStatus) Enum() *IdVerificationStatus {
+	p := new(IdVerificationStatus)
+	*p = x
+	return p
+}
+
+func (x IdVerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdVerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_v1_user_proto_enumTypes[23].Descriptor()
+}
+
+func (IdVerificationStatus) Type() protoreflect.EnumType {
+	return &file_user_v1_user_proto_enumTypes[23]
+}
+
+func (x IdVerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdVerificationStatus.Descriptor instead.
+func (IdVerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
 // Manglik/Mangal Dosha status (Vedic astrology - important in India)
 type ManglikStatus int32
 
@@ -1799,11 +1914,11 @@ func (x ManglikStatus) String() string {
 }
 
 func (ManglikStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[22].Descriptor()
+	return file_user_v1_user_proto_enumTypes[24].Descriptor()
 }
 
 func (ManglikStatus) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[22]
+	return &file_user_v1_user_proto_enumTypes[24]
 }
 
 func (x ManglikStatus) Number() protoreflect.EnumNumber {
@@ -1812,7 +1927,7 @@ func (x ManglikStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ManglikStatus.Descriptor instead.
 func (ManglikStatus) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
 }
 
 // Manglik preference for partner search
@@ -1855,11 +1970,11 @@ func (x ManglikPreference) String() string {
 }
 
 func (ManglikPreference) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[23].Descriptor()
+	return file_user_v1_user_proto_enumTypes[25].Descriptor()
 }
 
 func (ManglikPreference) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[23]
+	return &file_user_v1_user_proto_enumTypes[25]
 }
 
 func (x ManglikPreference) Number() protoreflect.EnumNumber {
@@ -1868,7 +1983,7 @@ func (x ManglikPreference) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ManglikPreference.Descriptor instead.
 func (ManglikPreference) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
 }
 
 // Ethnicity/Race (for global markets)
@@ -1941,11 +2056,11 @@ func (x Ethnicity) String() string {
 }
 
 func (Ethnicity) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[24].Descriptor()
+	return file_user_v1_user_proto_enumTypes[26].Descriptor()
 }
 
 func (Ethnicity) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[24]
+	return &file_user_v1_user_proto_enumTypes[26]
 }
 
 func (x Ethnicity) Number() protoreflect.EnumNumber {
@@ -1954,7 +2069,7 @@ func (x Ethnicity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Ethnicity.Descriptor instead.
 func (Ethnicity) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
 }
 
 // Body type
@@ -2009,11 +2124,11 @@ func (x BodyType) String() string {
 }
 
 func (BodyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[25].Descriptor()
+	return file_user_v1_user_proto_enumTypes[27].Descriptor()
 }
 
 func (BodyType) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[25]
+	return &file_user_v1_user_proto_enumTypes[27]
 }
 
 func (x BodyType) Number() protoreflect.EnumNumber {
@@ -2022,7 +2137,7 @@ func (x BodyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BodyType.Descriptor instead.
 func (BodyType) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
 }
 
 // Complexion/Skin tone (important in some cultures, especially India)
@@ -2071,11 +2186,11 @@ func (x Complexion) String() string {
 }
 
 func (Complexion) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[26].Descriptor()
+	return file_user_v1_user_proto_enumTypes[28].Descriptor()
 }
 
 func (Complexion) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[26]
+	return &file_user_v1_user_proto_enumTypes[28]
 }
 
 func (x Complexion) Number() protoreflect.EnumNumber {
@@ -2084,7 +2199,7 @@ func (x Complexion) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Complexion.Descriptor instead.
 func (Complexion) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
 }
 
 // Hair color
@@ -2142,11 +2257,11 @@ func (x HairColor) String() string {
 }
 
 func (HairColor) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[27].Descriptor()
+	return file_user_v1_user_proto_enumTypes[29].Descriptor()
 }
 
 func (HairColor) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[27]
+	return &file_user_v1_user_proto_enumTypes[29]
 }
 
 func (x HairColor) Number() protoreflect.EnumNumber {
@@ -2155,7 +2270,7 @@ func (x HairColor) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HairColor.Descriptor instead.
 func (HairColor) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
 }
 
 // Eye color
@@ -2210,11 +2325,11 @@ func (x EyeColor) String() string {
 }
 
 func (EyeColor) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[28].Descriptor()
+	return file_user_v1_user_proto_enumTypes[30].Descriptor()
 }
 
 func (EyeColor) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[28]
+	return &file_user_v1_user_proto_enumTypes[30]
 }
 
 func (x EyeColor) Number() protoreflect.EnumNumber {
@@ -2223,7 +2338,7 @@ func (x EyeColor) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EyeColor.Descriptor instead.
 func (EyeColor) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
 // Facial hair (for men)
@@ -2272,11 +2387,11 @@ func (x FacialHair) String() string {
 }
 
 func (FacialHair) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[29].Descriptor()
+	return file_user_v1_user_proto_enumTypes[31].Descriptor()
 }
 
 func (FacialHair) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[29]
+	return &file_user_v1_user_proto_enumTypes[31]
 }
 
 func (x FacialHair) Number() protoreflect.EnumNumber {
@@ -2285,7 +2400,7 @@ func (x FacialHair) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FacialHair.Descriptor instead.
 func (FacialHair) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
 // Disability status
@@ -2334,11 +2449,11 @@ func (x DisabilityStatus) String() string {
 }
 
 func (DisabilityStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[30].Descriptor()
+	return file_user_v1_user_proto_enumTypes[32].Descriptor()
 }
 
 func (DisabilityStatus) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[30]
+	return &file_user_v1_user_proto_enumTypes[32]
 }
 
 func (x DisabilityStatus) Number() protoreflect.EnumNumber {
@@ -2347,7 +2462,7 @@ func (x DisabilityStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DisabilityStatus.Descriptor instead.
 func (DisabilityStatus) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
 }
 
 // Blood group
@@ -2405,11 +2520,11 @@ func (x BloodGroup) String() string {
 }
 
 func (BloodGroup) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[31].Descriptor()
+	return file_user_v1_user_proto_enumTypes[33].Descriptor()
 }
 
 func (BloodGroup) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[31]
+	return &file_user_v1_user_proto_enumTypes[33]
 }
 
 func (x BloodGroup) Number() protoreflect.EnumNumber {
@@ -2418,7 +2533,7 @@ func (x BloodGroup) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BloodGroup.Descriptor instead.
 func (BloodGroup) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
 }
 
 // Income range
@@ -2482,11 +2597,11 @@ func (x IncomeRange) String() string {
 }
 
 func (IncomeRange) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[32].Descriptor()
+	return file_user_v1_user_proto_enumTypes[34].Descriptor()
 }
 
 func (IncomeRange) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[32]
+	return &file_user_v1_user_proto_enumTypes[34]
 }
 
 func (x IncomeRange) Number() protoreflect.EnumNumber {
@@ -2495,7 +2610,7 @@ func (x IncomeRange) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IncomeRange.Descriptor instead.
 func (IncomeRange) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
 }
 
 // Employment type
@@ -2562,11 +2677,11 @@ func (x EmploymentType) String() string {
 }
 
 func (EmploymentType) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[33].Descriptor()
+	return file_user_v1_user_proto_enumTypes[35].Descriptor()
 }
 
 func (EmploymentType) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[33]
+	return &file_user_v1_user_proto_enumTypes[35]
 }
 
 func (x EmploymentType) Number() protoreflect.EnumNumber {
@@ -2575,7 +2690,7 @@ func (x EmploymentType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EmploymentType.Descriptor instead.
 func (EmploymentType) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
 // Family type (India-specific but applicable globally)
@@ -2624,11 +2739,11 @@ func (x FamilyType) String() string {
 }
 
 func (FamilyType) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[34].Descriptor()
+	return file_user_v1_user_proto_enumTypes[36].Descriptor()
 }
 
 func (FamilyType) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[34]
+	return &file_user_v1_user_proto_enumTypes[36]
 }
 
 func (x FamilyType) Number() protoreflect.EnumNumber {
@@ -2637,7 +2752,7 @@ func (x FamilyType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FamilyType.Descriptor instead.
 func (FamilyType) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
 }
 
 // Family values
@@ -2683,11 +2798,11 @@ func (x FamilyValues) String() string {
 }
 
 func (FamilyValues) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[35].Descriptor()
+	return file_user_v1_user_proto_enumTypes[37].Descriptor()
 }
 
 func (FamilyValues) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[35]
+	return &file_user_v1_user_proto_enumTypes[37]
 }
 
 func (x FamilyValues) Number() protoreflect.EnumNumber {
@@ -2696,7 +2811,7 @@ func (x FamilyValues) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FamilyValues.Descriptor instead.
 func (FamilyValues) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
 }
 
 // Living situation
@@ -2748,11 +2863,11 @@ func (x LivingSituation) String() string {
 }
 
 func (LivingSituation) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[36].Descriptor()
+	return file_user_v1_user_proto_enumTypes[38].Descriptor()
 }
 
 func (LivingSituation) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[36]
+	return &file_user_v1_user_proto_enumTypes[38]
 }
 
 func (x LivingSituation) Number() protoreflect.EnumNumber {
@@ -2761,7 +2876,7 @@ func (x LivingSituation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LivingSituation.Descriptor instead.
 func (LivingSituation) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
 }
 
 // Family affluence/socioeconomic status
@@ -2810,11 +2925,11 @@ func (x FamilyAffluence) String() string {
 }
 
 func (FamilyAffluence) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[37].Descriptor()
+	return file_user_v1_user_proto_enumTypes[39].Descriptor()
 }
 
 func (FamilyAffluence) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[37]
+	return &file_user_v1_user_proto_enumTypes[39]
 }
 
 func (x FamilyAffluence) Number() protoreflect.EnumNumber {
@@ -2823,7 +2938,7 @@ func (x FamilyAffluence) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FamilyAffluence.Descriptor instead.
 func (FamilyAffluence) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
 }
 
 // Parent status (for family information)
@@ -2863,11 +2978,11 @@ func (x ParentStatus) String() string {
 }
 
 func (ParentStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[38].Descriptor()
+	return file_user_v1_user_proto_enumTypes[40].Descriptor()
 }
 
 func (ParentStatus) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[38]
+	return &file_user_v1_user_proto_enumTypes[40]
 }
 
 func (x ParentStatus) Number() protoreflect.EnumNumber {
@@ -2876,7 +2991,7 @@ func (x ParentStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ParentStatus.Descriptor instead.
 func (ParentStatus) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
 }
 
 // NRI (Non-Resident Indian) preference
@@ -2919,11 +3034,11 @@ func (x NRIPreference) String() string {
 }
 
 func (NRIPreference) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[39].Descriptor()
+	return file_user_v1_user_proto_enumTypes[41].Descriptor()
 }
 
 func (NRIPreference) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[39]
+	return &file_user_v1_user_proto_enumTypes[41]
 }
 
 func (x NRIPreference) Number() protoreflect.EnumNumber {
@@ -2932,7 +3047,7 @@ func (x NRIPreference) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NRIPreference.Descriptor instead.
 func (NRIPreference) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
 }
 
 // Relocation expectation
@@ -2975,11 +3090,11 @@ func (x RelocationExpectation) String() string {
 }
 
 func (RelocationExpectation) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[40].Descriptor()
+	return file_user_v1_user_proto_enumTypes[42].Descriptor()
 }
 
 func (RelocationExpectation) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[40]
+	return &file_user_v1_user_proto_enumTypes[42]
 }
 
 func (x RelocationExpectation) Number() protoreflect.EnumNumber {
@@ -2988,7 +3103,7 @@ func (x RelocationExpectation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RelocationExpectation.Descriptor instead.
 func (RelocationExpectation) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
 }
 
 // Tattoo preference
@@ -3034,11 +3149,11 @@ func (x TattooPreference) String() string {
 }
 
 func (TattooPreference) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[41].Descriptor()
+	return file_user_v1_user_proto_enumTypes[43].Descriptor()
 }
 
 func (TattooPreference) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[41]
+	return &file_user_v1_user_proto_enumTypes[43]
 }
 
 func (x TattooPreference) Number() protoreflect.EnumNumber {
@@ -3047,7 +3162,7 @@ func (x TattooPreference) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TattooPreference.Descriptor instead.
 func (TattooPreference) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
 }
 
 // Piercing preference
@@ -3093,11 +3208,11 @@ func (x PiercingPreference) String() string {
 }
 
 func (PiercingPreference) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[42].Descriptor()
+	return file_user_v1_user_proto_enumTypes[44].Descriptor()
 }
 
 func (PiercingPreference) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[42]
+	return &file_user_v1_user_proto_enumTypes[44]
 }
 
 func (x PiercingPreference) Number() protoreflect.EnumNumber {
@@ -3106,7 +3221,7 @@ func (x PiercingPreference) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PiercingPreference.Descriptor instead.
 func (PiercingPreference) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
 }
 
 // Disability acceptance
@@ -3149,11 +3264,11 @@ func (x DisabilityAcceptance) String() string {
 }
 
 func (DisabilityAcceptance) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[43].Descriptor()
+	return file_user_v1_user_proto_enumTypes[45].Descriptor()
 }
 
 func (DisabilityAcceptance) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[43]
+	return &file_user_v1_user_proto_enumTypes[45]
 }
 
 func (x DisabilityAcceptance) Number() protoreflect.EnumNumber {
@@ -3162,7 +3277,7 @@ func (x DisabilityAcceptance) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DisabilityAcceptance.Descriptor instead.
 func (DisabilityAcceptance) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
 // Property ownership preference
@@ -3205,11 +3320,11 @@ func (x PropertyOwnership) String() string {
 }
 
 func (PropertyOwnership) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[44].Descriptor()
+	return file_user_v1_user_proto_enumTypes[46].Descriptor()
 }
 
 func (PropertyOwnership) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[44]
+	return &file_user_v1_user_proto_enumTypes[46]
 }
 
 func (x PropertyOwnership) Number() protoreflect.EnumNumber {
@@ -3218,7 +3333,7 @@ func (x PropertyOwnership) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PropertyOwnership.Descriptor instead.
 func (PropertyOwnership) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
 }
 
 // Vehicle ownership preference
@@ -3261,11 +3376,11 @@ func (x VehicleOwnership) String() string {
 }
 
 func (VehicleOwnership) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[45].Descriptor()
+	return file_user_v1_user_proto_enumTypes[47].Descriptor()
 }
 
 func (VehicleOwnership) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[45]
+	return &file_user_v1_user_proto_enumTypes[47]
 }
 
 func (x VehicleOwnership) Number() protoreflect.EnumNumber {
@@ -3274,7 +3389,7 @@ func (x VehicleOwnership) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VehicleOwnership.Descriptor instead.
 func (VehicleOwnership) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
 }
 
 // Financial expectation
@@ -3317,11 +3432,11 @@ func (x FinancialExpectation) String() string {
 }
 
 func (FinancialExpectation) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[46].Descriptor()
+	return file_user_v1_user_proto_enumTypes[48].Descriptor()
 }
 
 func (FinancialExpectation) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[46]
+	return &file_user_v1_user_proto_enumTypes[48]
 }
 
 func (x FinancialExpectation) Number() protoreflect.EnumNumber {
@@ -3330,7 +3445,7 @@ func (x FinancialExpectation) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FinancialExpectation.Descriptor instead.
 func (FinancialExpectation) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
 }
 
 // Deal-breaker types
@@ -3409,11 +3524,11 @@ func (x DealBreakerType) String() string {
 }
 
 func (DealBreakerType) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[47].Descriptor()
+	return file_user_v1_user_proto_enumTypes[49].Descriptor()
 }
 
 func (DealBreakerType) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[47]
+	return &file_user_v1_user_proto_enumTypes[49]
 }
 
 func (x DealBreakerType) Number() protoreflect.EnumNumber {
@@ -3422,7 +3537,7 @@ func (x DealBreakerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DealBreakerType.Descriptor instead.
 func (DealBreakerType) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
 }
 
 // Must-have types
@@ -3501,11 +3616,11 @@ func (x MustHaveType) String() string {
 }
 
 func (MustHaveType) Descriptor() protoreflect.EnumDescriptor {
-	return file_user_v1_user_proto_enumTypes[48].Descriptor()
+	return file_user_v1_user_proto_enumTypes[50].Descriptor()
 }
 
 func (MustHaveType) Type() protoreflect.EnumType {
-	return &file_user_v1_user_proto_enumTypes[48]
+	return &file_user_v1_user_proto_enumTypes[50]
 }
 
 func (x MustHaveType) Number() protoreflect.EnumNumber {
@@ -3514,7 +3629,7 @@ func (x MustHaveType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MustHaveType.Descriptor instead.
 func (MustHaveType) EnumDescriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
 }
 
 // Complete user profile message
@@ -3548,7 +3663,8 @@ type UserProfile struct {
 	LastSeenAt *v1.Timestamp `protobuf:"bytes,13,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
 	// Profile verification status
 	IsVerified bool `protobuf:"varint,14,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	// Compatibility score (when viewing from another profile)
+	// Compatibility score (when viewing from another profile): the weighted
+	// share (0-100) of the viewer's soft partner preferences this profile meets
 	CompatibilityScore int32 `protobuf:"varint,15,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
 	// Cultural & Matrimonial Information (India-specific)
 	CulturalInfo *CulturalInfo `protobuf:"bytes,16,opt,name=cultural_info,json=culturalInfo,proto3" json:"cultural_info,omitempty"`
@@ -3557,9 +3673,15 @@ type UserProfile struct {
 	// Financial & Professional details
 	ProfessionalInfo *ProfessionalInfo `protobuf:"bytes,18,opt,name=professional_info,json=professionalInfo,proto3" json:"professional_info,omitempty"`
 	// Family background and values
-	FamilyInfo    *FamilyInfo `protobuf:"bytes,19,opt,name=family_info,json=familyInfo,proto3" json:"family_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	FamilyInfo *FamilyInfo `protobuf:"bytes,19,opt,name=family_info,json=familyInfo,proto3" json:"family_info,omitempty"`
+	// Work email verified against a corporate domain
+	WorkEmailVerified bool `protobuf:"varint,20,opt,name=work_email_verified,json=workEmailVerified,proto3" json:"work_email_verified,omitempty"`
+	// Approximate distance from the viewer in km, rounded to a bucket (0 when unknown or hidden)
+	DistanceKm int32 `protobuf:"varint,21,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// Horoscope compatibility with the viewer, when both have birth details
+	HoroscopeMatch *HoroscopeMatch `protobuf:"bytes,22,opt,name=horoscope_match,json=horoscopeMatch,proto3" json:"horoscope_match,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UserProfile) Reset() {
@@ -3725,6 +3847,27 @@ func (x *UserProfile) GetFamilyInfo() *FamilyInfo {
 	return nil
 }
 
+func (x *UserProfile) GetWorkEmailVerified() bool {
+	if x != nil {
+		return x.WorkEmailVerified
+	}
+	return false
+}
+
+func (x *UserProfile) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *UserProfile) GetHoroscopeMatch() *HoroscopeMatch {
+	if x != nil {
+		return x.HoroscopeMatch
+	}
+	return nil
+}
+
 // Basic user information
 type BasicInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4162,8 +4305,15 @@ type CulturalInfo struct {
 	WillingToRelocate bool `protobuf:"varint,12,opt,name=willing_to_relocate,json=willingToRelocate,proto3" json:"willing_to_relocate,omitempty"`
 	// Willing to relocate to countries
 	RelocationCountries []string `protobuf:"bytes,13,rep,name=relocation_countries,json=relocationCountries,proto3" json:"relocation_countries,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Birth time (HH:MM, local) and place used to cast the birth chart. Only
+	// shown to the profile owner; nakshatra, raasi and manglik_status are
+	// derived from them and the date of birth.
+	BirthTime  string `protobuf:"bytes,14,opt,name=birth_time,json=birthTime,proto3" json:"birth_time,omitempty"`
+	BirthPlace string `protobuf:"bytes,15,opt,name=birth_place,json=birthPlace,proto3" json:"birth_place,omitempty"`
+	// IANA timezone of the birth time (defaults to Asia/Kolkata)
+	BirthTimezone string `protobuf:"bytes,16,opt,name=birth_timezone,json=birthTimezone,proto3" json:"birth_timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CulturalInfo) Reset() {
@@ -4287,15 +4437,108 @@ func (x *CulturalInfo) GetRelocationCountries() []string {
 	return nil
 }
 
-// Physical appearance details
-type AppearanceInfo struct {
+func (x *CulturalInfo) GetBirthTime() string {
+	if x != nil {
+		return x.BirthTime
+	}
+	return ""
+}
+
+func (x *CulturalInfo) GetBirthPlace() string {
+	if x != nil {
+		return x.BirthPlace
+	}
+	return ""
+}
+
+func (x *CulturalInfo) GetBirthTimezone() string {
+	if x != nil {
+		return x.BirthTimezone
+	}
+	return ""
+}
+
+// Ashtakoota (Guna Milan) horoscope compatibility between two users
+type HoroscopeMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Body type
-	BodyType BodyType `protobuf:"varint,1,opt,name=body_type,json=bodyType,proto3,enum=datifyy.user.v1.BodyType" json:"body_type,omitempty"`
-	// Complexion/Skin tone (important in some cultures)
-	Complexion Complexion `protobuf:"varint,2,opt,name=complexion,proto3,enum=datifyy.user.v1.Complexion" json:"complexion,omitempty"`
-	// Hair color
-	HairColor HairColor `protobuf:"varint,3,opt,name=hair_color,json=hairColor,proto3,enum=datifyy.user.v1.HairColor" json:"hair_color,omitempty"`
+	// Gunas scored out of max_gunas
+	Gunas    float64 `protobuf:"fixed64,1,opt,name=gunas,proto3" json:"gunas,omitempty"`
+	MaxGunas int32   `protobuf:"varint,2,opt,name=max_gunas,json=maxGunas,proto3" json:"max_gunas,omitempty"`
+	// False when exactly one of the two has Mangal dosha
+	ManglikCompatible bool `protobuf:"varint,3,opt,name=manglik_compatible,json=manglikCompatible,proto3" json:"manglik_compatible,omitempty"`
+	// At least 18 gunas with no unresolved Mangal dosha
+	Acceptable    bool `protobuf:"varint,4,opt,name=acceptable,proto3" json:"acceptable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoroscopeMatch) Reset() {
+	*x = HoroscopeMatch{}
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoroscopeMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoroscopeMatch) ProtoMessage() {}
+
+func (x *HoroscopeMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoroscopeMatch.ProtoReflect.Descriptor instead.
+func (*HoroscopeMatch) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HoroscopeMatch) GetGunas() float64 {
+	if x != nil {
+		return x.Gunas
+	}
+	return 0
+}
+
+func (x *HoroscopeMatch) GetMaxGunas() int32 {
+	if x != nil {
+		return x.MaxGunas
+	}
+	return 0
+}
+
+func (x *HoroscopeMatch) GetManglikCompatible() bool {
+	if x != nil {
+		return x.ManglikCompatible
+	}
+	return false
+}
+
+func (x *HoroscopeMatch) GetAcceptable() bool {
+	if x != nil {
+		return x.Acceptable
+	}
+	return false
+}
+
+// Physical appearance details
+type AppearanceInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Body type
+	BodyType BodyType `protobuf:"varint,1,opt,name=body_type,json=bodyType,proto3,enum=datifyy.user.v1.BodyType" json:"body_type,omitempty"`
+	// Complexion/Skin tone (important in some cultures)
+	Complexion Complexion `protobuf:"varint,2,opt,name=complexion,proto3,enum=datifyy.user.v1.Complexion" json:"complexion,omitempty"`
+	// Hair color
+	HairColor HairColor `protobuf:"varint,3,opt,name=hair_color,json=hairColor,proto3,enum=datifyy.user.v1.HairColor" json:"hair_color,omitempty"`
 	// Eye color
 	EyeColor EyeColor `protobuf:"varint,4,opt,name=eye_color,json=eyeColor,proto3,enum=datifyy.user.v1.EyeColor" json:"eye_color,omitempty"`
 	// Facial hair (for men)
@@ -4314,7 +4557,7 @@ type AppearanceInfo struct {
 
 func (x *AppearanceInfo) Reset() {
 	*x = AppearanceInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4326,7 +4569,7 @@ func (x *AppearanceInfo) String() string {
 func (*AppearanceInfo) ProtoMessage() {}
 
 func (x *AppearanceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[5]
+	mi := &file_user_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,7 +4582,7 @@ func (x *AppearanceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppearanceInfo.ProtoReflect.Descriptor instead.
 func (*AppearanceInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AppearanceInfo) GetBodyType() BodyType {
@@ -4432,7 +4675,7 @@ type ProfessionalInfo struct {
 
 func (x *ProfessionalInfo) Reset() {
 	*x = ProfessionalInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4444,7 +4687,7 @@ func (x *ProfessionalInfo) String() string {
 func (*ProfessionalInfo) ProtoMessage() {}
 
 func (x *ProfessionalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[6]
+	mi := &file_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4457,7 +4700,7 @@ func (x *ProfessionalInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfessionalInfo.ProtoReflect.Descriptor instead.
 func (*ProfessionalInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ProfessionalInfo) GetIncomeRange() IncomeRange {
@@ -4560,7 +4803,7 @@ type FamilyInfo struct {
 
 func (x *FamilyInfo) Reset() {
 	*x = FamilyInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4572,7 +4815,7 @@ func (x *FamilyInfo) String() string {
 func (*FamilyInfo) ProtoMessage() {}
 
 func (x *FamilyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[7]
+	mi := &file_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4585,7 +4828,7 @@ func (x *FamilyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FamilyInfo.ProtoReflect.Descriptor instead.
 func (*FamilyInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FamilyInfo) GetFamilyType() FamilyType {
@@ -4701,7 +4944,7 @@ type OccupationInfo struct {
 
 func (x *OccupationInfo) Reset() {
 	*x = OccupationInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4713,7 +4956,7 @@ func (x *OccupationInfo) String() string {
 func (*OccupationInfo) ProtoMessage() {}
 
 func (x *OccupationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[8]
+	mi := &file_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,7 +4969,7 @@ func (x *OccupationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OccupationInfo.ProtoReflect.Descriptor instead.
 func (*OccupationInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OccupationInfo) GetCategory() OccupationCategory {
@@ -4769,7 +5012,7 @@ type EducationInfo struct {
 
 func (x *EducationInfo) Reset() {
 	*x = EducationInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4781,7 +5024,7 @@ func (x *EducationInfo) String() string {
 func (*EducationInfo) ProtoMessage() {}
 
 func (x *EducationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4794,7 +5037,7 @@ func (x *EducationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EducationInfo.ProtoReflect.Descriptor instead.
 func (*EducationInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EducationInfo) GetLevel() EducationLevel {
@@ -4845,7 +5088,7 @@ type InterestInfo struct {
 
 func (x *InterestInfo) Reset() {
 	*x = InterestInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4857,7 +5100,7 @@ func (x *InterestInfo) String() string {
 func (*InterestInfo) ProtoMessage() {}
 
 func (x *InterestInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4870,7 +5113,7 @@ func (x *InterestInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterestInfo.ProtoReflect.Descriptor instead.
 func (*InterestInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InterestInfo) GetCategory() InterestCategory {
@@ -4902,7 +5145,7 @@ type LanguageInfo struct {
 
 func (x *LanguageInfo) Reset() {
 	*x = LanguageInfo{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4914,7 +5157,7 @@ func (x *LanguageInfo) String() string {
 func (*LanguageInfo) ProtoMessage() {}
 
 func (x *LanguageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4927,7 +5170,7 @@ func (x *LanguageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LanguageInfo.ProtoReflect.Descriptor instead.
 func (*LanguageInfo) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LanguageInfo) GetCode() LanguageCode {
@@ -4954,9 +5197,9 @@ func (x *LanguageInfo) GetProficiency() LanguageProficiency {
 // Profile prompt (like Hinge prompts)
 type ProfilePrompt struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Prompt ID
+	// Catalog prompt ID (see ListPrompts)
 	PromptId string `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
-	// Prompt question
+	// Prompt question (legacy; used to find the prompt when prompt_id is empty)
 	Question PromptQuestion `protobuf:"varint,2,opt,name=question,proto3,enum=datifyy.user.v1.PromptQuestion" json:"question,omitempty"`
 	// User's answer (text)
 	AnswerText string `protobuf:"bytes,3,opt,name=answer_text,json=answerText,proto3" json:"answer_text,omitempty"`
@@ -4970,7 +5213,7 @@ type ProfilePrompt struct {
 
 func (x *ProfilePrompt) Reset() {
 	*x = ProfilePrompt{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4982,7 +5225,7 @@ func (x *ProfilePrompt) String() string {
 func (*ProfilePrompt) ProtoMessage() {}
 
 func (x *ProfilePrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4995,7 +5238,7 @@ func (x *ProfilePrompt) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfilePrompt.ProtoReflect.Descriptor instead.
 func (*ProfilePrompt) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ProfilePrompt) GetPromptId() string {
@@ -5056,7 +5299,7 @@ type ProfilePhoto struct {
 
 func (x *ProfilePhoto) Reset() {
 	*x = ProfilePhoto{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5068,7 +5311,7 @@ func (x *ProfilePhoto) String() string {
 func (*ProfilePhoto) ProtoMessage() {}
 
 func (x *ProfilePhoto) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5081,7 +5324,7 @@ func (x *ProfilePhoto) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfilePhoto.ProtoReflect.Descriptor instead.
 func (*ProfilePhoto) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ProfilePhoto) GetPhotoId() string {
@@ -5158,7 +5401,7 @@ type AccountMetadata struct {
 
 func (x *AccountMetadata) Reset() {
 	*x = AccountMetadata{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5170,7 +5413,7 @@ func (x *AccountMetadata) String() string {
 func (*AccountMetadata) ProtoMessage() {}
 
 func (x *AccountMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5183,7 +5426,7 @@ func (x *AccountMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountMetadata.ProtoReflect.Descriptor instead.
 func (*AccountMetadata) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AccountMetadata) GetStatus() v1.AccountStatus {
@@ -5380,7 +5623,7 @@ type PartnerPreferences struct {
 
 func (x *PartnerPreferences) Reset() {
 	*x = PartnerPreferences{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5392,7 +5635,7 @@ func (x *PartnerPreferences) String() string {
 func (*PartnerPreferences) ProtoMessage() {}
 
 func (x *PartnerPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5405,7 +5648,7 @@ func (x *PartnerPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartnerPreferences.ProtoReflect.Descriptor instead.
 func (*PartnerPreferences) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PartnerPreferences) GetLookingForGender() []Gender {
@@ -5869,7 +6112,7 @@ type AgeRange struct {
 
 func (x *AgeRange) Reset() {
 	*x = AgeRange{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +6124,7 @@ func (x *AgeRange) String() string {
 func (*AgeRange) ProtoMessage() {}
 
 func (x *AgeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +6137,7 @@ func (x *AgeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgeRange.ProtoReflect.Descriptor instead.
 func (*AgeRange) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AgeRange) GetMinAge() int32 {
@@ -5924,7 +6167,7 @@ type HeightRange struct {
 
 func (x *HeightRange) Reset() {
 	*x = HeightRange{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5936,7 +6179,7 @@ func (x *HeightRange) String() string {
 func (*HeightRange) ProtoMessage() {}
 
 func (x *HeightRange) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5949,7 +6192,7 @@ func (x *HeightRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeightRange.ProtoReflect.Descriptor instead.
 func (*HeightRange) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HeightRange) GetMinHeight() int32 {
@@ -5981,7 +6224,7 @@ type DealBreaker struct {
 
 func (x *DealBreaker) Reset() {
 	*x = DealBreaker{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5993,7 +6236,7 @@ func (x *DealBreaker) String() string {
 func (*DealBreaker) ProtoMessage() {}
 
 func (x *DealBreaker) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6006,7 +6249,7 @@ func (x *DealBreaker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DealBreaker.ProtoReflect.Descriptor instead.
 func (*DealBreaker) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DealBreaker) GetType() DealBreakerType {
@@ -6045,7 +6288,7 @@ type MustHave struct {
 
 func (x *MustHave) Reset() {
 	*x = MustHave{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6057,7 +6300,7 @@ func (x *MustHave) String() string {
 func (*MustHave) ProtoMessage() {}
 
 func (x *MustHave) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6070,7 +6313,7 @@ func (x *MustHave) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MustHave.ProtoReflect.Descriptor instead.
 func (*MustHave) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MustHave) GetType() MustHaveType {
@@ -6094,6 +6337,97 @@ func (x *MustHave) GetPriority() int32 {
 	return 0
 }
 
+// Outcome of one partner-preference rule checked against another user's profile
+type PreferenceRuleResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable rule ID, e.g. "dealbreaker.smoking" or "preference.drinking"
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Hard rules (deal-breakers) exclude the other user when they fail;
+	// soft rules (must-haves, preference lists) only affect the score
+	Hard   bool `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"`
+	Passed bool `protobuf:"varint,3,opt,name=passed,proto3" json:"passed,omitempty"`
+	// False when the other user's profile doesn't say; unknown rules pass
+	Known bool `protobuf:"varint,4,opt,name=known,proto3" json:"known,omitempty"`
+	// Human-readable reason, e.g. "smokes (regularly)"
+	Explanation string `protobuf:"bytes,5,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// Weight (1-5) of the rule in the preference score
+	Weight        int32 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferenceRuleResult) Reset() {
+	*x = PreferenceRuleResult{}
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferenceRuleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferenceRuleResult) ProtoMessage() {}
+
+func (x *PreferenceRuleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferenceRuleResult.ProtoReflect.Descriptor instead.
+func (*PreferenceRuleResult) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PreferenceRuleResult) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PreferenceRuleResult) GetHard() bool {
+	if x != nil {
+		return x.Hard
+	}
+	return false
+}
+
+func (x *PreferenceRuleResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *PreferenceRuleResult) GetKnown() bool {
+	if x != nil {
+		return x.Known
+	}
+	return false
+}
+
+func (x *PreferenceRuleResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *PreferenceRuleResult) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
 // User app preferences (settings)
 type UserPreferences struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -6106,14 +6440,17 @@ type UserPreferences struct {
 	// Language preference for app
 	AppLanguage string `protobuf:"bytes,4,opt,name=app_language,json=appLanguage,proto3" json:"app_language,omitempty"`
 	// Theme preference (light/dark)
-	Theme         string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+	Theme string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+	// IANA timezone (e.g. "Asia/Kolkata") used for local availability times and
+	// to render dates, emails and invites; defaults to UTC
+	Timezone      string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserPreferences) Reset() {
 	*x = UserPreferences{}
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6125,7 +6462,7 @@ func (x *UserPreferences) String() string {
 func (*UserPreferences) ProtoMessage() {}
 
 func (x *UserPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6138,7 +6475,7 @@ func (x *UserPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
 func (*UserPreferences) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UserPreferences) GetNotifications() *NotificationPreferences {
@@ -6176,6 +6513,13 @@ func (x *UserPreferences) GetTheme() string {
 	return ""
 }
 
+func (x *UserPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 // Notification preferences
 type NotificationPreferences struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -6201,7 +6545,7 @@ type NotificationPreferences struct {
 
 func (x *NotificationPreferences) Reset() {
 	*x = NotificationPreferences{}
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6213,7 +6557,7 @@ func (x *NotificationPreferences) String() string {
 func (*NotificationPreferences) ProtoMessage() {}
 
 func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[21]
+	mi := &file_user_v1_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6226,7 +6570,7 @@ func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
 func (*NotificationPreferences) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *NotificationPreferences) GetPushEnabled() bool {
@@ -6308,7 +6652,7 @@ type PrivacyPreferences struct {
 
 func (x *PrivacyPreferences) Reset() {
 	*x = PrivacyPreferences{}
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6320,7 +6664,7 @@ func (x *PrivacyPreferences) String() string {
 func (*PrivacyPreferences) ProtoMessage() {}
 
 func (x *PrivacyPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[22]
+	mi := &file_user_v1_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6333,7 +6677,7 @@ func (x *PrivacyPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivacyPreferences.ProtoReflect.Descriptor instead.
 func (*PrivacyPreferences) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{22}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PrivacyPreferences) GetPublicProfile() bool {
@@ -6404,7 +6748,7 @@ type DiscoveryPreferences struct {
 
 func (x *DiscoveryPreferences) Reset() {
 	*x = DiscoveryPreferences{}
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6416,7 +6760,7 @@ func (x *DiscoveryPreferences) String() string {
 func (*DiscoveryPreferences) ProtoMessage() {}
 
 func (x *DiscoveryPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[23]
+	mi := &file_user_v1_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6773,7 @@ func (x *DiscoveryPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoveryPreferences.ProtoReflect.Descriptor instead.
 func (*DiscoveryPreferences) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{23}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DiscoveryPreferences) GetDiscoverable() bool {
@@ -6478,7 +6822,7 @@ type GetUserProfileRequest struct {
 
 func (x *GetUserProfileRequest) Reset() {
 	*x = GetUserProfileRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6490,7 +6834,7 @@ func (x *GetUserProfileRequest) String() string {
 func (*GetUserProfileRequest) ProtoMessage() {}
 
 func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[24]
+	mi := &file_user_v1_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6503,7 +6847,7 @@ func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{24}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetUserProfileRequest) GetUserId() string {
@@ -6523,7 +6867,7 @@ type GetUserProfileResponse struct {
 
 func (x *GetUserProfileResponse) Reset() {
 	*x = GetUserProfileResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6535,7 +6879,7 @@ func (x *GetUserProfileResponse) String() string {
 func (*GetUserProfileResponse) ProtoMessage() {}
 
 func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[25]
+	mi := &file_user_v1_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6548,7 +6892,7 @@ func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{25}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetUserProfileResponse) GetProfile() *UserProfile {
@@ -6567,7 +6911,7 @@ type GetMyProfileRequest struct {
 
 func (x *GetMyProfileRequest) Reset() {
 	*x = GetMyProfileRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6579,7 +6923,7 @@ func (x *GetMyProfileRequest) String() string {
 func (*GetMyProfileRequest) ProtoMessage() {}
 
 func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[26]
+	mi := &file_user_v1_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6592,7 +6936,7 @@ func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetMyProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{26}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
 }
 
 type GetMyProfileResponse struct {
@@ -6605,7 +6949,7 @@ type GetMyProfileResponse struct {
 
 func (x *GetMyProfileResponse) Reset() {
 	*x = GetMyProfileResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6617,7 +6961,7 @@ func (x *GetMyProfileResponse) String() string {
 func (*GetMyProfileResponse) ProtoMessage() {}
 
 func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[27]
+	mi := &file_user_v1_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6630,7 +6974,7 @@ func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetMyProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{27}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetMyProfileResponse) GetProfile() *UserProfile {
@@ -6667,7 +7011,7 @@ type UpdateProfileRequest struct {
 
 func (x *UpdateProfileRequest) Reset() {
 	*x = UpdateProfileRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6679,7 +7023,7 @@ func (x *UpdateProfileRequest) String() string {
 func (*UpdateProfileRequest) ProtoMessage() {}
 
 func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[28]
+	mi := &file_user_v1_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6692,7 +7036,7 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{28}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateProfileRequest) GetBasicInfo() *BasicInfo {
@@ -6770,7 +7114,7 @@ type UpdateProfileResponse struct {
 
 func (x *UpdateProfileResponse) Reset() {
 	*x = UpdateProfileResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6782,7 +7126,7 @@ func (x *UpdateProfileResponse) String() string {
 func (*UpdateProfileResponse) ProtoMessage() {}
 
 func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[29]
+	mi := &file_user_v1_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6795,7 +7139,7 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{29}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateProfileResponse) GetProfile() *UserProfile {
@@ -6812,6 +7156,196 @@ func (x *UpdateProfileResponse) GetMessage() string {
 	return ""
 }
 
+// List prompts
+type ListPromptsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Locale for the question text, e.g. "hi" or "en-IN" (defaults to English)
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Only prompts in this category (optional)
+	Category      string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsRequest) Reset() {
+	*x = ListPromptsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsRequest) ProtoMessage() {}
+
+func (x *ListPromptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromptsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPromptsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *ListPromptsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListPromptsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Active prompts, ordered by category and display order
+	Prompts       []*PromptDefinition `protobuf:"bytes,1,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromptsResponse) Reset() {
+	*x = ListPromptsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromptsResponse) ProtoMessage() {}
+
+func (x *ListPromptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromptsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromptsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPromptsResponse) GetPrompts() []*PromptDefinition {
+	if x != nil {
+		return x.Prompts
+	}
+	return nil
+}
+
+// A question from the prompts catalog
+type PromptDefinition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Prompt ID, referenced by ProfilePrompt.prompt_id
+	PromptId string `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	// Question text in the requested locale, falling back to English
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Category, e.g. about_me, dating, fun or values
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// Answer length limits, in characters
+	MinAnswerLength int32 `protobuf:"varint,4,opt,name=min_answer_length,json=minAnswerLength,proto3" json:"min_answer_length,omitempty"`
+	MaxAnswerLength int32 `protobuf:"varint,5,opt,name=max_answer_length,json=maxAnswerLength,proto3" json:"max_answer_length,omitempty"`
+	// Legacy enum value for prompts that predate the catalog
+	Question      PromptQuestion `protobuf:"varint,6,opt,name=question,proto3,enum=datifyy.user.v1.PromptQuestion" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromptDefinition) Reset() {
+	*x = PromptDefinition{}
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromptDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptDefinition) ProtoMessage() {}
+
+func (x *PromptDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptDefinition.ProtoReflect.Descriptor instead.
+func (*PromptDefinition) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PromptDefinition) GetPromptId() string {
+	if x != nil {
+		return x.PromptId
+	}
+	return ""
+}
+
+func (x *PromptDefinition) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PromptDefinition) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PromptDefinition) GetMinAnswerLength() int32 {
+	if x != nil {
+		return x.MinAnswerLength
+	}
+	return 0
+}
+
+func (x *PromptDefinition) GetMaxAnswerLength() int32 {
+	if x != nil {
+		return x.MaxAnswerLength
+	}
+	return 0
+}
+
+func (x *PromptDefinition) GetQuestion() PromptQuestion {
+	if x != nil {
+		return x.Question
+	}
+	return PromptQuestion_PROMPT_UNSPECIFIED
+}
+
 // Delete account
 type DeleteAccountRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -6825,7 +7359,7 @@ type DeleteAccountRequest struct {
 
 func (x *DeleteAccountRequest) Reset() {
 	*x = DeleteAccountRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6837,7 +7371,7 @@ func (x *DeleteAccountRequest) String() string {
 func (*DeleteAccountRequest) ProtoMessage() {}
 
 func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[30]
+	mi := &file_user_v1_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6850,7 +7384,7 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{30}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DeleteAccountRequest) GetPassword() string {
@@ -6872,14 +7406,16 @@ type DeleteAccountResponse struct {
 	// Success
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	// Message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// When the account will be permanently purged (logging in before then cancels deletion)
+	DeletionScheduledFor *v1.Timestamp `protobuf:"bytes,3,opt,name=deletion_scheduled_for,json=deletionScheduledFor,proto3" json:"deletion_scheduled_for,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *DeleteAccountResponse) Reset() {
 	*x = DeleteAccountResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6891,7 +7427,7 @@ func (x *DeleteAccountResponse) String() string {
 func (*DeleteAccountResponse) ProtoMessage() {}
 
 func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[31]
+	mi := &file_user_v1_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6904,7 +7440,7 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{31}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DeleteAccountResponse) GetSuccess() bool {
@@ -6921,6 +7457,13 @@ func (x *DeleteAccountResponse) GetMessage() string {
 	return ""
 }
 
+func (x *DeleteAccountResponse) GetDeletionScheduledFor() *v1.Timestamp {
+	if x != nil {
+		return x.DeletionScheduledFor
+	}
+	return nil
+}
+
 // Upload profile photo
 type UploadProfilePhotoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -6940,7 +7483,7 @@ type UploadProfilePhotoRequest struct {
 
 func (x *UploadProfilePhotoRequest) Reset() {
 	*x = UploadProfilePhotoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[32]
+	mi := &file_user_v1_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6952,7 +7495,7 @@ func (x *UploadProfilePhotoRequest) String() string {
 func (*UploadProfilePhotoRequest) ProtoMessage() {}
 
 func (x *UploadProfilePhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[32]
+	mi := &file_user_v1_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6965,7 +7508,7 @@ func (x *UploadProfilePhotoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadProfilePhotoRequest.ProtoReflect.Descriptor instead.
 func (*UploadProfilePhotoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{32}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UploadProfilePhotoRequest) GetPhotoData() []byte {
@@ -7015,7 +7558,7 @@ type UploadProfilePhotoResponse struct {
 
 func (x *UploadProfilePhotoResponse) Reset() {
 	*x = UploadProfilePhotoResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[33]
+	mi := &file_user_v1_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7027,7 +7570,7 @@ func (x *UploadProfilePhotoResponse) String() string {
 func (*UploadProfilePhotoResponse) ProtoMessage() {}
 
 func (x *UploadProfilePhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[33]
+	mi := &file_user_v1_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7040,7 +7583,7 @@ func (x *UploadProfilePhotoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadProfilePhotoResponse.ProtoReflect.Descriptor instead.
 func (*UploadProfilePhotoResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{33}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UploadProfilePhotoResponse) GetPhoto() *ProfilePhoto {
@@ -7068,7 +7611,7 @@ type DeleteProfilePhotoRequest struct {
 
 func (x *DeleteProfilePhotoRequest) Reset() {
 	*x = DeleteProfilePhotoRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[34]
+	mi := &file_user_v1_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7080,7 +7623,7 @@ func (x *DeleteProfilePhotoRequest) String() string {
 func (*DeleteProfilePhotoRequest) ProtoMessage() {}
 
 func (x *DeleteProfilePhotoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[34]
+	mi := &file_user_v1_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7093,7 +7636,7 @@ func (x *DeleteProfilePhotoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfilePhotoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProfilePhotoRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{34}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeleteProfilePhotoRequest) GetPhotoId() string {
@@ -7115,7 +7658,7 @@ type DeleteProfilePhotoResponse struct {
 
 func (x *DeleteProfilePhotoResponse) Reset() {
 	*x = DeleteProfilePhotoResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[35]
+	mi := &file_user_v1_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7127,7 +7670,7 @@ func (x *DeleteProfilePhotoResponse) String() string {
 func (*DeleteProfilePhotoResponse) ProtoMessage() {}
 
 func (x *DeleteProfilePhotoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[35]
+	mi := &file_user_v1_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7140,7 +7683,7 @@ func (x *DeleteProfilePhotoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProfilePhotoResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProfilePhotoResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{35}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *DeleteProfilePhotoResponse) GetSuccess() bool {
@@ -7170,7 +7713,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[36]
+	mi := &file_user_v1_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7182,7 +7725,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[36]
+	mi := &file_user_v1_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7195,7 +7738,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{36}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SearchUsersRequest) GetFilters() *SearchFilters {
@@ -7217,14 +7760,17 @@ type SearchUsersResponse struct {
 	// Matching users
 	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	// Pagination
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Whether the search stopped at its candidate limit, in which case
+	// pagination.total_count is a lower bound
+	ResultsCapped bool `protobuf:"varint,3,opt,name=results_capped,json=resultsCapped,proto3" json:"results_capped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[37]
+	mi := &file_user_v1_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7236,7 +7782,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[37]
+	mi := &file_user_v1_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7249,7 +7795,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{37}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*UserProfile {
@@ -7266,6 +7812,13 @@ func (x *SearchUsersResponse) GetPagination() *v1.PaginationResponse {
 	return nil
 }
 
+func (x *SearchUsersResponse) GetResultsCapped() bool {
+	if x != nil {
+		return x.ResultsCapped
+	}
+	return false
+}
+
 // Search filters
 type SearchFilters struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -7307,7 +7860,7 @@ type SearchFilters struct {
 
 func (x *SearchFilters) Reset() {
 	*x = SearchFilters{}
-	mi := &file_user_v1_user_proto_msgTypes[38]
+	mi := &file_user_v1_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7319,7 +7872,7 @@ func (x *SearchFilters) String() string {
 func (*SearchFilters) ProtoMessage() {}
 
 func (x *SearchFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[38]
+	mi := &file_user_v1_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7332,7 +7885,7 @@ func (x *SearchFilters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchFilters.ProtoReflect.Descriptor instead.
 func (*SearchFilters) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{38}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SearchFilters) GetGender() []Gender {
@@ -7472,7 +8025,7 @@ type GetRecommendationsRequest struct {
 
 func (x *GetRecommendationsRequest) Reset() {
 	*x = GetRecommendationsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[39]
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7484,7 +8037,7 @@ func (x *GetRecommendationsRequest) String() string {
 func (*GetRecommendationsRequest) ProtoMessage() {}
 
 func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[39]
+	mi := &file_user_v1_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7497,7 +8050,7 @@ func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
 func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{39}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetRecommendationsRequest) GetLimit() int32 {
@@ -7512,14 +8065,17 @@ type GetRecommendationsResponse struct {
 	// Recommended users
 	Recommendations []*UserProfile `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
 	// Total available recommendations
-	TotalCount    int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Whether recommendations stopped at their candidate limit, in which case
+	// total_count is a lower bound
+	ResultsCapped bool `protobuf:"varint,3,opt,name=results_capped,json=resultsCapped,proto3" json:"results_capped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetRecommendationsResponse) Reset() {
 	*x = GetRecommendationsResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[40]
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7531,7 +8087,7 @@ func (x *GetRecommendationsResponse) String() string {
 func (*GetRecommendationsResponse) ProtoMessage() {}
 
 func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[40]
+	mi := &file_user_v1_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7544,7 +8100,7 @@ func (x *GetRecommendationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecommendationsResponse.ProtoReflect.Descriptor instead.
 func (*GetRecommendationsResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{40}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetRecommendationsResponse) GetRecommendations() []*UserProfile {
@@ -7561,28 +8117,37 @@ func (x *GetRecommendationsResponse) GetTotalCount() int32 {
 	return 0
 }
 
-// Get partner preferences
-type GetPartnerPreferencesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetRecommendationsResponse) GetResultsCapped() bool {
+	if x != nil {
+		return x.ResultsCapped
+	}
+	return false
+}
+
+// List profile viewers
+type ListProfileViewersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination
+	Pagination    *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartnerPreferencesRequest) Reset() {
-	*x = GetPartnerPreferencesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[41]
+func (x *ListProfileViewersRequest) Reset() {
+	*x = ListProfileViewersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartnerPreferencesRequest) String() string {
+func (x *ListProfileViewersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartnerPreferencesRequest) ProtoMessage() {}
+func (*ListProfileViewersRequest) ProtoMessage() {}
 
-func (x *GetPartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[41]
+func (x *ListProfileViewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7593,34 +8158,43 @@ func (x *GetPartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartnerPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetPartnerPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListProfileViewersRequest.ProtoReflect.Descriptor instead.
+func (*ListProfileViewersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
 }
 
-type GetPartnerPreferencesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Partner preferences
-	Preferences   *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+func (x *ListProfileViewersRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListProfileViewersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Viewers, most recent first
+	Viewers []*ProfileViewer `protobuf:"bytes,1,rep,name=viewers,proto3" json:"viewers,omitempty"`
+	// Pagination
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPartnerPreferencesResponse) Reset() {
-	*x = GetPartnerPreferencesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[42]
+func (x *ListProfileViewersResponse) Reset() {
+	*x = ListProfileViewersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPartnerPreferencesResponse) String() string {
+func (x *ListProfileViewersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartnerPreferencesResponse) ProtoMessage() {}
+func (*ListProfileViewersResponse) ProtoMessage() {}
 
-func (x *GetPartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[42]
+func (x *ListProfileViewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7631,44 +8205,51 @@ func (x *GetPartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartnerPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetPartnerPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListProfileViewersResponse.ProtoReflect.Descriptor instead.
+func (*ListProfileViewersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetPartnerPreferencesResponse) GetPreferences() *PartnerPreferences {
+func (x *ListProfileViewersResponse) GetViewers() []*ProfileViewer {
 	if x != nil {
-		return x.Preferences
+		return x.Viewers
 	}
 	return nil
 }
 
-// Update partner preferences
-type UpdatePartnerPreferencesRequest struct {
+func (x *ListProfileViewersResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A user who viewed a profile
+type ProfileViewer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updated partner preferences
-	Preferences *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	// Fields to update
-	UpdateFields  []string `protobuf:"bytes,2,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
+	// Viewer's profile, redacted by their privacy settings
+	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// When they last viewed the profile
+	ViewedAt      *v1.Timestamp `protobuf:"bytes,2,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePartnerPreferencesRequest) Reset() {
-	*x = UpdatePartnerPreferencesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[43]
+func (x *ProfileViewer) Reset() {
+	*x = ProfileViewer{}
+	mi := &file_user_v1_user_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePartnerPreferencesRequest) String() string {
+func (x *ProfileViewer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePartnerPreferencesRequest) ProtoMessage() {}
+func (*ProfileViewer) ProtoMessage() {}
 
-func (x *UpdatePartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[43]
+func (x *ProfileViewer) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7679,50 +8260,49 @@ func (x *UpdatePartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePartnerPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePartnerPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ProfileViewer.ProtoReflect.Descriptor instead.
+func (*ProfileViewer) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdatePartnerPreferencesRequest) GetPreferences() *PartnerPreferences {
+func (x *ProfileViewer) GetProfile() *UserProfile {
 	if x != nil {
-		return x.Preferences
+		return x.Profile
 	}
 	return nil
 }
 
-func (x *UpdatePartnerPreferencesRequest) GetUpdateFields() []string {
+func (x *ProfileViewer) GetViewedAt() *v1.Timestamp {
 	if x != nil {
-		return x.UpdateFields
+		return x.ViewedAt
 	}
 	return nil
 }
 
-type UpdatePartnerPreferencesResponse struct {
+// Like a user
+type LikeUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updated preferences
-	Preferences *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	// Success message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// User ID to like
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePartnerPreferencesResponse) Reset() {
-	*x = UpdatePartnerPreferencesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[44]
+func (x *LikeUserRequest) Reset() {
+	*x = LikeUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePartnerPreferencesResponse) String() string {
+func (x *LikeUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePartnerPreferencesResponse) ProtoMessage() {}
+func (*LikeUserRequest) ProtoMessage() {}
 
-func (x *UpdatePartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[44]
+func (x *LikeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7733,47 +8313,47 @@ func (x *UpdatePartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePartnerPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePartnerPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *UpdatePartnerPreferencesResponse) GetPreferences() *PartnerPreferences {
-	if x != nil {
-		return x.Preferences
-	}
-	return nil
+// Deprecated: Use LikeUserRequest.ProtoReflect.Descriptor instead.
+func (*LikeUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *UpdatePartnerPreferencesResponse) GetMessage() string {
+func (x *LikeUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-// Get user preferences
-type GetUserPreferencesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type LikeUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the other user already liked the caller
+	IsMutual bool `protobuf:"varint,1,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`
+	// Curated match created for genies when the like is mutual
+	MatchId string `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Likes left today; the day is the caller's timezone preference (UTC if unset)
+	RemainingToday int32 `protobuf:"varint,3,opt,name=remaining_today,json=remainingToday,proto3" json:"remaining_today,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPreferencesRequest) Reset() {
-	*x = GetUserPreferencesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[45]
+func (x *LikeUserResponse) Reset() {
+	*x = LikeUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPreferencesRequest) String() string {
+func (x *LikeUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPreferencesRequest) ProtoMessage() {}
+func (*LikeUserResponse) ProtoMessage() {}
 
-func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[45]
+func (x *LikeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7784,34 +8364,63 @@ func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use LikeUserResponse.ProtoReflect.Descriptor instead.
+func (*LikeUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
 }
 
-type GetUserPreferencesResponse struct {
+func (x *LikeUserResponse) GetIsMutual() bool {
+	if x != nil {
+		return x.IsMutual
+	}
+	return false
+}
+
+func (x *LikeUserResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *LikeUserResponse) GetRemainingToday() int32 {
+	if x != nil {
+		return x.RemainingToday
+	}
+	return 0
+}
+
+func (x *LikeUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Super like a user
+type SuperLikeUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User app preferences
-	Preferences   *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// User ID to super like
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserPreferencesResponse) Reset() {
-	*x = GetUserPreferencesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[46]
+func (x *SuperLikeUserRequest) Reset() {
+	*x = SuperLikeUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserPreferencesResponse) String() string {
+func (x *SuperLikeUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserPreferencesResponse) ProtoMessage() {}
+func (*SuperLikeUserRequest) ProtoMessage() {}
 
-func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[46]
+func (x *SuperLikeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7822,44 +8431,47 @@ func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SuperLikeUserRequest.ProtoReflect.Descriptor instead.
+func (*SuperLikeUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetUserPreferencesResponse) GetPreferences() *UserPreferences {
+func (x *SuperLikeUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Preferences
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// Update user preferences
-type UpdateUserPreferencesRequest struct {
+type SuperLikeUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updated user preferences
-	Preferences *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	// Fields to update
-	UpdateFields  []string `protobuf:"bytes,2,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
+	// Whether the other user already liked the caller
+	IsMutual bool `protobuf:"varint,1,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`
+	// Curated match created for genies when the like is mutual
+	MatchId string `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Super likes left today; the day is the caller's timezone preference (UTC if unset)
+	RemainingToday int32 `protobuf:"varint,3,opt,name=remaining_today,json=remainingToday,proto3" json:"remaining_today,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserPreferencesRequest) Reset() {
-	*x = UpdateUserPreferencesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[47]
+func (x *SuperLikeUserResponse) Reset() {
+	*x = SuperLikeUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserPreferencesRequest) String() string {
+func (x *SuperLikeUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPreferencesRequest) ProtoMessage() {}
+func (*SuperLikeUserResponse) ProtoMessage() {}
 
-func (x *UpdateUserPreferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[47]
+func (x *SuperLikeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7870,50 +8482,63 @@ func (x *UpdateUserPreferencesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPreferencesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserPreferencesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SuperLikeUserResponse.ProtoReflect.Descriptor instead.
+func (*SuperLikeUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UpdateUserPreferencesRequest) GetPreferences() *UserPreferences {
+func (x *SuperLikeUserResponse) GetIsMutual() bool {
 	if x != nil {
-		return x.Preferences
+		return x.IsMutual
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateUserPreferencesRequest) GetUpdateFields() []string {
+func (x *SuperLikeUserResponse) GetMatchId() string {
 	if x != nil {
-		return x.UpdateFields
+		return x.MatchId
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserPreferencesResponse struct {
+func (x *SuperLikeUserResponse) GetRemainingToday() int32 {
+	if x != nil {
+		return x.RemainingToday
+	}
+	return 0
+}
+
+func (x *SuperLikeUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Pass on a user
+type PassUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updated preferences
-	Preferences *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	// Success message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// User ID to pass on
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserPreferencesResponse) Reset() {
-	*x = UpdateUserPreferencesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[48]
+func (x *PassUserRequest) Reset() {
+	*x = PassUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserPreferencesResponse) String() string {
+func (x *PassUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPreferencesResponse) ProtoMessage() {}
+func (*PassUserRequest) ProtoMessage() {}
 
-func (x *UpdateUserPreferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[48]
+func (x *PassUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7924,51 +8549,41 @@ func (x *UpdateUserPreferencesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPreferencesResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserPreferencesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *UpdateUserPreferencesResponse) GetPreferences() *UserPreferences {
-	if x != nil {
-		return x.Preferences
-	}
-	return nil
+// Deprecated: Use PassUserRequest.ProtoReflect.Descriptor instead.
+func (*PassUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateUserPreferencesResponse) GetMessage() string {
+func (x *PassUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-// Block user
-type BlockUserRequest struct {
+type PassUserResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID to block
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Optional reason
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserRequest) Reset() {
-	*x = BlockUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[49]
+func (x *PassUserResponse) Reset() {
+	*x = PassUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserRequest) String() string {
+func (x *PassUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserRequest) ProtoMessage() {}
+func (*PassUserResponse) ProtoMessage() {}
 
-func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[49]
+func (x *PassUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7979,50 +8594,42 @@ func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
-func (*BlockUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *BlockUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use PassUserResponse.ProtoReflect.Descriptor instead.
+func (*PassUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *BlockUserRequest) GetReason() string {
+func (x *PassUserResponse) GetMessage() string {
 	if x != nil {
-		return x.Reason
+		return x.Message
 	}
 	return ""
 }
 
-type BlockUserResponse struct {
+// List likes received
+type ListLikesReceivedRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Pagination
+	Pagination    *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockUserResponse) Reset() {
-	*x = BlockUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[50]
+func (x *ListLikesReceivedRequest) Reset() {
+	*x = ListLikesReceivedRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockUserResponse) String() string {
+func (x *ListLikesReceivedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserResponse) ProtoMessage() {}
+func (*ListLikesReceivedRequest) ProtoMessage() {}
 
-func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[50]
+func (x *ListLikesReceivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8033,49 +8640,43 @@ func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
-func (*BlockUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *BlockUserResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ListLikesReceivedRequest.ProtoReflect.Descriptor instead.
+func (*ListLikesReceivedRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *BlockUserResponse) GetMessage() string {
+func (x *ListLikesReceivedRequest) GetPagination() *v1.PaginationRequest {
 	if x != nil {
-		return x.Message
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-// Unblock user
-type UnblockUserRequest struct {
+type ListLikesReceivedResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID to unblock
-	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Likes, super likes first then most recent
+	Likes []*ReceivedLike `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes,omitempty"`
+	// Pagination
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnblockUserRequest) Reset() {
-	*x = UnblockUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[51]
+func (x *ListLikesReceivedResponse) Reset() {
+	*x = ListLikesReceivedResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnblockUserRequest) String() string {
+func (x *ListLikesReceivedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserRequest) ProtoMessage() {}
+func (*ListLikesReceivedResponse) ProtoMessage() {}
 
-func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[51]
+func (x *ListLikesReceivedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8086,43 +8687,53 @@ func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
-func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListLikesReceivedResponse.ProtoReflect.Descriptor instead.
+func (*ListLikesReceivedResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *UnblockUserRequest) GetUserId() string {
+func (x *ListLikesReceivedResponse) GetLikes() []*ReceivedLike {
 	if x != nil {
-		return x.UserId
+		return x.Likes
 	}
-	return ""
+	return nil
 }
 
-type UnblockUserResponse struct {
+func (x *ListLikesReceivedResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A like someone sent to the caller
+type ReceivedLike struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// Message
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Sender's profile, redacted by their privacy settings
+	Profile *UserProfile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Whether it was a super like
+	IsSuperLike bool `protobuf:"varint,2,opt,name=is_super_like,json=isSuperLike,proto3" json:"is_super_like,omitempty"`
+	// When they liked the caller
+	LikedAt       *v1.Timestamp `protobuf:"bytes,3,opt,name=liked_at,json=likedAt,proto3" json:"liked_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnblockUserResponse) Reset() {
-	*x = UnblockUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[52]
+func (x *ReceivedLike) Reset() {
+	*x = ReceivedLike{}
+	mi := &file_user_v1_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnblockUserResponse) String() string {
+func (x *ReceivedLike) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserResponse) ProtoMessage() {}
+func (*ReceivedLike) ProtoMessage() {}
 
-func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[52]
+func (x *ReceivedLike) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8133,49 +8744,54 @@ func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
-func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ReceivedLike.ProtoReflect.Descriptor instead.
+func (*ReceivedLike) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UnblockUserResponse) GetSuccess() bool {
+func (x *ReceivedLike) GetProfile() *UserProfile {
 	if x != nil {
-		return x.Success
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ReceivedLike) GetIsSuperLike() bool {
+	if x != nil {
+		return x.IsSuperLike
 	}
 	return false
 }
 
-func (x *UnblockUserResponse) GetMessage() string {
+func (x *ReceivedLike) GetLikedAt() *v1.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.LikedAt
 	}
-	return ""
+	return nil
 }
 
-// List blocked users
-type ListBlockedUsersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Pagination
-	Pagination    *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// Get partner preferences
+type GetPartnerPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBlockedUsersRequest) Reset() {
-	*x = ListBlockedUsersRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[53]
+func (x *GetPartnerPreferencesRequest) Reset() {
+	*x = GetPartnerPreferencesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBlockedUsersRequest) String() string {
+func (x *GetPartnerPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBlockedUsersRequest) ProtoMessage() {}
+func (*GetPartnerPreferencesRequest) ProtoMessage() {}
 
-func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[53]
+func (x *GetPartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8186,43 +8802,34 @@ func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
-func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *ListBlockedUsersRequest) GetPagination() *v1.PaginationRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use GetPartnerPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPartnerPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{58}
 }
 
-type ListBlockedUsersResponse struct {
+type GetPartnerPreferencesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Blocked users
-	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	// Pagination
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Partner preferences
+	Preferences   *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBlockedUsersResponse) Reset() {
-	*x = ListBlockedUsersResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[54]
+func (x *GetPartnerPreferencesResponse) Reset() {
+	*x = GetPartnerPreferencesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBlockedUsersResponse) String() string {
+func (x *GetPartnerPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBlockedUsersResponse) ProtoMessage() {}
+func (*GetPartnerPreferencesResponse) ProtoMessage() {}
 
-func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[54]
+func (x *GetPartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8233,55 +8840,44 @@ func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
-func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *ListBlockedUsersResponse) GetUsers() []*UserProfile {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use GetPartnerPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPartnerPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListBlockedUsersResponse) GetPagination() *v1.PaginationResponse {
+func (x *GetPartnerPreferencesResponse) GetPreferences() *PartnerPreferences {
 	if x != nil {
-		return x.Pagination
+		return x.Preferences
 	}
 	return nil
 }
 
-// Report user
-type ReportUserRequest struct {
+// Update partner preferences
+type UpdatePartnerPreferencesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID to report
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Report reason
-	Reason ReportReason `protobuf:"varint,2,opt,name=reason,proto3,enum=datifyy.user.v1.ReportReason" json:"reason,omitempty"`
-	// Additional details
-	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
-	// Evidence (screenshot URLs, etc.)
-	EvidenceUrls  []string `protobuf:"bytes,4,rep,name=evidence_urls,json=evidenceUrls,proto3" json:"evidence_urls,omitempty"`
+	// Updated partner preferences
+	Preferences *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Fields to update
+	UpdateFields  []string `protobuf:"bytes,2,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportUserRequest) Reset() {
-	*x = ReportUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[55]
+func (x *UpdatePartnerPreferencesRequest) Reset() {
+	*x = UpdatePartnerPreferencesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportUserRequest) String() string {
+func (x *UpdatePartnerPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportUserRequest) ProtoMessage() {}
+func (*UpdatePartnerPreferencesRequest) ProtoMessage() {}
 
-func (x *ReportUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[55]
+func (x *UpdatePartnerPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8292,64 +8888,50 @@ func (x *ReportUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportUserRequest.ProtoReflect.Descriptor instead.
-func (*ReportUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *ReportUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ReportUserRequest) GetReason() ReportReason {
-	if x != nil {
-		return x.Reason
-	}
-	return ReportReason_REPORT_REASON_UNSPECIFIED
+// Deprecated: Use UpdatePartnerPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartnerPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ReportUserRequest) GetDetails() string {
+func (x *UpdatePartnerPreferencesRequest) GetPreferences() *PartnerPreferences {
 	if x != nil {
-		return x.Details
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-func (x *ReportUserRequest) GetEvidenceUrls() []string {
+func (x *UpdatePartnerPreferencesRequest) GetUpdateFields() []string {
 	if x != nil {
-		return x.EvidenceUrls
+		return x.UpdateFields
 	}
 	return nil
 }
 
-type ReportUserResponse struct {
+type UpdatePartnerPreferencesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Report ID
-	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// Updated preferences
+	Preferences *PartnerPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	// Success message
 	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportUserResponse) Reset() {
-	*x = ReportUserResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[56]
+func (x *UpdatePartnerPreferencesResponse) Reset() {
+	*x = UpdatePartnerPreferencesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportUserResponse) String() string {
+func (x *UpdatePartnerPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportUserResponse) ProtoMessage() {}
+func (*UpdatePartnerPreferencesResponse) ProtoMessage() {}
 
-func (x *ReportUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[56]
+func (x *UpdatePartnerPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8360,33 +8942,778 @@ func (x *ReportUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportUserResponse.ProtoReflect.Descriptor instead.
-func (*ReportUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UpdatePartnerPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartnerPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ReportUserResponse) GetReportId() string {
+func (x *UpdatePartnerPreferencesResponse) GetPreferences() *PartnerPreferences {
 	if x != nil {
-		return x.ReportId
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-func (x *ReportUserResponse) GetMessage() string {
+func (x *UpdatePartnerPreferencesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// User summary for date displays (lighter than full UserProfile)
-type UserSummary struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Age
+// Get user preferences
+type GetUserPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPreferencesRequest) Reset() {
+	*x = GetUserPreferencesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPreferencesRequest) ProtoMessage() {}
+
+func (x *GetUserPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{62}
+}
+
+type GetUserPreferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User app preferences
+	Preferences   *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPreferencesResponse) Reset() {
+	*x = GetUserPreferencesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPreferencesResponse) ProtoMessage() {}
+
+func (x *GetUserPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetUserPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// Update user preferences
+type UpdateUserPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated user preferences
+	Preferences *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Fields to update
+	UpdateFields  []string `protobuf:"bytes,2,rep,name=update_fields,json=updateFields,proto3" json:"update_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPreferencesRequest) Reset() {
+	*x = UpdateUserPreferencesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateUserPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateUserPreferencesRequest) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateUserPreferencesRequest) GetUpdateFields() []string {
+	if x != nil {
+		return x.UpdateFields
+	}
+	return nil
+}
+
+type UpdateUserPreferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated preferences
+	Preferences *UserPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserPreferencesResponse) Reset() {
+	*x = UpdateUserPreferencesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateUserPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateUserPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateUserPreferencesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Block user
+type BlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID to block
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional reason
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BlockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Unblock user
+type UnblockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID to unblock
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UnblockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnblockUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// List blocked users
+type ListBlockedUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination
+	Pagination    *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListBlockedUsersRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListBlockedUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Blocked users
+	Users []*UserProfile `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Pagination
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*UserProfile {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// Report user
+type ReportUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID to report
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Report reason
+	Reason ReportReason `protobuf:"varint,2,opt,name=reason,proto3,enum=datifyy.user.v1.ReportReason" json:"reason,omitempty"`
+	// Additional details
+	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// Evidence (screenshot URLs, etc.)
+	EvidenceUrls  []string `protobuf:"bytes,4,rep,name=evidence_urls,json=evidenceUrls,proto3" json:"evidence_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportUserRequest) Reset() {
+	*x = ReportUserRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportUserRequest) ProtoMessage() {}
+
+func (x *ReportUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportUserRequest.ProtoReflect.Descriptor instead.
+func (*ReportUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReportUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReportUserRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportUserRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *ReportUserRequest) GetEvidenceUrls() []string {
+	if x != nil {
+		return x.EvidenceUrls
+	}
+	return nil
+}
+
+type ReportUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Report ID
+	ReportId string `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportUserResponse) Reset() {
+	*x = ReportUserResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportUserResponse) ProtoMessage() {}
+
+func (x *ReportUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportUserResponse.ProtoReflect.Descriptor instead.
+func (*ReportUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReportUserResponse) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ReportUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Appeal a suspension or ban
+type AppealSuspensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Credentials identify the user, since restricted accounts cannot sign in.
+	// Ignored when the call is authenticated.
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Why the suspension or ban should be lifted
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealSuspensionRequest) Reset() {
+	*x = AppealSuspensionRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealSuspensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealSuspensionRequest) ProtoMessage() {}
+
+func (x *AppealSuspensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealSuspensionRequest.ProtoReflect.Descriptor instead.
+func (*AppealSuspensionRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AppealSuspensionRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AppealSuspensionRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AppealSuspensionRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AppealSuspensionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Appeal ID
+	AppealId string `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	// Success message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealSuspensionResponse) Reset() {
+	*x = AppealSuspensionResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealSuspensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealSuspensionResponse) ProtoMessage() {}
+
+func (x *AppealSuspensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealSuspensionResponse.ProtoReflect.Descriptor instead.
+func (*AppealSuspensionResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AppealSuspensionResponse) GetAppealId() string {
+	if x != nil {
+		return x.AppealId
+	}
+	return ""
+}
+
+func (x *AppealSuspensionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// User summary for date displays (lighter than full UserProfile)
+type UserSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Age
 	Age int32 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
 	// Gender
 	Gender Gender `protobuf:"varint,4,opt,name=gender,proto3,enum=datifyy.user.v1.Gender" json:"gender,omitempty"`
@@ -8402,21 +9729,991 @@ type UserSummary struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSummary) Reset() {
-	*x = UserSummary{}
-	mi := &file_user_v1_user_proto_msgTypes[57]
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_user_v1_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UserSummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSummary) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *UserSummary) GetGender() Gender {
+	if x != nil {
+		return x.Gender
+	}
+	return Gender_GENDER_UNSPECIFIED
+}
+
+func (x *UserSummary) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UserSummary) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *UserSummary) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UserSummary) GetOccupation() string {
+	if x != nil {
+		return x.Occupation
+	}
+	return ""
+}
+
+// Date suggestion detail (pending suggestions)
+type DateSuggestionDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggestion ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Suggested user details
+	SuggestedUser *UserSummary `protobuf:"bytes,2,opt,name=suggested_user,json=suggestedUser,proto3" json:"suggested_user,omitempty"`
+	// Compatibility score
+	CompatibilityScore float64 `protobuf:"fixed64,3,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
+	// AI reasoning
+	Reasoning string `protobuf:"bytes,4,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
+	// Status (pending, accepted, rejected)
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Created timestamp
+	CreatedAt     *v1.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateSuggestionDetail) Reset() {
+	*x = DateSuggestionDetail{}
+	mi := &file_user_v1_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateSuggestionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateSuggestionDetail) ProtoMessage() {}
+
+func (x *DateSuggestionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateSuggestionDetail.ProtoReflect.Descriptor instead.
+func (*DateSuggestionDetail) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DateSuggestionDetail) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DateSuggestionDetail) GetSuggestedUser() *UserSummary {
+	if x != nil {
+		return x.SuggestedUser
+	}
+	return nil
+}
+
+func (x *DateSuggestionDetail) GetCompatibilityScore() float64 {
+	if x != nil {
+		return x.CompatibilityScore
+	}
+	return 0
+}
+
+func (x *DateSuggestionDetail) GetReasoning() string {
+	if x != nil {
+		return x.Reasoning
+	}
+	return ""
+}
+
+func (x *DateSuggestionDetail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DateSuggestionDetail) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Scheduled date detail (upcoming/past dates)
+type ScheduledDateDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Date ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Other user (not the current user)
+	OtherUser *UserSummary `protobuf:"bytes,2,opt,name=other_user,json=otherUser,proto3" json:"other_user,omitempty"`
+	// Scheduled time
+	ScheduledTime *v1.Timestamp `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	// Duration in minutes
+	DurationMinutes int32 `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	// Status (scheduled, confirmed, in_progress, completed, cancelled, no_show)
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Date type (online, offline, event)
+	DateType string `protobuf:"bytes,6,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
+	// Location details (for offline dates)
+	PlaceName string `protobuf:"bytes,7,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
+	Address   string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	City      string `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	// Notes (includes Google Meet link for online dates)
+	Notes string `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Created timestamp
+	CreatedAt *v1.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Confirmed timestamp
+	ConfirmedAt *v1.Timestamp `protobuf:"bytes,12,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
+	// Completed timestamp
+	CompletedAt   *v1.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledDateDetail) Reset() {
+	*x = ScheduledDateDetail{}
+	mi := &file_user_v1_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledDateDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledDateDetail) ProtoMessage() {}
+
+func (x *ScheduledDateDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledDateDetail.ProtoReflect.Descriptor instead.
+func (*ScheduledDateDetail) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduledDateDetail) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledDateDetail) GetOtherUser() *UserSummary {
+	if x != nil {
+		return x.OtherUser
+	}
+	return nil
+}
+
+func (x *ScheduledDateDetail) GetScheduledTime() *v1.Timestamp {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *ScheduledDateDetail) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *ScheduledDateDetail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetPlaceName() string {
+	if x != nil {
+		return x.PlaceName
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ScheduledDateDetail) GetCreatedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledDateDetail) GetConfirmedAt() *v1.Timestamp {
+	if x != nil {
+		return x.ConfirmedAt
+	}
+	return nil
+}
+
+func (x *ScheduledDateDetail) GetCompletedAt() *v1.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// Rejected date detail
+type RejectedDateDetail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Suggestion ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Rejected user details
+	RejectedUser *UserSummary `protobuf:"bytes,2,opt,name=rejected_user,json=rejectedUser,proto3" json:"rejected_user,omitempty"`
+	// Compatibility score
+	CompatibilityScore float64 `protobuf:"fixed64,3,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
+	// Rejected timestamp
+	RejectedAt    *v1.Timestamp `protobuf:"bytes,4,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedDateDetail) Reset() {
+	*x = RejectedDateDetail{}
+	mi := &file_user_v1_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedDateDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedDateDetail) ProtoMessage() {}
+
+func (x *RejectedDateDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedDateDetail.ProtoReflect.Descriptor instead.
+func (*RejectedDateDetail) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RejectedDateDetail) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectedDateDetail) GetRejectedUser() *UserSummary {
+	if x != nil {
+		return x.RejectedUser
+	}
+	return nil
+}
+
+func (x *RejectedDateDetail) GetCompatibilityScore() float64 {
+	if x != nil {
+		return x.CompatibilityScore
+	}
+	return 0
+}
+
+func (x *RejectedDateDetail) GetRejectedAt() *v1.Timestamp {
+	if x != nil {
+		return x.RejectedAt
+	}
+	return nil
+}
+
+// Love Zone statistics
+type LoveZoneStatistics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total date suggestions received
+	TotalSuggestions int32 `protobuf:"varint,1,opt,name=total_suggestions,json=totalSuggestions,proto3" json:"total_suggestions,omitempty"`
+	// Pending suggestions count
+	PendingSuggestions int32 `protobuf:"varint,2,opt,name=pending_suggestions,json=pendingSuggestions,proto3" json:"pending_suggestions,omitempty"`
+	// Accepted suggestions count
+	AcceptedSuggestions int32 `protobuf:"varint,3,opt,name=accepted_suggestions,json=acceptedSuggestions,proto3" json:"accepted_suggestions,omitempty"`
+	// Rejected suggestions count
+	RejectedSuggestions int32 `protobuf:"varint,4,opt,name=rejected_suggestions,json=rejectedSuggestions,proto3" json:"rejected_suggestions,omitempty"`
+	// Total scheduled dates
+	TotalScheduledDates int32 `protobuf:"varint,5,opt,name=total_scheduled_dates,json=totalScheduledDates,proto3" json:"total_scheduled_dates,omitempty"`
+	// Upcoming dates count
+	UpcomingDates int32 `protobuf:"varint,6,opt,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
+	// Past dates count
+	PastDates int32 `protobuf:"varint,7,opt,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
+	// Completed dates count
+	CompletedDates int32 `protobuf:"varint,8,opt,name=completed_dates,json=completedDates,proto3" json:"completed_dates,omitempty"`
+	// Cancelled dates count
+	CancelledDates int32 `protobuf:"varint,9,opt,name=cancelled_dates,json=cancelledDates,proto3" json:"cancelled_dates,omitempty"`
+	// Acceptance rate (percentage)
+	AcceptanceRate float64 `protobuf:"fixed64,10,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`
+	// Completion rate (percentage)
+	CompletionRate float64 `protobuf:"fixed64,11,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoveZoneStatistics) Reset() {
+	*x = LoveZoneStatistics{}
+	mi := &file_user_v1_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoveZoneStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoveZoneStatistics) ProtoMessage() {}
+
+func (x *LoveZoneStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoveZoneStatistics.ProtoReflect.Descriptor instead.
+func (*LoveZoneStatistics) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LoveZoneStatistics) GetTotalSuggestions() int32 {
+	if x != nil {
+		return x.TotalSuggestions
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetPendingSuggestions() int32 {
+	if x != nil {
+		return x.PendingSuggestions
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetAcceptedSuggestions() int32 {
+	if x != nil {
+		return x.AcceptedSuggestions
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetRejectedSuggestions() int32 {
+	if x != nil {
+		return x.RejectedSuggestions
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetTotalScheduledDates() int32 {
+	if x != nil {
+		return x.TotalScheduledDates
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetUpcomingDates() int32 {
+	if x != nil {
+		return x.UpcomingDates
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetPastDates() int32 {
+	if x != nil {
+		return x.PastDates
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetCompletedDates() int32 {
+	if x != nil {
+		return x.CompletedDates
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetCancelledDates() int32 {
+	if x != nil {
+		return x.CancelledDates
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetAcceptanceRate() float64 {
+	if x != nil {
+		return x.AcceptanceRate
+	}
+	return 0
+}
+
+func (x *LoveZoneStatistics) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+// Get Love Zone Dashboard (all-in-one)
+type GetLoveZoneDashboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (from auth context)
+	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoveZoneDashboardRequest) Reset() {
+	*x = GetLoveZoneDashboardRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoveZoneDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoveZoneDashboardRequest) ProtoMessage() {}
+
+func (x *GetLoveZoneDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoveZoneDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLoveZoneDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetLoveZoneDashboardRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetLoveZoneDashboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pending date suggestions
+	PendingSuggestions []*DateSuggestionDetail `protobuf:"bytes,1,rep,name=pending_suggestions,json=pendingSuggestions,proto3" json:"pending_suggestions,omitempty"`
+	// Upcoming dates
+	UpcomingDates []*ScheduledDateDetail `protobuf:"bytes,2,rep,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
+	// Past dates (last 10)
+	PastDates []*ScheduledDateDetail `protobuf:"bytes,3,rep,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
+	// Rejected dates (last 10)
+	RejectedDates []*RejectedDateDetail `protobuf:"bytes,4,rep,name=rejected_dates,json=rejectedDates,proto3" json:"rejected_dates,omitempty"`
+	// Statistics
+	Statistics    *LoveZoneStatistics `protobuf:"bytes,5,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoveZoneDashboardResponse) Reset() {
+	*x = GetLoveZoneDashboardResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoveZoneDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoveZoneDashboardResponse) ProtoMessage() {}
+
+func (x *GetLoveZoneDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoveZoneDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLoveZoneDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetLoveZoneDashboardResponse) GetPendingSuggestions() []*DateSuggestionDetail {
+	if x != nil {
+		return x.PendingSuggestions
+	}
+	return nil
+}
+
+func (x *GetLoveZoneDashboardResponse) GetUpcomingDates() []*ScheduledDateDetail {
+	if x != nil {
+		return x.UpcomingDates
+	}
+	return nil
+}
+
+func (x *GetLoveZoneDashboardResponse) GetPastDates() []*ScheduledDateDetail {
+	if x != nil {
+		return x.PastDates
+	}
+	return nil
+}
+
+func (x *GetLoveZoneDashboardResponse) GetRejectedDates() []*RejectedDateDetail {
+	if x != nil {
+		return x.RejectedDates
+	}
+	return nil
+}
+
+func (x *GetLoveZoneDashboardResponse) GetStatistics() *LoveZoneStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+// Get Date Suggestions
+type GetDateSuggestionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (from auth context)
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Status filter (optional: pending, accepted, rejected, all)
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDateSuggestionsRequest) Reset() {
+	*x = GetDateSuggestionsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDateSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDateSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDateSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDateSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetDateSuggestionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDateSuggestionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetDateSuggestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDateSuggestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetDateSuggestionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Date suggestions
+	Suggestions []*DateSuggestionDetail `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// Total count
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDateSuggestionsResponse) Reset() {
+	*x = GetDateSuggestionsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDateSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDateSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDateSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDateSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetDateSuggestionsResponse) GetSuggestions() []*DateSuggestionDetail {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *GetDateSuggestionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetDateSuggestionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDateSuggestionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDateSuggestionsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Get Upcoming Dates
+type GetUpcomingDatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (from auth context)
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingDatesRequest) Reset() {
+	*x = GetUpcomingDatesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingDatesRequest) ProtoMessage() {}
+
+func (x *GetUpcomingDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetUpcomingDatesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetUpcomingDatesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUpcomingDatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUpcomingDatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetUpcomingDatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upcoming dates
+	Dates []*ScheduledDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	// Total count
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingDatesResponse) Reset() {
+	*x = GetUpcomingDatesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingDatesResponse) ProtoMessage() {}
+
+func (x *GetUpcomingDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetUpcomingDatesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetUpcomingDatesResponse) GetDates() []*ScheduledDateDetail {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *GetUpcomingDatesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetUpcomingDatesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUpcomingDatesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetUpcomingDatesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Get Past Dates
+type GetPastDatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (from auth context)
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPastDatesRequest) Reset() {
+	*x = GetPastDatesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSummary) String() string {
+func (x *GetPastDatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSummary) ProtoMessage() {}
+func (*GetPastDatesRequest) ProtoMessage() {}
 
-func (x *UserSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[57]
+func (x *GetPastDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8427,101 +10724,138 @@ func (x *UserSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
-func (*UserSummary) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetPastDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetPastDatesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UserSummary) GetId() int32 {
+func (x *GetPastDatesRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UserSummary) GetName() string {
+func (x *GetPastDatesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Name
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserSummary) GetAge() int32 {
+func (x *GetPastDatesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Age
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *UserSummary) GetGender() Gender {
+type GetPastDatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Past dates
+	Dates []*ScheduledDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	// Total count
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPastDatesResponse) Reset() {
+	*x = GetPastDatesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPastDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPastDatesResponse) ProtoMessage() {}
+
+func (x *GetPastDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[88]
 	if x != nil {
-		return x.Gender
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Gender_GENDER_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *UserSummary) GetLocation() string {
+// Deprecated: Use GetPastDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetPastDatesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetPastDatesResponse) GetDates() []*ScheduledDateDetail {
 	if x != nil {
-		return x.Location
+		return x.Dates
 	}
-	return ""
+	return nil
 }
 
-func (x *UserSummary) GetPhotoUrl() string {
+func (x *GetPastDatesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.PhotoUrl
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UserSummary) GetBio() string {
+func (x *GetPastDatesResponse) GetPage() int32 {
 	if x != nil {
-		return x.Bio
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UserSummary) GetOccupation() string {
+func (x *GetPastDatesResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.Occupation
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// Date suggestion detail (pending suggestions)
-type DateSuggestionDetail struct {
+func (x *GetPastDatesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Get Rejected Dates
+type GetRejectedDatesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Suggestion ID
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Suggested user details
-	SuggestedUser *UserSummary `protobuf:"bytes,2,opt,name=suggested_user,json=suggestedUser,proto3" json:"suggested_user,omitempty"`
-	// Compatibility score
-	CompatibilityScore float64 `protobuf:"fixed64,3,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
-	// AI reasoning
-	Reasoning string `protobuf:"bytes,4,opt,name=reasoning,proto3" json:"reasoning,omitempty"`
-	// Status (pending, accepted, rejected)
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	// Created timestamp
-	CreatedAt     *v1.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// User ID (from auth context)
+	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateSuggestionDetail) Reset() {
-	*x = DateSuggestionDetail{}
-	mi := &file_user_v1_user_proto_msgTypes[58]
+func (x *GetRejectedDatesRequest) Reset() {
+	*x = GetRejectedDatesRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateSuggestionDetail) String() string {
+func (x *GetRejectedDatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateSuggestionDetail) ProtoMessage() {}
+func (*GetRejectedDatesRequest) ProtoMessage() {}
 
-func (x *DateSuggestionDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[58]
+func (x *GetRejectedDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8532,99 +10866,135 @@ func (x *DateSuggestionDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateSuggestionDetail.ProtoReflect.Descriptor instead.
-func (*DateSuggestionDetail) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetRejectedDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetRejectedDatesRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *DateSuggestionDetail) GetId() int32 {
+func (x *GetRejectedDatesRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *DateSuggestionDetail) GetSuggestedUser() *UserSummary {
+func (x *GetRejectedDatesRequest) GetPage() int32 {
 	if x != nil {
-		return x.SuggestedUser
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *DateSuggestionDetail) GetCompatibilityScore() float64 {
+func (x *GetRejectedDatesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.CompatibilityScore
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *DateSuggestionDetail) GetReasoning() string {
+type GetRejectedDatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rejected dates
+	Dates []*RejectedDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	// Total count
+	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Pagination
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRejectedDatesResponse) Reset() {
+	*x = GetRejectedDatesResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRejectedDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRejectedDatesResponse) ProtoMessage() {}
+
+func (x *GetRejectedDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[90]
 	if x != nil {
-		return x.Reasoning
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DateSuggestionDetail) GetStatus() string {
+// Deprecated: Use GetRejectedDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetRejectedDatesResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetRejectedDatesResponse) GetDates() []*RejectedDateDetail {
 	if x != nil {
-		return x.Status
+		return x.Dates
 	}
-	return ""
+	return nil
 }
 
-func (x *DateSuggestionDetail) GetCreatedAt() *v1.Timestamp {
+func (x *GetRejectedDatesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.TotalCount
 	}
-	return nil
+	return 0
 }
 
-// Scheduled date detail (upcoming/past dates)
-type ScheduledDateDetail struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Date ID
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Other user (not the current user)
-	OtherUser *UserSummary `protobuf:"bytes,2,opt,name=other_user,json=otherUser,proto3" json:"other_user,omitempty"`
-	// Scheduled time
-	ScheduledTime *v1.Timestamp `protobuf:"bytes,3,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
-	// Duration in minutes
-	DurationMinutes int32 `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
-	// Status (scheduled, confirmed, in_progress, completed, cancelled, no_show)
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	// Date type (online, offline, event)
-	DateType string `protobuf:"bytes,6,opt,name=date_type,json=dateType,proto3" json:"date_type,omitempty"`
-	// Location details (for offline dates)
-	PlaceName string `protobuf:"bytes,7,opt,name=place_name,json=placeName,proto3" json:"place_name,omitempty"`
-	Address   string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
-	City      string `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
-	// Notes (includes Google Meet link for online dates)
-	Notes string `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Created timestamp
-	CreatedAt *v1.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Confirmed timestamp
-	ConfirmedAt *v1.Timestamp `protobuf:"bytes,12,opt,name=confirmed_at,json=confirmedAt,proto3" json:"confirmed_at,omitempty"`
-	// Completed timestamp
-	CompletedAt   *v1.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+func (x *GetRejectedDatesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRejectedDatesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRejectedDatesResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Get Love Zone Statistics
+type GetLoveZoneStatisticsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User ID (from auth context)
+	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledDateDetail) Reset() {
-	*x = ScheduledDateDetail{}
-	mi := &file_user_v1_user_proto_msgTypes[59]
+func (x *GetLoveZoneStatisticsRequest) Reset() {
+	*x = GetLoveZoneStatisticsRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledDateDetail) String() string {
+func (x *GetLoveZoneStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduledDateDetail) ProtoMessage() {}
+func (*GetLoveZoneStatisticsRequest) ProtoMessage() {}
 
-func (x *ScheduledDateDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[59]
+func (x *GetLoveZoneStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8635,132 +11005,149 @@ func (x *ScheduledDateDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduledDateDetail.ProtoReflect.Descriptor instead.
-func (*ScheduledDateDetail) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use GetLoveZoneStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoveZoneStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ScheduledDateDetail) GetId() int32 {
+func (x *GetLoveZoneStatisticsRequest) GetUserId() int32 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ScheduledDateDetail) GetOtherUser() *UserSummary {
-	if x != nil {
-		return x.OtherUser
-	}
-	return nil
+type GetLoveZoneStatisticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Statistics
+	Statistics    *LoveZoneStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledDateDetail) GetScheduledTime() *v1.Timestamp {
-	if x != nil {
-		return x.ScheduledTime
-	}
-	return nil
+func (x *GetLoveZoneStatisticsResponse) Reset() {
+	*x = GetLoveZoneStatisticsResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledDateDetail) GetDurationMinutes() int32 {
-	if x != nil {
-		return x.DurationMinutes
-	}
-	return 0
+func (x *GetLoveZoneStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ScheduledDateDetail) GetStatus() string {
+func (*GetLoveZoneStatisticsResponse) ProtoMessage() {}
+
+func (x *GetLoveZoneStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[92]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ScheduledDateDetail) GetDateType() string {
-	if x != nil {
-		return x.DateType
-	}
-	return ""
+// Deprecated: Use GetLoveZoneStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoveZoneStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ScheduledDateDetail) GetPlaceName() string {
+func (x *GetLoveZoneStatisticsResponse) GetStatistics() *LoveZoneStatistics {
 	if x != nil {
-		return x.PlaceName
+		return x.Statistics
 	}
-	return ""
+	return nil
 }
 
-func (x *ScheduledDateDetail) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Cancel Date
+type CancelDateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Date ID
+	DateId int32 `protobuf:"varint,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	// Why the caller can't make it (shared with the other participant)
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduledDateDetail) GetCity() string {
-	if x != nil {
-		return x.City
-	}
-	return ""
+func (x *CancelDateRequest) Reset() {
+	*x = CancelDateRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduledDateDetail) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+func (x *CancelDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ScheduledDateDetail) GetCreatedAt() *v1.Timestamp {
+func (*CancelDateRequest) ProtoMessage() {}
+
+func (x *CancelDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[93]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ScheduledDateDetail) GetConfirmedAt() *v1.Timestamp {
+// Deprecated: Use CancelDateRequest.ProtoReflect.Descriptor instead.
+func (*CancelDateRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CancelDateRequest) GetDateId() int32 {
 	if x != nil {
-		return x.ConfirmedAt
+		return x.DateId
 	}
-	return nil
+	return 0
 }
 
-func (x *ScheduledDateDetail) GetCompletedAt() *v1.Timestamp {
+func (x *CancelDateRequest) GetReason() string {
 	if x != nil {
-		return x.CompletedAt
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-// Rejected date detail
-type RejectedDateDetail struct {
+type CancelDateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Suggestion ID
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Rejected user details
-	RejectedUser *UserSummary `protobuf:"bytes,2,opt,name=rejected_user,json=rejectedUser,proto3" json:"rejected_user,omitempty"`
-	// Compatibility score
-	CompatibilityScore float64 `protobuf:"fixed64,3,opt,name=compatibility_score,json=compatibilityScore,proto3" json:"compatibility_score,omitempty"`
-	// Rejected timestamp
-	RejectedAt    *v1.Timestamp `protobuf:"bytes,4,opt,name=rejected_at,json=rejectedAt,proto3" json:"rejected_at,omitempty"`
+	// Date ID
+	DateId int32 `protobuf:"varint,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	// Status (cancelled)
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Whether the date was cancelled within the late-cancellation window
+	LateCancellation bool `protobuf:"varint,3,opt,name=late_cancellation,json=lateCancellation,proto3" json:"late_cancellation,omitempty"`
+	// Cancelled timestamp
+	CancelledAt *v1.Timestamp `protobuf:"bytes,4,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectedDateDetail) Reset() {
-	*x = RejectedDateDetail{}
-	mi := &file_user_v1_user_proto_msgTypes[60]
+func (x *CancelDateResponse) Reset() {
+	*x = CancelDateResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectedDateDetail) String() string {
+func (x *CancelDateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectedDateDetail) ProtoMessage() {}
+func (*CancelDateResponse) ProtoMessage() {}
 
-func (x *RejectedDateDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[60]
+func (x *CancelDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8771,83 +11158,86 @@ func (x *RejectedDateDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectedDateDetail.ProtoReflect.Descriptor instead.
-func (*RejectedDateDetail) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CancelDateResponse.ProtoReflect.Descriptor instead.
+func (*CancelDateResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RejectedDateDetail) GetId() int32 {
+func (x *CancelDateResponse) GetDateId() int32 {
 	if x != nil {
-		return x.Id
+		return x.DateId
 	}
 	return 0
 }
 
-func (x *RejectedDateDetail) GetRejectedUser() *UserSummary {
+func (x *CancelDateResponse) GetStatus() string {
 	if x != nil {
-		return x.RejectedUser
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *RejectedDateDetail) GetCompatibilityScore() float64 {
+func (x *CancelDateResponse) GetLateCancellation() bool {
 	if x != nil {
-		return x.CompatibilityScore
+		return x.LateCancellation
 	}
-	return 0
+	return false
 }
 
-func (x *RejectedDateDetail) GetRejectedAt() *v1.Timestamp {
+func (x *CancelDateResponse) GetCancelledAt() *v1.Timestamp {
 	if x != nil {
-		return x.RejectedAt
+		return x.CancelledAt
 	}
 	return nil
 }
 
-// Love Zone statistics
-type LoveZoneStatistics struct {
+func (x *CancelDateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A proposal to move a date to a new time
+type RescheduleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total date suggestions received
-	TotalSuggestions int32 `protobuf:"varint,1,opt,name=total_suggestions,json=totalSuggestions,proto3" json:"total_suggestions,omitempty"`
-	// Pending suggestions count
-	PendingSuggestions int32 `protobuf:"varint,2,opt,name=pending_suggestions,json=pendingSuggestions,proto3" json:"pending_suggestions,omitempty"`
-	// Accepted suggestions count
-	AcceptedSuggestions int32 `protobuf:"varint,3,opt,name=accepted_suggestions,json=acceptedSuggestions,proto3" json:"accepted_suggestions,omitempty"`
-	// Rejected suggestions count
-	RejectedSuggestions int32 `protobuf:"varint,4,opt,name=rejected_suggestions,json=rejectedSuggestions,proto3" json:"rejected_suggestions,omitempty"`
-	// Total scheduled dates
-	TotalScheduledDates int32 `protobuf:"varint,5,opt,name=total_scheduled_dates,json=totalScheduledDates,proto3" json:"total_scheduled_dates,omitempty"`
-	// Upcoming dates count
-	UpcomingDates int32 `protobuf:"varint,6,opt,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
-	// Past dates count
-	PastDates int32 `protobuf:"varint,7,opt,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
-	// Completed dates count
-	CompletedDates int32 `protobuf:"varint,8,opt,name=completed_dates,json=completedDates,proto3" json:"completed_dates,omitempty"`
-	// Cancelled dates count
-	CancelledDates int32 `protobuf:"varint,9,opt,name=cancelled_dates,json=cancelledDates,proto3" json:"cancelled_dates,omitempty"`
-	// Acceptance rate (percentage)
-	AcceptanceRate float64 `protobuf:"fixed64,10,opt,name=acceptance_rate,json=acceptanceRate,proto3" json:"acceptance_rate,omitempty"`
-	// Completion rate (percentage)
-	CompletionRate float64 `protobuf:"fixed64,11,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Request ID
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Date ID
+	DateId int32 `protobuf:"varint,2,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	// User who asked to reschedule
+	RequestedBy int32 `protobuf:"varint,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	// Proposed start times, from when both participants are free
+	ProposedTimes []*v1.Timestamp `protobuf:"bytes,4,rep,name=proposed_times,json=proposedTimes,proto3" json:"proposed_times,omitempty"`
+	// Reason
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Status (pending, accepted, declined, withdrawn)
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Time the other participant accepted
+	AcceptedTime *v1.Timestamp `protobuf:"bytes,7,opt,name=accepted_time,json=acceptedTime,proto3" json:"accepted_time,omitempty"`
+	// Created timestamp
+	CreatedAt *v1.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Answered timestamp
+	RespondedAt   *v1.Timestamp `protobuf:"bytes,9,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoveZoneStatistics) Reset() {
-	*x = LoveZoneStatistics{}
-	mi := &file_user_v1_user_proto_msgTypes[61]
+func (x *RescheduleRequest) Reset() {
+	*x = RescheduleRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoveZoneStatistics) String() string {
+func (x *RescheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoveZoneStatistics) ProtoMessage() {}
+func (*RescheduleRequest) ProtoMessage() {}
 
-func (x *LoveZoneStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[61]
+func (x *RescheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8858,112 +11248,103 @@ func (x *LoveZoneStatistics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoveZoneStatistics.ProtoReflect.Descriptor instead.
-func (*LoveZoneStatistics) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *LoveZoneStatistics) GetTotalSuggestions() int32 {
-	if x != nil {
-		return x.TotalSuggestions
-	}
-	return 0
-}
-
-func (x *LoveZoneStatistics) GetPendingSuggestions() int32 {
-	if x != nil {
-		return x.PendingSuggestions
-	}
-	return 0
+// Deprecated: Use RescheduleRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *LoveZoneStatistics) GetAcceptedSuggestions() int32 {
+func (x *RescheduleRequest) GetId() int32 {
 	if x != nil {
-		return x.AcceptedSuggestions
+		return x.Id
 	}
 	return 0
 }
 
-func (x *LoveZoneStatistics) GetRejectedSuggestions() int32 {
+func (x *RescheduleRequest) GetDateId() int32 {
 	if x != nil {
-		return x.RejectedSuggestions
+		return x.DateId
 	}
 	return 0
 }
 
-func (x *LoveZoneStatistics) GetTotalScheduledDates() int32 {
+func (x *RescheduleRequest) GetRequestedBy() int32 {
 	if x != nil {
-		return x.TotalScheduledDates
+		return x.RequestedBy
 	}
 	return 0
 }
 
-func (x *LoveZoneStatistics) GetUpcomingDates() int32 {
+func (x *RescheduleRequest) GetProposedTimes() []*v1.Timestamp {
 	if x != nil {
-		return x.UpcomingDates
+		return x.ProposedTimes
 	}
-	return 0
+	return nil
 }
 
-func (x *LoveZoneStatistics) GetPastDates() int32 {
+func (x *RescheduleRequest) GetReason() string {
 	if x != nil {
-		return x.PastDates
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *LoveZoneStatistics) GetCompletedDates() int32 {
+func (x *RescheduleRequest) GetStatus() string {
 	if x != nil {
-		return x.CompletedDates
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *LoveZoneStatistics) GetCancelledDates() int32 {
+func (x *RescheduleRequest) GetAcceptedTime() *v1.Timestamp {
 	if x != nil {
-		return x.CancelledDates
+		return x.AcceptedTime
 	}
-	return 0
+	return nil
 }
 
-func (x *LoveZoneStatistics) GetAcceptanceRate() float64 {
+func (x *RescheduleRequest) GetCreatedAt() *v1.Timestamp {
 	if x != nil {
-		return x.AcceptanceRate
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *LoveZoneStatistics) GetCompletionRate() float64 {
+func (x *RescheduleRequest) GetRespondedAt() *v1.Timestamp {
 	if x != nil {
-		return x.CompletionRate
+		return x.RespondedAt
 	}
-	return 0
+	return nil
 }
 
-// Get Love Zone Dashboard (all-in-one)
-type GetLoveZoneDashboardRequest struct {
+// Request Reschedule
+type RequestRescheduleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Date ID
+	DateId int32 `protobuf:"varint,1,opt,name=date_id,json=dateId,proto3" json:"date_id,omitempty"`
+	// Up to 3 new start times, each within the other participant's
+	// availability. When empty, the best times both are free are proposed.
+	ProposedTimes []*v1.Timestamp `protobuf:"bytes,2,rep,name=proposed_times,json=proposedTimes,proto3" json:"proposed_times,omitempty"`
+	// Reason
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoveZoneDashboardRequest) Reset() {
-	*x = GetLoveZoneDashboardRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[62]
+func (x *RequestRescheduleRequest) Reset() {
+	*x = RequestRescheduleRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoveZoneDashboardRequest) String() string {
+func (x *RequestRescheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoveZoneDashboardRequest) ProtoMessage() {}
+func (*RequestRescheduleRequest) ProtoMessage() {}
 
-func (x *GetLoveZoneDashboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[62]
+func (x *RequestRescheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8974,49 +11355,57 @@ func (x *GetLoveZoneDashboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoveZoneDashboardRequest.ProtoReflect.Descriptor instead.
-func (*GetLoveZoneDashboardRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use RequestRescheduleRequest.ProtoReflect.Descriptor instead.
+func (*RequestRescheduleRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetLoveZoneDashboardRequest) GetUserId() int32 {
+func (x *RequestRescheduleRequest) GetDateId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.DateId
 	}
 	return 0
 }
 
-type GetLoveZoneDashboardResponse struct {
+func (x *RequestRescheduleRequest) GetProposedTimes() []*v1.Timestamp {
+	if x != nil {
+		return x.ProposedTimes
+	}
+	return nil
+}
+
+func (x *RequestRescheduleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestRescheduleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Pending date suggestions
-	PendingSuggestions []*DateSuggestionDetail `protobuf:"bytes,1,rep,name=pending_suggestions,json=pendingSuggestions,proto3" json:"pending_suggestions,omitempty"`
-	// Upcoming dates
-	UpcomingDates []*ScheduledDateDetail `protobuf:"bytes,2,rep,name=upcoming_dates,json=upcomingDates,proto3" json:"upcoming_dates,omitempty"`
-	// Past dates (last 10)
-	PastDates []*ScheduledDateDetail `protobuf:"bytes,3,rep,name=past_dates,json=pastDates,proto3" json:"past_dates,omitempty"`
-	// Rejected dates (last 10)
-	RejectedDates []*RejectedDateDetail `protobuf:"bytes,4,rep,name=rejected_dates,json=rejectedDates,proto3" json:"rejected_dates,omitempty"`
-	// Statistics
-	Statistics    *LoveZoneStatistics `protobuf:"bytes,5,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	// Reschedule request
+	Request *RescheduleRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoveZoneDashboardResponse) Reset() {
-	*x = GetLoveZoneDashboardResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[63]
+func (x *RequestRescheduleResponse) Reset() {
+	*x = RequestRescheduleResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoveZoneDashboardResponse) String() string {
+func (x *RequestRescheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoveZoneDashboardResponse) ProtoMessage() {}
+func (*RequestRescheduleResponse) ProtoMessage() {}
 
-func (x *GetLoveZoneDashboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[63]
+func (x *RequestRescheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9027,75 +11416,53 @@ func (x *GetLoveZoneDashboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoveZoneDashboardResponse.ProtoReflect.Descriptor instead.
-func (*GetLoveZoneDashboardResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *GetLoveZoneDashboardResponse) GetPendingSuggestions() []*DateSuggestionDetail {
-	if x != nil {
-		return x.PendingSuggestions
-	}
-	return nil
-}
-
-func (x *GetLoveZoneDashboardResponse) GetUpcomingDates() []*ScheduledDateDetail {
-	if x != nil {
-		return x.UpcomingDates
-	}
-	return nil
-}
-
-func (x *GetLoveZoneDashboardResponse) GetPastDates() []*ScheduledDateDetail {
-	if x != nil {
-		return x.PastDates
-	}
-	return nil
+// Deprecated: Use RequestRescheduleResponse.ProtoReflect.Descriptor instead.
+func (*RequestRescheduleResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetLoveZoneDashboardResponse) GetRejectedDates() []*RejectedDateDetail {
+func (x *RequestRescheduleResponse) GetRequest() *RescheduleRequest {
 	if x != nil {
-		return x.RejectedDates
+		return x.Request
 	}
 	return nil
 }
 
-func (x *GetLoveZoneDashboardResponse) GetStatistics() *LoveZoneStatistics {
+func (x *RequestRescheduleResponse) GetMessage() string {
 	if x != nil {
-		return x.Statistics
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// Get Date Suggestions
-type GetDateSuggestionsRequest struct {
+// Respond To Reschedule
+type RespondToRescheduleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Status filter (optional: pending, accepted, rejected, all)
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Request ID
+	RequestId int32 `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Whether to accept
+	Accept bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	// Proposed time to move the date to (required when accepting)
+	SelectedTime  *v1.Timestamp `protobuf:"bytes,3,opt,name=selected_time,json=selectedTime,proto3" json:"selected_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDateSuggestionsRequest) Reset() {
-	*x = GetDateSuggestionsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[64]
+func (x *RespondToRescheduleRequest) Reset() {
+	*x = RespondToRescheduleRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDateSuggestionsRequest) String() string {
+func (x *RespondToRescheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDateSuggestionsRequest) ProtoMessage() {}
+func (*RespondToRescheduleRequest) ProtoMessage() {}
 
-func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[64]
+func (x *RespondToRescheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9106,68 +11473,59 @@ func (x *GetDateSuggestionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDateSuggestionsRequest.ProtoReflect.Descriptor instead.
-func (*GetDateSuggestionsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use RespondToRescheduleRequest.ProtoReflect.Descriptor instead.
+func (*RespondToRescheduleRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetDateSuggestionsRequest) GetUserId() int32 {
+func (x *RespondToRescheduleRequest) GetRequestId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.RequestId
 	}
 	return 0
 }
 
-func (x *GetDateSuggestionsRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *GetDateSuggestionsRequest) GetPage() int32 {
+func (x *RespondToRescheduleRequest) GetAccept() bool {
 	if x != nil {
-		return x.Page
+		return x.Accept
 	}
-	return 0
+	return false
 }
 
-func (x *GetDateSuggestionsRequest) GetPageSize() int32 {
+func (x *RespondToRescheduleRequest) GetSelectedTime() *v1.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.SelectedTime
 	}
-	return 0
+	return nil
 }
 
-type GetDateSuggestionsResponse struct {
+type RespondToRescheduleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Date suggestions
-	Suggestions []*DateSuggestionDetail `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
-	// Total count
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Reschedule request
+	Request *RescheduleRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// When the date now is
+	ScheduledTime *v1.Timestamp `protobuf:"bytes,2,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDateSuggestionsResponse) Reset() {
-	*x = GetDateSuggestionsResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[65]
+func (x *RespondToRescheduleResponse) Reset() {
+	*x = RespondToRescheduleResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDateSuggestionsResponse) String() string {
+func (x *RespondToRescheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDateSuggestionsResponse) ProtoMessage() {}
+func (*RespondToRescheduleResponse) ProtoMessage() {}
 
-func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[65]
+func (x *RespondToRescheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9178,73 +11536,56 @@ func (x *GetDateSuggestionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDateSuggestionsResponse.ProtoReflect.Descriptor instead.
-func (*GetDateSuggestionsResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use RespondToRescheduleResponse.ProtoReflect.Descriptor instead.
+func (*RespondToRescheduleResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetDateSuggestionsResponse) GetSuggestions() []*DateSuggestionDetail {
+func (x *RespondToRescheduleResponse) GetRequest() *RescheduleRequest {
 	if x != nil {
-		return x.Suggestions
+		return x.Request
 	}
 	return nil
 }
 
-func (x *GetDateSuggestionsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetDateSuggestionsResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetDateSuggestionsResponse) GetPageSize() int32 {
+func (x *RespondToRescheduleResponse) GetScheduledTime() *v1.Timestamp {
 	if x != nil {
-		return x.PageSize
+		return x.ScheduledTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GetDateSuggestionsResponse) GetTotalPages() int32 {
+func (x *RespondToRescheduleResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-// Get Upcoming Dates
-type GetUpcomingDatesRequest struct {
+// Send work email verification code
+type SendWorkEmailVerificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Corporate email address (free-mail providers are rejected)
+	WorkEmail     string `protobuf:"bytes,1,opt,name=work_email,json=workEmail,proto3" json:"work_email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpcomingDatesRequest) Reset() {
-	*x = GetUpcomingDatesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[66]
+func (x *SendWorkEmailVerificationRequest) Reset() {
+	*x = SendWorkEmailVerificationRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingDatesRequest) String() string {
+func (x *SendWorkEmailVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingDatesRequest) ProtoMessage() {}
+func (*SendWorkEmailVerificationRequest) ProtoMessage() {}
 
-func (x *GetUpcomingDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[66]
+func (x *SendWorkEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9255,61 +11596,43 @@ func (x *GetUpcomingDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingDatesRequest.ProtoReflect.Descriptor instead.
-func (*GetUpcomingDatesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *GetUpcomingDatesRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetUpcomingDatesRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use SendWorkEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SendWorkEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetUpcomingDatesRequest) GetPageSize() int32 {
+func (x *SendWorkEmailVerificationRequest) GetWorkEmail() string {
 	if x != nil {
-		return x.PageSize
+		return x.WorkEmail
 	}
-	return 0
+	return ""
 }
 
-type GetUpcomingDatesResponse struct {
+type SendWorkEmailVerificationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Upcoming dates
-	Dates []*ScheduledDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
-	// Total count
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// When the code expires
+	ExpiresAt *v1.Timestamp `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpcomingDatesResponse) Reset() {
-	*x = GetUpcomingDatesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[67]
+func (x *SendWorkEmailVerificationResponse) Reset() {
+	*x = SendWorkEmailVerificationResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingDatesResponse) String() string {
+func (x *SendWorkEmailVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingDatesResponse) ProtoMessage() {}
+func (*SendWorkEmailVerificationResponse) ProtoMessage() {}
 
-func (x *GetUpcomingDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[67]
+func (x *SendWorkEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9320,73 +11643,96 @@ func (x *GetUpcomingDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingDatesResponse.ProtoReflect.Descriptor instead.
-func (*GetUpcomingDatesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use SendWorkEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SendWorkEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetUpcomingDatesResponse) GetDates() []*ScheduledDateDetail {
+func (x *SendWorkEmailVerificationResponse) GetExpiresAt() *v1.Timestamp {
 	if x != nil {
-		return x.Dates
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-func (x *GetUpcomingDatesResponse) GetTotalCount() int32 {
+func (x *SendWorkEmailVerificationResponse) GetMessage() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUpcomingDatesResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Verify work email
+type VerifyWorkEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Code sent to the work email
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpcomingDatesResponse) GetPageSize() int32 {
+func (x *VerifyWorkEmailRequest) Reset() {
+	*x = VerifyWorkEmailRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyWorkEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyWorkEmailRequest) ProtoMessage() {}
+
+func (x *VerifyWorkEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[102]
 	if x != nil {
-		return x.PageSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetUpcomingDatesResponse) GetTotalPages() int32 {
+// Deprecated: Use VerifyWorkEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyWorkEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *VerifyWorkEmailRequest) GetCode() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-// Get Past Dates
-type GetPastDatesRequest struct {
+type VerifyWorkEmailResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Verified company domain
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPastDatesRequest) Reset() {
-	*x = GetPastDatesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[68]
+func (x *VerifyWorkEmailResponse) Reset() {
+	*x = VerifyWorkEmailResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPastDatesRequest) String() string {
+func (x *VerifyWorkEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPastDatesRequest) ProtoMessage() {}
+func (*VerifyWorkEmailResponse) ProtoMessage() {}
 
-func (x *GetPastDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[68]
+func (x *VerifyWorkEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9397,61 +11743,59 @@ func (x *GetPastDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPastDatesRequest.ProtoReflect.Descriptor instead.
-func (*GetPastDatesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *GetPastDatesRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use VerifyWorkEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyWorkEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetPastDatesRequest) GetPage() int32 {
+func (x *VerifyWorkEmailResponse) GetDomain() string {
 	if x != nil {
-		return x.Page
+		return x.Domain
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPastDatesRequest) GetPageSize() int32 {
+func (x *VerifyWorkEmailResponse) GetMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetPastDatesResponse struct {
+// Submit government ID
+type SubmitIdVerificationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Past dates
-	Dates []*ScheduledDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
-	// Total count
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	// Document type
+	DocumentType IdDocumentType `protobuf:"varint,1,opt,name=document_type,json=documentType,proto3,enum=datifyy.user.v1.IdDocumentType" json:"document_type,omitempty"`
+	// Document number (only a masked form is stored)
+	IdNumber string `protobuf:"bytes,2,opt,name=id_number,json=idNumber,proto3" json:"id_number,omitempty"`
+	// Front of the document (required)
+	FrontImage []byte `protobuf:"bytes,3,opt,name=front_image,json=frontImage,proto3" json:"front_image,omitempty"`
+	// Back of the document (optional)
+	BackImage []byte `protobuf:"bytes,4,opt,name=back_image,json=backImage,proto3" json:"back_image,omitempty"`
+	// Selfie holding the document (optional)
+	SelfieImage []byte `protobuf:"bytes,5,opt,name=selfie_image,json=selfieImage,proto3" json:"selfie_image,omitempty"`
+	// Image content type (image/jpeg, image/png or image/webp)
+	ContentType   string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPastDatesResponse) Reset() {
-	*x = GetPastDatesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[69]
+func (x *SubmitIdVerificationRequest) Reset() {
+	*x = SubmitIdVerificationRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPastDatesResponse) String() string {
+func (x *SubmitIdVerificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPastDatesResponse) ProtoMessage() {}
+func (*SubmitIdVerificationRequest) ProtoMessage() {}
 
-func (x *GetPastDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[69]
+func (x *SubmitIdVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9462,73 +11806,80 @@ func (x *GetPastDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPastDatesResponse.ProtoReflect.Descriptor instead.
-func (*GetPastDatesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use SubmitIdVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitIdVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetPastDatesResponse) GetDates() []*ScheduledDateDetail {
+func (x *SubmitIdVerificationRequest) GetDocumentType() IdDocumentType {
 	if x != nil {
-		return x.Dates
+		return x.DocumentType
 	}
-	return nil
+	return IdDocumentType_ID_DOCUMENT_TYPE_UNSPECIFIED
 }
 
-func (x *GetPastDatesResponse) GetTotalCount() int32 {
+func (x *SubmitIdVerificationRequest) GetIdNumber() string {
 	if x != nil {
-		return x.TotalCount
+		return x.IdNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPastDatesResponse) GetPage() int32 {
+func (x *SubmitIdVerificationRequest) GetFrontImage() []byte {
 	if x != nil {
-		return x.Page
+		return x.FrontImage
 	}
-	return 0
+	return nil
 }
 
-func (x *GetPastDatesResponse) GetPageSize() int32 {
+func (x *SubmitIdVerificationRequest) GetBackImage() []byte {
 	if x != nil {
-		return x.PageSize
+		return x.BackImage
 	}
-	return 0
+	return nil
 }
 
-func (x *GetPastDatesResponse) GetTotalPages() int32 {
+func (x *SubmitIdVerificationRequest) GetSelfieImage() []byte {
 	if x != nil {
-		return x.TotalPages
+		return x.SelfieImage
 	}
-	return 0
+	return nil
 }
 
-// Get Rejected Dates
-type GetRejectedDatesRequest struct {
+func (x *SubmitIdVerificationRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type SubmitIdVerificationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Verification ID
+	VerificationId string `protobuf:"bytes,1,opt,name=verification_id,json=verificationId,proto3" json:"verification_id,omitempty"`
+	// Status
+	Status IdVerificationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=datifyy.user.v1.IdVerificationStatus" json:"status,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRejectedDatesRequest) Reset() {
-	*x = GetRejectedDatesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[70]
+func (x *SubmitIdVerificationResponse) Reset() {
+	*x = SubmitIdVerificationResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRejectedDatesRequest) String() string {
+func (x *SubmitIdVerificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRejectedDatesRequest) ProtoMessage() {}
+func (*SubmitIdVerificationResponse) ProtoMessage() {}
 
-func (x *GetRejectedDatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[70]
+func (x *SubmitIdVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9539,61 +11890,54 @@ func (x *GetRejectedDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRejectedDatesRequest.ProtoReflect.Descriptor instead.
-func (*GetRejectedDatesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use SubmitIdVerificationResponse.ProtoReflect.Descriptor instead.
+func (*SubmitIdVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetRejectedDatesRequest) GetUserId() int32 {
+func (x *SubmitIdVerificationResponse) GetVerificationId() string {
 	if x != nil {
-		return x.UserId
+		return x.VerificationId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRejectedDatesRequest) GetPage() int32 {
+func (x *SubmitIdVerificationResponse) GetStatus() IdVerificationStatus {
 	if x != nil {
-		return x.Page
+		return x.Status
 	}
-	return 0
+	return IdVerificationStatus_ID_VERIFICATION_STATUS_UNSPECIFIED
 }
 
-func (x *GetRejectedDatesRequest) GetPageSize() int32 {
+func (x *SubmitIdVerificationResponse) GetMessage() string {
 	if x != nil {
-		return x.PageSize
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetRejectedDatesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Rejected dates
-	Dates []*RejectedDateDetail `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
-	// Total count
-	TotalCount int32 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Pagination
-	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalPages    int32 `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+// Get verification status
+type GetVerificationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRejectedDatesResponse) Reset() {
-	*x = GetRejectedDatesResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[71]
+func (x *GetVerificationStatusRequest) Reset() {
+	*x = GetVerificationStatusRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRejectedDatesResponse) String() string {
+func (x *GetVerificationStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRejectedDatesResponse) ProtoMessage() {}
+func (*GetVerificationStatusRequest) ProtoMessage() {}
 
-func (x *GetRejectedDatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[71]
+func (x *GetVerificationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9604,70 +11948,123 @@ func (x *GetRejectedDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRejectedDatesResponse.ProtoReflect.Descriptor instead.
-func (*GetRejectedDatesResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use GetVerificationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetRejectedDatesResponse) GetDates() []*RejectedDateDetail {
+type GetVerificationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Work email badge
+	WorkEmailVerified bool `protobuf:"varint,1,opt,name=work_email_verified,json=workEmailVerified,proto3" json:"work_email_verified,omitempty"`
+	// Verified company domain
+	WorkEmailDomain string `protobuf:"bytes,2,opt,name=work_email_domain,json=workEmailDomain,proto3" json:"work_email_domain,omitempty"`
+	// Government ID badge
+	IdVerified bool `protobuf:"varint,3,opt,name=id_verified,json=idVerified,proto3" json:"id_verified,omitempty"`
+	// Latest government ID submission status
+	IdVerificationStatus IdVerificationStatus `protobuf:"varint,4,opt,name=id_verification_status,json=idVerificationStatus,proto3,enum=datifyy.user.v1.IdVerificationStatus" json:"id_verification_status,omitempty"`
+	// Masked number of the latest submission
+	IdNumberMasked string `protobuf:"bytes,5,opt,name=id_number_masked,json=idNumberMasked,proto3" json:"id_number_masked,omitempty"`
+	// Why the latest submission was rejected
+	RejectionReason string `protobuf:"bytes,6,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetVerificationStatusResponse) Reset() {
+	*x = GetVerificationStatusResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerificationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationStatusResponse) ProtoMessage() {}
+
+func (x *GetVerificationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[107]
 	if x != nil {
-		return x.Dates
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetRejectedDatesResponse) GetTotalCount() int32 {
+// Deprecated: Use GetVerificationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetVerificationStatusResponse) GetWorkEmailVerified() bool {
 	if x != nil {
-		return x.TotalCount
+		return x.WorkEmailVerified
 	}
-	return 0
+	return false
 }
 
-func (x *GetRejectedDatesResponse) GetPage() int32 {
+func (x *GetVerificationStatusResponse) GetWorkEmailDomain() string {
 	if x != nil {
-		return x.Page
+		return x.WorkEmailDomain
 	}
-	return 0
+	return ""
 }
 
-func (x *GetRejectedDatesResponse) GetPageSize() int32 {
+func (x *GetVerificationStatusResponse) GetIdVerified() bool {
 	if x != nil {
-		return x.PageSize
+		return x.IdVerified
 	}
-	return 0
+	return false
 }
 
-func (x *GetRejectedDatesResponse) GetTotalPages() int32 {
+func (x *GetVerificationStatusResponse) GetIdVerificationStatus() IdVerificationStatus {
 	if x != nil {
-		return x.TotalPages
+		return x.IdVerificationStatus
 	}
-	return 0
+	return IdVerificationStatus_ID_VERIFICATION_STATUS_UNSPECIFIED
 }
 
-// Get Love Zone Statistics
-type GetLoveZoneStatisticsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// User ID (from auth context)
-	UserId        int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+func (x *GetVerificationStatusResponse) GetIdNumberMasked() string {
+	if x != nil {
+		return x.IdNumberMasked
+	}
+	return ""
+}
+
+func (x *GetVerificationStatusResponse) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+// Request data export
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoveZoneStatisticsRequest) Reset() {
-	*x = GetLoveZoneStatisticsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[72]
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoveZoneStatisticsRequest) String() string {
+func (x *RequestDataExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoveZoneStatisticsRequest) ProtoMessage() {}
+func (*RequestDataExportRequest) ProtoMessage() {}
 
-func (x *GetLoveZoneStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[72]
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9678,41 +12075,42 @@ func (x *GetLoveZoneStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoveZoneStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetLoveZoneStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *GetLoveZoneStatisticsRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{108}
 }
 
-type GetLoveZoneStatisticsResponse struct {
+type RequestDataExportResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Statistics
-	Statistics    *LoveZoneStatistics `protobuf:"bytes,1,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	// Export request ID
+	ExportId string `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	// Status (pending, processing, completed, failed)
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// When the export was requested
+	RequestedAt *v1.Timestamp `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// Earliest time another export may be requested
+	NextAllowedAt *v1.Timestamp `protobuf:"bytes,4,opt,name=next_allowed_at,json=nextAllowedAt,proto3" json:"next_allowed_at,omitempty"`
+	// Message
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoveZoneStatisticsResponse) Reset() {
-	*x = GetLoveZoneStatisticsResponse{}
-	mi := &file_user_v1_user_proto_msgTypes[73]
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoveZoneStatisticsResponse) String() string {
+func (x *RequestDataExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoveZoneStatisticsResponse) ProtoMessage() {}
+func (*RequestDataExportResponse) ProtoMessage() {}
 
-func (x *GetLoveZoneStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[73]
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9723,23 +12121,51 @@ func (x *GetLoveZoneStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoveZoneStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetLoveZoneStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetLoveZoneStatisticsResponse) GetStatistics() *LoveZoneStatistics {
+func (x *RequestDataExportResponse) GetExportId() string {
 	if x != nil {
-		return x.Statistics
+		return x.ExportId
+	}
+	return ""
+}
+
+func (x *RequestDataExportResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RequestDataExportResponse) GetRequestedAt() *v1.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *RequestDataExportResponse) GetNextAllowedAt() *v1.Timestamp {
+	if x != nil {
+		return x.NextAllowedAt
 	}
 	return nil
 }
 
+func (x *RequestDataExportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\x0fdatifyy.user.v1\x1a\x15common/v1/types.proto\"\xe1\b\n" +
+	"\x12user/v1/user.proto\x12\x0fdatifyy.user.v1\x1a\x15common/v1/types.proto\"\xfc\t\n" +
 	"\vUserProfile\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
@@ -9764,7 +12190,11 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x0fappearance_info\x18\x11 \x01(\v2\x1f.datifyy.user.v1.AppearanceInfoR\x0eappearanceInfo\x12N\n" +
 	"\x11professional_info\x18\x12 \x01(\v2!.datifyy.user.v1.ProfessionalInfoR\x10professionalInfo\x12<\n" +
 	"\vfamily_info\x18\x13 \x01(\v2\x1b.datifyy.user.v1.FamilyInfoR\n" +
-	"familyInfo\"\xb7\x02\n" +
+	"familyInfo\x12.\n" +
+	"\x13work_email_verified\x18\x14 \x01(\bR\x11workEmailVerified\x12\x1f\n" +
+	"\vdistance_km\x18\x15 \x01(\x05R\n" +
+	"distanceKm\x12H\n" +
+	"\x0fhoroscope_match\x18\x16 \x01(\v2\x1f.datifyy.user.v1.HoroscopeMatchR\x0ehoroscopeMatch\"\xb7\x02\n" +
 	"\tBasicInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
@@ -9803,7 +12233,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	" \x01(\tR\x0fpersonalityType\x12T\n" +
 	"\x13communication_style\x18\v \x01(\x0e2#.datifyy.user.v1.CommunicationStyleR\x12communicationStyle\x12B\n" +
 	"\rlove_language\x18\f \x01(\x0e2\x1d.datifyy.user.v1.LoveLanguageR\floveLanguage\x12E\n" +
-	"\x0esleep_schedule\x18\r \x01(\x0e2\x1e.datifyy.user.v1.SleepScheduleR\rsleepSchedule\"\x89\x04\n" +
+	"\x0esleep_schedule\x18\r \x01(\x0e2\x1e.datifyy.user.v1.SleepScheduleR\rsleepSchedule\"\xf0\x04\n" +
 	"\fCulturalInfo\x12\x14\n" +
 	"\x05caste\x18\x01 \x01(\tR\x05caste\x12\x1b\n" +
 	"\tsub_caste\x18\x02 \x01(\tR\bsubCaste\x12\x14\n" +
@@ -9818,7 +12248,19 @@ const file_user_v1_user_proto_rawDesc = "" +
 	" \x01(\tR\vnationality\x12 \n" +
 	"\vcitizenship\x18\v \x03(\tR\vcitizenship\x12.\n" +
 	"\x13willing_to_relocate\x18\f \x01(\bR\x11willingToRelocate\x121\n" +
-	"\x14relocation_countries\x18\r \x03(\tR\x13relocationCountries\"\x8a\x04\n" +
+	"\x14relocation_countries\x18\r \x03(\tR\x13relocationCountries\x12\x1d\n" +
+	"\n" +
+	"birth_time\x18\x0e \x01(\tR\tbirthTime\x12\x1f\n" +
+	"\vbirth_place\x18\x0f \x01(\tR\n" +
+	"birthPlace\x12%\n" +
+	"\x0ebirth_timezone\x18\x10 \x01(\tR\rbirthTimezone\"\x92\x01\n" +
+	"\x0eHoroscopeMatch\x12\x14\n" +
+	"\x05gunas\x18\x01 \x01(\x01R\x05gunas\x12\x1b\n" +
+	"\tmax_gunas\x18\x02 \x01(\x05R\bmaxGunas\x12-\n" +
+	"\x12manglik_compatible\x18\x03 \x01(\bR\x11manglikCompatible\x12\x1e\n" +
+	"\n" +
+	"acceptable\x18\x04 \x01(\bR\n" +
+	"acceptable\"\x8a\x04\n" +
 	"\x0eAppearanceInfo\x126\n" +
 	"\tbody_type\x18\x01 \x01(\x0e2\x19.datifyy.user.v1.BodyTypeR\bbodyType\x12;\n" +
 	"\n" +
@@ -9993,13 +12435,21 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\bMustHave\x121\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1d.datifyy.user.v1.MustHaveTypeR\x04type\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bpriority\x18\x03 \x01(\x05R\bpriority\"\x9e\x02\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\"\xa6\x01\n" +
+	"\x14PreferenceRuleResult\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x12\n" +
+	"\x04hard\x18\x02 \x01(\bR\x04hard\x12\x16\n" +
+	"\x06passed\x18\x03 \x01(\bR\x06passed\x12\x14\n" +
+	"\x05known\x18\x04 \x01(\bR\x05known\x12 \n" +
+	"\vexplanation\x18\x05 \x01(\tR\vexplanation\x12\x16\n" +
+	"\x06weight\x18\x06 \x01(\x05R\x06weight\"\xba\x02\n" +
 	"\x0fUserPreferences\x12N\n" +
 	"\rnotifications\x18\x01 \x01(\v2(.datifyy.user.v1.NotificationPreferencesR\rnotifications\x12=\n" +
 	"\aprivacy\x18\x02 \x01(\v2#.datifyy.user.v1.PrivacyPreferencesR\aprivacy\x12C\n" +
 	"\tdiscovery\x18\x03 \x01(\v2%.datifyy.user.v1.DiscoveryPreferencesR\tdiscovery\x12!\n" +
 	"\fapp_language\x18\x04 \x01(\tR\vappLanguage\x12\x14\n" +
-	"\x05theme\x18\x05 \x01(\tR\x05theme\"\xd5\x02\n" +
+	"\x05theme\x18\x05 \x01(\tR\x05theme\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\"\xd5\x02\n" +
 	"\x17NotificationPreferences\x12!\n" +
 	"\fpush_enabled\x18\x01 \x01(\bR\vpushEnabled\x12#\n" +
 	"\remail_enabled\x18\x02 \x01(\bR\femailEnabled\x12\x1f\n" +
@@ -10046,13 +12496,26 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\rupdate_fields\x18\t \x03(\tR\fupdateFields\"i\n" +
 	"\x15UpdateProfileResponse\x126\n" +
 	"\aprofile\x18\x01 \x01(\v2\x1c.datifyy.user.v1.UserProfileR\aprofile\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"J\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
+	"\x12ListPromptsRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"R\n" +
+	"\x13ListPromptsResponse\x12;\n" +
+	"\aprompts\x18\x01 \x03(\v2!.datifyy.user.v1.PromptDefinitionR\aprompts\"\xf4\x01\n" +
+	"\x10PromptDefinition\x12\x1b\n" +
+	"\tprompt_id\x18\x01 \x01(\tR\bpromptId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12*\n" +
+	"\x11min_answer_length\x18\x04 \x01(\x05R\x0fminAnswerLength\x12*\n" +
+	"\x11max_answer_length\x18\x05 \x01(\x05R\x0fmaxAnswerLength\x12;\n" +
+	"\bquestion\x18\x06 \x01(\x0e2\x1f.datifyy.user.v1.PromptQuestionR\bquestion\"J\n" +
 	"\x14DeleteAccountRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"K\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9f\x01\n" +
 	"\x15DeleteAccountResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xac\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12R\n" +
+	"\x16deletion_scheduled_for\x18\x03 \x01(\v2\x1c.datifyy.common.v1.TimestampR\x14deletionScheduledFor\"\xac\x01\n" +
 	"\x19UploadProfilePhotoRequest\x12\x1d\n" +
 	"\n" +
 	"photo_data\x18\x01 \x01(\fR\tphotoData\x12!\n" +
@@ -10073,12 +12536,13 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\afilters\x18\x01 \x01(\v2\x1e.datifyy.user.v1.SearchFiltersR\afilters\x12D\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2$.datifyy.common.v1.PaginationRequestR\n" +
-	"pagination\"\x90\x01\n" +
+	"pagination\"\xb7\x01\n" +
 	"\x13SearchUsersResponse\x122\n" +
 	"\x05users\x18\x01 \x03(\v2\x1c.datifyy.user.v1.UserProfileR\x05users\x12E\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2%.datifyy.common.v1.PaginationResponseR\n" +
-	"pagination\"\xfa\a\n" +
+	"pagination\x12%\n" +
+	"\x0eresults_capped\x18\x03 \x01(\bR\rresultsCapped\"\xfa\a\n" +
 	"\rSearchFilters\x12/\n" +
 	"\x06gender\x18\x01 \x03(\x0e2\x17.datifyy.user.v1.GenderR\x06gender\x126\n" +
 	"\tage_range\x18\x02 \x01(\v2\x19.datifyy.user.v1.AgeRangeR\bageRange\x12\x1a\n" +
@@ -10101,11 +12565,55 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x06income\x18\x11 \x03(\x0e2\x1c.datifyy.user.v1.IncomeRangeR\x06income\x126\n" +
 	"\tbody_type\x18\x12 \x03(\x0e2\x19.datifyy.user.v1.BodyTypeR\bbodyType\"1\n" +
 	"\x19GetRecommendationsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\x85\x01\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\xac\x01\n" +
 	"\x1aGetRecommendationsResponse\x12F\n" +
 	"\x0frecommendations\x18\x01 \x03(\v2\x1c.datifyy.user.v1.UserProfileR\x0frecommendations\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\x1e\n" +
+	"totalCount\x12%\n" +
+	"\x0eresults_capped\x18\x03 \x01(\bR\rresultsCapped\"a\n" +
+	"\x19ListProfileViewersRequest\x12D\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2$.datifyy.common.v1.PaginationRequestR\n" +
+	"pagination\"\x9d\x01\n" +
+	"\x1aListProfileViewersResponse\x128\n" +
+	"\aviewers\x18\x01 \x03(\v2\x1e.datifyy.user.v1.ProfileViewerR\aviewers\x12E\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2%.datifyy.common.v1.PaginationResponseR\n" +
+	"pagination\"\x82\x01\n" +
+	"\rProfileViewer\x126\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1c.datifyy.user.v1.UserProfileR\aprofile\x129\n" +
+	"\tviewed_at\x18\x02 \x01(\v2\x1c.datifyy.common.v1.TimestampR\bviewedAt\"*\n" +
+	"\x0fLikeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x8d\x01\n" +
+	"\x10LikeUserResponse\x12\x1b\n" +
+	"\tis_mutual\x18\x01 \x01(\bR\bisMutual\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12'\n" +
+	"\x0fremaining_today\x18\x03 \x01(\x05R\x0eremainingToday\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"/\n" +
+	"\x14SuperLikeUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x92\x01\n" +
+	"\x15SuperLikeUserResponse\x12\x1b\n" +
+	"\tis_mutual\x18\x01 \x01(\bR\bisMutual\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12'\n" +
+	"\x0fremaining_today\x18\x03 \x01(\x05R\x0eremainingToday\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"*\n" +
+	"\x0fPassUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
+	"\x10PassUserResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"`\n" +
+	"\x18ListLikesReceivedRequest\x12D\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2$.datifyy.common.v1.PaginationRequestR\n" +
+	"pagination\"\x97\x01\n" +
+	"\x19ListLikesReceivedResponse\x123\n" +
+	"\x05likes\x18\x01 \x03(\v2\x1d.datifyy.user.v1.ReceivedLikeR\x05likes\x12E\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2%.datifyy.common.v1.PaginationResponseR\n" +
+	"pagination\"\xa3\x01\n" +
+	"\fReceivedLike\x126\n" +
+	"\aprofile\x18\x01 \x01(\v2\x1c.datifyy.user.v1.UserProfileR\aprofile\x12\"\n" +
+	"\ris_super_like\x18\x02 \x01(\bR\visSuperLike\x127\n" +
+	"\bliked_at\x18\x03 \x01(\v2\x1c.datifyy.common.v1.TimestampR\alikedAt\"\x1e\n" +
 	"\x1cGetPartnerPreferencesRequest\"f\n" +
 	"\x1dGetPartnerPreferencesResponse\x12E\n" +
 	"\vpreferences\x18\x01 \x01(\v2#.datifyy.user.v1.PartnerPreferencesR\vpreferences\"\x8d\x01\n" +
@@ -10151,6 +12659,13 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\revidence_urls\x18\x04 \x03(\tR\fevidenceUrls\"K\n" +
 	"\x12ReportUserResponse\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"e\n" +
+	"\x17AppealSuspensionRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"Q\n" +
+	"\x18AppealSuspensionResponse\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\xdf\x01\n" +
 	"\vUserSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
@@ -10274,7 +12789,84 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x1dGetLoveZoneStatisticsResponse\x12C\n" +
 	"\n" +
 	"statistics\x18\x01 \x01(\v2#.datifyy.user.v1.LoveZoneStatisticsR\n" +
-	"statistics*\xdf\x01\n" +
+	"statistics\"D\n" +
+	"\x11CancelDateRequest\x12\x17\n" +
+	"\adate_id\x18\x01 \x01(\x05R\x06dateId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xcd\x01\n" +
+	"\x12CancelDateResponse\x12\x17\n" +
+	"\adate_id\x18\x01 \x01(\x05R\x06dateId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12+\n" +
+	"\x11late_cancellation\x18\x03 \x01(\bR\x10lateCancellation\x12?\n" +
+	"\fcancelled_at\x18\x04 \x01(\v2\x1c.datifyy.common.v1.TimestampR\vcancelledAt\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x95\x03\n" +
+	"\x11RescheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\adate_id\x18\x02 \x01(\x05R\x06dateId\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\x05R\vrequestedBy\x12C\n" +
+	"\x0eproposed_times\x18\x04 \x03(\v2\x1c.datifyy.common.v1.TimestampR\rproposedTimes\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12A\n" +
+	"\raccepted_time\x18\a \x01(\v2\x1c.datifyy.common.v1.TimestampR\facceptedTime\x12;\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1c.datifyy.common.v1.TimestampR\tcreatedAt\x12?\n" +
+	"\fresponded_at\x18\t \x01(\v2\x1c.datifyy.common.v1.TimestampR\vrespondedAt\"\x90\x01\n" +
+	"\x18RequestRescheduleRequest\x12\x17\n" +
+	"\adate_id\x18\x01 \x01(\x05R\x06dateId\x12C\n" +
+	"\x0eproposed_times\x18\x02 \x03(\v2\x1c.datifyy.common.v1.TimestampR\rproposedTimes\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"s\n" +
+	"\x19RequestRescheduleResponse\x12<\n" +
+	"\arequest\x18\x01 \x01(\v2\".datifyy.user.v1.RescheduleRequestR\arequest\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x96\x01\n" +
+	"\x1aRespondToRescheduleRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x05R\trequestId\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\x12A\n" +
+	"\rselected_time\x18\x03 \x01(\v2\x1c.datifyy.common.v1.TimestampR\fselectedTime\"\xba\x01\n" +
+	"\x1bRespondToRescheduleResponse\x12<\n" +
+	"\arequest\x18\x01 \x01(\v2\".datifyy.user.v1.RescheduleRequestR\arequest\x12C\n" +
+	"\x0escheduled_time\x18\x02 \x01(\v2\x1c.datifyy.common.v1.TimestampR\rscheduledTime\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"A\n" +
+	" SendWorkEmailVerificationRequest\x12\x1d\n" +
+	"\n" +
+	"work_email\x18\x01 \x01(\tR\tworkEmail\"z\n" +
+	"!SendWorkEmailVerificationResponse\x12;\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\v2\x1c.datifyy.common.v1.TimestampR\texpiresAt\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x16VerifyWorkEmailRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"K\n" +
+	"\x17VerifyWorkEmailResponse\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x86\x02\n" +
+	"\x1bSubmitIdVerificationRequest\x12D\n" +
+	"\rdocument_type\x18\x01 \x01(\x0e2\x1f.datifyy.user.v1.IdDocumentTypeR\fdocumentType\x12\x1b\n" +
+	"\tid_number\x18\x02 \x01(\tR\bidNumber\x12\x1f\n" +
+	"\vfront_image\x18\x03 \x01(\fR\n" +
+	"frontImage\x12\x1d\n" +
+	"\n" +
+	"back_image\x18\x04 \x01(\fR\tbackImage\x12!\n" +
+	"\fselfie_image\x18\x05 \x01(\fR\vselfieImage\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\"\xa0\x01\n" +
+	"\x1cSubmitIdVerificationResponse\x12'\n" +
+	"\x0fverification_id\x18\x01 \x01(\tR\x0everificationId\x12=\n" +
+	"\x06status\x18\x02 \x01(\x0e2%.datifyy.user.v1.IdVerificationStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x1e\n" +
+	"\x1cGetVerificationStatusRequest\"\xce\x02\n" +
+	"\x1dGetVerificationStatusResponse\x12.\n" +
+	"\x13work_email_verified\x18\x01 \x01(\bR\x11workEmailVerified\x12*\n" +
+	"\x11work_email_domain\x18\x02 \x01(\tR\x0fworkEmailDomain\x12\x1f\n" +
+	"\vid_verified\x18\x03 \x01(\bR\n" +
+	"idVerified\x12[\n" +
+	"\x16id_verification_status\x18\x04 \x01(\x0e2%.datifyy.user.v1.IdVerificationStatusR\x14idVerificationStatus\x12(\n" +
+	"\x10id_number_masked\x18\x05 \x01(\tR\x0eidNumberMasked\x12)\n" +
+	"\x10rejection_reason\x18\x06 \x01(\tR\x0frejectionReason\"\x1a\n" +
+	"\x18RequestDataExportRequest\"\xf1\x01\n" +
+	"\x19RequestDataExportResponse\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12?\n" +
+	"\frequested_at\x18\x03 \x01(\v2\x1c.datifyy.common.v1.TimestampR\vrequestedAt\x12D\n" +
+	"\x0fnext_allowed_at\x18\x04 \x01(\v2\x1c.datifyy.common.v1.TimestampR\rnextAllowedAt\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage*\xdf\x01\n" +
 	"\x06Gender\x12\x16\n" +
 	"\x12GENDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vGENDER_MALE\x10\x01\x12\x11\n" +
@@ -10580,7 +13172,20 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x19REPORT_REASON_HATE_SPEECH\x10\b\x12\x1a\n" +
 	"\x16REPORT_REASON_VIOLENCE\x10\t\x12\x17\n" +
 	"\x13REPORT_REASON_OTHER\x10\n" +
-	"*\xcd\x01\n" +
+	"*\xce\x01\n" +
+	"\x0eIdDocumentType\x12 \n" +
+	"\x1cID_DOCUMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ID_DOCUMENT_TYPE_AADHAAR\x10\x01\x12\x1d\n" +
+	"\x19ID_DOCUMENT_TYPE_PASSPORT\x10\x02\x12$\n" +
+	" ID_DOCUMENT_TYPE_DRIVING_LICENSE\x10\x03\x12\x1d\n" +
+	"\x19ID_DOCUMENT_TYPE_VOTER_ID\x10\x04\x12\x18\n" +
+	"\x14ID_DOCUMENT_TYPE_PAN\x10\x05*\xd6\x01\n" +
+	"\x14IdVerificationStatus\x12&\n" +
+	"\"ID_VERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12(\n" +
+	"$ID_VERIFICATION_STATUS_NOT_SUBMITTED\x10\x01\x12\"\n" +
+	"\x1eID_VERIFICATION_STATUS_PENDING\x10\x02\x12#\n" +
+	"\x1fID_VERIFICATION_STATUS_APPROVED\x10\x03\x12#\n" +
+	"\x1fID_VERIFICATION_STATUS_REJECTED\x10\x04*\xcd\x01\n" +
 	"\rManglikStatus\x12\x1e\n" +
 	"\x1aMANGLIK_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16MANGLIK_STATUS_MANGLIK\x10\x01\x12\x1e\n" +
@@ -10834,7 +13439,7 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x19MUSTHAVE_TYPE_ADVENTUROUS\x10\r\x12\x1a\n" +
 	"\x16MUSTHAVE_TYPE_HOMEBODY\x10\x0e\x12\x18\n" +
 	"\x14MUSTHAVE_TYPE_SOCIAL\x10\x0f\x12\x17\n" +
-	"\x13MUSTHAVE_TYPE_OTHER\x10\x102\x8d\x12\n" +
+	"\x13MUSTHAVE_TYPE_OTHER\x10\x102\xa6\x1e\n" +
 	"\vUserService\x12a\n" +
 	"\x0eGetUserProfile\x12&.datifyy.user.v1.GetUserProfileRequest\x1a'.datifyy.user.v1.GetUserProfileResponse\x12[\n" +
 	"\fGetMyProfile\x12$.datifyy.user.v1.GetMyProfileRequest\x1a%.datifyy.user.v1.GetMyProfileResponse\x12^\n" +
@@ -10842,8 +13447,14 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\rDeleteAccount\x12%.datifyy.user.v1.DeleteAccountRequest\x1a&.datifyy.user.v1.DeleteAccountResponse\x12m\n" +
 	"\x12UploadProfilePhoto\x12*.datifyy.user.v1.UploadProfilePhotoRequest\x1a+.datifyy.user.v1.UploadProfilePhotoResponse\x12m\n" +
 	"\x12DeleteProfilePhoto\x12*.datifyy.user.v1.DeleteProfilePhotoRequest\x1a+.datifyy.user.v1.DeleteProfilePhotoResponse\x12X\n" +
+	"\vListPrompts\x12#.datifyy.user.v1.ListPromptsRequest\x1a$.datifyy.user.v1.ListPromptsResponse\x12X\n" +
 	"\vSearchUsers\x12#.datifyy.user.v1.SearchUsersRequest\x1a$.datifyy.user.v1.SearchUsersResponse\x12m\n" +
-	"\x12GetRecommendations\x12*.datifyy.user.v1.GetRecommendationsRequest\x1a+.datifyy.user.v1.GetRecommendationsResponse\x12v\n" +
+	"\x12GetRecommendations\x12*.datifyy.user.v1.GetRecommendationsRequest\x1a+.datifyy.user.v1.GetRecommendationsResponse\x12m\n" +
+	"\x12ListProfileViewers\x12*.datifyy.user.v1.ListProfileViewersRequest\x1a+.datifyy.user.v1.ListProfileViewersResponse\x12O\n" +
+	"\bLikeUser\x12 .datifyy.user.v1.LikeUserRequest\x1a!.datifyy.user.v1.LikeUserResponse\x12^\n" +
+	"\rSuperLikeUser\x12%.datifyy.user.v1.SuperLikeUserRequest\x1a&.datifyy.user.v1.SuperLikeUserResponse\x12O\n" +
+	"\bPassUser\x12 .datifyy.user.v1.PassUserRequest\x1a!.datifyy.user.v1.PassUserResponse\x12j\n" +
+	"\x11ListLikesReceived\x12).datifyy.user.v1.ListLikesReceivedRequest\x1a*.datifyy.user.v1.ListLikesReceivedResponse\x12v\n" +
 	"\x15GetPartnerPreferences\x12-.datifyy.user.v1.GetPartnerPreferencesRequest\x1a..datifyy.user.v1.GetPartnerPreferencesResponse\x12\x7f\n" +
 	"\x18UpdatePartnerPreferences\x120.datifyy.user.v1.UpdatePartnerPreferencesRequest\x1a1.datifyy.user.v1.UpdatePartnerPreferencesResponse\x12m\n" +
 	"\x12GetUserPreferences\x12*.datifyy.user.v1.GetUserPreferencesRequest\x1a+.datifyy.user.v1.GetUserPreferencesResponse\x12v\n" +
@@ -10852,13 +13463,23 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\vUnblockUser\x12#.datifyy.user.v1.UnblockUserRequest\x1a$.datifyy.user.v1.UnblockUserResponse\x12g\n" +
 	"\x10ListBlockedUsers\x12(.datifyy.user.v1.ListBlockedUsersRequest\x1a).datifyy.user.v1.ListBlockedUsersResponse\x12U\n" +
 	"\n" +
-	"ReportUser\x12\".datifyy.user.v1.ReportUserRequest\x1a#.datifyy.user.v1.ReportUserResponse\x12s\n" +
+	"ReportUser\x12\".datifyy.user.v1.ReportUserRequest\x1a#.datifyy.user.v1.ReportUserResponse\x12g\n" +
+	"\x10AppealSuspension\x12(.datifyy.user.v1.AppealSuspensionRequest\x1a).datifyy.user.v1.AppealSuspensionResponse\x12s\n" +
 	"\x14GetLoveZoneDashboard\x12,.datifyy.user.v1.GetLoveZoneDashboardRequest\x1a-.datifyy.user.v1.GetLoveZoneDashboardResponse\x12m\n" +
 	"\x12GetDateSuggestions\x12*.datifyy.user.v1.GetDateSuggestionsRequest\x1a+.datifyy.user.v1.GetDateSuggestionsResponse\x12g\n" +
 	"\x10GetUpcomingDates\x12(.datifyy.user.v1.GetUpcomingDatesRequest\x1a).datifyy.user.v1.GetUpcomingDatesResponse\x12[\n" +
 	"\fGetPastDates\x12$.datifyy.user.v1.GetPastDatesRequest\x1a%.datifyy.user.v1.GetPastDatesResponse\x12g\n" +
 	"\x10GetRejectedDates\x12(.datifyy.user.v1.GetRejectedDatesRequest\x1a).datifyy.user.v1.GetRejectedDatesResponse\x12v\n" +
-	"\x15GetLoveZoneStatistics\x12-.datifyy.user.v1.GetLoveZoneStatisticsRequest\x1a..datifyy.user.v1.GetLoveZoneStatisticsResponseB\xad\x01\n" +
+	"\x15GetLoveZoneStatistics\x12-.datifyy.user.v1.GetLoveZoneStatisticsRequest\x1a..datifyy.user.v1.GetLoveZoneStatisticsResponse\x12U\n" +
+	"\n" +
+	"CancelDate\x12\".datifyy.user.v1.CancelDateRequest\x1a#.datifyy.user.v1.CancelDateResponse\x12j\n" +
+	"\x11RequestReschedule\x12).datifyy.user.v1.RequestRescheduleRequest\x1a*.datifyy.user.v1.RequestRescheduleResponse\x12p\n" +
+	"\x13RespondToReschedule\x12+.datifyy.user.v1.RespondToRescheduleRequest\x1a,.datifyy.user.v1.RespondToRescheduleResponse\x12\x82\x01\n" +
+	"\x19SendWorkEmailVerification\x121.datifyy.user.v1.SendWorkEmailVerificationRequest\x1a2.datifyy.user.v1.SendWorkEmailVerificationResponse\x12d\n" +
+	"\x0fVerifyWorkEmail\x12'.datifyy.user.v1.VerifyWorkEmailRequest\x1a(.datifyy.user.v1.VerifyWorkEmailResponse\x12s\n" +
+	"\x14SubmitIdVerification\x12,.datifyy.user.v1.SubmitIdVerificationRequest\x1a-.datifyy.user.v1.SubmitIdVerificationResponse\x12v\n" +
+	"\x15GetVerificationStatus\x12-.datifyy.user.v1.GetVerificationStatusRequest\x1a..datifyy.user.v1.GetVerificationStatusResponse\x12j\n" +
+	"\x11RequestDataExport\x12).datifyy.user.v1.RequestDataExportRequest\x1a*.datifyy.user.v1.RequestDataExportResponseB\xad\x01\n" +
 	"\x13com.datifyy.user.v1B\tUserProtoP\x01Z-github.com/datifyy/backend/gen/user/v1;userv1\xa2\x02\x03DUX\xaa\x02\x0fDatifyy.User.V1\xca\x02\x0fDatifyy\\User\\V1\xe2\x02\x1bDatifyy\\User\\V1\\GPBMetadata\xea\x02\x11Datifyy::User::V1b\x06proto3"
 
 var (
@@ -10873,364 +13494,462 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 49)
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 51)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
 var file_user_v1_user_proto_goTypes = []any{
-	(Gender)(0),                              // 0: datifyy.user.v1.Gender
-	(ZodiacSign)(0),                          // 1: datifyy.user.v1.ZodiacSign
-	(OccupationCategory)(0),                  // 2: datifyy.user.v1.OccupationCategory
-	(EducationLevel)(0),                      // 3: datifyy.user.v1.EducationLevel
-	(InterestCategory)(0),                    // 4: datifyy.user.v1.InterestCategory
-	(LanguageCode)(0),                        // 5: datifyy.user.v1.LanguageCode
-	(LanguageProficiency)(0),                 // 6: datifyy.user.v1.LanguageProficiency
-	(RelationshipGoal)(0),                    // 7: datifyy.user.v1.RelationshipGoal
-	(DrinkingHabit)(0),                       // 8: datifyy.user.v1.DrinkingHabit
-	(SmokingHabit)(0),                        // 9: datifyy.user.v1.SmokingHabit
-	(WorkoutFrequency)(0),                    // 10: datifyy.user.v1.WorkoutFrequency
-	(DietaryPreference)(0),                   // 11: datifyy.user.v1.DietaryPreference
-	(Religion)(0),                            // 12: datifyy.user.v1.Religion
-	(Importance)(0),                          // 13: datifyy.user.v1.Importance
-	(PoliticalView)(0),                       // 14: datifyy.user.v1.PoliticalView
-	(PetPreference)(0),                       // 15: datifyy.user.v1.PetPreference
-	(ChildrenPreference)(0),                  // 16: datifyy.user.v1.ChildrenPreference
-	(CommunicationStyle)(0),                  // 17: datifyy.user.v1.CommunicationStyle
-	(LoveLanguage)(0),                        // 18: datifyy.user.v1.LoveLanguage
-	(SleepSchedule)(0),                       // 19: datifyy.user.v1.SleepSchedule
-	(PromptQuestion)(0),                      // 20: datifyy.user.v1.PromptQuestion
-	(ReportReason)(0),                        // 21: datifyy.user.v1.ReportReason
-	(ManglikStatus)(0),                       // 22: datifyy.user.v1.ManglikStatus
-	(ManglikPreference)(0),                   // 23: datifyy.user.v1.ManglikPreference
-	(Ethnicity)(0),                           // 24: datifyy.user.v1.Ethnicity
-	(BodyType)(0),                            // 25: datifyy.user.v1.BodyType
-	(Complexion)(0),                          // 26: datifyy.user.v1.Complexion
-	(HairColor)(0),                           // 27: datifyy.user.v1.HairColor
-	(EyeColor)(0),                            // 28: datifyy.user.v1.EyeColor
-	(FacialHair)(0),                          // 29: datifyy.user.v1.FacialHair
-	(DisabilityStatus)(0),                    // 30: datifyy.user.v1.DisabilityStatus
-	(BloodGroup)(0),                          // 31: datifyy.user.v1.BloodGroup
-	(IncomeRange)(0),                         // 32: datifyy.user.v1.IncomeRange
-	(EmploymentType)(0),                      // 33: datifyy.user.v1.EmploymentType
-	(FamilyType)(0),                          // 34: datifyy.user.v1.FamilyType
-	(FamilyValues)(0),                        // 35: datifyy.user.v1.FamilyValues
-	(LivingSituation)(0),                     // 36: datifyy.user.v1.LivingSituation
-	(FamilyAffluence)(0),                     // 37: datifyy.user.v1.FamilyAffluence
-	(ParentStatus)(0),                        // 38: datifyy.user.v1.ParentStatus
-	(NRIPreference)(0),                       // 39: datifyy.user.v1.NRIPreference
-	(RelocationExpectation)(0),               // 40: datifyy.user.v1.RelocationExpectation
-	(TattooPreference)(0),                    // 41: datifyy.user.v1.TattooPreference
-	(PiercingPreference)(0),                  // 42: datifyy.user.v1.PiercingPreference
-	(DisabilityAcceptance)(0),                // 43: datifyy.user.v1.DisabilityAcceptance
-	(PropertyOwnership)(0),                   // 44: datifyy.user.v1.PropertyOwnership
-	(VehicleOwnership)(0),                    // 45: datifyy.user.v1.VehicleOwnership
-	(FinancialExpectation)(0),                // 46: datifyy.user.v1.FinancialExpectation
-	(DealBreakerType)(0),                     // 47: datifyy.user.v1.DealBreakerType
-	(MustHaveType)(0),                        // 48: datifyy.user.v1.MustHaveType
-	(*UserProfile)(nil),                      // 49: datifyy.user.v1.UserProfile
-	(*BasicInfo)(nil),                        // 50: datifyy.user.v1.BasicInfo
-	(*ProfileDetails)(nil),                   // 51: datifyy.user.v1.ProfileDetails
-	(*LifestyleInfo)(nil),                    // 52: datifyy.user.v1.LifestyleInfo
-	(*CulturalInfo)(nil),                     // 53: datifyy.user.v1.CulturalInfo
-	(*AppearanceInfo)(nil),                   // 54: datifyy.user.v1.AppearanceInfo
-	(*ProfessionalInfo)(nil),                 // 55: datifyy.user.v1.ProfessionalInfo
-	(*FamilyInfo)(nil),                       // 56: datifyy.user.v1.FamilyInfo
-	(*OccupationInfo)(nil),                   // 57: datifyy.user.v1.OccupationInfo
-	(*EducationInfo)(nil),                    // 58: datifyy.user.v1.EducationInfo
-	(*InterestInfo)(nil),                     // 59: datifyy.user.v1.InterestInfo
-	(*LanguageInfo)(nil),                     // 60: datifyy.user.v1.LanguageInfo
-	(*ProfilePrompt)(nil),                    // 61: datifyy.user.v1.ProfilePrompt
-	(*ProfilePhoto)(nil),                     // 62: datifyy.user.v1.ProfilePhoto
-	(*AccountMetadata)(nil),                  // 63: datifyy.user.v1.AccountMetadata
-	(*PartnerPreferences)(nil),               // 64: datifyy.user.v1.PartnerPreferences
-	(*AgeRange)(nil),                         // 65: datifyy.user.v1.AgeRange
-	(*HeightRange)(nil),                      // 66: datifyy.user.v1.HeightRange
-	(*DealBreaker)(nil),                      // 67: datifyy.user.v1.DealBreaker
-	(*MustHave)(nil),                         // 68: datifyy.user.v1.MustHave
-	(*UserPreferences)(nil),                  // 69: datifyy.user.v1.UserPreferences
-	(*NotificationPreferences)(nil),          // 70: datifyy.user.v1.NotificationPreferences
-	(*PrivacyPreferences)(nil),               // 71: datifyy.user.v1.PrivacyPreferences
-	(*DiscoveryPreferences)(nil),             // 72: datifyy.user.v1.DiscoveryPreferences
-	(*GetUserProfileRequest)(nil),            // 73: datifyy.user.v1.GetUserProfileRequest
-	(*GetUserProfileResponse)(nil),           // 74: datifyy.user.v1.GetUserProfileResponse
-	(*GetMyProfileRequest)(nil),              // 75: datifyy.user.v1.GetMyProfileRequest
-	(*GetMyProfileResponse)(nil),             // 76: datifyy.user.v1.GetMyProfileResponse
-	(*UpdateProfileRequest)(nil),             // 77: datifyy.user.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),            // 78: datifyy.user.v1.UpdateProfileResponse
-	(*DeleteAccountRequest)(nil),             // 79: datifyy.user.v1.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil),            // 80: datifyy.user.v1.DeleteAccountResponse
-	(*UploadProfilePhotoRequest)(nil),        // 81: datifyy.user.v1.UploadProfilePhotoRequest
-	(*UploadProfilePhotoResponse)(nil),       // 82: datifyy.user.v1.UploadProfilePhotoResponse
-	(*DeleteProfilePhotoRequest)(nil),        // 83: datifyy.user.v1.DeleteProfilePhotoRequest
-	(*DeleteProfilePhotoResponse)(nil),       // 84: datifyy.user.v1.DeleteProfilePhotoResponse
-	(*SearchUsersRequest)(nil),               // 85: datifyy.user.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),              // 86: datifyy.user.v1.SearchUsersResponse
-	(*SearchFilters)(nil),                    // 87: datifyy.user.v1.SearchFilters
-	(*GetRecommendationsRequest)(nil),        // 88: datifyy.user.v1.GetRecommendationsRequest
-	(*GetRecommendationsResponse)(nil),       // 89: datifyy.user.v1.GetRecommendationsResponse
-	(*GetPartnerPreferencesRequest)(nil),     // 90: datifyy.user.v1.GetPartnerPreferencesRequest
-	(*GetPartnerPreferencesResponse)(nil),    // 91: datifyy.user.v1.GetPartnerPreferencesResponse
-	(*UpdatePartnerPreferencesRequest)(nil),  // 92: datifyy.user.v1.UpdatePartnerPreferencesRequest
-	(*UpdatePartnerPreferencesResponse)(nil), // 93: datifyy.user.v1.UpdatePartnerPreferencesResponse
-	(*GetUserPreferencesRequest)(nil),        // 94: datifyy.user.v1.GetUserPreferencesRequest
-	(*GetUserPreferencesResponse)(nil),       // 95: datifyy.user.v1.GetUserPreferencesResponse
-	(*UpdateUserPreferencesRequest)(nil),     // 96: datifyy.user.v1.UpdateUserPreferencesRequest
-	(*UpdateUserPreferencesResponse)(nil),    // 97: datifyy.user.v1.UpdateUserPreferencesResponse
-	(*BlockUserRequest)(nil),                 // 98: datifyy.user.v1.BlockUserRequest
-	(*BlockUserResponse)(nil),                // 99: datifyy.user.v1.BlockUserResponse
-	(*UnblockUserRequest)(nil),               // 100: datifyy.user.v1.UnblockUserRequest
-	(*UnblockUserResponse)(nil),              // 101: datifyy.user.v1.UnblockUserResponse
-	(*ListBlockedUsersRequest)(nil),          // 102: datifyy.user.v1.ListBlockedUsersRequest
-	(*ListBlockedUsersResponse)(nil),         // 103: datifyy.user.v1.ListBlockedUsersResponse
-	(*ReportUserRequest)(nil),                // 104: datifyy.user.v1.ReportUserRequest
-	(*ReportUserResponse)(nil),               // 105: datifyy.user.v1.ReportUserResponse
-	(*UserSummary)(nil),                      // 106: datifyy.user.v1.UserSummary
-	(*DateSuggestionDetail)(nil),             // 107: datifyy.user.v1.DateSuggestionDetail
-	(*ScheduledDateDetail)(nil),              // 108: datifyy.user.v1.ScheduledDateDetail
-	(*RejectedDateDetail)(nil),               // 109: datifyy.user.v1.RejectedDateDetail
-	(*LoveZoneStatistics)(nil),               // 110: datifyy.user.v1.LoveZoneStatistics
-	(*GetLoveZoneDashboardRequest)(nil),      // 111: datifyy.user.v1.GetLoveZoneDashboardRequest
-	(*GetLoveZoneDashboardResponse)(nil),     // 112: datifyy.user.v1.GetLoveZoneDashboardResponse
-	(*GetDateSuggestionsRequest)(nil),        // 113: datifyy.user.v1.GetDateSuggestionsRequest
-	(*GetDateSuggestionsResponse)(nil),       // 114: datifyy.user.v1.GetDateSuggestionsResponse
-	(*GetUpcomingDatesRequest)(nil),          // 115: datifyy.user.v1.GetUpcomingDatesRequest
-	(*GetUpcomingDatesResponse)(nil),         // 116: datifyy.user.v1.GetUpcomingDatesResponse
-	(*GetPastDatesRequest)(nil),              // 117: datifyy.user.v1.GetPastDatesRequest
-	(*GetPastDatesResponse)(nil),             // 118: datifyy.user.v1.GetPastDatesResponse
-	(*GetRejectedDatesRequest)(nil),          // 119: datifyy.user.v1.GetRejectedDatesRequest
-	(*GetRejectedDatesResponse)(nil),         // 120: datifyy.user.v1.GetRejectedDatesResponse
-	(*GetLoveZoneStatisticsRequest)(nil),     // 121: datifyy.user.v1.GetLoveZoneStatisticsRequest
-	(*GetLoveZoneStatisticsResponse)(nil),    // 122: datifyy.user.v1.GetLoveZoneStatisticsResponse
-	(*v1.Timestamp)(nil),                     // 123: datifyy.common.v1.Timestamp
-	(*v1.Location)(nil),                      // 124: datifyy.common.v1.Location
-	(v1.AccountStatus)(0),                    // 125: datifyy.common.v1.AccountStatus
-	(v1.VerificationStatus)(0),               // 126: datifyy.common.v1.VerificationStatus
-	(*v1.PaginationRequest)(nil),             // 127: datifyy.common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),            // 128: datifyy.common.v1.PaginationResponse
+	(Gender)(0),                               // 0: datifyy.user.v1.Gender
+	(ZodiacSign)(0),                           // 1: datifyy.user.v1.ZodiacSign
+	(OccupationCategory)(0),                   // 2: datifyy.user.v1.OccupationCategory
+	(EducationLevel)(0),                       // 3: datifyy.user.v1.EducationLevel
+	(InterestCategory)(0),                     // 4: datifyy.user.v1.InterestCategory
+	(LanguageCode)(0),                         // 5: datifyy.user.v1.LanguageCode
+	(LanguageProficiency)(0),                  // 6: datifyy.user.v1.LanguageProficiency
+	(RelationshipGoal)(0),                     // 7: datifyy.user.v1.RelationshipGoal
+	(DrinkingHabit)(0),                        // 8: datifyy.user.v1.DrinkingHabit
+	(SmokingHabit)(0),                         // 9: datifyy.user.v1.SmokingHabit
+	(WorkoutFrequency)(0),                     // 10: datifyy.user.v1.WorkoutFrequency
+	(DietaryPreference)(0),                    // 11: datifyy.user.v1.DietaryPreference
+	(Religion)(0),                             // 12: datifyy.user.v1.Religion
+	(Importance)(0),                           // 13: datifyy.user.v1.Importance
+	(PoliticalView)(0),                        // 14: datifyy.user.v1.PoliticalView
+	(PetPreference)(0),                        // 15: datifyy.user.v1.PetPreference
+	(ChildrenPreference)(0),                   // 16: datifyy.user.v1.ChildrenPreference
+	(CommunicationStyle)(0),                   // 17: datifyy.user.v1.CommunicationStyle
+	(LoveLanguage)(0),                         // 18: datifyy.user.v1.LoveLanguage
+	(SleepSchedule)(0),                        // 19: datifyy.user.v1.SleepSchedule
+	(PromptQuestion)(0),                       // 20: datifyy.user.v1.PromptQuestion
+	(ReportReason)(0),                         // 21: datifyy.user.v1.ReportReason
+	(IdDocumentType)(0),                       // 22: datifyy.user.v1.IdDocumentType
+	(IdVerificationStatus)(0),                 // 23: datifyy.user.v1.IdVerificationStatus
+	(ManglikStatus)(0),                        // 24: datifyy.user.v1.ManglikStatus
+	(ManglikPreference)(0),                    // 25: datifyy.user.v1.ManglikPreference
+	(Ethnicity)(0),                            // 26: datifyy.user.v1.Ethnicity
+	(BodyType)(0),                             // 27: datifyy.user.v1.BodyType
+	(Complexion)(0),                           // 28: datifyy.user.v1.Complexion
+	(HairColor)(0),                            // 29: datifyy.user.v1.HairColor
+	(EyeColor)(0),                             // 30: datifyy.user.v1.EyeColor
+	(FacialHair)(0),                           // 31: datifyy.user.v1.FacialHair
+	(DisabilityStatus)(0),                     // 32: datifyy.user.v1.DisabilityStatus
+	(BloodGroup)(0),                           // 33: datifyy.user.v1.BloodGroup
+	(IncomeRange)(0),                          // 34: datifyy.user.v1.IncomeRange
+	(EmploymentType)(0),                       // 35: datifyy.user.v1.EmploymentType
+	(FamilyType)(0),                           // 36: datifyy.user.v1.FamilyType
+	(FamilyValues)(0),                         // 37: datifyy.user.v1.FamilyValues
+	(LivingSituation)(0),                      // 38: datifyy.user.v1.LivingSituation
+	(FamilyAffluence)(0),                      // 39: datifyy.user.v1.FamilyAffluence
+	(ParentStatus)(0),                         // 40: datifyy.user.v1.ParentStatus
+	(NRIPreference)(0),                        // 41: datifyy.user.v1.NRIPreference
+	(RelocationExpectation)(0),                // 42: datifyy.user.v1.RelocationExpectation
+	(TattooPreference)(0),                     // 43: datifyy.user.v1.TattooPreference
+	(PiercingPreference)(0),                   // 44: datifyy.user.v1.PiercingPreference
+	(DisabilityAcceptance)(0),                 // 45: datifyy.user.v1.DisabilityAcceptance
+	(PropertyOwnership)(0),                    // 46: datifyy.user.v1.PropertyOwnership
+	(VehicleOwnership)(0),                     // 47: datifyy.user.v1.VehicleOwnership
+	(FinancialExpectation)(0),                 // 48: datifyy.user.v1.FinancialExpectation
+	(DealBreakerType)(0),                      // 49: datifyy.user.v1.DealBreakerType
+	(MustHaveType)(0),                         // 50: datifyy.user.v1.MustHaveType
+	(*UserProfile)(nil),                       // 51: datifyy.user.v1.UserProfile
+	(*BasicInfo)(nil),                         // 52: datifyy.user.v1.BasicInfo
+	(*ProfileDetails)(nil),                    // 53: datifyy.user.v1.ProfileDetails
+	(*LifestyleInfo)(nil),                     // 54: datifyy.user.v1.LifestyleInfo
+	(*CulturalInfo)(nil),                      // 55: datifyy.user.v1.CulturalInfo
+	(*HoroscopeMatch)(nil),                    // 56: datifyy.user.v1.HoroscopeMatch
+	(*AppearanceInfo)(nil),                    // 57: datifyy.user.v1.AppearanceInfo
+	(*ProfessionalInfo)(nil),                  // 58: datifyy.user.v1.ProfessionalInfo
+	(*FamilyInfo)(nil),                        // 59: datifyy.user.v1.FamilyInfo
+	(*OccupationInfo)(nil),                    // 60: datifyy.user.v1.OccupationInfo
+	(*EducationInfo)(nil),                     // 61: datifyy.user.v1.EducationInfo
+	(*InterestInfo)(nil),                      // 62: datifyy.user.v1.InterestInfo
+	(*LanguageInfo)(nil),                      // 63: datifyy.user.v1.LanguageInfo
+	(*ProfilePrompt)(nil),                     // 64: datifyy.user.v1.ProfilePrompt
+	(*ProfilePhoto)(nil),                      // 65: datifyy.user.v1.ProfilePhoto
+	(*AccountMetadata)(nil),                   // 66: datifyy.user.v1.AccountMetadata
+	(*PartnerPreferences)(nil),                // 67: datifyy.user.v1.PartnerPreferences
+	(*AgeRange)(nil),                          // 68: datifyy.user.v1.AgeRange
+	(*HeightRange)(nil),                       // 69: datifyy.user.v1.HeightRange
+	(*DealBreaker)(nil),                       // 70: datifyy.user.v1.DealBreaker
+	(*MustHave)(nil),                          // 71: datifyy.user.v1.MustHave
+	(*PreferenceRuleResult)(nil),              // 72: datifyy.user.v1.PreferenceRuleResult
+	(*UserPreferences)(nil),                   // 73: datifyy.user.v1.UserPreferences
+	(*NotificationPreferences)(nil),           // 74: datifyy.user.v1.NotificationPreferences
+	(*PrivacyPreferences)(nil),                // 75: datifyy.user.v1.PrivacyPreferences
+	(*DiscoveryPreferences)(nil),              // 76: datifyy.user.v1.DiscoveryPreferences
+	(*GetUserProfileRequest)(nil),             // 77: datifyy.user.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),            // 78: datifyy.user.v1.GetUserProfileResponse
+	(*GetMyProfileRequest)(nil),               // 79: datifyy.user.v1.GetMyProfileRequest
+	(*GetMyProfileResponse)(nil),              // 80: datifyy.user.v1.GetMyProfileResponse
+	(*UpdateProfileRequest)(nil),              // 81: datifyy.user.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),             // 82: datifyy.user.v1.UpdateProfileResponse
+	(*ListPromptsRequest)(nil),                // 83: datifyy.user.v1.ListPromptsRequest
+	(*ListPromptsResponse)(nil),               // 84: datifyy.user.v1.ListPromptsResponse
+	(*PromptDefinition)(nil),                  // 85: datifyy.user.v1.PromptDefinition
+	(*DeleteAccountRequest)(nil),              // 86: datifyy.user.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),             // 87: datifyy.user.v1.DeleteAccountResponse
+	(*UploadProfilePhotoRequest)(nil),         // 88: datifyy.user.v1.UploadProfilePhotoRequest
+	(*UploadProfilePhotoResponse)(nil),        // 89: datifyy.user.v1.UploadProfilePhotoResponse
+	(*DeleteProfilePhotoRequest)(nil),         // 90: datifyy.user.v1.DeleteProfilePhotoRequest
+	(*DeleteProfilePhotoResponse)(nil),        // 91: datifyy.user.v1.DeleteProfilePhotoResponse
+	(*SearchUsersRequest)(nil),                // 92: datifyy.user.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),               // 93: datifyy.user.v1.SearchUsersResponse
+	(*SearchFilters)(nil),                     // 94: datifyy.user.v1.SearchFilters
+	(*GetRecommendationsRequest)(nil),         // 95: datifyy.user.v1.GetRecommendationsRequest
+	(*GetRecommendationsResponse)(nil),        // 96: datifyy.user.v1.GetRecommendationsResponse
+	(*ListProfileViewersRequest)(nil),         // 97: datifyy.user.v1.ListProfileViewersRequest
+	(*ListProfileViewersResponse)(nil),        // 98: datifyy.user.v1.ListProfileViewersResponse
+	(*ProfileViewer)(nil),                     // 99: datifyy.user.v1.ProfileViewer
+	(*LikeUserRequest)(nil),                   // 100: datifyy.user.v1.LikeUserRequest
+	(*LikeUserResponse)(nil),                  // 101: datifyy.user.v1.LikeUserResponse
+	(*SuperLikeUserRequest)(nil),              // 102: datifyy.user.v1.SuperLikeUserRequest
+	(*SuperLikeUserResponse)(nil),             // 103: datifyy.user.v1.SuperLikeUserResponse
+	(*PassUserRequest)(nil),                   // 104: datifyy.user.v1.PassUserRequest
+	(*PassUserResponse)(nil),                  // 105: datifyy.user.v1.PassUserResponse
+	(*ListLikesReceivedRequest)(nil),          // 106: datifyy.user.v1.ListLikesReceivedRequest
+	(*ListLikesReceivedResponse)(nil),         // 107: datifyy.user.v1.ListLikesReceivedResponse
+	(*ReceivedLike)(nil),                      // 108: datifyy.user.v1.ReceivedLike
+	(*GetPartnerPreferencesRequest)(nil),      // 109: datifyy.user.v1.GetPartnerPreferencesRequest
+	(*GetPartnerPreferencesResponse)(nil),     // 110: datifyy.user.v1.GetPartnerPreferencesResponse
+	(*UpdatePartnerPreferencesRequest)(nil),   // 111: datifyy.user.v1.UpdatePartnerPreferencesRequest
+	(*UpdatePartnerPreferencesResponse)(nil),  // 112: datifyy.user.v1.UpdatePartnerPreferencesResponse
+	(*GetUserPreferencesRequest)(nil),         // 113: datifyy.user.v1.GetUserPreferencesRequest
+	(*GetUserPreferencesResponse)(nil),        // 114: datifyy.user.v1.GetUserPreferencesResponse
+	(*UpdateUserPreferencesRequest)(nil),      // 115: datifyy.user.v1.UpdateUserPreferencesRequest
+	(*UpdateUserPreferencesResponse)(nil),     // 116: datifyy.user.v1.UpdateUserPreferencesResponse
+	(*BlockUserRequest)(nil),                  // 117: datifyy.user.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),                 // 118: datifyy.user.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),                // 119: datifyy.user.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),               // 120: datifyy.user.v1.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),           // 121: datifyy.user.v1.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),          // 122: datifyy.user.v1.ListBlockedUsersResponse
+	(*ReportUserRequest)(nil),                 // 123: datifyy.user.v1.ReportUserRequest
+	(*ReportUserResponse)(nil),                // 124: datifyy.user.v1.ReportUserResponse
+	(*AppealSuspensionRequest)(nil),           // 125: datifyy.user.v1.AppealSuspensionRequest
+	(*AppealSuspensionResponse)(nil),          // 126: datifyy.user.v1.AppealSuspensionResponse
+	(*UserSummary)(nil),                       // 127: datifyy.user.v1.UserSummary
+	(*DateSuggestionDetail)(nil),              // 128: datifyy.user.v1.DateSuggestionDetail
+	(*ScheduledDateDetail)(nil),               // 129: datifyy.user.v1.ScheduledDateDetail
+	(*RejectedDateDetail)(nil),                // 130: datifyy.user.v1.RejectedDateDetail
+	(*LoveZoneStatistics)(nil),                // 131: datifyy.user.v1.LoveZoneStatistics
+	(*GetLoveZoneDashboardRequest)(nil),       // 132: datifyy.user.v1.GetLoveZoneDashboardRequest
+	(*GetLoveZoneDashboardResponse)(nil),      // 133: datifyy.user.v1.GetLoveZoneDashboardResponse
+	(*GetDateSuggestionsRequest)(nil),         // 134: datifyy.user.v1.GetDateSuggestionsRequest
+	(*GetDateSuggestionsResponse)(nil),        // 135: datifyy.user.v1.GetDateSuggestionsResponse
+	(*GetUpcomingDatesRequest)(nil),           // 136: datifyy.user.v1.GetUpcomingDatesRequest
+	(*GetUpcomingDatesResponse)(nil),          // 137: datifyy.user.v1.GetUpcomingDatesResponse
+	(*GetPastDatesRequest)(nil),               // 138: datifyy.user.v1.GetPastDatesRequest
+	(*GetPastDatesResponse)(nil),              // 139: datifyy.user.v1.GetPastDatesResponse
+	(*GetRejectedDatesRequest)(nil),           // 140: datifyy.user.v1.GetRejectedDatesRequest
+	(*GetRejectedDatesResponse)(nil),          // 141: datifyy.user.v1.GetRejectedDatesResponse
+	(*GetLoveZoneStatisticsRequest)(nil),      // 142: datifyy.user.v1.GetLoveZoneStatisticsRequest
+	(*GetLoveZoneStatisticsResponse)(nil),     // 143: datifyy.user.v1.GetLoveZoneStatisticsResponse
+	(*CancelDateRequest)(nil),                 // 144: datifyy.user.v1.CancelDateRequest
+	(*CancelDateResponse)(nil),                // 145: datifyy.user.v1.CancelDateResponse
+	(*RescheduleRequest)(nil),                 // 146: datifyy.user.v1.RescheduleRequest
+	(*RequestRescheduleRequest)(nil),          // 147: datifyy.user.v1.RequestRescheduleRequest
+	(*RequestRescheduleResponse)(nil),         // 148: datifyy.user.v1.RequestRescheduleResponse
+	(*RespondToRescheduleRequest)(nil),        // 149: datifyy.user.v1.RespondToRescheduleRequest
+	(*RespondToRescheduleResponse)(nil),       // 150: datifyy.user.v1.RespondToRescheduleResponse
+	(*SendWorkEmailVerificationRequest)(nil),  // 151: datifyy.user.v1.SendWorkEmailVerificationRequest
+	(*SendWorkEmailVerificationResponse)(nil), // 152: datifyy.user.v1.SendWorkEmailVerificationResponse
+	(*VerifyWorkEmailRequest)(nil),            // 153: datifyy.user.v1.VerifyWorkEmailRequest
+	(*VerifyWorkEmailResponse)(nil),           // 154: datifyy.user.v1.VerifyWorkEmailResponse
+	(*SubmitIdVerificationRequest)(nil),       // 155: datifyy.user.v1.SubmitIdVerificationRequest
+	(*SubmitIdVerificationResponse)(nil),      // 156: datifyy.user.v1.SubmitIdVerificationResponse
+	(*GetVerificationStatusRequest)(nil),      // 157: datifyy.user.v1.GetVerificationStatusRequest
+	(*GetVerificationStatusResponse)(nil),     // 158: datifyy.user.v1.GetVerificationStatusResponse
+	(*RequestDataExportRequest)(nil),          // 159: datifyy.user.v1.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),         // 160: datifyy.user.v1.RequestDataExportResponse
+	(*v1.Timestamp)(nil),                      // 161: datifyy.common.v1.Timestamp
+	(*v1.Location)(nil),                       // 162: datifyy.common.v1.Location
+	(v1.AccountStatus)(0),                     // 163: datifyy.common.v1.AccountStatus
+	(v1.VerificationStatus)(0),                // 164: datifyy.common.v1.VerificationStatus
+	(*v1.PaginationRequest)(nil),              // 165: datifyy.common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),             // 166: datifyy.common.v1.PaginationResponse
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	50,  // 0: datifyy.user.v1.UserProfile.basic_info:type_name -> datifyy.user.v1.BasicInfo
-	51,  // 1: datifyy.user.v1.UserProfile.profile_details:type_name -> datifyy.user.v1.ProfileDetails
-	52,  // 2: datifyy.user.v1.UserProfile.lifestyle_info:type_name -> datifyy.user.v1.LifestyleInfo
-	61,  // 3: datifyy.user.v1.UserProfile.prompts:type_name -> datifyy.user.v1.ProfilePrompt
-	62,  // 4: datifyy.user.v1.UserProfile.photos:type_name -> datifyy.user.v1.ProfilePhoto
-	63,  // 5: datifyy.user.v1.UserProfile.metadata:type_name -> datifyy.user.v1.AccountMetadata
-	64,  // 6: datifyy.user.v1.UserProfile.partner_preferences:type_name -> datifyy.user.v1.PartnerPreferences
-	69,  // 7: datifyy.user.v1.UserProfile.user_preferences:type_name -> datifyy.user.v1.UserPreferences
-	123, // 8: datifyy.user.v1.UserProfile.last_seen_at:type_name -> datifyy.common.v1.Timestamp
-	53,  // 9: datifyy.user.v1.UserProfile.cultural_info:type_name -> datifyy.user.v1.CulturalInfo
-	54,  // 10: datifyy.user.v1.UserProfile.appearance_info:type_name -> datifyy.user.v1.AppearanceInfo
-	55,  // 11: datifyy.user.v1.UserProfile.professional_info:type_name -> datifyy.user.v1.ProfessionalInfo
-	56,  // 12: datifyy.user.v1.UserProfile.family_info:type_name -> datifyy.user.v1.FamilyInfo
-	123, // 13: datifyy.user.v1.BasicInfo.date_of_birth:type_name -> datifyy.common.v1.Timestamp
-	0,   // 14: datifyy.user.v1.BasicInfo.gender:type_name -> datifyy.user.v1.Gender
-	1,   // 15: datifyy.user.v1.BasicInfo.zodiac_sign:type_name -> datifyy.user.v1.ZodiacSign
-	57,  // 16: datifyy.user.v1.ProfileDetails.occupations:type_name -> datifyy.user.v1.OccupationInfo
-	58,  // 17: datifyy.user.v1.ProfileDetails.education:type_name -> datifyy.user.v1.EducationInfo
-	124, // 18: datifyy.user.v1.ProfileDetails.location:type_name -> datifyy.common.v1.Location
-	59,  // 19: datifyy.user.v1.ProfileDetails.interests:type_name -> datifyy.user.v1.InterestInfo
-	60,  // 20: datifyy.user.v1.ProfileDetails.languages:type_name -> datifyy.user.v1.LanguageInfo
-	7,   // 21: datifyy.user.v1.ProfileDetails.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
-	8,   // 22: datifyy.user.v1.LifestyleInfo.drinking:type_name -> datifyy.user.v1.DrinkingHabit
-	9,   // 23: datifyy.user.v1.LifestyleInfo.smoking:type_name -> datifyy.user.v1.SmokingHabit
-	10,  // 24: datifyy.user.v1.LifestyleInfo.workout:type_name -> datifyy.user.v1.WorkoutFrequency
-	11,  // 25: datifyy.user.v1.LifestyleInfo.dietary_preference:type_name -> datifyy.user.v1.DietaryPreference
-	12,  // 26: datifyy.user.v1.LifestyleInfo.religion:type_name -> datifyy.user.v1.Religion
-	13,  // 27: datifyy.user.v1.LifestyleInfo.religion_importance:type_name -> datifyy.user.v1.Importance
-	14,  // 28: datifyy.user.v1.LifestyleInfo.political_view:type_name -> datifyy.user.v1.PoliticalView
-	15,  // 29: datifyy.user.v1.LifestyleInfo.pets:type_name -> datifyy.user.v1.PetPreference
-	16,  // 30: datifyy.user.v1.LifestyleInfo.children:type_name -> datifyy.user.v1.ChildrenPreference
-	17,  // 31: datifyy.user.v1.LifestyleInfo.communication_style:type_name -> datifyy.user.v1.CommunicationStyle
-	18,  // 32: datifyy.user.v1.LifestyleInfo.love_language:type_name -> datifyy.user.v1.LoveLanguage
-	19,  // 33: datifyy.user.v1.LifestyleInfo.sleep_schedule:type_name -> datifyy.user.v1.SleepSchedule
-	22,  // 34: datifyy.user.v1.CulturalInfo.manglik_status:type_name -> datifyy.user.v1.ManglikStatus
-	24,  // 35: datifyy.user.v1.CulturalInfo.ethnicity:type_name -> datifyy.user.v1.Ethnicity
-	25,  // 36: datifyy.user.v1.AppearanceInfo.body_type:type_name -> datifyy.user.v1.BodyType
-	26,  // 37: datifyy.user.v1.AppearanceInfo.complexion:type_name -> datifyy.user.v1.Complexion
-	27,  // 38: datifyy.user.v1.AppearanceInfo.hair_color:type_name -> datifyy.user.v1.HairColor
-	28,  // 39: datifyy.user.v1.AppearanceInfo.eye_color:type_name -> datifyy.user.v1.EyeColor
-	29,  // 40: datifyy.user.v1.AppearanceInfo.facial_hair:type_name -> datifyy.user.v1.FacialHair
-	30,  // 41: datifyy.user.v1.AppearanceInfo.disability_status:type_name -> datifyy.user.v1.DisabilityStatus
-	31,  // 42: datifyy.user.v1.AppearanceInfo.blood_group:type_name -> datifyy.user.v1.BloodGroup
-	32,  // 43: datifyy.user.v1.ProfessionalInfo.income_range:type_name -> datifyy.user.v1.IncomeRange
-	33,  // 44: datifyy.user.v1.ProfessionalInfo.employment_type:type_name -> datifyy.user.v1.EmploymentType
-	3,   // 45: datifyy.user.v1.ProfessionalInfo.highest_education:type_name -> datifyy.user.v1.EducationLevel
-	34,  // 46: datifyy.user.v1.FamilyInfo.family_type:type_name -> datifyy.user.v1.FamilyType
-	35,  // 47: datifyy.user.v1.FamilyInfo.family_values:type_name -> datifyy.user.v1.FamilyValues
-	36,  // 48: datifyy.user.v1.FamilyInfo.living_situation:type_name -> datifyy.user.v1.LivingSituation
-	37,  // 49: datifyy.user.v1.FamilyInfo.family_affluence:type_name -> datifyy.user.v1.FamilyAffluence
-	38,  // 50: datifyy.user.v1.FamilyInfo.father_status:type_name -> datifyy.user.v1.ParentStatus
-	38,  // 51: datifyy.user.v1.FamilyInfo.mother_status:type_name -> datifyy.user.v1.ParentStatus
-	2,   // 52: datifyy.user.v1.OccupationInfo.category:type_name -> datifyy.user.v1.OccupationCategory
-	3,   // 53: datifyy.user.v1.EducationInfo.level:type_name -> datifyy.user.v1.EducationLevel
-	4,   // 54: datifyy.user.v1.InterestInfo.category:type_name -> datifyy.user.v1.InterestCategory
-	5,   // 55: datifyy.user.v1.LanguageInfo.code:type_name -> datifyy.user.v1.LanguageCode
-	6,   // 56: datifyy.user.v1.LanguageInfo.proficiency:type_name -> datifyy.user.v1.LanguageProficiency
-	20,  // 57: datifyy.user.v1.ProfilePrompt.question:type_name -> datifyy.user.v1.PromptQuestion
-	123, // 58: datifyy.user.v1.ProfilePhoto.uploaded_at:type_name -> datifyy.common.v1.Timestamp
-	125, // 59: datifyy.user.v1.AccountMetadata.status:type_name -> datifyy.common.v1.AccountStatus
-	126, // 60: datifyy.user.v1.AccountMetadata.email_verified:type_name -> datifyy.common.v1.VerificationStatus
-	126, // 61: datifyy.user.v1.AccountMetadata.phone_verified:type_name -> datifyy.common.v1.VerificationStatus
-	123, // 62: datifyy.user.v1.AccountMetadata.created_at:type_name -> datifyy.common.v1.Timestamp
-	123, // 63: datifyy.user.v1.AccountMetadata.updated_at:type_name -> datifyy.common.v1.Timestamp
-	123, // 64: datifyy.user.v1.AccountMetadata.last_login_at:type_name -> datifyy.common.v1.Timestamp
-	0,   // 65: datifyy.user.v1.PartnerPreferences.looking_for_gender:type_name -> datifyy.user.v1.Gender
-	65,  // 66: datifyy.user.v1.PartnerPreferences.age_range:type_name -> datifyy.user.v1.AgeRange
-	66,  // 67: datifyy.user.v1.PartnerPreferences.height_range:type_name -> datifyy.user.v1.HeightRange
-	7,   // 68: datifyy.user.v1.PartnerPreferences.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
-	3,   // 69: datifyy.user.v1.PartnerPreferences.education_levels:type_name -> datifyy.user.v1.EducationLevel
-	2,   // 70: datifyy.user.v1.PartnerPreferences.occupations:type_name -> datifyy.user.v1.OccupationCategory
-	12,  // 71: datifyy.user.v1.PartnerPreferences.religions:type_name -> datifyy.user.v1.Religion
-	13,  // 72: datifyy.user.v1.PartnerPreferences.religion_importance:type_name -> datifyy.user.v1.Importance
-	16,  // 73: datifyy.user.v1.PartnerPreferences.children_preferences:type_name -> datifyy.user.v1.ChildrenPreference
-	8,   // 74: datifyy.user.v1.PartnerPreferences.drinking_preferences:type_name -> datifyy.user.v1.DrinkingHabit
-	9,   // 75: datifyy.user.v1.PartnerPreferences.smoking_preferences:type_name -> datifyy.user.v1.SmokingHabit
-	11,  // 76: datifyy.user.v1.PartnerPreferences.dietary_preferences:type_name -> datifyy.user.v1.DietaryPreference
-	15,  // 77: datifyy.user.v1.PartnerPreferences.pet_preferences:type_name -> datifyy.user.v1.PetPreference
-	10,  // 78: datifyy.user.v1.PartnerPreferences.workout_preferences:type_name -> datifyy.user.v1.WorkoutFrequency
-	17,  // 79: datifyy.user.v1.PartnerPreferences.communication_styles:type_name -> datifyy.user.v1.CommunicationStyle
-	18,  // 80: datifyy.user.v1.PartnerPreferences.love_languages:type_name -> datifyy.user.v1.LoveLanguage
-	14,  // 81: datifyy.user.v1.PartnerPreferences.political_views:type_name -> datifyy.user.v1.PoliticalView
-	19,  // 82: datifyy.user.v1.PartnerPreferences.sleep_schedules:type_name -> datifyy.user.v1.SleepSchedule
-	23,  // 83: datifyy.user.v1.PartnerPreferences.manglik_preference:type_name -> datifyy.user.v1.ManglikPreference
-	24,  // 84: datifyy.user.v1.PartnerPreferences.ethnicity_preferences:type_name -> datifyy.user.v1.Ethnicity
-	39,  // 85: datifyy.user.v1.PartnerPreferences.nri_preference:type_name -> datifyy.user.v1.NRIPreference
-	40,  // 86: datifyy.user.v1.PartnerPreferences.relocation_expectation:type_name -> datifyy.user.v1.RelocationExpectation
-	25,  // 87: datifyy.user.v1.PartnerPreferences.body_type_preferences:type_name -> datifyy.user.v1.BodyType
-	26,  // 88: datifyy.user.v1.PartnerPreferences.complexion_preferences:type_name -> datifyy.user.v1.Complexion
-	27,  // 89: datifyy.user.v1.PartnerPreferences.hair_color_preferences:type_name -> datifyy.user.v1.HairColor
-	28,  // 90: datifyy.user.v1.PartnerPreferences.eye_color_preferences:type_name -> datifyy.user.v1.EyeColor
-	29,  // 91: datifyy.user.v1.PartnerPreferences.facial_hair_preferences:type_name -> datifyy.user.v1.FacialHair
-	41,  // 92: datifyy.user.v1.PartnerPreferences.tattoo_preference:type_name -> datifyy.user.v1.TattooPreference
-	42,  // 93: datifyy.user.v1.PartnerPreferences.piercing_preference:type_name -> datifyy.user.v1.PiercingPreference
-	43,  // 94: datifyy.user.v1.PartnerPreferences.disability_acceptance:type_name -> datifyy.user.v1.DisabilityAcceptance
-	32,  // 95: datifyy.user.v1.PartnerPreferences.income_preferences:type_name -> datifyy.user.v1.IncomeRange
-	33,  // 96: datifyy.user.v1.PartnerPreferences.employment_preferences:type_name -> datifyy.user.v1.EmploymentType
-	44,  // 97: datifyy.user.v1.PartnerPreferences.property_preference:type_name -> datifyy.user.v1.PropertyOwnership
-	45,  // 98: datifyy.user.v1.PartnerPreferences.vehicle_preference:type_name -> datifyy.user.v1.VehicleOwnership
-	46,  // 99: datifyy.user.v1.PartnerPreferences.financial_expectation:type_name -> datifyy.user.v1.FinancialExpectation
-	34,  // 100: datifyy.user.v1.PartnerPreferences.family_type_preferences:type_name -> datifyy.user.v1.FamilyType
-	35,  // 101: datifyy.user.v1.PartnerPreferences.family_values_preferences:type_name -> datifyy.user.v1.FamilyValues
-	36,  // 102: datifyy.user.v1.PartnerPreferences.living_situation_preferences:type_name -> datifyy.user.v1.LivingSituation
-	37,  // 103: datifyy.user.v1.PartnerPreferences.family_affluence_preferences:type_name -> datifyy.user.v1.FamilyAffluence
-	5,   // 104: datifyy.user.v1.PartnerPreferences.language_preferences:type_name -> datifyy.user.v1.LanguageCode
-	6,   // 105: datifyy.user.v1.PartnerPreferences.min_language_proficiency:type_name -> datifyy.user.v1.LanguageProficiency
-	4,   // 106: datifyy.user.v1.PartnerPreferences.interest_preferences:type_name -> datifyy.user.v1.InterestCategory
-	67,  // 107: datifyy.user.v1.PartnerPreferences.deal_breakers:type_name -> datifyy.user.v1.DealBreaker
-	68,  // 108: datifyy.user.v1.PartnerPreferences.must_haves:type_name -> datifyy.user.v1.MustHave
-	47,  // 109: datifyy.user.v1.DealBreaker.type:type_name -> datifyy.user.v1.DealBreakerType
-	48,  // 110: datifyy.user.v1.MustHave.type:type_name -> datifyy.user.v1.MustHaveType
-	70,  // 111: datifyy.user.v1.UserPreferences.notifications:type_name -> datifyy.user.v1.NotificationPreferences
-	71,  // 112: datifyy.user.v1.UserPreferences.privacy:type_name -> datifyy.user.v1.PrivacyPreferences
-	72,  // 113: datifyy.user.v1.UserPreferences.discovery:type_name -> datifyy.user.v1.DiscoveryPreferences
-	49,  // 114: datifyy.user.v1.GetUserProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
-	49,  // 115: datifyy.user.v1.GetMyProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
-	50,  // 116: datifyy.user.v1.UpdateProfileRequest.basic_info:type_name -> datifyy.user.v1.BasicInfo
-	51,  // 117: datifyy.user.v1.UpdateProfileRequest.profile_details:type_name -> datifyy.user.v1.ProfileDetails
-	52,  // 118: datifyy.user.v1.UpdateProfileRequest.lifestyle_info:type_name -> datifyy.user.v1.LifestyleInfo
-	61,  // 119: datifyy.user.v1.UpdateProfileRequest.prompts:type_name -> datifyy.user.v1.ProfilePrompt
-	53,  // 120: datifyy.user.v1.UpdateProfileRequest.cultural_info:type_name -> datifyy.user.v1.CulturalInfo
-	54,  // 121: datifyy.user.v1.UpdateProfileRequest.appearance_info:type_name -> datifyy.user.v1.AppearanceInfo
-	55,  // 122: datifyy.user.v1.UpdateProfileRequest.professional_info:type_name -> datifyy.user.v1.ProfessionalInfo
-	56,  // 123: datifyy.user.v1.UpdateProfileRequest.family_info:type_name -> datifyy.user.v1.FamilyInfo
-	49,  // 124: datifyy.user.v1.UpdateProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
-	62,  // 125: datifyy.user.v1.UploadProfilePhotoResponse.photo:type_name -> datifyy.user.v1.ProfilePhoto
-	87,  // 126: datifyy.user.v1.SearchUsersRequest.filters:type_name -> datifyy.user.v1.SearchFilters
-	127, // 127: datifyy.user.v1.SearchUsersRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
-	49,  // 128: datifyy.user.v1.SearchUsersResponse.users:type_name -> datifyy.user.v1.UserProfile
-	128, // 129: datifyy.user.v1.SearchUsersResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
-	0,   // 130: datifyy.user.v1.SearchFilters.gender:type_name -> datifyy.user.v1.Gender
-	65,  // 131: datifyy.user.v1.SearchFilters.age_range:type_name -> datifyy.user.v1.AgeRange
-	124, // 132: datifyy.user.v1.SearchFilters.location:type_name -> datifyy.common.v1.Location
-	4,   // 133: datifyy.user.v1.SearchFilters.interests:type_name -> datifyy.user.v1.InterestCategory
-	7,   // 134: datifyy.user.v1.SearchFilters.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
-	3,   // 135: datifyy.user.v1.SearchFilters.education_levels:type_name -> datifyy.user.v1.EducationLevel
-	66,  // 136: datifyy.user.v1.SearchFilters.height_range:type_name -> datifyy.user.v1.HeightRange
-	8,   // 137: datifyy.user.v1.SearchFilters.drinking:type_name -> datifyy.user.v1.DrinkingHabit
-	9,   // 138: datifyy.user.v1.SearchFilters.smoking:type_name -> datifyy.user.v1.SmokingHabit
-	16,  // 139: datifyy.user.v1.SearchFilters.children:type_name -> datifyy.user.v1.ChildrenPreference
-	23,  // 140: datifyy.user.v1.SearchFilters.manglik_preference:type_name -> datifyy.user.v1.ManglikPreference
-	24,  // 141: datifyy.user.v1.SearchFilters.ethnicity:type_name -> datifyy.user.v1.Ethnicity
-	32,  // 142: datifyy.user.v1.SearchFilters.income:type_name -> datifyy.user.v1.IncomeRange
-	25,  // 143: datifyy.user.v1.SearchFilters.body_type:type_name -> datifyy.user.v1.BodyType
-	49,  // 144: datifyy.user.v1.GetRecommendationsResponse.recommendations:type_name -> datifyy.user.v1.UserProfile
-	64,  // 145: datifyy.user.v1.GetPartnerPreferencesResponse.preferences:type_name -> datifyy.user.v1.PartnerPreferences
-	64,  // 146: datifyy.user.v1.UpdatePartnerPreferencesRequest.preferences:type_name -> datifyy.user.v1.PartnerPreferences
-	64,  // 147: datifyy.user.v1.UpdatePartnerPreferencesResponse.preferences:type_name -> datifyy.user.v1.PartnerPreferences
-	69,  // 148: datifyy.user.v1.GetUserPreferencesResponse.preferences:type_name -> datifyy.user.v1.UserPreferences
-	69,  // 149: datifyy.user.v1.UpdateUserPreferencesRequest.preferences:type_name -> datifyy.user.v1.UserPreferences
-	69,  // 150: datifyy.user.v1.UpdateUserPreferencesResponse.preferences:type_name -> datifyy.user.v1.UserPreferences
-	127, // 151: datifyy.user.v1.ListBlockedUsersRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
-	49,  // 152: datifyy.user.v1.ListBlockedUsersResponse.users:type_name -> datifyy.user.v1.UserProfile
-	128, // 153: datifyy.user.v1.ListBlockedUsersResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
-	21,  // 154: datifyy.user.v1.ReportUserRequest.reason:type_name -> datifyy.user.v1.ReportReason
-	0,   // 155: datifyy.user.v1.UserSummary.gender:type_name -> datifyy.user.v1.Gender
-	106, // 156: datifyy.user.v1.DateSuggestionDetail.suggested_user:type_name -> datifyy.user.v1.UserSummary
-	123, // 157: datifyy.user.v1.DateSuggestionDetail.created_at:type_name -> datifyy.common.v1.Timestamp
-	106, // 158: datifyy.user.v1.ScheduledDateDetail.other_user:type_name -> datifyy.user.v1.UserSummary
-	123, // 159: datifyy.user.v1.ScheduledDateDetail.scheduled_time:type_name -> datifyy.common.v1.Timestamp
-	123, // 160: datifyy.user.v1.ScheduledDateDetail.created_at:type_name -> datifyy.common.v1.Timestamp
-	123, // 161: datifyy.user.v1.ScheduledDateDetail.confirmed_at:type_name -> datifyy.common.v1.Timestamp
-	123, // 162: datifyy.user.v1.ScheduledDateDetail.completed_at:type_name -> datifyy.common.v1.Timestamp
-	106, // 163: datifyy.user.v1.RejectedDateDetail.rejected_user:type_name -> datifyy.user.v1.UserSummary
-	123, // 164: datifyy.user.v1.RejectedDateDetail.rejected_at:type_name -> datifyy.common.v1.Timestamp
-	107, // 165: datifyy.user.v1.GetLoveZoneDashboardResponse.pending_suggestions:type_name -> datifyy.user.v1.DateSuggestionDetail
-	108, // 166: datifyy.user.v1.GetLoveZoneDashboardResponse.upcoming_dates:type_name -> datifyy.user.v1.ScheduledDateDetail
-	108, // 167: datifyy.user.v1.GetLoveZoneDashboardResponse.past_dates:type_name -> datifyy.user.v1.ScheduledDateDetail
-	109, // 168: datifyy.user.v1.GetLoveZoneDashboardResponse.rejected_dates:type_name -> datifyy.user.v1.RejectedDateDetail
-	110, // 169: datifyy.user.v1.GetLoveZoneDashboardResponse.statistics:type_name -> datifyy.user.v1.LoveZoneStatistics
-	107, // 170: datifyy.user.v1.GetDateSuggestionsResponse.suggestions:type_name -> datifyy.user.v1.DateSuggestionDetail
-	108, // 171: datifyy.user.v1.GetUpcomingDatesResponse.dates:type_name -> datifyy.user.v1.ScheduledDateDetail
-	108, // 172: datifyy.user.v1.GetPastDatesResponse.dates:type_name -> datifyy.user.v1.ScheduledDateDetail
-	109, // 173: datifyy.user.v1.GetRejectedDatesResponse.dates:type_name -> datifyy.user.v1.RejectedDateDetail
-	110, // 174: datifyy.user.v1.GetLoveZoneStatisticsResponse.statistics:type_name -> datifyy.user.v1.LoveZoneStatistics
-	73,  // 175: datifyy.user.v1.UserService.GetUserProfile:input_type -> datifyy.user.v1.GetUserProfileRequest
-	75,  // 176: datifyy.user.v1.UserService.GetMyProfile:input_type -> datifyy.user.v1.GetMyProfileRequest
-	77,  // 177: datifyy.user.v1.UserService.UpdateProfile:input_type -> datifyy.user.v1.UpdateProfileRequest
-	79,  // 178: datifyy.user.v1.UserService.DeleteAccount:input_type -> datifyy.user.v1.DeleteAccountRequest
-	81,  // 179: datifyy.user.v1.UserService.UploadProfilePhoto:input_type -> datifyy.user.v1.UploadProfilePhotoRequest
-	83,  // 180: datifyy.user.v1.UserService.DeleteProfilePhoto:input_type -> datifyy.user.v1.DeleteProfilePhotoRequest
-	85,  // 181: datifyy.user.v1.UserService.SearchUsers:input_type -> datifyy.user.v1.SearchUsersRequest
-	88,  // 182: datifyy.user.v1.UserService.GetRecommendations:input_type -> datifyy.user.v1.GetRecommendationsRequest
-	90,  // 183: datifyy.user.v1.UserService.GetPartnerPreferences:input_type -> datifyy.user.v1.GetPartnerPreferencesRequest
-	92,  // 184: datifyy.user.v1.UserService.UpdatePartnerPreferences:input_type -> datifyy.user.v1.UpdatePartnerPreferencesRequest
-	94,  // 185: datifyy.user.v1.UserService.GetUserPreferences:input_type -> datifyy.user.v1.GetUserPreferencesRequest
-	96,  // 186: datifyy.user.v1.UserService.UpdateUserPreferences:input_type -> datifyy.user.v1.UpdateUserPreferencesRequest
-	98,  // 187: datifyy.user.v1.UserService.BlockUser:input_type -> datifyy.user.v1.BlockUserRequest
-	100, // 188: datifyy.user.v1.UserService.UnblockUser:input_type -> datifyy.user.v1.UnblockUserRequest
-	102, // 189: datifyy.user.v1.UserService.ListBlockedUsers:input_type -> datifyy.user.v1.ListBlockedUsersRequest
-	104, // 190: datifyy.user.v1.UserService.ReportUser:input_type -> datifyy.user.v1.ReportUserRequest
-	111, // 191: datifyy.user.v1.UserService.GetLoveZoneDashboard:input_type -> datifyy.user.v1.GetLoveZoneDashboardRequest
-	113, // 192: datifyy.user.v1.UserService.GetDateSuggestions:input_type -> datifyy.user.v1.GetDateSuggestionsRequest
-	115, // 193: datifyy.user.v1.UserService.GetUpcomingDates:input_type -> datifyy.user.v1.GetUpcomingDatesRequest
-	117, // 194: datifyy.user.v1.UserService.GetPastDates:input_type -> datifyy.user.v1.GetPastDatesRequest
-	119, // 195: datifyy.user.v1.UserService.GetRejectedDates:input_type -> datifyy.user.v1.GetRejectedDatesRequest
-	121, // 196: datifyy.user.v1.UserService.GetLoveZoneStatistics:input_type -> datifyy.user.v1.GetLoveZoneStatisticsRequest
-	74,  // 197: datifyy.user.v1.UserService.GetUserProfile:output_type -> datifyy.user.v1.GetUserProfileResponse
-	76,  // 198: datifyy.user.v1.UserService.GetMyProfile:output_type -> datifyy.user.v1.GetMyProfileResponse
-	78,  // 199: datifyy.user.v1.UserService.UpdateProfile:output_type -> datifyy.user.v1.UpdateProfileResponse
-	80,  // 200: datifyy.user.v1.UserService.DeleteAccount:output_type -> datifyy.user.v1.DeleteAccountResponse
-	82,  // 201: datifyy.user.v1.UserService.UploadProfilePhoto:output_type -> datifyy.user.v1.UploadProfilePhotoResponse
-	84,  // 202: datifyy.user.v1.UserService.DeleteProfilePhoto:output_type -> datifyy.user.v1.DeleteProfilePhotoResponse
-	86,  // 203: datifyy.user.v1.UserService.SearchUsers:output_type -> datifyy.user.v1.SearchUsersResponse
-	89,  // 204: datifyy.user.v1.UserService.GetRecommendations:output_type -> datifyy.user.v1.GetRecommendationsResponse
-	91,  // 205: datifyy.user.v1.UserService.GetPartnerPreferences:output_type -> datifyy.user.v1.GetPartnerPreferencesResponse
-	93,  // 206: datifyy.user.v1.UserService.UpdatePartnerPreferences:output_type -> datifyy.user.v1.UpdatePartnerPreferencesResponse
-	95,  // 207: datifyy.user.v1.UserService.GetUserPreferences:output_type -> datifyy.user.v1.GetUserPreferencesResponse
-	97,  // 208: datifyy.user.v1.UserService.UpdateUserPreferences:output_type -> datifyy.user.v1.UpdateUserPreferencesResponse
-	99,  // 209: datifyy.user.v1.UserService.BlockUser:output_type -> datifyy.user.v1.BlockUserResponse
-	101, // 210: datifyy.user.v1.UserService.UnblockUser:output_type -> datifyy.user.v1.UnblockUserResponse
-	103, // 211: datifyy.user.v1.UserService.ListBlockedUsers:output_type -> datifyy.user.v1.ListBlockedUsersResponse
-	105, // 212: datifyy.user.v1.UserService.ReportUser:output_type -> datifyy.user.v1.ReportUserResponse
-	112, // 213: datifyy.user.v1.UserService.GetLoveZoneDashboard:output_type -> datifyy.user.v1.GetLoveZoneDashboardResponse
-	114, // 214: datifyy.user.v1.UserService.GetDateSuggestions:output_type -> datifyy.user.v1.GetDateSuggestionsResponse
-	116, // 215: datifyy.user.v1.UserService.GetUpcomingDates:output_type -> datifyy.user.v1.GetUpcomingDatesResponse
-	118, // 216: datifyy.user.v1.UserService.GetPastDates:output_type -> datifyy.user.v1.GetPastDatesResponse
-	120, // 217: datifyy.user.v1.UserService.GetRejectedDates:output_type -> datifyy.user.v1.GetRejectedDatesResponse
-	122, // 218: datifyy.user.v1.UserService.GetLoveZoneStatistics:output_type -> datifyy.user.v1.GetLoveZoneStatisticsResponse
-	197, // [197:219] is the sub-list for method output_type
-	175, // [175:197] is the sub-list for method input_type
-	175, // [175:175] is the sub-list for extension type_name
-	175, // [175:175] is the sub-list for extension extendee
-	0,   // [0:175] is the sub-list for field type_name
+	52,  // 0: datifyy.user.v1.UserProfile.basic_info:type_name -> datifyy.user.v1.BasicInfo
+	53,  // 1: datifyy.user.v1.UserProfile.profile_details:type_name -> datifyy.user.v1.ProfileDetails
+	54,  // 2: datifyy.user.v1.UserProfile.lifestyle_info:type_name -> datifyy.user.v1.LifestyleInfo
+	64,  // 3: datifyy.user.v1.UserProfile.prompts:type_name -> datifyy.user.v1.ProfilePrompt
+	65,  // 4: datifyy.user.v1.UserProfile.photos:type_name -> datifyy.user.v1.ProfilePhoto
+	66,  // 5: datifyy.user.v1.UserProfile.metadata:type_name -> datifyy.user.v1.AccountMetadata
+	67,  // 6: datifyy.user.v1.UserProfile.partner_preferences:type_name -> datifyy.user.v1.PartnerPreferences
+	73,  // 7: datifyy.user.v1.UserProfile.user_preferences:type_name -> datifyy.user.v1.UserPreferences
+	161, // 8: datifyy.user.v1.UserProfile.last_seen_at:type_name -> datifyy.common.v1.Timestamp
+	55,  // 9: datifyy.user.v1.UserProfile.cultural_info:type_name -> datifyy.user.v1.CulturalInfo
+	57,  // 10: datifyy.user.v1.UserProfile.appearance_info:type_name -> datifyy.user.v1.AppearanceInfo
+	58,  // 11: datifyy.user.v1.UserProfile.professional_info:type_name -> datifyy.user.v1.ProfessionalInfo
+	59,  // 12: datifyy.user.v1.UserProfile.family_info:type_name -> datifyy.user.v1.FamilyInfo
+	56,  // 13: datifyy.user.v1.UserProfile.horoscope_match:type_name -> datifyy.user.v1.HoroscopeMatch
+	161, // 14: datifyy.user.v1.BasicInfo.date_of_birth:type_name -> datifyy.common.v1.Timestamp
+	0,   // 15: datifyy.user.v1.BasicInfo.gender:type_name -> datifyy.user.v1.Gender
+	1,   // 16: datifyy.user.v1.BasicInfo.zodiac_sign:type_name -> datifyy.user.v1.ZodiacSign
+	60,  // 17: datifyy.user.v1.ProfileDetails.occupations:type_name -> datifyy.user.v1.OccupationInfo
+	61,  // 18: datifyy.user.v1.ProfileDetails.education:type_name -> datifyy.user.v1.EducationInfo
+	162, // 19: datifyy.user.v1.ProfileDetails.location:type_name -> datifyy.common.v1.Location
+	62,  // 20: datifyy.user.v1.ProfileDetails.interests:type_name -> datifyy.user.v1.InterestInfo
+	63,  // 21: datifyy.user.v1.ProfileDetails.languages:type_name -> datifyy.user.v1.LanguageInfo
+	7,   // 22: datifyy.user.v1.ProfileDetails.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
+	8,   // 23: datifyy.user.v1.LifestyleInfo.drinking:type_name -> datifyy.user.v1.DrinkingHabit
+	9,   // 24: datifyy.user.v1.LifestyleInfo.smoking:type_name -> datifyy.user.v1.SmokingHabit
+	10,  // 25: datifyy.user.v1.LifestyleInfo.workout:type_name -> datifyy.user.v1.WorkoutFrequency
+	11,  // 26: datifyy.user.v1.LifestyleInfo.dietary_preference:type_name -> datifyy.user.v1.DietaryPreference
+	12,  // 27: datifyy.user.v1.LifestyleInfo.religion:type_name -> datifyy.user.v1.Religion
+	13,  // 28: datifyy.user.v1.LifestyleInfo.religion_importance:type_name -> datifyy.user.v1.Importance
+	14,  // 29: datifyy.user.v1.LifestyleInfo.political_view:type_name -> datifyy.user.v1.PoliticalView
+	15,  // 30: datifyy.user.v1.LifestyleInfo.pets:type_name -> datifyy.user.v1.PetPreference
+	16,  // 31: datifyy.user.v1.LifestyleInfo.children:type_name -> datifyy.user.v1.ChildrenPreference
+	17,  // 32: datifyy.user.v1.LifestyleInfo.communication_style:type_name -> datifyy.user.v1.CommunicationStyle
+	18,  // 33: datifyy.user.v1.LifestyleInfo.love_language:type_name -> datifyy.user.v1.LoveLanguage
+	19,  // 34: datifyy.user.v1.LifestyleInfo.sleep_schedule:type_name -> datifyy.user.v1.SleepSchedule
+	24,  // 35: datifyy.user.v1.CulturalInfo.manglik_status:type_name -> datifyy.user.v1.ManglikStatus
+	26,  // 36: datifyy.user.v1.CulturalInfo.ethnicity:type_name -> datifyy.user.v1.Ethnicity
+	27,  // 37: datifyy.user.v1.AppearanceInfo.body_type:type_name -> datifyy.user.v1.BodyType
+	28,  // 38: datifyy.user.v1.AppearanceInfo.complexion:type_name -> datifyy.user.v1.Complexion
+	29,  // 39: datifyy.user.v1.AppearanceInfo.hair_color:type_name -> datifyy.user.v1.HairColor
+	30,  // 40: datifyy.user.v1.AppearanceInfo.eye_color:type_name -> datifyy.user.v1.EyeColor
+	31,  // 41: datifyy.user.v1.AppearanceInfo.facial_hair:type_name -> datifyy.user.v1.FacialHair
+	32,  // 42: datifyy.user.v1.AppearanceInfo.disability_status:type_name -> datifyy.user.v1.DisabilityStatus
+	33,  // 43: datifyy.user.v1.AppearanceInfo.blood_group:type_name -> datifyy.user.v1.BloodGroup
+	34,  // 44: datifyy.user.v1.ProfessionalInfo.income_range:type_name -> datifyy.user.v1.IncomeRange
+	35,  // 45: datifyy.user.v1.ProfessionalInfo.employment_type:type_name -> datifyy.user.v1.EmploymentType
+	3,   // 46: datifyy.user.v1.ProfessionalInfo.highest_education:type_name -> datifyy.user.v1.EducationLevel
+	36,  // 47: datifyy.user.v1.FamilyInfo.family_type:type_name -> datifyy.user.v1.FamilyType
+	37,  // 48: datifyy.user.v1.FamilyInfo.family_values:type_name -> datifyy.user.v1.FamilyValues
+	38,  // 49: datifyy.user.v1.FamilyInfo.living_situation:type_name -> datifyy.user.v1.LivingSituation
+	39,  // 50: datifyy.user.v1.FamilyInfo.family_affluence:type_name -> datifyy.user.v1.FamilyAffluence
+	40,  // 51: datifyy.user.v1.FamilyInfo.father_status:type_name -> datifyy.user.v1.ParentStatus
+	40,  // 52: datifyy.user.v1.FamilyInfo.mother_status:type_name -> datifyy.user.v1.ParentStatus
+	2,   // 53: datifyy.user.v1.OccupationInfo.category:type_name -> datifyy.user.v1.OccupationCategory
+	3,   // 54: datifyy.user.v1.EducationInfo.level:type_name -> datifyy.user.v1.EducationLevel
+	4,   // 55: datifyy.user.v1.InterestInfo.category:type_name -> datifyy.user.v1.InterestCategory
+	5,   // 56: datifyy.user.v1.LanguageInfo.code:type_name -> datifyy.user.v1.LanguageCode
+	6,   // 57: datifyy.user.v1.LanguageInfo.proficiency:type_name -> datifyy.user.v1.LanguageProficiency
+	20,  // 58: datifyy.user.v1.ProfilePrompt.question:type_name -> datifyy.user.v1.PromptQuestion
+	161, // 59: datifyy.user.v1.ProfilePhoto.uploaded_at:type_name -> datifyy.common.v1.Timestamp
+	163, // 60: datifyy.user.v1.AccountMetadata.status:type_name -> datifyy.common.v1.AccountStatus
+	164, // 61: datifyy.user.v1.AccountMetadata.email_verified:type_name -> datifyy.common.v1.VerificationStatus
+	164, // 62: datifyy.user.v1.AccountMetadata.phone_verified:type_name -> datifyy.common.v1.VerificationStatus
+	161, // 63: datifyy.user.v1.AccountMetadata.created_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 64: datifyy.user.v1.AccountMetadata.updated_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 65: datifyy.user.v1.AccountMetadata.last_login_at:type_name -> datifyy.common.v1.Timestamp
+	0,   // 66: datifyy.user.v1.PartnerPreferences.looking_for_gender:type_name -> datifyy.user.v1.Gender
+	68,  // 67: datifyy.user.v1.PartnerPreferences.age_range:type_name -> datifyy.user.v1.AgeRange
+	69,  // 68: datifyy.user.v1.PartnerPreferences.height_range:type_name -> datifyy.user.v1.HeightRange
+	7,   // 69: datifyy.user.v1.PartnerPreferences.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
+	3,   // 70: datifyy.user.v1.PartnerPreferences.education_levels:type_name -> datifyy.user.v1.EducationLevel
+	2,   // 71: datifyy.user.v1.PartnerPreferences.occupations:type_name -> datifyy.user.v1.OccupationCategory
+	12,  // 72: datifyy.user.v1.PartnerPreferences.religions:type_name -> datifyy.user.v1.Religion
+	13,  // 73: datifyy.user.v1.PartnerPreferences.religion_importance:type_name -> datifyy.user.v1.Importance
+	16,  // 74: datifyy.user.v1.PartnerPreferences.children_preferences:type_name -> datifyy.user.v1.ChildrenPreference
+	8,   // 75: datifyy.user.v1.PartnerPreferences.drinking_preferences:type_name -> datifyy.user.v1.DrinkingHabit
+	9,   // 76: datifyy.user.v1.PartnerPreferences.smoking_preferences:type_name -> datifyy.user.v1.SmokingHabit
+	11,  // 77: datifyy.user.v1.PartnerPreferences.dietary_preferences:type_name -> datifyy.user.v1.DietaryPreference
+	15,  // 78: datifyy.user.v1.PartnerPreferences.pet_preferences:type_name -> datifyy.user.v1.PetPreference
+	10,  // 79: datifyy.user.v1.PartnerPreferences.workout_preferences:type_name -> datifyy.user.v1.WorkoutFrequency
+	17,  // 80: datifyy.user.v1.PartnerPreferences.communication_styles:type_name -> datifyy.user.v1.CommunicationStyle
+	18,  // 81: datifyy.user.v1.PartnerPreferences.love_languages:type_name -> datifyy.user.v1.LoveLanguage
+	14,  // 82: datifyy.user.v1.PartnerPreferences.political_views:type_name -> datifyy.user.v1.PoliticalView
+	19,  // 83: datifyy.user.v1.PartnerPreferences.sleep_schedules:type_name -> datifyy.user.v1.SleepSchedule
+	25,  // 84: datifyy.user.v1.PartnerPreferences.manglik_preference:type_name -> datifyy.user.v1.ManglikPreference
+	26,  // 85: datifyy.user.v1.PartnerPreferences.ethnicity_preferences:type_name -> datifyy.user.v1.Ethnicity
+	41,  // 86: datifyy.user.v1.PartnerPreferences.nri_preference:type_name -> datifyy.user.v1.NRIPreference
+	42,  // 87: datifyy.user.v1.PartnerPreferences.relocation_expectation:type_name -> datifyy.user.v1.RelocationExpectation
+	27,  // 88: datifyy.user.v1.PartnerPreferences.body_type_preferences:type_name -> datifyy.user.v1.BodyType
+	28,  // 89: datifyy.user.v1.PartnerPreferences.complexion_preferences:type_name -> datifyy.user.v1.Complexion
+	29,  // 90: datifyy.user.v1.PartnerPreferences.hair_color_preferences:type_name -> datifyy.user.v1.HairColor
+	30,  // 91: datifyy.user.v1.PartnerPreferences.eye_color_preferences:type_name -> datifyy.user.v1.EyeColor
+	31,  // 92: datifyy.user.v1.PartnerPreferences.facial_hair_preferences:type_name -> datifyy.user.v1.FacialHair
+	43,  // 93: datifyy.user.v1.PartnerPreferences.tattoo_preference:type_name -> datifyy.user.v1.TattooPreference
+	44,  // 94: datifyy.user.v1.PartnerPreferences.piercing_preference:type_name -> datifyy.user.v1.PiercingPreference
+	45,  // 95: datifyy.user.v1.PartnerPreferences.disability_acceptance:type_name -> datifyy.user.v1.DisabilityAcceptance
+	34,  // 96: datifyy.user.v1.PartnerPreferences.income_preferences:type_name -> datifyy.user.v1.IncomeRange
+	35,  // 97: datifyy.user.v1.PartnerPreferences.employment_preferences:type_name -> datifyy.user.v1.EmploymentType
+	46,  // 98: datifyy.user.v1.PartnerPreferences.property_preference:type_name -> datifyy.user.v1.PropertyOwnership
+	47,  // 99: datifyy.user.v1.PartnerPreferences.vehicle_preference:type_name -> datifyy.user.v1.VehicleOwnership
+	48,  // 100: datifyy.user.v1.PartnerPreferences.financial_expectation:type_name -> datifyy.user.v1.FinancialExpectation
+	36,  // 101: datifyy.user.v1.PartnerPreferences.family_type_preferences:type_name -> datifyy.user.v1.FamilyType
+	37,  // 102: datifyy.user.v1.PartnerPreferences.family_values_preferences:type_name -> datifyy.user.v1.FamilyValues
+	38,  // 103: datifyy.user.v1.PartnerPreferences.living_situation_preferences:type_name -> datifyy.user.v1.LivingSituation
+	39,  // 104: datifyy.user.v1.PartnerPreferences.family_affluence_preferences:type_name -> datifyy.user.v1.FamilyAffluence
+	5,   // 105: datifyy.user.v1.PartnerPreferences.language_preferences:type_name -> datifyy.user.v1.LanguageCode
+	6,   // 106: datifyy.user.v1.PartnerPreferences.min_language_proficiency:type_name -> datifyy.user.v1.LanguageProficiency
+	4,   // 107: datifyy.user.v1.PartnerPreferences.interest_preferences:type_name -> datifyy.user.v1.InterestCategory
+	70,  // 108: datifyy.user.v1.PartnerPreferences.deal_breakers:type_name -> datifyy.user.v1.DealBreaker
+	71,  // 109: datifyy.user.v1.PartnerPreferences.must_haves:type_name -> datifyy.user.v1.MustHave
+	49,  // 110: datifyy.user.v1.DealBreaker.type:type_name -> datifyy.user.v1.DealBreakerType
+	50,  // 111: datifyy.user.v1.MustHave.type:type_name -> datifyy.user.v1.MustHaveType
+	74,  // 112: datifyy.user.v1.UserPreferences.notifications:type_name -> datifyy.user.v1.NotificationPreferences
+	75,  // 113: datifyy.user.v1.UserPreferences.privacy:type_name -> datifyy.user.v1.PrivacyPreferences
+	76,  // 114: datifyy.user.v1.UserPreferences.discovery:type_name -> datifyy.user.v1.DiscoveryPreferences
+	51,  // 115: datifyy.user.v1.GetUserProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
+	51,  // 116: datifyy.user.v1.GetMyProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
+	52,  // 117: datifyy.user.v1.UpdateProfileRequest.basic_info:type_name -> datifyy.user.v1.BasicInfo
+	53,  // 118: datifyy.user.v1.UpdateProfileRequest.profile_details:type_name -> datifyy.user.v1.ProfileDetails
+	54,  // 119: datifyy.user.v1.UpdateProfileRequest.lifestyle_info:type_name -> datifyy.user.v1.LifestyleInfo
+	64,  // 120: datifyy.user.v1.UpdateProfileRequest.prompts:type_name -> datifyy.user.v1.ProfilePrompt
+	55,  // 121: datifyy.user.v1.UpdateProfileRequest.cultural_info:type_name -> datifyy.user.v1.CulturalInfo
+	57,  // 122: datifyy.user.v1.UpdateProfileRequest.appearance_info:type_name -> datifyy.user.v1.AppearanceInfo
+	58,  // 123: datifyy.user.v1.UpdateProfileRequest.professional_info:type_name -> datifyy.user.v1.ProfessionalInfo
+	59,  // 124: datifyy.user.v1.UpdateProfileRequest.family_info:type_name -> datifyy.user.v1.FamilyInfo
+	51,  // 125: datifyy.user.v1.UpdateProfileResponse.profile:type_name -> datifyy.user.v1.UserProfile
+	85,  // 126: datifyy.user.v1.ListPromptsResponse.prompts:type_name -> datifyy.user.v1.PromptDefinition
+	20,  // 127: datifyy.user.v1.PromptDefinition.question:type_name -> datifyy.user.v1.PromptQuestion
+	161, // 128: datifyy.user.v1.DeleteAccountResponse.deletion_scheduled_for:type_name -> datifyy.common.v1.Timestamp
+	65,  // 129: datifyy.user.v1.UploadProfilePhotoResponse.photo:type_name -> datifyy.user.v1.ProfilePhoto
+	94,  // 130: datifyy.user.v1.SearchUsersRequest.filters:type_name -> datifyy.user.v1.SearchFilters
+	165, // 131: datifyy.user.v1.SearchUsersRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
+	51,  // 132: datifyy.user.v1.SearchUsersResponse.users:type_name -> datifyy.user.v1.UserProfile
+	166, // 133: datifyy.user.v1.SearchUsersResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
+	0,   // 134: datifyy.user.v1.SearchFilters.gender:type_name -> datifyy.user.v1.Gender
+	68,  // 135: datifyy.user.v1.SearchFilters.age_range:type_name -> datifyy.user.v1.AgeRange
+	162, // 136: datifyy.user.v1.SearchFilters.location:type_name -> datifyy.common.v1.Location
+	4,   // 137: datifyy.user.v1.SearchFilters.interests:type_name -> datifyy.user.v1.InterestCategory
+	7,   // 138: datifyy.user.v1.SearchFilters.relationship_goals:type_name -> datifyy.user.v1.RelationshipGoal
+	3,   // 139: datifyy.user.v1.SearchFilters.education_levels:type_name -> datifyy.user.v1.EducationLevel
+	69,  // 140: datifyy.user.v1.SearchFilters.height_range:type_name -> datifyy.user.v1.HeightRange
+	8,   // 141: datifyy.user.v1.SearchFilters.drinking:type_name -> datifyy.user.v1.DrinkingHabit
+	9,   // 142: datifyy.user.v1.SearchFilters.smoking:type_name -> datifyy.user.v1.SmokingHabit
+	16,  // 143: datifyy.user.v1.SearchFilters.children:type_name -> datifyy.user.v1.ChildrenPreference
+	25,  // 144: datifyy.user.v1.SearchFilters.manglik_preference:type_name -> datifyy.user.v1.ManglikPreference
+	26,  // 145: datifyy.user.v1.SearchFilters.ethnicity:type_name -> datifyy.user.v1.Ethnicity
+	34,  // 146: datifyy.user.v1.SearchFilters.income:type_name -> datifyy.user.v1.IncomeRange
+	27,  // 147: datifyy.user.v1.SearchFilters.body_type:type_name -> datifyy.user.v1.BodyType
+	51,  // 148: datifyy.user.v1.GetRecommendationsResponse.recommendations:type_name -> datifyy.user.v1.UserProfile
+	165, // 149: datifyy.user.v1.ListProfileViewersRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
+	99,  // 150: datifyy.user.v1.ListProfileViewersResponse.viewers:type_name -> datifyy.user.v1.ProfileViewer
+	166, // 151: datifyy.user.v1.ListProfileViewersResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
+	51,  // 152: datifyy.user.v1.ProfileViewer.profile:type_name -> datifyy.user.v1.UserProfile
+	161, // 153: datifyy.user.v1.ProfileViewer.viewed_at:type_name -> datifyy.common.v1.Timestamp
+	165, // 154: datifyy.user.v1.ListLikesReceivedRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
+	108, // 155: datifyy.user.v1.ListLikesReceivedResponse.likes:type_name -> datifyy.user.v1.ReceivedLike
+	166, // 156: datifyy.user.v1.ListLikesReceivedResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
+	51,  // 157: datifyy.user.v1.ReceivedLike.profile:type_name -> datifyy.user.v1.UserProfile
+	161, // 158: datifyy.user.v1.ReceivedLike.liked_at:type_name -> datifyy.common.v1.Timestamp
+	67,  // 159: datifyy.user.v1.GetPartnerPreferencesResponse.preferences:type_name -> datifyy.user.v1.PartnerPreferences
+	67,  // 160: datifyy.user.v1.UpdatePartnerPreferencesRequest.preferences:type_name -> datifyy.user.v1.PartnerPreferences
+	67,  // 161: datifyy.user.v1.UpdatePartnerPreferencesResponse.preferences:type_name -> datifyy.user.v1.PartnerPreferences
+	73,  // 162: datifyy.user.v1.GetUserPreferencesResponse.preferences:type_name -> datifyy.user.v1.UserPreferences
+	73,  // 163: datifyy.user.v1.UpdateUserPreferencesRequest.preferences:type_name -> datifyy.user.v1.UserPreferences
+	73,  // 164: datifyy.user.v1.UpdateUserPreferencesResponse.preferences:type_name -> datifyy.user.v1.UserPreferences
+	165, // 165: datifyy.user.v1.ListBlockedUsersRequest.pagination:type_name -> datifyy.common.v1.PaginationRequest
+	51,  // 166: datifyy.user.v1.ListBlockedUsersResponse.users:type_name -> datifyy.user.v1.UserProfile
+	166, // 167: datifyy.user.v1.ListBlockedUsersResponse.pagination:type_name -> datifyy.common.v1.PaginationResponse
+	21,  // 168: datifyy.user.v1.ReportUserRequest.reason:type_name -> datifyy.user.v1.ReportReason
+	0,   // 169: datifyy.user.v1.UserSummary.gender:type_name -> datifyy.user.v1.Gender
+	127, // 170: datifyy.user.v1.DateSuggestionDetail.suggested_user:type_name -> datifyy.user.v1.UserSummary
+	161, // 171: datifyy.user.v1.DateSuggestionDetail.created_at:type_name -> datifyy.common.v1.Timestamp
+	127, // 172: datifyy.user.v1.ScheduledDateDetail.other_user:type_name -> datifyy.user.v1.UserSummary
+	161, // 173: datifyy.user.v1.ScheduledDateDetail.scheduled_time:type_name -> datifyy.common.v1.Timestamp
+	161, // 174: datifyy.user.v1.ScheduledDateDetail.created_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 175: datifyy.user.v1.ScheduledDateDetail.confirmed_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 176: datifyy.user.v1.ScheduledDateDetail.completed_at:type_name -> datifyy.common.v1.Timestamp
+	127, // 177: datifyy.user.v1.RejectedDateDetail.rejected_user:type_name -> datifyy.user.v1.UserSummary
+	161, // 178: datifyy.user.v1.RejectedDateDetail.rejected_at:type_name -> datifyy.common.v1.Timestamp
+	128, // 179: datifyy.user.v1.GetLoveZoneDashboardResponse.pending_suggestions:type_name -> datifyy.user.v1.DateSuggestionDetail
+	129, // 180: datifyy.user.v1.GetLoveZoneDashboardResponse.upcoming_dates:type_name -> datifyy.user.v1.ScheduledDateDetail
+	129, // 181: datifyy.user.v1.GetLoveZoneDashboardResponse.past_dates:type_name -> datifyy.user.v1.ScheduledDateDetail
+	130, // 182: datifyy.user.v1.GetLoveZoneDashboardResponse.rejected_dates:type_name -> datifyy.user.v1.RejectedDateDetail
+	131, // 183: datifyy.user.v1.GetLoveZoneDashboardResponse.statistics:type_name -> datifyy.user.v1.LoveZoneStatistics
+	128, // 184: datifyy.user.v1.GetDateSuggestionsResponse.suggestions:type_name -> datifyy.user.v1.DateSuggestionDetail
+	129, // 185: datifyy.user.v1.GetUpcomingDatesResponse.dates:type_name -> datifyy.user.v1.ScheduledDateDetail
+	129, // 186: datifyy.user.v1.GetPastDatesResponse.dates:type_name -> datifyy.user.v1.ScheduledDateDetail
+	130, // 187: datifyy.user.v1.GetRejectedDatesResponse.dates:type_name -> datifyy.user.v1.RejectedDateDetail
+	131, // 188: datifyy.user.v1.GetLoveZoneStatisticsResponse.statistics:type_name -> datifyy.user.v1.LoveZoneStatistics
+	161, // 189: datifyy.user.v1.CancelDateResponse.cancelled_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 190: datifyy.user.v1.RescheduleRequest.proposed_times:type_name -> datifyy.common.v1.Timestamp
+	161, // 191: datifyy.user.v1.RescheduleRequest.accepted_time:type_name -> datifyy.common.v1.Timestamp
+	161, // 192: datifyy.user.v1.RescheduleRequest.created_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 193: datifyy.user.v1.RescheduleRequest.responded_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 194: datifyy.user.v1.RequestRescheduleRequest.proposed_times:type_name -> datifyy.common.v1.Timestamp
+	146, // 195: datifyy.user.v1.RequestRescheduleResponse.request:type_name -> datifyy.user.v1.RescheduleRequest
+	161, // 196: datifyy.user.v1.RespondToRescheduleRequest.selected_time:type_name -> datifyy.common.v1.Timestamp
+	146, // 197: datifyy.user.v1.RespondToRescheduleResponse.request:type_name -> datifyy.user.v1.RescheduleRequest
+	161, // 198: datifyy.user.v1.RespondToRescheduleResponse.scheduled_time:type_name -> datifyy.common.v1.Timestamp
+	161, // 199: datifyy.user.v1.SendWorkEmailVerificationResponse.expires_at:type_name -> datifyy.common.v1.Timestamp
+	22,  // 200: datifyy.user.v1.SubmitIdVerificationRequest.document_type:type_name -> datifyy.user.v1.IdDocumentType
+	23,  // 201: datifyy.user.v1.SubmitIdVerificationResponse.status:type_name -> datifyy.user.v1.IdVerificationStatus
+	23,  // 202: datifyy.user.v1.GetVerificationStatusResponse.id_verification_status:type_name -> datifyy.user.v1.IdVerificationStatus
+	161, // 203: datifyy.user.v1.RequestDataExportResponse.requested_at:type_name -> datifyy.common.v1.Timestamp
+	161, // 204: datifyy.user.v1.RequestDataExportResponse.next_allowed_at:type_name -> datifyy.common.v1.Timestamp
+	77,  // 205: datifyy.user.v1.UserService.GetUserProfile:input_type -> datifyy.user.v1.GetUserProfileRequest
+	79,  // 206: datifyy.user.v1.UserService.GetMyProfile:input_type -> datifyy.user.v1.GetMyProfileRequest
+	81,  // 207: datifyy.user.v1.UserService.UpdateProfile:input_type -> datifyy.user.v1.UpdateProfileRequest
+	86,  // 208: datifyy.user.v1.UserService.DeleteAccount:input_type -> datifyy.user.v1.DeleteAccountRequest
+	88,  // 209: datifyy.user.v1.UserService.UploadProfilePhoto:input_type -> datifyy.user.v1.UploadProfilePhotoRequest
+	90,  // 210: datifyy.user.v1.UserService.DeleteProfilePhoto:input_type -> datifyy.user.v1.DeleteProfilePhotoRequest
+	83,  // 211: datifyy.user.v1.UserService.ListPrompts:input_type -> datifyy.user.v1.ListPromptsRequest
+	92,  // 212: datifyy.user.v1.UserService.SearchUsers:input_type -> datifyy.user.v1.SearchUsersRequest
+	95,  // 213: datifyy.user.v1.UserService.GetRecommendations:input_type -> datifyy.user.v1.GetRecommendationsRequest
+	97,  // 214: datifyy.user.v1.UserService.ListProfileViewers:input_type -> datifyy.user.v1.ListProfileViewersRequest
+	100, // 215: datifyy.user.v1.UserService.LikeUser:input_type -> datifyy.user.v1.LikeUserRequest
+	102, // 216: datifyy.user.v1.UserService.SuperLikeUser:input_type -> datifyy.user.v1.SuperLikeUserRequest
+	104, // 217: datifyy.user.v1.UserService.PassUser:input_type -> datifyy.user.v1.PassUserRequest
+	106, // 218: datifyy.user.v1.UserService.ListLikesReceived:input_type -> datifyy.user.v1.ListLikesReceivedRequest
+	109, // 219: datifyy.user.v1.UserService.GetPartnerPreferences:input_type -> datifyy.user.v1.GetPartnerPreferencesRequest
+	111, // 220: datifyy.user.v1.UserService.UpdatePartnerPreferences:input_type -> datifyy.user.v1.UpdatePartnerPreferencesRequest
+	113, // 221: datifyy.user.v1.UserService.GetUserPreferences:input_type -> datifyy.user.v1.GetUserPreferencesRequest
+	115, // 222: datifyy.user.v1.UserService.UpdateUserPreferences:input_type -> datifyy.user.v1.UpdateUserPreferencesRequest
+	117, // 223: datifyy.user.v1.UserService.BlockUser:input_type -> datifyy.user.v1.BlockUserRequest
+	119, // 224: datifyy.user.v1.UserService.UnblockUser:input_type -> datifyy.user.v1.UnblockUserRequest
+	121, // 225: datifyy.user.v1.UserService.ListBlockedUsers:input_type -> datifyy.user.v1.ListBlockedUsersRequest
+	123, // 226: datifyy.user.v1.UserService.ReportUser:input_type -> datifyy.user.v1.ReportUserRequest
+	125, // 227: datifyy.user.v1.UserService.AppealSuspension:input_type -> datifyy.user.v1.AppealSuspensionRequest
+	132, // 228: datifyy.user.v1.UserService.GetLoveZoneDashboard:input_type -> datifyy.user.v1.GetLoveZoneDashboardRequest
+	134, // 229: datifyy.user.v1.UserService.GetDateSuggestions:input_type -> datifyy.user.v1.GetDateSuggestionsRequest
+	136, // 230: datifyy.user.v1.UserService.GetUpcomingDates:input_type -> datifyy.user.v1.GetUpcomingDatesRequest
+	138, // 231: datifyy.user.v1.UserService.GetPastDates:input_type -> datifyy.user.v1.GetPastDatesRequest
+	140, // 232: datifyy.user.v1.UserService.GetRejectedDates:input_type -> datifyy.user.v1.GetRejectedDatesRequest
+	142, // 233: datifyy.user.v1.UserService.GetLoveZoneStatistics:input_type -> datifyy.user.v1.GetLoveZoneStatisticsRequest
+	144, // 234: datifyy.user.v1.UserService.CancelDate:input_type -> datifyy.user.v1.CancelDateRequest
+	147, // 235: datifyy.user.v1.UserService.RequestReschedule:input_type -> datifyy.user.v1.RequestRescheduleRequest
+	149, // 236: datifyy.user.v1.UserService.RespondToReschedule:input_type -> datifyy.user.v1.RespondToRescheduleRequest
+	151, // 237: datifyy.user.v1.UserService.SendWorkEmailVerification:input_type -> datifyy.user.v1.SendWorkEmailVerificationRequest
+	153, // 238: datifyy.user.v1.UserService.VerifyWorkEmail:input_type -> datifyy.user.v1.VerifyWorkEmailRequest
+	155, // 239: datifyy.user.v1.UserService.SubmitIdVerification:input_type -> datifyy.user.v1.SubmitIdVerificationRequest
+	157, // 240: datifyy.user.v1.UserService.GetVerificationStatus:input_type -> datifyy.user.v1.GetVerificationStatusRequest
+	159, // 241: datifyy.user.v1.UserService.RequestDataExport:input_type -> datifyy.user.v1.RequestDataExportRequest
+	78,  // 242: datifyy.user.v1.UserService.GetUserProfile:output_type -> datifyy.user.v1.GetUserProfileResponse
+	80,  // 243: datifyy.user.v1.UserService.GetMyProfile:output_type -> datifyy.user.v1.GetMyProfileResponse
+	82,  // 244: datifyy.user.v1.UserService.UpdateProfile:output_type -> datifyy.user.v1.UpdateProfileResponse
+	87,  // 245: datifyy.user.v1.UserService.DeleteAccount:output_type -> datifyy.user.v1.DeleteAccountResponse
+	89,  // 246: datifyy.user.v1.UserService.UploadProfilePhoto:output_type -> datifyy.user.v1.UploadProfilePhotoResponse
+	91,  // 247: datifyy.user.v1.UserService.DeleteProfilePhoto:output_type -> datifyy.user.v1.DeleteProfilePhotoResponse
+	84,  // 248: datifyy.user.v1.UserService.ListPrompts:output_type -> datifyy.user.v1.ListPromptsResponse
+	93,  // 249: datifyy.user.v1.UserService.SearchUsers:output_type -> datifyy.user.v1.SearchUsersResponse
+	96,  // 250: datifyy.user.v1.UserService.GetRecommendations:output_type -> datifyy.user.v1.GetRecommendationsResponse
+	98,  // 251: datifyy.user.v1.UserService.ListProfileViewers:output_type -> datifyy.user.v1.ListProfileViewersResponse
+	101, // 252: datifyy.user.v1.UserService.LikeUser:output_type -> datifyy.user.v1.LikeUserResponse
+	103, // 253: datifyy.user.v1.UserService.SuperLikeUser:output_type -> datifyy.user.v1.SuperLikeUserResponse
+	105, // 254: datifyy.user.v1.UserService.PassUser:output_type -> datifyy.user.v1.PassUserResponse
+	107, // 255: datifyy.user.v1.UserService.ListLikesReceived:output_type -> datifyy.user.v1.ListLikesReceivedResponse
+	110, // 256: datifyy.user.v1.UserService.GetPartnerPreferences:output_type -> datifyy.user.v1.GetPartnerPreferencesResponse
+	112, // 257: datifyy.user.v1.UserService.UpdatePartnerPreferences:output_type -> datifyy.user.v1.UpdatePartnerPreferencesResponse
+	114, // 258: datifyy.user.v1.UserService.GetUserPreferences:output_type -> datifyy.user.v1.GetUserPreferencesResponse
+	116, // 259: datifyy.user.v1.UserService.UpdateUserPreferences:output_type -> datifyy.user.v1.UpdateUserPreferencesResponse
+	118, // 260: datifyy.user.v1.UserService.BlockUser:output_type -> datifyy.user.v1.BlockUserResponse
+	120, // 261: datifyy.user.v1.UserService.UnblockUser:output_type -> datifyy.user.v1.UnblockUserResponse
+	122, // 262: datifyy.user.v1.UserService.ListBlockedUsers:output_type -> datifyy.user.v1.ListBlockedUsersResponse
+	124, // 263: datifyy.user.v1.UserService.ReportUser:output_type -> datifyy.user.v1.ReportUserResponse
+	126, // 264: datifyy.user.v1.UserService.AppealSuspension:output_type -> datifyy.user.v1.AppealSuspensionResponse
+	133, // 265: datifyy.user.v1.UserService.GetLoveZoneDashboard:output_type -> datifyy.user.v1.GetLoveZoneDashboardResponse
+	135, // 266: datifyy.user.v1.UserService.GetDateSuggestions:output_type -> datifyy.user.v1.GetDateSuggestionsResponse
+	137, // 267: datifyy.user.v1.UserService.GetUpcomingDates:output_type -> datifyy.user.v1.GetUpcomingDatesResponse
+	139, // 268: datifyy.user.v1.UserService.GetPastDates:output_type -> datifyy.user.v1.GetPastDatesResponse
+	141, // 269: datifyy.user.v1.UserService.GetRejectedDates:output_type -> datifyy.user.v1.GetRejectedDatesResponse
+	143, // 270: datifyy.user.v1.UserService.GetLoveZoneStatistics:output_type -> datifyy.user.v1.GetLoveZoneStatisticsResponse
+	145, // 271: datifyy.user.v1.UserService.CancelDate:output_type -> datifyy.user.v1.CancelDateResponse
+	148, // 272: datifyy.user.v1.UserService.RequestReschedule:output_type -> datifyy.user.v1.RequestRescheduleResponse
+	150, // 273: datifyy.user.v1.UserService.RespondToReschedule:output_type -> datifyy.user.v1.RespondToRescheduleResponse
+	152, // 274: datifyy.user.v1.UserService.SendWorkEmailVerification:output_type -> datifyy.user.v1.SendWorkEmailVerificationResponse
+	154, // 275: datifyy.user.v1.UserService.VerifyWorkEmail:output_type -> datifyy.user.v1.VerifyWorkEmailResponse
+	156, // 276: datifyy.user.v1.UserService.SubmitIdVerification:output_type -> datifyy.user.v1.SubmitIdVerificationResponse
+	158, // 277: datifyy.user.v1.UserService.GetVerificationStatus:output_type -> datifyy.user.v1.GetVerificationStatusResponse
+	160, // 278: datifyy.user.v1.UserService.RequestDataExport:output_type -> datifyy.user.v1.RequestDataExportResponse
+	242, // [242:279] is the sub-list for method output_type
+	205, // [205:242] is the sub-list for method input_type
+	205, // [205:205] is the sub-list for extension type_name
+	205, // [205:205] is the sub-list for extension extendee
+	0,   // [0:205] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -11243,8 +13962,8 @@ func file_user_v1_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
-			NumEnums:      49,
-			NumMessages:   74,
+			NumEnums:      51,
+			NumMessages:   110,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
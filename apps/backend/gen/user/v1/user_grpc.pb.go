@@ -19,28 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUserProfile_FullMethodName           = "/datifyy.user.v1.UserService/GetUserProfile"
-	UserService_GetMyProfile_FullMethodName             = "/datifyy.user.v1.UserService/GetMyProfile"
-	UserService_UpdateProfile_FullMethodName            = "/datifyy.user.v1.UserService/UpdateProfile"
-	UserService_DeleteAccount_FullMethodName            = "/datifyy.user.v1.UserService/DeleteAccount"
-	UserService_UploadProfilePhoto_FullMethodName       = "/datifyy.user.v1.UserService/UploadProfilePhoto"
-	UserService_DeleteProfilePhoto_FullMethodName       = "/datifyy.user.v1.UserService/DeleteProfilePhoto"
-	UserService_SearchUsers_FullMethodName              = "/datifyy.user.v1.UserService/SearchUsers"
-	UserService_GetRecommendations_FullMethodName       = "/datifyy.user.v1.UserService/GetRecommendations"
-	UserService_GetPartnerPreferences_FullMethodName    = "/datifyy.user.v1.UserService/GetPartnerPreferences"
-	UserService_UpdatePartnerPreferences_FullMethodName = "/datifyy.user.v1.UserService/UpdatePartnerPreferences"
-	UserService_GetUserPreferences_FullMethodName       = "/datifyy.user.v1.UserService/GetUserPreferences"
-	UserService_UpdateUserPreferences_FullMethodName    = "/datifyy.user.v1.UserService/UpdateUserPreferences"
-	UserService_BlockUser_FullMethodName                = "/datifyy.user.v1.UserService/BlockUser"
-	UserService_UnblockUser_FullMethodName              = "/datifyy.user.v1.UserService/UnblockUser"
-	UserService_ListBlockedUsers_FullMethodName         = "/datifyy.user.v1.UserService/ListBlockedUsers"
-	UserService_ReportUser_FullMethodName               = "/datifyy.user.v1.UserService/ReportUser"
-	UserService_GetLoveZoneDashboard_FullMethodName     = "/datifyy.user.v1.UserService/GetLoveZoneDashboard"
-	UserService_GetDateSuggestions_FullMethodName       = "/datifyy.user.v1.UserService/GetDateSuggestions"
-	UserService_GetUpcomingDates_FullMethodName         = "/datifyy.user.v1.UserService/GetUpcomingDates"
-	UserService_GetPastDates_FullMethodName             = "/datifyy.user.v1.UserService/GetPastDates"
-	UserService_GetRejectedDates_FullMethodName         = "/datifyy.user.v1.UserService/GetRejectedDates"
-	UserService_GetLoveZoneStatistics_FullMethodName    = "/datifyy.user.v1.UserService/GetLoveZoneStatistics"
+	UserService_GetUserProfile_FullMethodName            = "/datifyy.user.v1.UserService/GetUserProfile"
+	UserService_GetMyProfile_FullMethodName              = "/datifyy.user.v1.UserService/GetMyProfile"
+	UserService_UpdateProfile_FullMethodName             = "/datifyy.user.v1.UserService/UpdateProfile"
+	UserService_DeleteAccount_FullMethodName             = "/datifyy.user.v1.UserService/DeleteAccount"
+	UserService_UploadProfilePhoto_FullMethodName        = "/datifyy.user.v1.UserService/UploadProfilePhoto"
+	UserService_DeleteProfilePhoto_FullMethodName        = "/datifyy.user.v1.UserService/DeleteProfilePhoto"
+	UserService_ListPrompts_FullMethodName               = "/datifyy.user.v1.UserService/ListPrompts"
+	UserService_SearchUsers_FullMethodName               = "/datifyy.user.v1.UserService/SearchUsers"
+	UserService_GetRecommendations_FullMethodName        = "/datifyy.user.v1.UserService/GetRecommendations"
+	UserService_ListProfileViewers_FullMethodName        = "/datifyy.user.v1.UserService/ListProfileViewers"
+	UserService_LikeUser_FullMethodName                  = "/datifyy.user.v1.UserService/LikeUser"
+	UserService_SuperLikeUser_FullMethodName             = "/datifyy.user.v1.UserService/SuperLikeUser"
+	UserService_PassUser_FullMethodName                  = "/datifyy.user.v1.UserService/PassUser"
+	UserService_ListLikesReceived_FullMethodName         = "/datifyy.user.v1.UserService/ListLikesReceived"
+	UserService_GetPartnerPreferences_FullMethodName     = "/datifyy.user.v1.UserService/GetPartnerPreferences"
+	UserService_UpdatePartnerPreferences_FullMethodName  = "/datifyy.user.v1.UserService/UpdatePartnerPreferences"
+	UserService_GetUserPreferences_FullMethodName        = "/datifyy.user.v1.UserService/GetUserPreferences"
+	UserService_UpdateUserPreferences_FullMethodName     = "/datifyy.user.v1.UserService/UpdateUserPreferences"
+	UserService_BlockUser_FullMethodName                 = "/datifyy.user.v1.UserService/BlockUser"
+	UserService_UnblockUser_FullMethodName               = "/datifyy.user.v1.UserService/UnblockUser"
+	UserService_ListBlockedUsers_FullMethodName          = "/datifyy.user.v1.UserService/ListBlockedUsers"
+	UserService_ReportUser_FullMethodName                = "/datifyy.user.v1.UserService/ReportUser"
+	UserService_AppealSuspension_FullMethodName          = "/datifyy.user.v1.UserService/AppealSuspension"
+	UserService_GetLoveZoneDashboard_FullMethodName      = "/datifyy.user.v1.UserService/GetLoveZoneDashboard"
+	UserService_GetDateSuggestions_FullMethodName        = "/datifyy.user.v1.UserService/GetDateSuggestions"
+	UserService_GetUpcomingDates_FullMethodName          = "/datifyy.user.v1.UserService/GetUpcomingDates"
+	UserService_GetPastDates_FullMethodName              = "/datifyy.user.v1.UserService/GetPastDates"
+	UserService_GetRejectedDates_FullMethodName          = "/datifyy.user.v1.UserService/GetRejectedDates"
+	UserService_GetLoveZoneStatistics_FullMethodName     = "/datifyy.user.v1.UserService/GetLoveZoneStatistics"
+	UserService_CancelDate_FullMethodName                = "/datifyy.user.v1.UserService/CancelDate"
+	UserService_RequestReschedule_FullMethodName         = "/datifyy.user.v1.UserService/RequestReschedule"
+	UserService_RespondToReschedule_FullMethodName       = "/datifyy.user.v1.UserService/RespondToReschedule"
+	UserService_SendWorkEmailVerification_FullMethodName = "/datifyy.user.v1.UserService/SendWorkEmailVerification"
+	UserService_VerifyWorkEmail_FullMethodName           = "/datifyy.user.v1.UserService/VerifyWorkEmail"
+	UserService_SubmitIdVerification_FullMethodName      = "/datifyy.user.v1.UserService/SubmitIdVerification"
+	UserService_GetVerificationStatus_FullMethodName     = "/datifyy.user.v1.UserService/GetVerificationStatus"
+	UserService_RequestDataExport_FullMethodName         = "/datifyy.user.v1.UserService/RequestDataExport"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -61,10 +76,22 @@ type UserServiceClient interface {
 	UploadProfilePhoto(ctx context.Context, in *UploadProfilePhotoRequest, opts ...grpc.CallOption) (*UploadProfilePhotoResponse, error)
 	// Delete profile photo
 	DeleteProfilePhoto(ctx context.Context, in *DeleteProfilePhotoRequest, opts ...grpc.CallOption) (*DeleteProfilePhotoResponse, error)
+	// List the prompts that can currently be answered on a profile
+	ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error)
 	// Search users (for matching)
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// Get user recommendations
 	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*GetRecommendationsResponse, error)
+	// List users who viewed the caller's profile ("who viewed me")
+	ListProfileViewers(ctx context.Context, in *ListProfileViewersRequest, opts ...grpc.CallOption) (*ListProfileViewersResponse, error)
+	// Like a user (counts against a daily quota)
+	LikeUser(ctx context.Context, in *LikeUserRequest, opts ...grpc.CallOption) (*LikeUserResponse, error)
+	// Super like a user (counts against a smaller daily quota)
+	SuperLikeUser(ctx context.Context, in *SuperLikeUserRequest, opts ...grpc.CallOption) (*SuperLikeUserResponse, error)
+	// Pass on a user
+	PassUser(ctx context.Context, in *PassUserRequest, opts ...grpc.CallOption) (*PassUserResponse, error)
+	// List likes received that the caller hasn't responded to
+	ListLikesReceived(ctx context.Context, in *ListLikesReceivedRequest, opts ...grpc.CallOption) (*ListLikesReceivedResponse, error)
 	// Get partner preferences
 	GetPartnerPreferences(ctx context.Context, in *GetPartnerPreferencesRequest, opts ...grpc.CallOption) (*GetPartnerPreferencesResponse, error)
 	// Update partner preferences
@@ -81,6 +108,8 @@ type UserServiceClient interface {
 	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
 	// Report a user
 	ReportUser(ctx context.Context, in *ReportUserRequest, opts ...grpc.CallOption) (*ReportUserResponse, error)
+	// Appeal an active suspension or ban (callable while signed out)
+	AppealSuspension(ctx context.Context, in *AppealSuspensionRequest, opts ...grpc.CallOption) (*AppealSuspensionResponse, error)
 	// Get Love Zone dashboard (all date views in one call)
 	GetLoveZoneDashboard(ctx context.Context, in *GetLoveZoneDashboardRequest, opts ...grpc.CallOption) (*GetLoveZoneDashboardResponse, error)
 	// Get date suggestions (pending)
@@ -93,6 +122,22 @@ type UserServiceClient interface {
 	GetRejectedDates(ctx context.Context, in *GetRejectedDatesRequest, opts ...grpc.CallOption) (*GetRejectedDatesResponse, error)
 	// Get Love Zone statistics
 	GetLoveZoneStatistics(ctx context.Context, in *GetLoveZoneStatisticsRequest, opts ...grpc.CallOption) (*GetLoveZoneStatisticsResponse, error)
+	// Cancel an upcoming date (counts toward the caller's reliability)
+	CancelDate(ctx context.Context, in *CancelDateRequest, opts ...grpc.CallOption) (*CancelDateResponse, error)
+	// Propose new times for an upcoming date; the other participant must accept one
+	RequestReschedule(ctx context.Context, in *RequestRescheduleRequest, opts ...grpc.CallOption) (*RequestRescheduleResponse, error)
+	// Accept one of the times proposed for a date, or decline them
+	RespondToReschedule(ctx context.Context, in *RespondToRescheduleRequest, opts ...grpc.CallOption) (*RespondToRescheduleResponse, error)
+	// Send a verification code to a corporate email address
+	SendWorkEmailVerification(ctx context.Context, in *SendWorkEmailVerificationRequest, opts ...grpc.CallOption) (*SendWorkEmailVerificationResponse, error)
+	// Confirm a work email with the code that was sent to it
+	VerifyWorkEmail(ctx context.Context, in *VerifyWorkEmailRequest, opts ...grpc.CallOption) (*VerifyWorkEmailResponse, error)
+	// Submit a government ID document for moderator review
+	SubmitIdVerification(ctx context.Context, in *SubmitIdVerificationRequest, opts ...grpc.CallOption) (*SubmitIdVerificationResponse, error)
+	// Get the caller's verification badges and pending submissions
+	GetVerificationStatus(ctx context.Context, in *GetVerificationStatusRequest, opts ...grpc.CallOption) (*GetVerificationStatusResponse, error)
+	// Request a copy of all personal data (delivered by email as a signed download link)
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
 }
 
 type userServiceClient struct {
@@ -163,6 +208,16 @@ func (c *userServiceClient) DeleteProfilePhoto(ctx context.Context, in *DeletePr
 	return out, nil
 }
 
+func (c *userServiceClient) ListPrompts(ctx context.Context, in *ListPromptsRequest, opts ...grpc.CallOption) (*ListPromptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromptsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPrompts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchUsersResponse)
@@ -183,6 +238,56 @@ func (c *userServiceClient) GetRecommendations(ctx context.Context, in *GetRecom
 	return out, nil
 }
 
+func (c *userServiceClient) ListProfileViewers(ctx context.Context, in *ListProfileViewersRequest, opts ...grpc.CallOption) (*ListProfileViewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfileViewersResponse)
+	err := c.cc.Invoke(ctx, UserService_ListProfileViewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LikeUser(ctx context.Context, in *LikeUserRequest, opts ...grpc.CallOption) (*LikeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LikeUserResponse)
+	err := c.cc.Invoke(ctx, UserService_LikeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuperLikeUser(ctx context.Context, in *SuperLikeUserRequest, opts ...grpc.CallOption) (*SuperLikeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuperLikeUserResponse)
+	err := c.cc.Invoke(ctx, UserService_SuperLikeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) PassUser(ctx context.Context, in *PassUserRequest, opts ...grpc.CallOption) (*PassUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PassUserResponse)
+	err := c.cc.Invoke(ctx, UserService_PassUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListLikesReceived(ctx context.Context, in *ListLikesReceivedRequest, opts ...grpc.CallOption) (*ListLikesReceivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLikesReceivedResponse)
+	err := c.cc.Invoke(ctx, UserService_ListLikesReceived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetPartnerPreferences(ctx context.Context, in *GetPartnerPreferencesRequest, opts ...grpc.CallOption) (*GetPartnerPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPartnerPreferencesResponse)
@@ -263,6 +368,16 @@ func (c *userServiceClient) ReportUser(ctx context.Context, in *ReportUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) AppealSuspension(ctx context.Context, in *AppealSuspensionRequest, opts ...grpc.CallOption) (*AppealSuspensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppealSuspensionResponse)
+	err := c.cc.Invoke(ctx, UserService_AppealSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetLoveZoneDashboard(ctx context.Context, in *GetLoveZoneDashboardRequest, opts ...grpc.CallOption) (*GetLoveZoneDashboardResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetLoveZoneDashboardResponse)
@@ -323,6 +438,86 @@ func (c *userServiceClient) GetLoveZoneStatistics(ctx context.Context, in *GetLo
 	return out, nil
 }
 
+func (c *userServiceClient) CancelDate(ctx context.Context, in *CancelDateRequest, opts ...grpc.CallOption) (*CancelDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelDateResponse)
+	err := c.cc.Invoke(ctx, UserService_CancelDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestReschedule(ctx context.Context, in *RequestRescheduleRequest, opts ...grpc.CallOption) (*RequestRescheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRescheduleResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestReschedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RespondToReschedule(ctx context.Context, in *RespondToRescheduleRequest, opts ...grpc.CallOption) (*RespondToRescheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToRescheduleResponse)
+	err := c.cc.Invoke(ctx, UserService_RespondToReschedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SendWorkEmailVerification(ctx context.Context, in *SendWorkEmailVerificationRequest, opts ...grpc.CallOption) (*SendWorkEmailVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendWorkEmailVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_SendWorkEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyWorkEmail(ctx context.Context, in *VerifyWorkEmailRequest, opts ...grpc.CallOption) (*VerifyWorkEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyWorkEmailResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyWorkEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SubmitIdVerification(ctx context.Context, in *SubmitIdVerificationRequest, opts ...grpc.CallOption) (*SubmitIdVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitIdVerificationResponse)
+	err := c.cc.Invoke(ctx, UserService_SubmitIdVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetVerificationStatus(ctx context.Context, in *GetVerificationStatusRequest, opts ...grpc.CallOption) (*GetVerificationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVerificationStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_GetVerificationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -341,10 +536,22 @@ type UserServiceServer interface {
 	UploadProfilePhoto(context.Context, *UploadProfilePhotoRequest) (*UploadProfilePhotoResponse, error)
 	// Delete profile photo
 	DeleteProfilePhoto(context.Context, *DeleteProfilePhotoRequest) (*DeleteProfilePhotoResponse, error)
+	// List the prompts that can currently be answered on a profile
+	ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error)
 	// Search users (for matching)
 	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// Get user recommendations
 	GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error)
+	// List users who viewed the caller's profile ("who viewed me")
+	ListProfileViewers(context.Context, *ListProfileViewersRequest) (*ListProfileViewersResponse, error)
+	// Like a user (counts against a daily quota)
+	LikeUser(context.Context, *LikeUserRequest) (*LikeUserResponse, error)
+	// Super like a user (counts against a smaller daily quota)
+	SuperLikeUser(context.Context, *SuperLikeUserRequest) (*SuperLikeUserResponse, error)
+	// Pass on a user
+	PassUser(context.Context, *PassUserRequest) (*PassUserResponse, error)
+	// List likes received that the caller hasn't responded to
+	ListLikesReceived(context.Context, *ListLikesReceivedRequest) (*ListLikesReceivedResponse, error)
 	// Get partner preferences
 	GetPartnerPreferences(context.Context, *GetPartnerPreferencesRequest) (*GetPartnerPreferencesResponse, error)
 	// Update partner preferences
@@ -361,6 +568,8 @@ type UserServiceServer interface {
 	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
 	// Report a user
 	ReportUser(context.Context, *ReportUserRequest) (*ReportUserResponse, error)
+	// Appeal an active suspension or ban (callable while signed out)
+	AppealSuspension(context.Context, *AppealSuspensionRequest) (*AppealSuspensionResponse, error)
 	// Get Love Zone dashboard (all date views in one call)
 	GetLoveZoneDashboard(context.Context, *GetLoveZoneDashboardRequest) (*GetLoveZoneDashboardResponse, error)
 	// Get date suggestions (pending)
@@ -373,6 +582,22 @@ type UserServiceServer interface {
 	GetRejectedDates(context.Context, *GetRejectedDatesRequest) (*GetRejectedDatesResponse, error)
 	// Get Love Zone statistics
 	GetLoveZoneStatistics(context.Context, *GetLoveZoneStatisticsRequest) (*GetLoveZoneStatisticsResponse, error)
+	// Cancel an upcoming date (counts toward the caller's reliability)
+	CancelDate(context.Context, *CancelDateRequest) (*CancelDateResponse, error)
+	// Propose new times for an upcoming date; the other participant must accept one
+	RequestReschedule(context.Context, *RequestRescheduleRequest) (*RequestRescheduleResponse, error)
+	// Accept one of the times proposed for a date, or decline them
+	RespondToReschedule(context.Context, *RespondToRescheduleRequest) (*RespondToRescheduleResponse, error)
+	// Send a verification code to a corporate email address
+	SendWorkEmailVerification(context.Context, *SendWorkEmailVerificationRequest) (*SendWorkEmailVerificationResponse, error)
+	// Confirm a work email with the code that was sent to it
+	VerifyWorkEmail(context.Context, *VerifyWorkEmailRequest) (*VerifyWorkEmailResponse, error)
+	// Submit a government ID document for moderator review
+	SubmitIdVerification(context.Context, *SubmitIdVerificationRequest) (*SubmitIdVerificationResponse, error)
+	// Get the caller's verification badges and pending submissions
+	GetVerificationStatus(context.Context, *GetVerificationStatusRequest) (*GetVerificationStatusResponse, error)
+	// Request a copy of all personal data (delivered by email as a signed download link)
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -401,12 +626,30 @@ func (UnimplementedUserServiceServer) UploadProfilePhoto(context.Context, *Uploa
 func (UnimplementedUserServiceServer) DeleteProfilePhoto(context.Context, *DeleteProfilePhotoRequest) (*DeleteProfilePhotoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfilePhoto not implemented")
 }
+func (UnimplementedUserServiceServer) ListPrompts(context.Context, *ListPromptsRequest) (*ListPromptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrompts not implemented")
+}
 func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
 }
 func (UnimplementedUserServiceServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*GetRecommendationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
 }
+func (UnimplementedUserServiceServer) ListProfileViewers(context.Context, *ListProfileViewersRequest) (*ListProfileViewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfileViewers not implemented")
+}
+func (UnimplementedUserServiceServer) LikeUser(context.Context, *LikeUserRequest) (*LikeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeUser not implemented")
+}
+func (UnimplementedUserServiceServer) SuperLikeUser(context.Context, *SuperLikeUserRequest) (*SuperLikeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperLikeUser not implemented")
+}
+func (UnimplementedUserServiceServer) PassUser(context.Context, *PassUserRequest) (*PassUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PassUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListLikesReceived(context.Context, *ListLikesReceivedRequest) (*ListLikesReceivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLikesReceived not implemented")
+}
 func (UnimplementedUserServiceServer) GetPartnerPreferences(context.Context, *GetPartnerPreferencesRequest) (*GetPartnerPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPartnerPreferences not implemented")
 }
@@ -431,6 +674,9 @@ func (UnimplementedUserServiceServer) ListBlockedUsers(context.Context, *ListBlo
 func (UnimplementedUserServiceServer) ReportUser(context.Context, *ReportUserRequest) (*ReportUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportUser not implemented")
 }
+func (UnimplementedUserServiceServer) AppealSuspension(context.Context, *AppealSuspensionRequest) (*AppealSuspensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppealSuspension not implemented")
+}
 func (UnimplementedUserServiceServer) GetLoveZoneDashboard(context.Context, *GetLoveZoneDashboardRequest) (*GetLoveZoneDashboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoveZoneDashboard not implemented")
 }
@@ -449,6 +695,30 @@ func (UnimplementedUserServiceServer) GetRejectedDates(context.Context, *GetReje
 func (UnimplementedUserServiceServer) GetLoveZoneStatistics(context.Context, *GetLoveZoneStatisticsRequest) (*GetLoveZoneStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoveZoneStatistics not implemented")
 }
+func (UnimplementedUserServiceServer) CancelDate(context.Context, *CancelDateRequest) (*CancelDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDate not implemented")
+}
+func (UnimplementedUserServiceServer) RequestReschedule(context.Context, *RequestRescheduleRequest) (*RequestRescheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReschedule not implemented")
+}
+func (UnimplementedUserServiceServer) RespondToReschedule(context.Context, *RespondToRescheduleRequest) (*RespondToRescheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToReschedule not implemented")
+}
+func (UnimplementedUserServiceServer) SendWorkEmailVerification(context.Context, *SendWorkEmailVerificationRequest) (*SendWorkEmailVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWorkEmailVerification not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyWorkEmail(context.Context, *VerifyWorkEmailRequest) (*VerifyWorkEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyWorkEmail not implemented")
+}
+func (UnimplementedUserServiceServer) SubmitIdVerification(context.Context, *SubmitIdVerificationRequest) (*SubmitIdVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitIdVerification not implemented")
+}
+func (UnimplementedUserServiceServer) GetVerificationStatus(context.Context, *GetVerificationStatusRequest) (*GetVerificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationStatus not implemented")
+}
+func (UnimplementedUserServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -578,6 +848,24 @@ func _UserService_DeleteProfilePhoto_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListPrompts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListPrompts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListPrompts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListPrompts(ctx, req.(*ListPromptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchUsersRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +902,96 @@ func _UserService_GetRecommendations_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListProfileViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileViewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListProfileViewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListProfileViewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListProfileViewers(ctx, req.(*ListProfileViewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LikeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LikeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LikeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LikeUser(ctx, req.(*LikeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuperLikeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperLikeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuperLikeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuperLikeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuperLikeUser(ctx, req.(*SuperLikeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_PassUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PassUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).PassUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_PassUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).PassUser(ctx, req.(*PassUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListLikesReceived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLikesReceivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListLikesReceived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListLikesReceived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListLikesReceived(ctx, req.(*ListLikesReceivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetPartnerPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPartnerPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -758,6 +1136,24 @@ func _UserService_ReportUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_AppealSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppealSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AppealSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AppealSuspension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AppealSuspension(ctx, req.(*AppealSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetLoveZoneDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLoveZoneDashboardRequest)
 	if err := dec(in); err != nil {
@@ -866,6 +1262,150 @@ func _UserService_GetLoveZoneStatistics_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_CancelDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CancelDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelDate(ctx, req.(*CancelDateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestReschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRescheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestReschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestReschedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestReschedule(ctx, req.(*RequestRescheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RespondToReschedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToRescheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RespondToReschedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RespondToReschedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RespondToReschedule(ctx, req.(*RespondToRescheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SendWorkEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendWorkEmailVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SendWorkEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SendWorkEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SendWorkEmailVerification(ctx, req.(*SendWorkEmailVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyWorkEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyWorkEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyWorkEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyWorkEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyWorkEmail(ctx, req.(*VerifyWorkEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SubmitIdVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitIdVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SubmitIdVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SubmitIdVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SubmitIdVerification(ctx, req.(*SubmitIdVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetVerificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetVerificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetVerificationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetVerificationStatus(ctx, req.(*GetVerificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -897,6 +1437,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProfilePhoto",
 			Handler:    _UserService_DeleteProfilePhoto_Handler,
 		},
+		{
+			MethodName: "ListPrompts",
+			Handler:    _UserService_ListPrompts_Handler,
+		},
 		{
 			MethodName: "SearchUsers",
 			Handler:    _UserService_SearchUsers_Handler,
@@ -905,6 +1449,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendations",
 			Handler:    _UserService_GetRecommendations_Handler,
 		},
+		{
+			MethodName: "ListProfileViewers",
+			Handler:    _UserService_ListProfileViewers_Handler,
+		},
+		{
+			MethodName: "LikeUser",
+			Handler:    _UserService_LikeUser_Handler,
+		},
+		{
+			MethodName: "SuperLikeUser",
+			Handler:    _UserService_SuperLikeUser_Handler,
+		},
+		{
+			MethodName: "PassUser",
+			Handler:    _UserService_PassUser_Handler,
+		},
+		{
+			MethodName: "ListLikesReceived",
+			Handler:    _UserService_ListLikesReceived_Handler,
+		},
 		{
 			MethodName: "GetPartnerPreferences",
 			Handler:    _UserService_GetPartnerPreferences_Handler,
@@ -937,6 +1501,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportUser",
 			Handler:    _UserService_ReportUser_Handler,
 		},
+		{
+			MethodName: "AppealSuspension",
+			Handler:    _UserService_AppealSuspension_Handler,
+		},
 		{
 			MethodName: "GetLoveZoneDashboard",
 			Handler:    _UserService_GetLoveZoneDashboard_Handler,
@@ -961,6 +1529,38 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLoveZoneStatistics",
 			Handler:    _UserService_GetLoveZoneStatistics_Handler,
 		},
+		{
+			MethodName: "CancelDate",
+			Handler:    _UserService_CancelDate_Handler,
+		},
+		{
+			MethodName: "RequestReschedule",
+			Handler:    _UserService_RequestReschedule_Handler,
+		},
+		{
+			MethodName: "RespondToReschedule",
+			Handler:    _UserService_RespondToReschedule_Handler,
+		},
+		{
+			MethodName: "SendWorkEmailVerification",
+			Handler:    _UserService_SendWorkEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyWorkEmail",
+			Handler:    _UserService_VerifyWorkEmail_Handler,
+		},
+		{
+			MethodName: "SubmitIdVerification",
+			Handler:    _UserService_SubmitIdVerification_Handler,
+		},
+		{
+			MethodName: "GetVerificationStatus",
+			Handler:    _UserService_GetVerificationStatus_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserService_RequestDataExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
@@ -146,7 +146,7 @@ User 2 Partner Preferences:
 - Preferred Interests: %s
 - Education Preference: %s
 - Lifestyle Preference: %s
-
+%s
 Analyze their compatibility considering:
 1. Age compatibility with each other's preferences
 2. Gender preference match
@@ -202,5 +202,30 @@ Be objective and consider both mutual compatibility (do they match each other's
 		strings.Join(req.User2Preferences.InterestsPreferred, ", "),
 		req.User2Preferences.EducationPreference,
 		req.User2Preferences.LifestylePreference,
+		// Horoscope
+		horoscopeSection(req.Horoscope),
 	)
 }
+
+// horoscopeSection describes the couple's Guna Milan, if known
+func horoscopeSection(h *HoroscopeCompatibility) string {
+	if h == nil {
+		return ""
+	}
+
+	manglik := "compatible"
+	if !h.ManglikCompatible {
+		manglik = "only one partner has Mangal dosha"
+	}
+	importance := "Treat it as a minor factor."
+	if h.Required {
+		importance = "At least one user requires horoscope matching, so weigh it heavily."
+	}
+
+	return fmt.Sprintf(`
+Horoscope (Ashtakoota Guna Milan):
+- Gunas Matched: %.1f / %d
+- Manglik: %s
+- %s
+`, h.Gunas, h.MaxGunas, manglik, importance)
+}
@@ -6,6 +6,15 @@ type CompatibilityRequest struct {
 	User1Preferences     PartnerPreferences  `json:"user1_preferences"`
 	User2Profile         UserProfile         `json:"user2_profile"`
 	User2Preferences     PartnerPreferences  `json:"user2_preferences"`
+	Horoscope            *HoroscopeCompatibility `json:"horoscope,omitempty"` // nil unless both users gave birth details
+}
+
+// HoroscopeCompatibility is the couple's Ashtakoota (Guna Milan) result
+type HoroscopeCompatibility struct {
+	Gunas             float64 `json:"gunas"`
+	MaxGunas          int     `json:"max_gunas"`
+	ManglikCompatible bool    `json:"manglik_compatible"`
+	Required          bool    `json:"required"` // either user requires horoscope matching
 }
 
 // UserProfile contains user information for compatibility check
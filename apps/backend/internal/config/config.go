@@ -1,9 +1,12 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -31,6 +34,26 @@ type Config struct {
 	// Notifications
 	SlackWebhookURL string
 
+	// Storage & Links
+	StorageDir   string
+	PublicAPIURL string
+
+	// Data Exports: key for signing download links, shared by every service
+	// instance so links signed by the export job verify in the HTTP server
+	DataExportSigningKey string
+
+	// Moderation: users reported by this many distinct users within the
+	// window are escalated
+	ReportEscalationThreshold  int
+	ReportEscalationWindowDays int
+
+	// Likes
+	DailyLikeLimit      int
+	DailySuperLikeLimit int
+
+	// Dates: cancelling a date closer to it than this is a late cancellation
+	LateCancellationWindowHours int
+
 	// Environment
 	Environment string
 }
@@ -42,7 +65,7 @@ type Config struct {
 func Load() *Config {
 	loadEnvFile()
 
-	return &Config{
+	cfg := &Config{
 		// Server
 		HTTPPort: getEnv("PORT", "8080"),
 		GRPCPort: getEnv("GRPC_PORT", "9090"),
@@ -67,9 +90,37 @@ func Load() *Config {
 		// Notifications
 		SlackWebhookURL: os.Getenv("SLACK_WEBHOOK_URL"),
 
+		// Storage & Links
+		StorageDir:   getEnv("STORAGE_DIR", "storage"),
+		PublicAPIURL: getEnv("PUBLIC_API_URL", "http://localhost:8080"),
+
+		// Data Exports
+		DataExportSigningKey: os.Getenv("DATA_EXPORT_SIGNING_KEY"),
+
+		// Moderation
+		ReportEscalationThreshold:  getEnvInt("REPORT_ESCALATION_THRESHOLD", 3),
+		ReportEscalationWindowDays: getEnvInt("REPORT_ESCALATION_WINDOW_DAYS", 30),
+
+		// Likes
+		DailyLikeLimit:      getEnvInt("DAILY_LIKE_LIMIT", 50),
+		DailySuperLikeLimit: getEnvInt("DAILY_SUPER_LIKE_LIMIT", 1),
+
+		// Dates
+		LateCancellationWindowHours: getEnvInt("LATE_CANCELLATION_WINDOW_HOURS", 24),
+
 		// Environment
 		Environment: getEnv("ENV", "development"),
 	}
+
+	if cfg.DataExportSigningKey == "" && cfg.IsDevelopment() {
+		// Links signed with a random key stop working after a restart
+		key := make([]byte, 32)
+		rand.Read(key)
+		cfg.DataExportSigningKey = hex.EncodeToString(key)
+		log.Printf("DATA_EXPORT_SIGNING_KEY not set - using an ephemeral key for data export links")
+	}
+
+	return cfg
 }
 
 // Validate checks if required configuration is present
@@ -80,6 +131,9 @@ func (c *Config) Validate() error {
 	if c.RedisURL == "" {
 		return fmt.Errorf("REDIS_URL is required")
 	}
+	if c.DataExportSigningKey == "" {
+		return fmt.Errorf("DATA_EXPORT_SIGNING_KEY is required outside development")
+	}
 	return nil
 }
 
@@ -101,6 +155,14 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvInt gets environment variable parsed as an int with fallback default
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 // loadEnvFile loads the appropriate .env file based on environment
 func loadEnvFile() {
 	env := os.Getenv("ENV")
@@ -0,0 +1,79 @@
+// Package datestatus is the lifecycle of a scheduled date: the statuses it
+// moves through and which moves between them are allowed.
+//
+//	scheduled ──> confirmed ──> in_progress ──> completed
+//	    │             │              │
+//	    │             ├──> no_show <─┘
+//	    └─────────────┴──> cancelled
+//
+// A scheduled date may also be marked no_show directly, for when neither
+// participant confirmed and one of them didn't turn up. completed, cancelled
+// and no_show are final. Rescheduling a confirmed date puts it back to
+// scheduled outside these moves, since the new time hasn't been confirmed.
+package datestatus
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Statuses, matching the date_status database enum
+const (
+	Scheduled  = "scheduled"
+	Confirmed  = "confirmed"
+	InProgress = "in_progress"
+	Completed  = "completed"
+	Cancelled  = "cancelled"
+	NoShow     = "no_show"
+)
+
+var (
+	ErrUnknownStatus     = errors.New("unknown date status")
+	ErrInvalidTransition = errors.New("invalid date status transition")
+)
+
+// transitions lists the statuses each status can move to
+var transitions = map[string][]string{
+	Scheduled:  {Confirmed, Cancelled, NoShow},
+	Confirmed:  {InProgress, Cancelled, NoShow},
+	InProgress: {Completed, NoShow},
+	Completed:  nil,
+	Cancelled:  nil,
+	NoShow:     nil,
+}
+
+// Valid reports whether status is a known date status
+func Valid(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+// Final reports whether a date in status can no longer change
+func Final(status string) bool {
+	return Valid(status) && len(transitions[status]) == 0
+}
+
+// Next returns the statuses a date in status can move to
+func Next(status string) []string {
+	return append([]string(nil), transitions[status]...)
+}
+
+// Check returns an error wrapping ErrInvalidTransition if a date can't move
+// from one status to another, or ErrUnknownStatus if either isn't a status
+func Check(from, to string) error {
+	if !Valid(from) {
+		return fmt.Errorf("%w: %q", ErrUnknownStatus, from)
+	}
+	if !Valid(to) {
+		return fmt.Errorf("%w: %q", ErrUnknownStatus, to)
+	}
+	for _, next := range transitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	if from == to {
+		return fmt.Errorf("%w: date is already %s", ErrInvalidTransition, from)
+	}
+	return fmt.Errorf("%w: a %s date can't become %s", ErrInvalidTransition, from, to)
+}
@@ -0,0 +1,64 @@
+package datestatus
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheck_AllowedTransitions(t *testing.T) {
+	for _, tr := range [][2]string{
+		{Scheduled, Confirmed},
+		{Scheduled, Cancelled},
+		{Scheduled, NoShow},
+		{Confirmed, InProgress},
+		{Confirmed, Cancelled},
+		{Confirmed, NoShow},
+		{InProgress, Completed},
+		{InProgress, NoShow},
+	} {
+		if err := Check(tr[0], tr[1]); err != nil {
+			t.Errorf("%s -> %s: unexpected error %v", tr[0], tr[1], err)
+		}
+	}
+}
+
+func TestCheck_RejectedTransitions(t *testing.T) {
+	for _, tr := range [][2]string{
+		{Scheduled, Completed},
+		{Scheduled, InProgress},
+		{Confirmed, Scheduled},
+		{InProgress, Cancelled},
+		{Completed, Cancelled},
+		{Cancelled, Scheduled},
+		{NoShow, Completed},
+		{Confirmed, Confirmed},
+	} {
+		if err := Check(tr[0], tr[1]); !errors.Is(err, ErrInvalidTransition) {
+			t.Errorf("%s -> %s: expected ErrInvalidTransition, got %v", tr[0], tr[1], err)
+		}
+	}
+
+	if err := Check(Scheduled, "postponed"); !errors.Is(err, ErrUnknownStatus) {
+		t.Errorf("expected ErrUnknownStatus, got %v", err)
+	}
+}
+
+func TestFinal(t *testing.T) {
+	for status, final := range map[string]bool{
+		Scheduled: false, Confirmed: false, InProgress: false,
+		Completed: true, Cancelled: true, NoShow: true,
+		"postponed": false,
+	} {
+		if Final(status) != final {
+			t.Errorf("Final(%q) = %v, want %v", status, !final, final)
+		}
+	}
+}
+
+func TestNext_ReturnsCopy(t *testing.T) {
+	next := Next(Scheduled)
+	next[0] = Completed
+	if err := Check(Scheduled, Completed); err == nil {
+		t.Error("modifying Next's result changed the transitions")
+	}
+}
@@ -323,3 +323,471 @@ The Datifyy Team
 		HTML:    html,
 	})
 }
+
+// SendDataExportEmail sends a signed, expiring link to a user's personal data export.
+// Like the other emails, times are shown in their own location, which callers set
+// to the recipient's timezone.
+func (c *MailerSendClient) SendDataExportEmail(to, downloadURL string, expiresAt time.Time) error {
+	subject := "Your Datifyy Data Export Is Ready"
+	expiry := expiresAt.Format("January 2, 2006 at 3:04 PM MST")
+
+	text := fmt.Sprintf(`
+Hello,
+
+The copy of your personal data you requested is ready. Download it here:
+
+%s
+
+This link will expire on %s. After that you can request a new export.
+
+If you didn't request this export, please contact our support team immediately.
+
+Best regards,
+The Datifyy Team
+`, downloadURL, expiry)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .cta-button {
+            display: inline-block;
+            background: #4F46E5;
+            color: white;
+            padding: 12px 30px;
+            text-decoration: none;
+            border-radius: 6px;
+            margin: 20px 0;
+        }
+        .warning {
+            background: #FEF2F2;
+            border-left: 4px solid #DC2626;
+            padding: 12px;
+            margin: 20px 0;
+        }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Your Data Export Is Ready</h2>
+        <p>The copy of your personal data you requested is ready to download.</p>
+        <a class="cta-button" href="%s">Download My Data</a>
+        <p>This link will expire on %s. After that you can request a new export.</p>
+        <div class="warning">
+            <strong>Security Notice:</strong> If you didn't request this export, please contact our support team immediately.
+        </div>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, downloadURL, expiry)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendAccountDeletionScheduledEmail confirms that an account will be deleted after the grace period
+func (c *MailerSendClient) SendAccountDeletionScheduledEmail(to, name string, scheduledFor time.Time) error {
+	subject := "Your Datifyy Account Is Scheduled for Deletion"
+	deletionDate := scheduledFor.Format("January 2, 2006")
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+We received your request to delete your Datifyy account. Your account and personal data will be permanently deleted on %s.
+
+Changed your mind? Simply log in before then and your account will be restored.
+
+If you didn't request this, please log in and change your password immediately.
+
+Best regards,
+The Datifyy Team
+`, name, deletionDate)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .warning {
+            background: #FEF2F2;
+            border-left: 4px solid #DC2626;
+            padding: 12px;
+            margin: 20px 0;
+        }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Account Deletion Scheduled</h2>
+        <p>Hello %s,</p>
+        <p>We received your request to delete your Datifyy account. Your account and personal data will be permanently deleted on <strong>%s</strong>.</p>
+        <p>Changed your mind? Simply log in before then and your account will be restored.</p>
+        <div class="warning">
+            <strong>Security Notice:</strong> If you didn't request this, please log in and change your password immediately.
+        </div>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, name, deletionDate)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendDateCancelledEmail notifies a user that an upcoming date was cancelled
+func (c *MailerSendClient) SendDateCancelledEmail(to, name string, scheduledTime time.Time, reason string) error {
+	subject := "Your Upcoming Datifyy Date Was Cancelled"
+	dateTime := scheduledTime.Format("Monday, January 2, 2006 at 3:04 PM MST")
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+Unfortunately, your date scheduled for %s has been cancelled.
+
+Reason: %s
+
+We're sorry for the inconvenience. Our team will keep looking for great matches for you.
+
+Best regards,
+The Datifyy Team
+`, name, dateTime, reason)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Date Cancelled</h2>
+        <p>Hello %s,</p>
+        <p>Unfortunately, your date scheduled for <strong>%s</strong> has been cancelled.</p>
+        <p><strong>Reason:</strong> %s</p>
+        <p>We're sorry for the inconvenience. Our team will keep looking for great matches for you.</p>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, name, dateTime, reason)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendRescheduleRequestEmail asks a user to pick one of the new times their
+// match proposed for an upcoming date
+func (c *MailerSendClient) SendRescheduleRequestEmail(to, name, requesterName string, proposedTimes []time.Time, reason string) error {
+	subject := fmt.Sprintf("%s Asked to Reschedule Your Datifyy Date", requesterName)
+
+	var textTimes, htmlTimes string
+	for _, t := range proposedTimes {
+		formatted := t.Format("Monday, January 2, 2006 at 3:04 PM MST")
+		textTimes += "- " + formatted + "\n"
+		htmlTimes += "<li>" + formatted + "</li>"
+	}
+	if reason == "" {
+		reason = "No reason given"
+	}
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+%s can't make your upcoming date and has proposed these new times:
+
+%s
+Reason: %s
+
+Open Datifyy to accept one of them or decline. Your date stays as it is until you answer.
+
+Best regards,
+The Datifyy Team
+`, name, requesterName, textTimes, reason)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Reschedule Request</h2>
+        <p>Hello %s,</p>
+        <p>%s can't make your upcoming date and has proposed these new times:</p>
+        <ul>%s</ul>
+        <p><strong>Reason:</strong> %s</p>
+        <p>Open Datifyy to accept one of them or decline. Your date stays as it is until you answer.</p>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, name, requesterName, htmlTimes, reason)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendRescheduleAnsweredEmail tells a user whether their match accepted the
+// new time they proposed for a date. scheduledTime is when the date now is.
+func (c *MailerSendClient) SendRescheduleAnsweredEmail(to, name string, accepted bool, scheduledTime time.Time) error {
+	dateTime := scheduledTime.Format("Monday, January 2, 2006 at 3:04 PM MST")
+
+	subject := "Your Datifyy Date Has Been Rescheduled"
+	heading := "Date Rescheduled"
+	message := fmt.Sprintf("Your match accepted your new time. Your date is now on %s.", dateTime)
+	if !accepted {
+		subject = "Your Reschedule Request Was Declined"
+		heading = "Reschedule Declined"
+		message = fmt.Sprintf("Your match couldn't make any of the times you proposed, so your date is still on %s. You can cancel it if you can't make it.", dateTime)
+	}
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+%s
+
+Best regards,
+The Datifyy Team
+`, name, message)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>%s</h2>
+        <p>Hello %s,</p>
+        <p>%s</p>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, heading, name, message)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendGenieDateUpdateEmail tells a genie about a change participants made to
+// a date assigned to them
+func (c *MailerSendClient) SendGenieDateUpdateEmail(to, name string, dateID int, update string) error {
+	subject := fmt.Sprintf("Update on Date #%d", dateID)
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+%s
+
+You can see the date's full history in the admin dashboard.
+
+The Datifyy Team
+`, name, update)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Update on Date #%d</h2>
+        <p>Hello %s,</p>
+        <p>%s</p>
+        <p>You can see the date's full history in the admin dashboard.</p>
+        <div class="footer">
+            <p>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, dateID, name, update)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendAvailabilityReminderEmail reminds a user whose availability has run out to add more
+func (c *MailerSendClient) SendAvailabilityReminderEmail(to, name, availabilityURL string) error {
+	subject := "Add Your Availability to Keep Getting Matches"
+
+	text := fmt.Sprintf(`
+Hello %s,
+
+You don't have any upcoming availability on Datifyy, so we can't set up new dates for you right now.
+
+Add the times you're free here:
+
+%s
+
+Best regards,
+The Datifyy Team
+`, name, availabilityURL)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .cta-button {
+            display: inline-block;
+            background: #4F46E5;
+            color: white;
+            padding: 12px 30px;
+            text-decoration: none;
+            border-radius: 6px;
+            margin: 20px 0;
+        }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Your Availability Has Run Out</h2>
+        <p>Hello %s,</p>
+        <p>You don't have any upcoming availability on Datifyy, so we can't set up new dates for you right now.</p>
+        <a class="cta-button" href="%s">Add Availability</a>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, name, availabilityURL)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
+
+// SendWorkEmailVerificationCode sends a code that confirms ownership of a work email address
+func (c *MailerSendClient) SendWorkEmailVerificationCode(to, code string, expiresAt time.Time) error {
+	subject := "Verify Your Work Email"
+	expiry := expiresAt.Format("3:04 PM MST")
+
+	text := fmt.Sprintf(`
+Hello,
+
+Use the following code to verify your work email on Datifyy:
+
+%s
+
+This code will expire at %s.
+
+If you didn't request this, please ignore this email.
+
+Best regards,
+The Datifyy Team
+`, code, expiry)
+
+	html := fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <style>
+        body { font-family: Arial, sans-serif; line-height: 1.6; color: #333; }
+        .container { max-width: 600px; margin: 0 auto; padding: 20px; }
+        .code {
+            font-size: 32px;
+            font-weight: bold;
+            color: #4F46E5;
+            background: #F3F4F6;
+            padding: 20px;
+            text-align: center;
+            border-radius: 8px;
+            margin: 20px 0;
+            letter-spacing: 4px;
+        }
+        .footer { margin-top: 30px; color: #6B7280; font-size: 14px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <h2>Verify Your Work Email</h2>
+        <p>Use the following code to verify your work email on Datifyy:</p>
+        <div class="code">%s</div>
+        <p>This code will expire at %s.</p>
+        <p>If you didn't request this, please ignore this email.</p>
+        <div class="footer">
+            <p>Best regards,<br>The Datifyy Team</p>
+        </div>
+    </div>
+</body>
+</html>
+`, code, expiry)
+
+	return c.SendEmail(EmailRequest{
+		To:      to,
+		Subject: subject,
+		Text:    text,
+		HTML:    html,
+	})
+}
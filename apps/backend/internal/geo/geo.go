@@ -0,0 +1,174 @@
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+// EarthRadiusKm is the mean radius of the Earth
+const EarthRadiusKm = 6371.0
+
+// CoarsePrecision is the geohash length used when comparing users' locations.
+// A 5 character cell is roughly 5km x 5km, so distances derived from it can't
+// be used to pinpoint someone.
+const CoarsePrecision = 5
+
+// Point is a latitude/longitude pair in degrees
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid reports whether the point is a real coordinate. (0, 0) is treated as unset.
+func (p Point) Valid() bool {
+	if p.Lat == 0 && p.Lng == 0 {
+		return false
+	}
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// DistanceKm returns the great-circle distance between two points
+func DistanceKm(a, b Point) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox returns the latitude/longitude box enclosing a radius around center.
+// It is used to narrow down candidates before computing exact distances.
+func BoundingBox(center Point, radiusKm float64) (minLat, maxLat, minLng, maxLng float64) {
+	dLat := radiusKm / EarthRadiusKm * 180 / math.Pi
+	minLat = math.Max(center.Lat-dLat, -90)
+	maxLat = math.Min(center.Lat+dLat, 90)
+
+	// Longitude degrees shrink towards the poles
+	cosLat := math.Cos(center.Lat * math.Pi / 180)
+	if cosLat < 0.01 || minLat == -90 || maxLat == 90 {
+		return minLat, maxLat, -180, 180
+	}
+	dLng := dLat / cosLat
+	if dLng >= 180 {
+		return minLat, maxLat, -180, 180
+	}
+
+	return minLat, maxLat, center.Lng - dLng, center.Lng + dLng
+}
+
+// distanceBuckets are the values distances are rounded up to before being
+// shown to other users
+var distanceBuckets = []int{1, 2, 5, 10, 15, 25, 50, 100, 200, 500}
+
+// RoundDistanceKm rounds a distance up to a display bucket ("within 5 km").
+// Beyond the largest bucket it rounds up to the next 500km.
+func RoundDistanceKm(km float64) int {
+	for _, bucket := range distanceBuckets {
+		if km <= float64(bucket) {
+			return bucket
+		}
+	}
+	return int(math.Ceil(km/500)) * 500
+}
+
+// =============================================================================
+// Geohash
+// =============================================================================
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeohash returns the geohash of a point with the given number of characters
+func EncodeGeohash(p Point, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	var hash strings.Builder
+	bit, ch := 0, 0
+	even := true
+	for hash.Len() < precision {
+		if even {
+			mid := (lngRange[0] + lngRange[1]) / 2
+			if p.Lng >= mid {
+				ch |= 1 << (4 - bit)
+				lngRange[0] = mid
+			} else {
+				lngRange[1] = mid
+			}
+		} else {
+			mid := (latRange[0] + latRange[1]) / 2
+			if p.Lat >= mid {
+				ch |= 1 << (4 - bit)
+				latRange[0] = mid
+			} else {
+				latRange[1] = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+		} else {
+			hash.WriteByte(geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return hash.String()
+}
+
+// DecodeGeohash returns the center of a geohash cell. ok is false for an
+// empty or malformed hash.
+func DecodeGeohash(hash string) (p Point, ok bool) {
+	if hash == "" {
+		return Point{}, false
+	}
+
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+	even := true
+	for _, c := range strings.ToLower(hash) {
+		idx := strings.IndexRune(geohashAlphabet, c)
+		if idx < 0 {
+			return Point{}, false
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := idx&(1<<bit) != 0
+			if even {
+				mid := (lngRange[0] + lngRange[1]) / 2
+				if set {
+					lngRange[0] = mid
+				} else {
+					lngRange[1] = mid
+				}
+			} else {
+				mid := (latRange[0] + latRange[1]) / 2
+				if set {
+					latRange[0] = mid
+				} else {
+					latRange[1] = mid
+				}
+			}
+			even = !even
+		}
+	}
+
+	return Point{
+		Lat: (latRange[0] + latRange[1]) / 2,
+		Lng: (lngRange[0] + lngRange[1]) / 2,
+	}, true
+}
+
+// CoarseDistanceKm returns the distance between the centers of two geohash
+// cells. ok is false when either hash is missing.
+func CoarseDistanceKm(hashA, hashB string) (float64, bool) {
+	a, okA := DecodeGeohash(hashA)
+	b, okB := DecodeGeohash(hashB)
+	if !okA || !okB {
+		return 0, false
+	}
+	return DistanceKm(a, b), true
+}
@@ -0,0 +1,111 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDistanceKm(t *testing.T) {
+	mumbai := Point{19.0760, 72.8777}
+	pune := Point{18.5204, 73.8567}
+
+	got := DistanceKm(mumbai, pune)
+	if math.Abs(got-120) > 5 {
+		t.Errorf("expected Mumbai-Pune to be ~120km, got %.1f", got)
+	}
+
+	if d := DistanceKm(pune, pune); d != 0 {
+		t.Errorf("expected zero distance to self, got %f", d)
+	}
+}
+
+func TestEncodeGeohash(t *testing.T) {
+	if got := EncodeGeohash(Point{42.605, -5.603}, 5); got != "ezs42" {
+		t.Errorf("expected ezs42, got %s", got)
+	}
+}
+
+func TestDecodeGeohash_RoundTrip(t *testing.T) {
+	p := Point{18.5204, 73.8567}
+	center, ok := DecodeGeohash(EncodeGeohash(p, CoarsePrecision))
+	if !ok {
+		t.Fatal("expected valid geohash")
+	}
+
+	// A precision 5 cell is about 5km across
+	if d := DistanceKm(p, center); d > 5 {
+		t.Errorf("expected cell center within 5km, got %.1f", d)
+	}
+
+	if _, ok := DecodeGeohash("ab!"); ok {
+		t.Error("expected malformed geohash to be rejected")
+	}
+	if _, ok := DecodeGeohash(""); ok {
+		t.Error("expected empty geohash to be rejected")
+	}
+}
+
+func TestRoundDistanceKm(t *testing.T) {
+	tests := []struct {
+		km   float64
+		want int
+	}{
+		{0, 1},
+		{0.4, 1},
+		{3.2, 5},
+		{10, 10},
+		{118, 200},
+		{501, 1000},
+		{1400, 1500},
+	}
+
+	for _, tt := range tests {
+		if got := RoundDistanceKm(tt.km); got != tt.want {
+			t.Errorf("RoundDistanceKm(%v) = %d, want %d", tt.km, got, tt.want)
+		}
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	center := Point{18.5204, 73.8567}
+	minLat, maxLat, minLng, maxLng := BoundingBox(center, 50)
+
+	// Every point 50km away in any direction must fall inside the box
+	for _, p := range []Point{
+		{center.Lat + 0.449, center.Lng},
+		{center.Lat - 0.449, center.Lng},
+		{center.Lat, center.Lng + 0.47},
+		{center.Lat, center.Lng - 0.47},
+	} {
+		if p.Lat < minLat || p.Lat > maxLat || p.Lng < minLng || p.Lng > maxLng {
+			t.Errorf("point %+v outside box", p)
+		}
+	}
+}
+
+func TestGazetteerGeocoder(t *testing.T) {
+	g := NewGazetteerGeocoder()
+	ctx := context.Background()
+
+	place, err := g.Geocode(ctx, " bangalore ", "")
+	if err != nil {
+		t.Fatalf("expected alias lookup to succeed: %v", err)
+	}
+	if place.City != "Bengaluru" || place.CountryCode != "IN" {
+		t.Errorf("unexpected place %+v", place)
+	}
+
+	if _, err := g.Geocode(ctx, "Pune", "in"); err != nil {
+		t.Errorf("expected country code match: %v", err)
+	}
+
+	if _, err := g.Geocode(ctx, "Pune", "Germany"); !errors.Is(err, ErrPlaceNotFound) {
+		t.Errorf("expected ErrPlaceNotFound for wrong country, got %v", err)
+	}
+
+	if _, err := g.Geocode(ctx, "Atlantis", ""); !errors.Is(err, ErrPlaceNotFound) {
+		t.Errorf("expected ErrPlaceNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrPlaceNotFound is returned when a place can't be geocoded
+var ErrPlaceNotFound = errors.New("place not found")
+
+// Place is a geocoded city
+type Place struct {
+	City        string
+	State       string
+	Country     string
+	CountryCode string
+	Point       Point
+}
+
+// Geocoder resolves a city name to coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, city, country string) (*Place, error)
+}
+
+// GazetteerGeocoder geocodes against a built-in list of cities, so it works
+// offline and without an API key. Coordinates are city centers.
+type GazetteerGeocoder struct {
+	places map[string][]*Place
+}
+
+// NewGazetteerGeocoder creates a geocoder backed by the built-in gazetteer
+func NewGazetteerGeocoder() *GazetteerGeocoder {
+	g := &GazetteerGeocoder{places: make(map[string][]*Place)}
+	for i := range gazetteer {
+		entry := &gazetteer[i]
+		place := &entry.Place
+		for _, name := range append([]string{place.City}, entry.aliases...) {
+			key := normalizePlaceName(name)
+			g.places[key] = append(g.places[key], place)
+		}
+	}
+	return g
+}
+
+// Geocode looks a city up by name. When country is given (name or ISO code)
+// it is used to pick between cities sharing a name.
+func (g *GazetteerGeocoder) Geocode(ctx context.Context, city, country string) (*Place, error) {
+	candidates := g.places[normalizePlaceName(city)]
+	if len(candidates) == 0 {
+		return nil, ErrPlaceNotFound
+	}
+
+	country = normalizePlaceName(country)
+	if country == "" {
+		return candidates[0], nil
+	}
+	for _, place := range candidates {
+		if normalizePlaceName(place.Country) == country || strings.EqualFold(place.CountryCode, country) {
+			return place, nil
+		}
+	}
+
+	return nil, ErrPlaceNotFound
+}
+
+func normalizePlaceName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+type gazetteerEntry struct {
+	Place
+	aliases []string
+}
+
+var gazetteer = []gazetteerEntry{
+	// India
+	{Place{"Mumbai", "Maharashtra", "India", "IN", Point{19.0760, 72.8777}}, []string{"Bombay"}},
+	{Place{"Delhi", "Delhi", "India", "IN", Point{28.6139, 77.2090}}, []string{"New Delhi"}},
+	{Place{"Bengaluru", "Karnataka", "India", "IN", Point{12.9716, 77.5946}}, []string{"Bangalore"}},
+	{Place{"Hyderabad", "Telangana", "India", "IN", Point{17.3850, 78.4867}}, nil},
+	{Place{"Chennai", "Tamil Nadu", "India", "IN", Point{13.0827, 80.2707}}, []string{"Madras"}},
+	{Place{"Kolkata", "West Bengal", "India", "IN", Point{22.5726, 88.3639}}, []string{"Calcutta"}},
+	{Place{"Pune", "Maharashtra", "India", "IN", Point{18.5204, 73.8567}}, []string{"Poona"}},
+	{Place{"Ahmedabad", "Gujarat", "India", "IN", Point{23.0225, 72.5714}}, nil},
+	{Place{"Jaipur", "Rajasthan", "India", "IN", Point{26.9124, 75.7873}}, nil},
+	{Place{"Surat", "Gujarat", "India", "IN", Point{21.1702, 72.8311}}, nil},
+	{Place{"Lucknow", "Uttar Pradesh", "India", "IN", Point{26.8467, 80.9462}}, nil},
+	{Place{"Kanpur", "Uttar Pradesh", "India", "IN", Point{26.4499, 80.3319}}, nil},
+	{Place{"Nagpur", "Maharashtra", "India", "IN", Point{21.1458, 79.0882}}, nil},
+	{Place{"Indore", "Madhya Pradesh", "India", "IN", Point{22.7196, 75.8577}}, nil},
+	{Place{"Bhopal", "Madhya Pradesh", "India", "IN", Point{23.2599, 77.4126}}, nil},
+	{Place{"Patna", "Bihar", "India", "IN", Point{25.5941, 85.1376}}, nil},
+	{Place{"Vadodara", "Gujarat", "India", "IN", Point{22.3072, 73.1812}}, []string{"Baroda"}},
+	{Place{"Gurugram", "Haryana", "India", "IN", Point{28.4595, 77.0266}}, []string{"Gurgaon"}},
+	{Place{"Noida", "Uttar Pradesh", "India", "IN", Point{28.5355, 77.3910}}, nil},
+	{Place{"Ghaziabad", "Uttar Pradesh", "India", "IN", Point{28.6692, 77.4538}}, nil},
+	{Place{"Faridabad", "Haryana", "India", "IN", Point{28.4089, 77.3178}}, nil},
+	{Place{"Chandigarh", "Chandigarh", "India", "IN", Point{30.7333, 76.7794}}, nil},
+	{Place{"Ludhiana", "Punjab", "India", "IN", Point{30.9010, 75.8573}}, nil},
+	{Place{"Amritsar", "Punjab", "India", "IN", Point{31.6340, 74.8723}}, nil},
+	{Place{"Dehradun", "Uttarakhand", "India", "IN", Point{30.3165, 78.0322}}, nil},
+	{Place{"Kochi", "Kerala", "India", "IN", Point{9.9312, 76.2673}}, []string{"Cochin"}},
+	{Place{"Thiruvananthapuram", "Kerala", "India", "IN", Point{8.5241, 76.9366}}, []string{"Trivandrum"}},
+	{Place{"Coimbatore", "Tamil Nadu", "India", "IN", Point{11.0168, 76.9558}}, nil},
+	{Place{"Madurai", "Tamil Nadu", "India", "IN", Point{9.9252, 78.1198}}, nil},
+	{Place{"Mysuru", "Karnataka", "India", "IN", Point{12.2958, 76.6394}}, []string{"Mysore"}},
+	{Place{"Mangaluru", "Karnataka", "India", "IN", Point{12.9141, 74.8560}}, []string{"Mangalore"}},
+	{Place{"Visakhapatnam", "Andhra Pradesh", "India", "IN", Point{17.6868, 83.2185}}, []string{"Vizag"}},
+	{Place{"Vijayawada", "Andhra Pradesh", "India", "IN", Point{16.5062, 80.6480}}, nil},
+	{Place{"Bhubaneswar", "Odisha", "India", "IN", Point{20.2961, 85.8245}}, nil},
+	{Place{"Guwahati", "Assam", "India", "IN", Point{26.1445, 91.7362}}, nil},
+	{Place{"Ranchi", "Jharkhand", "India", "IN", Point{23.3441, 85.3096}}, nil},
+	{Place{"Raipur", "Chhattisgarh", "India", "IN", Point{21.2514, 81.6296}}, nil},
+	{Place{"Nashik", "Maharashtra", "India", "IN", Point{19.9975, 73.7898}}, nil},
+	{Place{"Thane", "Maharashtra", "India", "IN", Point{19.2183, 72.9781}}, nil},
+	{Place{"Navi Mumbai", "Maharashtra", "India", "IN", Point{19.0330, 73.0297}}, nil},
+	{Place{"Goa", "Goa", "India", "IN", Point{15.4909, 73.8278}}, []string{"Panaji", "Panjim"}},
+	{Place{"Varanasi", "Uttar Pradesh", "India", "IN", Point{25.3176, 82.9739}}, []string{"Benares"}},
+	{Place{"Agra", "Uttar Pradesh", "India", "IN", Point{27.1767, 78.0081}}, nil},
+	{Place{"Udaipur", "Rajasthan", "India", "IN", Point{24.5854, 73.7125}}, nil},
+	{Place{"Jodhpur", "Rajasthan", "India", "IN", Point{26.2389, 73.0243}}, nil},
+	{Place{"Srinagar", "Jammu and Kashmir", "India", "IN", Point{34.0837, 74.7973}}, nil},
+
+	// International
+	{Place{"London", "England", "United Kingdom", "GB", Point{51.5074, -0.1278}}, nil},
+	{Place{"New York", "New York", "United States", "US", Point{40.7128, -74.0060}}, []string{"New York City", "NYC"}},
+	{Place{"San Francisco", "California", "United States", "US", Point{37.7749, -122.4194}}, nil},
+	{Place{"Los Angeles", "California", "United States", "US", Point{34.0522, -118.2437}}, nil},
+	{Place{"Seattle", "Washington", "United States", "US", Point{47.6062, -122.3321}}, nil},
+	{Place{"Chicago", "Illinois", "United States", "US", Point{41.8781, -87.6298}}, nil},
+	{Place{"Toronto", "Ontario", "Canada", "CA", Point{43.6532, -79.3832}}, nil},
+	{Place{"Vancouver", "British Columbia", "Canada", "CA", Point{49.2827, -123.1207}}, nil},
+	{Place{"Dubai", "Dubai", "United Arab Emirates", "AE", Point{25.2048, 55.2708}}, nil},
+	{Place{"Abu Dhabi", "Abu Dhabi", "United Arab Emirates", "AE", Point{24.4539, 54.3773}}, nil},
+	{Place{"Singapore", "Singapore", "Singapore", "SG", Point{1.3521, 103.8198}}, nil},
+	{Place{"Sydney", "New South Wales", "Australia", "AU", Point{-33.8688, 151.2093}}, nil},
+	{Place{"Melbourne", "Victoria", "Australia", "AU", Point{-37.8136, 144.9631}}, nil},
+	{Place{"Berlin", "Berlin", "Germany", "DE", Point{52.5200, 13.4050}}, nil},
+	{Place{"Paris", "Île-de-France", "France", "FR", Point{48.8566, 2.3522}}, nil},
+	{Place{"Amsterdam", "North Holland", "Netherlands", "NL", Point{52.3676, 4.9041}}, nil},
+	{Place{"Tokyo", "Tokyo", "Japan", "JP", Point{35.6762, 139.6503}}, nil},
+	{Place{"Hong Kong", "Hong Kong", "Hong Kong", "HK", Point{22.3193, 114.1694}}, nil},
+	{Place{"Kathmandu", "Bagmati", "Nepal", "NP", Point{27.7172, 85.3240}}, nil},
+	{Place{"Colombo", "Western", "Sri Lanka", "LK", Point{6.9271, 79.8612}}, nil},
+	{Place{"Dhaka", "Dhaka", "Bangladesh", "BD", Point{23.8103, 90.4125}}, nil},
+}
@@ -0,0 +1,290 @@
+// Package ical writes iCalendar (RFC 5545) feeds of events and reads busy
+// periods from imported calendars.
+//
+// Feeds write event times as local times in one timezone, described by a
+// VTIMEZONE generated from the Go timezone database: an observance for the
+// offset in effect when the feed starts, and one for each offset change up to
+// its last event. Explicit observances rather than RRULEs keep the VTIMEZONE
+// exact for zones whose rules have changed over time.
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// localLayout formats local (and floating) date-times
+	localLayout = "20060102T150405"
+	// utcLayout formats UTC date-times
+	utcLayout = "20060102T150405Z"
+	// dateLayout formats dates
+	dateLayout = "20060102"
+
+	// maxLineOctets is the longest a content line may be before folding
+	maxLineOctets = 75
+)
+
+// Event statuses
+const (
+	StatusConfirmed = "CONFIRMED"
+	StatusTentative = "TENTATIVE"
+	StatusCancelled = "CANCELLED"
+)
+
+// Event is a VEVENT in a feed
+type Event struct {
+	// UID identifies the event across feed refreshes, so it must stay the
+	// same when the event is rescheduled
+	UID          string
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	URL          string
+	Status       string // StatusConfirmed, StatusTentative or StatusCancelled; empty omits it
+	LastModified time.Time
+}
+
+// Calendar is a feed of events. Event times are written as local times in
+// Location, or in UTC when Location is nil or UTC.
+type Calendar struct {
+	ProdID   string
+	Name     string
+	Location *time.Location
+	Events   []Event
+
+	// Stamp is when the feed was generated, written as every event's DTSTAMP
+	Stamp time.Time
+}
+
+// ContentType is the media type of encoded calendars
+const ContentType = "text/calendar; charset=utf-8"
+
+// Encode writes the calendar as an iCalendar stream
+func (c *Calendar) Encode(w io.Writer) error {
+	cw := &contentWriter{w: w}
+	loc := c.Location
+	if loc == nil || loc.String() == "UTC" {
+		loc = nil
+	}
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:" + c.ProdID)
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		cw.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if loc != nil {
+		cw.line("X-WR-TIMEZONE:" + loc.String())
+	}
+
+	if loc != nil && len(c.Events) > 0 {
+		from, to := c.Events[0].Start, c.Events[0].End
+		for _, e := range c.Events[1:] {
+			if e.Start.Before(from) {
+				from = e.Start
+			}
+			if e.End.After(to) {
+				to = e.End
+			}
+		}
+		writeTimezone(cw, loc, from, to)
+	}
+
+	stamp := c.Stamp.UTC().Format(utcLayout)
+	for _, e := range c.Events {
+		cw.line("BEGIN:VEVENT")
+		cw.line("UID:" + escapeText(e.UID))
+		cw.line("DTSTAMP:" + stamp)
+		cw.line(timeProperty("DTSTART", e.Start, loc))
+		cw.line(timeProperty("DTEND", e.End, loc))
+		if e.Summary != "" {
+			cw.line("SUMMARY:" + escapeText(e.Summary))
+		}
+		if e.Description != "" {
+			cw.line("DESCRIPTION:" + escapeText(e.Description))
+		}
+		if e.Location != "" {
+			cw.line("LOCATION:" + escapeText(e.Location))
+		}
+		if e.URL != "" {
+			cw.line("URL:" + e.URL)
+		}
+		if e.Status != "" {
+			cw.line("STATUS:" + e.Status)
+		}
+		if !e.LastModified.IsZero() {
+			cw.line("LAST-MODIFIED:" + e.LastModified.UTC().Format(utcLayout))
+		}
+		cw.line("END:VEVENT")
+	}
+
+	cw.line("END:VCALENDAR")
+	return cw.err
+}
+
+// timeProperty formats a date-time property, local to loc when it's set
+func timeProperty(name string, t time.Time, loc *time.Location) string {
+	if loc == nil {
+		return name + ":" + t.UTC().Format(utcLayout)
+	}
+	return name + ";TZID=" + paramValue(loc.String()) + ":" + t.In(loc).Format(localLayout)
+}
+
+// transition is a change in a timezone's UTC offset
+type transition struct {
+	at         time.Time
+	offsetFrom int
+	offsetTo   int
+	name       string
+	dst        bool
+}
+
+// writeTimezone writes a VTIMEZONE for loc with the observance in effect at
+// from and one for each offset change up to to
+func writeTimezone(cw *contentWriter, loc *time.Location, from, to time.Time) {
+	start := from.In(loc)
+	name, offset := start.Zone()
+
+	cw.line("BEGIN:VTIMEZONE")
+	cw.line("TZID:" + loc.String())
+	writeObservance(cw, transition{at: start, offsetFrom: offset, offsetTo: offset, name: name, dst: start.IsDST()})
+	for _, tr := range transitions(loc, from, to) {
+		writeObservance(cw, tr)
+	}
+	cw.line("END:VTIMEZONE")
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT component. Its DTSTART is the
+// onset as a local time in the offset being left.
+func writeObservance(cw *contentWriter, tr transition) {
+	kind := "STANDARD"
+	if tr.dst {
+		kind = "DAYLIGHT"
+	}
+	onset := tr.at.UTC().Add(time.Duration(tr.offsetFrom) * time.Second)
+
+	cw.line("BEGIN:" + kind)
+	cw.line("DTSTART:" + onset.Format(localLayout))
+	cw.line("TZOFFSETFROM:" + formatOffset(tr.offsetFrom))
+	cw.line("TZOFFSETTO:" + formatOffset(tr.offsetTo))
+	if tr.name != "" {
+		cw.line("TZNAME:" + escapeText(tr.name))
+	}
+	cw.line("END:" + kind)
+}
+
+// transitions returns loc's offset changes in (from, to]. Offsets are
+// sampled daily and each change is then narrowed down to the second.
+func transitions(loc *time.Location, from, to time.Time) []transition {
+	offsetAt := func(unix int64) int {
+		_, offset := time.Unix(unix, 0).In(loc).Zone()
+		return offset
+	}
+
+	var found []transition
+	current := offsetAt(from.Unix())
+	for t := from.Unix(); t < to.Unix(); {
+		next := t + 24*60*60
+		if next > to.Unix() {
+			next = to.Unix()
+		}
+		if offsetAt(next) == current {
+			t = next
+			continue
+		}
+
+		// The offset is current at lo and has changed by hi
+		lo, hi := t, next
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if offsetAt(mid) == current {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+
+		at := time.Unix(hi, 0).In(loc)
+		name, offset := at.Zone()
+		found = append(found, transition{at: at, offsetFrom: current, offsetTo: offset, name: name, dst: at.IsDST()})
+		current = offset
+		t = hi
+	}
+	return found
+}
+
+// formatOffset formats a UTC offset in seconds as ±HHMM, or ±HHMMSS when it
+// isn't a whole number of minutes
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	if seconds%60 != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+// escapeText escapes a TEXT value
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// paramValue quotes a parameter value when it contains characters that
+// aren't allowed bare
+func paramValue(s string) string {
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + strings.ReplaceAll(s, `"`, "") + `"`
+	}
+	return s
+}
+
+// contentWriter writes content lines, folding long ones, and keeps the
+// first write error
+type contentWriter struct {
+	w   io.Writer
+	err error
+}
+
+// line writes a content line terminated by CRLF, folded so no physical line
+// is longer than maxLineOctets. Folds never split a UTF-8 sequence.
+func (cw *contentWriter) line(s string) {
+	if cw.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+
+	_, cw.err = io.WriteString(cw.w, b.String())
+}
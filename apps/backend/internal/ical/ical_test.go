@@ -0,0 +1,132 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("timezone data for %s unavailable", name)
+	}
+	return loc
+}
+
+func encode(t *testing.T, c *Calendar) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := c.Encode(&buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	return buf.String()
+}
+
+func TestEncode_EventsInLocalTime(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	start := time.Date(2026, 3, 1, 19, 0, 0, 0, ny)
+
+	out := encode(t, &Calendar{
+		ProdID:   "-//Datifyy//Dates//EN",
+		Name:     "Datifyy dates",
+		Location: ny,
+		Stamp:    time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC),
+		Events: []Event{
+			{
+				UID:     "date-7@datifyy.com",
+				Start:   start,
+				End:     start.Add(90 * time.Minute),
+				Summary: "Dinner, drinks; then a walk",
+				Status:  StatusConfirmed,
+			},
+			{
+				// After the DST change on March 8
+				UID:   "date-8@datifyy.com",
+				Start: time.Date(2026, 3, 14, 19, 0, 0, 0, ny),
+				End:   time.Date(2026, 3, 14, 20, 0, 0, 0, ny),
+			},
+		},
+	})
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-TIMEZONE:America/New_York\r\n",
+		"UID:date-7@datifyy.com\r\n",
+		"DTSTAMP:20260201T120000Z\r\n",
+		"DTSTART;TZID=America/New_York:20260301T190000\r\n",
+		"DTEND;TZID=America/New_York:20260301T203000\r\n",
+		"DTSTART;TZID=America/New_York:20260314T190000\r\n",
+		`SUMMARY:Dinner\, drinks\; then a walk` + "\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q\n%s", want, out)
+		}
+	}
+
+	// Standard time from the first event, then the switch to daylight time
+	// at 02:00 local on March 8
+	vtimezone := out[strings.Index(out, "BEGIN:VTIMEZONE"):strings.Index(out, "END:VTIMEZONE")]
+	want := "BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n" +
+		"BEGIN:STANDARD\r\nDTSTART:20260301T190000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n"
+	if vtimezone != want {
+		t.Errorf("unexpected VTIMEZONE:\n%s\nwant:\n%s", vtimezone, want)
+	}
+}
+
+func TestEncode_UTCWithoutTimezone(t *testing.T) {
+	start := time.Date(2026, 6, 1, 9, 30, 0, 0, time.UTC)
+	out := encode(t, &Calendar{
+		ProdID: "-//Datifyy//Dates//EN",
+		Events: []Event{{UID: "date-1@datifyy.com", Start: start, End: start.Add(time.Hour)}},
+	})
+
+	if strings.Contains(out, "VTIMEZONE") {
+		t.Errorf("UTC feeds don't need a VTIMEZONE:\n%s", out)
+	}
+	if !strings.Contains(out, "DTSTART:20260601T093000Z\r\n") {
+		t.Errorf("expected a UTC DTSTART:\n%s", out)
+	}
+}
+
+func TestContentWriter_FoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	cw := &contentWriter{w: &buf}
+	cw.line("DESCRIPTION:" + strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected the line to be folded once, got %q", lines)
+	}
+	for i, line := range lines {
+		if len(line) > maxLineOctets {
+			t.Errorf("line %d is %d octets", i, len(line))
+		}
+		if !strings.HasPrefix(line, "DESCRIPTION:") && !strings.HasPrefix(line, " ") {
+			t.Errorf("continuation line %d must start with a space: %q", i, line)
+		}
+	}
+
+	// Unfolding gives back the original, with no split characters
+	if unfolded := lines[0] + lines[1][1:]; unfolded != "DESCRIPTION:"+strings.Repeat("é", 60) {
+		t.Errorf("unfolded to %q", unfolded)
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	for seconds, want := range map[int]string{
+		0:                     "+0000",
+		5*3600 + 30*60:        "+0530",
+		-(3*3600 + 30*60):     "-0330",
+		-(4*3600 + 56*60 + 2): "-045602",
+	} {
+		if got := formatOffset(seconds); got != want {
+			t.Errorf("formatOffset(%d) = %s, want %s", seconds, got, want)
+		}
+	}
+}
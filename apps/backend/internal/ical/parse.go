@@ -0,0 +1,484 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datifyy/backend/internal/recurrence"
+)
+
+// MaxBusyPeriods bounds how many busy periods one calendar may produce
+const MaxBusyPeriods = 2000
+
+// ErrInvalidCalendar is returned for streams that aren't iCalendar data
+var ErrInvalidCalendar = errors.New("invalid iCalendar data, expected a VCALENDAR")
+
+// Busy is a period during which a calendar's owner is busy. UID is that of
+// the component it came from; together with Start it identifies the period
+// across imports of the same calendar.
+type Busy struct {
+	UID   string
+	Start time.Time
+	End   time.Time
+}
+
+// property is a content line: NAME;PARAM=value:value
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// component is a BEGIN/END block and the properties and components in it
+type component struct {
+	name       string
+	properties []property
+	children   []*component
+}
+
+func (c *component) get(name string) (property, bool) {
+	for _, p := range c.properties {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return property{}, false
+}
+
+func (c *component) all(name string) []property {
+	var found []property
+	for _, p := range c.properties {
+		if p.name == name {
+			found = append(found, p)
+		}
+	}
+	return found
+}
+
+// ParseBusy reads the busy periods of an iCalendar stream that overlap
+// [from, to): VEVENTs that are neither transparent nor cancelled, and the
+// busy periods of VFREEBUSY components. Floating and all-day times are taken
+// to be in loc. Recurring events are expanded when their RRULE is one
+// internal/recurrence supports, and otherwise contribute only their first
+// instance. Components that can't be read are skipped, with a warning
+// saying why.
+func ParseBusy(r io.Reader, loc *time.Location, from, to time.Time) ([]Busy, []string, error) {
+	calendars, err := parseComponents(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var busy []Busy
+	var warnings []string
+	for _, cal := range calendars {
+		// Instances of recurring events overridden by their own VEVENT
+		overridden := make(map[string][]time.Time)
+		for _, child := range cal.children {
+			if child.name != "VEVENT" {
+				continue
+			}
+			if rid, ok := child.get("RECURRENCE-ID"); ok {
+				uid := propertyValue(child, "UID")
+				if t, _, err := parseTime(rid, loc); err == nil {
+					overridden[uid] = append(overridden[uid], t)
+				}
+			}
+		}
+
+		for _, child := range cal.children {
+			var periods []Busy
+			var err error
+			switch child.name {
+			case "VEVENT":
+				periods, err = eventBusy(child, loc, from, to, overridden)
+			case "VFREEBUSY":
+				periods, err = freeBusy(child, loc, from, to)
+			default:
+				continue
+			}
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s %s skipped: %v", strings.ToLower(child.name[1:]), describe(child), err))
+				continue
+			}
+			busy = append(busy, periods...)
+		}
+	}
+
+	sort.Slice(busy, func(i, j int) bool {
+		if !busy[i].Start.Equal(busy[j].Start) {
+			return busy[i].Start.Before(busy[j].Start)
+		}
+		return busy[i].UID < busy[j].UID
+	})
+	if len(busy) > MaxBusyPeriods {
+		warnings = append(warnings, fmt.Sprintf("only the first %d busy periods were imported", MaxBusyPeriods))
+		busy = busy[:MaxBusyPeriods]
+	}
+
+	return busy, warnings, nil
+}
+
+// eventBusy returns the busy periods of a VEVENT
+func eventBusy(event *component, loc *time.Location, from, to time.Time, overridden map[string][]time.Time) ([]Busy, error) {
+	if strings.EqualFold(propertyValue(event, "STATUS"), StatusCancelled) ||
+		strings.EqualFold(propertyValue(event, "TRANSP"), "TRANSPARENT") {
+		return nil, nil
+	}
+
+	dtstart, ok := event.get("DTSTART")
+	if !ok {
+		return nil, errors.New("no DTSTART")
+	}
+	start, allDay, err := parseTime(dtstart, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var length time.Duration
+	days := 0
+	if dtend, ok := event.get("DTEND"); ok {
+		end, _, err := parseTime(dtend, loc)
+		if err != nil {
+			return nil, err
+		}
+		length = end.Sub(start)
+	} else if duration, ok := event.get("DURATION"); ok {
+		if length, err = parseDuration(duration.value); err != nil {
+			return nil, err
+		}
+	} else if allDay {
+		length = 24 * time.Hour
+	}
+	if length <= 0 {
+		return nil, nil
+	}
+	if allDay {
+		// All-day events keep whole days across DST changes
+		days = int((length + 12*time.Hour) / (24 * time.Hour))
+	}
+
+	uid := propertyValue(event, "UID")
+	if uid == "" {
+		uid = "dtstart-" + strconv.FormatInt(start.Unix(), 10)
+	}
+
+	starts := []time.Time{start}
+	if rrule, ok := event.get("RRULE"); ok {
+		if _, isOverride := event.get("RECURRENCE-ID"); !isOverride {
+			rule, err := recurrence.Parse(importableRule(rrule.value))
+			if err != nil {
+				return nil, fmt.Errorf("unsupported recurrence: %w", err)
+			}
+
+			excluded := overridden[uid]
+			for _, exdate := range event.all("EXDATE") {
+				for _, value := range strings.Split(exdate.value, ",") {
+					t, _, err := parseTime(property{name: exdate.name, params: exdate.params, value: value}, loc)
+					if err == nil {
+						excluded = append(excluded, t)
+					}
+				}
+			}
+
+			// Occurrences starting before from may still run into it
+			starts = rule.Expand(start, from.Add(-length), to, excluded)
+		}
+	}
+
+	var busy []Busy
+	for _, s := range starts {
+		e := s.Add(length)
+		if days > 0 {
+			e = s.AddDate(0, 0, days)
+		}
+		if s.Before(to) && e.After(from) {
+			busy = append(busy, Busy{UID: uid, Start: s, End: e})
+		}
+	}
+	return busy, nil
+}
+
+// freeBusy returns the busy periods of a VFREEBUSY
+func freeBusy(fb *component, loc *time.Location, from, to time.Time) ([]Busy, error) {
+	uid := propertyValue(fb, "UID")
+	if uid == "" {
+		uid = "freebusy"
+	}
+
+	var busy []Busy
+	for _, p := range fb.all("FREEBUSY") {
+		if fbtype := strings.ToUpper(p.params["FBTYPE"]); fbtype == "FREE" {
+			continue
+		}
+		for _, period := range strings.Split(p.value, ",") {
+			startValue, endValue, ok := strings.Cut(period, "/")
+			if !ok {
+				return nil, fmt.Errorf("invalid period %q", period)
+			}
+			start, _, err := parseTime(property{value: startValue}, loc)
+			if err != nil {
+				return nil, err
+			}
+
+			var end time.Time
+			if strings.HasPrefix(endValue, "P") || strings.HasPrefix(endValue, "+P") {
+				length, err := parseDuration(endValue)
+				if err != nil {
+					return nil, err
+				}
+				end = start.Add(length)
+			} else if end, _, err = parseTime(property{value: endValue}, loc); err != nil {
+				return nil, err
+			}
+
+			if end.After(start) && start.Before(to) && end.After(from) {
+				busy = append(busy, Busy{UID: uid, Start: start, End: end})
+			}
+		}
+	}
+	return busy, nil
+}
+
+// importableRule drops WKST from rules it can't affect, since calendar apps
+// often send WKST=SU which internal/recurrence rejects. It only matters to
+// weekly rules with an INTERVAL over 1.
+func importableRule(rrule string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:"), ";")
+	interval := "1"
+	for _, part := range parts {
+		if name, value, _ := strings.Cut(part, "="); strings.EqualFold(name, "INTERVAL") {
+			interval = value
+		}
+	}
+	if interval != "1" {
+		return rrule
+	}
+
+	kept := parts[:0]
+	for _, part := range parts {
+		if name, _, _ := strings.Cut(part, "="); !strings.EqualFold(name, "WKST") {
+			kept = append(kept, part)
+		}
+	}
+	return strings.Join(kept, ";")
+}
+
+// parseTime parses a DATE or DATE-TIME property value. UTC times end in Z,
+// zoned ones carry a TZID naming an IANA timezone, and floating times and
+// dates are in loc. allDay reports whether the value is a date.
+func parseTime(p property, loc *time.Location) (t time.Time, allDay bool, err error) {
+	value := strings.TrimSpace(p.value)
+
+	if strings.EqualFold(p.params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err = time.ParseInLocation(dateLayout, value, loc)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q", value)
+		}
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.Parse(utcLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+		}
+		return t, false, nil
+	}
+
+	zone := loc
+	if tzid := p.params["TZID"]; tzid != "" {
+		// Globally unique TZIDs are prefixed with a solidus
+		if zone, err = time.LoadLocation(strings.TrimPrefix(tzid, "/")); err != nil || tzid == "Local" {
+			return time.Time{}, false, fmt.Errorf("unknown timezone %q", tzid)
+		}
+	}
+	t, err = time.ParseInLocation(localLayout, value, zone)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q", value)
+	}
+	return t, false, nil
+}
+
+// parseDuration parses a DURATION value such as PT1H30M, P1D or P2W
+func parseDuration(value string) (time.Duration, error) {
+	s := strings.TrimPrefix(value, "+")
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	units := map[byte]time.Duration{
+		'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour,
+		'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+	}
+	var total time.Duration
+	inTime := false
+	n := -1
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == 'T':
+			inTime = true
+		case c >= '0' && c <= '9':
+			if n < 0 {
+				n = 0
+			}
+			n = n*10 + int(c-'0')
+		default:
+			unit, ok := units[c]
+			// M is minutes only after T; months aren't allowed
+			if !ok || n < 0 || (c == 'M' && !inTime) || ((c == 'H' || c == 'S') && !inTime) {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			total += time.Duration(n) * unit
+			n = -1
+		}
+	}
+	if n >= 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	if negative {
+		total = -total
+	}
+	return total, nil
+}
+
+// parseComponents reads the VCALENDAR components of a stream
+func parseComponents(r io.Reader) ([]*component, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	// Unfold lines: a line starting with a space or tab continues the last
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+
+	var calendars []*component
+	var stack []*component
+	for _, line := range lines {
+		p, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+		}
+
+		switch p.name {
+		case "BEGIN":
+			c := &component{name: strings.ToUpper(p.value)}
+			if len(stack) == 0 {
+				if c.name != "VCALENDAR" {
+					return nil, ErrInvalidCalendar
+				}
+				calendars = append(calendars, c)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, c)
+			}
+			stack = append(stack, c)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != strings.ToUpper(p.value) {
+				return nil, fmt.Errorf("%w: unexpected END:%s", ErrInvalidCalendar, p.value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, ErrInvalidCalendar
+			}
+			top := stack[len(stack)-1]
+			top.properties = append(top.properties, p)
+		}
+	}
+
+	if len(calendars) == 0 || len(stack) > 0 {
+		return nil, ErrInvalidCalendar
+	}
+	return calendars, nil
+}
+
+// parseProperty splits a content line into its name, parameters and value
+func parseProperty(line string) (property, error) {
+	p := property{params: make(map[string]string)}
+
+	// The name and parameters end at the first colon outside quotes
+	inQuotes := false
+	split := -1
+	for i := 0; i < len(line) && split < 0; i++ {
+		switch line[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ':':
+			if !inQuotes {
+				split = i
+			}
+		}
+	}
+	if split < 0 {
+		return property{}, fmt.Errorf("malformed line %q", line)
+	}
+	p.value = line[split+1:]
+
+	parts := splitParams(line[:split])
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			return property{}, fmt.Errorf("malformed parameter %q", param)
+		}
+		p.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+	}
+	return p, nil
+}
+
+// splitParams splits NAME;PARAM=a;PARAM="b;c" on semicolons outside quotes
+func splitParams(s string) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ';':
+			if !inQuotes {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// propertyValue returns the value of a component's property, or ""
+func propertyValue(c *component, name string) string {
+	p, _ := c.get(name)
+	return p.value
+}
+
+// describe names a component for warnings by its UID or start
+func describe(c *component) string {
+	if uid := propertyValue(c, "UID"); uid != "" {
+		return strconv.Quote(uid)
+	}
+	if start := propertyValue(c, "DTSTART"); start != "" {
+		return "starting " + start
+	}
+	return "without a UID"
+}
@@ -0,0 +1,191 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseBusy(t *testing.T, ics string, loc *time.Location, from, to time.Time) ([]Busy, []string) {
+	t.Helper()
+	busy, warnings, err := ParseBusy(strings.NewReader(strings.ReplaceAll(ics, "\n", "\r\n")), loc, from, to)
+	if err != nil {
+		t.Fatalf("ParseBusy: %v", err)
+	}
+	return busy, warnings
+}
+
+func formatBusy(busy []Busy, loc *time.Location) []string {
+	out := make([]string, len(busy))
+	for i, b := range busy {
+		out[i] = b.UID + " " + b.Start.In(loc).Format("2006-01-02 15:04") + "-" + b.End.In(loc).Format("01-02 15:04")
+	}
+	return out
+}
+
+func assertBusy(t *testing.T, got []Busy, loc *time.Location, want ...string) {
+	t.Helper()
+	formatted := formatBusy(got, loc)
+	if strings.Join(formatted, "\n") != strings.Join(want, "\n") {
+		t.Errorf("busy periods:\n%s\nwant:\n%s", strings.Join(formatted, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestParseBusy_Events(t *testing.T) {
+	kolkata := mustLoad(t, "Asia/Kolkata")
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, kolkata)
+	to := from.AddDate(0, 1, 0)
+
+	busy, warnings := parseBusy(t, `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VTIMEZONE
+TZID:Asia/Kolkata
+END:VTIMEZONE
+BEGIN:VEVENT
+UID:standup
+DTSTART;TZID=Asia/Kolkata:20260504T100000
+DURATION:PT30M
+SUMMARY:Standup
+END:VEVENT
+BEGIN:VEVENT
+UID:flight
+DTSTART:20260506T033000Z
+DTEND:20260506T063000Z
+DESCRIPTION:A long description that is folded onto
+  a second line
+END:VEVENT
+BEGIN:VEVENT
+UID:holiday
+DTSTART;VALUE=DATE:20260510
+END:VEVENT
+BEGIN:VEVENT
+UID:free-time
+DTSTART:20260511T090000
+DTEND:20260511T100000
+TRANSP:TRANSPARENT
+END:VEVENT
+BEGIN:VEVENT
+UID:called-off
+DTSTART:20260512T090000
+DTEND:20260512T100000
+STATUS:CANCELLED
+END:VEVENT
+BEGIN:VEVENT
+UID:last-year
+DTSTART:20250512T090000
+DTEND:20250512T100000
+END:VEVENT
+BEGIN:VEVENT
+UID:mars
+DTSTART;TZID=Mars/Olympus:20260513T090000
+DTEND;TZID=Mars/Olympus:20260513T100000
+END:VEVENT
+END:VCALENDAR
+`, kolkata, from, to)
+
+	assertBusy(t, busy, kolkata,
+		"standup 2026-05-04 10:00-05-04 10:30",
+		"flight 2026-05-06 09:00-05-06 12:00",
+		"holiday 2026-05-10 00:00-05-11 00:00",
+	)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `"mars"`) || !strings.Contains(warnings[0], "unknown timezone") {
+		t.Errorf("expected a warning for the unknown timezone, got %q", warnings)
+	}
+}
+
+func TestParseBusy_RecurringEvents(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, ny)
+	to := time.Date(2026, 3, 22, 0, 0, 0, 0, ny)
+
+	busy, warnings := parseBusy(t, `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:gym
+DTSTART;TZID=America/New_York:20260205T180000
+DTEND;TZID=America/New_York:20260205T190000
+RRULE:FREQ=WEEKLY;BYDAY=TH;WKST=SU
+EXDATE;TZID=America/New_York:20260312T180000
+END:VEVENT
+BEGIN:VEVENT
+UID:gym
+RECURRENCE-ID;TZID=America/New_York:20260319T180000
+DTSTART;TZID=America/New_York:20260319T200000
+DTEND;TZID=America/New_York:20260319T210000
+END:VEVENT
+BEGIN:VEVENT
+UID:yearly
+DTSTART:20260310T090000Z
+DTEND:20260310T100000Z
+RRULE:FREQ=YEARLY
+END:VEVENT
+END:VCALENDAR
+`, ny, from, to)
+
+	// Weekly across the DST change, less the excluded and moved instances
+	assertBusy(t, busy, ny,
+		"gym 2026-03-05 18:00-03-05 19:00",
+		"gym 2026-03-19 20:00-03-19 21:00",
+	)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "unsupported recurrence") {
+		t.Errorf("expected a warning for the yearly rule, got %q", warnings)
+	}
+}
+
+func TestParseBusy_FreeBusy(t *testing.T) {
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	busy, _ := parseBusy(t, `BEGIN:VCALENDAR
+BEGIN:VFREEBUSY
+UID:fb-1
+DTSTART:20260701T000000Z
+DTEND:20260708T000000Z
+FREEBUSY:20260702T090000Z/20260702T120000Z,20260703T150000Z/PT2H
+FREEBUSY;FBTYPE=FREE:20260704T090000Z/20260704T120000Z
+FREEBUSY;FBTYPE=BUSY-TENTATIVE:20260705T090000Z/PT1H
+END:VFREEBUSY
+END:VCALENDAR
+`, time.UTC, from, to)
+
+	assertBusy(t, busy, time.UTC,
+		"fb-1 2026-07-02 09:00-07-02 12:00",
+		"fb-1 2026-07-03 15:00-07-03 17:00",
+		"fb-1 2026-07-05 09:00-07-05 10:00",
+	)
+}
+
+func TestParseBusy_Invalid(t *testing.T) {
+	for _, ics := range []string{
+		"",
+		"hello world",
+		"BEGIN:VEVENT\r\nEND:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		_, _, err := ParseBusy(strings.NewReader(ics), time.UTC, time.Now(), time.Now().Add(time.Hour))
+		if !errors.Is(err, ErrInvalidCalendar) {
+			t.Errorf("ParseBusy(%q) = %v, want ErrInvalidCalendar", ics, err)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"PT1H30M": 90 * time.Minute,
+		"P1D":     24 * time.Hour,
+		"P1DT12H": 36 * time.Hour,
+		"P2W":     14 * 24 * time.Hour,
+		"-PT15M":  -15 * time.Minute,
+	} {
+		got, err := parseDuration(value)
+		if err != nil || got != want {
+			t.Errorf("parseDuration(%q) = %v, %v; want %v", value, got, err, want)
+		}
+	}
+
+	for _, value := range []string{"", "P", "PT", "1H", "P1M", "PT1H30", "PTH"} {
+		if _, err := parseDuration(value); err == nil {
+			t.Errorf("parseDuration(%q) should fail", value)
+		}
+	}
+}
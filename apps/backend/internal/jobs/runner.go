@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// JobFunc is a unit of background work run on a fixed interval
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	fn       JobFunc
+}
+
+// Runner runs registered jobs periodically until its context is cancelled
+type Runner struct {
+	jobs []job
+	wg   sync.WaitGroup
+}
+
+// NewRunner creates an empty job runner
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// Every registers a job to run once at start-up and then on every interval
+func (r *Runner) Every(name string, interval time.Duration, fn JobFunc) {
+	r.jobs = append(r.jobs, job{name: name, interval: interval, fn: fn})
+}
+
+// Start launches every registered job in its own goroutine
+func (r *Runner) Start(ctx context.Context) {
+	for _, j := range r.jobs {
+		r.wg.Add(1)
+		go r.loop(ctx, j)
+	}
+	log.Printf("✓ Started %d background jobs", len(r.jobs))
+}
+
+// Wait blocks until all jobs have stopped
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+func (r *Runner) loop(ctx context.Context, j job) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		r.run(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run executes a single job iteration, recovering from panics so one bad run
+// does not stop the schedule
+func (r *Runner) run(ctx context.Context, j job) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			log.Printf("Background job %s panicked: %v", j.name, rec)
+		}
+	}()
+
+	if err := j.fn(ctx); err != nil {
+		log.Printf("Background job %s failed: %v", j.name, err)
+	}
+}
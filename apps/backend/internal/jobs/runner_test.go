@@ -0,0 +1,48 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunner_RunsJobUntilCancelled(t *testing.T) {
+	var runs int32
+	runner := NewRunner()
+	runner.Every("counter", 10*time.Millisecond, func(ctx context.Context) error {
+		atomic.AddInt32(&runs, 1)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner.Start(ctx)
+	time.Sleep(55 * time.Millisecond)
+	cancel()
+	runner.Wait()
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(2))
+}
+
+func TestRunner_SurvivesErrorsAndPanics(t *testing.T) {
+	var runs int32
+	runner := NewRunner()
+	runner.Every("flaky", 5*time.Millisecond, func(ctx context.Context) error {
+		n := atomic.AddInt32(&runs, 1)
+		if n == 1 {
+			panic("boom")
+		}
+		return errors.New("transient failure")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner.Start(ctx)
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+	runner.Wait()
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&runs), int32(2))
+}
@@ -0,0 +1,244 @@
+package kundli
+
+// Ashtakoota (Guna Milan) scores a couple's moon signs and nakshatras across
+// eight kootas worth 36 gunas in total. Several kootas are directional, so
+// charts are passed as bride and groom; callers pick an order for couples
+// where that doesn't apply.
+
+const (
+	// MaxGunas is the best possible Ashtakoota score
+	MaxGunas = 36
+	// MinAcceptableGunas is the traditional threshold for a match
+	MinAcceptableGunas = 18
+)
+
+// Match is the Ashtakoota result for a couple
+type Match struct {
+	Varna       float64 // out of 1
+	Vashya      float64 // out of 2
+	Tara        float64 // out of 3
+	Yoni        float64 // out of 4
+	GrahaMaitri float64 // out of 5
+	Gana        float64 // out of 6
+	Bhakoot     float64 // out of 7
+	Nadi        float64 // out of 8
+	Total       float64 // out of 36
+
+	// ManglikCompatible is false when exactly one partner has Mangal dosha.
+	// Dosha on both sides cancels out.
+	ManglikCompatible bool
+}
+
+// Acceptable reports whether the match meets the guna threshold with no
+// unresolved Mangal dosha
+func (m Match) Acceptable() bool {
+	return m.Total >= MinAcceptableGunas && m.ManglikCompatible
+}
+
+// Ashtakoota scores a bride's and groom's charts
+func Ashtakoota(bride, groom Chart) Match {
+	m := Match{
+		Varna:       varnaScore(bride.Moon, groom.Moon),
+		Vashya:      vashyaScore(bride.Moon, groom.Moon),
+		Tara:        taraScore(bride.Nakshatra, groom.Nakshatra),
+		Yoni:        yoniScores[nakshatraYoni[bride.Nakshatra]][nakshatraYoni[groom.Nakshatra]],
+		GrahaMaitri: grahaMaitriScore(bride.Moon, groom.Moon),
+		Gana:        ganaScores[nakshatraGana[groom.Nakshatra]][nakshatraGana[bride.Nakshatra]],
+		Bhakoot:     bhakootScore(bride.Moon, groom.Moon),
+		Nadi:        nadiScore(bride.Nakshatra, groom.Nakshatra),
+	}
+	m.Total = m.Varna + m.Vashya + m.Tara + m.Yoni + m.GrahaMaitri + m.Gana + m.Bhakoot + m.Nadi
+	m.ManglikCompatible = (bride.Manglik == NonManglik) == (groom.Manglik == NonManglik)
+	return m
+}
+
+// Varna: spiritual rank of the moon sign. Brahmin 3, Kshatriya 2, Vaishya 1, Shudra 0.
+var rashiVarna = [12]int{2, 1, 0, 3, 2, 1, 0, 3, 2, 1, 0, 3}
+
+func varnaScore(bride, groom Rashi) float64 {
+	if rashiVarna[groom] >= rashiVarna[bride] {
+		return 1
+	}
+	return 0
+}
+
+// Vashya groups: quadruped, human, water, wild, insect
+const (
+	vashyaChatushpada = iota
+	vashyaManava
+	vashyaJalachara
+	vashyaVanachara
+	vashyaKeeta
+)
+
+var rashiVashya = [12]int{
+	vashyaChatushpada, vashyaChatushpada, vashyaManava, vashyaJalachara,
+	vashyaVanachara, vashyaManava, vashyaManava, vashyaKeeta,
+	vashyaManava, vashyaJalachara, vashyaManava, vashyaJalachara,
+}
+
+// vashyaScores is indexed by the groom's group, then the bride's
+var vashyaScores = [5][5]float64{
+	{2, 1, 1, 0.5, 1},
+	{1, 2, 0.5, 0, 1},
+	{1, 0.5, 2, 1, 1},
+	{0.5, 0, 1, 2, 0},
+	{1, 1, 1, 0, 2},
+}
+
+func vashyaScore(bride, groom Rashi) float64 {
+	return vashyaScores[rashiVashya[groom]][rashiVashya[bride]]
+}
+
+// Tara: counting nakshatras from one partner to the other, remainders 3, 5
+// and 7 (mod 9) are inauspicious. Each direction is worth 1.5.
+func taraScore(bride, groom Nakshatra) float64 {
+	score := 0.0
+	if auspiciousTara(bride, groom) {
+		score += 1.5
+	}
+	if auspiciousTara(groom, bride) {
+		score += 1.5
+	}
+	return score
+}
+
+func auspiciousTara(from, to Nakshatra) bool {
+	switch ((int(to)-int(from)+27)%27 + 1) % 9 {
+	case 3, 5, 7:
+		return false
+	}
+	return true
+}
+
+// Yoni animals
+const (
+	yoniHorse = iota
+	yoniElephant
+	yoniSheep
+	yoniSerpent
+	yoniDog
+	yoniCat
+	yoniRat
+	yoniCow
+	yoniBuffalo
+	yoniTiger
+	yoniDeer
+	yoniMonkey
+	yoniMongoose
+	yoniLion
+)
+
+var nakshatraYoni = [27]int{
+	yoniHorse, yoniElephant, yoniSheep, yoniSerpent, yoniSerpent, yoniDog,
+	yoniCat, yoniSheep, yoniCat, yoniRat, yoniRat, yoniCow,
+	yoniBuffalo, yoniTiger, yoniBuffalo, yoniTiger, yoniDeer, yoniDeer,
+	yoniDog, yoniMonkey, yoniMongoose, yoniMonkey, yoniLion, yoniHorse,
+	yoniLion, yoniCow, yoniElephant,
+}
+
+// yoniScores: 4 for the same animal, 0 for sworn enemies
+var yoniScores = [14][14]float64{
+	{4, 2, 2, 3, 2, 2, 2, 1, 0, 1, 3, 3, 2, 1},
+	{2, 4, 3, 3, 2, 2, 2, 2, 3, 1, 2, 3, 2, 0},
+	{2, 3, 4, 2, 1, 2, 1, 3, 3, 1, 2, 0, 3, 1},
+	{3, 3, 2, 4, 2, 1, 1, 1, 1, 2, 2, 2, 0, 2},
+	{2, 2, 1, 2, 4, 2, 1, 2, 2, 1, 0, 2, 1, 1},
+	{2, 2, 2, 1, 2, 4, 0, 2, 2, 1, 3, 3, 2, 1},
+	{2, 2, 1, 1, 1, 0, 4, 2, 2, 2, 2, 2, 1, 2},
+	{1, 2, 3, 1, 2, 2, 2, 4, 3, 0, 3, 2, 2, 1},
+	{0, 3, 3, 1, 2, 2, 2, 3, 4, 1, 2, 2, 2, 1},
+	{1, 1, 1, 2, 1, 1, 2, 0, 1, 4, 1, 1, 2, 1},
+	{3, 2, 2, 2, 0, 3, 2, 3, 2, 1, 4, 2, 2, 1},
+	{3, 3, 0, 2, 2, 3, 2, 2, 2, 1, 2, 4, 3, 2},
+	{2, 2, 3, 0, 1, 2, 1, 2, 2, 2, 2, 3, 4, 2},
+	{1, 0, 1, 2, 1, 1, 2, 1, 1, 1, 1, 2, 2, 4},
+}
+
+// Planets ruling the rashis
+const (
+	sun = iota
+	moon
+	mars
+	mercury
+	jupiter
+	venus
+	saturn
+)
+
+var rashiLord = [12]int{mars, venus, mercury, moon, sun, mercury, venus, mars, jupiter, saturn, saturn, jupiter}
+
+// Natural planetary relationships
+const (
+	enemy = iota
+	neutral
+	friend
+)
+
+// planetRelations[a][b] is how planet a regards planet b
+var planetRelations = [7][7]int{
+	sun:     {friend, friend, friend, neutral, friend, enemy, enemy},
+	moon:    {friend, friend, neutral, friend, neutral, neutral, neutral},
+	mars:    {friend, friend, friend, enemy, friend, neutral, neutral},
+	mercury: {friend, enemy, neutral, friend, neutral, friend, neutral},
+	jupiter: {friend, friend, friend, enemy, friend, enemy, neutral},
+	venus:   {enemy, enemy, neutral, friend, neutral, friend, friend},
+	saturn:  {enemy, enemy, enemy, friend, neutral, friend, friend},
+}
+
+// grahaMaitriScores is indexed by how each lord regards the other
+var grahaMaitriScores = [3][3]float64{
+	enemy:   {enemy: 0, neutral: 0.5, friend: 1},
+	neutral: {enemy: 0.5, neutral: 3, friend: 4},
+	friend:  {enemy: 1, neutral: 4, friend: 5},
+}
+
+func grahaMaitriScore(bride, groom Rashi) float64 {
+	b, g := rashiLord[bride], rashiLord[groom]
+	if b == g {
+		return 5
+	}
+	return grahaMaitriScores[planetRelations[b][g]][planetRelations[g][b]]
+}
+
+// Ganas: temperament of the nakshatra
+const (
+	ganaDeva = iota
+	ganaManushya
+	ganaRakshasa
+)
+
+var nakshatraGana = [27]int{
+	ganaDeva, ganaManushya, ganaRakshasa, ganaManushya, ganaDeva, ganaManushya,
+	ganaDeva, ganaDeva, ganaRakshasa, ganaRakshasa, ganaManushya, ganaManushya,
+	ganaDeva, ganaRakshasa, ganaDeva, ganaRakshasa, ganaDeva, ganaRakshasa,
+	ganaRakshasa, ganaManushya, ganaManushya, ganaDeva, ganaRakshasa, ganaRakshasa,
+	ganaManushya, ganaManushya, ganaDeva,
+}
+
+// ganaScores is indexed by the groom's gana, then the bride's
+var ganaScores = [3][3]float64{
+	ganaDeva:     {ganaDeva: 6, ganaManushya: 6, ganaRakshasa: 0},
+	ganaManushya: {ganaDeva: 5, ganaManushya: 6, ganaRakshasa: 0},
+	ganaRakshasa: {ganaDeva: 1, ganaManushya: 0, ganaRakshasa: 6},
+}
+
+// Bhakoot: moon signs 2/12, 5/9 or 6/8 apart carry dosha
+func bhakootScore(bride, groom Rashi) float64 {
+	switch house(bride, groom) {
+	case 2, 12, 5, 9, 6, 8:
+		return 0
+	}
+	return 7
+}
+
+// Nadi: partners sharing a nadi (Adi, Madhya, Antya) carry dosha. Nadis
+// repeat in a six-nakshatra zigzag.
+var nadiPattern = [6]int{0, 1, 2, 2, 1, 0}
+
+func nadiScore(bride, groom Nakshatra) float64 {
+	if nadiPattern[bride%6] == nadiPattern[groom%6] {
+		return 0
+	}
+	return 8
+}
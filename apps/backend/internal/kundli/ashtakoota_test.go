@@ -0,0 +1,110 @@
+package kundli
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAshtakoota_SameChart(t *testing.T) {
+	c := Chart{Moon: 0, Nakshatra: 0}
+
+	m := Ashtakoota(c, c)
+
+	// Same nakshatra: full marks everywhere except Nadi dosha
+	if m.Nadi != 0 {
+		t.Errorf("expected Nadi dosha for the same nakshatra, got %v", m.Nadi)
+	}
+	if m.Total != 28 {
+		t.Errorf("expected 28 gunas, got %v", m.Total)
+	}
+	if !m.Acceptable() {
+		t.Error("expected 28 gunas with no Mangal dosha to be acceptable")
+	}
+}
+
+func TestAshtakoota_BoundedByMaxGunas(t *testing.T) {
+	for b := 0; b < 27; b++ {
+		for g := 0; g < 27; g++ {
+			bride := Chart{Nakshatra: Nakshatra(b), Moon: Rashi(b * 4 / 9)}
+			groom := Chart{Nakshatra: Nakshatra(g), Moon: Rashi(g * 4 / 9)}
+
+			m := Ashtakoota(bride, groom)
+			if m.Total < 0 || m.Total > MaxGunas {
+				t.Fatalf("%s/%s: total %v out of range", bride.Nakshatra, groom.Nakshatra, m.Total)
+			}
+		}
+	}
+}
+
+func TestYoniScores_SymmetricWithSwornEnemies(t *testing.T) {
+	for a := range yoniScores {
+		if yoniScores[a][a] != 4 {
+			t.Errorf("expected 4 for the same yoni %d", a)
+		}
+		for b := range yoniScores {
+			if yoniScores[a][b] != yoniScores[b][a] {
+				t.Errorf("yoni scores %d/%d not symmetric", a, b)
+			}
+		}
+	}
+
+	enemies := [][2]int{{yoniHorse, yoniBuffalo}, {yoniCat, yoniRat}, {yoniCow, yoniTiger}, {yoniSerpent, yoniMongoose}}
+	for _, pair := range enemies {
+		if yoniScores[pair[0]][pair[1]] != 0 {
+			t.Errorf("expected sworn enemies %d/%d to score 0", pair[0], pair[1])
+		}
+	}
+}
+
+func TestAshtakoota_ManglikCompatibility(t *testing.T) {
+	manglik := Chart{Manglik: Manglik}
+	anshik := Chart{Manglik: AnshikManglik}
+	non := Chart{Manglik: NonManglik}
+
+	if Ashtakoota(manglik, non).ManglikCompatible {
+		t.Error("expected Manglik and non-Manglik to be incompatible")
+	}
+	if !Ashtakoota(manglik, anshik).ManglikCompatible {
+		t.Error("expected dosha on both sides to cancel")
+	}
+	if !Ashtakoota(non, non).ManglikCompatible {
+		t.Error("expected two non-Manglik charts to be compatible")
+	}
+}
+
+func TestNewChart(t *testing.T) {
+	// 1992-04-12 05:30 IST is 0h UT, when the tropical Moon was at ~133°
+	// (sidereal ~109.4°: Karka, Ashlesha)
+	c, err := NewChart(Birth{
+		Date:      time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC),
+		Time:      "05:30",
+		Latitude:  18.5204,
+		Longitude: 73.8567,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if c.Moon.String() != "Karka" {
+		t.Errorf("expected moon in Karka, got %s", c.Moon)
+	}
+	if c.Nakshatra.String() != "Ashlesha" {
+		t.Errorf("expected Ashlesha, got %s", c.Nakshatra)
+	}
+	if c.Pada < 1 || c.Pada > 4 {
+		t.Errorf("expected pada 1-4, got %d", c.Pada)
+	}
+}
+
+func TestNewChart_InvalidInput(t *testing.T) {
+	_, err := NewChart(Birth{Date: time.Now(), Time: "25:00"})
+	if !errors.Is(err, ErrInvalidBirthTime) {
+		t.Errorf("expected ErrInvalidBirthTime, got %v", err)
+	}
+
+	_, err = NewChart(Birth{Date: time.Now(), Time: "10:00", Timezone: "Mars/Olympus"})
+	if !errors.Is(err, ErrInvalidBirthTimezone) {
+		t.Errorf("expected ErrInvalidBirthTimezone, got %v", err)
+	}
+}
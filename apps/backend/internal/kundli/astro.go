@@ -0,0 +1,164 @@
+package kundli
+
+import (
+	"math"
+	"time"
+)
+
+// Positions are computed with truncated series that are accurate to well
+// under a degree for dates within a few centuries of J2000. That's enough to
+// place the Moon in one of 27 nakshatras (13°20' each) and Mars in a sign,
+// except for births right on a boundary.
+
+// j2000 is the Julian day of 2000-01-01 12:00 TT
+const j2000 = 2451545.0
+
+// julianDay converts a time to a Julian day number
+func julianDay(t time.Time) float64 {
+	return float64(t.UTC().UnixNano())/float64(24*time.Hour) + 2440587.5
+}
+
+// julianCenturies returns the Julian centuries since J2000
+func julianCenturies(jd float64) float64 {
+	return (jd - j2000) / 36525
+}
+
+func normalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+func sinDeg(d float64) float64 { return math.Sin(d * math.Pi / 180) }
+func cosDeg(d float64) float64 { return math.Cos(d * math.Pi / 180) }
+func tanDeg(d float64) float64 { return math.Tan(d * math.Pi / 180) }
+
+func atan2Deg(y, x float64) float64 {
+	return normalizeDegrees(math.Atan2(y, x) * 180 / math.Pi)
+}
+
+// moonLongitude returns the Moon's tropical ecliptic longitude in degrees,
+// using the largest terms of the ELP-2000 series (Meeus, chapter 47)
+func moonLongitude(jd float64) float64 {
+	t := julianCenturies(jd)
+
+	l := 218.3164477 + 481267.88123421*t // mean longitude
+	d := 297.8501921 + 445267.1114034*t  // mean elongation
+	m := 357.5291092 + 35999.0502909*t   // Sun's mean anomaly
+	mp := 134.9633964 + 477198.8675055*t // Moon's mean anomaly
+	f := 93.2720950 + 483202.0175233*t   // argument of latitude
+
+	lon := l +
+		6.288774*sinDeg(mp) +
+		1.274027*sinDeg(2*d-mp) +
+		0.658314*sinDeg(2*d) +
+		0.213618*sinDeg(2*mp) -
+		0.185116*sinDeg(m) -
+		0.114332*sinDeg(2*f) +
+		0.058793*sinDeg(2*d-2*mp) +
+		0.057066*sinDeg(2*d-m-mp) +
+		0.053322*sinDeg(2*d+mp) +
+		0.045758*sinDeg(2*d-m) -
+		0.040923*sinDeg(m-mp) -
+		0.034720*sinDeg(d) -
+		0.030383*sinDeg(m+mp) +
+		0.015327*sinDeg(2*d-2*f) -
+		0.012528*sinDeg(mp+2*f) +
+		0.010980*sinDeg(mp-2*f) +
+		0.010675*sinDeg(4*d-mp) +
+		0.010034*sinDeg(3*mp) +
+		0.008548*sinDeg(4*d-2*mp)
+
+	return normalizeDegrees(lon)
+}
+
+// orbitalElements are Keplerian elements with their rates per Julian century
+// (JPL "Approximate Positions of the Planets", valid 1800-2050)
+type orbitalElements struct {
+	a, aRate             float64 // semi-major axis (au)
+	e, eRate             float64 // eccentricity
+	i, iRate             float64 // inclination (deg)
+	l, lRate             float64 // mean longitude (deg)
+	perihelion, periRate float64 // longitude of perihelion (deg)
+	node, nodeRate       float64 // longitude of ascending node (deg)
+}
+
+var (
+	earthElements = orbitalElements{
+		a: 1.00000261, aRate: 0.00000562,
+		e: 0.01671123, eRate: -0.00004392,
+		i: -0.00001531, iRate: -0.01294668,
+		l: 100.46457166, lRate: 35999.37244981,
+		perihelion: 102.93768193, periRate: 0.32327364,
+		node: 0, nodeRate: 0,
+	}
+	marsElements = orbitalElements{
+		a: 1.52371034, aRate: 0.00001847,
+		e: 0.09339410, eRate: 0.00007882,
+		i: 1.84969142, iRate: -0.00813131,
+		l: -4.55343205, lRate: 19140.30268499,
+		perihelion: -23.94362959, periRate: 0.44441088,
+		node: 49.55953891, nodeRate: -0.29257343,
+	}
+)
+
+// heliocentric returns ecliptic rectangular coordinates in au
+func (el orbitalElements) heliocentric(t float64) (x, y float64) {
+	a := el.a + el.aRate*t
+	e := el.e + el.eRate*t
+	i := el.i + el.iRate*t
+	l := el.l + el.lRate*t
+	peri := el.perihelion + el.periRate*t
+	node := el.node + el.nodeRate*t
+
+	meanAnomaly := normalizeDegrees(l-peri) * math.Pi / 180
+	omega := peri - node
+
+	// Solve Kepler's equation
+	ecc := meanAnomaly
+	for n := 0; n < 10; n++ {
+		ecc -= (ecc - e*math.Sin(ecc) - meanAnomaly) / (1 - e*math.Cos(ecc))
+	}
+
+	xp := a * (math.Cos(ecc) - e)
+	yp := a * math.Sqrt(1-e*e) * math.Sin(ecc)
+
+	x = (cosDeg(omega)*cosDeg(node)-sinDeg(omega)*sinDeg(node)*cosDeg(i))*xp +
+		(-sinDeg(omega)*cosDeg(node)-cosDeg(omega)*sinDeg(node)*cosDeg(i))*yp
+	y = (cosDeg(omega)*sinDeg(node)+sinDeg(omega)*cosDeg(node)*cosDeg(i))*xp +
+		(-sinDeg(omega)*sinDeg(node)+cosDeg(omega)*cosDeg(node)*cosDeg(i))*yp
+	return x, y
+}
+
+// marsLongitude returns Mars' geocentric tropical ecliptic longitude in degrees
+func marsLongitude(jd float64) float64 {
+	t := julianCenturies(jd)
+	mx, my := marsElements.heliocentric(t)
+	ex, ey := earthElements.heliocentric(t)
+	return atan2Deg(my-ey, mx-ex)
+}
+
+// ascendant returns the tropical longitude of the eastern horizon in degrees
+// for a birth at the given latitude and east longitude
+func ascendant(jd, lat, lng float64) float64 {
+	t := julianCenturies(jd)
+	gmst := 280.46061837 + 360.98564736629*(jd-j2000) + 0.000387933*t*t
+	lst := normalizeDegrees(gmst + lng)
+	obliquity := 23.439291 - 0.0130042*t
+
+	return atan2Deg(cosDeg(lst), -(sinDeg(lst)*cosDeg(obliquity) + tanDeg(lat)*sinDeg(obliquity)))
+}
+
+// lahiriAyanamsa returns the Lahiri (Chitrapaksha) ayanamsa in degrees, the
+// offset between tropical and sidereal longitudes used in Vedic astrology
+func lahiriAyanamsa(jd float64) float64 {
+	years := (jd - j2000) / 365.25
+	return 23.853 + years*50.2788/3600
+}
+
+// sidereal converts a tropical longitude to a sidereal one
+func sidereal(tropical, jd float64) float64 {
+	return normalizeDegrees(tropical - lahiriAyanamsa(jd))
+}
@@ -0,0 +1,47 @@
+package kundli
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// angleDiff returns the smallest difference between two angles in degrees
+func angleDiff(a, b float64) float64 {
+	d := math.Abs(normalizeDegrees(a) - normalizeDegrees(b))
+	return math.Min(d, 360-d)
+}
+
+func TestMoonLongitude(t *testing.T) {
+	// Meeus example 47.a: 1992-04-12 0h, apparent longitude 133.16°
+	jd := julianDay(time.Date(1992, 4, 12, 0, 0, 0, 0, time.UTC))
+	if jd != 2448724.5 {
+		t.Fatalf("expected JD 2448724.5, got %f", jd)
+	}
+
+	if got := moonLongitude(jd); angleDiff(got, 133.16) > 0.3 {
+		t.Errorf("expected moon longitude ~133.16, got %.2f", got)
+	}
+}
+
+func TestMarsLongitude(t *testing.T) {
+	// Mars was at opposition on 2003-08-28, opposite the Sun at ~155°
+	jd := julianDay(time.Date(2003, 8, 28, 0, 0, 0, 0, time.UTC))
+	if got := marsLongitude(jd); angleDiff(got, 335) > 2 {
+		t.Errorf("expected mars longitude ~335, got %.2f", got)
+	}
+}
+
+func TestAscendant_Equator(t *testing.T) {
+	// With 0° Aries culminating on the equator, 0° Cancer is rising
+	jd := j2000 + (360-280.46061837)/360.98564736629
+	if got := ascendant(jd, 0, 0); angleDiff(got, 90) > 0.5 {
+		t.Errorf("expected ascendant ~90, got %.2f", got)
+	}
+}
+
+func TestSidereal_AppliesAyanamsa(t *testing.T) {
+	if got := sidereal(30, j2000); angleDiff(got, 30-23.853) > 0.001 {
+		t.Errorf("expected sidereal longitude %.3f, got %.3f", 30-23.853, got)
+	}
+}
@@ -0,0 +1,176 @@
+package kundli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	// Birth timezones are resolved even where the host has no zoneinfo
+	_ "time/tzdata"
+)
+
+var (
+	ErrInvalidBirthTime     = errors.New("birth time must be HH:MM")
+	ErrInvalidBirthTimezone = errors.New("unknown birth timezone")
+)
+
+// DefaultTimezone is assumed when a birth timezone isn't given
+const DefaultTimezone = "Asia/Kolkata"
+
+// Rashi is a sidereal zodiac sign, 0 (Mesha/Aries) to 11 (Meena/Pisces)
+type Rashi int
+
+var rashiNames = [12]string{
+	"Mesha", "Vrishabha", "Mithuna", "Karka", "Simha", "Kanya",
+	"Tula", "Vrishchika", "Dhanu", "Makara", "Kumbha", "Meena",
+}
+
+func (r Rashi) String() string {
+	if r < 0 || int(r) >= len(rashiNames) {
+		return fmt.Sprintf("Rashi(%d)", int(r))
+	}
+	return rashiNames[r]
+}
+
+// Nakshatra is a lunar mansion, 0 (Ashwini) to 26 (Revati)
+type Nakshatra int
+
+var nakshatraNames = [27]string{
+	"Ashwini", "Bharani", "Krittika", "Rohini", "Mrigashira", "Ardra",
+	"Punarvasu", "Pushya", "Ashlesha", "Magha", "Purva Phalguni", "Uttara Phalguni",
+	"Hasta", "Chitra", "Swati", "Vishakha", "Anuradha", "Jyeshtha",
+	"Mula", "Purva Ashadha", "Uttara Ashadha", "Shravana", "Dhanishta", "Shatabhisha",
+	"Purva Bhadrapada", "Uttara Bhadrapada", "Revati",
+}
+
+func (n Nakshatra) String() string {
+	if n < 0 || int(n) >= len(nakshatraNames) {
+		return fmt.Sprintf("Nakshatra(%d)", int(n))
+	}
+	return nakshatraNames[n]
+}
+
+// ManglikStatus is the Mangal dosha of a chart
+type ManglikStatus int
+
+const (
+	NonManglik ManglikStatus = iota
+	// AnshikManglik charts have Mars in a dosha house counted from the Moon
+	// but not from the ascendant (partial dosha)
+	AnshikManglik
+	// Manglik charts have Mars in a dosha house counted from the ascendant
+	Manglik
+)
+
+func (m ManglikStatus) String() string {
+	switch m {
+	case Manglik:
+		return "MANGLIK"
+	case AnshikManglik:
+		return "ANSHIK_MANGLIK"
+	default:
+		return "NON_MANGLIK"
+	}
+}
+
+// manglikHouses are the houses in which Mars causes Mangal dosha
+var manglikHouses = map[int]bool{1: true, 2: true, 4: true, 7: true, 8: true, 12: true}
+
+// Birth is where and when someone was born
+type Birth struct {
+	Date      time.Time // calendar date of birth; the clock and zone are ignored
+	Time      string    // local clock time, HH:MM
+	Timezone  string    // IANA zone, DefaultTimezone when empty
+	Latitude  float64
+	Longitude float64 // east positive
+}
+
+// Instant returns the moment of birth
+func (b Birth) Instant() (time.Time, error) {
+	clock, err := ParseBirthTime(b.Time)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := LoadTimezone(b.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Date(b.Date.Year(), b.Date.Month(), b.Date.Day(), clock.Hour(), clock.Minute(), 0, 0, loc), nil
+}
+
+// ParseBirthTime parses an HH:MM birth time
+func ParseBirthTime(s string) (time.Time, error) {
+	clock, err := time.Parse("15:04", s)
+	if err != nil {
+		return time.Time{}, ErrInvalidBirthTime
+	}
+	return clock, nil
+}
+
+// LoadTimezone loads an IANA birth timezone, defaulting to DefaultTimezone
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidBirthTimezone
+	}
+	return loc, nil
+}
+
+// Chart is the part of a birth chart used for matching
+type Chart struct {
+	Moon      Rashi // moon sign (raasi)
+	Nakshatra Nakshatra
+	Pada      int   // quarter of the nakshatra, 1-4
+	Lagna     Rashi // ascendant sign
+	Mars      Rashi
+	Manglik   ManglikStatus
+}
+
+// NewChart casts the chart for a birth
+func NewChart(b Birth) (Chart, error) {
+	at, err := b.Instant()
+	if err != nil {
+		return Chart{}, err
+	}
+	return chartAt(at, b.Latitude, b.Longitude), nil
+}
+
+func chartAt(at time.Time, lat, lng float64) Chart {
+	jd := julianDay(at)
+
+	moon := sidereal(moonLongitude(jd), jd)
+	mars := sidereal(marsLongitude(jd), jd)
+	lagna := sidereal(ascendant(jd, lat, lng), jd)
+
+	const nakshatraSpan = 360.0 / 27
+	nakshatra := int(moon / nakshatraSpan)
+
+	c := Chart{
+		Moon:      Rashi(int(moon / 30)),
+		Nakshatra: Nakshatra(nakshatra),
+		Pada:      int((moon-float64(nakshatra)*nakshatraSpan)/(nakshatraSpan/4)) + 1,
+		Lagna:     Rashi(int(lagna / 30)),
+		Mars:      Rashi(int(mars / 30)),
+	}
+
+	switch {
+	case manglikHouses[house(c.Lagna, c.Mars)]:
+		c.Manglik = Manglik
+	case manglikHouses[house(c.Moon, c.Mars)]:
+		c.Manglik = AnshikManglik
+	default:
+		c.Manglik = NonManglik
+	}
+
+	return c
+}
+
+// house returns the whole-sign house of sign counted from from, 1-12
+func house(from, sign Rashi) int {
+	return (int(sign)-int(from)+12)%12 + 1
+}
@@ -0,0 +1,159 @@
+package matchrules
+
+import (
+	"strings"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumValue is a generated profile enum
+type enumValue interface {
+	comparable
+	protoreflect.Enum
+}
+
+// stated reports whether an enum value says anything: unspecified and
+// "prefer not to say" values don't
+func stated(v protoreflect.Enum) bool {
+	value := v.Descriptor().Values().ByNumber(v.Number())
+	if v.Number() == 0 || value == nil {
+		return false
+	}
+	return !strings.HasSuffix(string(value.Name()), "PREFER_NOT_TO_SAY")
+}
+
+// enumLabel renders an enum value for explanations, e.g. SMOKING_REGULARLY
+// becomes "regularly". The prefix is taken from the enum's zero value.
+func enumLabel(v protoreflect.Enum) string {
+	values := v.Descriptor().Values()
+	value := values.ByNumber(v.Number())
+	if value == nil {
+		return "unknown"
+	}
+
+	name := string(value.Name())
+	if zero := values.ByNumber(0); zero != nil {
+		name = strings.TrimPrefix(name, strings.TrimSuffix(string(zero.Name()), "UNSPECIFIED"))
+	}
+	return strings.ToLower(strings.ReplaceAll(name, "_", " "))
+}
+
+func enumLabels[E enumValue](values []E) string {
+	labels := make([]string, len(values))
+	for i, v := range values {
+		labels[i] = enumLabel(v)
+	}
+	return strings.Join(labels, ", ")
+}
+
+// statedValues drops unspecified entries from a preference list
+func statedValues[E enumValue](values []E) []E {
+	var out []E
+	for _, v := range values {
+		if stated(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func containsValue[E comparable](values []E, v E) bool {
+	for _, candidate := range values {
+		if candidate == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Value groups used by deal-breakers and must-haves
+var (
+	smokers = []userpb.SmokingHabit{
+		userpb.SmokingHabit_SMOKING_SOCIALLY,
+		userpb.SmokingHabit_SMOKING_REGULARLY,
+		userpb.SmokingHabit_SMOKING_TRYING_TO_QUIT,
+	}
+	drinkers = []userpb.DrinkingHabit{
+		userpb.DrinkingHabit_DRINKING_SOCIALLY,
+		userpb.DrinkingHabit_DRINKING_REGULARLY,
+	}
+	hasChildren = []userpb.ChildrenPreference{
+		userpb.ChildrenPreference_CHILDREN_HAVE_AND_WANT_MORE,
+		userpb.ChildrenPreference_CHILDREN_HAVE_DONT_WANT_MORE,
+	}
+	wantsNoChildren = []userpb.ChildrenPreference{
+		userpb.ChildrenPreference_CHILDREN_HAVE_DONT_WANT_MORE,
+		userpb.ChildrenPreference_CHILDREN_DONT_HAVE_DONT_WANT,
+	}
+	wantsChildren = []userpb.ChildrenPreference{
+		userpb.ChildrenPreference_CHILDREN_HAVE_AND_WANT_MORE,
+		userpb.ChildrenPreference_CHILDREN_DONT_HAVE_WANT,
+		userpb.ChildrenPreference_CHILDREN_OPEN_TO_CHILDREN,
+	}
+	vegetarianDiets = []userpb.DietaryPreference{
+		userpb.DietaryPreference_DIETARY_VEGETARIAN,
+		userpb.DietaryPreference_DIETARY_VEGAN,
+		userpb.DietaryPreference_DIETARY_JAIN,
+	}
+	nonVegetarianDiets = []userpb.DietaryPreference{
+		userpb.DietaryPreference_DIETARY_ANYTHING,
+		userpb.DietaryPreference_DIETARY_PESCATARIAN,
+		userpb.DietaryPreference_DIETARY_HALAL,
+		userpb.DietaryPreference_DIETARY_KOSHER,
+		userpb.DietaryPreference_DIETARY_KETO,
+		userpb.DietaryPreference_DIETARY_PALEO,
+	}
+	fitWorkouts = []userpb.WorkoutFrequency{
+		userpb.WorkoutFrequency_WORKOUT_OFTEN,
+		userpb.WorkoutFrequency_WORKOUT_DAILY,
+		userpb.WorkoutFrequency_WORKOUT_ATHLETE,
+	}
+	careerEmployment = []userpb.EmploymentType{
+		userpb.EmploymentType_EMPLOYMENT_TYPE_FULL_TIME,
+		userpb.EmploymentType_EMPLOYMENT_TYPE_SELF_EMPLOYED,
+		userpb.EmploymentType_EMPLOYMENT_TYPE_ENTREPRENEUR,
+		userpb.EmploymentType_EMPLOYMENT_TYPE_FREELANCER,
+	}
+	notEarning = []userpb.EmploymentType{
+		userpb.EmploymentType_EMPLOYMENT_TYPE_UNEMPLOYED,
+		userpb.EmploymentType_EMPLOYMENT_TYPE_STUDENT,
+		userpb.EmploymentType_EMPLOYMENT_TYPE_INTERN,
+	}
+	// degrees are the education levels that count as a college degree
+	degrees = []userpb.EducationLevel{
+		userpb.EducationLevel_EDUCATION_LEVEL_ASSOCIATE_DEGREE,
+		userpb.EducationLevel_EDUCATION_LEVEL_BACHELORS,
+		userpb.EducationLevel_EDUCATION_LEVEL_MASTERS,
+		userpb.EducationLevel_EDUCATION_LEVEL_MBA,
+		userpb.EducationLevel_EDUCATION_LEVEL_PHD,
+		userpb.EducationLevel_EDUCATION_LEVEL_PROFESSIONAL_DEGREE,
+	}
+	// graduateLevels are bachelor's and above
+	graduateLevels = degrees[1:]
+)
+
+// educationLevels returns every education level on a profile
+func educationLevels(p *userpb.UserProfile) []userpb.EducationLevel {
+	var levels []userpb.EducationLevel
+	if highest := p.GetProfessionalInfo().GetHighestEducation(); stated(highest) {
+		levels = append(levels, highest)
+	}
+	for _, e := range p.GetProfileDetails().GetEducation() {
+		if stated(e.GetLevel()) {
+			levels = append(levels, e.GetLevel())
+		}
+	}
+	return levels
+}
+
+// occupationCategories returns every occupation category on a profile
+func occupationCategories(p *userpb.UserProfile) []userpb.OccupationCategory {
+	var categories []userpb.OccupationCategory
+	for _, o := range p.GetProfileDetails().GetOccupations() {
+		if stated(o.GetCategory()) {
+			categories = append(categories, o.GetCategory())
+		}
+	}
+	return categories
+}
@@ -0,0 +1,194 @@
+// Package matchrules compiles a user's partner preferences into predicates
+// over another user's profile. Deal-breakers compile to hard rules that
+// exclude a candidate when they fail; must-haves and the *_preferences lists
+// compile to soft rules that only affect the preference score.
+//
+// Rules that need something the candidate hasn't shared (or that profiles
+// don't capture) are reported as unknown and never exclude anyone.
+package matchrules
+
+import (
+	userpb "github.com/datifyy/backend/gen/user/v1"
+)
+
+const (
+	// preferenceWeight is the weight of rules compiled from *_preferences lists
+	preferenceWeight = 1
+	// defaultMustHaveWeight is used for must-haves without a priority
+	defaultMustHaveWeight = 3
+)
+
+// Result is the outcome of one rule against a candidate
+type Result struct {
+	Rule        string // stable ID, e.g. "dealbreaker.smoking" or "preference.drinking"
+	Hard        bool
+	Passed      bool
+	Known       bool // false when the candidate's profile doesn't say; unknown rules pass
+	Weight      int  // 1-5, used to weight soft rules in the score
+	Explanation string
+}
+
+// Evaluation is the outcome of a rule set against a candidate
+type Evaluation struct {
+	Results []Result
+}
+
+// Passed reports whether every hard rule passed
+func (e Evaluation) Passed() bool {
+	for _, r := range e.Results {
+		if r.Hard && !r.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the hard rules that failed
+func (e Evaluation) Failed() []Result {
+	var failed []Result
+	for _, r := range e.Results {
+		if r.Hard && !r.Passed {
+			failed = append(failed, r)
+		}
+	}
+	return failed
+}
+
+// Score returns the weighted share (0-100) of known soft rules that passed.
+// ok is false when no soft rule could be evaluated.
+func (e Evaluation) Score() (score int, ok bool) {
+	var passed, total int
+	for _, r := range e.Results {
+		if r.Hard || !r.Known {
+			continue
+		}
+		total += r.Weight
+		if r.Passed {
+			passed += r.Weight
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	return passed * 100 / total, true
+}
+
+// outcome is what a rule's check decides about a candidate
+type outcome struct {
+	passed      bool
+	known       bool
+	explanation string
+}
+
+func passed(explanation string) outcome {
+	return outcome{passed: true, known: true, explanation: explanation}
+}
+
+func failed(explanation string) outcome {
+	return outcome{known: true, explanation: explanation}
+}
+
+// unknown rules pass, so missing information never excludes anyone
+func unknown(explanation string) outcome {
+	return outcome{passed: true, explanation: explanation}
+}
+
+func check(ok bool, pass, fail string) outcome {
+	if ok {
+		return passed(pass)
+	}
+	return failed(fail)
+}
+
+type rule struct {
+	id     string
+	hard   bool
+	weight int
+	check  func(candidate *userpb.UserProfile) outcome
+}
+
+// RuleSet is a user's compiled partner preferences
+type RuleSet struct {
+	rules []rule
+}
+
+// Len returns the number of compiled rules
+func (rs RuleSet) Len() int {
+	return len(rs.rules)
+}
+
+// HasHardRules reports whether any rule can exclude a candidate
+func (rs RuleSet) HasHardRules() bool {
+	for _, r := range rs.rules {
+		if r.hard {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate checks every rule against a candidate's profile. The profile
+// should be evaluated before privacy redaction, with DistanceKm set to its
+// distance from the owner (0 when unknown).
+func (rs RuleSet) Evaluate(candidate *userpb.UserProfile) Evaluation {
+	results := make([]Result, len(rs.rules))
+	for i, r := range rs.rules {
+		o := r.check(candidate)
+		results[i] = Result{
+			Rule:        r.id,
+			Hard:        r.hard,
+			Passed:      o.passed,
+			Known:       o.known,
+			Weight:      r.weight,
+			Explanation: o.explanation,
+		}
+	}
+	return Evaluation{Results: results}
+}
+
+// Compile turns the owner's partner preferences into a rule set. The owner's
+// own profile is needed for relative rules such as "different religion".
+func Compile(prefs *userpb.PartnerPreferences, owner *userpb.UserProfile) RuleSet {
+	var rs RuleSet
+	if prefs == nil {
+		return rs
+	}
+
+	seen := make(map[string]bool)
+	add := func(r rule) {
+		// Duplicate entries (e.g. the same deal-breaker twice) are checked once
+		if seen[r.id] {
+			return
+		}
+		seen[r.id] = true
+		rs.rules = append(rs.rules, r)
+	}
+
+	for _, db := range prefs.DealBreakers {
+		if r, ok := dealBreakerRule(db, prefs, owner); ok {
+			add(r)
+		}
+	}
+	for _, mh := range prefs.MustHaves {
+		if r, ok := mustHaveRule(mh, owner); ok {
+			add(r)
+		}
+	}
+	for _, r := range preferenceRules(prefs) {
+		add(r)
+	}
+
+	return rs
+}
+
+// priorityWeight clamps a deal-breaker or must-have priority to 1-5
+func priorityWeight(priority int32) int {
+	switch {
+	case priority <= 0:
+		return defaultMustHaveWeight
+	case priority > 5:
+		return 5
+	default:
+		return int(priority)
+	}
+}
@@ -0,0 +1,156 @@
+package matchrules
+
+import (
+	"testing"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+)
+
+func profileWith(lifestyle *userpb.LifestyleInfo) *userpb.UserProfile {
+	return &userpb.UserProfile{
+		BasicInfo:     &userpb.BasicInfo{Age: 30},
+		LifestyleInfo: lifestyle,
+	}
+}
+
+func findResult(t *testing.T, e Evaluation, id string) Result {
+	t.Helper()
+	for _, r := range e.Results {
+		if r.Rule == id {
+			return r
+		}
+	}
+	t.Fatalf("no result for rule %s", id)
+	return Result{}
+}
+
+func TestCompile_DealBreakerExcludes(t *testing.T) {
+	rs := Compile(&userpb.PartnerPreferences{
+		DealBreakers: []*userpb.DealBreaker{{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING}},
+	}, nil)
+
+	smoker := rs.Evaluate(profileWith(&userpb.LifestyleInfo{Smoking: userpb.SmokingHabit_SMOKING_REGULARLY}))
+	if smoker.Passed() {
+		t.Fatal("expected a regular smoker to fail the smoking deal-breaker")
+	}
+	failed := smoker.Failed()
+	if len(failed) != 1 || failed[0].Rule != "dealbreaker.smoking" || failed[0].Explanation != "smokes (regularly)" {
+		t.Errorf("unexpected failed rules: %+v", failed)
+	}
+
+	nonSmoker := rs.Evaluate(profileWith(&userpb.LifestyleInfo{Smoking: userpb.SmokingHabit_SMOKING_NEVER}))
+	if !nonSmoker.Passed() {
+		t.Error("expected a non-smoker to pass the smoking deal-breaker")
+	}
+}
+
+func TestCompile_UnknownNeverExcludes(t *testing.T) {
+	rs := Compile(&userpb.PartnerPreferences{
+		DealBreakers: []*userpb.DealBreaker{
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_DRINKING},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_DRUGS},
+		},
+	}, nil)
+
+	e := rs.Evaluate(profileWith(&userpb.LifestyleInfo{Drinking: userpb.DrinkingHabit_DRINKING_PREFER_NOT_TO_SAY}))
+
+	if !e.Passed() {
+		t.Fatal("expected unstated and uncaptured deal-breakers to pass")
+	}
+	for _, r := range e.Results {
+		if r.Known {
+			t.Errorf("expected %s to be unknown", r.Rule)
+		}
+	}
+}
+
+func TestCompile_RelativeToOwner(t *testing.T) {
+	owner := profileWith(&userpb.LifestyleInfo{
+		Religion:          userpb.Religion_RELIGION_HINDU,
+		DietaryPreference: userpb.DietaryPreference_DIETARY_VEGETARIAN,
+	})
+	rs := Compile(&userpb.PartnerPreferences{
+		DealBreakers: []*userpb.DealBreaker{
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_DIFFERENT_RELIGION},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_VEGETARIAN_NON_VEG},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_AGE_GAP},
+		},
+	}, owner)
+
+	candidate := profileWith(&userpb.LifestyleInfo{
+		Religion:          userpb.Religion_RELIGION_HINDU,
+		DietaryPreference: userpb.DietaryPreference_DIETARY_ANYTHING,
+	})
+	candidate.BasicInfo.Age = 45
+	e := rs.Evaluate(candidate)
+
+	if !findResult(t, e, "dealbreaker.different_religion").Passed {
+		t.Error("expected the same religion to pass")
+	}
+	if findResult(t, e, "dealbreaker.vegetarian_non_veg").Passed {
+		t.Error("expected a non-vegetarian to fail a vegetarian's diet deal-breaker")
+	}
+	if r := findResult(t, e, "dealbreaker.age_gap"); r.Passed || r.Explanation != "15 years apart, more than 10" {
+		t.Errorf("unexpected age gap result: %+v", r)
+	}
+}
+
+func TestEvaluation_ScoreWeighsSoftRules(t *testing.T) {
+	rs := Compile(&userpb.PartnerPreferences{
+		MustHaves: []*userpb.MustHave{
+			{Type: userpb.MustHaveType_MUSTHAVE_TYPE_PHYSICALLY_FIT, Priority: 3},
+		},
+		DrinkingPreferences: []userpb.DrinkingHabit{userpb.DrinkingHabit_DRINKING_NEVER},
+		PetPreferences:      []userpb.PetPreference{userpb.PetPreference_PET_DOG_LOVER},
+	}, nil)
+
+	// Fit (3 of 3) but drinks (0 of 1); pets aren't stated so don't count
+	e := rs.Evaluate(profileWith(&userpb.LifestyleInfo{
+		Workout:  userpb.WorkoutFrequency_WORKOUT_DAILY,
+		Drinking: userpb.DrinkingHabit_DRINKING_SOCIALLY,
+	}))
+
+	score, ok := e.Score()
+	if !ok || score != 75 {
+		t.Errorf("expected a score of 75, got %d (ok=%v)", score, ok)
+	}
+	if !e.Passed() {
+		t.Error("expected soft rules never to exclude a candidate")
+	}
+}
+
+func TestEvaluation_NoKnownSoftRules(t *testing.T) {
+	rs := Compile(&userpb.PartnerPreferences{
+		DrinkingPreferences: []userpb.DrinkingHabit{userpb.DrinkingHabit_DRINKING_NEVER},
+	}, nil)
+
+	if _, ok := rs.Evaluate(profileWith(nil)).Score(); ok {
+		t.Error("expected no score when no soft rule could be checked")
+	}
+}
+
+func TestCompile_DeduplicatesRules(t *testing.T) {
+	rs := Compile(&userpb.PartnerPreferences{
+		DealBreakers: []*userpb.DealBreaker{
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING, Priority: 5},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_UNSPECIFIED},
+		},
+	}, nil)
+
+	if rs.Len() != 1 || !rs.HasHardRules() {
+		t.Errorf("expected one hard rule, got %d", rs.Len())
+	}
+}
+
+func TestEnumLabel(t *testing.T) {
+	if got := enumLabel(userpb.SmokingHabit_SMOKING_TRYING_TO_QUIT); got != "trying to quit" {
+		t.Errorf("expected %q, got %q", "trying to quit", got)
+	}
+	if got := enumLabel(userpb.DealBreakerType_DEALBREAKER_TYPE_VEGETARIAN_NON_VEG); got != "vegetarian non veg" {
+		t.Errorf("expected %q, got %q", "vegetarian non veg", got)
+	}
+	if got := enumLabel(userpb.EducationLevel_EDUCATION_LEVEL_MBA); got != "mba" {
+		t.Errorf("expected %q, got %q", "mba", got)
+	}
+}
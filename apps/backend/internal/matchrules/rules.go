@@ -0,0 +1,415 @@
+package matchrules
+
+import (
+	"fmt"
+	"strings"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// defaultLongDistanceKm is how far away counts as long distance for users
+	// without a distance preference
+	defaultLongDistanceKm = 100
+	// defaultMaxAgeGap is the age gap deal-breaker for users without an age range
+	defaultMaxAgeGap = 10
+)
+
+func ruleID(kind string, v protoreflect.Enum) string {
+	return kind + "." + strings.ReplaceAll(enumLabel(v), " ", "_")
+}
+
+// notCaptured is the check for requirements profiles don't record
+func notCaptured(what string) func(*userpb.UserProfile) outcome {
+	return func(*userpb.UserProfile) outcome {
+		return unknown(what + " isn't captured on profiles; review manually")
+	}
+}
+
+// dealBreakerRule compiles a deal-breaker into a hard rule
+func dealBreakerRule(db *userpb.DealBreaker, prefs *userpb.PartnerPreferences, owner *userpb.UserProfile) (rule, bool) {
+	if !stated(db.GetType()) {
+		return rule{}, false
+	}
+
+	r := rule{
+		id:     ruleID("dealbreaker", db.GetType()),
+		hard:   true,
+		weight: priorityWeight(db.GetPriority()),
+	}
+
+	switch db.GetType() {
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetSmoking()
+			if !stated(v) {
+				return unknown("smoking habit not stated")
+			}
+			return check(!containsValue(smokers, v), "doesn't smoke", "smokes ("+enumLabel(v)+")")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DRINKING:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetDrinking()
+			if !stated(v) {
+				return unknown("drinking habit not stated")
+			}
+			return check(!containsValue(drinkers, v), "drinks "+enumLabel(v), "drinks "+enumLabel(v))
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_HAS_CHILDREN:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetChildren()
+			if !stated(v) {
+				return unknown("children not stated")
+			}
+			return check(!containsValue(hasChildren, v), "has no children", "has children")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DOESNT_WANT_CHILDREN:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetChildren()
+			if !stated(v) {
+				return unknown("children not stated")
+			}
+			return check(!containsValue(wantsNoChildren, v), "open to children", "doesn't want (more) children")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DIFFERENT_RELIGION:
+		r.check = sameValue("religion", owner.GetLifestyleInfo().GetReligion(), func(c *userpb.UserProfile) userpb.Religion {
+			return c.GetLifestyleInfo().GetReligion()
+		})
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DIFFERENT_POLITICAL_VIEW:
+		r.check = sameValue("political view", owner.GetLifestyleInfo().GetPoliticalView(), func(c *userpb.UserProfile) userpb.PoliticalView {
+			return c.GetLifestyleInfo().GetPoliticalView()
+		})
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_LONG_DISTANCE:
+		maxKm := int32(defaultLongDistanceKm)
+		if prefs.GetDistancePreference() > 0 {
+			maxKm = prefs.GetDistancePreference()
+		}
+		r.check = func(c *userpb.UserProfile) outcome {
+			if c.GetDistanceKm() == 0 {
+				return unknown("distance unknown")
+			}
+			return check(c.GetDistanceKm() <= maxKm,
+				fmt.Sprintf("about %d km away", c.GetDistanceKm()),
+				fmt.Sprintf("about %d km away, more than %d km", c.GetDistanceKm(), maxKm))
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_NOT_EDUCATED:
+		r.check = func(c *userpb.UserProfile) outcome {
+			levels := educationLevels(c)
+			if len(levels) == 0 {
+				return unknown("education not stated")
+			}
+			for _, l := range levels {
+				if containsValue(degrees, l) {
+					return passed("has a degree (" + enumLabel(l) + ")")
+				}
+			}
+			return failed("no college degree (" + enumLabels(levels) + ")")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_UNEMPLOYED:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetProfessionalInfo().GetEmploymentType()
+			if !stated(v) {
+				return unknown("employment not stated")
+			}
+			return check(v != userpb.EmploymentType_EMPLOYMENT_TYPE_UNEMPLOYED, enumLabel(v), "unemployed")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_LIVING_WITH_PARENTS:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetFamilyInfo().GetLivingSituation()
+			if !stated(v) {
+				return unknown("living situation not stated")
+			}
+			return check(v != userpb.LivingSituation_LIVING_SITUATION_WITH_PARENTS, "lives "+enumLabel(v), "lives with parents")
+		}
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_AGE_GAP:
+		r.check = ageGapCheck(prefs, owner)
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_HEIGHT:
+		r.check = heightCheck(prefs)
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_VEGETARIAN_NON_VEG:
+		r.check = dietCheck(owner.GetLifestyleInfo().GetDietaryPreference())
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DRUGS:
+		r.check = notCaptured("drug use")
+	case userpb.DealBreakerType_DEALBREAKER_TYPE_DIVORCED:
+		r.check = notCaptured("marital history")
+	default:
+		what := strings.TrimSpace(db.GetDescription())
+		if what == "" {
+			what = "this deal-breaker"
+		}
+		r.check = notCaptured(fmt.Sprintf("%q", what))
+	}
+
+	return r, true
+}
+
+// mustHaveRule compiles a must-have into a soft rule weighted by its priority
+func mustHaveRule(mh *userpb.MustHave, owner *userpb.UserProfile) (rule, bool) {
+	if !stated(mh.GetType()) {
+		return rule{}, false
+	}
+
+	r := rule{
+		id:     ruleID("musthave", mh.GetType()),
+		weight: priorityWeight(mh.GetPriority()),
+	}
+
+	switch mh.GetType() {
+	case userpb.MustHaveType_MUSTHAVE_TYPE_FAMILY_ORIENTED:
+		r.check = func(c *userpb.UserProfile) outcome {
+			goals := c.GetProfileDetails().GetRelationshipGoals()
+			if len(goals) == 0 {
+				return unknown("relationship goals not stated")
+			}
+			return check(containsValue(goals, userpb.RelationshipGoal_RELATIONSHIP_GOAL_MARRIAGE),
+				"looking for marriage", "not looking for marriage ("+enumLabels(goals)+")")
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_FINANCIALLY_STABLE:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetProfessionalInfo().GetEmploymentType()
+			if !stated(v) {
+				return unknown("employment not stated")
+			}
+			return check(!containsValue(notEarning, v), enumLabel(v), "not earning ("+enumLabel(v)+")")
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_PHYSICALLY_FIT:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetWorkout()
+			if !stated(v) {
+				return unknown("workout frequency not stated")
+			}
+			return check(containsValue(fitWorkouts, v), "works out "+enumLabel(v), "works out "+enumLabel(v))
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_EDUCATED:
+		r.check = func(c *userpb.UserProfile) outcome {
+			levels := educationLevels(c)
+			if len(levels) == 0 {
+				return unknown("education not stated")
+			}
+			for _, l := range levels {
+				if containsValue(graduateLevels, l) {
+					return passed("graduate (" + enumLabel(l) + ")")
+				}
+			}
+			return failed("not a graduate (" + enumLabels(levels) + ")")
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_CAREER_FOCUSED:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetProfessionalInfo().GetEmploymentType()
+			if !stated(v) {
+				return unknown("employment not stated")
+			}
+			return check(containsValue(careerEmployment, v), enumLabel(v), enumLabel(v))
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_WANTS_CHILDREN:
+		r.check = func(c *userpb.UserProfile) outcome {
+			v := c.GetLifestyleInfo().GetChildren()
+			if !stated(v) {
+				return unknown("children not stated")
+			}
+			return check(containsValue(wantsChildren, v), "wants children", "children: "+enumLabel(v))
+		}
+	case userpb.MustHaveType_MUSTHAVE_TYPE_SAME_RELIGION:
+		r.check = sameValue("religion", owner.GetLifestyleInfo().GetReligion(), func(c *userpb.UserProfile) userpb.Religion {
+			return c.GetLifestyleInfo().GetReligion()
+		})
+	default:
+		// Ambition, humour, values, communication and the like are judged by
+		// people (and the AI analysis), not rules
+		what := strings.TrimSpace(mh.GetDescription())
+		if what == "" {
+			what = enumLabel(mh.GetType())
+		}
+		r.check = notCaptured(fmt.Sprintf("%q", what))
+	}
+
+	return r, true
+}
+
+// preferenceRules compiles the *_preferences lists into soft rules
+func preferenceRules(prefs *userpb.PartnerPreferences) []rule {
+	var rules []rule
+	add := func(r rule, ok bool) {
+		if ok {
+			rules = append(rules, r)
+		}
+	}
+
+	add(anyOfRule("preference.relationship_goals", "relationship goals", prefs.GetRelationshipGoals(),
+		func(c *userpb.UserProfile) []userpb.RelationshipGoal {
+			return c.GetProfileDetails().GetRelationshipGoals()
+		}))
+	add(anyOfRule("preference.education_levels", "education", prefs.GetEducationLevels(), educationLevels))
+	add(anyOfRule("preference.occupations", "occupation", prefs.GetOccupations(), occupationCategories))
+	add(oneOfRule("preference.religions", "religion", prefs.GetReligions(),
+		func(c *userpb.UserProfile) userpb.Religion { return c.GetLifestyleInfo().GetReligion() }))
+	add(oneOfRule("preference.children", "children", prefs.GetChildrenPreferences(),
+		func(c *userpb.UserProfile) userpb.ChildrenPreference { return c.GetLifestyleInfo().GetChildren() }))
+	add(oneOfRule("preference.drinking", "drinking", prefs.GetDrinkingPreferences(),
+		func(c *userpb.UserProfile) userpb.DrinkingHabit { return c.GetLifestyleInfo().GetDrinking() }))
+	add(oneOfRule("preference.smoking", "smoking", prefs.GetSmokingPreferences(),
+		func(c *userpb.UserProfile) userpb.SmokingHabit { return c.GetLifestyleInfo().GetSmoking() }))
+	add(oneOfRule("preference.diet", "diet", prefs.GetDietaryPreferences(),
+		func(c *userpb.UserProfile) userpb.DietaryPreference {
+			return c.GetLifestyleInfo().GetDietaryPreference()
+		}))
+	add(oneOfRule("preference.pets", "pets", prefs.GetPetPreferences(),
+		func(c *userpb.UserProfile) userpb.PetPreference { return c.GetLifestyleInfo().GetPets() }))
+	add(oneOfRule("preference.workout", "workout", prefs.GetWorkoutPreferences(),
+		func(c *userpb.UserProfile) userpb.WorkoutFrequency { return c.GetLifestyleInfo().GetWorkout() }))
+	add(oneOfRule("preference.political_views", "political view", prefs.GetPoliticalViews(),
+		func(c *userpb.UserProfile) userpb.PoliticalView { return c.GetLifestyleInfo().GetPoliticalView() }))
+	add(oneOfRule("preference.sleep_schedules", "sleep schedule", prefs.GetSleepSchedules(),
+		func(c *userpb.UserProfile) userpb.SleepSchedule { return c.GetLifestyleInfo().GetSleepSchedule() }))
+	add(oneOfRule("preference.communication_styles", "communication style", prefs.GetCommunicationStyles(),
+		func(c *userpb.UserProfile) userpb.CommunicationStyle {
+			return c.GetLifestyleInfo().GetCommunicationStyle()
+		}))
+	add(oneOfRule("preference.love_languages", "love language", prefs.GetLoveLanguages(),
+		func(c *userpb.UserProfile) userpb.LoveLanguage { return c.GetLifestyleInfo().GetLoveLanguage() }))
+	add(personalityRule(prefs.GetPersonalityTypes()))
+
+	if prefs.GetHeightRange().GetMinHeight() > 0 || prefs.GetHeightRange().GetMaxHeight() > 0 {
+		rules = append(rules, rule{id: "preference.height", weight: preferenceWeight, check: heightCheck(prefs)})
+	}
+
+	return rules
+}
+
+// oneOfRule checks that a single-valued profile field is one of the preferred values
+func oneOfRule[E enumValue](id, what string, preferred []E, get func(*userpb.UserProfile) E) (rule, bool) {
+	preferred = statedValues(preferred)
+	if len(preferred) == 0 {
+		return rule{}, false
+	}
+
+	return rule{id: id, weight: preferenceWeight, check: func(c *userpb.UserProfile) outcome {
+		v := get(c)
+		if !stated(v) {
+			return unknown(what + " not stated")
+		}
+		return check(containsValue(preferred, v),
+			what+" is "+enumLabel(v),
+			what+" is "+enumLabel(v)+", not "+enumLabels(preferred))
+	}}, true
+}
+
+// anyOfRule checks that a multi-valued profile field shares a preferred value
+func anyOfRule[E enumValue](id, what string, preferred []E, get func(*userpb.UserProfile) []E) (rule, bool) {
+	preferred = statedValues(preferred)
+	if len(preferred) == 0 {
+		return rule{}, false
+	}
+
+	return rule{id: id, weight: preferenceWeight, check: func(c *userpb.UserProfile) outcome {
+		values := statedValues(get(c))
+		if len(values) == 0 {
+			return unknown(what + " not stated")
+		}
+		for _, v := range values {
+			if containsValue(preferred, v) {
+				return passed(what + " includes " + enumLabel(v))
+			}
+		}
+		return failed(what + " is " + enumLabels(values) + ", not " + enumLabels(preferred))
+	}}, true
+}
+
+func personalityRule(preferred []string) (rule, bool) {
+	var types []string
+	for _, t := range preferred {
+		if t = strings.ToUpper(strings.TrimSpace(t)); t != "" {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return rule{}, false
+	}
+
+	return rule{id: "preference.personality_types", weight: preferenceWeight, check: func(c *userpb.UserProfile) outcome {
+		t := strings.ToUpper(strings.TrimSpace(c.GetLifestyleInfo().GetPersonalityType()))
+		if t == "" {
+			return unknown("personality type not stated")
+		}
+		return check(containsValue(types, t), "personality type is "+t,
+			"personality type is "+t+", not "+strings.Join(types, ", "))
+	}}, true
+}
+
+// sameValue checks that the candidate shares the owner's value
+func sameValue[E enumValue](what string, own E, get func(*userpb.UserProfile) E) func(*userpb.UserProfile) outcome {
+	return func(c *userpb.UserProfile) outcome {
+		if !stated(own) {
+			return unknown("your " + what + " isn't set")
+		}
+		v := get(c)
+		if !stated(v) {
+			return unknown(what + " not stated")
+		}
+		return check(v == own, "same "+what+" ("+enumLabel(v)+")",
+			"different "+what+" ("+enumLabel(v)+" vs "+enumLabel(own)+")")
+	}
+}
+
+func ageGapCheck(prefs *userpb.PartnerPreferences, owner *userpb.UserProfile) func(*userpb.UserProfile) outcome {
+	minAge, maxAge := prefs.GetAgeRange().GetMinAge(), prefs.GetAgeRange().GetMaxAge()
+	ownAge := owner.GetBasicInfo().GetAge()
+
+	return func(c *userpb.UserProfile) outcome {
+		age := c.GetBasicInfo().GetAge()
+		if age == 0 {
+			return unknown("age not shared")
+		}
+
+		// The preferred age range is the acceptable gap when there is one
+		if minAge > 0 || maxAge > 0 {
+			ok := (minAge == 0 || age >= minAge) && (maxAge == 0 || age <= maxAge)
+			return check(ok, fmt.Sprintf("age %d", age),
+				fmt.Sprintf("age %d is outside %d-%d", age, minAge, maxAge))
+		}
+
+		if ownAge == 0 {
+			return unknown("your age isn't set")
+		}
+		gap := age - ownAge
+		if gap < 0 {
+			gap = -gap
+		}
+		return check(gap <= defaultMaxAgeGap, fmt.Sprintf("%d years apart", gap),
+			fmt.Sprintf("%d years apart, more than %d", gap, defaultMaxAgeGap))
+	}
+}
+
+func heightCheck(prefs *userpb.PartnerPreferences) func(*userpb.UserProfile) outcome {
+	minHeight, maxHeight := prefs.GetHeightRange().GetMinHeight(), prefs.GetHeightRange().GetMaxHeight()
+
+	return func(c *userpb.UserProfile) outcome {
+		if minHeight == 0 && maxHeight == 0 {
+			return unknown("no preferred height range set")
+		}
+		height := c.GetProfileDetails().GetHeight()
+		if height == 0 {
+			return unknown("height not stated")
+		}
+		ok := (minHeight == 0 || height >= minHeight) && (maxHeight == 0 || height <= maxHeight)
+		return check(ok, fmt.Sprintf("%d cm", height),
+			fmt.Sprintf("%d cm is outside %d-%d cm", height, minHeight, maxHeight))
+	}
+}
+
+// dietCheck fails when exactly one of the two is vegetarian
+func dietCheck(own userpb.DietaryPreference) func(*userpb.UserProfile) outcome {
+	return func(c *userpb.UserProfile) outcome {
+		v := c.GetLifestyleInfo().GetDietaryPreference()
+		ownVeg, ownNonVeg := containsValue(vegetarianDiets, own), containsValue(nonVegetarianDiets, own)
+		veg, nonVeg := containsValue(vegetarianDiets, v), containsValue(nonVegetarianDiets, v)
+
+		switch {
+		case !ownVeg && !ownNonVeg:
+			return unknown("your diet isn't set")
+		case !veg && !nonVeg:
+			return unknown("diet not stated")
+		}
+		return check(ownVeg == veg, "diet is "+enumLabel(v), "diet is "+enumLabel(v)+", yours is "+enumLabel(own))
+	}
+}
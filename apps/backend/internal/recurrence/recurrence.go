@@ -0,0 +1,355 @@
+// Package recurrence parses and expands the subset of RFC 5545 recurrence
+// rules used for recurring availability:
+//
+//	FREQ=DAILY|WEEKLY|MONTHLY
+//	INTERVAL=n
+//	COUNT=n or UNTIL=<date-time>
+//	BYDAY=MO,WE (weekly), BYDAY=1SA,-1FR or BYDAY=SA (monthly)
+//	BYMONTHDAY=1,15,-1 (monthly)
+//	WKST=MO
+//
+// Occurrences keep the wall-clock time of the rule's start in its location,
+// so a rule for 18:00 every Saturday stays at 18:00 across DST changes.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is how often a rule repeats
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// maxPeriods bounds expansion so a malformed rule can't loop forever
+const maxPeriods = 10000
+
+// ErrInvalidRule is wrapped by every parse error
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// WeekdayNum is a BYDAY entry. N is the occurrence within the month for
+// monthly rules (1 = first, -1 = last); 0 means every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int       // 0 means unbounded
+	Until      time.Time // zero means unbounded
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// Parse parses an RRULE value, with or without the "RRULE:" prefix
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	r := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch Frequency(value) {
+			case Daily, Weekly, Monthly:
+				r.Freq = Frequency(value)
+			default:
+				err = fmt.Errorf("unsupported FREQ %s", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = positiveInt(value)
+		case "COUNT":
+			r.Count, err = positiveInt(value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseByMonthDay(value)
+		case "WKST":
+			if value != "MO" {
+				err = errors.New("only WKST=MO is supported")
+			}
+		default:
+			err = fmt.Errorf("unsupported part %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL can't both be set", ErrInvalidRule)
+	}
+	if r.Freq != Monthly && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("%w: BYMONTHDAY needs FREQ=MONTHLY", ErrInvalidRule)
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly {
+			return nil, fmt.Errorf("%w: numbered BYDAY needs FREQ=MONTHLY", ErrInvalidRule)
+		}
+	}
+	if r.Freq == Daily && len(r.ByDay) > 0 {
+		return nil, fmt.Errorf("%w: BYDAY isn't supported with FREQ=DAILY", ErrInvalidRule)
+	}
+	if len(r.ByDay) > 0 && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("%w: BYDAY and BYMONTHDAY can't be combined", ErrInvalidRule)
+	}
+
+	return r, nil
+}
+
+func positiveInt(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q is not a positive integer", s)
+	}
+	return n, nil
+}
+
+// parseUntil accepts UTC date-times (20250131T235959Z), floating date-times
+// (treated as UTC) and dates (the end of that day, UTC)
+func parseUntil(s string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse("20060102", s); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL %q", s)
+}
+
+func parseByDay(s string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(s, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		code := item[len(item)-2:]
+		weekday, ok := weekdayCodes[code]
+		if !ok {
+			return nil, fmt.Errorf("invalid BYDAY %q", item)
+		}
+		day := WeekdayNum{Weekday: weekday}
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid BYDAY %q", item)
+			}
+			day.N = n
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func parseByMonthDay(s string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, fmt.Errorf("invalid BYMONTHDAY %q", item)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+// String formats the rule as an RRULE value (without the "RRULE:" prefix)
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%d", r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			codes[i] = strings.ToUpper(d.Weekday.String()[:2])
+			if d.N != 0 {
+				codes[i] = strconv.Itoa(d.N) + codes[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Ended reports whether the rule has no occurrences after t. Rules bounded by
+// COUNT are never reported as ended since that depends on expansion.
+func (r *Rule) Ended(t time.Time) bool {
+	return !r.Until.IsZero() && t.After(r.Until)
+}
+
+// Expand returns the starts of the occurrences in [from, to), in order.
+// dtstart is the first occurrence's start; its location sets the wall clock
+// of every occurrence. Starts listed in exdates are skipped, though they
+// still count towards COUNT.
+func (r *Rule) Expand(dtstart, from, to time.Time, exdates []time.Time) []time.Time {
+	excluded := make(map[int64]bool, len(exdates))
+	for _, t := range exdates {
+		excluded[t.Unix()] = true
+	}
+
+	loc := dtstart.Location()
+	hour, minute, second := dtstart.Clock()
+	y, m, d := dtstart.Date()
+	// Weekly periods start on the Monday of dtstart's week
+	weekStart := time.Date(y, m, d-(int(dtstart.Weekday())+6)%7, 0, 0, 0, 0, time.UTC)
+
+	var occurrences []time.Time
+	count := 0
+	for period := 0; period < maxPeriods; period++ {
+		var days []time.Time // candidate dates, as midnight UTC
+		switch r.Freq {
+		case Daily:
+			days = []time.Time{time.Date(y, m, d+period*r.Interval, 0, 0, 0, 0, time.UTC)}
+		case Weekly:
+			days = r.weekDays(weekStart.AddDate(0, 0, 7*period*r.Interval), dtstart.Weekday())
+		case Monthly:
+			days = r.monthDays(y, m+time.Month(period*r.Interval), d, dtstart.Weekday())
+		}
+
+		for _, day := range days {
+			start := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, loc)
+			if start.Before(dtstart) {
+				continue
+			}
+			if (!r.Until.IsZero() && start.After(r.Until)) || !start.Before(to) {
+				return occurrences
+			}
+			count++
+			if !start.Before(from) && !excluded[start.Unix()] {
+				occurrences = append(occurrences, start)
+			}
+			if r.Count > 0 && count >= r.Count {
+				return occurrences
+			}
+		}
+	}
+	return occurrences
+}
+
+// weekDays returns the dates in the week starting on monday that match BYDAY
+func (r *Rule) weekDays(monday time.Time, defaultDay time.Weekday) []time.Time {
+	weekdays := []time.Weekday{defaultDay}
+	if len(r.ByDay) > 0 {
+		weekdays = weekdays[:0]
+		for _, d := range r.ByDay {
+			weekdays = append(weekdays, d.Weekday)
+		}
+	}
+
+	var days []time.Time
+	seen := make(map[int]bool)
+	for _, wd := range weekdays {
+		offset := (int(wd) + 6) % 7
+		if !seen[offset] {
+			seen[offset] = true
+			days = append(days, monday.AddDate(0, 0, offset))
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days
+}
+
+// monthDays returns the dates in a month that match BYMONTHDAY or BYDAY,
+// defaulting to dtstart's day of the month. Days a month doesn't have, such
+// as the 31st in April, are skipped.
+func (r *Rule) monthDays(year int, month time.Month, defaultDay int, defaultWeekday time.Weekday) []time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	length := first.AddDate(0, 1, -1).Day()
+
+	seen := make(map[int]bool)
+	add := func(day int) {
+		if day < 0 {
+			day = length + day + 1
+		}
+		if day >= 1 && day <= length {
+			seen[day] = true
+		}
+	}
+
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, day := range r.ByMonthDay {
+			add(day)
+		}
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			firstMatch := 1 + (int(wd.Weekday)-int(first.Weekday())+7)%7
+			var matches []int
+			for day := firstMatch; day <= length; day += 7 {
+				matches = append(matches, day)
+			}
+			switch {
+			case wd.N == 0:
+				for _, day := range matches {
+					add(day)
+				}
+			case wd.N > 0 && wd.N <= len(matches):
+				add(matches[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(matches):
+				add(matches[len(matches)+wd.N])
+			}
+		}
+	default:
+		add(defaultDay)
+	}
+
+	days := make([]time.Time, 0, len(seen))
+	for day := range seen {
+		days = append(days, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days
+}
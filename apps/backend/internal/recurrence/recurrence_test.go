@@ -0,0 +1,128 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, s string) *Rule {
+	t.Helper()
+	r, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", s, err)
+	}
+	return r
+}
+
+func formatAll(times []time.Time) []string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = t.Format("2006-01-02 15:04 MST")
+	}
+	return out
+}
+
+func assertOccurrences(t *testing.T, got []time.Time, want ...string) {
+	t.Helper()
+	formatted := formatAll(got)
+	if len(formatted) != len(want) {
+		t.Fatalf("expected %d occurrences %v, got %d: %v", len(want), want, len(formatted), formatted)
+	}
+	for i := range want {
+		if formatted[i] != want[i] {
+			t.Errorf("occurrence %d: expected %s, got %s", i, want[i], formatted[i])
+		}
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"FREQ=WEEKLY;BYDAY=SA",
+		"FREQ=WEEKLY;INTERVAL=2;COUNT=6;BYDAY=TU,TH",
+		"FREQ=MONTHLY;UNTIL=20261231T235959Z;BYDAY=1SA,-1FR",
+		"FREQ=MONTHLY;BYMONTHDAY=1,15,-1",
+		"FREQ=DAILY",
+	} {
+		if got := mustParse(t, "RRULE:"+s).String(); got != s {
+			t.Errorf("expected %q, got %q", s, got)
+		}
+	}
+}
+
+func TestParse_Rejects(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"BYDAY=SA",
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY;BYHOUR=18",
+		"FREQ=WEEKLY;COUNT=0",
+		"FREQ=WEEKLY;COUNT=3;UNTIL=20261231",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1SA",
+		"FREQ=DAILY;BYDAY=SA",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=WEEKLY;FREQ=DAILY",
+	} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("Parse(%q): expected ErrInvalidRule, got %v", s, err)
+		}
+	}
+}
+
+func TestExpand_WeeklyKeepsWallClockAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+
+	// Saturdays 18:00 either side of the 2026-03-08 DST change
+	r := mustParse(t, "FREQ=WEEKLY;BYDAY=SA")
+	dtstart := time.Date(2026, 2, 28, 18, 0, 0, 0, ny)
+	got := r.Expand(dtstart, dtstart, dtstart.AddDate(0, 0, 21), nil)
+
+	assertOccurrences(t, got, "2026-02-28 18:00 EST", "2026-03-07 18:00 EST", "2026-03-14 18:00 EDT")
+}
+
+func TestExpand_WeeklyMultipleDaysWithCountAndExdate(t *testing.T) {
+	r := mustParse(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=5;BYDAY=TU,TH")
+	dtstart := time.Date(2026, 6, 4, 19, 0, 0, 0, time.UTC) // a Thursday
+	exdate := time.Date(2026, 6, 16, 19, 0, 0, 0, time.UTC)
+	got := r.Expand(dtstart, dtstart, dtstart.AddDate(1, 0, 0), []time.Time{exdate})
+
+	// The Tuesday before dtstart is skipped; the excluded date still counts
+	assertOccurrences(t, got,
+		"2026-06-04 19:00 UTC", "2026-06-18 19:00 UTC", "2026-06-30 19:00 UTC", "2026-07-02 19:00 UTC")
+}
+
+func TestExpand_WindowAndUntil(t *testing.T) {
+	r := mustParse(t, "FREQ=DAILY;INTERVAL=3;UNTIL=20260115T200000Z")
+	dtstart := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	got := r.Expand(dtstart, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), dtstart.AddDate(0, 1, 0), nil)
+
+	assertOccurrences(t, got, "2026-01-07 20:00 UTC", "2026-01-10 20:00 UTC", "2026-01-13 20:00 UTC")
+	if !r.Ended(time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)) {
+		t.Error("expected the rule to have ended after UNTIL")
+	}
+}
+
+func TestExpand_MonthlyByDayAndMonthDay(t *testing.T) {
+	dtstart := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	firstSaturday := mustParse(t, "FREQ=MONTHLY;BYDAY=1SA").Expand(dtstart, dtstart, to, nil)
+	assertOccurrences(t, firstSaturday,
+		"2026-01-03 10:00 UTC", "2026-02-07 10:00 UTC", "2026-03-07 10:00 UTC", "2026-04-04 10:00 UTC")
+
+	lastDay := mustParse(t, "FREQ=MONTHLY;BYMONTHDAY=-1").Expand(dtstart, dtstart, to, nil)
+	assertOccurrences(t, lastDay,
+		"2026-01-31 10:00 UTC", "2026-02-28 10:00 UTC", "2026-03-31 10:00 UTC", "2026-04-30 10:00 UTC")
+}
+
+func TestExpand_MonthlySkipsMissingDays(t *testing.T) {
+	dtstart := time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC)
+	got := mustParse(t, "FREQ=MONTHLY").Expand(dtstart, dtstart, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), nil)
+
+	assertOccurrences(t, got, "2026-01-31 10:00 UTC", "2026-03-31 10:00 UTC", "2026-05-31 10:00 UTC")
+}
@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// CancelledDate describes a scheduled date cancelled on behalf of one participant
+type CancelledDate struct {
+	DateID         int
+	OtherUserID    int
+	ScheduledTime  time.Time
+	PreviousStatus string
+}
+
+// accountDeletedCancellationReason is recorded on dates cancelled by a purge
+const accountDeletedCancellationReason = "Participant deleted their account"
+
+// PurgeResult summarizes what was removed when an account was purged.
+// Storage objects are returned so they can be deleted after the transaction commits.
+type PurgeResult struct {
+	UserID               int
+	PhotoIDs             []string
+	ExportStorageKeys    []string
+	IDDocumentKeys       []string
+	CancelledDates       []CancelledDate
+	WithdrawnSuggestions int64
+	WithdrawnMatches     int64
+	RevokedSessions      int64
+}
+
+// AccountPurgeRepository handles irreversible account purges after the deletion grace period
+type AccountPurgeRepository struct {
+	db *sql.DB
+}
+
+// NewAccountPurgeRepository creates a new repository
+func NewAccountPurgeRepository(db *sql.DB) *AccountPurgeRepository {
+	return &AccountPurgeRepository{db: db}
+}
+
+// ListDueForPurge returns IDs of PENDING_DELETION accounts whose grace period has ended
+func (r *AccountPurgeRepository) ListDueForPurge(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	query := `
+		SELECT id FROM datifyy_v2_users
+		WHERE account_status = 'PENDING_DELETION' AND deletion_scheduled_for <= $1
+		ORDER BY deletion_scheduled_for ASC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts due for purge: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	return userIDs, rows.Err()
+}
+
+// PurgeAccount scrubs a user's PII and detaches them from the rest of the platform in one
+// transaction. The anonymized user row is kept so dates and reports stay referentially intact.
+func (r *AccountPurgeRepository) PurgeAccount(ctx context.Context, userID int) (*PurgeResult, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result := &PurgeResult{UserID: userID}
+
+	// Re-check status inside the transaction in case the user logged in meanwhile
+	var accountStatus string
+	err = tx.QueryRowContext(ctx,
+		`SELECT account_status FROM datifyy_v2_users WHERE id = $1 FOR UPDATE`, userID,
+	).Scan(&accountStatus)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock user: %w", err)
+	}
+	if accountStatus != "PENDING_DELETION" {
+		return nil, fmt.Errorf("account %d is %s, not pending deletion", userID, accountStatus)
+	}
+
+	// Photos
+	result.PhotoIDs, err = queryStrings(ctx, tx,
+		`DELETE FROM user_photos WHERE user_id = $1 RETURNING photo_id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete photos: %w", err)
+	}
+
+	// Data export archives
+	result.ExportStorageKeys, err = queryStrings(ctx, tx,
+		`DELETE FROM datifyy_v2_data_exports WHERE user_id = $1 AND storage_key IS NOT NULL RETURNING storage_key`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete data exports: %w", err)
+	}
+
+	// Identity document images
+	result.IDDocumentKeys, err = queryStrings(ctx, tx, `
+		WITH deleted AS (
+			DELETE FROM datifyy_v2_id_verifications WHERE user_id = $1
+			RETURNING front_image_key, back_image_key, selfie_image_key
+		)
+		SELECT k FROM deleted, unnest(ARRAY[front_image_key, back_image_key, selfie_image_key]) AS k
+		WHERE k IS NOT NULL`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete identity documents: %w", err)
+	}
+
+	// Pending suggestions in either direction
+	res, err := tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_date_suggestions
+		SET status = 'withdrawn', responded_at = NOW()
+		WHERE (user_id = $1 OR suggested_user_id = $1) AND status = 'pending'`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw suggestions: %w", err)
+	}
+	result.WithdrawnSuggestions, _ = res.RowsAffected()
+
+	// Curated matches that have not turned into a date yet
+	res, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_curated_matches
+		SET status = 'withdrawn'
+		WHERE (user1_id = $1 OR user2_id = $1) AND status NOT IN ('scheduled', 'rejected', 'withdrawn')`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw curated matches: %w", err)
+	}
+	result.WithdrawnMatches, _ = res.RowsAffected()
+
+	// Future scheduled dates
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE datifyy_v2_scheduled_dates d
+		SET status = 'cancelled', cancelled_at = NOW(), cancellation_reason = $2
+		FROM datifyy_v2_scheduled_dates old
+		WHERE old.id = d.id
+		  AND (d.user1_id = $1 OR d.user2_id = $1)
+		  AND d.status IN ('scheduled', 'confirmed')
+		  AND d.scheduled_time > NOW()
+		RETURNING d.id, CASE WHEN d.user1_id = $1 THEN d.user2_id ELSE d.user1_id END, d.scheduled_time, old.status`,
+		userID, accountDeletedCancellationReason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled dates: %w", err)
+	}
+	for rows.Next() {
+		var d CancelledDate
+		if err := rows.Scan(&d.DateID, &d.OtherUserID, &d.ScheduledTime, &d.PreviousStatus); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan cancelled date: %w", err)
+		}
+		result.CancelledDates = append(result.CancelledDates, d)
+	}
+	rows.Close()
+
+	// The other participants' slots become free again
+	cancelledIDs := make([]int, len(result.CancelledDates))
+	for i, d := range result.CancelledDates {
+		cancelledIDs[i] = d.DateID
+	}
+	if err := releaseDateSlots(ctx, tx, cancelledIDs); err != nil {
+		return nil, err
+	}
+	if err := logDateCancellations(ctx, tx, result.CancelledDates, accountDeletedCancellationReason, DateActor{Type: DateActorSystem}); err != nil {
+		return nil, err
+	}
+
+	// Sessions and devices
+	res, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_sessions SET is_active = false
+		WHERE user_id = $1 AND is_active = true`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	result.RevokedSessions, _ = res.RowsAffected()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM datifyy_v2_devices WHERE user_id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("failed to delete devices: %w", err)
+	}
+
+	// Blocks in either direction
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM user_blocks WHERE blocker_user_id = $1 OR blocked_user_id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("failed to delete blocks: %w", err)
+	}
+
+	// Profile data, including the change log's copies of old and new values
+	for _, table := range []string{
+		"datifyy_v2_user_profiles",
+		"datifyy_v2_partner_preferences",
+		"user_preferences",
+		"datifyy_v2_profile_changes",
+		"datifyy_v2_availability_slots",
+		"datifyy_v2_availability_rules",
+		"datifyy_v2_availability_slots_archive",
+		"datifyy_v2_availability_reminders",
+		"datifyy_v2_availability_exclusions",
+		"datifyy_v2_calendar_feed_tokens",
+		"datifyy_v2_work_email_verifications",
+		"datifyy_v2_date_reschedule_requests",
+		"datifyy_v2_date_reliability",
+	} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE user_id = $1`, userID); err != nil {
+			return nil, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+
+	// Scrub PII on the user row
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_users
+		SET email = 'deleted-user-' || id || '@deleted.datifyy.invalid',
+			name = 'Deleted User',
+			password_hash = NULL,
+			phone_number = NULL,
+			photo_url = NULL,
+			date_of_birth = NULL,
+			gender = NULL,
+			verification_token = NULL,
+			verification_token_expires_at = NULL,
+			password_reset_token = NULL,
+			password_reset_token_expires_at = NULL,
+			deletion_reason = NULL,
+			account_status = 'DELETED',
+			purged_at = NOW()
+		WHERE id = $1`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrub user: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit purge: %w", err)
+	}
+
+	return result, nil
+}
+
+// queryStrings runs a query returning a single string column
+func queryStrings(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	return values, rows.Err()
+}
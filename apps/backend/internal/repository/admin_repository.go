@@ -59,6 +59,8 @@ type ScheduledDate struct {
 	ConfirmedAt     sql.NullTime
 	CompletedAt     sql.NullTime
 	CancelledAt     sql.NullTime
+	User1SlotID     sql.NullInt64 // availability slots reserved when the date was created
+	User2SlotID     sql.NullInt64
 }
 
 // UserWithDetails represents a user with all details for admin view
@@ -394,6 +396,10 @@ func (r *AdminRepository) GetOppositeSexUsers(ctx context.Context, userID int, l
 		  AND u.account_status = 'ACTIVE'
 		  AND u.id != $2
 		  AND a.start_time > NOW()
+		  AND (
+		      NOT COALESCE((SELECT pp.verified_only FROM datifyy_v2_partner_preferences pp WHERE pp.user_id = $2), FALSE)
+		      OR EXISTS (SELECT 1 FROM datifyy_v2_user_profiles vp WHERE vp.user_id = u.id AND vp.is_verified)
+		  )
 		ORDER BY u.created_at DESC
 		LIMIT $3
 	`
@@ -425,27 +431,51 @@ func (r *AdminRepository) GetOppositeSexUsers(ctx context.Context, userID int, l
 // Scheduled Date Operations
 // =============================================================================
 
-// CreateScheduledDate creates a new scheduled date
+// CreateScheduledDate creates a new scheduled date, reserving the
+// participants' slots that cover it. It fails with ErrSlotReserved or
+// ErrDateConflict if either participant is already booked.
 func (r *AdminRepository) CreateScheduledDate(ctx context.Context, date *ScheduledDate) (*ScheduledDate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := reserveDateSlots(ctx, tx, date); err != nil {
+		return nil, err
+	}
+
 	query := `
 		INSERT INTO datifyy_v2_scheduled_dates (
 			user1_id, user2_id, genie_id, scheduled_time, duration_minutes,
 			date_type, place_name, address, city, state, country, zipcode,
-			latitude, longitude, notes
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+			latitude, longitude, notes, user1_slot_id, user2_slot_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 		RETURNING id, status, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		date.User1ID, date.User2ID, date.GenieID, date.ScheduledTime, date.DurationMinutes,
 		date.DateType, date.PlaceName, date.Address, date.City, date.State, date.Country,
-		date.Zipcode, date.Latitude, date.Longitude, date.Notes,
+		date.Zipcode, date.Latitude, date.Longitude, date.Notes, date.User1SlotID, date.User2SlotID,
 	).Scan(&date.ID, &date.Status, &date.CreatedAt, &date.UpdatedAt)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scheduled date: %w", err)
 	}
 
+	if err := markSlotsReserved(ctx, tx, date); err != nil {
+		return nil, err
+	}
+
+	if err := insertDateActivity(ctx, tx, date.ID, DateActivityCreated, "", date.Status, "", dateCreator(date)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
 	return date, nil
 }
 
@@ -540,44 +570,26 @@ func (r *AdminRepository) GetGenieDates(ctx context.Context, genieID int, status
 	return dates, totalCount, nil
 }
 
-// UpdateDateStatus updates the status of a scheduled date
-func (r *AdminRepository) UpdateDateStatus(ctx context.Context, dateID int, status, notes string) (*ScheduledDate, error) {
-	// Build update query based on status
-	var extraColumn string
-	switch status {
-	case "confirmed":
-		extraColumn = ", confirmed_at = CURRENT_TIMESTAMP"
-	case "completed":
-		extraColumn = ", completed_at = CURRENT_TIMESTAMP"
-	case "cancelled":
-		extraColumn = ", cancelled_at = CURRENT_TIMESTAMP"
+// UpdateDateStatus moves a scheduled date to a new status on behalf of an
+// admin (adminID 0 if unknown). Transitions the date's lifecycle doesn't
+// allow fail with datestatus.ErrInvalidTransition.
+func (r *AdminRepository) UpdateDateStatus(ctx context.Context, dateID int, status, notes string, adminID int) (*ScheduledDate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	query := fmt.Sprintf(`
-		UPDATE datifyy_v2_scheduled_dates
-		SET status = $1, admin_notes = COALESCE($2, admin_notes)%s
-		WHERE id = $3
-		RETURNING id, user1_id, user2_id, genie_id, scheduled_time, duration_minutes,
-		          status, date_type, place_name, address, city, state, country, zipcode,
-		          latitude, longitude, notes, admin_notes, created_at, updated_at,
-		          confirmed_at, completed_at, cancelled_at
-	`, extraColumn)
-
-	var d ScheduledDate
-	err := r.db.QueryRowContext(ctx, query, status, notes, dateID).Scan(
-		&d.ID, &d.User1ID, &d.User2ID, &d.GenieID, &d.ScheduledTime, &d.DurationMinutes,
-		&d.Status, &d.DateType, &d.PlaceName, &d.Address, &d.City, &d.State, &d.Country,
-		&d.Zipcode, &d.Latitude, &d.Longitude, &d.Notes, &d.AdminNotes, &d.CreatedAt,
-		&d.UpdatedAt, &d.ConfirmedAt, &d.CompletedAt, &d.CancelledAt,
-	)
-	if err == sql.ErrNoRows {
-		return nil, ErrDateNotFound
-	}
+	d, err := transitionDate(ctx, tx, dateID, status, DateActor{Type: DateActorAdmin, AdminID: adminID}, notes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update date status: %w", err)
+		return nil, err
 	}
 
-	return &d, nil
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return d, nil
 }
 
 // GetUserAvailability gets user's future availability slots
@@ -1068,6 +1080,121 @@ func (r *AdminRepository) GetAvailabilityStats(ctx context.Context) (int64, int6
 	return availableUsers, unavailableUsers, nil
 }
 
+// AvailabilityHeatmapFilter selects the slots aggregated into a heatmap
+type AvailabilityHeatmapFilter struct {
+	From     int64  // Unix seconds
+	To       int64  // Unix seconds
+	Timezone string // IANA name weekdays and hours are bucketed in
+	City     string // optional, case-insensitive
+	DateType string // optional
+}
+
+// AvailabilityHeatmapRow is the availability of one gender in a city, either
+// for one date type, weekday and local hour or, when IsCityTotal is set, over
+// the whole range
+type AvailabilityHeatmapRow struct {
+	City          string
+	DateType      string
+	Weekday       int // 0 = Sunday
+	Hour          int
+	Gender        string // male, female or other
+	Slots         int64
+	Users         int64
+	ReservedSlots int64
+	IsCityTotal   bool
+}
+
+// GetAvailabilityHeatmap counts the slots overlapping each local hour of the
+// week in a date range, by city, date type and gender, along with per-city
+// totals. A slot's city is its own for offline dates, otherwise its owner's.
+// Archived slots are included, so past ranges keep their history.
+func (r *AdminRepository) GetAvailabilityHeatmap(ctx context.Context, filter AvailabilityHeatmapFilter) ([]AvailabilityHeatmapRow, error) {
+	// Slots last at most 12 hours, which bounds the start_time range scan
+	args := []interface{}{filter.From, filter.To, filter.Timezone, int64(MaxSlotDuration / time.Second)}
+	conditions := []string{
+		"s.start_time > $1 - $4",
+		"s.start_time < $2",
+		"s.end_time > $1",
+	}
+	if filter.DateType != "" {
+		args = append(args, filter.DateType)
+		conditions = append(conditions, fmt.Sprintf("s.date_type::text = $%d", len(args)))
+	}
+
+	cityExpr := `INITCAP(TRIM(COALESCE(NULLIF(TRIM(s.city), ''), NULLIF(TRIM(up.location->>'city'), ''), 'Unknown')))`
+	cityFilter := ""
+	if filter.City != "" {
+		args = append(args, filter.City)
+		cityFilter = fmt.Sprintf("WHERE LOWER(city) = LOWER($%d)", len(args))
+	}
+
+	// Each slot is expanded into the local hours it overlaps within the range
+	query := `
+		WITH slot_hours AS (
+			SELECT
+				s.id, s.user_id, s.date_type::text AS date_type, s.reserved_date_id,
+				` + cityExpr + ` AS city,
+				CASE REPLACE(UPPER(COALESCE(u.gender, '')), 'GENDER_', '')
+					WHEN 'MALE' THEN 'male'
+					WHEN 'FEMALE' THEN 'female'
+					ELSE 'other'
+				END AS gender,
+				h.local_hour
+			FROM (
+				SELECT id, user_id, start_time, end_time, date_type, city, reserved_date_id
+				FROM datifyy_v2_availability_slots
+				UNION ALL
+				SELECT id, user_id, start_time, end_time, date_type, city, reserved_date_id
+				FROM datifyy_v2_availability_slots_archive
+			) s
+			JOIN datifyy_v2_users u ON u.id = s.user_id
+			LEFT JOIN datifyy_v2_user_profiles up ON up.user_id = s.user_id
+			CROSS JOIN LATERAL generate_series(
+				date_trunc('hour', to_timestamp(GREATEST(s.start_time, $1)) AT TIME ZONE $3),
+				to_timestamp(LEAST(s.end_time, $2) - 1) AT TIME ZONE $3,
+				INTERVAL '1 hour'
+			) AS h(local_hour)
+			WHERE ` + strings.Join(conditions, " AND ") + `
+		)
+		SELECT
+			city,
+			COALESCE(date_type, ''),
+			COALESCE(EXTRACT(DOW FROM local_hour)::int, 0),
+			COALESCE(EXTRACT(HOUR FROM local_hour)::int, 0),
+			gender,
+			COUNT(DISTINCT id),
+			COUNT(DISTINCT user_id),
+			COUNT(DISTINCT id) FILTER (WHERE reserved_date_id IS NOT NULL),
+			GROUPING(date_type) = 1
+		FROM slot_hours
+		` + cityFilter + `
+		GROUP BY GROUPING SETS (
+			(city, date_type, EXTRACT(DOW FROM local_hour), EXTRACT(HOUR FROM local_hour), gender),
+			(city, gender)
+		)
+		ORDER BY 1, 2, 3, 4, 5
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query availability heatmap: %w", err)
+	}
+	defer rows.Close()
+
+	var results []AvailabilityHeatmapRow
+	for rows.Next() {
+		var row AvailabilityHeatmapRow
+		err := rows.Scan(&row.City, &row.DateType, &row.Weekday, &row.Hour, &row.Gender,
+			&row.Slots, &row.Users, &row.ReservedSlots, &row.IsCityTotal)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan availability heatmap row: %w", err)
+		}
+		results = append(results, row)
+	}
+
+	return results, rows.Err()
+}
+
 // =============================================================================
 // Admin Management Operations
 // =============================================================================
@@ -1204,8 +1331,10 @@ type BulkActionResult struct {
 	ErrorMessages   []string
 }
 
-// BulkUserAction performs bulk actions on users
-func (r *AdminRepository) BulkUserAction(ctx context.Context, userIDs []int, action string, reason string) (*BulkActionResult, error) {
+// BulkUserAction performs bulk actions on users. Suspensions and reactivations are
+// recorded in each user's enforcement history; suspensionDays of 0 suspends
+// indefinitely. adminID may be 0 when the acting admin is unknown.
+func (r *AdminRepository) BulkUserAction(ctx context.Context, userIDs []int, action string, reason string, adminID, suspensionDays int) (*BulkActionResult, error) {
 	result := &BulkActionResult{
 		FailedUserIDs: []string{},
 		ErrorMessages: []string{},
@@ -1223,7 +1352,7 @@ func (r *AdminRepository) BulkUserAction(ctx context.Context, userIDs []int, act
 	case "activate":
 		query = `UPDATE datifyy_v2_users SET account_status = 'ACTIVE' WHERE id = $1`
 	case "suspend":
-		query = `UPDATE datifyy_v2_users SET account_status = 'SUSPENDED' WHERE id = $1`
+		// Handled by applyModerationAction
 	case "delete":
 		query = `UPDATE datifyy_v2_users SET account_status = 'DELETED' WHERE id = $1`
 	case "verify":
@@ -1234,8 +1363,24 @@ func (r *AdminRepository) BulkUserAction(ctx context.Context, userIDs []int, act
 		return nil, fmt.Errorf("invalid action: %s", action)
 	}
 
+	admin := sql.NullInt64{Int64: int64(adminID), Valid: adminID > 0}
+	notes := sql.NullString{String: reason, Valid: reason != ""}
+
 	for _, userID := range userIDs {
-		_, err := tx.ExecContext(ctx, query, userID)
+		var err error
+		switch action {
+		case "suspend":
+			suspension := &ModerationAction{UserID: userID, AdminID: admin, Action: "SUSPEND", Notes: notes}
+			if suspensionDays > 0 {
+				suspension.DurationDays = sql.NullInt64{Int64: int64(suspensionDays), Valid: true}
+				suspension.ExpiresAt = sql.NullTime{Time: time.Now().AddDate(0, 0, suspensionDays), Valid: true}
+			}
+			err = applyModerationAction(ctx, tx, suspension)
+		case "activate":
+			err = reinstateUser(ctx, tx, query, userID, admin, notes)
+		default:
+			_, err = tx.ExecContext(ctx, query, userID)
+		}
 		if err != nil {
 			result.FailedCount++
 			result.FailedUserIDs = append(result.FailedUserIDs, strconv.Itoa(userID))
@@ -1252,3 +1397,26 @@ func (r *AdminRepository) BulkUserAction(ctx context.Context, userIDs []int, act
 
 	return result, nil
 }
+
+// reinstateUser reactivates an account and lifts any suspension or ban in force,
+// recording a REINSTATE action when one was lifted
+func reinstateUser(ctx context.Context, tx *sql.Tx, activateQuery string, userID int, adminID sql.NullInt64, notes sql.NullString) error {
+	if _, err := tx.ExecContext(ctx, activateQuery, userID); err != nil {
+		return err
+	}
+
+	lifted, err := liftActiveEnforcement(ctx, tx, userID, "Reinstated by admin")
+	if err != nil {
+		return err
+	}
+	if lifted == 0 {
+		return nil
+	}
+
+	return recordModerationAction(ctx, tx, &ModerationAction{
+		UserID:  userID,
+		AdminID: adminID,
+		Action:  "REINSTATE",
+		Notes:   notes,
+	})
+}
@@ -263,6 +263,34 @@ func TestGetAvailabilityStats(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetAvailabilityHeatmap(t *testing.T) {
+	db, mock, repo := setupMockDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	columns := []string{"city", "date_type", "weekday", "hour", "gender", "slots", "users", "reserved", "is_city_total"}
+	mock.ExpectQuery("GROUP BY GROUPING SETS").
+		WithArgs(int64(1000), int64(2000), "Asia/Kolkata", int64(43200), "offline", "bengaluru").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("Bengaluru", "offline", 6, 19, "female", 4, 3, 1, false).
+			AddRow("Bengaluru", "", 0, 0, "female", 9, 5, 2, true))
+
+	rows, err := repo.GetAvailabilityHeatmap(ctx, AvailabilityHeatmapFilter{
+		From:     1000,
+		To:       2000,
+		Timezone: "Asia/Kolkata",
+		City:     "bengaluru",
+		DateType: "offline",
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, AvailabilityHeatmapRow{City: "Bengaluru", DateType: "offline", Weekday: 6, Hour: 19, Gender: "female", Slots: 4, Users: 3, ReservedSlots: 1}, rows[0])
+	assert.True(t, rows[1].IsCityTotal)
+	assert.Equal(t, int64(5), rows[1].Users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 // =============================================================================
 // Admin Management Tests
 // =============================================================================
@@ -385,15 +413,24 @@ func TestBulkUserAction_Activate(t *testing.T) {
 	userIDs := []int{1, 2, 3}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'ACTIVE'")).
-		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'ACTIVE'")).
-		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'ACTIVE'")).
-		WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, id := range userIDs {
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'ACTIVE'")).
+			WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+		lifted := int64(0)
+		if id == 2 {
+			lifted = 1 // user 2 was suspended
+		}
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_moderation_actions")).
+			WithArgs(id, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, lifted))
+		if id == 2 {
+			mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO datifyy_v2_moderation_actions")).
+				WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg(), "REINSTATE", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+		}
+	}
 	mock.ExpectCommit()
 
-	result, err := repo.BulkUserAction(ctx, userIDs, "activate", "test reason")
+	result, err := repo.BulkUserAction(ctx, userIDs, "activate", "test reason", 9, 0)
 	require.NoError(t, err)
 	assert.Equal(t, 3, result.SuccessCount)
 	assert.Equal(t, 0, result.FailedCount)
@@ -409,13 +446,19 @@ func TestBulkUserAction_Suspend(t *testing.T) {
 	userIDs := []int{1, 2}
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'SUSPENDED'")).
-		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = 'SUSPENDED'")).
-		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, id := range userIDs {
+		mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO datifyy_v2_moderation_actions")).
+			WithArgs(id, sqlmock.AnyArg(), sql.NullInt64{Int64: 9, Valid: true}, "SUSPEND",
+				sql.NullInt64{Int64: 7, Valid: true}, sqlmock.AnyArg(), sql.NullString{String: "violation", Valid: true}).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(id, time.Now()))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_users SET account_status = $2")).
+			WithArgs(id, "SUSPENDED").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE datifyy_v2_sessions SET is_active = false")).
+			WithArgs(id).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
 	mock.ExpectCommit()
 
-	result, err := repo.BulkUserAction(ctx, userIDs, "suspend", "violation")
+	result, err := repo.BulkUserAction(ctx, userIDs, "suspend", "violation", 9, 7)
 	require.NoError(t, err)
 	assert.Equal(t, 2, result.SuccessCount)
 	assert.Equal(t, 0, result.FailedCount)
@@ -438,7 +481,7 @@ func TestBulkUserAction_PartialFailure(t *testing.T) {
 		WithArgs(3).WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectCommit()
 
-	result, err := repo.BulkUserAction(ctx, userIDs, "delete", "cleanup")
+	result, err := repo.BulkUserAction(ctx, userIDs, "delete", "cleanup", 0, 0)
 	require.NoError(t, err)
 	assert.Equal(t, 2, result.SuccessCount)
 	assert.Equal(t, 1, result.FailedCount)
@@ -458,7 +501,7 @@ func TestBulkUserAction_InvalidAction(t *testing.T) {
 	mock.ExpectBegin()
 	mock.ExpectRollback()
 
-	result, err := repo.BulkUserAction(ctx, userIDs, "invalid_action", "test")
+	result, err := repo.BulkUserAction(ctx, userIDs, "invalid_action", "test", 0, 0)
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "invalid action")
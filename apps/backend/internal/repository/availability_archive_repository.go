@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AvailabilityStatus summarizes a user's availability at a point in time
+type AvailabilityStatus struct {
+	OpenSlots     int   // upcoming slots not reserved by a date
+	ReservedSlots int   // upcoming slots reserved by a date
+	RuleCount     int   // recurring availability rules
+	AvailableFrom int64 // start of the earliest open slot, 0 if none
+	AvailableTo   int64 // end of the latest open slot, 0 if none
+	HasSubmitted  bool  // whether the user has ever submitted availability
+}
+
+// AvailabilityReminderRecipient is a user whose availability has run out
+type AvailabilityReminderRecipient struct {
+	UserID          int
+	Email           string
+	Name            string
+	LastAvailableAt int64 // start of the latest slot they had
+}
+
+// ArchiveExpiredSlots moves up to limit slots that ended before cutoff into
+// the archive, returning how many were moved. Slots locked by another
+// transaction are skipped and picked up by a later batch.
+func (r *AvailabilityRepository) ArchiveExpiredSlots(ctx context.Context, cutoff int64, limit int) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		WITH expired AS (
+			SELECT id FROM datifyy_v2_availability_slots
+			WHERE start_time < $1 AND end_time < $1
+			ORDER BY start_time ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		), moved AS (
+			DELETE FROM datifyy_v2_availability_slots s
+			USING expired
+			WHERE s.id = expired.id
+			RETURNING s.id, s.user_id, s.start_time, s.end_time, s.date_type, s.city,
+			          s.rule_id, s.reserved_date_id, s.created_at
+		)
+		INSERT INTO datifyy_v2_availability_slots_archive (
+			id, user_id, start_time, end_time, date_type, city,
+			rule_id, reserved_date_id, created_at
+		)
+		SELECT id, user_id, start_time, end_time, date_type, city,
+		       rule_id, reserved_date_id, created_at
+		FROM moved
+		ON CONFLICT (id) DO NOTHING`,
+		cutoff, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	archived, _ := result.RowsAffected()
+	return int(archived), nil
+}
+
+// GetAvailabilityStatus summarizes a user's availability as of now
+func (r *AvailabilityRepository) GetAvailabilityStatus(ctx context.Context, userID int, now int64) (*AvailabilityStatus, error) {
+	status := &AvailabilityStatus{}
+	var availableFrom, availableTo sql.NullInt64
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE s.start_time > $2 AND s.reserved_date_id IS NULL),
+			COUNT(*) FILTER (WHERE s.start_time > $2 AND s.reserved_date_id IS NOT NULL),
+			MIN(s.start_time) FILTER (WHERE s.start_time > $2 AND s.reserved_date_id IS NULL),
+			MAX(s.end_time) FILTER (WHERE s.start_time > $2 AND s.reserved_date_id IS NULL),
+			(SELECT COUNT(*) FROM datifyy_v2_availability_rules WHERE user_id = $1),
+			COUNT(*) > 0 OR EXISTS (SELECT 1 FROM datifyy_v2_availability_slots_archive WHERE user_id = $1)
+		FROM datifyy_v2_availability_slots s
+		WHERE s.user_id = $1`,
+		userID, now,
+	).Scan(
+		&status.OpenSlots,
+		&status.ReservedSlots,
+		&availableFrom,
+		&availableTo,
+		&status.RuleCount,
+		&status.HasSubmitted,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	status.AvailableFrom = availableFrom.Int64
+	status.AvailableTo = availableTo.Int64
+	status.HasSubmitted = status.HasSubmitted || status.RuleCount > 0
+	return status, nil
+}
+
+// GetAvailabilityReminderRecipients gets up to limit active users with email
+// notifications on whose latest slot started between since and now, who
+// haven't been reminded about that lapse yet
+func (r *AvailabilityRepository) GetAvailabilityReminderRecipients(ctx context.Context, since, now int64, limit int) ([]*AvailabilityReminderRecipient, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH latest AS (
+			SELECT user_id, MAX(start_time) AS last_available_at
+			FROM (
+				SELECT user_id, start_time FROM datifyy_v2_availability_slots
+				UNION ALL
+				SELECT user_id, start_time FROM datifyy_v2_availability_slots_archive
+				WHERE start_time >= $1
+			) slots
+			GROUP BY user_id
+		)
+		SELECT u.id, u.email, u.name, l.last_available_at
+		FROM latest l
+		JOIN datifyy_v2_users u ON u.id = l.user_id
+		LEFT JOIN user_preferences p ON p.user_id = u.id
+		LEFT JOIN datifyy_v2_availability_reminders ar ON ar.user_id = u.id
+		WHERE l.last_available_at >= $1 AND l.last_available_at <= $2
+		  AND u.account_status = 'ACTIVE'
+		  AND COALESCE(p.email_enabled, TRUE)
+		  AND (ar.user_id IS NULL OR ar.last_available_at < l.last_available_at)
+		ORDER BY l.last_available_at ASC
+		LIMIT $3`,
+		since, now, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var recipients []*AvailabilityReminderRecipient
+	for rows.Next() {
+		recipient := &AvailabilityReminderRecipient{}
+		if err := rows.Scan(&recipient.UserID, &recipient.Email, &recipient.Name, &recipient.LastAvailableAt); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, rows.Err()
+}
+
+// MarkAvailabilityReminderSent records that a user was reminded about
+// availability that ran out after lastAvailableAt
+func (r *AvailabilityRepository) MarkAvailabilityReminderSent(ctx context.Context, userID int, lastAvailableAt int64, sentAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_availability_reminders (user_id, last_available_at, sent_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE
+		SET last_available_at = EXCLUDED.last_available_at, sent_at = EXCLUDED.sent_at`,
+		userID, lastAvailableAt, sentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrExclusionNotFound = errors.New("availability exclusion not found")
+)
+
+// ExclusionSourceICSImport marks exclusions imported from an iCalendar file
+const ExclusionSourceICSImport = "ics_import"
+
+// AvailabilityExclusion is a busy period, imported from a user's own
+// calendar, during which they can't meet even if a slot covers it
+type AvailabilityExclusion struct {
+	ID          int
+	UserID      int
+	StartTime   int64
+	EndTime     int64
+	Source      string
+	ExternalUID string
+	CreatedAt   time.Time
+}
+
+// CreateExclusionInput represents input for creating an availability exclusion
+type CreateExclusionInput struct {
+	StartTime   int64
+	EndTime     int64
+	ExternalUID string
+}
+
+const availabilityExclusionColumns = `id, user_id, start_time, end_time, source, external_uid, created_at`
+
+func scanAvailabilityExclusion(row rowScanner) (*AvailabilityExclusion, error) {
+	exclusion := &AvailabilityExclusion{}
+	err := row.Scan(
+		&exclusion.ID,
+		&exclusion.UserID,
+		&exclusion.StartTime,
+		&exclusion.EndTime,
+		&exclusion.Source,
+		&exclusion.ExternalUID,
+		&exclusion.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return exclusion, nil
+}
+
+// ImportExclusions stores busy periods from source for a user in one
+// transaction. A period with the same UID and start as an existing one
+// updates its end instead of being duplicated. With replace, the user's
+// exclusions from source ending after since that weren't in this import are
+// deleted, so events removed from the calendar stop blocking availability;
+// the number deleted is returned.
+func (r *AvailabilityRepository) ImportExclusions(ctx context.Context, userID int, source string, inputs []CreateExclusionInput, replace bool, since int64) ([]*AvailabilityExclusion, int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	exclusions := make([]*AvailabilityExclusion, 0, len(inputs))
+	ids := make([]int, 0, len(inputs))
+	for _, input := range inputs {
+		exclusion, err := scanAvailabilityExclusion(tx.QueryRowContext(ctx, `
+			INSERT INTO datifyy_v2_availability_exclusions (user_id, start_time, end_time, source, external_uid)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (user_id, external_uid, start_time) DO UPDATE
+			SET end_time = EXCLUDED.end_time, source = EXCLUDED.source
+			RETURNING `+availabilityExclusionColumns,
+			userID, input.StartTime, input.EndTime, source, input.ExternalUID,
+		))
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		exclusions = append(exclusions, exclusion)
+		ids = append(ids, exclusion.ID)
+	}
+
+	removed := 0
+	if replace {
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM datifyy_v2_availability_exclusions
+			WHERE user_id = $1 AND source = $2 AND end_time > $3 AND NOT (id = ANY($4))`,
+			userID, source, since, pq.Array(ids),
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		removed = int(affected)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return exclusions, removed, nil
+}
+
+// GetExclusions gets a user's exclusions overlapping [from, to)
+func (r *AvailabilityRepository) GetExclusions(ctx context.Context, userID int, from, to int64) ([]*AvailabilityExclusion, error) {
+	return r.GetExclusionsForUsers(ctx, []int{userID}, from, to)
+}
+
+// GetExclusionsForUsers gets the exclusions of any of userIDs overlapping [from, to)
+func (r *AvailabilityRepository) GetExclusionsForUsers(ctx context.Context, userIDs []int, from, to int64) ([]*AvailabilityExclusion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+availabilityExclusionColumns+`
+		FROM datifyy_v2_availability_exclusions
+		WHERE user_id = ANY($1) AND start_time < $3 AND end_time > $2
+		ORDER BY start_time ASC`,
+		pq.Array(userIDs), from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var exclusions []*AvailabilityExclusion
+	for rows.Next() {
+		exclusion, err := scanAvailabilityExclusion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		exclusions = append(exclusions, exclusion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return exclusions, nil
+}
+
+// DeleteExclusion deletes one of a user's exclusions
+func (r *AvailabilityRepository) DeleteExclusion(ctx context.Context, exclusionID, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM datifyy_v2_availability_exclusions WHERE id = $1 AND user_id = $2`,
+		exclusionID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	if deleted == 0 {
+		return ErrExclusionNotFound
+	}
+
+	return nil
+}
@@ -5,38 +5,53 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 var (
 	ErrSlotNotFound       = errors.New("availability slot not found")
-	ErrDuplicateSlot      = errors.New("duplicate availability slot")
+	ErrOverlappingSlot    = errors.New("slot overlaps an existing availability slot")
 	ErrInvalidTimeRange   = errors.New("invalid time range")
-	ErrInvalidDuration    = errors.New("slot duration must be exactly 1 hour")
+	ErrInvalidDuration    = errors.New("slot duration must be between 30 minutes and 12 hours")
 	ErrSlotTooSoon        = errors.New("slot must be at least 24 hours in the future")
 	ErrMissingOfflineInfo = errors.New("offline location info required for offline/offline_event dates")
+	ErrSlotLocked         = errors.New("slot is reserved by a scheduled date and can't be changed")
+)
+
+const (
+	// MinSlotDuration and MaxSlotDuration bound how long a slot may last
+	MinSlotDuration = 30 * time.Minute
+	MaxSlotDuration = 12 * time.Hour
+
+	// SlotLeadTime is how far in the future a slot must start
+	SlotLeadTime = 24 * time.Hour
 )
 
 // AvailabilitySlot represents an availability slot in the database
 type AvailabilitySlot struct {
-	ID            int
-	UserID        int
-	StartTime     int64
-	EndTime       int64
-	DateType      string
-	PlaceName     sql.NullString
-	Address       sql.NullString
-	City          sql.NullString
-	State         sql.NullString
-	Country       sql.NullString
-	Zipcode       sql.NullString
-	Latitude      sql.NullFloat64
-	Longitude     sql.NullFloat64
-	GooglePlaceID sql.NullString
-	GoogleMapsURL sql.NullString
-	Notes         sql.NullString
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID             int
+	UserID         int
+	StartTime      int64
+	EndTime        int64
+	DateType       string
+	PlaceName      sql.NullString
+	Address        sql.NullString
+	City           sql.NullString
+	State          sql.NullString
+	Country        sql.NullString
+	Zipcode        sql.NullString
+	Latitude       sql.NullFloat64
+	Longitude      sql.NullFloat64
+	GooglePlaceID  sql.NullString
+	GoogleMapsURL  sql.NullString
+	Notes          sql.NullString
+	RuleID         sql.NullInt64
+	ReservedDateID sql.NullInt64 // scheduled date the slot is reserved for
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 // CreateSlotInput represents input for creating an availability slot
@@ -70,23 +85,39 @@ func NewAvailabilityRepository(db *sql.DB) *AvailabilityRepository {
 
 // ValidateSlot validates a slot before creation
 func (r *AvailabilityRepository) ValidateSlot(input CreateSlotInput) error {
+	return r.validateSlotAt(input, time.Now())
+}
+
+func (r *AvailabilityRepository) validateSlotAt(input CreateSlotInput, now time.Time) error {
+	if err := r.ValidateSlotDetails(input); err != nil {
+		return err
+	}
+
+	// Check that slot is at least 24 hours in the future. This is 24 elapsed
+	// hours on the Unix clock, so across a DST change the slot's local start
+	// may be 23 or 25 hours of wall-clock time away.
+	minTime := now.Add(SlotLeadTime).Unix()
+	if input.StartTime < minTime {
+		return ErrSlotTooSoon
+	}
+
+	return nil
+}
+
+// ValidateSlotDetails validates a slot's duration and location, but not when
+// it starts. Recurring rules use it for the template of their occurrences.
+func (r *AvailabilityRepository) ValidateSlotDetails(input CreateSlotInput) error {
 	// Check that end time is after start time
 	if input.EndTime <= input.StartTime {
 		return ErrInvalidTimeRange
 	}
 
-	// Check that duration is exactly 1 hour (3600 seconds)
-	if input.EndTime-input.StartTime != 3600 {
+	// Check that duration is between 30 minutes and 12 hours
+	duration := time.Duration(input.EndTime-input.StartTime) * time.Second
+	if duration < MinSlotDuration || duration > MaxSlotDuration {
 		return ErrInvalidDuration
 	}
 
-	// Check that slot is at least 24 hours in the future
-	now := time.Now().Unix()
-	minTime := now + (24 * 3600) // 24 hours from now
-	if input.StartTime < minTime {
-		return ErrSlotTooSoon
-	}
-
 	// Check offline location info for offline/offline_event types
 	if input.DateType == "offline" || input.DateType == "offline_event" {
 		if input.PlaceName == "" || input.Address == "" || input.City == "" ||
@@ -105,11 +136,23 @@ func (r *AvailabilityRepository) Create(ctx context.Context, input CreateSlotInp
 		return nil, err
 	}
 
-	// Check for duplicate slot
+	return insertSlot(ctx, r.db, input)
+}
+
+// slotQuerier is implemented by both *sql.DB and *sql.Tx
+type slotQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// insertSlot inserts a validated slot unless it overlaps one of the user's
+// existing slots
+func insertSlot(ctx context.Context, q slotQuerier, input CreateSlotInput) (*AvailabilitySlot, error) {
+	// Check for an overlapping slot; slots no longer share a fixed length,
+	// so matching start times alone would miss partial overlaps
 	var exists bool
-	err := r.db.QueryRowContext(ctx,
-		"SELECT EXISTS(SELECT 1 FROM datifyy_v2_availability_slots WHERE user_id = $1 AND start_time = $2)",
-		input.UserID, input.StartTime,
+	err := q.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM datifyy_v2_availability_slots WHERE user_id = $1 AND start_time < $3 AND end_time > $2)",
+		input.UserID, input.StartTime, input.EndTime,
 	).Scan(&exists)
 
 	if err != nil {
@@ -117,7 +160,7 @@ func (r *AvailabilityRepository) Create(ctx context.Context, input CreateSlotInp
 	}
 
 	if exists {
-		return nil, ErrDuplicateSlot
+		return nil, ErrOverlappingSlot
 	}
 
 	// Insert slot
@@ -127,14 +170,9 @@ func (r *AvailabilityRepository) Create(ctx context.Context, input CreateSlotInp
 			place_name, address, city, state, country, zipcode,
 			latitude, longitude, google_place_id, google_maps_url, notes
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
-		RETURNING id, user_id, start_time, end_time, date_type,
-		          place_name, address, city, state, country, zipcode,
-		          latitude, longitude, google_place_id, google_maps_url, notes,
-		          created_at, updated_at
-	`
+		RETURNING ` + availabilitySlotColumns
 
-	slot := &AvailabilitySlot{}
-	err = r.db.QueryRowContext(ctx, query,
+	slot, err := scanAvailabilitySlot(q.QueryRowContext(ctx, query,
 		input.UserID,
 		input.StartTime,
 		input.EndTime,
@@ -150,7 +188,23 @@ func (r *AvailabilityRepository) Create(ctx context.Context, input CreateSlotInp
 		nullString(input.GooglePlaceID),
 		nullString(input.GoogleMapsURL),
 		nullString(input.Notes),
-	).Scan(
+	))
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return slot, nil
+}
+
+const availabilitySlotColumns = `id, user_id, start_time, end_time, date_type,
+	place_name, address, city, state, country, zipcode,
+	latitude, longitude, google_place_id, google_maps_url, notes,
+	rule_id, reserved_date_id, created_at, updated_at`
+
+func scanAvailabilitySlot(row rowScanner) (*AvailabilitySlot, error) {
+	slot := &AvailabilitySlot{}
+	err := row.Scan(
 		&slot.ID,
 		&slot.UserID,
 		&slot.StartTime,
@@ -167,14 +221,14 @@ func (r *AvailabilityRepository) Create(ctx context.Context, input CreateSlotInp
 		&slot.GooglePlaceID,
 		&slot.GoogleMapsURL,
 		&slot.Notes,
+		&slot.RuleID,
+		&slot.ReservedDateID,
 		&slot.CreatedAt,
 		&slot.UpdatedAt,
 	)
-
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return nil, err
 	}
-
 	return slot, nil
 }
 
@@ -184,7 +238,7 @@ func (r *AvailabilityRepository) GetByUserID(ctx context.Context, userID int, fr
 		SELECT id, user_id, start_time, end_time, date_type,
 		       place_name, address, city, state, country, zipcode,
 		       latitude, longitude, google_place_id, google_maps_url, notes,
-		       created_at, updated_at
+		       rule_id, reserved_date_id, created_at, updated_at
 		FROM datifyy_v2_availability_slots
 		WHERE user_id = $1
 	`
@@ -228,6 +282,8 @@ func (r *AvailabilityRepository) GetByUserID(ctx context.Context, userID int, fr
 			&slot.GooglePlaceID,
 			&slot.GoogleMapsURL,
 			&slot.Notes,
+			&slot.RuleID,
+			&slot.ReservedDateID,
 			&slot.CreatedAt,
 			&slot.UpdatedAt,
 		)
@@ -246,7 +302,7 @@ func (r *AvailabilityRepository) GetByID(ctx context.Context, slotID int) (*Avai
 		SELECT id, user_id, start_time, end_time, date_type,
 		       place_name, address, city, state, country, zipcode,
 		       latitude, longitude, google_place_id, google_maps_url, notes,
-		       created_at, updated_at
+		       rule_id, reserved_date_id, created_at, updated_at
 		FROM datifyy_v2_availability_slots
 		WHERE id = $1
 	`
@@ -269,6 +325,8 @@ func (r *AvailabilityRepository) GetByID(ctx context.Context, slotID int) (*Avai
 		&slot.GooglePlaceID,
 		&slot.GoogleMapsURL,
 		&slot.Notes,
+		&slot.RuleID,
+		&slot.ReservedDateID,
 		&slot.CreatedAt,
 		&slot.UpdatedAt,
 	)
@@ -283,26 +341,294 @@ func (r *AvailabilityRepository) GetByID(ctx context.Context, slotID int) (*Avai
 	return slot, nil
 }
 
-// Delete deletes an availability slot
+// Delete deletes an availability slot. Slots reserved by a scheduled date
+// can't be deleted. Deleting an occurrence of a recurring rule also adds it to
+// the rule's exdates so expansion doesn't recreate it.
 func (r *AvailabilityRepository) Delete(ctx context.Context, slotID, userID int) error {
-	result, err := r.db.ExecContext(ctx,
-		"DELETE FROM datifyy_v2_availability_slots WHERE id = $1 AND user_id = $2",
-		slotID, userID,
+	query := `
+		WITH deleted AS (
+			DELETE FROM datifyy_v2_availability_slots
+			WHERE id = $1 AND user_id = $2 AND reserved_date_id IS NULL
+			RETURNING rule_id, start_time
+		), excluded AS (
+			UPDATE datifyy_v2_availability_rules ar
+			SET exdates = array_append(ar.exdates, deleted.start_time), updated_at = NOW()
+			FROM deleted
+			WHERE ar.id = deleted.rule_id
+			RETURNING ar.id
+		)
+		SELECT
+			(SELECT COUNT(*) FROM deleted),
+			EXISTS(SELECT 1 FROM datifyy_v2_availability_slots WHERE id = $1 AND user_id = $2 AND reserved_date_id IS NOT NULL)
+	`
+
+	var deleted int
+	var locked bool
+	if err := r.db.QueryRowContext(ctx, query, slotID, userID).Scan(&deleted, &locked); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if locked {
+		return ErrSlotLocked
+	}
+	if deleted == 0 {
+		return ErrSlotNotFound
+	}
+
+	return nil
+}
+
+// SlotBatchError is the error of the slot that stopped a batch
+type SlotBatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *SlotBatchError) Error() string {
+	return fmt.Sprintf("slot %d: %v", e.Index, e.Err)
+}
+
+func (e *SlotBatchError) Unwrap() error {
+	return e.Err
+}
+
+// NewRule is a recurring rule to create in a batch, with the starts of its
+// occurrences in the rolling window
+type NewRule struct {
+	Input         CreateRuleInput
+	Starts        []int64
+	ExpandedUntil int64
+}
+
+// CreateBatch creates slots and rules in a single transaction, so either all
+// of them are created or none are. Slots must already be validated; a slot
+// that can't be created is returned as a *SlotBatchError. The occurrences
+// created for each rule are returned in the same order as rules.
+func (r *AvailabilityRepository) CreateBatch(ctx context.Context, slots []CreateSlotInput, rules []NewRule) ([]*AvailabilitySlot, []*AvailabilityRule, [][]*AvailabilitySlot, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	createdSlots := make([]*AvailabilitySlot, 0, len(slots))
+	for i, input := range slots {
+		slot, err := insertSlot(ctx, tx, input)
+		if err != nil {
+			if errors.Is(err, ErrDatabaseError) {
+				return nil, nil, nil, err
+			}
+			return nil, nil, nil, &SlotBatchError{Index: i, Err: err}
+		}
+		createdSlots = append(createdSlots, slot)
+	}
+
+	createdRules := make([]*AvailabilityRule, 0, len(rules))
+	occurrences := make([][]*AvailabilitySlot, 0, len(rules))
+	for _, newRule := range rules {
+		rule, ruleSlots, err := insertRule(ctx, tx, newRule.Input, newRule.Starts, newRule.ExpandedUntil)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		createdRules = append(createdRules, rule)
+		occurrences = append(occurrences, ruleSlots)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return createdSlots, createdRules, occurrences, nil
+}
+
+// Update replaces a user's slot with input. Slots reserved by a scheduled
+// date can't be updated. Updating an occurrence of a recurring rule detaches
+// it from the rule and adds its original start to the rule's exdates.
+func (r *AvailabilityRepository) Update(ctx context.Context, slotID int, input CreateSlotInput) (*AvailabilitySlot, error) {
+	if err := r.ValidateSlot(input); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	var ruleID, reservedDateID sql.NullInt64
+	var startTime int64
+	err = tx.QueryRowContext(ctx, `
+		SELECT rule_id, reserved_date_id, start_time
+		FROM datifyy_v2_availability_slots
+		WHERE id = $1 AND user_id = $2
+		FOR UPDATE`, slotID, input.UserID,
+	).Scan(&ruleID, &reservedDateID, &startTime)
+	if err == sql.ErrNoRows {
+		return nil, ErrSlotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if reservedDateID.Valid {
+		return nil, ErrSlotLocked
+	}
+
+	var exists bool
+	err = tx.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM datifyy_v2_availability_slots WHERE user_id = $1 AND start_time < $3 AND end_time > $2 AND id <> $4)",
+		input.UserID, input.StartTime, input.EndTime, slotID,
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if exists {
+		return nil, ErrOverlappingSlot
+	}
+
+	if ruleID.Valid {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE datifyy_v2_availability_rules
+			SET exdates = array_append(exdates, $2), updated_at = NOW()
+			WHERE id = $1`, ruleID.Int64, startTime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+	}
+
+	slot, err := scanAvailabilitySlot(tx.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_availability_slots
+		SET start_time = $3, end_time = $4, date_type = $5,
+		    place_name = $6, address = $7, city = $8, state = $9, country = $10, zipcode = $11,
+		    latitude = $12, longitude = $13, google_place_id = $14, google_maps_url = $15, notes = $16,
+		    rule_id = NULL, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2
+		RETURNING `+availabilitySlotColumns,
+		slotID,
+		input.UserID,
+		input.StartTime,
+		input.EndTime,
+		input.DateType,
+		nullString(input.PlaceName),
+		nullString(input.Address),
+		nullString(input.City),
+		nullString(input.State),
+		nullString(input.Country),
+		nullString(input.Zipcode),
+		nullFloat64(input.Latitude),
+		nullFloat64(input.Longitude),
+		nullString(input.GooglePlaceID),
+		nullString(input.GoogleMapsURL),
+		nullString(input.Notes),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return slot, nil
+}
+
+// ClearRange deletes a user's slots that overlap [from, to) and haven't
+// started, except those reserved by a scheduled date, which are returned.
+// The starts of deleted rule occurrences, along with ruleExdates (rule ID ->
+// starts of occurrences not expanded yet), are added to the rules' exdates so
+// expansion doesn't refill the range.
+func (r *AvailabilityRepository) ClearRange(ctx context.Context, userID int, from, to int64, ruleExdates map[int][]int64) (int, []*AvailabilitySlot, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().Unix()
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM datifyy_v2_availability_slots
+		WHERE user_id = $1 AND start_time < $3 AND end_time > $2 AND start_time > $4
+		  AND reserved_date_id IS NULL
+		RETURNING rule_id, start_time`,
+		userID, from, to, now,
 	)
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	exdates := make(map[int][]int64, len(ruleExdates))
+	for ruleID, starts := range ruleExdates {
+		exdates[ruleID] = append(exdates[ruleID], starts...)
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	deleted := 0
+	for rows.Next() {
+		var ruleID sql.NullInt64
+		var startTime int64
+		if err := rows.Scan(&ruleID, &startTime); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		deleted++
+		if ruleID.Valid {
+			exdates[int(ruleID.Int64)] = append(exdates[int(ruleID.Int64)], startTime)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	ruleIDs := make([]int, 0, len(exdates))
+	for ruleID := range exdates {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Ints(ruleIDs)
+
+	for _, ruleID := range ruleIDs {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE datifyy_v2_availability_rules
+			SET exdates = ARRAY(SELECT DISTINCT e FROM unnest(exdates || $3::BIGINT[]) AS e ORDER BY e),
+			    updated_at = NOW()
+			WHERE id = $1 AND user_id = $2`,
+			ruleID, userID, pq.Array(exdates[ruleID]),
+		)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+	}
+
+	reservedRows, err := tx.QueryContext(ctx, `
+		SELECT `+availabilitySlotColumns+`
+		FROM datifyy_v2_availability_slots
+		WHERE user_id = $1 AND start_time < $3 AND end_time > $2 AND start_time > $4
+		  AND reserved_date_id IS NOT NULL
+		ORDER BY start_time ASC`,
+		userID, from, to, now,
+	)
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	defer reservedRows.Close()
 
-	if rowsAffected == 0 {
-		return ErrSlotNotFound
+	var reserved []*AvailabilitySlot
+	for reservedRows.Next() {
+		slot, err := scanAvailabilitySlot(reservedRows)
+		if err != nil {
+			return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		reserved = append(reserved, slot)
+	}
+	if err := reservedRows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
 
-	return nil
+	if err := tx.Commit(); err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return deleted, reserved, nil
 }
 
 // Helper functions for nullable types
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSlot_Duration(t *testing.T) {
+	repo := NewAvailabilityRepository(nil)
+	start := time.Now().Add(48 * time.Hour).Unix()
+
+	for duration, want := range map[time.Duration]error{
+		29 * time.Minute: ErrInvalidDuration,
+		30 * time.Minute: nil,
+		90 * time.Minute: nil,
+		12 * time.Hour:   nil,
+		13 * time.Hour:   ErrInvalidDuration,
+	} {
+		err := repo.ValidateSlot(CreateSlotInput{StartTime: start, EndTime: start + int64(duration.Seconds()), DateType: "online"})
+		assert.Equal(t, want, err, duration.String())
+	}
+}
+
+func TestValidateSlot_LeadTimeAcrossDST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	repo := NewAvailabilityRepository(nil)
+	slot := func(start time.Time) CreateSlotInput {
+		return CreateSlotInput{StartTime: start.Unix(), EndTime: start.Add(time.Hour).Unix(), DateType: "online"}
+	}
+
+	// Clocks spring forward overnight on 2026-03-08: 20:00 the next evening is
+	// only 23 hours away, 21:00 is 24
+	now := time.Date(2026, 3, 7, 20, 0, 0, 0, ny)
+	assert.Equal(t, ErrSlotTooSoon, repo.validateSlotAt(slot(time.Date(2026, 3, 8, 20, 0, 0, 0, ny)), now))
+	assert.NoError(t, repo.validateSlotAt(slot(time.Date(2026, 3, 8, 21, 0, 0, 0, ny)), now))
+
+	// Clocks fall back overnight on 2026-11-01: 19:00 the next evening is
+	// already 24 hours away
+	now = time.Date(2026, 10, 31, 20, 0, 0, 0, ny)
+	assert.NoError(t, repo.validateSlotAt(slot(time.Date(2026, 11, 1, 19, 0, 0, 0, ny)), now))
+	assert.Equal(t, ErrSlotTooSoon, repo.validateSlotAt(slot(time.Date(2026, 11, 1, 18, 59, 0, 0, ny)), now))
+}
@@ -0,0 +1,351 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var ErrRuleNotFound = errors.New("availability rule not found")
+
+// AvailabilityRule is a recurring availability rule. Its occurrences are
+// materialized as availability slots up to ExpandedUntil.
+type AvailabilityRule struct {
+	ID              int
+	UserID          int
+	RRule           string
+	DTStart         int64
+	DurationSeconds int
+	Timezone        string
+	ExDates         []int64
+	DateType        string
+	PlaceName       sql.NullString
+	Address         sql.NullString
+	City            sql.NullString
+	State           sql.NullString
+	Country         sql.NullString
+	Zipcode         sql.NullString
+	Latitude        sql.NullFloat64
+	Longitude       sql.NullFloat64
+	GooglePlaceID   sql.NullString
+	GoogleMapsURL   sql.NullString
+	Notes           sql.NullString
+	ExpandedUntil   int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CreateRuleInput represents input for creating a recurring availability rule.
+// The embedded slot's StartTime and EndTime describe the first occurrence.
+type CreateRuleInput struct {
+	CreateSlotInput
+	RRule    string
+	Timezone string
+	ExDates  []int64
+}
+
+// SlotInput returns the input for the occurrence of the rule starting at start
+func (r *AvailabilityRule) SlotInput(start int64) CreateSlotInput {
+	return CreateSlotInput{
+		UserID:        r.UserID,
+		StartTime:     start,
+		EndTime:       start + int64(r.DurationSeconds),
+		DateType:      r.DateType,
+		PlaceName:     r.PlaceName.String,
+		Address:       r.Address.String,
+		City:          r.City.String,
+		State:         r.State.String,
+		Country:       r.Country.String,
+		Zipcode:       r.Zipcode.String,
+		Latitude:      r.Latitude.Float64,
+		Longitude:     r.Longitude.Float64,
+		GooglePlaceID: r.GooglePlaceID.String,
+		GoogleMapsURL: r.GoogleMapsURL.String,
+		Notes:         r.Notes.String,
+	}
+}
+
+const availabilityRuleColumns = `id, user_id, rrule, dtstart, duration_seconds, timezone, exdates, date_type,
+	place_name, address, city, state, country, zipcode,
+	latitude, longitude, google_place_id, google_maps_url, notes,
+	expanded_until, created_at, updated_at`
+
+func scanAvailabilityRule(row rowScanner) (*AvailabilityRule, error) {
+	rule := &AvailabilityRule{}
+	err := row.Scan(
+		&rule.ID, &rule.UserID, &rule.RRule, &rule.DTStart, &rule.DurationSeconds, &rule.Timezone,
+		pq.Array(&rule.ExDates), &rule.DateType,
+		&rule.PlaceName, &rule.Address, &rule.City, &rule.State, &rule.Country, &rule.Zipcode,
+		&rule.Latitude, &rule.Longitude, &rule.GooglePlaceID, &rule.GoogleMapsURL, &rule.Notes,
+		&rule.ExpandedUntil, &rule.CreatedAt, &rule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// CreateRule creates a recurring rule together with its occurrences starting
+// at starts, recording expandedUntil as how far it has been expanded.
+// Occurrences overlapping an existing slot are skipped.
+func (r *AvailabilityRepository) CreateRule(ctx context.Context, input CreateRuleInput, starts []int64, expandedUntil int64) (*AvailabilityRule, []*AvailabilitySlot, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	rule, slots, err := insertRule(ctx, tx, input, starts, expandedUntil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return rule, slots, nil
+}
+
+// insertRule inserts a rule and its occurrences starting at starts
+func insertRule(ctx context.Context, tx *sql.Tx, input CreateRuleInput, starts []int64, expandedUntil int64) (*AvailabilityRule, []*AvailabilitySlot, error) {
+	exdates := input.ExDates
+	if exdates == nil {
+		exdates = []int64{}
+	}
+
+	rule, err := scanAvailabilityRule(tx.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_availability_rules (
+			user_id, rrule, dtstart, duration_seconds, timezone, exdates, date_type,
+			place_name, address, city, state, country, zipcode,
+			latitude, longitude, google_place_id, google_maps_url, notes, expanded_until
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		RETURNING `+availabilityRuleColumns,
+		input.UserID,
+		input.RRule,
+		input.StartTime,
+		input.EndTime-input.StartTime,
+		input.Timezone,
+		pq.Array(exdates),
+		input.DateType,
+		nullString(input.PlaceName),
+		nullString(input.Address),
+		nullString(input.City),
+		nullString(input.State),
+		nullString(input.Country),
+		nullString(input.Zipcode),
+		nullFloat64(input.Latitude),
+		nullFloat64(input.Longitude),
+		nullString(input.GooglePlaceID),
+		nullString(input.GoogleMapsURL),
+		nullString(input.Notes),
+		expandedUntil,
+	))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	slots, err := insertRuleOccurrences(ctx, tx, rule, starts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rule, slots, nil
+}
+
+// ExtendRule adds the occurrences of a rule starting at starts and moves its
+// expanded_until forward. Occurrences overlapping an existing slot are skipped.
+func (r *AvailabilityRepository) ExtendRule(ctx context.Context, rule *AvailabilityRule, starts []int64, expandedUntil int64) ([]*AvailabilitySlot, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	slots, err := insertRuleOccurrences(ctx, tx, rule, starts)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_availability_rules
+		SET expanded_until = GREATEST(expanded_until, $2), updated_at = NOW()
+		WHERE id = $1`, rule.ID, expandedUntil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	rule.ExpandedUntil = expandedUntil
+	return slots, nil
+}
+
+// insertRuleOccurrences inserts a slot per start, skipping any that would
+// overlap one of the user's existing slots
+func insertRuleOccurrences(ctx context.Context, tx *sql.Tx, rule *AvailabilityRule, starts []int64) ([]*AvailabilitySlot, error) {
+	query := `
+		INSERT INTO datifyy_v2_availability_slots (
+			user_id, start_time, end_time, date_type,
+			place_name, address, city, state, country, zipcode,
+			latitude, longitude, google_place_id, google_maps_url, notes, rule_id
+		)
+		SELECT $1::INTEGER, $2::BIGINT, $3::BIGINT, $4::date_type,
+		       $5::VARCHAR, $6::TEXT, $7::VARCHAR, $8::VARCHAR, $9::VARCHAR, $10::VARCHAR,
+		       $11::DOUBLE PRECISION, $12::DOUBLE PRECISION, $13::VARCHAR, $14::TEXT, $15::TEXT, $16::INTEGER
+		WHERE NOT EXISTS (
+			SELECT 1 FROM datifyy_v2_availability_slots
+			WHERE user_id = $1 AND start_time < $3 AND end_time > $2
+		)
+		ON CONFLICT DO NOTHING
+		RETURNING id, user_id, start_time, end_time, date_type,
+		          place_name, address, city, state, country, zipcode,
+		          latitude, longitude, google_place_id, google_maps_url, notes,
+		          rule_id, reserved_date_id, created_at, updated_at
+	`
+
+	var slots []*AvailabilitySlot
+	for _, start := range starts {
+		slot := &AvailabilitySlot{}
+		err := tx.QueryRowContext(ctx, query,
+			rule.UserID,
+			start,
+			start+int64(rule.DurationSeconds),
+			rule.DateType,
+			rule.PlaceName,
+			rule.Address,
+			rule.City,
+			rule.State,
+			rule.Country,
+			rule.Zipcode,
+			rule.Latitude,
+			rule.Longitude,
+			rule.GooglePlaceID,
+			rule.GoogleMapsURL,
+			rule.Notes,
+			rule.ID,
+		).Scan(
+			&slot.ID,
+			&slot.UserID,
+			&slot.StartTime,
+			&slot.EndTime,
+			&slot.DateType,
+			&slot.PlaceName,
+			&slot.Address,
+			&slot.City,
+			&slot.State,
+			&slot.Country,
+			&slot.Zipcode,
+			&slot.Latitude,
+			&slot.Longitude,
+			&slot.GooglePlaceID,
+			&slot.GoogleMapsURL,
+			&slot.Notes,
+			&slot.RuleID,
+			&slot.ReservedDateID,
+			&slot.CreatedAt,
+			&slot.UpdatedAt,
+		)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots, nil
+}
+
+// GetRulesByUserID gets all recurring availability rules for a user
+func (r *AvailabilityRepository) GetRulesByUserID(ctx context.Context, userID int) ([]*AvailabilityRule, error) {
+	return r.queryRules(ctx, `
+		SELECT `+availabilityRuleColumns+`
+		FROM datifyy_v2_availability_rules
+		WHERE user_id = $1
+		ORDER BY dtstart ASC, id ASC`, userID)
+}
+
+// GetRulesToExpand gets up to limit rules whose occurrences have not yet been
+// materialized up to horizon. Rules of suspended, deactivated or deleted
+// accounts are skipped so they don't keep offering slots.
+func (r *AvailabilityRepository) GetRulesToExpand(ctx context.Context, horizon int64, limit int) ([]*AvailabilityRule, error) {
+	return r.queryRules(ctx, `
+		SELECT `+availabilityRuleColumns+`
+		FROM datifyy_v2_availability_rules
+		WHERE expanded_until < $1
+		  AND user_id IN (SELECT id FROM datifyy_v2_users WHERE account_status = 'ACTIVE')
+		ORDER BY expanded_until ASC, id ASC
+		LIMIT $2`, horizon, limit)
+}
+
+func (r *AvailabilityRepository) queryRules(ctx context.Context, query string, args ...interface{}) ([]*AvailabilityRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	var rules []*AvailabilityRule
+	for rows.Next() {
+		rule, err := scanAvailabilityRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// DeleteRule deletes a user's recurring rule along with its occurrences that
+// haven't started yet. Past occurrences and those reserved by a scheduled date
+// are kept, detached from the rule.
+func (r *AvailabilityRepository) DeleteRule(ctx context.Context, ruleID, userID int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM datifyy_v2_availability_slots
+		WHERE rule_id = (SELECT id FROM datifyy_v2_availability_rules WHERE id = $1 AND user_id = $2)
+		  AND start_time > $3
+		  AND reserved_date_id IS NULL`,
+		ruleID, userID, time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	result, err := tx.ExecContext(ctx,
+		"DELETE FROM datifyy_v2_availability_rules WHERE id = $1 AND user_id = $2",
+		ruleID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrRuleNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return nil
+}
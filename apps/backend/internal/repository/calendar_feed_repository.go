@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrFeedTokenNotFound is returned when a feed token doesn't exist or has been revoked
+	ErrFeedTokenNotFound = errors.New("calendar feed token not found")
+)
+
+// CalendarFeedRepository handles the secret tokens of users' iCal feed URLs.
+// Tokens are stored hashed; callers hash them before lookup.
+type CalendarFeedRepository struct {
+	db *sql.DB
+}
+
+// NewCalendarFeedRepository creates a new repository
+func NewCalendarFeedRepository(db *sql.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// CreateFeedToken stores a new feed token for a user, revoking the previous
+// one so only the newest feed URL works
+func (r *CalendarFeedRepository) CreateFeedToken(ctx context.Context, userID int, tokenHash string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_calendar_feed_tokens SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_calendar_feed_tokens (user_id, token_hash)
+		VALUES ($1, $2)`, userID, tokenHash)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// RevokeFeedTokens revokes a user's active feed token, returning whether there was one
+func (r *CalendarFeedRepository) RevokeFeedTokens(ctx context.Context, userID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE datifyy_v2_calendar_feed_tokens SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL`, userID)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	revoked, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return revoked > 0, nil
+}
+
+// GetFeedUser returns the user an active feed token belongs to and records the access
+func (r *CalendarFeedRepository) GetFeedUser(ctx context.Context, tokenHash string) (int, error) {
+	var userID int
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_calendar_feed_tokens SET last_accessed_at = NOW()
+		WHERE token_hash = $1 AND revoked_at IS NULL
+		RETURNING user_id`, tokenHash,
+	).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return 0, ErrFeedTokenNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return userID, nil
+}
@@ -9,6 +9,12 @@ import (
 	"github.com/lib/pq"
 )
 
+// Curated match sources
+const (
+	CuratedMatchSourceAIAnalysis = "ai_analysis"
+	CuratedMatchSourceMutualLike = "mutual_like"
+)
+
 // CuratedMatch represents a curated match record
 type CuratedMatch struct {
 	ID                  int
@@ -22,6 +28,7 @@ type CuratedMatch struct {
 	AIProvider          string
 	AIModel             string
 	Status              string
+	Source              string // ai_analysis or mutual_like
 	CreatedByAdmin      *int
 	ScheduledDateID     *int
 	CreatedAt           time.Time
@@ -44,16 +51,20 @@ func (r *CuratedMatchesRepository) Create(ctx context.Context, match *CuratedMat
 		INSERT INTO datifyy_v2_curated_matches (
 			user1_id, user2_id, compatibility_score, is_match,
 			reasoning, matched_aspects, mismatched_aspects,
-			ai_provider, ai_model, status, created_by_admin
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			ai_provider, ai_model, status, source, created_by_admin
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING id, created_at, updated_at
 	`
 
+	if match.Source == "" {
+		match.Source = CuratedMatchSourceAIAnalysis
+	}
+
 	err := r.db.QueryRowContext(
 		ctx, query,
 		match.User1ID, match.User2ID, match.CompatibilityScore, match.IsMatch,
 		match.Reasoning, pq.Array(match.MatchedAspects), pq.Array(match.MismatchedAspects),
-		match.AIProvider, match.AIModel, match.Status, match.CreatedByAdmin,
+		match.AIProvider, match.AIModel, match.Status, match.Source, match.CreatedByAdmin,
 	).Scan(&match.ID, &match.CreatedAt, &match.UpdatedAt)
 
 	if err != nil {
@@ -68,7 +79,7 @@ func (r *CuratedMatchesRepository) GetByID(ctx context.Context, id int) (*Curate
 	query := `
 		SELECT id, user1_id, user2_id, compatibility_score, is_match,
 			   reasoning, matched_aspects, mismatched_aspects,
-			   ai_provider, ai_model, status, created_by_admin,
+			   ai_provider, ai_model, status, source, created_by_admin,
 			   scheduled_date_id, created_at, updated_at
 		FROM datifyy_v2_curated_matches
 		WHERE id = $1
@@ -78,7 +89,7 @@ func (r *CuratedMatchesRepository) GetByID(ctx context.Context, id int) (*Curate
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&match.ID, &match.User1ID, &match.User2ID, &match.CompatibilityScore, &match.IsMatch,
 		&match.Reasoning, pq.Array(&match.MatchedAspects), pq.Array(&match.MismatchedAspects),
-		&match.AIProvider, &match.AIModel, &match.Status, &match.CreatedByAdmin,
+		&match.AIProvider, &match.AIModel, &match.Status, &match.Source, &match.CreatedByAdmin,
 		&match.ScheduledDateID, &match.CreatedAt, &match.UpdatedAt,
 	)
 
@@ -97,7 +108,7 @@ func (r *CuratedMatchesRepository) GetByUserPair(ctx context.Context, user1ID, u
 	query := `
 		SELECT id, user1_id, user2_id, compatibility_score, is_match,
 			   reasoning, matched_aspects, mismatched_aspects,
-			   ai_provider, ai_model, status, created_by_admin,
+			   ai_provider, ai_model, status, source, created_by_admin,
 			   scheduled_date_id, created_at, updated_at
 		FROM datifyy_v2_curated_matches
 		WHERE (user1_id = $1 AND user2_id = $2) OR (user1_id = $2 AND user2_id = $1)
@@ -107,7 +118,7 @@ func (r *CuratedMatchesRepository) GetByUserPair(ctx context.Context, user1ID, u
 	err := r.db.QueryRowContext(ctx, query, user1ID, user2ID).Scan(
 		&match.ID, &match.User1ID, &match.User2ID, &match.CompatibilityScore, &match.IsMatch,
 		&match.Reasoning, pq.Array(&match.MatchedAspects), pq.Array(&match.MismatchedAspects),
-		&match.AIProvider, &match.AIModel, &match.Status, &match.CreatedByAdmin,
+		&match.AIProvider, &match.AIModel, &match.Status, &match.Source, &match.CreatedByAdmin,
 		&match.ScheduledDateID, &match.CreatedAt, &match.UpdatedAt,
 	)
 
@@ -126,7 +137,7 @@ func (r *CuratedMatchesRepository) ListByAdmin(ctx context.Context, adminID int,
 	query := `
 		SELECT id, user1_id, user2_id, compatibility_score, is_match,
 			   reasoning, matched_aspects, mismatched_aspects,
-			   ai_provider, ai_model, status, created_by_admin,
+			   ai_provider, ai_model, status, source, created_by_admin,
 			   scheduled_date_id, created_at, updated_at
 		FROM datifyy_v2_curated_matches
 		WHERE created_by_admin = $1
@@ -146,7 +157,7 @@ func (r *CuratedMatchesRepository) ListByAdmin(ctx context.Context, adminID int,
 		err := rows.Scan(
 			&match.ID, &match.User1ID, &match.User2ID, &match.CompatibilityScore, &match.IsMatch,
 			&match.Reasoning, pq.Array(&match.MatchedAspects), pq.Array(&match.MismatchedAspects),
-			&match.AIProvider, &match.AIModel, &match.Status, &match.CreatedByAdmin,
+			&match.AIProvider, &match.AIModel, &match.Status, &match.Source, &match.CreatedByAdmin,
 			&match.ScheduledDateID, &match.CreatedAt, &match.UpdatedAt,
 		)
 		if err != nil {
@@ -183,7 +194,7 @@ func (r *CuratedMatchesRepository) ListByStatus(ctx context.Context, status stri
 	query := `
 		SELECT id, user1_id, user2_id, compatibility_score, is_match,
 			   reasoning, matched_aspects, mismatched_aspects,
-			   ai_provider, ai_model, status, created_by_admin,
+			   ai_provider, ai_model, status, source, created_by_admin,
 			   scheduled_date_id, created_at, updated_at
 		FROM datifyy_v2_curated_matches
 		WHERE status = $1
@@ -203,7 +214,7 @@ func (r *CuratedMatchesRepository) ListByStatus(ctx context.Context, status stri
 		err := rows.Scan(
 			&match.ID, &match.User1ID, &match.User2ID, &match.CompatibilityScore, &match.IsMatch,
 			&match.Reasoning, pq.Array(&match.MatchedAspects), pq.Array(&match.MismatchedAspects),
-			&match.AIProvider, &match.AIModel, &match.Status, &match.CreatedByAdmin,
+			&match.AIProvider, &match.AIModel, &match.Status, &match.Source, &match.CreatedByAdmin,
 			&match.ScheduledDateID, &match.CreatedAt, &match.UpdatedAt,
 		)
 		if err != nil {
@@ -225,3 +236,61 @@ func (r *CuratedMatchesRepository) CountByStatus(ctx context.Context, status str
 	}
 	return count, nil
 }
+
+// CreateFromMutualLike creates a pending mutual_like match for two users who
+// liked each other, unless the pair already has a match (in either order).
+// created is false when a match already existed.
+func (r *CuratedMatchesRepository) CreateFromMutualLike(ctx context.Context, userAID, userBID int) (matchID int, created bool, err error) {
+	// Canonical order so concurrent likes from both sides hit the unique constraint
+	user1ID, user2ID := userAID, userBID
+	if user1ID > user2ID {
+		user1ID, user2ID = user2ID, user1ID
+	}
+
+	query := `
+		INSERT INTO datifyy_v2_curated_matches (
+			user1_id, user2_id, compatibility_score, is_match, reasoning,
+			matched_aspects, mismatched_aspects, ai_provider, status, source
+		)
+		SELECT $1, $2, 0, TRUE, 'Both users liked each other',
+		       '{}', '{}', 'none', 'pending', $3
+		WHERE NOT EXISTS (
+			SELECT 1 FROM datifyy_v2_curated_matches
+			WHERE (user1_id = $1 AND user2_id = $2) OR (user1_id = $2 AND user2_id = $1)
+		)
+		ON CONFLICT (user1_id, user2_id) DO NOTHING
+		RETURNING id
+	`
+
+	err = r.db.QueryRowContext(ctx, query, user1ID, user2ID, CuratedMatchSourceMutualLike).Scan(&matchID)
+	if err == sql.ErrNoRows {
+		existing, err := r.GetByUserPair(ctx, user1ID, user2ID)
+		if err != nil {
+			return 0, false, err
+		}
+		if existing == nil {
+			return 0, false, fmt.Errorf("failed to create mutual like match: match not found")
+		}
+		return existing.ID, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to create mutual like match: %w", err)
+	}
+
+	return matchID, true, nil
+}
+
+// WithdrawPendingMutualLike withdraws a pair's mutual_like match if genies
+// haven't acted on it yet
+func (r *CuratedMatchesRepository) WithdrawPendingMutualLike(ctx context.Context, userAID, userBID int) error {
+	query := `
+		UPDATE datifyy_v2_curated_matches SET status = 'withdrawn'
+		WHERE source = $3 AND status = 'pending'
+		  AND ((user1_id = $1 AND user2_id = $2) OR (user1_id = $2 AND user2_id = $1))
+	`
+	_, err := r.db.ExecContext(ctx, query, userAID, userBID, CuratedMatchSourceMutualLike)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw mutual like match: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrDataExportNotFound is returned when a data export does not exist
+	ErrDataExportNotFound = errors.New("data export not found")
+
+	// ErrDataExportTooSoon is returned when a user already has an export in
+	// progress or requested one within the cooldown
+	ErrDataExportTooSoon = errors.New("data export requested too recently")
+)
+
+// DataExport represents a personal data export request
+type DataExport struct {
+	ID            int
+	UserID        int
+	Status        string
+	StorageKey    sql.NullString
+	FileSize      sql.NullInt64
+	ErrorMessage  sql.NullString
+	ExpiresAt     sql.NullTime
+	DownloadedAt  sql.NullTime
+	DownloadCount int
+	RequestedAt   time.Time
+	CompletedAt   sql.NullTime
+}
+
+// DataExportRepository handles database operations for data exports
+type DataExportRepository struct {
+	db *sql.DB
+}
+
+// NewDataExportRepository creates a new repository
+func NewDataExportRepository(db *sql.DB) *DataExportRepository {
+	return &DataExportRepository{db: db}
+}
+
+const dataExportColumns = `
+	id, user_id, status, storage_key, file_size, error_message,
+	expires_at, downloaded_at, download_count, requested_at, completed_at
+`
+
+func scanDataExport(row interface{ Scan(...interface{}) error }) (*DataExport, error) {
+	export := &DataExport{}
+	err := row.Scan(
+		&export.ID, &export.UserID, &export.Status, &export.StorageKey, &export.FileSize, &export.ErrorMessage,
+		&export.ExpiresAt, &export.DownloadedAt, &export.DownloadCount, &export.RequestedAt, &export.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// Create enqueues a new pending data export for a user, failing with
+// ErrDataExportTooSoon if they requested one within cooldown. Failed exports
+// don't count. The check and insert are one statement, and the unique index
+// on in-flight exports stops concurrent requests both getting through.
+func (r *DataExportRepository) Create(ctx context.Context, userID int, cooldown time.Duration) (*DataExport, error) {
+	query := `
+		INSERT INTO datifyy_v2_data_exports (user_id, status)
+		SELECT $1, 'pending'
+		WHERE NOT EXISTS (
+			SELECT 1 FROM datifyy_v2_data_exports
+			WHERE user_id = $1 AND status <> 'failed'
+			  AND requested_at > NOW() - $2 * INTERVAL '1 second'
+		)
+		ON CONFLICT (user_id) WHERE status IN ('pending', 'processing') DO NOTHING
+		RETURNING ` + dataExportColumns
+
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, query, userID, int64(cooldown.Seconds())))
+	if err == sql.ErrNoRows {
+		return nil, ErrDataExportTooSoon
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// GetByID retrieves a data export by ID
+func (r *DataExportRepository) GetByID(ctx context.Context, id int) (*DataExport, error) {
+	query := `SELECT ` + dataExportColumns + ` FROM datifyy_v2_data_exports WHERE id = $1`
+
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrDataExportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// GetLatestByUserID retrieves the most recent export request for a user that
+// didn't fail (nil if none)
+func (r *DataExportRepository) GetLatestByUserID(ctx context.Context, userID int) (*DataExport, error) {
+	query := `
+		SELECT ` + dataExportColumns + `
+		FROM datifyy_v2_data_exports
+		WHERE user_id = $1 AND status <> 'failed'
+		ORDER BY requested_at DESC
+		LIMIT 1
+	`
+
+	export, err := scanDataExport(r.db.QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest data export: %w", err)
+	}
+
+	return export, nil
+}
+
+// ClaimPending atomically moves up to limit pending exports to processing and returns them
+func (r *DataExportRepository) ClaimPending(ctx context.Context, limit int) ([]*DataExport, error) {
+	query := `
+		UPDATE datifyy_v2_data_exports
+		SET status = 'processing'
+		WHERE id IN (
+			SELECT id FROM datifyy_v2_data_exports
+			WHERE status = 'pending'
+			ORDER BY requested_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + dataExportColumns
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	return exports, rows.Err()
+}
+
+// MarkCompleted records the built archive and its link expiry
+func (r *DataExportRepository) MarkCompleted(ctx context.Context, id int, storageKey string, fileSize int64, expiresAt time.Time) error {
+	query := `
+		UPDATE datifyy_v2_data_exports
+		SET status = 'completed', storage_key = $2, file_size = $3,
+			expires_at = $4, completed_at = NOW(), error_message = NULL
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, storageKey, fileSize, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark data export completed: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed export
+func (r *DataExportRepository) MarkFailed(ctx context.Context, id int, errorMessage string) error {
+	query := `
+		UPDATE datifyy_v2_data_exports
+		SET status = 'failed', error_message = $2, completed_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id, errorMessage)
+	if err != nil {
+		return fmt.Errorf("failed to mark data export failed: %w", err)
+	}
+
+	return nil
+}
+
+// RecordDownload logs a download of a completed export
+func (r *DataExportRepository) RecordDownload(ctx context.Context, id int) error {
+	query := `
+		UPDATE datifyy_v2_data_exports
+		SET downloaded_at = NOW(), download_count = download_count + 1
+		WHERE id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to record data export download: %w", err)
+	}
+
+	return nil
+}
+
+// ExpireCompleted marks completed exports whose link has expired and returns them
+// so their archives can be removed from storage
+func (r *DataExportRepository) ExpireCompleted(ctx context.Context, now time.Time) ([]*DataExport, error) {
+	query := `
+		UPDATE datifyy_v2_data_exports
+		SET status = 'expired'
+		WHERE status = 'completed' AND expires_at <= $1
+		RETURNING ` + dataExportColumns
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire data exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*DataExport
+	for rows.Next() {
+		export, err := scanDataExport(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan data export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+
+	return exports, rows.Err()
+}
+
+// =============================================================================
+// Data Collection
+// =============================================================================
+
+// DataExportDomain names one JSON file in a data export archive and the query
+// that produces it. Column lists are explicit so secrets such as password hashes
+// and tokens never leave the database.
+type DataExportDomain struct {
+	Name  string
+	Query string
+}
+
+// DataExportDomains lists every domain included in a user's data export
+var DataExportDomains = []DataExportDomain{
+	{
+		Name: "account",
+		Query: `
+			SELECT id, email, name, phone_number, email_verified, phone_verified,
+				   account_status, photo_url, date_of_birth, gender, last_login_at,
+				   created_at, updated_at
+			FROM datifyy_v2_users WHERE id = $1`,
+	},
+	{
+		Name:  "profile",
+		Query: `SELECT * FROM datifyy_v2_user_profiles WHERE user_id = $1`,
+	},
+	{
+		Name:  "partner_preferences",
+		Query: `SELECT * FROM datifyy_v2_partner_preferences WHERE user_id = $1`,
+	},
+	{
+		Name:  "user_preferences",
+		Query: `SELECT * FROM user_preferences WHERE user_id = $1`,
+	},
+	{
+		Name: "photos",
+		Query: `
+			SELECT photo_id, url, thumbnail_url, display_order, is_primary, caption, uploaded_at
+			FROM user_photos WHERE user_id = $1
+			ORDER BY display_order`,
+	},
+	{
+		Name: "work_email_verifications",
+		Query: `
+			SELECT email, domain, verified_at, created_at
+			FROM datifyy_v2_work_email_verifications WHERE user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "id_verifications",
+		Query: `
+			SELECT document_type, id_number_masked, status, rejection_reason, reviewed_at, created_at
+			FROM datifyy_v2_id_verifications WHERE user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "sessions",
+		Query: `
+			SELECT id, device_id, expires_at, is_active, last_active_at, created_at
+			FROM datifyy_v2_sessions WHERE user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "devices",
+		Query: `
+			SELECT device_id, device_name, platform, os_version, app_version, browser,
+				   is_trusted, login_count, last_ip_address, last_location, last_active_at, created_at
+			FROM datifyy_v2_devices WHERE user_id = $1
+			ORDER BY last_active_at DESC`,
+	},
+	{
+		Name: "blocks",
+		Query: `
+			SELECT blocked_user_id, reason, created_at
+			FROM user_blocks WHERE blocker_user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "reports_filed",
+		Query: `
+			SELECT report_id, reported_user_id, reason, details, evidence_urls, status, created_at
+			FROM datifyy_v2_user_reports WHERE reporter_user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "suggestions",
+		Query: `
+			SELECT id, suggested_user_id, compatibility_score, reasoning, status,
+				   scheduled_date_id, created_at, responded_at
+			FROM datifyy_v2_date_suggestions WHERE user_id = $1
+			ORDER BY created_at DESC`,
+	},
+	{
+		Name: "scheduled_dates",
+		Query: `
+			SELECT id, user1_id, user2_id, scheduled_time, duration_minutes, status, date_type,
+				   place_name, address, city, state, country, notes,
+				   confirmed_at, completed_at, cancelled_at, created_at
+			FROM datifyy_v2_scheduled_dates WHERE user1_id = $1 OR user2_id = $1
+			ORDER BY scheduled_time DESC`,
+	},
+	{
+		Name: "rejections",
+		Query: `
+			SELECT id, rejected_user_id, suggestion_id, scheduled_date_id, reasons, custom_reason, created_at
+			FROM datifyy_v2_date_rejections WHERE user_id = $1
+			ORDER BY created_at DESC`,
+	},
+}
+
+// CollectDomain runs a domain query for a user and returns each row as a column map
+func (r *DataExportRepository) CollectDomain(ctx context.Context, domain DataExportDomain, userID int) ([]map[string]interface{}, error) {
+	rows, err := r.db.QueryContext(ctx, domain.Query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect %s: %w", domain.Name, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s columns: %w", domain.Name, err)
+	}
+
+	records := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", domain.Name, err)
+		}
+
+		record := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			record[column] = exportValue(values[i])
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// exportValue converts a raw driver value into something JSON-friendly.
+// JSONB columns arrive as bytes and are embedded as JSON; other bytes become strings.
+func exportValue(v interface{}) interface{} {
+	b, ok := v.([]byte)
+	if !ok {
+		return v
+	}
+	if len(b) > 0 && (b[0] == '{' || b[0] == '[') && json.Valid(b) {
+		return json.RawMessage(b)
+	}
+	return string(b)
+}
@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/datifyy/backend/internal/datestatus"
+)
+
+// Date activity actions
+const (
+	DateActivityCreated       = "created"
+	DateActivityStatusChanged = "status_changed"
+	DateActivityRescheduled   = "rescheduled"
+)
+
+// Kinds of actor that change dates
+const (
+	DateActorAdmin  = "admin"
+	DateActorUser   = "user"
+	DateActorSystem = "system"
+)
+
+// DateActor is who made a change to a scheduled date
+type DateActor struct {
+	Type    string // DateActorAdmin, DateActorUser or DateActorSystem
+	AdminID int    // admin or genie, 0 if unknown
+	UserID  int    // participant
+}
+
+// DateActivity is an entry in a scheduled date's activity log
+type DateActivity struct {
+	ID        int
+	DateID    int
+	Action    string
+	OldValue  sql.NullString
+	NewValue  sql.NullString
+	Notes     sql.NullString
+	ActorType string
+	AdminID   sql.NullInt64
+	UserID    sql.NullInt64
+	ActorName string
+	CreatedAt time.Time
+}
+
+func (a DateActor) adminID() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(a.AdminID), Valid: a.Type == DateActorAdmin && a.AdminID != 0}
+}
+
+func (a DateActor) userID() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(a.UserID), Valid: a.Type == DateActorUser && a.UserID != 0}
+}
+
+// dateCreator is the actor recorded for a newly scheduled date: its genie,
+// or an unidentified admin
+func dateCreator(date *ScheduledDate) DateActor {
+	return DateActor{Type: DateActorAdmin, AdminID: int(date.GenieID.Int64)}
+}
+
+// insertDateActivity adds an entry to a date's activity log
+func insertDateActivity(ctx context.Context, tx *sql.Tx, dateID int, action, oldValue, newValue, notes string, actor DateActor) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_date_activity_log (
+			date_id, action, old_value, new_value, notes, actor_type, admin_id, user_id
+		) VALUES ($1, $2, NULLIF($3, ''), NULLIF($4, ''), NULLIF($5, ''), $6, $7, $8)`,
+		dateID, action, oldValue, newValue, notes, actor.Type, actor.adminID(), actor.userID(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to log date activity: %w", err)
+	}
+	return nil
+}
+
+// logDateCancellations logs dates cancelled in bulk on someone's behalf
+func logDateCancellations(ctx context.Context, tx *sql.Tx, dates []CancelledDate, reason string, actor DateActor) error {
+	for _, d := range dates {
+		if err := insertDateActivity(ctx, tx, d.DateID, DateActivityStatusChanged, d.PreviousStatus, datestatus.Cancelled, reason, actor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transitionDate moves a date to status to, if its lifecycle allows it, and
+// logs the change. Errors from datestatus.Check are returned as they are.
+// Notes are the cancellation reason when cancelling, and are also kept as
+// the date's admin notes when an admin makes the change. Cancelling releases
+// the participants' slots.
+func transitionDate(ctx context.Context, tx *sql.Tx, dateID int, to string, actor DateActor, notes string) (*ScheduledDate, error) {
+	var from string
+	err := tx.QueryRowContext(ctx,
+		`SELECT status FROM datifyy_v2_scheduled_dates WHERE id = $1 FOR UPDATE`, dateID,
+	).Scan(&from)
+	if err == sql.ErrNoRows {
+		return nil, ErrDateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock date: %w", err)
+	}
+
+	if err := datestatus.Check(from, to); err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{to, dateID}
+	set := "status = $1"
+	if actor.Type == DateActorAdmin && notes != "" {
+		args = append(args, notes)
+		set += fmt.Sprintf(", admin_notes = $%d", len(args))
+	}
+	switch to {
+	case datestatus.Confirmed:
+		set += ", confirmed_at = CURRENT_TIMESTAMP"
+	case datestatus.Completed:
+		set += ", completed_at = CURRENT_TIMESTAMP"
+	case datestatus.Cancelled:
+		set += ", cancelled_at = CURRENT_TIMESTAMP"
+		if adminID := actor.adminID(); adminID.Valid {
+			args = append(args, adminID)
+			set += fmt.Sprintf(", cancelled_by = $%d", len(args))
+		}
+		if userID := actor.userID(); userID.Valid {
+			args = append(args, userID)
+			set += fmt.Sprintf(", cancelled_by_user_id = $%d", len(args))
+		}
+		if notes != "" {
+			args = append(args, notes)
+			set += fmt.Sprintf(", cancellation_reason = $%d", len(args))
+		}
+	}
+
+	var d ScheduledDate
+	err = tx.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_scheduled_dates
+		SET `+set+`
+		WHERE id = $2
+		RETURNING id, user1_id, user2_id, genie_id, scheduled_time, duration_minutes,
+		          status, date_type, place_name, address, city, state, country, zipcode,
+		          latitude, longitude, notes, admin_notes, created_at, updated_at,
+		          confirmed_at, completed_at, cancelled_at`,
+		args...,
+	).Scan(
+		&d.ID, &d.User1ID, &d.User2ID, &d.GenieID, &d.ScheduledTime, &d.DurationMinutes,
+		&d.Status, &d.DateType, &d.PlaceName, &d.Address, &d.City, &d.State, &d.Country,
+		&d.Zipcode, &d.Latitude, &d.Longitude, &d.Notes, &d.AdminNotes, &d.CreatedAt,
+		&d.UpdatedAt, &d.ConfirmedAt, &d.CompletedAt, &d.CancelledAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update date status: %w", err)
+	}
+
+	// Cancelled dates give their slots back
+	if to == datestatus.Cancelled {
+		if err := releaseDateSlots(ctx, tx, []int{d.ID}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := insertDateActivity(ctx, tx, d.ID, DateActivityStatusChanged, from, to, notes, actor); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// GetDateActivity gets a scheduled date's activity log, oldest first, with
+// the name of the admin or user behind each entry
+func (r *AdminRepository) GetDateActivity(ctx context.Context, dateID int) ([]DateActivity, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS(SELECT 1 FROM datifyy_v2_scheduled_dates WHERE id = $1)`, dateID,
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get date: %w", err)
+	}
+	if !exists {
+		return nil, ErrDateNotFound
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.date_id, l.action, l.old_value, l.new_value, l.notes, l.actor_type,
+		       l.admin_id, l.user_id, COALESCE(a.name, u.name, ''), l.created_at
+		FROM datifyy_v2_date_activity_log l
+		LEFT JOIN datifyy_v2_admin_users a ON a.id = l.admin_id
+		LEFT JOIN datifyy_v2_users u ON u.id = l.user_id
+		WHERE l.date_id = $1
+		ORDER BY l.created_at ASC, l.id ASC`, dateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get date activity: %w", err)
+	}
+	defer rows.Close()
+
+	var activity []DateActivity
+	for rows.Next() {
+		var a DateActivity
+		err := rows.Scan(
+			&a.ID, &a.DateID, &a.Action, &a.OldValue, &a.NewValue, &a.Notes, &a.ActorType,
+			&a.AdminID, &a.UserID, &a.ActorName, &a.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan date activity: %w", err)
+		}
+		activity = append(activity, a)
+	}
+
+	return activity, rows.Err()
+}
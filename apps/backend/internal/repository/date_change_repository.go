@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/datifyy/backend/internal/datestatus"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrRescheduleNotFound = errors.New("reschedule request not found")
+	ErrReschedulePending  = errors.New("date already has a pending reschedule request")
+	ErrRescheduleAnswered = errors.New("reschedule request has already been answered")
+	ErrDateNotActive      = errors.New("date is no longer upcoming")
+	// ErrRescheduleTimePassed is returned when accepting a proposed time that
+	// is no longer in the future
+	ErrRescheduleTimePassed = errors.New("proposed time has passed")
+)
+
+// Reschedule request statuses
+const (
+	RescheduleStatusPending   = "pending"
+	RescheduleStatusAccepted  = "accepted"
+	RescheduleStatusDeclined  = "declined"
+	RescheduleStatusWithdrawn = "withdrawn"
+)
+
+// RescheduleRequest is a participant's proposal to move a scheduled date,
+// awaiting the other participant's answer
+type RescheduleRequest struct {
+	ID            int
+	DateID        int
+	UserID        int // requester
+	ResponderID   int
+	ProposedTimes []int64 // start times, Unix seconds
+	Reason        sql.NullString
+	Status        string
+	AcceptedTime  sql.NullInt64
+	CreatedAt     time.Time
+	RespondedAt   sql.NullTime
+}
+
+// DateReliability is how many dates a user has cancelled
+type DateReliability struct {
+	UserID            int
+	Cancellations     int
+	LateCancellations int
+	UpdatedAt         sql.NullTime
+}
+
+const rescheduleRequestColumns = `id, date_id, user_id, responder_id, proposed_times, reason, status,
+	accepted_time, created_at, responded_at`
+
+func scanRescheduleRequest(row interface{ Scan(...interface{}) error }) (*RescheduleRequest, error) {
+	var req RescheduleRequest
+	err := row.Scan(
+		&req.ID, &req.DateID, &req.UserID, &req.ResponderID, pq.Array(&req.ProposedTimes), &req.Reason,
+		&req.Status, &req.AcceptedTime, &req.CreatedAt, &req.RespondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// CancelByUser cancels a date on behalf of one of its participants, counting
+// it against their reliability and withdrawing any pending reschedule request
+func (r *ScheduledDatesRepository) CancelByUser(ctx context.Context, dateID, userID int, reason string, late bool) (*ScheduledDate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	date, err := transitionDate(ctx, tx, dateID, datestatus.Cancelled, DateActor{Type: DateActorUser, UserID: userID}, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if late {
+		_, err = tx.ExecContext(ctx, `UPDATE datifyy_v2_scheduled_dates SET late_cancellation = TRUE WHERE id = $1`, dateID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to flag late cancellation: %w", err)
+		}
+	}
+
+	lateCount := 0
+	if late {
+		lateCount = 1
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_date_reliability (user_id, cancellations, late_cancellations)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET cancellations = datifyy_v2_date_reliability.cancellations + 1,
+		    late_cancellations = datifyy_v2_date_reliability.late_cancellations + EXCLUDED.late_cancellations,
+		    updated_at = NOW()`,
+		userID, lateCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record cancellation: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_date_reschedule_requests
+		SET status = 'withdrawn', responded_at = NOW()
+		WHERE date_id = $1 AND status = 'pending'`, dateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw reschedule requests: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return date, nil
+}
+
+// CreateRescheduleRequest records a pending reschedule request, failing with
+// ErrReschedulePending if the date already has one
+func (r *ScheduledDatesRepository) CreateRescheduleRequest(ctx context.Context, req *RescheduleRequest) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_date_reschedule_requests (date_id, user_id, responder_id, proposed_times, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (date_id) WHERE status = 'pending' DO NOTHING
+		RETURNING id, status, created_at`,
+		req.DateID, req.UserID, req.ResponderID, pq.Array(req.ProposedTimes), req.Reason,
+	).Scan(&req.ID, &req.Status, &req.CreatedAt)
+	if err == sql.ErrNoRows {
+		return ErrReschedulePending
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create reschedule request: %w", err)
+	}
+	return nil
+}
+
+// GetRescheduleRequest retrieves a reschedule request by ID
+func (r *ScheduledDatesRepository) GetRescheduleRequest(ctx context.Context, id int) (*RescheduleRequest, error) {
+	req, err := scanRescheduleRequest(r.db.QueryRowContext(ctx,
+		`SELECT `+rescheduleRequestColumns+` FROM datifyy_v2_date_reschedule_requests WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrRescheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reschedule request: %w", err)
+	}
+	return req, nil
+}
+
+// AcceptRescheduleRequest moves a request's date to newTime, one of the
+// proposed times, which must still be in the future. The date's slots are
+// swapped for slots covering the new time, with the same checks as scheduling
+// a new date, and the move is logged as made by the responder. A confirmed
+// date goes back to scheduled, so the new time is confirmed afresh.
+func (r *ScheduledDatesRepository) AcceptRescheduleRequest(ctx context.Context, requestID int, newTime time.Time) (*RescheduleRequest, error) {
+	if !newTime.After(time.Now()) {
+		return nil, ErrRescheduleTimePassed
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	req, err := lockPendingRescheduleRequest(ctx, tx, requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	date := ScheduledDate{ID: req.DateID}
+	err = tx.QueryRowContext(ctx, `
+		SELECT user1_id, user2_id, scheduled_time, duration_minutes, status
+		FROM datifyy_v2_scheduled_dates
+		WHERE id = $1
+		FOR UPDATE`, req.DateID,
+	).Scan(&date.User1ID, &date.User2ID, &date.ScheduledTime, &date.DurationMinutes, &date.Status)
+	if err == sql.ErrNoRows {
+		return nil, ErrDateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock date: %w", err)
+	}
+	if (date.Status != datestatus.Scheduled && date.Status != datestatus.Confirmed) || !date.ScheduledTime.After(time.Now()) {
+		return nil, ErrDateNotActive
+	}
+	oldTime, oldStatus := date.ScheduledTime, date.Status
+
+	// Give back the old slots before taking the ones covering the new time,
+	// which may be the same slots
+	if err := releaseDateSlots(ctx, tx, []int{date.ID}); err != nil {
+		return nil, err
+	}
+	date.ScheduledTime = newTime
+	if err := reserveDateSlots(ctx, tx, &date); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_scheduled_dates
+		SET scheduled_time = $2, user1_slot_id = $3, user2_slot_id = $4,
+			status = $5, confirmed_at = NULL
+		WHERE id = $1`,
+		date.ID, newTime, date.User1SlotID, date.User2SlotID, datestatus.Scheduled,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reschedule date: %w", err)
+	}
+	if err := markSlotsReserved(ctx, tx, &date); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_date_reschedule_requests
+		SET status = 'accepted', accepted_time = $2, responded_at = NOW()
+		WHERE id = $1
+		RETURNING status, accepted_time, responded_at`,
+		requestID, newTime.Unix(),
+	).Scan(&req.Status, &req.AcceptedTime, &req.RespondedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept reschedule request: %w", err)
+	}
+
+	responder := DateActor{Type: DateActorUser, UserID: req.ResponderID}
+	err = insertDateActivity(ctx, tx, date.ID, DateActivityRescheduled,
+		oldTime.UTC().Format(time.RFC3339), newTime.UTC().Format(time.RFC3339), req.Reason.String, responder)
+	if err != nil {
+		return nil, err
+	}
+	if oldStatus != datestatus.Scheduled {
+		err = insertDateActivity(ctx, tx, date.ID, DateActivityStatusChanged,
+			oldStatus, datestatus.Scheduled, "Rescheduled; the new time needs confirming", responder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return req, nil
+}
+
+// DeclineRescheduleRequest declines a pending reschedule request, leaving
+// its date as it was
+func (r *ScheduledDatesRepository) DeclineRescheduleRequest(ctx context.Context, requestID int) (*RescheduleRequest, error) {
+	req, err := scanRescheduleRequest(r.db.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_date_reschedule_requests
+		SET status = 'declined', responded_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+		RETURNING `+rescheduleRequestColumns, requestID))
+	if err == sql.ErrNoRows {
+		return nil, ErrRescheduleAnswered
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decline reschedule request: %w", err)
+	}
+	return req, nil
+}
+
+// lockPendingRescheduleRequest locks a reschedule request that hasn't been
+// answered yet
+func lockPendingRescheduleRequest(ctx context.Context, tx *sql.Tx, requestID int) (*RescheduleRequest, error) {
+	req, err := scanRescheduleRequest(tx.QueryRowContext(ctx,
+		`SELECT `+rescheduleRequestColumns+` FROM datifyy_v2_date_reschedule_requests WHERE id = $1 FOR UPDATE`, requestID))
+	if err == sql.ErrNoRows {
+		return nil, ErrRescheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock reschedule request: %w", err)
+	}
+	if req.Status != RescheduleStatusPending {
+		return nil, ErrRescheduleAnswered
+	}
+	return req, nil
+}
+
+// GetDateReliability gets how many dates a user has cancelled; users who
+// never cancelled have zero counts
+func (r *AdminRepository) GetDateReliability(ctx context.Context, userID int) (*DateReliability, error) {
+	reliability := DateReliability{UserID: userID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT cancellations, late_cancellations, updated_at
+		FROM datifyy_v2_date_reliability
+		WHERE user_id = $1`, userID,
+	).Scan(&reliability.Cancellations, &reliability.LateCancellations, &reliability.UpdatedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get date reliability: %w", err)
+	}
+	return &reliability, nil
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/lib/pq"
+)
+
+// GeoBounds is a latitude/longitude box used to prefilter nearby users
+type GeoBounds struct {
+	MinLat float64
+	MaxLat float64
+	MinLng float64
+	MaxLng float64
+}
+
+// DiscoveryQuery filters the users shown in search and recommendations
+type DiscoveryQuery struct {
+	ViewerID     int
+	Genders      []string // stored gender values; empty means any gender
+	MinAge       int      // 0 means no lower bound
+	MaxAge       int      // 0 means no upper bound
+	VerifiedOnly bool
+	MinHeight    int        // cm; 0 means no lower bound
+	MaxHeight    int        // cm; 0 means no upper bound
+	ActiveSince  time.Time  // only users active in a session since then; zero means anyone
+	ExcludeSeen  bool       // leave out users the viewer liked, passed on or has a date with
+	Bounds       *GeoBounds // nil means worldwide
+	Limit        int
+}
+
+// DiscoveryCandidate is a user matching a discovery query
+type DiscoveryCandidate struct {
+	UserID  int
+	Geohash string // coarse location cell, empty when the user has no coordinates
+}
+
+// DiscoveryRepository finds users for search and recommendations
+type DiscoveryRepository struct {
+	db *sql.DB
+}
+
+// NewDiscoveryRepository creates a new repository
+func NewDiscoveryRepository(db *sql.DB) *DiscoveryRepository {
+	return &DiscoveryRepository{db: db}
+}
+
+// FindCandidates returns active, discoverable users matching the query, most
+// recently active first. Blocked users (in either direction) are excluded.
+func (r *DiscoveryRepository) FindCandidates(ctx context.Context, q DiscoveryQuery) ([]DiscoveryCandidate, error) {
+	query := `
+		SELECT u.id, p.geohash, p.latitude, p.longitude
+		FROM datifyy_v2_users u
+		JOIN datifyy_v2_user_profiles p ON p.user_id = u.id
+		LEFT JOIN user_preferences pr ON pr.user_id = u.id
+		WHERE u.account_status = 'ACTIVE'
+		  AND u.id <> $1
+		  AND COALESCE(pr.discoverable, TRUE)
+		  AND NOT EXISTS (
+		      SELECT 1 FROM user_blocks b
+		      WHERE (b.blocker_user_id = $1 AND b.blocked_user_id = u.id)
+		         OR (b.blocker_user_id = u.id AND b.blocked_user_id = $1)
+		  )`
+	args := []interface{}{q.ViewerID}
+
+	if len(q.Genders) > 0 {
+		args = append(args, pq.Array(q.Genders))
+		query += fmt.Sprintf(" AND u.gender = ANY($%d)", len(args))
+	}
+
+	// Age bounds become date of birth bounds so the index on date_of_birth can be used
+	now := time.Now()
+	if q.MinAge > 0 {
+		args = append(args, now.AddDate(-q.MinAge, 0, 0))
+		query += fmt.Sprintf(" AND u.date_of_birth <= $%d", len(args))
+	}
+	if q.MaxAge > 0 {
+		args = append(args, now.AddDate(-q.MaxAge-1, 0, 0))
+		query += fmt.Sprintf(" AND u.date_of_birth > $%d", len(args))
+	}
+
+	if q.VerifiedOnly {
+		query += " AND p.is_verified"
+	}
+
+	if q.MinHeight > 0 {
+		args = append(args, q.MinHeight)
+		query += fmt.Sprintf(" AND p.height >= $%d", len(args))
+	}
+	if q.MaxHeight > 0 {
+		args = append(args, q.MaxHeight)
+		query += fmt.Sprintf(" AND p.height <= $%d", len(args))
+	}
+
+	// Users who hide their online status never show up as online
+	if !q.ActiveSince.IsZero() {
+		args = append(args, q.ActiveSince)
+		query += fmt.Sprintf(`
+		  AND COALESCE(pr.show_online_status, TRUE) AND NOT COALESCE(pr.incognito_mode, FALSE)
+		  AND EXISTS (
+		      SELECT 1 FROM datifyy_v2_sessions s
+		      WHERE s.user_id = u.id AND s.is_active AND s.last_active_at >= $%d
+		  )`, len(args))
+	}
+
+	if q.ExcludeSeen {
+		query += `
+		  AND NOT EXISTS (
+		      SELECT 1 FROM datifyy_v2_user_likes l
+		      WHERE l.user_id = $1 AND l.liked_user_id = u.id
+		  )
+		  AND NOT EXISTS (
+		      SELECT 1 FROM datifyy_v2_scheduled_dates d
+		      WHERE ((d.user1_id = $1 AND d.user2_id = u.id) OR (d.user1_id = u.id AND d.user2_id = $1))
+		        AND d.status <> 'cancelled'
+		  )`
+	}
+
+	if q.Bounds != nil {
+		args = append(args, q.Bounds.MinLat, q.Bounds.MaxLat, q.Bounds.MinLng, q.Bounds.MaxLng)
+		n := len(args)
+		query += fmt.Sprintf(" AND p.latitude BETWEEN $%d AND $%d AND p.longitude BETWEEN $%d AND $%d", n-3, n-2, n-1, n)
+	}
+
+	args = append(args, q.Limit)
+	query += fmt.Sprintf(" ORDER BY u.last_login_at DESC NULLS LAST, u.id LIMIT $%d", len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []DiscoveryCandidate
+	for rows.Next() {
+		var c DiscoveryCandidate
+		var hash sql.NullString
+		var lat, lng sql.NullFloat64
+		if err := rows.Scan(&c.UserID, &hash, &lat, &lng); err != nil {
+			return nil, fmt.Errorf("failed to scan candidate: %w", err)
+		}
+		c.Geohash = CoarseGeohash(hash, lat, lng)
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
+
+// GetCoarseLocation returns the coarse geohash cell of a user's profile, or an
+// empty string when they haven't set a location
+func (r *DiscoveryRepository) GetCoarseLocation(ctx context.Context, userID int) (string, error) {
+	var hash sql.NullString
+	var lat, lng sql.NullFloat64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT geohash, latitude, longitude FROM datifyy_v2_user_profiles WHERE user_id = $1`, userID,
+	).Scan(&hash, &lat, &lng)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get location: %w", err)
+	}
+
+	return CoarseGeohash(hash, lat, lng), nil
+}
+
+// CoarseGeohash returns a profile's coarse location cell. Rows backfilled
+// before the geohash column existed derive it from their coordinates.
+func CoarseGeohash(hash sql.NullString, lat, lng sql.NullFloat64) string {
+	if hash.Valid && hash.String != "" {
+		if len(hash.String) > geo.CoarsePrecision {
+			return strings.ToLower(hash.String[:geo.CoarsePrecision])
+		}
+		return strings.ToLower(hash.String)
+	}
+	if lat.Valid && lng.Valid {
+		return geo.EncodeGeohash(geo.Point{Lat: lat.Float64, Lng: lng.Float64}, geo.CoarsePrecision)
+	}
+	return ""
+}
+
+// BirthDetails is what's needed to cast a user's birth chart
+type BirthDetails struct {
+	UserID      int
+	Gender      string
+	DateOfBirth time.Time
+	BirthTime   string // HH:MM local time
+	Timezone    string // IANA zone, empty for the default
+	Latitude    float64
+	Longitude   float64
+}
+
+// GetBirthDetails returns birth details for those of the given users who
+// have a date, time and geocoded place of birth
+func (r *DiscoveryRepository) GetBirthDetails(ctx context.Context, userIDs []int) (map[int]BirthDetails, error) {
+	details := make(map[int]BirthDetails)
+	if len(userIDs) == 0 {
+		return details, nil
+	}
+
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT u.id, COALESCE(u.gender, ''), u.date_of_birth, p.birth_time,
+		       COALESCE(p.birth_timezone, ''), p.birth_latitude, p.birth_longitude
+		FROM datifyy_v2_users u
+		JOIN datifyy_v2_user_profiles p ON p.user_id = u.id
+		WHERE u.id = ANY($1)
+		  AND u.date_of_birth IS NOT NULL
+		  AND p.birth_time IS NOT NULL
+		  AND p.birth_latitude IS NOT NULL
+		  AND p.birth_longitude IS NOT NULL
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get birth details: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var d BirthDetails
+		if err := rows.Scan(&d.UserID, &d.Gender, &d.DateOfBirth, &d.BirthTime, &d.Timezone, &d.Latitude, &d.Longitude); err != nil {
+			return nil, fmt.Errorf("failed to scan birth details: %w", err)
+		}
+		details[d.UserID] = d
+	}
+
+	return details, rows.Err()
+}
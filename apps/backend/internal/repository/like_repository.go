@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Like actions
+const (
+	LikeActionLike      = "LIKE"
+	LikeActionSuperLike = "SUPER_LIKE"
+	LikeActionPass      = "PASS"
+)
+
+// ErrLikeLimitReached is returned when a user has used their daily quota of an action
+var ErrLikeLimitReached = errors.New("daily like limit reached")
+
+// ReceivedLike is a like or super like someone sent to a user
+type ReceivedLike struct {
+	UserID  int
+	Action  string // LIKE or SUPER_LIKE
+	LikedAt time.Time
+}
+
+// LikeRepository handles likes, super likes and passes
+type LikeRepository struct {
+	db *sql.DB
+}
+
+// NewLikeRepository creates a new repository
+func NewLikeRepository(db *sql.DB) *LikeRepository {
+	return &LikeRepository{db: db}
+}
+
+// GetAction returns userID's current action on targetID, or "" if none
+func (r *LikeRepository) GetAction(ctx context.Context, userID, targetID int) (string, error) {
+	var action string
+	err := r.db.QueryRowContext(ctx,
+		`SELECT action FROM datifyy_v2_user_likes WHERE user_id = $1 AND liked_user_id = $2`,
+		userID, targetID,
+	).Scan(&action)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get like: %w", err)
+	}
+	return action, nil
+}
+
+// SetAction records userID's action on targetID, replacing any earlier one
+func (r *LikeRepository) SetAction(ctx context.Context, userID, targetID int, action string) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_user_likes (user_id, liked_user_id, action)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, liked_user_id)
+		DO UPDATE SET action = EXCLUDED.action, acted_at = CURRENT_TIMESTAMP
+	`, userID, targetID, action)
+	if err != nil {
+		return fmt.Errorf("failed to save like: %w", err)
+	}
+	return nil
+}
+
+// SetActionWithinLimit records userID's action on targetID unless they have
+// already taken that action on limit users since the given time. The user's
+// row is locked for the check so concurrent requests can't both take the last
+// slot. Repeating the current action changes nothing and uses no quota. It
+// returns how much of the quota is used afterwards and whether anything was
+// saved.
+func (r *LikeRepository) SetActionWithinLimit(ctx context.Context, userID, targetID int, action string, since time.Time, limit int) (used int, saved bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var lockedID int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT id FROM datifyy_v2_users WHERE id = $1 FOR UPDATE`, userID,
+	).Scan(&lockedID); err != nil {
+		return 0, false, fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	var current string
+	err = tx.QueryRowContext(ctx,
+		`SELECT action FROM datifyy_v2_user_likes WHERE user_id = $1 AND liked_user_id = $2`,
+		userID, targetID,
+	).Scan(&current)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, false, fmt.Errorf("failed to get like: %w", err)
+	}
+
+	// The quota counts users currently liked in the window, so a like changed
+	// to a pass frees its slot
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM datifyy_v2_user_likes WHERE user_id = $1 AND action = $2 AND acted_at >= $3`,
+		userID, action, since,
+	).Scan(&used); err != nil {
+		return 0, false, fmt.Errorf("failed to count likes: %w", err)
+	}
+
+	if current == action {
+		return used, false, nil
+	}
+	if used >= limit {
+		return used, false, ErrLikeLimitReached
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_user_likes (user_id, liked_user_id, action)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, liked_user_id)
+		DO UPDATE SET action = EXCLUDED.action, acted_at = CURRENT_TIMESTAMP
+	`, userID, targetID, action)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to save like: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, false, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return used + 1, true, nil
+}
+
+// ListReceived returns likes and super likes sent to userID that they haven't
+// responded to yet, super likes first. Inactive senders and users blocked in
+// either direction are left out.
+func (r *LikeRepository) ListReceived(ctx context.Context, userID, limit, offset int) ([]ReceivedLike, int, error) {
+	const filter = `
+		FROM datifyy_v2_user_likes l
+		JOIN datifyy_v2_users u ON u.id = l.user_id
+		WHERE l.liked_user_id = $1
+		  AND l.action IN ('LIKE', 'SUPER_LIKE')
+		  AND u.account_status = 'ACTIVE'
+		  AND NOT EXISTS (
+		      SELECT 1 FROM datifyy_v2_user_likes mine
+		      WHERE mine.user_id = $1 AND mine.liked_user_id = l.user_id
+		  )
+		  AND NOT EXISTS (
+		      SELECT 1 FROM user_blocks b
+		      WHERE (b.blocker_user_id = $1 AND b.blocked_user_id = l.user_id)
+		         OR (b.blocker_user_id = l.user_id AND b.blocked_user_id = $1)
+		  )`
+
+	var totalCount int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) `+filter, userID).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count received likes: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.user_id, l.action, l.acted_at `+filter+`
+		ORDER BY (l.action = 'SUPER_LIKE') DESC, l.acted_at DESC
+		LIMIT $2 OFFSET $3
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list received likes: %w", err)
+	}
+	defer rows.Close()
+
+	likes := []ReceivedLike{}
+	for rows.Next() {
+		var like ReceivedLike
+		if err := rows.Scan(&like.UserID, &like.Action, &like.LikedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan received like: %w", err)
+		}
+		likes = append(likes, like)
+	}
+
+	return likes, totalCount, rows.Err()
+}
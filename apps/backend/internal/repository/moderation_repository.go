@@ -0,0 +1,498 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrNoActiveEnforcement is returned when a user has no active suspension or ban
+	ErrNoActiveEnforcement = errors.New("no active suspension or ban")
+	// ErrAppealNotFound is returned when an appeal does not exist
+	ErrAppealNotFound = errors.New("appeal not found")
+	// ErrAppealExists is returned when the enforcement action has already been appealed
+	ErrAppealExists = errors.New("enforcement action already appealed")
+	// ErrAppealReviewed is returned when an appeal has already been reviewed
+	ErrAppealReviewed = errors.New("appeal already reviewed")
+)
+
+// ModerationAction is an enforcement action taken against a user
+type ModerationAction struct {
+	ID           int
+	UserID       int
+	ReportID     sql.NullInt64
+	AdminID      sql.NullInt64
+	Action       string // WARN, SUSPEND, BAN or REINSTATE
+	DurationDays sql.NullInt64
+	ExpiresAt    sql.NullTime
+	Notes        sql.NullString
+	LiftedAt     sql.NullTime
+	LiftedReason sql.NullString
+	CreatedAt    time.Time
+}
+
+// EnforcementAppeal is a user's appeal against a suspension or ban
+type EnforcementAppeal struct {
+	ID                 int
+	UserID             int
+	UserName           string
+	UserEmail          string
+	ModerationActionID int
+	Message            string
+	Status             string
+	ReviewedBy         sql.NullInt64
+	ReviewedAt         sql.NullTime
+	ReviewNotes        sql.NullString
+	CreatedAt          time.Time
+	Enforcement        *ModerationAction
+}
+
+// ModerationRepository handles enforcement history, suspension expiry and appeals
+type ModerationRepository struct {
+	db *sql.DB
+}
+
+// NewModerationRepository creates a new repository
+func NewModerationRepository(db *sql.DB) *ModerationRepository {
+	return &ModerationRepository{db: db}
+}
+
+const moderationActionColumns = `
+	id, user_id, report_id, admin_id, action, duration_days, expires_at, notes,
+	lifted_at, lifted_reason, created_at
+`
+
+func scanModerationAction(row interface{ Scan(...interface{}) error }) (*ModerationAction, error) {
+	a := &ModerationAction{}
+	err := row.Scan(
+		&a.ID, &a.UserID, &a.ReportID, &a.AdminID, &a.Action, &a.DurationDays, &a.ExpiresAt, &a.Notes,
+		&a.LiftedAt, &a.LiftedReason, &a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// recordModerationAction adds an entry to the user's enforcement history
+func recordModerationAction(ctx context.Context, tx *sql.Tx, action *ModerationAction) error {
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_moderation_actions
+			(user_id, report_id, admin_id, action, duration_days, expires_at, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`,
+		action.UserID, action.ReportID, action.AdminID, action.Action,
+		action.DurationDays, action.ExpiresAt, action.Notes,
+	).Scan(&action.ID, &action.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record moderation action: %w", err)
+	}
+
+	return nil
+}
+
+// applyModerationAction records an enforcement action and applies it to the account
+func applyModerationAction(ctx context.Context, tx *sql.Tx, action *ModerationAction) error {
+	if err := recordModerationAction(ctx, tx, action); err != nil {
+		return err
+	}
+
+	var accountStatus string
+	switch action.Action {
+	case "SUSPEND":
+		accountStatus = "SUSPENDED"
+	case "BAN":
+		accountStatus = "BANNED"
+	default:
+		return nil
+	}
+
+	// A suspension never downgrades a ban; the ban stays in force until lifted
+	_, err := tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_users SET account_status = $2, updated_at = NOW()
+		WHERE id = $1 AND (account_status <> 'BANNED' OR $2 = 'BANNED')`, action.UserID, accountStatus)
+	if err != nil {
+		return fmt.Errorf("failed to update account status: %w", err)
+	}
+
+	// Sign the user out everywhere
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_sessions SET is_active = false
+		WHERE user_id = $1 AND is_active = true`, action.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
+
+// liftActiveEnforcement marks all of a user's active suspensions and bans as lifted.
+// It returns the number of actions lifted.
+func liftActiveEnforcement(ctx context.Context, tx *sql.Tx, userID int, reason string) (int64, error) {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_moderation_actions
+		SET lifted_at = NOW(), lifted_reason = $2
+		WHERE user_id = $1 AND action IN ('SUSPEND', 'BAN') AND lifted_at IS NULL`,
+		userID, sql.NullString{String: reason, Valid: reason != ""})
+	if err != nil {
+		return 0, fmt.Errorf("failed to lift enforcement: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+// reactivateUnrestricted sets suspended users back to ACTIVE unless another
+// suspension or ban is still in force. It returns the reactivated user IDs.
+func reactivateUnrestricted(ctx context.Context, tx *sql.Tx, userIDs []int) ([]int, error) {
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE datifyy_v2_users u
+		SET account_status = 'ACTIVE', updated_at = NOW()
+		WHERE u.id = ANY($1)
+		  AND u.account_status = 'SUSPENDED'
+		  AND NOT EXISTS (
+			SELECT 1 FROM datifyy_v2_moderation_actions m
+			WHERE m.user_id = u.id AND m.action IN ('SUSPEND', 'BAN') AND m.lifted_at IS NULL
+		  )
+		RETURNING u.id`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reactivate users: %w", err)
+	}
+	defer rows.Close()
+
+	var reactivated []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		reactivated = append(reactivated, id)
+	}
+
+	return reactivated, rows.Err()
+}
+
+// GetActiveEnforcement returns the suspension or ban currently keeping a user out,
+// preferring bans over suspensions. Suspensions past their expiry are ignored.
+func (r *ModerationRepository) GetActiveEnforcement(ctx context.Context, userID int) (*ModerationAction, error) {
+	query := `
+		SELECT ` + moderationActionColumns + `
+		FROM datifyy_v2_moderation_actions
+		WHERE user_id = $1
+		  AND action IN ('SUSPEND', 'BAN')
+		  AND lifted_at IS NULL
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY (action = 'BAN') DESC, created_at DESC
+		LIMIT 1`
+
+	action, err := scanModerationAction(r.db.QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return nil, ErrNoActiveEnforcement
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active enforcement: %w", err)
+	}
+
+	return action, nil
+}
+
+// ListByUser returns a user's enforcement history, newest first
+func (r *ModerationRepository) ListByUser(ctx context.Context, userID int) ([]*ModerationAction, error) {
+	query := `
+		SELECT ` + moderationActionColumns + `
+		FROM datifyy_v2_moderation_actions
+		WHERE user_id = $1
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+	defer rows.Close()
+
+	var actions []*ModerationAction
+	for rows.Next() {
+		action, err := scanModerationAction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan moderation action: %w", err)
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, rows.Err()
+}
+
+// LiftExpiredSuspensions lifts suspensions that expired at or before now and
+// reactivates the affected accounts. It returns the reactivated user IDs.
+func (r *ModerationRepository) LiftExpiredSuspensions(ctx context.Context, now time.Time) ([]int, error) {
+	return r.liftExpiredSuspensions(ctx, `
+		UPDATE datifyy_v2_moderation_actions
+		SET lifted_at = NOW(), lifted_reason = 'Suspension expired'
+		WHERE action = 'SUSPEND' AND lifted_at IS NULL AND expires_at <= $1
+		RETURNING user_id`, now)
+}
+
+// LiftExpiredSuspensionsForUser lifts one user's expired suspensions and reports
+// whether the account was reactivated
+func (r *ModerationRepository) LiftExpiredSuspensionsForUser(ctx context.Context, userID int, now time.Time) (bool, error) {
+	reactivated, err := r.liftExpiredSuspensions(ctx, `
+		UPDATE datifyy_v2_moderation_actions
+		SET lifted_at = NOW(), lifted_reason = 'Suspension expired'
+		WHERE action = 'SUSPEND' AND lifted_at IS NULL AND expires_at <= $1 AND user_id = $2
+		RETURNING user_id`, now, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(reactivated) > 0, nil
+}
+
+// liftExpiredSuspensions runs a lifting query that returns user IDs and
+// reactivates those users in the same transaction
+func (r *ModerationRepository) liftExpiredSuspensions(ctx context.Context, query string, args ...interface{}) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lift expired suspensions: %w", err)
+	}
+
+	var userIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+	rows.Close()
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	reactivated, err := reactivateUnrestricted(ctx, tx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return reactivated, nil
+}
+
+// HasAppeal reports whether an appeal has already been filed against an action
+func (r *ModerationRepository) HasAppeal(ctx context.Context, moderationActionID int) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM datifyy_v2_enforcement_appeals WHERE moderation_action_id = $1
+		)`, moderationActionID,
+	).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check appeal: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CreateAppeal files an appeal against an enforcement action
+func (r *ModerationRepository) CreateAppeal(ctx context.Context, userID, moderationActionID int, message string) (*EnforcementAppeal, error) {
+	appeal := &EnforcementAppeal{
+		UserID:             userID,
+		ModerationActionID: moderationActionID,
+		Message:            message,
+	}
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_enforcement_appeals (user_id, moderation_action_id, message)
+		VALUES ($1, $2, $3)
+		RETURNING id, status, created_at`,
+		userID, moderationActionID, message,
+	).Scan(&appeal.ID, &appeal.Status, &appeal.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrAppealExists
+		}
+		return nil, fmt.Errorf("failed to create appeal: %w", err)
+	}
+
+	return appeal, nil
+}
+
+const enforcementAppealColumns = `
+	ap.id, ap.user_id, u.name, u.email, ap.moderation_action_id, ap.message, ap.status,
+	ap.reviewed_by, ap.reviewed_at, ap.review_notes, ap.created_at,
+	m.id, m.user_id, m.report_id, m.admin_id, m.action, m.duration_days, m.expires_at, m.notes,
+	m.lifted_at, m.lifted_reason, m.created_at
+`
+
+const enforcementAppealFrom = `
+	FROM datifyy_v2_enforcement_appeals ap
+	JOIN datifyy_v2_users u ON u.id = ap.user_id
+	JOIN datifyy_v2_moderation_actions m ON m.id = ap.moderation_action_id
+`
+
+func scanEnforcementAppeal(row interface{ Scan(...interface{}) error }) (*EnforcementAppeal, error) {
+	ap := &EnforcementAppeal{Enforcement: &ModerationAction{}}
+	m := ap.Enforcement
+	err := row.Scan(
+		&ap.ID, &ap.UserID, &ap.UserName, &ap.UserEmail, &ap.ModerationActionID, &ap.Message, &ap.Status,
+		&ap.ReviewedBy, &ap.ReviewedAt, &ap.ReviewNotes, &ap.CreatedAt,
+		&m.ID, &m.UserID, &m.ReportID, &m.AdminID, &m.Action, &m.DurationDays, &m.ExpiresAt, &m.Notes,
+		&m.LiftedAt, &m.LiftedReason, &m.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ap, nil
+}
+
+// GetAppeal retrieves an appeal with the enforcement action it contests
+func (r *ModerationRepository) GetAppeal(ctx context.Context, appealID int) (*EnforcementAppeal, error) {
+	query := `SELECT ` + enforcementAppealColumns + enforcementAppealFrom + ` WHERE ap.id = $1`
+
+	appeal, err := scanEnforcementAppeal(r.db.QueryRowContext(ctx, query, appealID))
+	if err == sql.ErrNoRows {
+		return nil, ErrAppealNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get appeal: %w", err)
+	}
+
+	return appeal, nil
+}
+
+// ListAppeals returns appeals for the review queue, oldest first
+func (r *ModerationRepository) ListAppeals(ctx context.Context, status string, limit, offset int) ([]*EnforcementAppeal, int, error) {
+	where := ""
+	args := []interface{}{}
+	if status != "" {
+		where = " WHERE ap.status = $1"
+		args = append(args, status)
+	}
+
+	var totalCount int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM datifyy_v2_enforcement_appeals ap`+where, args...,
+	).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count appeals: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`SELECT %s %s %s ORDER BY ap.created_at ASC LIMIT $%d OFFSET $%d`,
+		enforcementAppealColumns, enforcementAppealFrom, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list appeals: %w", err)
+	}
+	defer rows.Close()
+
+	var appeals []*EnforcementAppeal
+	for rows.Next() {
+		appeal, err := scanEnforcementAppeal(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan appeal: %w", err)
+		}
+		appeals = append(appeals, appeal)
+	}
+
+	return appeals, totalCount, rows.Err()
+}
+
+// ReviewAppeal approves or rejects a pending appeal. Approving lifts the contested
+// action, reactivates the account if nothing else is in force and records a
+// REINSTATE action in the user's history.
+func (r *ModerationRepository) ReviewAppeal(ctx context.Context, appealID, adminID int, approve bool, notes string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var userID, actionID int
+	var status string
+	err = tx.QueryRowContext(ctx, `
+		SELECT user_id, moderation_action_id, status
+		FROM datifyy_v2_enforcement_appeals WHERE id = $1 FOR UPDATE`, appealID,
+	).Scan(&userID, &actionID, &status)
+	if err == sql.ErrNoRows {
+		return ErrAppealNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get appeal: %w", err)
+	}
+	if status != "PENDING" {
+		return ErrAppealReviewed
+	}
+
+	newStatus := "REJECTED"
+	if approve {
+		newStatus = "APPROVED"
+	}
+	notesValue := sql.NullString{String: notes, Valid: notes != ""}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_enforcement_appeals
+		SET status = $2, reviewed_by = $3, reviewed_at = NOW(), review_notes = $4
+		WHERE id = $1`, appealID, newStatus, adminID, notesValue)
+	if err != nil {
+		return fmt.Errorf("failed to update appeal: %w", err)
+	}
+
+	if approve {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE datifyy_v2_moderation_actions
+			SET lifted_at = NOW(), lifted_reason = 'Appeal approved'
+			WHERE id = $1 AND lifted_at IS NULL`, actionID)
+		if err != nil {
+			return fmt.Errorf("failed to lift enforcement: %w", err)
+		}
+
+		// A lifted ban is treated like a lifted suspension
+		_, err = tx.ExecContext(ctx, `
+			UPDATE datifyy_v2_users SET account_status = 'SUSPENDED'
+			WHERE id = $1 AND account_status = 'BANNED'`, userID)
+		if err != nil {
+			return fmt.Errorf("failed to update account status: %w", err)
+		}
+
+		if _, err := reactivateUnrestricted(ctx, tx, []int{userID}); err != nil {
+			return err
+		}
+
+		reinstate := &ModerationAction{
+			UserID:  userID,
+			AdminID: sql.NullInt64{Int64: int64(adminID), Valid: true},
+			Action:  "REINSTATE",
+			Notes:   notesValue,
+		}
+		if err := recordModerationAction(ctx, tx, reinstate); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
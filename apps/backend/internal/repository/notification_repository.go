@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// Notification event types
+const (
+	NotificationEventProfileView = "PROFILE_VIEW"
+	NotificationEventLike        = "LIKE"
+	NotificationEventSuperLike   = "SUPER_LIKE"
+
+	NotificationEventDateCancelled       = "DATE_CANCELLED"
+	NotificationEventRescheduleRequested = "DATE_RESCHEDULE_REQUESTED"
+	NotificationEventRescheduleAccepted  = "DATE_RESCHEDULE_ACCEPTED"
+	NotificationEventRescheduleDeclined  = "DATE_RESCHEDULE_DECLINED"
+)
+
+// NotificationRepository writes notification events to the outbox
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new repository
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// CreateEvent queues a notification for userID. actorID is the user who
+// triggered it (0 for system events); payload may be nil.
+func (r *NotificationRepository) CreateEvent(ctx context.Context, userID int, eventType string, actorID int, payload map[string]interface{}) error {
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification payload: %w", err)
+	}
+
+	var actor sql.NullInt64
+	if actorID != 0 {
+		actor = sql.NullInt64{Int64: int64(actorID), Valid: true}
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_notification_events (user_id, event_type, actor_user_id, payload)
+		VALUES ($1, $2, $3, $4)
+	`, userID, eventType, actor, payloadJSON)
+	if err != nil {
+		return fmt.Errorf("failed to create notification event: %w", err)
+	}
+
+	return nil
+}
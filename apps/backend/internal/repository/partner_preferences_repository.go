@@ -0,0 +1,338 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// PartnerPreferences represents partner preferences in the database.
+//
+// Each field's db tag is its column. The pref tag names the PartnerPreferences
+// proto field it maps to (dotted for nested messages), defaulting to the
+// column name; "-" leaves a column unmapped and ",omitempty" skips zero values
+// on update. Adding a preference means adding a field here (plus its proto
+// field and column).
+type PartnerPreferences struct {
+	ID     int `db:"id" pref:"-"`
+	UserID int `db:"user_id" pref:"-"`
+	// Basic Preferences
+	LookingForGender   EnumList      `db:"looking_for_gender"`
+	AgeRangeMin        int           `db:"age_range_min" pref:"age_range.min_age"`
+	AgeRangeMax        int           `db:"age_range_max" pref:"age_range.max_age"`
+	DistancePreference int           `db:"distance_preference" pref:",omitempty"`
+	HeightRangeMin     sql.NullInt32 `db:"height_range_min" pref:"height_range.min_height"`
+	HeightRangeMax     sql.NullInt32 `db:"height_range_max" pref:"height_range.max_height"`
+	// Relationship & Lifestyle
+	RelationshipGoals   EnumList `db:"relationship_goals"`
+	EducationLevels     EnumList `db:"education_levels"`
+	Occupations         EnumList `db:"occupations"`
+	Religions           EnumList `db:"religions"`
+	ReligionImportance  int      `db:"religion_importance"`
+	ChildrenPreferences EnumList `db:"children_preferences"`
+	DrinkingPreferences EnumList `db:"drinking_preferences"`
+	SmokingPreferences  EnumList `db:"smoking_preferences"`
+	DietaryPreferences  EnumList `db:"dietary_preferences"`
+	PetPreferences      EnumList `db:"pet_preferences"`
+	WorkoutPreferences  EnumList `db:"workout_preferences"`
+	// Personality & Communication
+	PersonalityTypes    StringList `db:"personality_types"`
+	CommunicationStyles EnumList   `db:"communication_styles"`
+	LoveLanguages       EnumList   `db:"love_languages"`
+	PoliticalViews      EnumList   `db:"political_views"`
+	SleepSchedules      EnumList   `db:"sleep_schedules"`
+	// Cultural & Matrimonial
+	CastePreferences      StringList `db:"caste_preferences"`
+	SubCastePreferences   StringList `db:"sub_caste_preferences"`
+	GotraPreferences      StringList `db:"gotra_preferences"`
+	ManglikPreference     int        `db:"manglik_preference"`
+	MotherTonguePrefs     StringList `db:"mother_tongue_preferences"`
+	EthnicityPreferences  EnumList   `db:"ethnicity_preferences"`
+	NationalityPrefs      StringList `db:"nationality_preferences"`
+	NRIPreference         int        `db:"nri_preference"`
+	HoroscopeRequired     bool       `db:"horoscope_matching_required"`
+	RelocationExpectation int        `db:"relocation_expectation"`
+	// Appearance
+	BodyTypePreferences  EnumList `db:"body_type_preferences"`
+	ComplexionPrefs      EnumList `db:"complexion_preferences"`
+	HairColorPrefs       EnumList `db:"hair_color_preferences"`
+	EyeColorPrefs        EnumList `db:"eye_color_preferences"`
+	FacialHairPrefs      EnumList `db:"facial_hair_preferences"`
+	TattooPreference     int      `db:"tattoo_preference"`
+	PiercingPreference   int      `db:"piercing_preference"`
+	DisabilityAcceptance int      `db:"disability_acceptance"`
+	// Professional & Financial
+	IncomePreferences    EnumList   `db:"income_preferences"`
+	EmploymentPrefs      EnumList   `db:"employment_preferences"`
+	IndustryPreferences  StringList `db:"industry_preferences"`
+	MinYearsExperience   int        `db:"min_years_experience"`
+	PropertyPreference   int        `db:"property_preference"`
+	VehiclePreference    int        `db:"vehicle_preference"`
+	FinancialExpectation int        `db:"financial_expectation"`
+	// Family Background
+	FamilyTypePrefs      EnumList   `db:"family_type_preferences"`
+	FamilyValuesPrefs    EnumList   `db:"family_values_preferences"`
+	LivingSituationPrefs EnumList   `db:"living_situation_preferences"`
+	FamilyAffluencePrefs EnumList   `db:"family_affluence_preferences"`
+	FamilyLocationPrefs  StringList `db:"family_location_preferences"`
+	MaxSiblings          int        `db:"max_siblings"`
+	// Language & Location
+	LanguagePreferences EnumList   `db:"language_preferences"`
+	MinLangProficiency  int        `db:"min_language_proficiency"`
+	LocationPreferences StringList `db:"location_preferences"`
+	OpenToLongDistance  bool       `db:"open_to_long_distance"`
+	// Interest & Hobbies
+	InterestPreferences EnumList `db:"interest_preferences"`
+	MinSharedInterests  int      `db:"min_shared_interests"`
+	// Deal-Breakers & Must-Haves
+	VerifiedOnly         bool            `db:"verified_only"`
+	MaxDaysInactive      int             `db:"max_days_inactive"`
+	PhotosRequired       bool            `db:"photos_required"`
+	MinProfileCompletion int             `db:"min_profile_completion"`
+	DealBreakers         PreferenceRules `db:"deal_breakers"`
+	MustHaves            PreferenceRules `db:"must_haves"`
+	CustomDealbreakers   StringList      `db:"custom_dealbreakers"`
+}
+
+// EnumList is a JSONB array of proto enum numbers
+type EnumList []int32
+
+// Scan implements sql.Scanner
+func (l *EnumList) Scan(src interface{}) error {
+	return scanJSONB(src, l)
+}
+
+// Value implements driver.Valuer; nil lists are stored as []
+func (l EnumList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	return jsonbValue(l)
+}
+
+// StringList is a JSONB array of strings
+type StringList []string
+
+// Scan implements sql.Scanner
+func (l *StringList) Scan(src interface{}) error {
+	return scanJSONB(src, l)
+}
+
+// Value implements driver.Valuer; nil lists are stored as []
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	return jsonbValue(l)
+}
+
+// PreferenceRule is a deal-breaker or must-have. Type is the
+// DealBreakerType or MustHaveType enum number.
+type PreferenceRule struct {
+	Type        int32  `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	Priority    int32  `json:"priority,omitempty"`
+}
+
+// PreferenceRules is a JSONB array of deal-breakers or must-haves
+type PreferenceRules []PreferenceRule
+
+// Scan implements sql.Scanner
+func (r *PreferenceRules) Scan(src interface{}) error {
+	return scanJSONB(src, r)
+}
+
+// Value implements driver.Valuer; nil lists are stored as []
+func (r PreferenceRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	return jsonbValue(r)
+}
+
+func scanJSONB(src interface{}, dest interface{}) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", src, dest)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// jsonbValue encodes v as a string, which lib/pq sends as JSONB text
+func jsonbValue(v interface{}) (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// PreferenceField describes one PartnerPreferences column
+type PreferenceField struct {
+	Column    string
+	ProtoPath string // dotted PartnerPreferences proto field path
+	OmitEmpty bool   // skip zero values on update
+	Index     int    // index of the PartnerPreferences struct field
+}
+
+var (
+	partnerPreferenceFields  []PreferenceField // every column, in struct order
+	partnerPreferenceColumns string
+)
+
+func init() {
+	t := reflect.TypeOf(PartnerPreferences{})
+	var columns []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		column := f.Tag.Get("db")
+		if column == "" {
+			continue
+		}
+		field := PreferenceField{Column: column, Index: i}
+
+		path, opts, _ := strings.Cut(f.Tag.Get("pref"), ",")
+		switch path {
+		case "-":
+		case "":
+			field.ProtoPath = column
+		default:
+			field.ProtoPath = path
+		}
+		field.OmitEmpty = opts == "omitempty"
+
+		partnerPreferenceFields = append(partnerPreferenceFields, field)
+		columns = append(columns, column)
+	}
+	partnerPreferenceColumns = strings.Join(columns, ", ")
+}
+
+// PartnerPreferenceFields returns the columns that map to proto fields
+func PartnerPreferenceFields() []PreferenceField {
+	var fields []PreferenceField
+	for _, f := range partnerPreferenceFields {
+		if f.ProtoPath != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GetPartnerPreferences retrieves partner preferences by user ID
+func (r *UserProfileRepository) GetPartnerPreferences(ctx context.Context, userID int) (*PartnerPreferences, error) {
+	query := `SELECT ` + partnerPreferenceColumns + `
+		FROM datifyy_v2_partner_preferences
+		WHERE user_id = $1
+	`
+
+	prefs := &PartnerPreferences{}
+	v := reflect.ValueOf(prefs).Elem()
+	dest := make([]interface{}, len(partnerPreferenceFields))
+	for i, f := range partnerPreferenceFields {
+		dest[i] = v.Field(f.Index).Addr().Interface()
+	}
+
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil, ErrProfileNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return prefs, nil
+}
+
+// GetPartnerPreferencesByUserIDs retrieves the partner preferences of several
+// users, keyed by user ID. Users without preferences are left out.
+func (r *UserProfileRepository) GetPartnerPreferencesByUserIDs(ctx context.Context, userIDs []int) (map[int]*PartnerPreferences, error) {
+	prefsByUser := make(map[int]*PartnerPreferences)
+	if len(userIDs) == 0 {
+		return prefsByUser, nil
+	}
+
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+
+	query := `SELECT ` + partnerPreferenceColumns + `
+		FROM datifyy_v2_partner_preferences
+		WHERE user_id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		prefs := &PartnerPreferences{}
+		v := reflect.ValueOf(prefs).Elem()
+		dest := make([]interface{}, len(partnerPreferenceFields))
+		for i, f := range partnerPreferenceFields {
+			dest[i] = v.Field(f.Index).Addr().Interface()
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		prefsByUser[prefs.UserID] = prefs
+	}
+
+	return prefsByUser, rows.Err()
+}
+
+// UpdatePartnerPreferences updates partner preferences (UPSERT), recording
+// each changed field in the change log
+func (r *UserProfileRepository) UpdatePartnerPreferences(ctx context.Context, userID int, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	// Build UPSERT query with ON CONFLICT
+	// First, build the column names and values for INSERT
+	updateColumns := sortedUpdateColumns(updates)
+	columns := []string{"user_id"}
+	placeholders := []string{"$1"}
+	args := []interface{}{userID}
+	argPos := 2
+
+	// Build SET clause for ON CONFLICT UPDATE
+	var setClauses []string
+
+	for _, key := range updateColumns {
+		columns = append(columns, key)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", argPos))
+		args = append(args, updates[key])
+		setClauses = append(setClauses, fmt.Sprintf("%s = EXCLUDED.%s", key, key))
+		argPos++
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO datifyy_v2_partner_preferences (%s, created_at, updated_at)
+		VALUES (%s, NOW(), NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			%s,
+			updated_at = NOW()
+	`, strings.Join(columns, ", "), strings.Join(placeholders, ", "), strings.Join(setClauses, ", "))
+
+	return updateWithChangeLog(ctx, r.db, "datifyy_v2_partner_preferences", userID, updateColumns, query, args...)
+}
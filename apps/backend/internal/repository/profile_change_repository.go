@@ -0,0 +1,388 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrChangeNotFound = errors.New("profile change not found")
+	// ErrChangeReverted is returned when reverting a change that was already reverted
+	ErrChangeReverted = errors.New("profile change already reverted")
+	// ErrChangeSuperseded is returned when the field has changed again since,
+	// so reverting would silently discard the later change
+	ErrChangeSuperseded = errors.New("field has changed since")
+	// ErrChangeNotRevertible is returned when the old value can't be written
+	// back, such as a first save of a NOT NULL column that has no default
+	ErrChangeNotRevertible = errors.New("profile change cannot be reverted")
+)
+
+// Change sources
+const (
+	ChangeSourceUser   = "user"
+	ChangeSourceAdmin  = "admin"
+	ChangeSourceSystem = "system"
+)
+
+// changeLoggedTables maps each table whose updates are recorded in the change
+// log to the column identifying the user. Reverts only write to these tables.
+var changeLoggedTables = map[string]string{
+	"datifyy_v2_users":               "id",
+	"datifyy_v2_user_profiles":       "user_id",
+	"datifyy_v2_partner_preferences": "user_id",
+	"user_preferences":               "user_id",
+}
+
+// ChangeActor identifies who made a change. ID is a user ID for user
+// changes, an admin ID for admin changes and 0 for system changes.
+type ChangeActor struct {
+	Source string
+	ID     int
+}
+
+type changeActorKey struct{}
+
+// WithChangeActor attributes change-logged writes made with ctx to actor.
+// Without it, writes are attributed to the user being updated.
+func WithChangeActor(ctx context.Context, actor ChangeActor) context.Context {
+	return context.WithValue(ctx, changeActorKey{}, actor)
+}
+
+func changeActorFromContext(ctx context.Context, userID int) ChangeActor {
+	if actor, ok := ctx.Value(changeActorKey{}).(ChangeActor); ok {
+		return actor
+	}
+	return ChangeActor{Source: ChangeSourceUser, ID: userID}
+}
+
+// ProfileChange is one field change in the change log. OldValue and NewValue
+// hold the field's JSON encoding.
+type ProfileChange struct {
+	ID              int
+	UserID          int
+	TableName       string
+	FieldName       string
+	OldValue        json.RawMessage
+	NewValue        json.RawMessage
+	Source          string
+	ActorID         sql.NullInt64
+	RevertsChangeID sql.NullInt64
+	RevertedAt      sql.NullTime
+	RevertedBy      sql.NullInt64
+	CreatedAt       time.Time
+}
+
+// ProfileChangeRepository reads and reverts the profile change log
+type ProfileChangeRepository struct {
+	db *sql.DB
+}
+
+// NewProfileChangeRepository creates a new repository
+func NewProfileChangeRepository(db *sql.DB) *ProfileChangeRepository {
+	return &ProfileChangeRepository{db: db}
+}
+
+const profileChangeColumns = `id, user_id, table_name, field_name, old_value, new_value, source,
+	actor_id, reverts_change_id, reverted_at, reverted_by, created_at`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanProfileChange(row rowScanner) (*ProfileChange, error) {
+	c := &ProfileChange{}
+	var oldValue, newValue []byte
+	err := row.Scan(
+		&c.ID, &c.UserID, &c.TableName, &c.FieldName, &oldValue, &newValue, &c.Source,
+		&c.ActorID, &c.RevertsChangeID, &c.RevertedAt, &c.RevertedBy, &c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.OldValue = jsonValueOrNull(oldValue)
+	c.NewValue = jsonValueOrNull(newValue)
+	return c, nil
+}
+
+// ListByUser returns a user's most recent changes, newest first
+func (r *ProfileChangeRepository) ListByUser(ctx context.Context, userID, limit int) ([]ProfileChange, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+profileChangeColumns+`
+		FROM datifyy_v2_profile_changes
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profile changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []ProfileChange{}
+	for rows.Next() {
+		c, err := scanProfileChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan profile change: %w", err)
+		}
+		changes = append(changes, *c)
+	}
+
+	return changes, rows.Err()
+}
+
+// Revert restores the field a change modified to its old value, recording
+// the restore as an admin change. It fails with ErrChangeSuperseded if the
+// field no longer holds the change's new value. Reverting a first save of a
+// NOT NULL column restores the column default, or fails with
+// ErrChangeNotRevertible if it has none.
+func (r *ProfileChangeRepository) Revert(ctx context.Context, changeID, adminID int) (*ProfileChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	change, err := scanProfileChange(tx.QueryRowContext(ctx, `
+		SELECT `+profileChangeColumns+`
+		FROM datifyy_v2_profile_changes
+		WHERE id = $1
+		FOR UPDATE
+	`, changeID))
+	if err == sql.ErrNoRows {
+		return nil, ErrChangeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile change: %w", err)
+	}
+	if change.RevertedAt.Valid {
+		return nil, ErrChangeReverted
+	}
+
+	keyColumn, ok := changeLoggedTables[change.TableName]
+	if !ok {
+		return nil, fmt.Errorf("table %s is not change logged", change.TableName)
+	}
+	field := pq.QuoteIdentifier(change.FieldName)
+
+	var current []byte
+	err = tx.QueryRowContext(ctx, fmt.Sprintf(
+		`SELECT to_jsonb(%s) FROM %s WHERE %s = $1 FOR UPDATE`,
+		field, change.TableName, keyColumn,
+	), change.UserID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return nil, ErrChangeSuperseded
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current value: %w", err)
+	}
+	if !jsonValuesEqual(jsonValueOrNull(current), change.NewValue) {
+		return nil, ErrChangeSuperseded
+	}
+
+	restoreDefault := false
+	if jsonValuesEqual(change.OldValue, json.RawMessage("null")) {
+		// A null old value is either a genuine NULL or a row that didn't exist
+		// yet. A NOT NULL column can only mean the latter, so it gets back the
+		// default the row was created with.
+		var nullable, hasDefault bool
+		err = tx.QueryRowContext(ctx, `
+			SELECT is_nullable = 'YES', column_default IS NOT NULL
+			FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2
+		`, change.TableName, change.FieldName).Scan(&nullable, &hasDefault)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get column definition: %w", err)
+		}
+		if !nullable && !hasDefault {
+			return nil, ErrChangeNotRevertible
+		}
+		restoreDefault = !nullable
+	}
+
+	restored := change.OldValue
+	if restoreDefault {
+		var value []byte
+		err = tx.QueryRowContext(ctx, fmt.Sprintf(
+			`UPDATE %[2]s SET %[1]s = DEFAULT WHERE %[3]s = $1 RETURNING to_jsonb(%[1]s)`,
+			field, change.TableName, keyColumn,
+		), change.UserID).Scan(&value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revert field: %w", err)
+		}
+		restored = jsonValueOrNull(value)
+	} else {
+		// jsonb_populate_record converts the JSON back to the column's own type
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(
+			`UPDATE %[2]s SET %[1]s = (jsonb_populate_record(NULL::%[2]s, jsonb_build_object($1::text, $2::jsonb))).%[1]s WHERE %[3]s = $3`,
+			field, change.TableName, keyColumn,
+		), change.FieldName, string(change.OldValue), change.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to revert field: %w", err)
+		}
+	}
+
+	revert := &ProfileChange{
+		UserID:          change.UserID,
+		TableName:       change.TableName,
+		FieldName:       change.FieldName,
+		OldValue:        change.NewValue,
+		NewValue:        restored,
+		Source:          ChangeSourceAdmin,
+		ActorID:         sql.NullInt64{Int64: int64(adminID), Valid: true},
+		RevertsChangeID: sql.NullInt64{Int64: int64(change.ID), Valid: true},
+	}
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_profile_changes
+			(user_id, table_name, field_name, old_value, new_value, source, actor_id, reverts_change_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at
+	`, revert.UserID, revert.TableName, revert.FieldName, string(revert.OldValue), string(revert.NewValue),
+		revert.Source, revert.ActorID, revert.RevertsChangeID,
+	).Scan(&revert.ID, &revert.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record revert: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_profile_changes
+		SET reverted_at = CURRENT_TIMESTAMP, reverted_by = $2
+		WHERE id = $1
+	`, change.ID, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark change reverted: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit revert: %w", err)
+	}
+
+	return revert, nil
+}
+
+// updateWithChangeLog runs an UPDATE or UPSERT of one user's row in table and
+// records a change for every column in columns whose value it changed. query
+// must not have a RETURNING clause; the row is compared as JSON before and
+// after the write, in the same transaction.
+func updateWithChangeLog(ctx context.Context, db *sql.DB, table string, userID int, columns []string, query string, args ...interface{}) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer tx.Rollback()
+
+	if err := updateWithChangeLogTx(ctx, tx, table, userID, columns, query, args...); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return nil
+}
+
+// updateWithChangeLogTx is updateWithChangeLog within an existing transaction
+func updateWithChangeLogTx(ctx context.Context, tx *sql.Tx, table string, userID int, columns []string, query string, args ...interface{}) error {
+	keyColumn, ok := changeLoggedTables[table]
+	if !ok {
+		return fmt.Errorf("%w: table %s is not change logged", ErrDatabaseError, table)
+	}
+
+	// The row may not exist yet for upserts, in which case every old value is null
+	var before []byte
+	err := tx.QueryRowContext(ctx, fmt.Sprintf(
+		`SELECT to_jsonb(t) FROM %s t WHERE %s = $1 FOR UPDATE`, table, keyColumn,
+	), userID).Scan(&before)
+	if err != nil && err != sql.ErrNoRows {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	var after []byte
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("%s RETURNING to_jsonb(%s)", query, table), args...).Scan(&after)
+	if err == sql.ErrNoRows {
+		// Nothing matched, so nothing changed
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	beforeValues, err := decodeRowJSON(before)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	afterValues, err := decodeRowJSON(after)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	actor := changeActorFromContext(ctx, userID)
+	var actorID sql.NullInt64
+	if actor.ID != 0 {
+		actorID = sql.NullInt64{Int64: int64(actor.ID), Valid: true}
+	}
+
+	for _, column := range columns {
+		oldValue := jsonValueOrNull(beforeValues[column])
+		newValue := jsonValueOrNull(afterValues[column])
+		if jsonValuesEqual(oldValue, newValue) {
+			continue
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO datifyy_v2_profile_changes
+				(user_id, table_name, field_name, old_value, new_value, source, actor_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`, userID, table, column, string(oldValue), string(newValue), actor.Source, actorID)
+		if err != nil {
+			return fmt.Errorf("%w: failed to record change: %v", ErrDatabaseError, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeRowJSON(row []byte) (map[string]json.RawMessage, error) {
+	values := map[string]json.RawMessage{}
+	if len(row) == 0 {
+		return values, nil
+	}
+	if err := json.Unmarshal(row, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode row: %w", err)
+	}
+	return values, nil
+}
+
+// jsonValueOrNull treats a missing value (SQL NULL or absent key) as JSON null
+func jsonValueOrNull(value []byte) json.RawMessage {
+	if len(value) == 0 {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(value)
+}
+
+func jsonValuesEqual(a, b json.RawMessage) bool {
+	var compactA, compactB bytes.Buffer
+	if json.Compact(&compactA, a) != nil || json.Compact(&compactB, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(compactA.Bytes(), compactB.Bytes())
+}
+
+// sortedUpdateColumns returns the columns of an updates map in a stable order
+func sortedUpdateColumns(updates map[string]interface{}) []string {
+	columns := make([]string, 0, len(updates))
+	for column := range updates {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ProfileViewer is a user who viewed a profile, with their most recent view
+type ProfileViewer struct {
+	ViewerUserID int
+	LastViewedAt time.Time
+}
+
+// ProfileViewRepository records profile views
+type ProfileViewRepository struct {
+	db *sql.DB
+}
+
+// NewProfileViewRepository creates a new repository
+func NewProfileViewRepository(db *sql.DB) *ProfileViewRepository {
+	return &ProfileViewRepository{db: db}
+}
+
+// RecordView records that viewerID viewed viewedID's profile. Only the first
+// view per day is stored; recorded is false for repeat views.
+func (r *ProfileViewRepository) RecordView(ctx context.Context, viewerID, viewedID int) (recorded bool, err error) {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_profile_views (viewer_user_id, viewed_user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (viewer_user_id, viewed_user_id, view_date) DO NOTHING
+	`, viewerID, viewedID)
+	if err != nil {
+		return false, fmt.Errorf("failed to record profile view: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to record profile view: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// ListViewers returns the distinct users who viewed a profile, most recent
+// first. Inactive viewers and users blocked in either direction are left out.
+func (r *ProfileViewRepository) ListViewers(ctx context.Context, viewedID, limit, offset int) ([]ProfileViewer, int, error) {
+	const filter = `
+		FROM datifyy_v2_profile_views v
+		JOIN datifyy_v2_users u ON u.id = v.viewer_user_id
+		WHERE v.viewed_user_id = $1
+		  AND u.account_status = 'ACTIVE'
+		  AND NOT EXISTS (
+		      SELECT 1 FROM user_blocks b
+		      WHERE (b.blocker_user_id = $1 AND b.blocked_user_id = v.viewer_user_id)
+		         OR (b.blocker_user_id = v.viewer_user_id AND b.blocked_user_id = $1)
+		  )`
+
+	var totalCount int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(DISTINCT v.viewer_user_id) `+filter, viewedID).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count profile viewers: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT v.viewer_user_id, MAX(v.viewed_at) AS last_viewed_at `+filter+`
+		GROUP BY v.viewer_user_id
+		ORDER BY last_viewed_at DESC
+		LIMIT $2 OFFSET $3
+	`, viewedID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list profile viewers: %w", err)
+	}
+	defer rows.Close()
+
+	viewers := []ProfileViewer{}
+	for rows.Next() {
+		var v ProfileViewer
+		if err := rows.Scan(&v.ViewerUserID, &v.LastViewedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan profile viewer: %w", err)
+		}
+		viewers = append(viewers, v)
+	}
+
+	return viewers, totalCount, rows.Err()
+}
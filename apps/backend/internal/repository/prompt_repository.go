@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrPromptNotFound is returned when a prompt does not exist
+	ErrPromptNotFound = errors.New("prompt not found")
+	// ErrPromptExists is returned when a prompt key or legacy question is already taken
+	ErrPromptExists = errors.New("prompt already exists")
+)
+
+// PromptText maps a locale such as "en" or "hi" to a prompt's question text
+type PromptText map[string]string
+
+// Scan implements sql.Scanner
+func (t *PromptText) Scan(src interface{}) error {
+	return scanJSONB(src, t)
+}
+
+// Value implements driver.Valuer
+func (t PromptText) Value() (driver.Value, error) {
+	if t == nil {
+		return "{}", nil
+	}
+	return jsonbValue(t)
+}
+
+// Prompt is a question from the profile prompts catalog
+type Prompt struct {
+	ID              int
+	Key             string // referenced by answers as their prompt_id
+	Category        string
+	Text            PromptText
+	LegacyQuestion  sql.NullInt32 // PromptQuestion enum value, for prompts that predate the catalog
+	MinAnswerLength int
+	MaxAnswerLength int
+	DisplayOrder    int
+	ActiveFrom      sql.NullTime
+	ActiveUntil     sql.NullTime
+	UpdatedBy       sql.NullInt64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsActive reports whether the prompt can be answered at t
+func (p *Prompt) IsActive(t time.Time) bool {
+	if p.ActiveFrom.Valid && t.Before(p.ActiveFrom.Time) {
+		return false
+	}
+	return !p.ActiveUntil.Valid || t.Before(p.ActiveUntil.Time)
+}
+
+// PromptRepository handles the profile prompts catalog
+type PromptRepository struct {
+	db *sql.DB
+}
+
+// NewPromptRepository creates a new repository
+func NewPromptRepository(db *sql.DB) *PromptRepository {
+	return &PromptRepository{db: db}
+}
+
+const promptColumns = `id, key, category, text, legacy_question, min_answer_length, max_answer_length,
+	display_order, active_from, active_until, updated_by, created_at, updated_at`
+
+func scanPrompt(row interface{ Scan(...interface{}) error }) (*Prompt, error) {
+	p := &Prompt{}
+	err := row.Scan(&p.ID, &p.Key, &p.Category, &p.Text, &p.LegacyQuestion, &p.MinAnswerLength, &p.MaxAnswerLength,
+		&p.DisplayOrder, &p.ActiveFrom, &p.ActiveUntil, &p.UpdatedBy, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListPrompts returns prompts ordered by category and display order. An empty
+// category returns every category; activeOnly skips prompts outside their
+// active window.
+func (r *PromptRepository) ListPrompts(ctx context.Context, category string, activeOnly bool) ([]*Prompt, error) {
+	var conditions []string
+	var args []interface{}
+	if category != "" {
+		args = append(args, category)
+		conditions = append(conditions, fmt.Sprintf("category = $%d", len(args)))
+	}
+	if activeOnly {
+		conditions = append(conditions,
+			"(active_from IS NULL OR active_from <= NOW())",
+			"(active_until IS NULL OR active_until > NOW())")
+	}
+
+	query := `SELECT ` + promptColumns + ` FROM datifyy_v2_profile_prompts`
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query += ` ORDER BY category, display_order, id`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prompts: %w", err)
+	}
+	defer rows.Close()
+
+	var prompts []*Prompt
+	for rows.Next() {
+		p, err := scanPrompt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan prompt: %w", err)
+		}
+		prompts = append(prompts, p)
+	}
+
+	return prompts, rows.Err()
+}
+
+// GetPromptByKey returns a single prompt, active or not
+func (r *PromptRepository) GetPromptByKey(ctx context.Context, key string) (*Prompt, error) {
+	p, err := scanPrompt(r.db.QueryRowContext(ctx,
+		`SELECT `+promptColumns+` FROM datifyy_v2_profile_prompts WHERE key = $1`, key))
+	if err == sql.ErrNoRows {
+		return nil, ErrPromptNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt: %w", err)
+	}
+
+	return p, nil
+}
+
+// CreatePrompt adds a prompt to the catalog, filling in its ID and timestamps
+func (r *PromptRepository) CreatePrompt(ctx context.Context, p *Prompt) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_profile_prompts (key, category, text, legacy_question, min_answer_length,
+			max_answer_length, display_order, active_from, active_until, updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at`,
+		p.Key, p.Category, p.Text, p.LegacyQuestion, p.MinAnswerLength,
+		p.MaxAnswerLength, p.DisplayOrder, p.ActiveFrom, p.ActiveUntil, p.UpdatedBy,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return ErrPromptExists
+		}
+		return fmt.Errorf("failed to create prompt: %w", err)
+	}
+
+	return nil
+}
+
+// UpdatePrompt replaces everything but a prompt's key and legacy question
+func (r *PromptRepository) UpdatePrompt(ctx context.Context, p *Prompt) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_profile_prompts
+		SET category = $2, text = $3, min_answer_length = $4, max_answer_length = $5,
+			display_order = $6, active_from = $7, active_until = $8, updated_by = $9, updated_at = NOW()
+		WHERE key = $1
+		RETURNING id, legacy_question, created_at, updated_at`,
+		p.Key, p.Category, p.Text, p.MinAnswerLength, p.MaxAnswerLength,
+		p.DisplayOrder, p.ActiveFrom, p.ActiveUntil, p.UpdatedBy,
+	).Scan(&p.ID, &p.LegacyQuestion, &p.CreatedAt, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrPromptNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update prompt: %w", err)
+	}
+
+	return nil
+}
+
+// RetirePrompt closes a prompt's active window now. Retired prompts stay in
+// the catalog so existing answers keep their question text.
+func (r *PromptRepository) RetirePrompt(ctx context.Context, key string, adminID int) (*Prompt, error) {
+	p, err := scanPrompt(r.db.QueryRowContext(ctx, `
+		UPDATE datifyy_v2_profile_prompts
+		SET active_until = LEAST(COALESCE(active_until, NOW()), NOW()),
+			active_from = CASE WHEN active_from > NOW() THEN NULL ELSE active_from END,
+			updated_by = $2, updated_at = NOW()
+		WHERE key = $1
+		RETURNING `+promptColumns, key, adminID))
+	if err == sql.ErrNoRows {
+		return nil, ErrPromptNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retire prompt: %w", err)
+	}
+
+	return p, nil
+}
@@ -3,7 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 // ScheduledDatesRepository handles database operations for scheduled dates
@@ -16,26 +20,177 @@ func NewScheduledDatesRepository(db *sql.DB) *ScheduledDatesRepository {
 	return &ScheduledDatesRepository{db: db}
 }
 
-// Create creates a new scheduled date
+var (
+	ErrSlotReserved = errors.New("availability slot is already reserved by another date")
+	ErrDateConflict = errors.New("a participant already has a date scheduled at this time")
+)
+
+// Create creates a new scheduled date, reserving the participants' slots
+// that cover it in the same transaction. It fails with ErrSlotReserved or
+// ErrDateConflict if either participant is already booked.
 func (r *ScheduledDatesRepository) Create(ctx context.Context, date *ScheduledDate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := reserveDateSlots(ctx, tx, date); err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO datifyy_v2_scheduled_dates (
 			user1_id, user2_id, genie_id, scheduled_time, duration_minutes,
-			status, date_type, notes, admin_notes
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			status, date_type, notes, admin_notes, user1_slot_id, user2_slot_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err = tx.QueryRowContext(
 		ctx, query,
 		date.User1ID, date.User2ID, date.GenieID, date.ScheduledTime, date.DurationMinutes,
-		date.Status, date.DateType, date.Notes, date.AdminNotes,
+		date.Status, date.DateType, date.Notes, date.AdminNotes, date.User1SlotID, date.User2SlotID,
 	).Scan(&date.ID, &date.CreatedAt, &date.UpdatedAt)
 
 	if err != nil {
 		return fmt.Errorf("failed to create scheduled date: %w", err)
 	}
 
+	if err := markSlotsReserved(ctx, tx, date); err != nil {
+		return err
+	}
+
+	if err := insertDateActivity(ctx, tx, date.ID, DateActivityCreated, "", date.Status, "", dateCreator(date)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
+
+// reserveDateSlots checks a new or moved date against the participants'
+// bookings. It locks both participants, then each one's free slot covering the
+// date, failing if another date has reserved every covering slot or a
+// participant has another date at the time, and records the slots on date. A
+// participant without a covering slot is left unlinked.
+func reserveDateSlots(ctx context.Context, tx *sql.Tx, date *ScheduledDate) error {
+	end := date.ScheduledTime.Add(time.Duration(date.DurationMinutes) * time.Minute)
+
+	// Bookings for the same user are checked one at a time, so two dates can't
+	// both pass the conflict check below. Locking in ID order avoids deadlocks.
+	_, err := tx.ExecContext(ctx, `
+		SELECT id FROM datifyy_v2_users WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`,
+		date.User1ID, date.User2ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock participants: %w", err)
+	}
+
+	participants := []struct {
+		userID int
+		slotID *sql.NullInt64
+	}{
+		{date.User1ID, &date.User1SlotID},
+		{date.User2ID, &date.User2SlotID},
+	}
+	for _, p := range participants {
+		var slotID int64
+		err := tx.QueryRowContext(ctx, `
+			SELECT id
+			FROM datifyy_v2_availability_slots
+			WHERE user_id = $1 AND start_time <= $2 AND end_time >= $3
+			  AND reserved_date_id IS NULL
+			ORDER BY start_time ASC
+			LIMIT 1
+			FOR UPDATE`,
+			p.userID, date.ScheduledTime.Unix(), end.Unix(),
+		).Scan(&slotID)
+		if err == sql.ErrNoRows {
+			// No free slot: the time is taken if a reserved one covers it
+			var reserved bool
+			err = tx.QueryRowContext(ctx, `
+				SELECT EXISTS(
+					SELECT 1 FROM datifyy_v2_availability_slots
+					WHERE user_id = $1 AND start_time <= $2 AND end_time >= $3
+					  AND reserved_date_id IS NOT NULL
+				)`,
+				p.userID, date.ScheduledTime.Unix(), end.Unix(),
+			).Scan(&reserved)
+			if err != nil {
+				return fmt.Errorf("failed to check reserved slots: %w", err)
+			}
+			if reserved {
+				return ErrSlotReserved
+			}
+			*p.slotID = sql.NullInt64{}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock availability slot: %w", err)
+		}
+		*p.slotID = sql.NullInt64{Int64: slotID, Valid: true}
+	}
+
+	var conflict bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT EXISTS(
+			SELECT 1 FROM datifyy_v2_scheduled_dates
+			WHERE (user1_id IN ($1, $2) OR user2_id IN ($1, $2))
+			  AND status IN ('scheduled', 'confirmed', 'in_progress')
+			  AND scheduled_time < $4
+			  AND scheduled_time + duration_minutes * INTERVAL '1 minute' > $3
+			  AND id <> $5
+		)`,
+		date.User1ID, date.User2ID, date.ScheduledTime, end, date.ID,
+	).Scan(&conflict)
+	if err != nil {
+		return fmt.Errorf("failed to check for conflicting dates: %w", err)
+	}
+	if conflict {
+		return ErrDateConflict
+	}
+
+	return nil
+}
+
+// markSlotsReserved reserves the slots reserveDateSlots linked to a date once
+// it has been inserted
+func markSlotsReserved(ctx context.Context, tx *sql.Tx, date *ScheduledDate) error {
+	if !date.User1SlotID.Valid && !date.User2SlotID.Valid {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_availability_slots
+		SET reserved_date_id = $1
+		WHERE id IN ($2, $3)`,
+		date.ID, date.User1SlotID, date.User2SlotID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reserve availability slots: %w", err)
+	}
+	return nil
+}
+
+// releaseDateSlots frees the slots reserved by cancelled dates
+func releaseDateSlots(ctx context.Context, tx *sql.Tx, dateIDs []int) error {
+	if len(dateIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_availability_slots
+		SET reserved_date_id = NULL
+		WHERE reserved_date_id = ANY($1)`,
+		pq.Array(dateIDs),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to release availability slots: %w", err)
+	}
 	return nil
 }
 
@@ -59,7 +214,7 @@ func (r *ScheduledDatesRepository) GetByID(ctx context.Context, id int) (*Schedu
 	)
 
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("scheduled date not found")
+		return nil, ErrDateNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scheduled date: %w", err)
@@ -68,14 +223,25 @@ func (r *ScheduledDatesRepository) GetByID(ctx context.Context, id int) (*Schedu
 	return date, nil
 }
 
-// UpdateStatus updates the status of a scheduled date
-func (r *ScheduledDatesRepository) UpdateStatus(ctx context.Context, id int, status string) error {
-	query := `UPDATE datifyy_v2_scheduled_dates SET status = $1 WHERE id = $2`
-	_, err := r.db.ExecContext(ctx, query, status, id)
+// UpdateStatus moves a scheduled date to a new status on behalf of actor,
+// logging the change. Transitions the date's lifecycle doesn't allow fail
+// with datestatus.ErrInvalidTransition.
+func (r *ScheduledDatesRepository) UpdateStatus(ctx context.Context, id int, status string, actor DateActor, notes string) (*ScheduledDate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to update scheduled date status: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
-	return nil
+	defer tx.Rollback()
+
+	date, err := transitionDate(ctx, tx, id, status, actor, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+	return date, nil
 }
 
 // ListByUserUpcoming retrieves upcoming scheduled dates for a user
@@ -117,6 +283,46 @@ func (r *ScheduledDatesRepository) ListByUserUpcoming(ctx context.Context, userI
 	return dates, nil
 }
 
+// ListActiveByUsersBetween retrieves the scheduled, confirmed and in-progress
+// dates of any of userIDs that overlap [from, to)
+func (r *ScheduledDatesRepository) ListActiveByUsersBetween(ctx context.Context, userIDs []int, from, to time.Time) ([]*ScheduledDate, error) {
+	query := `
+		SELECT id, user1_id, user2_id, genie_id, scheduled_time, duration_minutes,
+			   status, date_type, place_name, address, city, state, country, zipcode,
+			   latitude, longitude, notes, admin_notes, created_at, updated_at,
+			   confirmed_at, completed_at, cancelled_at
+		FROM datifyy_v2_scheduled_dates
+		WHERE (user1_id = ANY($1) OR user2_id = ANY($1))
+		  AND status IN ('scheduled', 'confirmed', 'in_progress')
+		  AND scheduled_time < $3
+		  AND scheduled_time + duration_minutes * INTERVAL '1 minute' > $2
+		ORDER BY scheduled_time ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(userIDs), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active dates: %w", err)
+	}
+	defer rows.Close()
+
+	var dates []*ScheduledDate
+	for rows.Next() {
+		date := &ScheduledDate{}
+		err := rows.Scan(
+			&date.ID, &date.User1ID, &date.User2ID, &date.GenieID, &date.ScheduledTime, &date.DurationMinutes,
+			&date.Status, &date.DateType, &date.PlaceName, &date.Address, &date.City, &date.State, &date.Country, &date.Zipcode,
+			&date.Latitude, &date.Longitude, &date.Notes, &date.AdminNotes, &date.CreatedAt, &date.UpdatedAt,
+			&date.ConfirmedAt, &date.CompletedAt, &date.CancelledAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled date: %w", err)
+		}
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
+
 // ListByUserPast retrieves past scheduled dates for a user
 func (r *ScheduledDatesRepository) ListByUserPast(ctx context.Context, userID int, limit, offset int) ([]*ScheduledDate, error) {
 	query := `
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/datifyy/backend/internal/datestatus"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testScheduledDate(at time.Time) *ScheduledDate {
+	return &ScheduledDate{
+		User1ID:         1,
+		User2ID:         2,
+		ScheduledTime:   at,
+		DurationMinutes: 60,
+		Status:          "scheduled",
+		DateType:        "online",
+	}
+}
+
+func TestScheduledDatesCreate_ReservesCoveringSlots(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewScheduledDatesRepository(db)
+
+	at := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	start, end := at.Unix(), at.Add(time.Hour).Unix()
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT id FROM datifyy_v2_users WHERE id IN \\(\\$1, \\$2\\) ORDER BY id FOR UPDATE").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT id FROM datifyy_v2_availability_slots .* AND reserved_date_id IS NULL .* FOR UPDATE").
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	// User 2 has no slot covering the date; it is scheduled anyway
+	mock.ExpectQuery("SELECT id FROM datifyy_v2_availability_slots .* AND reserved_date_id IS NULL .* FOR UPDATE").
+		WithArgs(2, start, end).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT EXISTS\\(.* AND reserved_date_id IS NOT NULL").
+		WithArgs(2, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, 2, at, at.Add(time.Hour), 0).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_scheduled_dates").
+		WithArgs(1, 2, sql.NullInt64{}, at, 60, "scheduled", "online", sql.NullString{}, sql.NullString{},
+			sql.NullInt64{Int64: 11, Valid: true}, sql.NullInt64{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(7, time.Now(), time.Now()))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_slots SET reserved_date_id = \\$1").
+		WithArgs(7, sql.NullInt64{Int64: 11, Valid: true}, sql.NullInt64{}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(7, DateActivityCreated, "", "scheduled", "", DateActorAdmin, sql.NullInt64{}, sql.NullInt64{}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	date := testScheduledDate(at)
+	require.NoError(t, repo.Create(context.Background(), date))
+	assert.Equal(t, 7, date.ID)
+	assert.Equal(t, int64(11), date.User1SlotID.Int64)
+	assert.False(t, date.User2SlotID.Valid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestScheduledDatesCreate_RejectsDoubleBooking(t *testing.T) {
+	at := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+
+	t.Run("reserved slot", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("FROM datifyy_v2_users").WillReturnResult(sqlmock.NewResult(0, 2))
+		// The only slot covering the date is already reserved
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("reserved_date_id IS NOT NULL").
+			WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		err = NewScheduledDatesRepository(db).Create(context.Background(), testScheduledDate(at))
+		assert.ErrorIs(t, err, ErrSlotReserved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("overlapping date", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("FROM datifyy_v2_users").WillReturnResult(sqlmock.NewResult(0, 2))
+		for i := 0; i < 2; i++ {
+			mock.ExpectQuery("FOR UPDATE").WillReturnError(sql.ErrNoRows)
+			mock.ExpectQuery("reserved_date_id IS NOT NULL").
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		}
+		mock.ExpectQuery("FROM datifyy_v2_scheduled_dates").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		// Admin-created dates go through the same checks
+		_, err = NewAdminRepository(db).CreateScheduledDate(context.Background(), testScheduledDate(at))
+		assert.ErrorIs(t, err, ErrDateConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+// scheduledDateRow is a scheduled date as returned by the UPDATE ... RETURNING
+// in transitionDate
+func scheduledDateRow(id int, status string) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{
+		"id", "user1_id", "user2_id", "genie_id", "scheduled_time", "duration_minutes",
+		"status", "date_type", "place_name", "address", "city", "state", "country", "zipcode",
+		"latitude", "longitude", "notes", "admin_notes", "created_at", "updated_at",
+		"confirmed_at", "completed_at", "cancelled_at",
+	}).AddRow(
+		id, 1, 2, 5, now.Add(72*time.Hour), 60,
+		status, "online", nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, now, now,
+		nil, nil, nil,
+	)
+}
+
+func TestScheduledDatesUpdateStatus_CancelReleasesSlots(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	user := DateActor{Type: DateActorUser, UserID: 2}
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("confirmed"))
+	// Users aren't admins: cancelled_by_user_id rather than cancelled_by, and no admin notes
+	mock.ExpectQuery("UPDATE datifyy_v2_scheduled_dates SET status = \\$1, cancelled_at = CURRENT_TIMESTAMP, cancelled_by_user_id = \\$3, cancellation_reason = \\$4 WHERE id = \\$2 RETURNING").
+		WithArgs("cancelled", 7, sql.NullInt64{Int64: 2, Valid: true}, "Sick").
+		WillReturnRows(scheduledDateRow(7, "cancelled"))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_slots SET reserved_date_id = NULL").
+		WithArgs(pq.Array([]int{7})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(7, DateActivityStatusChanged, "confirmed", "cancelled", "Sick", DateActorUser,
+			sql.NullInt64{}, sql.NullInt64{Int64: 2, Valid: true}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	date, err := NewScheduledDatesRepository(db).UpdateStatus(context.Background(), 7, "cancelled", user, "Sick")
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", date.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateDateStatus_SetsTimestampAndLogsAdmin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("scheduled"))
+	mock.ExpectQuery("UPDATE datifyy_v2_scheduled_dates SET status = \\$1, admin_notes = \\$3, confirmed_at = CURRENT_TIMESTAMP WHERE id = \\$2").
+		WithArgs("confirmed", 7, "Both replied").
+		WillReturnRows(scheduledDateRow(7, "confirmed"))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(7, DateActivityStatusChanged, "scheduled", "confirmed", "Both replied", DateActorAdmin,
+			sql.NullInt64{Int64: 5, Valid: true}, sql.NullInt64{}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	date, err := NewAdminRepository(db).UpdateDateStatus(context.Background(), 7, "confirmed", "Both replied", 5)
+	require.NoError(t, err)
+	assert.Equal(t, "confirmed", date.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateDateStatus_RejectsInvalidTransition(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("completed"))
+	mock.ExpectRollback()
+
+	_, err = NewAdminRepository(db).UpdateDateStatus(context.Background(), 7, "cancelled", "", 5)
+	assert.ErrorIs(t, err, datestatus.ErrInvalidTransition)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateRescheduleRequest_OnePendingPerDate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("INSERT INTO datifyy_v2_date_reschedule_requests (.+) ON CONFLICT \\(date_id\\) WHERE status = 'pending' DO NOTHING").
+		WillReturnError(sql.ErrNoRows)
+
+	err = NewScheduledDatesRepository(db).CreateRescheduleRequest(context.Background(), &RescheduleRequest{
+		DateID: 7, UserID: 1, ResponderID: 2, ProposedTimes: []int64{time.Now().Add(96 * time.Hour).Unix()},
+	})
+	assert.ErrorIs(t, err, ErrReschedulePending)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptRescheduleRequest_DatePassed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM datifyy_v2_date_reschedule_requests WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRow(time.Now().Add(96 * time.Hour)))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"user1_id", "user2_id", "scheduled_time", "duration_minutes", "status"}).
+			AddRow(1, 2, time.Now().Add(-time.Hour), 60, "confirmed"))
+	mock.ExpectRollback()
+
+	_, err = NewScheduledDatesRepository(db).AcceptRescheduleRequest(context.Background(), 3, time.Now().Add(96*time.Hour))
+	assert.ErrorIs(t, err, ErrDateNotActive)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// rescheduleRequestRow is a pending request to move date 7, answered by user 2
+func rescheduleRequestRow(proposed time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "date_id", "user_id", "responder_id", "proposed_times", "reason", "status",
+		"accepted_time", "created_at", "responded_at",
+	}).AddRow(3, 7, 1, 2, pq.Array([]int64{proposed.Unix()}), nil, "pending", nil, time.Now(), nil)
+}
+
+func TestAcceptRescheduleRequest_ConfirmedDateNeedsConfirmingAgain(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	oldTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	newTime := oldTime.Add(48 * time.Hour)
+	responder := sql.NullInt64{Int64: 2, Valid: true}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("FROM datifyy_v2_date_reschedule_requests WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRow(newTime))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"user1_id", "user2_id", "scheduled_time", "duration_minutes", "status"}).
+			AddRow(1, 2, oldTime, 60, "confirmed"))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_slots SET reserved_date_id = NULL").
+		WithArgs(pq.Array([]int{7})).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("FROM datifyy_v2_users").WillReturnResult(sqlmock.NewResult(0, 2))
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery("FOR UPDATE").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("reserved_date_id IS NOT NULL").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectExec("UPDATE datifyy_v2_scheduled_dates SET scheduled_time = \\$2, user1_slot_id = \\$3, user2_slot_id = \\$4, status = \\$5, confirmed_at = NULL").
+		WithArgs(7, newTime, sql.NullInt64{}, sql.NullInt64{}, "scheduled").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE datifyy_v2_date_reschedule_requests SET status = 'accepted'").
+		WithArgs(3, newTime.Unix()).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "accepted_time", "responded_at"}).AddRow("accepted", newTime.Unix(), time.Now()))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(7, DateActivityRescheduled, sqlmock.AnyArg(), sqlmock.AnyArg(), "", DateActorUser, sql.NullInt64{}, responder).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(7, DateActivityStatusChanged, "confirmed", "scheduled", sqlmock.AnyArg(), DateActorUser, sql.NullInt64{}, responder).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	req, err := NewScheduledDatesRepository(db).AcceptRescheduleRequest(context.Background(), 3, newTime)
+	require.NoError(t, err)
+	assert.Equal(t, RescheduleStatusAccepted, req.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAcceptRescheduleRequest_ProposedTimePassed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = NewScheduledDatesRepository(db).AcceptRescheduleRequest(context.Background(), 3, time.Now().Add(-time.Minute))
+	assert.ErrorIs(t, err, ErrRescheduleTimePassed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,7 +6,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
+
+	"github.com/lib/pq"
 )
 
 var (
@@ -25,6 +26,14 @@ type UserProfile struct {
 	School               sql.NullString
 	Height               sql.NullInt32
 	Location             []byte // JSONB
+	Latitude             sql.NullFloat64
+	Longitude            sql.NullFloat64
+	Geohash              sql.NullString // coarse ~5km cell
+	BirthTime            sql.NullString // HH:MM local time
+	BirthPlace           sql.NullString
+	BirthLatitude        sql.NullFloat64
+	BirthLongitude       sql.NullFloat64
+	BirthTimezone        sql.NullString // IANA zone
 	Hometown             sql.NullString
 	Interests            []byte // JSONB
 	Languages            []byte // JSONB
@@ -45,89 +54,9 @@ type UserProfile struct {
 	Prompts              []byte // JSONB
 	CompletionPercentage int
 	IsPublic             bool
-	IsVerified           bool
-}
-
-// PartnerPreferences represents partner preferences in the database
-type PartnerPreferences struct {
-	ID                   int
-	UserID               int
-	// Basic Preferences
-	LookingForGender     []byte // JSONB
-	AgeRangeMin          int
-	AgeRangeMax          int
-	DistancePreference   int
-	HeightRangeMin       sql.NullInt32
-	HeightRangeMax       sql.NullInt32
-	// Relationship & Lifestyle
-	RelationshipGoals    []byte // JSONB
-	EducationLevels      []byte // JSONB
-	Occupations          []byte // JSONB
-	Religions            []byte // JSONB
-	ReligionImportance   int
-	ChildrenPreferences  []byte // JSONB
-	DrinkingPreferences  []byte // JSONB
-	SmokingPreferences   []byte // JSONB
-	DietaryPreferences   []byte // JSONB
-	PetPreferences       []byte // JSONB
-	WorkoutPreferences   []byte // JSONB
-	// Personality & Communication
-	PersonalityTypes     []byte // JSONB
-	CommunicationStyles  []byte // JSONB
-	LoveLanguages        []byte // JSONB
-	PoliticalViews       []byte // JSONB
-	SleepSchedules       []byte // JSONB
-	// Cultural & Matrimonial
-	CastePreferences     []byte // JSONB
-	SubCastePreferences  []byte // JSONB
-	GotraPreferences     []byte // JSONB
-	ManglikPreference    int
-	MotherTonguePrefs    []byte // JSONB
-	EthnicityPreferences []byte // JSONB
-	NationalityPrefs     []byte // JSONB
-	NRIPreference        int
-	HoroscopeRequired    bool
-	RelocationExpectation int
-	// Appearance
-	BodyTypePreferences  []byte // JSONB
-	ComplexionPrefs      []byte // JSONB
-	HairColorPrefs       []byte // JSONB
-	EyeColorPrefs        []byte // JSONB
-	FacialHairPrefs      []byte // JSONB
-	TattooPreference     int
-	PiercingPreference   int
-	DisabilityAcceptance int
-	// Professional & Financial
-	IncomePreferences    []byte // JSONB
-	EmploymentPrefs      []byte // JSONB
-	IndustryPreferences  []byte // JSONB
-	MinYearsExperience   int
-	PropertyPreference   int
-	VehiclePreference    int
-	FinancialExpectation int
-	// Family Background
-	FamilyTypePrefs      []byte // JSONB
-	FamilyValuesPrefs    []byte // JSONB
-	LivingSituationPrefs []byte // JSONB
-	FamilyAffluencePrefs []byte // JSONB
-	FamilyLocationPrefs  []byte // JSONB
-	MaxSiblings          int
-	// Language & Location
-	LanguagePreferences  []byte // JSONB
-	MinLangProficiency   int
-	LocationPreferences  []byte // JSONB
-	OpenToLongDistance   bool
-	// Interest & Hobbies
-	InterestPreferences  []byte // JSONB
-	MinSharedInterests   int
-	// Deal-Breakers & Must-Haves
-	VerifiedOnly         bool
-	MaxDaysInactive      int
-	PhotosRequired       bool
-	MinProfileCompletion int
-	DealBreakers         []byte // JSONB
-	MustHaves            []byte // JSONB
-	CustomDealbreakers   []byte // JSONB
+	IsVerified           bool // government ID verified
+	WorkEmailVerified    bool
+	WorkEmailDomain      sql.NullString
 }
 
 // UserPreferences represents user app preferences in the database
@@ -156,6 +85,7 @@ type UserPreferences struct {
 	RecentlyActiveDays  int
 	AppLanguage         string
 	Theme               string
+	Timezone            string // IANA zone
 }
 
 // ProfilePhoto represents a user photo in the database
@@ -180,22 +110,6 @@ type UserBlock struct {
 	CreatedAt      sql.NullTime
 }
 
-// UserReport represents a user report in the database
-type UserReport struct {
-	ID              int
-	ReporterUserID  int
-	ReportedUserID  int
-	ReportID        string
-	Reason          string
-	Details         sql.NullString
-	EvidenceURLs    []byte // JSONB
-	Status          string
-	ReviewedBy      sql.NullInt32
-	ReviewedAt      sql.NullTime
-	ResolutionNotes sql.NullString
-	CreatedAt       sql.NullTime
-}
-
 // UserProfileRepository handles user profile database operations
 type UserProfileRepository struct {
 	db *sql.DB
@@ -206,21 +120,20 @@ func NewUserProfileRepository(db *sql.DB) *UserProfileRepository {
 	return &UserProfileRepository{db: db}
 }
 
-// GetProfileByUserID retrieves a user profile by user ID
-func (r *UserProfileRepository) GetProfileByUserID(ctx context.Context, userID int) (*UserProfile, error) {
-	query := `
-		SELECT id, user_id, bio, occupation, company, job_title, education,
-		       school, height, location, hometown, interests, languages,
-		       relationship_goals, drinking, smoking, workout, dietary_preference,
-		       religion, religion_importance, political_view, pets, children,
-		       personality_type, communication_style, love_language, sleep_schedule,
-		       prompts, completion_percentage, is_public, is_verified
-		FROM datifyy_v2_user_profiles
-		WHERE user_id = $1
-	`
-
+// profileColumns are the columns scanned by scanProfile
+const profileColumns = `
+		id, user_id, bio, occupation, company, job_title, education,
+		school, height, location, hometown, interests, languages,
+		relationship_goals, drinking, smoking, workout, dietary_preference,
+		religion, religion_importance, political_view, pets, children,
+		personality_type, communication_style, love_language, sleep_schedule,
+		prompts, completion_percentage, is_public, is_verified,
+		work_email_verified, work_email_domain, latitude, longitude, geohash,
+		birth_time, birth_place, birth_latitude, birth_longitude, birth_timezone`
+
+func scanProfile(row rowScanner) (*UserProfile, error) {
 	profile := &UserProfile{}
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+	err := row.Scan(
 		&profile.ID, &profile.UserID, &profile.Bio, &profile.Occupation,
 		&profile.Company, &profile.JobTitle, &profile.Education, &profile.School,
 		&profile.Height, &profile.Location, &profile.Hometown, &profile.Interests,
@@ -230,8 +143,25 @@ func (r *UserProfileRepository) GetProfileByUserID(ctx context.Context, userID i
 		&profile.Pets, &profile.Children, &profile.PersonalityType,
 		&profile.CommunicationStyle, &profile.LoveLanguage, &profile.SleepSchedule,
 		&profile.Prompts, &profile.CompletionPercentage, &profile.IsPublic,
-		&profile.IsVerified,
+		&profile.IsVerified, &profile.WorkEmailVerified, &profile.WorkEmailDomain,
+		&profile.Latitude, &profile.Longitude, &profile.Geohash,
+		&profile.BirthTime, &profile.BirthPlace, &profile.BirthLatitude,
+		&profile.BirthLongitude, &profile.BirthTimezone,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// GetProfileByUserID retrieves a user profile by user ID
+func (r *UserProfileRepository) GetProfileByUserID(ctx context.Context, userID int) (*UserProfile, error) {
+	query := `SELECT ` + profileColumns + `
+		FROM datifyy_v2_user_profiles
+		WHERE user_id = $1
+	`
+
+	profile, err := scanProfile(r.db.QueryRowContext(ctx, query, userID))
 
 	if err == sql.ErrNoRows {
 		return nil, ErrProfileNotFound
@@ -244,92 +174,107 @@ func (r *UserProfileRepository) GetProfileByUserID(ctx context.Context, userID i
 	return profile, nil
 }
 
-// UpdateProfile updates a user profile
+// GetProfilesByUserIDs retrieves the profiles of several users, keyed by
+// user ID. Users without a profile are left out.
+func (r *UserProfileRepository) GetProfilesByUserIDs(ctx context.Context, userIDs []int) (map[int]*UserProfile, error) {
+	profiles := make(map[int]*UserProfile)
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+
+	query := `SELECT ` + profileColumns + `
+		FROM datifyy_v2_user_profiles
+		WHERE user_id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		profiles[profile.UserID] = profile
+	}
+
+	return profiles, rows.Err()
+}
+
+// UpdateProfile updates a user profile, recording each changed field in the change log
 func (r *UserProfileRepository) UpdateProfile(ctx context.Context, userID int, updates map[string]interface{}) error {
 	if len(updates) == 0 {
 		return nil
 	}
 
 	// Build dynamic UPDATE query
+	columns := sortedUpdateColumns(updates)
 	query := "UPDATE datifyy_v2_user_profiles SET "
 	args := []interface{}{}
 	argPos := 1
 
-	for key, value := range updates {
+	for _, key := range columns {
 		if argPos > 1 {
 			query += ", "
 		}
 		query += fmt.Sprintf("%s = $%d", key, argPos)
-		args = append(args, value)
+		args = append(args, updates[key])
 		argPos++
 	}
 
 	query += fmt.Sprintf(" WHERE user_id = $%d", argPos)
 	args = append(args, userID)
 
-	_, err := r.db.ExecContext(ctx, query, args...)
+	return updateWithChangeLog(ctx, r.db, "datifyy_v2_user_profiles", userID, columns, query, args...)
+}
+
+// userPreferenceColumns are the columns scanned by scanUserPreferences
+const userPreferenceColumns = `
+		id, user_id, push_enabled, email_enabled, sms_enabled,
+		notify_matches, notify_messages, notify_likes, notify_super_likes,
+		notify_profile_views, public_profile, show_online_status, show_distance,
+		show_age, allow_search_engines, incognito_mode, read_receipts,
+		discoverable, global_mode, verified_only, distance_radius,
+		recently_active_days, app_language, theme, timezone`
+
+func scanUserPreferences(row rowScanner) (*UserPreferences, error) {
+	prefs := &UserPreferences{}
+	err := row.Scan(
+		&prefs.ID, &prefs.UserID, &prefs.PushEnabled, &prefs.EmailEnabled,
+		&prefs.SmsEnabled, &prefs.NotifyMatches, &prefs.NotifyMessages,
+		&prefs.NotifyLikes, &prefs.NotifySuperLikes, &prefs.NotifyProfileViews,
+		&prefs.PublicProfile, &prefs.ShowOnlineStatus, &prefs.ShowDistance,
+		&prefs.ShowAge, &prefs.AllowSearchEngines, &prefs.IncognitoMode,
+		&prefs.ReadReceipts, &prefs.Discoverable, &prefs.GlobalMode,
+		&prefs.VerifiedOnly, &prefs.DistanceRadius, &prefs.RecentlyActiveDays,
+		&prefs.AppLanguage, &prefs.Theme, &prefs.Timezone,
+	)
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return nil, err
 	}
-
-	return nil
+	return prefs, nil
 }
 
-// GetPartnerPreferences retrieves partner preferences by user ID
-func (r *UserProfileRepository) GetPartnerPreferences(ctx context.Context, userID int) (*PartnerPreferences, error) {
-	query := `
-		SELECT id, user_id, looking_for_gender, age_range_min, age_range_max,
-		       distance_preference, height_range_min, height_range_max,
-		       relationship_goals, education_levels, occupations, religions,
-		       religion_importance, children_preferences, drinking_preferences, smoking_preferences,
-		       dietary_preferences, pet_preferences, workout_preferences,
-		       personality_types, communication_styles, love_languages, political_views, sleep_schedules,
-		       caste_preferences, sub_caste_preferences, gotra_preferences, manglik_preference,
-		       mother_tongue_preferences, ethnicity_preferences, nationality_preferences, nri_preference,
-		       horoscope_matching_required, relocation_expectation,
-		       body_type_preferences, complexion_preferences, hair_color_preferences, eye_color_preferences,
-		       facial_hair_preferences, tattoo_preference, piercing_preference, disability_acceptance,
-		       income_preferences, employment_preferences, industry_preferences, min_years_experience,
-		       property_preference, vehicle_preference, financial_expectation,
-		       family_type_preferences, family_values_preferences, living_situation_preferences,
-		       family_affluence_preferences, family_location_preferences, max_siblings,
-		       language_preferences, min_language_proficiency, location_preferences, open_to_long_distance,
-		       interest_preferences, min_shared_interests,
-		       verified_only, max_days_inactive, photos_required, min_profile_completion,
-		       deal_breakers, must_haves, custom_dealbreakers
-		FROM datifyy_v2_partner_preferences
+// GetUserPreferences retrieves user app preferences by user ID
+func (r *UserProfileRepository) GetUserPreferences(ctx context.Context, userID int) (*UserPreferences, error) {
+	query := `SELECT ` + userPreferenceColumns + `
+		FROM user_preferences
 		WHERE user_id = $1
 	`
 
-	prefs := &PartnerPreferences{}
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&prefs.ID, &prefs.UserID, &prefs.LookingForGender, &prefs.AgeRangeMin,
-		&prefs.AgeRangeMax, &prefs.DistancePreference, &prefs.HeightRangeMin,
-		&prefs.HeightRangeMax, &prefs.RelationshipGoals, &prefs.EducationLevels,
-		&prefs.Occupations, &prefs.Religions, &prefs.ReligionImportance,
-		&prefs.ChildrenPreferences, &prefs.DrinkingPreferences, &prefs.SmokingPreferences,
-		&prefs.DietaryPreferences, &prefs.PetPreferences, &prefs.WorkoutPreferences,
-		&prefs.PersonalityTypes, &prefs.CommunicationStyles, &prefs.LoveLanguages,
-		&prefs.PoliticalViews, &prefs.SleepSchedules,
-		&prefs.CastePreferences, &prefs.SubCastePreferences, &prefs.GotraPreferences,
-		&prefs.ManglikPreference, &prefs.MotherTonguePrefs, &prefs.EthnicityPreferences,
-		&prefs.NationalityPrefs, &prefs.NRIPreference, &prefs.HoroscopeRequired,
-		&prefs.RelocationExpectation, &prefs.BodyTypePreferences, &prefs.ComplexionPrefs,
-		&prefs.HairColorPrefs, &prefs.EyeColorPrefs, &prefs.FacialHairPrefs,
-		&prefs.TattooPreference, &prefs.PiercingPreference, &prefs.DisabilityAcceptance,
-		&prefs.IncomePreferences, &prefs.EmploymentPrefs, &prefs.IndustryPreferences,
-		&prefs.MinYearsExperience, &prefs.PropertyPreference, &prefs.VehiclePreference,
-		&prefs.FinancialExpectation, &prefs.FamilyTypePrefs, &prefs.FamilyValuesPrefs,
-		&prefs.LivingSituationPrefs, &prefs.FamilyAffluencePrefs, &prefs.FamilyLocationPrefs,
-		&prefs.MaxSiblings, &prefs.LanguagePreferences, &prefs.MinLangProficiency,
-		&prefs.LocationPreferences, &prefs.OpenToLongDistance, &prefs.InterestPreferences,
-		&prefs.MinSharedInterests, &prefs.VerifiedOnly, &prefs.MaxDaysInactive,
-		&prefs.PhotosRequired, &prefs.MinProfileCompletion, &prefs.DealBreakers,
-		&prefs.MustHaves, &prefs.CustomDealbreakers,
-	)
+	prefs, err := scanUserPreferences(r.db.QueryRowContext(ctx, query, userID))
 
 	if err == sql.ErrNoRows {
-		return nil, ErrProfileNotFound
+		// Create default preferences if not exists
+		return r.CreateDefaultUserPreferences(ctx, userID)
 	}
 
 	if err != nil {
@@ -339,81 +284,58 @@ func (r *UserProfileRepository) GetPartnerPreferences(ctx context.Context, userI
 	return prefs, nil
 }
 
-// UpdatePartnerPreferences updates partner preferences (UPSERT)
-func (r *UserProfileRepository) UpdatePartnerPreferences(ctx context.Context, userID int, updates map[string]interface{}) error {
-	if len(updates) == 0 {
-		return nil
+// GetUserPreferencesByUserIDs retrieves the app preferences of several users,
+// keyed by user ID. Users who never saved preferences are left out; unlike
+// GetUserPreferences, no defaults are created for them.
+func (r *UserProfileRepository) GetUserPreferencesByUserIDs(ctx context.Context, userIDs []int) (map[int]*UserPreferences, error) {
+	prefsByUser := make(map[int]*UserPreferences)
+	if len(userIDs) == 0 {
+		return prefsByUser, nil
 	}
 
-	// Build UPSERT query with ON CONFLICT
-	// First, build the column names and values for INSERT
-	columns := []string{"user_id"}
-	placeholders := []string{"$1"}
-	args := []interface{}{userID}
-	argPos := 2
-
-	// Build SET clause for ON CONFLICT UPDATE
-	var setClauses []string
-
-	for key, value := range updates {
-		columns = append(columns, key)
-		placeholders = append(placeholders, fmt.Sprintf("$%d", argPos))
-		args = append(args, value)
-		setClauses = append(setClauses, fmt.Sprintf("%s = EXCLUDED.%s", key, key))
-		argPos++
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
 	}
 
-	query := fmt.Sprintf(`
-		INSERT INTO datifyy_v2_partner_preferences (%s, created_at, updated_at)
-		VALUES (%s, NOW(), NOW())
-		ON CONFLICT (user_id) DO UPDATE SET
-			%s,
-			updated_at = NOW()
-	`, strings.Join(columns, ", "), strings.Join(placeholders, ", "), strings.Join(setClauses, ", "))
+	query := `SELECT ` + userPreferenceColumns + `
+		FROM user_preferences
+		WHERE user_id = ANY($1)
+	`
 
-	_, err := r.db.ExecContext(ctx, query, args...)
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
+	defer rows.Close()
 
-	return nil
-}
+	for rows.Next() {
+		prefs, err := scanUserPreferences(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		prefsByUser[prefs.UserID] = prefs
+	}
 
-// GetUserPreferences retrieves user app preferences by user ID
-func (r *UserProfileRepository) GetUserPreferences(ctx context.Context, userID int) (*UserPreferences, error) {
-	query := `
-		SELECT id, user_id, push_enabled, email_enabled, sms_enabled,
-		       notify_matches, notify_messages, notify_likes, notify_super_likes,
-		       notify_profile_views, public_profile, show_online_status, show_distance,
-		       show_age, allow_search_engines, incognito_mode, read_receipts,
-		       discoverable, global_mode, verified_only, distance_radius,
-		       recently_active_days, app_language, theme
-		FROM user_preferences
-		WHERE user_id = $1
-	`
+	return prefsByUser, rows.Err()
+}
 
-	prefs := &UserPreferences{}
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&prefs.ID, &prefs.UserID, &prefs.PushEnabled, &prefs.EmailEnabled,
-		&prefs.SmsEnabled, &prefs.NotifyMatches, &prefs.NotifyMessages,
-		&prefs.NotifyLikes, &prefs.NotifySuperLikes, &prefs.NotifyProfileViews,
-		&prefs.PublicProfile, &prefs.ShowOnlineStatus, &prefs.ShowDistance,
-		&prefs.ShowAge, &prefs.AllowSearchEngines, &prefs.IncognitoMode,
-		&prefs.ReadReceipts, &prefs.Discoverable, &prefs.GlobalMode,
-		&prefs.VerifiedOnly, &prefs.DistanceRadius, &prefs.RecentlyActiveDays,
-		&prefs.AppLanguage, &prefs.Theme,
-	)
+// GetUserTimezone returns a user's IANA timezone preference, or an empty
+// string if they have no preferences yet
+func (r *UserProfileRepository) GetUserTimezone(ctx context.Context, userID int) (string, error) {
+	var timezone string
+	err := r.db.QueryRowContext(ctx,
+		"SELECT timezone FROM user_preferences WHERE user_id = $1", userID,
+	).Scan(&timezone)
 
 	if err == sql.ErrNoRows {
-		// Create default preferences if not exists
-		return r.CreateDefaultUserPreferences(ctx, userID)
+		return "", nil
 	}
-
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		return "", fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
 
-	return prefs, nil
+	return timezone, nil
 }
 
 // CreateDefaultUserPreferences creates default user preferences
@@ -421,25 +343,9 @@ func (r *UserProfileRepository) CreateDefaultUserPreferences(ctx context.Context
 	query := `
 		INSERT INTO user_preferences (user_id)
 		VALUES ($1)
-		RETURNING id, user_id, push_enabled, email_enabled, sms_enabled,
-		          notify_matches, notify_messages, notify_likes, notify_super_likes,
-		          notify_profile_views, public_profile, show_online_status, show_distance,
-		          show_age, allow_search_engines, incognito_mode, read_receipts,
-		          discoverable, global_mode, verified_only, distance_radius,
-		          recently_active_days, app_language, theme
-	`
+		RETURNING ` + userPreferenceColumns
 
-	prefs := &UserPreferences{}
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&prefs.ID, &prefs.UserID, &prefs.PushEnabled, &prefs.EmailEnabled,
-		&prefs.SmsEnabled, &prefs.NotifyMatches, &prefs.NotifyMessages,
-		&prefs.NotifyLikes, &prefs.NotifySuperLikes, &prefs.NotifyProfileViews,
-		&prefs.PublicProfile, &prefs.ShowOnlineStatus, &prefs.ShowDistance,
-		&prefs.ShowAge, &prefs.AllowSearchEngines, &prefs.IncognitoMode,
-		&prefs.ReadReceipts, &prefs.Discoverable, &prefs.GlobalMode,
-		&prefs.VerifiedOnly, &prefs.DistanceRadius, &prefs.RecentlyActiveDays,
-		&prefs.AppLanguage, &prefs.Theme,
-	)
+	prefs, err := scanUserPreferences(r.db.QueryRowContext(ctx, query, userID))
 
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
@@ -448,35 +354,32 @@ func (r *UserProfileRepository) CreateDefaultUserPreferences(ctx context.Context
 	return prefs, nil
 }
 
-// UpdateUserPreferences updates user app preferences
+// UpdateUserPreferences updates user app preferences, recording each changed
+// field in the change log
 func (r *UserProfileRepository) UpdateUserPreferences(ctx context.Context, userID int, updates map[string]interface{}) error {
 	if len(updates) == 0 {
 		return nil
 	}
 
 	// Build dynamic UPDATE query
+	columns := sortedUpdateColumns(updates)
 	query := "UPDATE user_preferences SET "
 	args := []interface{}{}
 	argPos := 1
 
-	for key, value := range updates {
+	for _, key := range columns {
 		if argPos > 1 {
 			query += ", "
 		}
 		query += fmt.Sprintf("%s = $%d", key, argPos)
-		args = append(args, value)
+		args = append(args, updates[key])
 		argPos++
 	}
 
 	query += fmt.Sprintf(" WHERE user_id = $%d", argPos)
 	args = append(args, userID)
 
-	_, err := r.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
-	}
-
-	return nil
+	return updateWithChangeLog(ctx, r.db, "user_preferences", userID, columns, query, args...)
 }
 
 // GetPhotosByUserID retrieves all photos for a user
@@ -512,6 +415,48 @@ func (r *UserProfileRepository) GetPhotosByUserID(ctx context.Context, userID in
 	return photos, nil
 }
 
+// GetPhotosByUserIDs retrieves the photos of several users, keyed by user ID
+func (r *UserProfileRepository) GetPhotosByUserIDs(ctx context.Context, userIDs []int) (map[int][]*ProfilePhoto, error) {
+	photosByUser := make(map[int][]*ProfilePhoto)
+	if len(userIDs) == 0 {
+		return photosByUser, nil
+	}
+
+	ids := make([]int64, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = int64(id)
+	}
+
+	query := `
+		SELECT id, user_id, photo_id, url, thumbnail_url, display_order,
+		       is_primary, caption, uploaded_at
+		FROM user_photos
+		WHERE user_id = ANY($1)
+		ORDER BY user_id, display_order ASC, uploaded_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		photo := &ProfilePhoto{}
+		err := rows.Scan(
+			&photo.ID, &photo.UserID, &photo.PhotoID, &photo.URL,
+			&photo.ThumbnailURL, &photo.DisplayOrder, &photo.IsPrimary,
+			&photo.Caption, &photo.UploadedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		photosByUser[photo.UserID] = append(photosByUser[photo.UserID], photo)
+	}
+
+	return photosByUser, rows.Err()
+}
+
 // CreatePhoto creates a new user photo
 func (r *UserProfileRepository) CreatePhoto(ctx context.Context, photo *ProfilePhoto) error {
 	query := `
@@ -628,7 +573,7 @@ func (r *UserProfileRepository) GetBlockedUsers(ctx context.Context, blockerUser
 }
 
 // CreateReport creates a user report
-func (r *UserProfileRepository) CreateReport(ctx context.Context, reporterUserID, reportedUserID int, reportID, reason, details string, evidenceURLs []string) error {
+func (r *UserProfileRepository) CreateReport(ctx context.Context, reporterUserID, reportedUserID int, reportID, reason, severity, details string, evidenceURLs []string) error {
 	// Convert evidence URLs to JSON
 	evidenceJSON, err := json.Marshal(evidenceURLs)
 	if err != nil {
@@ -636,12 +581,12 @@ func (r *UserProfileRepository) CreateReport(ctx context.Context, reporterUserID
 	}
 
 	query := `
-		INSERT INTO user_reports (reporter_user_id, reported_user_id, report_id,
-		                          reason, details, evidence_urls)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO datifyy_v2_user_reports (reporter_user_id, reported_user_id, report_id,
+		                                     reason, severity, details, evidence_urls)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 	`
 
-	_, err = r.db.ExecContext(ctx, query, reporterUserID, reportedUserID, reportID, reason, details, evidenceJSON)
+	_, err = r.db.ExecContext(ctx, query, reporterUserID, reportedUserID, reportID, reason, severity, details, evidenceJSON)
 	if err != nil {
 		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
 	}
@@ -661,3 +606,22 @@ func (r *UserProfileRepository) IsUserBlocked(ctx context.Context, blockerUserID
 
 	return exists, nil
 }
+
+// IsBlockedEitherWay checks if either user has blocked the other
+func (r *UserProfileRepository) IsBlockedEitherWay(ctx context.Context, userAID, userBID int) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM user_blocks
+			WHERE (blocker_user_id = $1 AND blocked_user_id = $2)
+			   OR (blocker_user_id = $2 AND blocked_user_id = $1)
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRowContext(ctx, query, userAID, userBID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// UserRelationshipRepository answers questions about how two users relate to each other
+type UserRelationshipRepository struct {
+	db *sql.DB
+}
+
+// NewUserRelationshipRepository creates a new repository
+func NewUserRelationshipRepository(db *sql.DB) *UserRelationshipRepository {
+	return &UserRelationshipRepository{db: db}
+}
+
+// AreMatched reports whether two users are matched: they have a date that was not
+// cancelled, or both accepted a suggestion of each other.
+func (r *UserRelationshipRepository) AreMatched(ctx context.Context, userAID, userBID int) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM datifyy_v2_scheduled_dates
+			WHERE ((user1_id = $1 AND user2_id = $2) OR (user1_id = $2 AND user2_id = $1))
+			  AND status <> 'cancelled'
+		) OR (
+			SELECT COUNT(DISTINCT user_id) FROM datifyy_v2_date_suggestions
+			WHERE ((user_id = $1 AND suggested_user_id = $2) OR (user_id = $2 AND suggested_user_id = $1))
+			  AND status = 'accepted'
+		) = 2
+	`
+
+	var matched bool
+	if err := r.db.QueryRowContext(ctx, query, userAID, userBID).Scan(&matched); err != nil {
+		return false, fmt.Errorf("failed to check match: %w", err)
+	}
+
+	return matched, nil
+}
+
+// MatchedUserIDs returns which of otherIDs are matched with userID, by the
+// same rules as AreMatched
+func (r *UserRelationshipRepository) MatchedUserIDs(ctx context.Context, userID int, otherIDs []int) (map[int]bool, error) {
+	matched := make(map[int]bool)
+	if len(otherIDs) == 0 {
+		return matched, nil
+	}
+
+	ids := make([]int64, len(otherIDs))
+	for i, id := range otherIDs {
+		ids[i] = int64(id)
+	}
+
+	query := `
+		SELECT other.id
+		FROM UNNEST($2::int[]) AS other(id)
+		WHERE EXISTS (
+			SELECT 1 FROM datifyy_v2_scheduled_dates
+			WHERE ((user1_id = $1 AND user2_id = other.id) OR (user1_id = other.id AND user2_id = $1))
+			  AND status <> 'cancelled'
+		) OR (
+			SELECT COUNT(DISTINCT user_id) FROM datifyy_v2_date_suggestions
+			WHERE ((user_id = $1 AND suggested_user_id = other.id) OR (user_id = other.id AND suggested_user_id = $1))
+			  AND status = 'accepted'
+		) = 2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to check matches: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan match: %w", err)
+		}
+		matched[id] = true
+	}
+
+	return matched, rows.Err()
+}
+
+// WithdrawPairActivity withdraws pending suggestions and open curated matches between two
+// users and cancels their upcoming dates. Cancelled dates are returned with OtherUserID
+// set to userBID so the caller can notify them.
+func (r *UserRelationshipRepository) WithdrawPairActivity(ctx context.Context, userAID, userBID int, reason string) ([]CancelledDate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_date_suggestions
+		SET status = 'withdrawn', responded_at = NOW()
+		WHERE ((user_id = $1 AND suggested_user_id = $2) OR (user_id = $2 AND suggested_user_id = $1))
+		  AND status = 'pending'`, userAID, userBID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw suggestions: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_curated_matches
+		SET status = 'withdrawn'
+		WHERE ((user1_id = $1 AND user2_id = $2) OR (user1_id = $2 AND user2_id = $1))
+		  AND status NOT IN ('scheduled', 'rejected', 'withdrawn')`, userAID, userBID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to withdraw curated matches: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE datifyy_v2_scheduled_dates d
+		SET status = 'cancelled', cancelled_at = NOW(), cancellation_reason = $3
+		FROM datifyy_v2_scheduled_dates old
+		WHERE old.id = d.id
+		  AND ((d.user1_id = $1 AND d.user2_id = $2) OR (d.user1_id = $2 AND d.user2_id = $1))
+		  AND d.status IN ('scheduled', 'confirmed')
+		  AND d.scheduled_time > NOW()
+		RETURNING d.id, d.scheduled_time, old.status`, userAID, userBID, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel scheduled dates: %w", err)
+	}
+
+	var cancelled []CancelledDate
+	for rows.Next() {
+		d := CancelledDate{OtherUserID: userBID}
+		if err := rows.Scan(&d.DateID, &d.ScheduledTime, &d.PreviousStatus); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan cancelled date: %w", err)
+		}
+		cancelled = append(cancelled, d)
+	}
+	rows.Close()
+
+	cancelledIDs := make([]int, len(cancelled))
+	for i, d := range cancelled {
+		cancelledIDs[i] = d.DateID
+	}
+	if err := releaseDateSlots(ctx, tx, cancelledIDs); err != nil {
+		return nil, err
+	}
+	if err := logDateCancellations(ctx, tx, cancelled, reason, DateActor{Type: DateActorUser, UserID: userAID}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return cancelled, nil
+}
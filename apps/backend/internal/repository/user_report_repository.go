@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrReportNotFound is returned when a user report does not exist
+	ErrReportNotFound = errors.New("report not found")
+	// ErrReportClosed is returned when a report has already been resolved or dismissed
+	ErrReportClosed = errors.New("report already closed")
+	// ErrReportClaimed is returned when a report is being reviewed by another moderator
+	ErrReportClaimed = errors.New("report claimed by another moderator")
+)
+
+// UserReport represents a report filed against a user
+type UserReport struct {
+	ID               int
+	ReportID         string
+	ReporterUserID   int
+	ReportedUserID   int
+	ReportedUserName string
+	Reason           string
+	Details          sql.NullString
+	EvidenceURLs     []string
+	Status           string
+	Severity         string
+	ClaimedBy        sql.NullInt64
+	ClaimedAt        sql.NullTime
+	ReviewedBy       sql.NullInt64
+	ReviewedAt       sql.NullTime
+	ResolutionNotes  sql.NullString
+	EscalatedAt      sql.NullTime
+	CreatedAt        time.Time
+	OpenReportCount  int // open reports against the same user
+}
+
+// UserReportFilter narrows down the moderation queue
+type UserReportFilter struct {
+	Status         string
+	Severity       string
+	ReportedUserID int
+	ClaimedBy      int
+}
+
+// UserReportRepository handles database operations for report moderation
+type UserReportRepository struct {
+	db *sql.DB
+}
+
+// NewUserReportRepository creates a new repository
+func NewUserReportRepository(db *sql.DB) *UserReportRepository {
+	return &UserReportRepository{db: db}
+}
+
+const userReportColumns = `
+	r.id, r.report_id, r.reporter_user_id, r.reported_user_id, u.name,
+	r.reason, r.details, r.evidence_urls, r.status, r.severity,
+	r.claimed_by, r.claimed_at, r.reviewed_by, r.reviewed_at, r.resolution_notes,
+	r.escalated_at, r.created_at,
+	(SELECT COUNT(*) FROM datifyy_v2_user_reports o
+	 WHERE o.reported_user_id = r.reported_user_id AND o.status IN ('PENDING', 'IN_REVIEW'))
+`
+
+func scanUserReport(row interface{ Scan(...interface{}) error }) (*UserReport, error) {
+	report := &UserReport{}
+	var evidenceJSON []byte
+	err := row.Scan(
+		&report.ID, &report.ReportID, &report.ReporterUserID, &report.ReportedUserID, &report.ReportedUserName,
+		&report.Reason, &report.Details, &evidenceJSON, &report.Status, &report.Severity,
+		&report.ClaimedBy, &report.ClaimedAt, &report.ReviewedBy, &report.ReviewedAt, &report.ResolutionNotes,
+		&report.EscalatedAt, &report.CreatedAt,
+		&report.OpenReportCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(evidenceJSON) > 0 {
+		if err := json.Unmarshal(evidenceJSON, &report.EvidenceURLs); err != nil {
+			return nil, fmt.Errorf("failed to parse evidence URLs: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+// List returns reports matching the filter, highest severity and oldest first
+func (r *UserReportRepository) List(ctx context.Context, filter UserReportFilter, limit, offset int) ([]*UserReport, int, error) {
+	whereClauses := []string{"1=1"}
+	args := []interface{}{}
+	argCount := 0
+
+	if filter.Status != "" {
+		argCount++
+		whereClauses = append(whereClauses, fmt.Sprintf("r.status = $%d", argCount))
+		args = append(args, filter.Status)
+	}
+	if filter.Severity != "" {
+		argCount++
+		whereClauses = append(whereClauses, fmt.Sprintf("r.severity = $%d", argCount))
+		args = append(args, filter.Severity)
+	}
+	if filter.ReportedUserID != 0 {
+		argCount++
+		whereClauses = append(whereClauses, fmt.Sprintf("r.reported_user_id = $%d", argCount))
+		args = append(args, filter.ReportedUserID)
+	}
+	if filter.ClaimedBy != 0 {
+		argCount++
+		whereClauses = append(whereClauses, fmt.Sprintf("r.claimed_by = $%d", argCount))
+		args = append(args, filter.ClaimedBy)
+	}
+
+	whereClause := strings.Join(whereClauses, " AND ")
+
+	var totalCount int
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM datifyy_v2_user_reports r WHERE %s`, whereClause)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count reports: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM datifyy_v2_user_reports r
+		JOIN datifyy_v2_users u ON u.id = r.reported_user_id
+		WHERE %s
+		ORDER BY CASE r.severity WHEN 'HIGH' THEN 0 WHEN 'MEDIUM' THEN 1 ELSE 2 END, r.created_at ASC
+		LIMIT $%d OFFSET $%d
+	`, userReportColumns, whereClause, argCount+1, argCount+2)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reports: %w", err)
+	}
+	defer rows.Close()
+
+	var reports []*UserReport
+	for rows.Next() {
+		report, err := scanUserReport(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan report: %w", err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, totalCount, rows.Err()
+}
+
+// GetByReportID retrieves a report by its public report ID
+func (r *UserReportRepository) GetByReportID(ctx context.Context, reportID string) (*UserReport, error) {
+	query := `
+		SELECT ` + userReportColumns + `
+		FROM datifyy_v2_user_reports r
+		JOIN datifyy_v2_users u ON u.id = r.reported_user_id
+		WHERE r.report_id = $1`
+
+	report, err := scanUserReport(r.db.QueryRowContext(ctx, query, reportID))
+	if err == sql.ErrNoRows {
+		return nil, ErrReportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report: %w", err)
+	}
+
+	return report, nil
+}
+
+// Claim assigns an open report to a moderator and moves it to IN_REVIEW.
+// Re-claiming a report the moderator already holds is a no-op.
+func (r *UserReportRepository) Claim(ctx context.Context, reportID string, adminID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE datifyy_v2_user_reports
+		SET status = 'IN_REVIEW', claimed_by = $2, claimed_at = NOW()
+		WHERE report_id = $1
+		  AND (status = 'PENDING' OR (status = 'IN_REVIEW' AND claimed_by = $2))`, reportID, adminID)
+	if err != nil {
+		return fmt.Errorf("failed to claim report: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows > 0 {
+		return nil
+	}
+
+	// Work out why the report could not be claimed
+	var status string
+	var claimedBy sql.NullInt64
+	err = r.db.QueryRowContext(ctx,
+		`SELECT status, claimed_by FROM datifyy_v2_user_reports WHERE report_id = $1`, reportID,
+	).Scan(&status, &claimedBy)
+	if err == sql.ErrNoRows {
+		return ErrReportNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+	if status == "IN_REVIEW" {
+		return ErrReportClaimed
+	}
+	return ErrReportClosed
+}
+
+// Close resolves or dismisses an open report. When action is non-nil the enforcement
+// action is recorded against the reported user and their account status updated,
+// all in one transaction.
+func (r *UserReportRepository) Close(ctx context.Context, reportID string, adminID int, status, notes string, action *ModerationAction) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id, reportedUserID int
+	var currentStatus string
+	var claimedBy sql.NullInt64
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, reported_user_id, status, claimed_by
+		FROM datifyy_v2_user_reports WHERE report_id = $1 FOR UPDATE`, reportID,
+	).Scan(&id, &reportedUserID, &currentStatus, &claimedBy)
+	if err == sql.ErrNoRows {
+		return ErrReportNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get report: %w", err)
+	}
+
+	if currentStatus != "PENDING" && currentStatus != "IN_REVIEW" {
+		return ErrReportClosed
+	}
+	if currentStatus == "IN_REVIEW" && claimedBy.Valid && int(claimedBy.Int64) != adminID {
+		return ErrReportClaimed
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_user_reports
+		SET status = $2, reviewed_by = $3, reviewed_at = NOW(), resolution_notes = $4
+		WHERE id = $1`, id, status, adminID, sql.NullString{String: notes, Valid: notes != ""})
+	if err != nil {
+		return fmt.Errorf("failed to close report: %w", err)
+	}
+
+	if action != nil {
+		action.UserID = reportedUserID
+		action.ReportID = sql.NullInt64{Int64: int64(id), Valid: true}
+		action.AdminID = sql.NullInt64{Int64: int64(adminID), Valid: true}
+		if err := applyModerationAction(ctx, tx, action); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
+
+// CountRecentReporters returns how many distinct users reported a user since the given
+// time, and how many did not counting the report reportID, so callers can tell whether
+// that report took the count past a threshold
+func (r *UserReportRepository) CountRecentReporters(ctx context.Context, reportedUserID int, since time.Time, reportID string) (total, before int, err error) {
+	err = r.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT reporter_user_id),
+		       COUNT(DISTINCT reporter_user_id) FILTER (WHERE report_id <> $3)
+		FROM datifyy_v2_user_reports
+		WHERE reported_user_id = $1 AND created_at >= $2`, reportedUserID, since, reportID,
+	).Scan(&total, &before)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count reporters: %w", err)
+	}
+
+	return total, before, nil
+}
+
+// EscalateOpenReports raises every open, not yet escalated report against a user to
+// HIGH severity. It returns the number of reports escalated.
+func (r *UserReportRepository) EscalateOpenReports(ctx context.Context, reportedUserID int) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE datifyy_v2_user_reports
+		SET severity = 'HIGH', escalated_at = NOW()
+		WHERE reported_user_id = $1
+		  AND status IN ('PENDING', 'IN_REVIEW')
+		  AND escalated_at IS NULL`, reportedUserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to escalate reports: %w", err)
+	}
+
+	return result.RowsAffected()
+}
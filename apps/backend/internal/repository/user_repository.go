@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 var (
@@ -176,21 +178,18 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*User, e
 	return user, nil
 }
 
-// GetByID retrieves a user by ID
-func (r *UserRepository) GetByID(ctx context.Context, id int) (*User, error) {
-	query := `
-		SELECT id, email, name, password_hash, phone_number,
-		       email_verified, phone_verified, account_status,
-		       verification_token, verification_token_expires_at,
-		       password_reset_token, password_reset_token_expires_at,
-		       last_login_at, photo_url, date_of_birth, gender,
-		       created_at, updated_at
-		FROM datifyy_v2_users
-		WHERE id = $1
-	`
+// userColumns are the columns scanned by scanUser
+const userColumns = `
+		id, email, name, password_hash, phone_number,
+		email_verified, phone_verified, account_status,
+		verification_token, verification_token_expires_at,
+		password_reset_token, password_reset_token_expires_at,
+		last_login_at, photo_url, date_of_birth, gender,
+		created_at, updated_at`
 
+func scanUser(row rowScanner) (*User, error) {
 	user := &User{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := row.Scan(
 		&user.ID,
 		&user.Email,
 		&user.Name,
@@ -210,6 +209,20 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*User, error) {
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetByID retrieves a user by ID
+func (r *UserRepository) GetByID(ctx context.Context, id int) (*User, error) {
+	query := `SELECT ` + userColumns + `
+		FROM datifyy_v2_users
+		WHERE id = $1
+	`
+
+	user, err := scanUser(r.db.QueryRowContext(ctx, query, id))
 
 	if err == sql.ErrNoRows {
 		return nil, ErrUserNotFound
@@ -222,6 +235,40 @@ func (r *UserRepository) GetByID(ctx context.Context, id int) (*User, error) {
 	return user, nil
 }
 
+// GetByIDs retrieves several users, keyed by ID. Unknown IDs are left out.
+func (r *UserRepository) GetByIDs(ctx context.Context, ids []int) (map[int]*User, error) {
+	users := make(map[int]*User)
+	if len(ids) == 0 {
+		return users, nil
+	}
+
+	userIDs := make([]int64, len(ids))
+	for i, id := range ids {
+		userIDs[i] = int64(id)
+	}
+
+	query := `SELECT ` + userColumns + `
+		FROM datifyy_v2_users
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrDatabaseError, err)
+		}
+		users[user.ID] = user
+	}
+
+	return users, rows.Err()
+}
+
 // UpdateLastLogin updates the user's last login timestamp
 func (r *UserRepository) UpdateLastLogin(ctx context.Context, userID int) error {
 	_, err := r.db.ExecContext(ctx,
@@ -269,32 +316,75 @@ func (r *UserRepository) UpdateAccountStatus(ctx context.Context, userID int, st
 	return nil
 }
 
-// UpdateBasicInfo updates basic user information (name, gender, phone_number)
+// ScheduleDeletion moves an account into PENDING_DELETION until scheduledFor
+func (r *UserRepository) ScheduleDeletion(ctx context.Context, userID int, scheduledFor time.Time, reason string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE datifyy_v2_users
+		 SET account_status = 'PENDING_DELETION', deletion_requested_at = NOW(),
+		     deletion_scheduled_for = $2, deletion_reason = $3
+		 WHERE id = $1`,
+		userID, scheduledFor, sql.NullString{String: reason, Valid: reason != ""},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+	if rows == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// CancelDeletion restores a PENDING_DELETION account and returns its restored status
+func (r *UserRepository) CancelDeletion(ctx context.Context, userID int) (string, error) {
+	var status string
+	err := r.db.QueryRowContext(ctx,
+		`UPDATE datifyy_v2_users
+		 SET account_status = CASE WHEN email_verified THEN 'ACTIVE' ELSE 'PENDING' END,
+		     deletion_requested_at = NULL, deletion_scheduled_for = NULL, deletion_reason = NULL
+		 WHERE id = $1 AND account_status = 'PENDING_DELETION'
+		 RETURNING account_status`,
+		userID,
+	).Scan(&status)
+
+	if err == sql.ErrNoRows {
+		return "", ErrUserNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrDatabaseError, err)
+	}
+
+	return status, nil
+}
+
+// UpdateBasicInfo updates basic user information (name, gender, phone_number),
+// recording each changed field in the change log
 func (r *UserRepository) UpdateBasicInfo(ctx context.Context, userID int, updates map[string]interface{}) error {
 	if len(updates) == 0 {
 		return nil
 	}
 
+	columns := sortedUpdateColumns(updates)
 	query := "UPDATE datifyy_v2_users SET "
 	args := []interface{}{}
 	argPos := 1
 
-	for field, value := range updates {
+	for _, field := range columns {
 		if argPos > 1 {
 			query += ", "
 		}
 		query += fmt.Sprintf("%s = $%d", field, argPos)
-		args = append(args, value)
+		args = append(args, updates[field])
 		argPos++
 	}
 
 	query += fmt.Sprintf(" WHERE id = $%d", argPos)
 	args = append(args, userID)
 
-	_, err := r.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("%w: %v", ErrDatabaseError, err)
-	}
-
-	return nil
+	return updateWithChangeLog(ctx, r.db, "datifyy_v2_users", userID, columns, query, args...)
 }
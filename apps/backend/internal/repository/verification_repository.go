@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrVerificationNotFound is returned when a verification request does not exist
+	ErrVerificationNotFound = errors.New("verification not found")
+	// ErrVerificationPending is returned when a user already has an ID submission awaiting review
+	ErrVerificationPending = errors.New("verification already pending review")
+	// ErrVerificationReviewed is returned when an ID submission has already been reviewed
+	ErrVerificationReviewed = errors.New("verification already reviewed")
+)
+
+// WorkEmailVerification is a code sent to a user's corporate email address
+type WorkEmailVerification struct {
+	ID         int
+	UserID     int
+	Email      string
+	Domain     string
+	CodeHash   string
+	ExpiresAt  time.Time
+	Attempts   int
+	VerifiedAt sql.NullTime
+	CreatedAt  time.Time
+}
+
+// IDVerification is a government ID document submitted for review
+type IDVerification struct {
+	ID              int
+	UserID          int
+	UserName        string
+	UserEmail       string
+	DocumentType    string
+	IDNumberMasked  string
+	FrontImageKey   string
+	BackImageKey    sql.NullString
+	SelfieImageKey  sql.NullString
+	Status          string // PENDING, APPROVED or REJECTED
+	ReviewedBy      sql.NullInt64
+	ReviewedAt      sql.NullTime
+	RejectionReason sql.NullString
+	CreatedAt       time.Time
+}
+
+// VerificationRepository handles work email and government ID verification
+type VerificationRepository struct {
+	db *sql.DB
+}
+
+// NewVerificationRepository creates a new repository
+func NewVerificationRepository(db *sql.DB) *VerificationRepository {
+	return &VerificationRepository{db: db}
+}
+
+// =============================================================================
+// Work Email
+// =============================================================================
+
+// CreateWorkEmailVerification stores a new work email code
+func (r *VerificationRepository) CreateWorkEmailVerification(ctx context.Context, v *WorkEmailVerification) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_work_email_verifications (user_id, email, domain, code_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`,
+		v.UserID, v.Email, v.Domain, v.CodeHash, v.ExpiresAt,
+	).Scan(&v.ID, &v.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create work email verification: %w", err)
+	}
+
+	return nil
+}
+
+// GetLatestWorkEmailVerification returns the most recent work email code sent to a user
+func (r *VerificationRepository) GetLatestWorkEmailVerification(ctx context.Context, userID int) (*WorkEmailVerification, error) {
+	v := &WorkEmailVerification{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, user_id, email, domain, code_hash, expires_at, attempts, verified_at, created_at
+		FROM datifyy_v2_work_email_verifications
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+		LIMIT 1`, userID,
+	).Scan(&v.ID, &v.UserID, &v.Email, &v.Domain, &v.CodeHash, &v.ExpiresAt, &v.Attempts, &v.VerifiedAt, &v.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrVerificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work email verification: %w", err)
+	}
+
+	return v, nil
+}
+
+// IncrementWorkEmailAttempts records a wrong code entry
+func (r *VerificationRepository) IncrementWorkEmailAttempts(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE datifyy_v2_work_email_verifications SET attempts = attempts + 1 WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to record attempt: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmWorkEmail marks a work email code as used and grants the work email badge
+func (r *VerificationRepository) ConfirmWorkEmail(ctx context.Context, v *WorkEmailVerification) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_work_email_verifications SET verified_at = NOW()
+		WHERE id = $1`, v.ID)
+	if err != nil {
+		return fmt.Errorf("failed to confirm work email: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO datifyy_v2_user_profiles (user_id, work_email_verified, work_email_domain)
+		VALUES ($1, TRUE, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET work_email_verified = TRUE, work_email_domain = EXCLUDED.work_email_domain`,
+		v.UserID, v.Domain)
+	if err != nil {
+		return fmt.Errorf("failed to set work email badge: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
+
+// =============================================================================
+// Government ID
+// =============================================================================
+
+const idVerificationColumns = `
+	v.id, v.user_id, u.name, u.email, v.document_type, v.id_number_masked,
+	v.front_image_key, v.back_image_key, v.selfie_image_key, v.status,
+	v.reviewed_by, v.reviewed_at, v.rejection_reason, v.created_at
+`
+
+func scanIDVerification(row interface{ Scan(...interface{}) error }) (*IDVerification, error) {
+	v := &IDVerification{}
+	err := row.Scan(
+		&v.ID, &v.UserID, &v.UserName, &v.UserEmail, &v.DocumentType, &v.IDNumberMasked,
+		&v.FrontImageKey, &v.BackImageKey, &v.SelfieImageKey, &v.Status,
+		&v.ReviewedBy, &v.ReviewedAt, &v.RejectionReason, &v.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// CreateIDVerification stores a government ID submission for review
+func (r *VerificationRepository) CreateIDVerification(ctx context.Context, v *IDVerification) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO datifyy_v2_id_verifications
+			(user_id, document_type, id_number_masked, front_image_key, back_image_key, selfie_image_key)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, status, created_at`,
+		v.UserID, v.DocumentType, v.IDNumberMasked, v.FrontImageKey, v.BackImageKey, v.SelfieImageKey,
+	).Scan(&v.ID, &v.Status, &v.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return ErrVerificationPending
+		}
+		return fmt.Errorf("failed to create id verification: %w", err)
+	}
+
+	return nil
+}
+
+// GetIDVerification retrieves a government ID submission
+func (r *VerificationRepository) GetIDVerification(ctx context.Context, id int) (*IDVerification, error) {
+	query := `
+		SELECT ` + idVerificationColumns + `
+		FROM datifyy_v2_id_verifications v
+		JOIN datifyy_v2_users u ON u.id = v.user_id
+		WHERE v.id = $1`
+
+	v, err := scanIDVerification(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrVerificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get id verification: %w", err)
+	}
+
+	return v, nil
+}
+
+// GetLatestIDVerification returns a user's most recent government ID submission
+func (r *VerificationRepository) GetLatestIDVerification(ctx context.Context, userID int) (*IDVerification, error) {
+	query := `
+		SELECT ` + idVerificationColumns + `
+		FROM datifyy_v2_id_verifications v
+		JOIN datifyy_v2_users u ON u.id = v.user_id
+		WHERE v.user_id = $1
+		ORDER BY v.created_at DESC
+		LIMIT 1`
+
+	v, err := scanIDVerification(r.db.QueryRowContext(ctx, query, userID))
+	if err == sql.ErrNoRows {
+		return nil, ErrVerificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get id verification: %w", err)
+	}
+
+	return v, nil
+}
+
+// ListIDVerifications returns government ID submissions for the review queue, oldest first
+func (r *VerificationRepository) ListIDVerifications(ctx context.Context, status string, limit, offset int) ([]*IDVerification, int, error) {
+	where := ""
+	args := []interface{}{}
+	if status != "" {
+		where = " WHERE v.status = $1"
+		args = append(args, status)
+	}
+
+	var totalCount int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM datifyy_v2_id_verifications v`+where, args...,
+	).Scan(&totalCount); err != nil {
+		return nil, 0, fmt.Errorf("failed to count id verifications: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM datifyy_v2_id_verifications v
+		JOIN datifyy_v2_users u ON u.id = v.user_id
+		%s
+		ORDER BY v.created_at ASC
+		LIMIT $%d OFFSET $%d`, idVerificationColumns, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list id verifications: %w", err)
+	}
+	defer rows.Close()
+
+	var verifications []*IDVerification
+	for rows.Next() {
+		v, err := scanIDVerification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan id verification: %w", err)
+		}
+		verifications = append(verifications, v)
+	}
+
+	return verifications, totalCount, rows.Err()
+}
+
+// ReviewIDVerification approves or rejects a pending submission. Approval grants
+// the government ID badge (the profile's is_verified flag), recorded in the
+// change log under the actor attached to ctx.
+func (r *VerificationRepository) ReviewIDVerification(ctx context.Context, id, adminID int, approve bool, rejectionReason string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var userID int
+	var status string
+	err = tx.QueryRowContext(ctx, `
+		SELECT user_id, status FROM datifyy_v2_id_verifications WHERE id = $1 FOR UPDATE`, id,
+	).Scan(&userID, &status)
+	if err == sql.ErrNoRows {
+		return ErrVerificationNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get id verification: %w", err)
+	}
+	if status != "PENDING" {
+		return ErrVerificationReviewed
+	}
+
+	newStatus := "REJECTED"
+	if approve {
+		newStatus = "APPROVED"
+		rejectionReason = ""
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE datifyy_v2_id_verifications
+		SET status = $2, reviewed_by = $3, reviewed_at = NOW(), rejection_reason = $4
+		WHERE id = $1`, id, newStatus, adminID, sql.NullString{String: rejectionReason, Valid: rejectionReason != ""})
+	if err != nil {
+		return fmt.Errorf("failed to update id verification: %w", err)
+	}
+
+	if approve {
+		err = updateWithChangeLogTx(ctx, tx, "datifyy_v2_user_profiles", userID, []string{"is_verified"}, `
+			INSERT INTO datifyy_v2_user_profiles (user_id, is_verified)
+			VALUES ($1, TRUE)
+			ON CONFLICT (user_id) DO UPDATE SET is_verified = TRUE`, userID)
+		if err != nil {
+			return fmt.Errorf("failed to set verified badge: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/timezone"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultHeatmapRange is the range aggregated when none is given
+	defaultHeatmapRange = 28 * 24 * time.Hour
+	// maxHeatmapRange is the longest range that may be aggregated
+	maxHeatmapRange = 92 * 24 * time.Hour
+
+	// heatmapMinUsers is how many users must be available before a cell's
+	// balance means anything; thinner cells are low_supply
+	heatmapMinUsers = 5
+	// heatmapImbalanceThreshold is how lopsided a cell must be to count as
+	// male_heavy or female_heavy (0.5 is 3:1)
+	heatmapImbalanceThreshold = 0.5
+)
+
+// Supply/demand indicators
+const (
+	heatmapBalanced    = "balanced"
+	heatmapMaleHeavy   = "male_heavy"
+	heatmapFemaleHeavy = "female_heavy"
+	heatmapLowSupply   = "low_supply"
+)
+
+// GetAvailabilityHeatmap aggregates availability by city, date type, local
+// weekday and hour, split by gender, with indicators of where one gender's
+// availability outstrips the other's
+func (s *AdminService) GetAvailabilityHeatmap(ctx context.Context, req *adminpb.AvailabilityHeatmapRequest) (*adminpb.AvailabilityHeatmapResponse, error) {
+	loc := time.UTC
+	if req.Timezone != "" {
+		var err error
+		loc, err = timezone.Load(req.Timezone)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	from := time.Now().Truncate(time.Hour)
+	to := from.Add(defaultHeatmapRange)
+	if req.TimeRange != nil {
+		if req.TimeRange.StartTime != nil {
+			from = time.Unix(req.TimeRange.StartTime.Seconds, 0)
+			to = from.Add(defaultHeatmapRange)
+		}
+		if req.TimeRange.EndTime != nil {
+			to = time.Unix(req.TimeRange.EndTime.Seconds, 0)
+		}
+	}
+	if !to.After(from) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	if to.Sub(from) > maxHeatmapRange {
+		return nil, status.Error(codes.InvalidArgument, "time range can't be longer than 92 days")
+	}
+
+	switch req.DateType {
+	case "", "online", "offline", "offline_event":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "date_type must be online, offline or offline_event")
+	}
+
+	rows, err := s.adminRepo.GetAvailabilityHeatmap(ctx, repository.AvailabilityHeatmapFilter{
+		From:     from.Unix(),
+		To:       to.Unix(),
+		Timezone: loc.String(),
+		City:     strings.TrimSpace(req.City),
+		DateType: req.DateType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get availability heatmap: %v", err)
+	}
+
+	cells, cities := buildAvailabilityHeatmap(rows)
+
+	return &adminpb.AvailabilityHeatmapResponse{
+		Cells:  cells,
+		Cities: cities,
+		TimeRange: &adminpb.TimeRange{
+			StartTime: &commonpb.Timestamp{Seconds: from.Unix()},
+			EndTime:   &commonpb.Timestamp{Seconds: to.Unix()},
+		},
+		Timezone: loc.String(),
+	}, nil
+}
+
+// buildAvailabilityHeatmap pivots per-gender rows, which arrive ordered by
+// cell, into cells and city summaries
+func buildAvailabilityHeatmap(rows []repository.AvailabilityHeatmapRow) ([]*adminpb.AvailabilityHeatmapCell, []*adminpb.AvailabilityCitySummary) {
+	var cells []*adminpb.AvailabilityHeatmapCell
+	citiesByName := make(map[string]*adminpb.AvailabilityCitySummary)
+	var cities []*adminpb.AvailabilityCitySummary
+
+	for _, row := range rows {
+		if row.IsCityTotal {
+			city, ok := citiesByName[row.City]
+			if !ok {
+				city = &adminpb.AvailabilityCitySummary{City: row.City}
+				citiesByName[row.City] = city
+				cities = append(cities, city)
+			}
+			city.TotalSlots += row.Slots
+			city.ReservedSlots += row.ReservedSlots
+			switch row.Gender {
+			case "male":
+				city.MaleUsers = row.Users
+			case "female":
+				city.FemaleUsers = row.Users
+			default:
+				city.OtherUsers = row.Users
+			}
+			continue
+		}
+
+		var cell *adminpb.AvailabilityHeatmapCell
+		if n := len(cells); n > 0 {
+			last := cells[n-1]
+			if last.City == row.City && last.DateType == row.DateType &&
+				last.Weekday == int32(row.Weekday) && last.Hour == int32(row.Hour) {
+				cell = last
+			}
+		}
+		if cell == nil {
+			cell = &adminpb.AvailabilityHeatmapCell{
+				City:     row.City,
+				DateType: row.DateType,
+				Weekday:  int32(row.Weekday),
+				Hour:     int32(row.Hour),
+			}
+			cells = append(cells, cell)
+		}
+
+		cell.TotalSlots += row.Slots
+		cell.ReservedSlots += row.ReservedSlots
+		switch row.Gender {
+		case "male":
+			cell.MaleSlots, cell.MaleUsers = row.Slots, row.Users
+		case "female":
+			cell.FemaleSlots, cell.FemaleUsers = row.Slots, row.Users
+		default:
+			cell.OtherSlots, cell.OtherUsers = row.Slots, row.Users
+		}
+	}
+
+	for _, cell := range cells {
+		cell.GenderImbalance, cell.Indicator = supplyIndicator(cell.MaleUsers, cell.FemaleUsers, cell.OtherUsers)
+		cell.Utilization = ratio(cell.ReservedSlots, cell.TotalSlots)
+	}
+	for _, city := range cities {
+		city.GenderImbalance, city.Indicator = supplyIndicator(city.MaleUsers, city.FemaleUsers, city.OtherUsers)
+		city.Utilization = ratio(city.ReservedSlots, city.TotalSlots)
+	}
+	sort.SliceStable(cities, func(i, j int) bool { return cities[i].TotalSlots > cities[j].TotalSlots })
+
+	return cells, cities
+}
+
+// supplyIndicator returns how lopsided availability is between men and women,
+// from -1 (only women) to 1 (only men), and the indicator it amounts to
+func supplyIndicator(male, female, other int64) (float64, string) {
+	imbalance := 0.0
+	if male+female > 0 {
+		imbalance = float64(male-female) / float64(male+female)
+	}
+
+	switch {
+	case male+female+other < heatmapMinUsers:
+		return imbalance, heatmapLowSupply
+	case imbalance >= heatmapImbalanceThreshold:
+		return imbalance, heatmapMaleHeavy
+	case imbalance <= -heatmapImbalanceThreshold:
+		return imbalance, heatmapFemaleHeavy
+	default:
+		return imbalance, heatmapBalanced
+	}
+}
+
+// ratio returns part/total, or 0 when total is 0
+func ratio(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
+
+// AvailabilityHeatmapCSV renders a heatmap's cells as CSV, one row per cell
+func AvailabilityHeatmapCSV(resp *adminpb.AvailabilityHeatmapResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write([]string{
+		"city", "date_type", "weekday", "hour",
+		"male_slots", "female_slots", "other_slots", "total_slots",
+		"male_users", "female_users", "other_users", "reserved_slots",
+		"gender_imbalance", "utilization", "indicator",
+	})
+	for _, cell := range resp.Cells {
+		w.Write([]string{
+			csvSafe(cell.City),
+			cell.DateType,
+			time.Weekday(cell.Weekday).String(),
+			strconv.Itoa(int(cell.Hour)),
+			strconv.FormatInt(cell.MaleSlots, 10),
+			strconv.FormatInt(cell.FemaleSlots, 10),
+			strconv.FormatInt(cell.OtherSlots, 10),
+			strconv.FormatInt(cell.TotalSlots, 10),
+			strconv.FormatInt(cell.MaleUsers, 10),
+			strconv.FormatInt(cell.FemaleUsers, 10),
+			strconv.FormatInt(cell.OtherUsers, 10),
+			strconv.FormatInt(cell.ReservedSlots, 10),
+			strconv.FormatFloat(math.Round(cell.GenderImbalance*1000)/1000, 'f', -1, 64),
+			strconv.FormatFloat(math.Round(cell.Utilization*1000)/1000, 'f', -1, 64),
+			cell.Indicator,
+		})
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// csvSafe stops user-entered text from being interpreted as a formula when
+// the CSV is opened in a spreadsheet
+func csvSafe(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var heatmapColumns = []string{"city", "date_type", "weekday", "hour", "gender", "slots", "users", "reserved", "is_city_total"}
+
+func TestGetAvailabilityHeatmap_PivotsGendersAndFlagsImbalance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	service := newTestAdminService(db)
+
+	from := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(14 * 24 * time.Hour)
+	// Archived slots count too, so past ranges aren't empty
+	mock.ExpectQuery("UNION ALL(.|\\n)+FROM datifyy_v2_availability_slots_archive(.|\\n)+GROUP BY GROUPING SETS").
+		WithArgs(from.Unix(), to.Unix(), "Asia/Kolkata", int64(43200)).
+		WillReturnRows(sqlmock.NewRows(heatmapColumns).
+			// Saturday 19:00 offline in Bengaluru: 6 men, 2 women
+			AddRow("Bengaluru", "offline", 6, 19, "female", 3, 2, 1, false).
+			AddRow("Bengaluru", "offline", 6, 19, "male", 8, 6, 0, false).
+			// Sunday 11:00 online: too few users to judge
+			AddRow("Bengaluru", "online", 0, 11, "female", 2, 2, 0, false).
+			AddRow("Bengaluru", "", 0, 0, "female", 5, 4, 1, true).
+			AddRow("Bengaluru", "", 0, 0, "male", 8, 6, 0, true).
+			AddRow("Mumbai", "", 0, 0, "female", 20, 10, 5, true).
+			AddRow("Mumbai", "", 0, 0, "male", 20, 10, 5, true))
+
+	resp, err := service.GetAvailabilityHeatmap(context.Background(), &adminpb.AvailabilityHeatmapRequest{
+		TimeRange: &adminpb.TimeRange{
+			StartTime: &commonpb.Timestamp{Seconds: from.Unix()},
+			EndTime:   &commonpb.Timestamp{Seconds: to.Unix()},
+		},
+		Timezone: "Asia/Kolkata",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Kolkata", resp.Timezone)
+
+	require.Len(t, resp.Cells, 2)
+	saturday := resp.Cells[0]
+	assert.Equal(t, int32(6), saturday.Weekday)
+	assert.Equal(t, int32(19), saturday.Hour)
+	assert.Equal(t, int64(11), saturday.TotalSlots)
+	assert.Equal(t, int64(8), saturday.MaleSlots)
+	assert.Equal(t, int64(6), saturday.MaleUsers)
+	assert.Equal(t, int64(2), saturday.FemaleUsers)
+	assert.InDelta(t, 0.5, saturday.GenderImbalance, 1e-9)
+	assert.InDelta(t, 1.0/11, saturday.Utilization, 1e-9)
+	assert.Equal(t, "male_heavy", saturday.Indicator)
+	assert.Equal(t, "low_supply", resp.Cells[1].Indicator)
+
+	require.Len(t, resp.Cities, 2)
+	assert.Equal(t, "Mumbai", resp.Cities[0].City, "cities are ordered by supply")
+	assert.Equal(t, "balanced", resp.Cities[0].Indicator)
+	assert.Equal(t, int64(13), resp.Cities[1].TotalSlots)
+	assert.Equal(t, int64(4), resp.Cities[1].FemaleUsers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAvailabilityHeatmap_Validation(t *testing.T) {
+	service := &AdminService{}
+	now := time.Now()
+
+	for name, req := range map[string]*adminpb.AvailabilityHeatmapRequest{
+		"unknown timezone":  {Timezone: "Mars/Olympus"},
+		"unknown date type": {DateType: "picnic"},
+		"backwards range": {TimeRange: &adminpb.TimeRange{
+			StartTime: &commonpb.Timestamp{Seconds: now.Unix()},
+			EndTime:   &commonpb.Timestamp{Seconds: now.Add(-time.Hour).Unix()},
+		}},
+		"range too long": {TimeRange: &adminpb.TimeRange{
+			StartTime: &commonpb.Timestamp{Seconds: now.Unix()},
+			EndTime:   &commonpb.Timestamp{Seconds: now.Add(100 * 24 * time.Hour).Unix()},
+		}},
+	} {
+		_, err := service.GetAvailabilityHeatmap(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
+
+func TestAvailabilityHeatmapCSV(t *testing.T) {
+	data, err := AvailabilityHeatmapCSV(&adminpb.AvailabilityHeatmapResponse{
+		Cells: []*adminpb.AvailabilityHeatmapCell{{
+			City: "=HYPERLINK(\"x\")", DateType: "offline", Weekday: 6, Hour: 19,
+			MaleSlots: 8, FemaleSlots: 3, TotalSlots: 11, MaleUsers: 6, FemaleUsers: 2, ReservedSlots: 1,
+			GenderImbalance: 0.5, Utilization: 1.0 / 11, Indicator: "male_heavy",
+		}},
+	})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "city,date_type,weekday,hour,male_slots,female_slots,other_slots,total_slots,male_users,female_users,other_users,reserved_slots,gender_imbalance,utilization,indicator", lines[0])
+	assert.Equal(t, `"'=HYPERLINK(""x"")",offline,Saturday,19,8,3,0,11,6,2,0,1,0.5,0.091,male_heavy`, lines[1])
+}
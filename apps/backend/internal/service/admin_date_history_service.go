@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/datifyy/backend/internal/datestatus"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDateHistory returns a date with every change made to it, oldest first,
+// and the statuses it can move to next
+func (s *AdminService) GetDateHistory(ctx context.Context, req *adminpb.GetDateHistoryRequest) (*adminpb.GetDateHistoryResponse, error) {
+	dateID, err := strconv.Atoi(req.DateId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid date ID")
+	}
+
+	date, err := s.adminRepo.GetScheduledDateByID(ctx, dateID)
+	if errors.Is(err, repository.ErrDateNotFound) {
+		return nil, status.Error(codes.NotFound, "date not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get date: %v", err)
+	}
+
+	activity, err := s.adminRepo.GetDateActivity(ctx, dateID)
+	if errors.Is(err, repository.ErrDateNotFound) {
+		return nil, status.Error(codes.NotFound, "date not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get date history: %v", err)
+	}
+
+	resp := &adminpb.GetDateHistoryResponse{
+		Date:     convertScheduledDate(date),
+		Activity: make([]*adminpb.DateActivity, 0, len(activity)),
+	}
+	for i := range activity {
+		resp.Activity = append(resp.Activity, convertDateActivity(&activity[i]))
+	}
+	for _, next := range datestatus.Next(date.Status) {
+		resp.NextStatuses = append(resp.NextStatuses, convertDateStatus(next))
+	}
+
+	return resp, nil
+}
+
+func convertDateActivity(a *repository.DateActivity) *adminpb.DateActivity {
+	activity := &adminpb.DateActivity{
+		ActivityId: strconv.Itoa(a.ID),
+		Action:     a.Action,
+		FromStatus: convertDateStatus(a.OldValue.String),
+		ToStatus:   convertDateStatus(a.NewValue.String),
+		Notes:      nullStringValue(a.Notes),
+		ActorType:  a.ActorType,
+		ActorName:  a.ActorName,
+		CreatedAt:  timestampFromTime(a.CreatedAt),
+		OldValue:   nullStringValue(a.OldValue),
+		NewValue:   nullStringValue(a.NewValue),
+	}
+
+	switch {
+	case a.AdminID.Valid:
+		activity.ActorId = strconv.FormatInt(a.AdminID.Int64, 10)
+	case a.UserID.Valid:
+		activity.ActorId = strconv.FormatInt(a.UserID.Int64, 10)
+	}
+
+	return activity
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scheduledDateRows(id int, dateStatus string, confirmedAt interface{}) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{
+		"id", "user1_id", "user2_id", "genie_id", "scheduled_time", "duration_minutes",
+		"status", "date_type", "place_name", "address", "city", "state", "country", "zipcode",
+		"latitude", "longitude", "notes", "admin_notes", "created_at", "updated_at",
+		"confirmed_at", "completed_at", "cancelled_at",
+	}).AddRow(
+		id, 1, 2, 5, now.Add(72*time.Hour), 60,
+		dateStatus, "online", nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, now, now,
+		confirmedAt, nil, nil,
+	)
+}
+
+func TestUpdateDateStatus_RejectsInvalidTransition(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("scheduled"))
+	mock.ExpectRollback()
+
+	_, err := service.UpdateDateStatus(context.Background(), &adminpb.UpdateDateStatusRequest{
+		DateId:  "7",
+		Status:  adminpb.DateStatus_DATE_STATUS_COMPLETED,
+		AdminId: "5",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateDateStatus_RequiresStatus(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.UpdateDateStatus(context.Background(), &adminpb.UpdateDateStatusRequest{DateId: "7"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDateHistory(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	confirmedAt := time.Now().Add(-time.Hour)
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(scheduledDateRows(7, "confirmed", confirmedAt))
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery("FROM datifyy_v2_date_activity_log l").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "date_id", "action", "old_value", "new_value", "notes", "actor_type",
+			"admin_id", "user_id", "name", "created_at",
+		}).
+			AddRow(1, 7, "created", nil, "scheduled", nil, "admin", 5, nil, "Genie Asha", time.Now().Add(-2*time.Hour)).
+			AddRow(2, 7, "status_changed", "scheduled", "confirmed", "Both replied", "user", nil, 2, "Rahul", confirmedAt))
+
+	resp, err := service.GetDateHistory(context.Background(), &adminpb.GetDateHistoryRequest{DateId: "7"})
+	require.NoError(t, err)
+	assert.Equal(t, confirmedAt.Unix(), resp.Date.ConfirmedAt.Seconds)
+	require.Len(t, resp.Activity, 2)
+	assert.Equal(t, adminpb.DateStatus_DATE_STATUS_UNSPECIFIED, resp.Activity[0].FromStatus)
+	assert.Equal(t, "5", resp.Activity[0].ActorId)
+	assert.Equal(t, adminpb.DateStatus_DATE_STATUS_CONFIRMED, resp.Activity[1].ToStatus)
+	assert.Equal(t, "2", resp.Activity[1].ActorId)
+	assert.Equal(t, "Rahul", resp.Activity[1].ActorName)
+	assert.Equal(t, []adminpb.DateStatus{
+		adminpb.DateStatus_DATE_STATUS_IN_PROGRESS,
+		adminpb.DateStatus_DATE_STATUS_CANCELLED,
+		adminpb.DateStatus_DATE_STATUS_NO_SHOW,
+	}, resp.NextStatuses)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDateHistory_NotFound(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := service.GetDateHistory(context.Background(), &adminpb.GetDateHistoryRequest{DateId: "7"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// profileChangeHistoryLimit is how many recent changes GetUserDetails returns
+const profileChangeHistoryLimit = 50
+
+// RevertProfileChange restores the field a profile change modified to its
+// previous value. Only active super admins may revert changes.
+func (s *AdminService) RevertProfileChange(ctx context.Context, req *adminpb.RevertProfileChangeRequest) (*adminpb.RevertProfileChangeResponse, error) {
+	changeID, err := strconv.Atoi(req.ChangeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_id")
+	}
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+
+	admin, err := s.adminRepo.GetAdminByID(ctx, adminID)
+	if err != nil && !errors.Is(err, repository.ErrAdminNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get admin: %v", err)
+	}
+	if admin == nil || !admin.IsActive || admin.Role != "super_admin" {
+		return nil, status.Error(codes.PermissionDenied, "only super admins can revert profile changes")
+	}
+
+	revert, err := s.changeRepo.Revert(ctx, changeID, adminID)
+	switch {
+	case errors.Is(err, repository.ErrChangeNotFound):
+		return nil, status.Error(codes.NotFound, "profile change not found")
+	case errors.Is(err, repository.ErrChangeReverted):
+		return nil, status.Error(codes.FailedPrecondition, "profile change has already been reverted")
+	case errors.Is(err, repository.ErrChangeSuperseded):
+		return nil, status.Error(codes.FailedPrecondition, "field has changed since; revert the later change first")
+	case errors.Is(err, repository.ErrChangeNotRevertible):
+		return nil, status.Error(codes.FailedPrecondition, "field had no value before this change and can't be cleared")
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to revert profile change: %v", err)
+	}
+
+	return &adminpb.RevertProfileChangeResponse{Change: convertProfileChange(revert)}, nil
+}
+
+func convertProfileChange(c *repository.ProfileChange) *adminpb.ProfileChange {
+	return &adminpb.ProfileChange{
+		ChangeId:        strconv.Itoa(c.ID),
+		UserId:          strconv.Itoa(c.UserID),
+		TableName:       c.TableName,
+		FieldName:       c.FieldName,
+		OldValue:        string(c.OldValue),
+		NewValue:        string(c.NewValue),
+		Source:          c.Source,
+		ActorId:         nullIntIDValue(c.ActorID),
+		RevertsChangeId: nullIntIDValue(c.RevertsChangeID),
+		RevertedAt:      timestampFromNullTime(c.RevertedAt),
+		RevertedBy:      nullIntIDValue(c.RevertedBy),
+		CreatedAt:       timestampFromTime(c.CreatedAt),
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func expectAdminWithRole(mock sqlmock.Sqlmock, adminID int, role string) {
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_admin_users WHERE id = \\$1").
+		WithArgs(adminID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "email", "name", "password_hash", "role", "is_genie", "is_active",
+			"last_login_at", "created_at", "updated_at", "created_by",
+		}).AddRow(adminID, nil, "admin@test.com", "Admin", "hash", role, false, true, nil, now, now, nil))
+}
+
+func profileChangeRows(oldValue, newValue string, revertedAt interface{}) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "user_id", "table_name", "field_name", "old_value", "new_value", "source",
+		"actor_id", "reverts_change_id", "reverted_at", "reverted_by", "created_at",
+	}).AddRow(
+		9, 1, "datifyy_v2_partner_preferences", "looking_for_gender", []byte(oldValue), []byte(newValue), "user",
+		1, nil, revertedAt, nil, time.Now(),
+	)
+}
+
+func TestRevertProfileChange_RestoresOldValue(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 3, "super_admin")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_changes WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(profileChangeRows(`["FEMALE"]`, `["MALE"]`, nil))
+	mock.ExpectQuery(`SELECT to_jsonb\("looking_for_gender"\) FROM datifyy_v2_partner_preferences WHERE user_id = \$1 FOR UPDATE`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`["MALE"]`)))
+	mock.ExpectExec(`UPDATE datifyy_v2_partner_preferences SET "looking_for_gender" = \(jsonb_populate_record`).
+		WithArgs("looking_for_gender", `["FEMALE"]`, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_profile_changes").
+		WithArgs(1, "datifyy_v2_partner_preferences", "looking_for_gender", `["MALE"]`, `["FEMALE"]`, "admin", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, time.Now()))
+	mock.ExpectExec("UPDATE datifyy_v2_profile_changes SET reverted_at = CURRENT_TIMESTAMP").
+		WithArgs(9, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.RevertProfileChange(adminContext(3), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "10", resp.Change.ChangeId)
+	assert.Equal(t, "9", resp.Change.RevertsChangeId)
+	assert.Equal(t, "admin", resp.Change.Source)
+	assert.Equal(t, "3", resp.Change.ActorId)
+	assert.Equal(t, `["FEMALE"]`, resp.Change.NewValue)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_FirstSaveRestoresColumnDefault(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 3, "super_admin")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_changes WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(profileChangeRows(`null`, `["MALE"]`, nil))
+	mock.ExpectQuery(`SELECT to_jsonb\("looking_for_gender"\) FROM datifyy_v2_partner_preferences`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`["MALE"]`)))
+	mock.ExpectQuery("SELECT is_nullable = 'YES', column_default IS NOT NULL FROM information_schema.columns").
+		WithArgs("datifyy_v2_partner_preferences", "looking_for_gender").
+		WillReturnRows(sqlmock.NewRows([]string{"nullable", "has_default"}).AddRow(false, true))
+	mock.ExpectQuery(`UPDATE datifyy_v2_partner_preferences SET "looking_for_gender" = DEFAULT WHERE user_id = \$1 RETURNING`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`[]`)))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_profile_changes").
+		WithArgs(1, "datifyy_v2_partner_preferences", "looking_for_gender", `["MALE"]`, `[]`, "admin", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(10, time.Now()))
+	mock.ExpectExec("UPDATE datifyy_v2_profile_changes SET reverted_at = CURRENT_TIMESTAMP").
+		WithArgs(9, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.RevertProfileChange(adminContext(3), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, resp.Change.NewValue)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_FirstSaveWithoutDefaultNotRevertible(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 3, "super_admin")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_changes WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(profileChangeRows(`null`, `["MALE"]`, nil))
+	mock.ExpectQuery(`SELECT to_jsonb\("looking_for_gender"\) FROM datifyy_v2_partner_preferences`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`["MALE"]`)))
+	mock.ExpectQuery("SELECT is_nullable = 'YES', column_default IS NOT NULL FROM information_schema.columns").
+		WithArgs("datifyy_v2_partner_preferences", "looking_for_gender").
+		WillReturnRows(sqlmock.NewRows([]string{"nullable", "has_default"}).AddRow(false, false))
+	mock.ExpectRollback()
+
+	_, err := service.RevertProfileChange(adminContext(3), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_RequiresAdminToken(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.RevertProfileChange(context.Background(), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_RequiresSuperAdmin(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 4, "support")
+
+	_, err := service.RevertProfileChange(adminContext(4), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_SupersededByLaterChange(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 3, "super_admin")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_changes WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(profileChangeRows(`["FEMALE"]`, `["MALE"]`, nil))
+	mock.ExpectQuery(`SELECT to_jsonb\("looking_for_gender"\) FROM datifyy_v2_partner_preferences`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`["FEMALE", "MALE"]`)))
+	mock.ExpectRollback()
+
+	_, err := service.RevertProfileChange(adminContext(3), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevertProfileChange_AlreadyReverted(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	expectAdminWithRole(mock, 3, "super_admin")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_changes WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(profileChangeRows(`["FEMALE"]`, `["MALE"]`, time.Now()))
+	mock.ExpectRollback()
+
+	_, err := service.RevertProfileChange(adminContext(3), &adminpb.RevertProfileChangeRequest{
+		ChangeId: "9",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
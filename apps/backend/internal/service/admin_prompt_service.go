@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Answer length limits used when a prompt doesn't set its own
+	defaultPromptMinAnswerLength = 1
+	defaultPromptMaxAnswerLength = 300
+
+	// maxPromptAnswerLength caps what a prompt may allow
+	maxPromptAnswerLength = 1000
+)
+
+var (
+	promptIDPattern     = regexp.MustCompile(`^[a-z0-9_]{1,100}$`)
+	promptLocalePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})?$`)
+)
+
+// ListPrompts lists the prompts catalog, optionally including retired and
+// scheduled prompts
+func (s *AdminService) ListPrompts(ctx context.Context, req *adminpb.ListPromptsRequest) (*adminpb.ListPromptsResponse, error) {
+	prompts, err := s.promptRepo.ListPrompts(ctx, strings.TrimSpace(req.Category), !req.IncludeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list prompts: %v", err)
+	}
+
+	resp := &adminpb.ListPromptsResponse{}
+	now := time.Now()
+	for _, p := range prompts {
+		resp.Prompts = append(resp.Prompts, convertPrompt(p, now))
+	}
+
+	return resp, nil
+}
+
+// CreatePrompt adds a prompt to the catalog
+func (s *AdminService) CreatePrompt(ctx context.Context, req *adminpb.CreatePromptRequest) (*adminpb.CreatePromptResponse, error) {
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+
+	p, err := promptFromProto(req.Prompt, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if q := req.Prompt.LegacyQuestion; q != userpb.PromptQuestion_PROMPT_UNSPECIFIED {
+		if _, ok := userpb.PromptQuestion_name[int32(q)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid legacy_question")
+		}
+		p.LegacyQuestion = sql.NullInt32{Int32: int32(q), Valid: true}
+	}
+
+	err = s.promptRepo.CreatePrompt(ctx, p)
+	if errors.Is(err, repository.ErrPromptExists) {
+		return nil, status.Error(codes.AlreadyExists, "a prompt with this prompt_id or legacy_question already exists")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create prompt: %v", err)
+	}
+
+	return &adminpb.CreatePromptResponse{Prompt: convertPrompt(p, time.Now())}, nil
+}
+
+// UpdatePrompt replaces a prompt's text, category, limits and active window.
+// Its ID and legacy question can't be changed since answers reference them.
+func (s *AdminService) UpdatePrompt(ctx context.Context, req *adminpb.UpdatePromptRequest) (*adminpb.UpdatePromptResponse, error) {
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+
+	p, err := promptFromProto(req.Prompt, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.promptRepo.UpdatePrompt(ctx, p)
+	if errors.Is(err, repository.ErrPromptNotFound) {
+		return nil, status.Error(codes.NotFound, "prompt not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update prompt: %v", err)
+	}
+
+	return &adminpb.UpdatePromptResponse{Prompt: convertPrompt(p, time.Now())}, nil
+}
+
+// DeletePrompt retires a prompt so it can no longer be answered. It stays in
+// the catalog so existing answers keep their question text.
+func (s *AdminService) DeletePrompt(ctx context.Context, req *adminpb.DeletePromptRequest) (*adminpb.DeletePromptResponse, error) {
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+	if req.PromptId == "" {
+		return nil, status.Error(codes.InvalidArgument, "prompt_id is required")
+	}
+
+	p, err := s.promptRepo.RetirePrompt(ctx, req.PromptId, adminID)
+	if errors.Is(err, repository.ErrPromptNotFound) {
+		return nil, status.Error(codes.NotFound, "prompt not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retire prompt: %v", err)
+	}
+
+	return &adminpb.DeletePromptResponse{Prompt: convertPrompt(p, time.Now())}, nil
+}
+
+// promptFromProto validates a prompt sent by an admin
+func promptFromProto(pb *adminpb.Prompt, adminID int) (*repository.Prompt, error) {
+	if pb == nil {
+		return nil, status.Error(codes.InvalidArgument, "prompt is required")
+	}
+	if !promptIDPattern.MatchString(pb.PromptId) {
+		return nil, status.Error(codes.InvalidArgument, "prompt_id must be 1-100 lowercase letters, digits or underscores")
+	}
+
+	category := strings.ToLower(strings.TrimSpace(pb.Category))
+	if category == "" {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	text := make(repository.PromptText, len(pb.Text))
+	for locale, t := range pb.Text {
+		locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+		if !promptLocalePattern.MatchString(locale) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid locale %q", locale)
+		}
+		if t = strings.TrimSpace(t); t != "" {
+			text[locale] = t
+		}
+	}
+	if text[defaultPromptLocale] == "" {
+		return nil, status.Error(codes.InvalidArgument, "English (en) text is required")
+	}
+
+	minLength := int(pb.MinAnswerLength)
+	if minLength == 0 {
+		minLength = defaultPromptMinAnswerLength
+	}
+	maxLength := int(pb.MaxAnswerLength)
+	if maxLength == 0 {
+		maxLength = defaultPromptMaxAnswerLength
+	}
+	if minLength < 1 || maxLength < minLength || maxLength > maxPromptAnswerLength {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"answer lengths must satisfy 1 <= min_answer_length <= max_answer_length <= %d", maxPromptAnswerLength)
+	}
+
+	p := &repository.Prompt{
+		Key:             pb.PromptId,
+		Category:        category,
+		Text:            text,
+		MinAnswerLength: minLength,
+		MaxAnswerLength: maxLength,
+		DisplayOrder:    int(pb.DisplayOrder),
+		UpdatedBy:       sql.NullInt64{Int64: int64(adminID), Valid: true},
+	}
+	if pb.ActiveFrom != nil {
+		p.ActiveFrom = sql.NullTime{Time: timeFromTimestamp(pb.ActiveFrom), Valid: true}
+	}
+	if pb.ActiveUntil != nil {
+		p.ActiveUntil = sql.NullTime{Time: timeFromTimestamp(pb.ActiveUntil), Valid: true}
+	}
+	if p.ActiveFrom.Valid && p.ActiveUntil.Valid && !p.ActiveUntil.Time.After(p.ActiveFrom.Time) {
+		return nil, status.Error(codes.InvalidArgument, "active_until must be after active_from")
+	}
+
+	return p, nil
+}
+
+func convertPrompt(p *repository.Prompt, now time.Time) *adminpb.Prompt {
+	return &adminpb.Prompt{
+		PromptId:        p.Key,
+		Category:        p.Category,
+		Text:            p.Text,
+		MinAnswerLength: int32(p.MinAnswerLength),
+		MaxAnswerLength: int32(p.MaxAnswerLength),
+		DisplayOrder:    int32(p.DisplayOrder),
+		ActiveFrom:      timestampFromNullTime(p.ActiveFrom),
+		ActiveUntil:     timestampFromNullTime(p.ActiveUntil),
+		LegacyQuestion:  userpb.PromptQuestion(p.LegacyQuestion.Int32),
+		Active:          p.IsActive(now),
+		UpdatedBy:       nullIntIDValue(p.UpdatedBy),
+		CreatedAt:       timestampFromTime(p.CreatedAt),
+		UpdatedAt:       timestampFromTime(p.UpdatedAt),
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreatePrompt_Success(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("INSERT INTO datifyy_v2_profile_prompts").
+		WithArgs("weekend_plans", "about_me", `{"en":"This weekend I'm...","hi":"इस वीकेंड मैं..."}`,
+			sql.NullInt32{}, 1, 300, 4, sql.NullTime{}, sql.NullTime{}, sql.NullInt64{Int64: 3, Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(21, now, now))
+
+	resp, err := service.CreatePrompt(adminContext(3), &adminpb.CreatePromptRequest{
+		Prompt: &adminpb.Prompt{
+			PromptId:     "weekend_plans",
+			Category:     " About_Me ",
+			Text:         map[string]string{"en": "This weekend I'm...", "HI": "इस वीकेंड मैं..."},
+			DisplayOrder: 4,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "weekend_plans", resp.Prompt.PromptId)
+	assert.Equal(t, int32(300), resp.Prompt.MaxAnswerLength)
+	assert.True(t, resp.Prompt.Active)
+	assert.Equal(t, "3", resp.Prompt.UpdatedBy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePrompt_RequiresAdminToken(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.CreatePrompt(context.Background(), &adminpb.CreatePromptRequest{
+		Prompt: &adminpb.Prompt{PromptId: "weekend_plans", Category: "about_me", Text: map[string]string{"en": "This weekend I'm..."}},
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePrompt_Validation(t *testing.T) {
+	valid := func() *adminpb.Prompt {
+		return &adminpb.Prompt{PromptId: "weekend_plans", Category: "about_me", Text: map[string]string{"en": "This weekend I'm..."}}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *adminpb.Prompt)
+	}{
+		{"invalid prompt_id", func(p *adminpb.Prompt) { p.PromptId = "Weekend Plans" }},
+		{"missing category", func(p *adminpb.Prompt) { p.Category = " " }},
+		{"missing English text", func(p *adminpb.Prompt) { p.Text = map[string]string{"hi": "इस वीकेंड मैं..."} }},
+		{"invalid locale", func(p *adminpb.Prompt) { p.Text["not a locale"] = "..." }},
+		{"max below min", func(p *adminpb.Prompt) { p.MinAnswerLength, p.MaxAnswerLength = 50, 10 }},
+		{"max too long", func(p *adminpb.Prompt) { p.MaxAnswerLength = 5000 }},
+		{"empty active window", func(p *adminpb.Prompt) {
+			p.ActiveFrom = &commonpb.Timestamp{Seconds: 2000}
+			p.ActiveUntil = &commonpb.Timestamp{Seconds: 1000}
+		}},
+		{"unknown legacy question", func(p *adminpb.Prompt) { p.LegacyQuestion = userpb.PromptQuestion(99) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mock, db := setupTestAdminService(t)
+			defer db.Close()
+
+			prompt := valid()
+			tt.modify(prompt)
+			_, err := service.CreatePrompt(adminContext(3), &adminpb.CreatePromptRequest{Prompt: prompt})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreatePrompt_Duplicate(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("INSERT INTO datifyy_v2_profile_prompts").
+		WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "datifyy_v2_profile_prompts_key_key"`))
+
+	_, err := service.CreatePrompt(adminContext(3), &adminpb.CreatePromptRequest{
+		Prompt: &adminpb.Prompt{PromptId: "green_flag", Category: "dating", Text: map[string]string{"en": "A green flag..."}},
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeletePrompt_Retires(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("UPDATE datifyy_v2_profile_prompts SET active_until = (.+) WHERE key = \\$1 RETURNING").
+		WithArgs("green_flag", 3).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "key", "category", "text", "legacy_question", "min_answer_length", "max_answer_length",
+			"display_order", "active_from", "active_until", "updated_by", "created_at", "updated_at",
+		}).AddRow(1, "green_flag", "dating", []byte(`{"en": "A green flag for me is..."}`), 9, 1, 300,
+			5, nil, now, 3, now, now))
+
+	resp, err := service.DeletePrompt(adminContext(3), &adminpb.DeletePromptRequest{PromptId: "green_flag"})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Prompt.Active)
+	assert.Equal(t, userpb.PromptQuestion_PROMPT_GREEN_FLAG, resp.Prompt.LegacyQuestion)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeletePrompt_NotFound(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("UPDATE datifyy_v2_profile_prompts").WillReturnError(sql.ErrNoRows)
+
+	_, err := service.DeletePrompt(adminContext(3), &adminpb.DeletePromptRequest{PromptId: "missing"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSuspensionDays caps suspensions issued from the moderation queue
+const maxSuspensionDays = 365
+
+// ListUserReports returns the moderation queue, highest severity first
+func (s *AdminService) ListUserReports(ctx context.Context, req *adminpb.ListUserReportsRequest) (*adminpb.ListUserReportsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	filter := repository.UserReportFilter{
+		Status:   convertReportStatusToString(req.Status),
+		Severity: convertReportSeverityToString(req.Severity),
+	}
+	if req.ReportedUserId != "" {
+		id, err := strconv.Atoi(req.ReportedUserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid reported_user_id")
+		}
+		filter.ReportedUserID = id
+	}
+	if req.ClaimedBy != "" {
+		id, err := strconv.Atoi(req.ClaimedBy)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid claimed_by")
+		}
+		filter.ClaimedBy = id
+	}
+
+	reports, totalCount, err := s.reportRepo.List(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reports: %v", err)
+	}
+
+	var pbReports []*adminpb.UserReport
+	for _, r := range reports {
+		pbReports = append(pbReports, convertUserReport(r))
+	}
+
+	return &adminpb.ListUserReportsResponse{
+		Reports:    pbReports,
+		TotalCount: int32(totalCount),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+// ClaimUserReport assigns a report to the moderator reviewing it
+func (s *AdminService) ClaimUserReport(ctx context.Context, req *adminpb.ClaimUserReportRequest) (*adminpb.ClaimUserReportResponse, error) {
+	adminID, err := parseReportModerationRequest(ctx, req.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reportRepo.Claim(ctx, req.ReportId, adminID); err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	report, err := s.reportRepo.GetByReportID(ctx, req.ReportId)
+	if err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	return &adminpb.ClaimUserReportResponse{
+		Report: convertUserReport(report),
+	}, nil
+}
+
+// ResolveUserReport closes a report, optionally taking an enforcement action
+// (warn, suspend for N days or ban) against the reported user
+func (s *AdminService) ResolveUserReport(ctx context.Context, req *adminpb.ResolveUserReportRequest) (*adminpb.ResolveUserReportResponse, error) {
+	adminID, err := parseReportModerationRequest(ctx, req.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	var action *repository.ModerationAction
+	switch req.Action {
+	case adminpb.EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED:
+		// Resolved without enforcement
+	case adminpb.EnforcementAction_ENFORCEMENT_ACTION_WARN:
+		action = &repository.ModerationAction{Action: "WARN"}
+	case adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND:
+		if req.SuspensionDays < 1 || req.SuspensionDays > maxSuspensionDays {
+			return nil, status.Errorf(codes.InvalidArgument, "suspension_days must be between 1 and %d", maxSuspensionDays)
+		}
+		action = &repository.ModerationAction{
+			Action:       "SUSPEND",
+			DurationDays: sql.NullInt64{Int64: int64(req.SuspensionDays), Valid: true},
+			ExpiresAt:    sql.NullTime{Time: time.Now().AddDate(0, 0, int(req.SuspensionDays)), Valid: true},
+		}
+	case adminpb.EnforcementAction_ENFORCEMENT_ACTION_BAN:
+		action = &repository.ModerationAction{Action: "BAN"}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid enforcement action")
+	}
+	if action != nil {
+		action.Notes = sql.NullString{String: req.Notes, Valid: req.Notes != ""}
+	}
+
+	if err := s.reportRepo.Close(ctx, req.ReportId, adminID, "RESOLVED", req.Notes, action); err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	report, err := s.reportRepo.GetByReportID(ctx, req.ReportId)
+	if err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	resp := &adminpb.ResolveUserReportResponse{
+		Report: convertUserReport(report),
+	}
+	if action != nil {
+		resp.Enforcement = convertModerationAction(action, req.ReportId)
+	}
+
+	return resp, nil
+}
+
+// DismissUserReport closes a report without action
+func (s *AdminService) DismissUserReport(ctx context.Context, req *adminpb.DismissUserReportRequest) (*adminpb.DismissUserReportResponse, error) {
+	adminID, err := parseReportModerationRequest(ctx, req.ReportId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.reportRepo.Close(ctx, req.ReportId, adminID, "DISMISSED", req.Notes, nil); err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	report, err := s.reportRepo.GetByReportID(ctx, req.ReportId)
+	if err != nil {
+		return nil, reportModerationError(err)
+	}
+
+	return &adminpb.DismissUserReportResponse{
+		Report: convertUserReport(report),
+	}, nil
+}
+
+// GetUserEnforcementHistory returns every warning, suspension, ban and reinstatement
+// recorded against a user, along with the restriction currently in force
+func (s *AdminService) GetUserEnforcementHistory(ctx context.Context, req *adminpb.GetUserEnforcementHistoryRequest) (*adminpb.GetUserEnforcementHistoryResponse, error) {
+	userID, err := strconv.Atoi(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	actions, err := s.moderationRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enforcement history: %v", err)
+	}
+
+	resp := &adminpb.GetUserEnforcementHistoryResponse{}
+	for _, a := range actions {
+		resp.Actions = append(resp.Actions, convertModerationAction(a, ""))
+	}
+
+	active, err := s.moderationRepo.GetActiveEnforcement(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrNoActiveEnforcement) {
+		return nil, status.Errorf(codes.Internal, "failed to get active enforcement: %v", err)
+	}
+	if active != nil {
+		resp.Active = convertModerationAction(active, "")
+	}
+
+	return resp, nil
+}
+
+// ListEnforcementAppeals returns the appeal review queue, oldest first
+func (s *AdminService) ListEnforcementAppeals(ctx context.Context, req *adminpb.ListEnforcementAppealsRequest) (*adminpb.ListEnforcementAppealsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	appeals, totalCount, err := s.moderationRepo.ListAppeals(ctx, convertAppealStatusToString(req.Status), pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list appeals: %v", err)
+	}
+
+	var pbAppeals []*adminpb.EnforcementAppeal
+	for _, a := range appeals {
+		pbAppeals = append(pbAppeals, convertEnforcementAppeal(a))
+	}
+
+	return &adminpb.ListEnforcementAppealsResponse{
+		Appeals:    pbAppeals,
+		TotalCount: int32(totalCount),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	}, nil
+}
+
+// ReviewEnforcementAppeal approves or rejects an appeal. Approval lifts the
+// suspension or ban and reactivates the account.
+func (s *AdminService) ReviewEnforcementAppeal(ctx context.Context, req *adminpb.ReviewEnforcementAppealRequest) (*adminpb.ReviewEnforcementAppealResponse, error) {
+	appealID, err := strconv.Atoi(req.AppealId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid appeal_id")
+	}
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+
+	if err := s.moderationRepo.ReviewAppeal(ctx, appealID, adminID, req.Approve, req.Notes); err != nil {
+		return nil, appealReviewError(err)
+	}
+
+	appeal, err := s.moderationRepo.GetAppeal(ctx, appealID)
+	if err != nil {
+		return nil, appealReviewError(err)
+	}
+
+	return &adminpb.ReviewEnforcementAppealResponse{
+		Appeal: convertEnforcementAppeal(appeal),
+	}, nil
+}
+
+// =============================================================================
+// Report Moderation Helpers
+// =============================================================================
+
+// parseReportModerationRequest validates a report moderation request and
+// returns the authenticated moderator
+func parseReportModerationRequest(ctx context.Context, reportID string) (int, error) {
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return 0, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+	if reportID == "" {
+		return 0, status.Error(codes.InvalidArgument, "report_id is required")
+	}
+	return adminID, nil
+}
+
+func reportModerationError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrReportNotFound):
+		return status.Error(codes.NotFound, "report not found")
+	case errors.Is(err, repository.ErrReportClaimed):
+		return status.Error(codes.FailedPrecondition, "report is being reviewed by another moderator")
+	case errors.Is(err, repository.ErrReportClosed):
+		return status.Error(codes.FailedPrecondition, "report is already closed")
+	default:
+		return status.Errorf(codes.Internal, "failed to update report: %v", err)
+	}
+}
+
+func appealReviewError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAppealNotFound):
+		return status.Error(codes.NotFound, "appeal not found")
+	case errors.Is(err, repository.ErrAppealReviewed):
+		return status.Error(codes.FailedPrecondition, "appeal has already been reviewed")
+	default:
+		return status.Errorf(codes.Internal, "failed to review appeal: %v", err)
+	}
+}
+
+func convertReportStatusToString(s adminpb.ReportStatus) string {
+	switch s {
+	case adminpb.ReportStatus_REPORT_STATUS_PENDING:
+		return "PENDING"
+	case adminpb.ReportStatus_REPORT_STATUS_IN_REVIEW:
+		return "IN_REVIEW"
+	case adminpb.ReportStatus_REPORT_STATUS_RESOLVED:
+		return "RESOLVED"
+	case adminpb.ReportStatus_REPORT_STATUS_DISMISSED:
+		return "DISMISSED"
+	default:
+		return ""
+	}
+}
+
+func convertReportStatus(s string) adminpb.ReportStatus {
+	switch s {
+	case "PENDING":
+		return adminpb.ReportStatus_REPORT_STATUS_PENDING
+	case "IN_REVIEW":
+		return adminpb.ReportStatus_REPORT_STATUS_IN_REVIEW
+	case "RESOLVED", "REVIEWED":
+		return adminpb.ReportStatus_REPORT_STATUS_RESOLVED
+	case "DISMISSED":
+		return adminpb.ReportStatus_REPORT_STATUS_DISMISSED
+	default:
+		return adminpb.ReportStatus_REPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func convertReportSeverityToString(s adminpb.ReportSeverity) string {
+	switch s {
+	case adminpb.ReportSeverity_REPORT_SEVERITY_LOW:
+		return "LOW"
+	case adminpb.ReportSeverity_REPORT_SEVERITY_MEDIUM:
+		return "MEDIUM"
+	case adminpb.ReportSeverity_REPORT_SEVERITY_HIGH:
+		return "HIGH"
+	default:
+		return ""
+	}
+}
+
+func convertReportSeverity(s string) adminpb.ReportSeverity {
+	switch s {
+	case "LOW":
+		return adminpb.ReportSeverity_REPORT_SEVERITY_LOW
+	case "MEDIUM":
+		return adminpb.ReportSeverity_REPORT_SEVERITY_MEDIUM
+	case "HIGH":
+		return adminpb.ReportSeverity_REPORT_SEVERITY_HIGH
+	default:
+		return adminpb.ReportSeverity_REPORT_SEVERITY_UNSPECIFIED
+	}
+}
+
+func convertEnforcementAction(action string) adminpb.EnforcementAction {
+	switch action {
+	case "WARN":
+		return adminpb.EnforcementAction_ENFORCEMENT_ACTION_WARN
+	case "SUSPEND":
+		return adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND
+	case "BAN":
+		return adminpb.EnforcementAction_ENFORCEMENT_ACTION_BAN
+	case "REINSTATE":
+		return adminpb.EnforcementAction_ENFORCEMENT_ACTION_REINSTATE
+	default:
+		return adminpb.EnforcementAction_ENFORCEMENT_ACTION_UNSPECIFIED
+	}
+}
+
+func convertAppealStatusToString(s adminpb.AppealStatus) string {
+	switch s {
+	case adminpb.AppealStatus_APPEAL_STATUS_PENDING:
+		return "PENDING"
+	case adminpb.AppealStatus_APPEAL_STATUS_APPROVED:
+		return "APPROVED"
+	case adminpb.AppealStatus_APPEAL_STATUS_REJECTED:
+		return "REJECTED"
+	default:
+		return ""
+	}
+}
+
+func convertAppealStatus(s string) adminpb.AppealStatus {
+	switch s {
+	case "PENDING":
+		return adminpb.AppealStatus_APPEAL_STATUS_PENDING
+	case "APPROVED":
+		return adminpb.AppealStatus_APPEAL_STATUS_APPROVED
+	case "REJECTED":
+		return adminpb.AppealStatus_APPEAL_STATUS_REJECTED
+	default:
+		return adminpb.AppealStatus_APPEAL_STATUS_UNSPECIFIED
+	}
+}
+
+func nullIntIDValue(id sql.NullInt64) string {
+	if id.Valid {
+		return strconv.FormatInt(id.Int64, 10)
+	}
+	return ""
+}
+
+func convertUserReport(r *repository.UserReport) *adminpb.UserReport {
+	return &adminpb.UserReport{
+		ReportId:               r.ReportID,
+		ReporterUserId:         strconv.Itoa(r.ReporterUserID),
+		ReportedUserId:         strconv.Itoa(r.ReportedUserID),
+		ReportedUserName:       r.ReportedUserName,
+		Reason:                 r.Reason,
+		Details:                nullStringValue(r.Details),
+		EvidenceUrls:           r.EvidenceURLs,
+		Status:                 convertReportStatus(r.Status),
+		Severity:               convertReportSeverity(r.Severity),
+		ClaimedBy:              nullIntIDValue(r.ClaimedBy),
+		ClaimedAt:              timestampFromNullTime(r.ClaimedAt),
+		ReviewedBy:             nullIntIDValue(r.ReviewedBy),
+		ReviewedAt:             timestampFromNullTime(r.ReviewedAt),
+		ResolutionNotes:        nullStringValue(r.ResolutionNotes),
+		Escalated:              r.EscalatedAt.Valid,
+		CreatedAt:              timestampFromTime(r.CreatedAt),
+		OpenReportsAgainstUser: int32(r.OpenReportCount),
+	}
+}
+
+// convertModerationAction converts an enforcement action. reportID is the public
+// report ID; when empty the internal report ID is used.
+func convertModerationAction(a *repository.ModerationAction, reportID string) *adminpb.ModerationAction {
+	if reportID == "" {
+		reportID = nullIntIDValue(a.ReportID)
+	}
+	return &adminpb.ModerationAction{
+		ActionId:     strconv.Itoa(a.ID),
+		UserId:       strconv.Itoa(a.UserID),
+		ReportId:     reportID,
+		AdminId:      nullIntIDValue(a.AdminID),
+		Action:       convertEnforcementAction(a.Action),
+		DurationDays: int32(a.DurationDays.Int64),
+		ExpiresAt:    timestampFromNullTime(a.ExpiresAt),
+		Notes:        nullStringValue(a.Notes),
+		CreatedAt:    timestampFromTime(a.CreatedAt),
+		LiftedAt:     timestampFromNullTime(a.LiftedAt),
+		LiftedReason: nullStringValue(a.LiftedReason),
+	}
+}
+
+func convertEnforcementAppeal(a *repository.EnforcementAppeal) *adminpb.EnforcementAppeal {
+	return &adminpb.EnforcementAppeal{
+		AppealId:    strconv.Itoa(a.ID),
+		UserId:      strconv.Itoa(a.UserID),
+		UserName:    a.UserName,
+		UserEmail:   a.UserEmail,
+		Enforcement: convertModerationAction(a.Enforcement, ""),
+		Message:     a.Message,
+		Status:      convertAppealStatus(a.Status),
+		ReviewedBy:  nullIntIDValue(a.ReviewedBy),
+		ReviewedAt:  timestampFromNullTime(a.ReviewedAt),
+		ReviewNotes: nullStringValue(a.ReviewNotes),
+		CreatedAt:   timestampFromTime(a.CreatedAt),
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestAdminService creates an AdminService whose repositories use db
+func newTestAdminService(db *sql.DB) *AdminService {
+	return &AdminService{
+		db:             db,
+		adminRepo:      repository.NewAdminRepository(db),
+		userRepo:       repository.NewUserRepository(db),
+		reportRepo:     repository.NewUserReportRepository(db),
+		moderationRepo: repository.NewModerationRepository(db),
+		verifyRepo:     repository.NewVerificationRepository(db),
+		changeRepo:     repository.NewProfileChangeRepository(db),
+		promptRepo:     repository.NewPromptRepository(db),
+	}
+}
+
+// adminContext is a context authenticated as adminID
+func adminContext(adminID int) context.Context {
+	return context.WithValue(context.Background(), "adminID", adminID)
+}
+
+func setupTestAdminService(t *testing.T) (*AdminService, sqlmock.Sqlmock, *sql.DB) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return newTestAdminService(db), mock, db
+}
+
+func userReportRows(status string, claimedBy interface{}) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{
+		"id", "report_id", "reporter_user_id", "reported_user_id", "name",
+		"reason", "details", "evidence_urls", "status", "severity",
+		"claimed_by", "claimed_at", "reviewed_by", "reviewed_at", "resolution_notes",
+		"escalated_at", "created_at", "open_reports",
+	}).AddRow(
+		7, "report_1_2_100", 1, 2, "Reported User",
+		"HARASSMENT", "Threatening messages", []byte(`["https://example.com/a.png"]`), status, "HIGH",
+		claimedBy, nil, nil, nil, nil,
+		nil, now, 1,
+	)
+}
+
+func TestResolveUserReport_SuspendsReportedUser(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	ctx := adminContext(5)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, reported_user_id, status, claimed_by FROM datifyy_v2_user_reports WHERE report_id = \\$1 FOR UPDATE").
+		WithArgs("report_1_2_100").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "reported_user_id", "status", "claimed_by"}).AddRow(7, 2, "IN_REVIEW", 5))
+	mock.ExpectExec("UPDATE datifyy_v2_user_reports SET status = \\$2").
+		WithArgs(7, "RESOLVED", 5, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_moderation_actions").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg(), "SUSPEND", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(11, time.Now()))
+	mock.ExpectExec("UPDATE datifyy_v2_users SET account_status = \\$2, updated_at = NOW\\(\\) WHERE id = \\$1 AND \\(account_status <> 'BANNED' OR \\$2 = 'BANNED'\\)").
+		WithArgs(2, "SUSPENDED").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_sessions SET is_active = false").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_reports r").
+		WithArgs("report_1_2_100").
+		WillReturnRows(userReportRows("RESOLVED", 5))
+
+	resp, err := service.ResolveUserReport(ctx, &adminpb.ResolveUserReportRequest{
+		ReportId:       "report_1_2_100",
+		Notes:          "Confirmed harassment",
+		Action:         adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND,
+		SuspensionDays: 7,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, adminpb.ReportStatus_REPORT_STATUS_RESOLVED, resp.Report.Status)
+	require.NotNil(t, resp.Enforcement)
+	assert.Equal(t, "11", resp.Enforcement.ActionId)
+	assert.Equal(t, "2", resp.Enforcement.UserId)
+	assert.Equal(t, int32(7), resp.Enforcement.DurationDays)
+	assert.NotNil(t, resp.Enforcement.ExpiresAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveUserReport_SuspendRequiresDuration(t *testing.T) {
+	service, _, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.ResolveUserReport(adminContext(5), &adminpb.ResolveUserReportRequest{
+		ReportId: "report_1_2_100",
+		Action:   adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestClaimUserReport_ClaimedByAnotherModerator(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	ctx := adminContext(5)
+
+	mock.ExpectExec("UPDATE datifyy_v2_user_reports SET status = 'IN_REVIEW'").
+		WithArgs("report_1_2_100", 5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT status, claimed_by FROM datifyy_v2_user_reports").
+		WithArgs("report_1_2_100").
+		WillReturnRows(sqlmock.NewRows([]string{"status", "claimed_by"}).AddRow("IN_REVIEW", 9))
+
+	_, err := service.ClaimUserReport(ctx, &adminpb.ClaimUserReportRequest{
+		ReportId: "report_1_2_100",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimUserReport_RequiresAdminToken(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.ClaimUserReport(context.Background(), &adminpb.ClaimUserReportRequest{
+		ReportId: "report_1_2_100",
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewEnforcementAppeal_ApproveReinstatesUser(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	ctx := adminContext(5)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT user_id, moderation_action_id, status FROM datifyy_v2_enforcement_appeals WHERE id = \\$1 FOR UPDATE").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "moderation_action_id", "status"}).AddRow(2, 11, "PENDING"))
+	mock.ExpectExec("UPDATE datifyy_v2_enforcement_appeals SET status = \\$2").
+		WithArgs(3, "APPROVED", 5, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_moderation_actions SET lifted_at = NOW\\(\\), lifted_reason = 'Appeal approved'").
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_users SET account_status = 'SUSPENDED'").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("UPDATE datifyy_v2_users u SET account_status = 'ACTIVE'").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_moderation_actions").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg(), "REINSTATE", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(12, now))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_enforcement_appeals ap").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "name", "email", "moderation_action_id", "message", "status",
+			"reviewed_by", "reviewed_at", "review_notes", "created_at",
+			"m_id", "m_user_id", "report_id", "admin_id", "action", "duration_days", "expires_at", "notes",
+			"lifted_at", "lifted_reason", "m_created_at",
+		}).AddRow(
+			3, 2, "Reported User", "reported@example.com", 11, "It was a misunderstanding", "APPROVED",
+			5, now, "Evidence was inconclusive", now.Add(-time.Hour),
+			11, 2, 7, 5, "SUSPEND", 7, now.Add(72*time.Hour), "Harassment",
+			now, "Appeal approved", now.Add(-96*time.Hour),
+		))
+
+	resp, err := service.ReviewEnforcementAppeal(ctx, &adminpb.ReviewEnforcementAppealRequest{
+		AppealId: "3",
+		Approve:  true,
+		Notes:    "Evidence was inconclusive",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, adminpb.AppealStatus_APPEAL_STATUS_APPROVED, resp.Appeal.Status)
+	assert.Equal(t, adminpb.EnforcementAction_ENFORCEMENT_ACTION_SUSPEND, resp.Appeal.Enforcement.Action)
+	assert.NotNil(t, resp.Appeal.Enforcement.LiftedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewEnforcementAppeal_AlreadyReviewed(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT user_id, moderation_action_id, status FROM datifyy_v2_enforcement_appeals").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "moderation_action_id", "status"}).AddRow(2, 11, "REJECTED"))
+	mock.ExpectRollback()
+
+	_, err := service.ReviewEnforcementAppeal(adminContext(5), &adminpb.ReviewEnforcementAppealRequest{
+		AppealId: "3",
+		Approve:  true,
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
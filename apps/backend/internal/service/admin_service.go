@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -10,7 +11,9 @@ import (
 	adminpb "github.com/datifyy/backend/gen/admin/v1"
 	commonpb "github.com/datifyy/backend/gen/common/v1"
 	"github.com/datifyy/backend/internal/auth"
+	"github.com/datifyy/backend/internal/datestatus"
 	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/storage"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,10 +22,16 @@ import (
 // AdminService implements the admin gRPC service
 type AdminService struct {
 	adminpb.UnimplementedAdminServiceServer
-	adminRepo    *repository.AdminRepository
-	userRepo     *repository.UserRepository
-	datesService *DatesService
-	db           *sql.DB
+	adminRepo      *repository.AdminRepository
+	userRepo       *repository.UserRepository
+	reportRepo     *repository.UserReportRepository
+	moderationRepo *repository.ModerationRepository
+	verifyRepo     *repository.VerificationRepository
+	changeRepo     *repository.ProfileChangeRepository
+	promptRepo     *repository.PromptRepository
+	blobStore      storage.BlobStore
+	datesService   *DatesService
+	db             *sql.DB
 }
 
 // NewAdminService creates a new admin service
@@ -33,10 +42,16 @@ func NewAdminService(db *sql.DB, redisClient *redis.Client) (*AdminService, erro
 	}
 
 	return &AdminService{
-		adminRepo:    repository.NewAdminRepository(db),
-		userRepo:     repository.NewUserRepository(db),
-		datesService: datesService,
-		db:           db,
+		adminRepo:      repository.NewAdminRepository(db),
+		userRepo:       repository.NewUserRepository(db),
+		reportRepo:     repository.NewUserReportRepository(db),
+		moderationRepo: repository.NewModerationRepository(db),
+		verifyRepo:     repository.NewVerificationRepository(db),
+		changeRepo:     repository.NewProfileChangeRepository(db),
+		promptRepo:     repository.NewPromptRepository(db),
+		blobStore:      storage.NewLocalStore(getEnvOrDefault("STORAGE_DIR", "storage")),
+		datesService:   datesService,
+		db:             db,
 	}, nil
 }
 
@@ -194,6 +209,12 @@ func (s *AdminService) GetUserDetails(ctx context.Context, req *adminpb.GetUserD
 	// Get upcoming dates
 	upcomingDates, _ := s.adminRepo.GetUserDates(ctx, userID, true)
 
+	// Get recent profile and preference changes
+	changes, _ := s.changeRepo.ListByUser(ctx, userID, profileChangeHistoryLimit)
+
+	// Get cancellation counts
+	reliability, _ := s.adminRepo.GetDateReliability(ctx, userID)
+
 	// Convert to proto
 	userDetails := &adminpb.UserFullDetails{
 		UserId:        strconv.Itoa(user.ID),
@@ -235,12 +256,26 @@ func (s *AdminService) GetUserDetails(ctx context.Context, req *adminpb.GetUserD
 		protoUpcomingDates = append(protoUpcomingDates, convertScheduledDate(&d))
 	}
 
-	return &adminpb.GetUserDetailsResponse{
-		User:          userDetails,
-		Availability:  protoAvailability,
-		PastDates:     protoPastDates,
-		UpcomingDates: protoUpcomingDates,
-	}, nil
+	var protoChanges []*adminpb.ProfileChange
+	for i := range changes {
+		protoChanges = append(protoChanges, convertProfileChange(&changes[i]))
+	}
+
+	resp := &adminpb.GetUserDetailsResponse{
+		User:           userDetails,
+		Availability:   protoAvailability,
+		PastDates:      protoPastDates,
+		UpcomingDates:  protoUpcomingDates,
+		ProfileChanges: protoChanges,
+	}
+	if reliability != nil {
+		resp.Reliability = &adminpb.DateReliability{
+			Cancellations:     int32(reliability.Cancellations),
+			LateCancellations: int32(reliability.LateCancellations),
+		}
+	}
+
+	return resp, nil
 }
 
 // =============================================================================
@@ -328,6 +363,9 @@ func (s *AdminService) ScheduleDate(ctx context.Context, req *adminpb.ScheduleDa
 	// TODO: Assign genie based on availability
 
 	createdDate, err := s.adminRepo.CreateScheduledDate(ctx, date)
+	if errors.Is(err, repository.ErrSlotReserved) || errors.Is(err, repository.ErrDateConflict) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to schedule date: %v", err)
 	}
@@ -337,13 +375,88 @@ func (s *AdminService) ScheduleDate(ctx context.Context, req *adminpb.ScheduleDa
 	}, nil
 }
 
+// FindCommonAvailability finds ranked times two users are both free to meet
+func (s *AdminService) FindCommonAvailability(ctx context.Context, req *adminpb.FindCommonAvailabilityRequest) (*adminpb.FindCommonAvailabilityResponse, error) {
+	user1ID, err := strconv.Atoi(req.User1Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user1 ID")
+	}
+
+	user2ID, err := strconv.Atoi(req.User2Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user2 ID")
+	}
+
+	switch req.DateType {
+	case "", "online", "offline", "offline_event":
+	default:
+		return nil, status.Error(codes.InvalidArgument, "date_type must be online, offline or offline_event")
+	}
+
+	limit := int(req.Limit)
+	if limit < 1 || limit > 100 {
+		limit = defaultCommonAvailabilityLimit
+	}
+
+	candidates, err := s.datesService.FindCommonAvailability(ctx, user1ID, user2ID, CommonAvailabilityOptions{
+		DateType:      req.DateType,
+		From:          timeFromTimestamp(req.FromTime),
+		To:            timeFromTimestamp(req.ToTime),
+		MinDuration:   time.Duration(req.MinDurationMinutes) * time.Minute,
+		Buffer:        time.Duration(req.BufferMinutes) * time.Minute,
+		MaxDistanceKm: req.MaxDistanceKm,
+		Limit:         limit,
+	})
+	switch {
+	case errors.Is(err, errSameUser), errors.Is(err, errInvalidCommonAvailabilityWindow):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, errUsersBlocked):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to find common availability: %v", err)
+	}
+
+	pbCandidates := make([]*adminpb.CommonAvailabilityCandidate, len(candidates))
+	for i, c := range candidates {
+		pbCandidates[i] = &adminpb.CommonAvailabilityCandidate{
+			StartTime:       timestampFromTime(c.Start),
+			EndTime:         timestampFromTime(c.End),
+			DurationMinutes: int64(c.End.Sub(c.Start).Minutes()),
+			DateType:        c.DateType,
+			User1SlotId:     strconv.Itoa(c.User1Slot.ID),
+			User2SlotId:     strconv.Itoa(c.User2Slot.ID),
+			User1Location:   slotLocationToAdminProto(c.User1Slot),
+			User2Location:   slotLocationToAdminProto(c.User2Slot),
+			DistanceKm:      c.DistanceKm,
+			SameCity:        c.SameCity,
+			Score:           c.Score,
+		}
+	}
+
+	return &adminpb.FindCommonAvailabilityResponse{
+		Candidates: pbCandidates,
+	}, nil
+}
+
 // =============================================================================
 // AI-Powered Date Curation
 // =============================================================================
 
 // GetCurationCandidates returns users available for dates tomorrow
 func (s *AdminService) GetCurationCandidates(ctx context.Context, req *adminpb.GetCurationCandidatesRequest) (*adminpb.GetCurationCandidatesResponse, error) {
-	candidates, err := s.datesService.GetCandidatesForCuration(ctx)
+	forUserID := 0
+	if req.ForUserId != "" {
+		id, err := strconv.Atoi(req.ForUserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid for_user_id")
+		}
+		forUserID = id
+	}
+
+	candidates, err := s.datesService.GetCandidatesForCuration(ctx, forUserID)
+	if errors.Is(err, errBirthDetailsMissing) {
+		return nil, status.Error(codes.FailedPrecondition, "user requires horoscope matching but has no birth details")
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get curation candidates: %v", err)
 	}
@@ -361,6 +474,10 @@ func (s *AdminService) GetCurationCandidates(ctx context.Context, req *adminpb.G
 			AadharVerified:      c.AadharVerified,
 			WorkEmailVerified:   c.WorkEmailVerified,
 			AvailableSlotsCount: int32(c.AvailableSlotsCount),
+			DistanceKm:          int32(c.DistanceKm),
+			HoroscopeMatch:      horoscopeMatchToProto(c.Horoscope),
+			PreferenceRules:     ruleResultsToProto(c.PreferenceRules),
+			PreferenceScore:     int32(c.PreferenceScore),
 		}
 
 		if c.NextAvailableDate != nil {
@@ -419,6 +536,9 @@ func (s *AdminService) CurateDates(ctx context.Context, req *adminpb.CurateDates
 			Reasoning:          m.Reasoning,
 			MatchedAspects:     m.MatchedAspects,
 			MismatchedAspects:  m.MismatchedAspects,
+			UserRules:          ruleResultsToProto(m.UserRules),
+			CandidateRules:     ruleResultsToProto(m.CandidateRules),
+			DealbreakersPassed: m.DealbreakersPassed,
 		}
 		pbMatches = append(pbMatches, match)
 	}
@@ -478,7 +598,8 @@ func (s *AdminService) GetGenieDates(ctx context.Context, req *adminpb.GetGenieD
 	}, nil
 }
 
-// UpdateDateStatus updates the status of a date
+// UpdateDateStatus moves a date along its lifecycle, rejecting moves the
+// lifecycle doesn't allow, and records the change in the date's history
 func (s *AdminService) UpdateDateStatus(ctx context.Context, req *adminpb.UpdateDateStatusRequest) (*adminpb.UpdateDateStatusResponse, error) {
 	dateID, err := strconv.Atoi(req.DateId)
 	if err != nil {
@@ -486,12 +607,26 @@ func (s *AdminService) UpdateDateStatus(ctx context.Context, req *adminpb.Update
 	}
 
 	statusStr := convertDateStatusToString(req.Status)
+	if statusStr == "" {
+		return nil, status.Error(codes.InvalidArgument, "status is required")
+	}
+
+	var adminID int
+	if req.AdminId != "" {
+		adminID, err = strconv.Atoi(req.AdminId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin ID")
+		}
+	}
 
-	date, err := s.adminRepo.UpdateDateStatus(ctx, dateID, statusStr, req.Notes)
+	date, err := s.adminRepo.UpdateDateStatus(ctx, dateID, statusStr, req.Notes, adminID)
 	if err != nil {
 		if err == repository.ErrDateNotFound {
 			return nil, status.Error(codes.NotFound, "date not found")
 		}
+		if errors.Is(err, datestatus.ErrInvalidTransition) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update date: %v", err)
 	}
 
@@ -686,7 +821,19 @@ func (s *AdminService) BulkUserAction(ctx context.Context, req *adminpb.BulkUser
 		return nil, status.Error(codes.InvalidArgument, "invalid action")
 	}
 
-	result, err := s.adminRepo.BulkUserAction(ctx, userIDs, action, req.Reason)
+	var adminID int
+	if req.AdminId != "" {
+		id, err := strconv.Atoi(req.AdminId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin_id")
+		}
+		adminID = id
+	}
+	if req.SuspensionDays < 0 || req.SuspensionDays > maxSuspensionDays {
+		return nil, status.Errorf(codes.InvalidArgument, "suspension_days must be between 0 and %d", maxSuspensionDays)
+	}
+
+	result, err := s.adminRepo.BulkUserAction(ctx, userIDs, action, req.Reason, adminID, int(req.SuspensionDays))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to perform bulk action: %v", err)
 	}
@@ -991,6 +1138,24 @@ func convertDateStatusToString(status adminpb.DateStatus) string {
 	}
 }
 
+// slotLocationToAdminProto returns an availability slot's place, or nil for
+// slots without one
+func slotLocationToAdminProto(slot *repository.AvailabilitySlot) *adminpb.OfflineLocation {
+	if !slot.PlaceName.Valid && !slot.Address.Valid && !slot.City.Valid {
+		return nil
+	}
+	return &adminpb.OfflineLocation{
+		PlaceName: slot.PlaceName.String,
+		Address:   slot.Address.String,
+		City:      slot.City.String,
+		State:     slot.State.String,
+		Country:   slot.Country.String,
+		Zipcode:   slot.Zipcode.String,
+		Latitude:  slot.Latitude.Float64,
+		Longitude: slot.Longitude.Float64,
+	}
+}
+
 func timestampFromTime(t time.Time) *commonpb.Timestamp {
 	return &commonpb.Timestamp{
 		Seconds: t.Unix(),
@@ -1073,6 +1238,9 @@ func convertScheduledDate(d *repository.ScheduledDate) *adminpb.ScheduledDate {
 		Notes:           nullStringValue(d.Notes),
 		CreatedAt:       timestampFromTime(d.CreatedAt),
 		UpdatedAt:       timestampFromTime(d.UpdatedAt),
+		ConfirmedAt:     timestampFromNullTime(d.ConfirmedAt),
+		CompletedAt:     timestampFromNullTime(d.CompletedAt),
+		CancelledAt:     timestampFromNullTime(d.CancelledAt),
 	}
 
 	if d.GenieID.Valid {
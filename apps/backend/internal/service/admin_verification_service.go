@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListIdVerifications returns the ID review queue, oldest submission first
+func (s *AdminService) ListIdVerifications(ctx context.Context, req *adminpb.ListIdVerificationsRequest) (*adminpb.ListIdVerificationsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	verifications, totalCount, err := s.verifyRepo.ListIDVerifications(ctx, idVerificationStatusToString(req.Status), pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list verifications: %v", err)
+	}
+
+	var pbVerifications []*adminpb.IdVerification
+	for _, v := range verifications {
+		pbVerifications = append(pbVerifications, convertIDVerification(v))
+	}
+
+	return &adminpb.ListIdVerificationsResponse{
+		Verifications: pbVerifications,
+		TotalCount:    int32(totalCount),
+		Page:          int32(page),
+		PageSize:      int32(pageSize),
+	}, nil
+}
+
+// GetIdVerification returns a submission together with its document images
+func (s *AdminService) GetIdVerification(ctx context.Context, req *adminpb.GetIdVerificationRequest) (*adminpb.GetIdVerificationResponse, error) {
+	verificationID, err := strconv.Atoi(req.VerificationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid verification_id")
+	}
+
+	v, err := s.verifyRepo.GetIDVerification(ctx, verificationID)
+	if errors.Is(err, repository.ErrVerificationNotFound) {
+		return nil, status.Error(codes.NotFound, "verification not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+
+	resp := &adminpb.GetIdVerificationResponse{Verification: convertIDVerification(v)}
+
+	resp.FrontImage, err = s.blobStore.Get(ctx, v.FrontImageKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load document")
+	}
+	if v.BackImageKey.Valid {
+		if resp.BackImage, err = s.blobStore.Get(ctx, v.BackImageKey.String); err != nil {
+			return nil, status.Error(codes.Internal, "failed to load document")
+		}
+	}
+	if v.SelfieImageKey.Valid {
+		if resp.SelfieImage, err = s.blobStore.Get(ctx, v.SelfieImageKey.String); err != nil {
+			return nil, status.Error(codes.Internal, "failed to load document")
+		}
+	}
+
+	return resp, nil
+}
+
+// ReviewIdVerification approves or rejects a pending submission. Approval grants
+// the user's verified badge.
+func (s *AdminService) ReviewIdVerification(ctx context.Context, req *adminpb.ReviewIdVerificationRequest) (*adminpb.ReviewIdVerificationResponse, error) {
+	verificationID, err := strconv.Atoi(req.VerificationId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid verification_id")
+	}
+	adminID, err := getAdminIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "admin authentication required")
+	}
+
+	reason := strings.TrimSpace(req.RejectionReason)
+	if !req.Approve && reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "rejection_reason is required when rejecting")
+	}
+
+	// The badge is change logged as the reviewing admin's edit
+	ctx = repository.WithChangeActor(ctx, repository.ChangeActor{Source: repository.ChangeSourceAdmin, ID: adminID})
+	err = s.verifyRepo.ReviewIDVerification(ctx, verificationID, adminID, req.Approve, reason)
+	switch {
+	case errors.Is(err, repository.ErrVerificationNotFound):
+		return nil, status.Error(codes.NotFound, "verification not found")
+	case errors.Is(err, repository.ErrVerificationReviewed):
+		return nil, status.Error(codes.FailedPrecondition, "verification has already been reviewed")
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to review verification: %v", err)
+	}
+
+	v, err := s.verifyRepo.GetIDVerification(ctx, verificationID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+
+	return &adminpb.ReviewIdVerificationResponse{Verification: convertIDVerification(v)}, nil
+}
+
+func convertIDVerification(v *repository.IDVerification) *adminpb.IdVerification {
+	return &adminpb.IdVerification{
+		VerificationId:  strconv.Itoa(v.ID),
+		UserId:          strconv.Itoa(v.UserID),
+		UserName:        v.UserName,
+		UserEmail:       v.UserEmail,
+		DocumentType:    stringToIDDocumentType(v.DocumentType),
+		IdNumberMasked:  v.IDNumberMasked,
+		Status:          stringToIDVerificationStatus(v.Status),
+		ReviewedBy:      nullIntIDValue(v.ReviewedBy),
+		ReviewedAt:      timestampFromNullTime(v.ReviewedAt),
+		RejectionReason: nullStringValue(v.RejectionReason),
+		CreatedAt:       timestampFromTime(v.CreatedAt),
+		HasBackImage:    v.BackImageKey.Valid,
+		HasSelfieImage:  v.SelfieImageKey.Valid,
+	}
+}
+
+func idVerificationStatusToString(s userpb.IdVerificationStatus) string {
+	switch s {
+	case userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_PENDING:
+		return "PENDING"
+	case userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_APPROVED:
+		return "APPROVED"
+	case userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_REJECTED:
+		return "REJECTED"
+	default:
+		return ""
+	}
+}
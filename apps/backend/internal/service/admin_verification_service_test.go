@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func idVerificationRows(status string, reviewedBy interface{}) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "user_id", "name", "email", "document_type", "id_number_masked",
+		"front_image_key", "back_image_key", "selfie_image_key", "status",
+		"reviewed_by", "reviewed_at", "rejection_reason", "created_at",
+	}).AddRow(
+		9, 2, "Jane", "jane@example.com", "PASSPORT", "XXXX4567",
+		"id-documents/2/1/front", nil, "id-documents/2/1/selfie", status,
+		reviewedBy, nil, nil, time.Now(),
+	)
+}
+
+func TestReviewIdVerification_ApproveGrantsBadge(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT user_id, status FROM datifyy_v2_id_verifications WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "status"}).AddRow(2, "PENDING"))
+	mock.ExpectExec("UPDATE datifyy_v2_id_verifications").
+		WithArgs(9, "APPROVED", 5, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT to_jsonb\\(t\\) FROM datifyy_v2_user_profiles t WHERE user_id = \\$1 FOR UPDATE").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`{"user_id": 2, "is_verified": false}`)))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_user_profiles \\(user_id, is_verified\\)(.+)RETURNING to_jsonb").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`{"user_id": 2, "is_verified": true}`)))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_changes").
+		WithArgs(2, "datifyy_v2_user_profiles", "is_verified", "false", "true", "admin", sql.NullInt64{Int64: 5, Valid: true}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_id_verifications v").
+		WithArgs(9).
+		WillReturnRows(idVerificationRows("APPROVED", 5))
+
+	resp, err := service.ReviewIdVerification(adminContext(5), &adminpb.ReviewIdVerificationRequest{
+		VerificationId: "9",
+		Approve:        true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_APPROVED, resp.Verification.Status)
+	assert.Equal(t, "5", resp.Verification.ReviewedBy)
+	assert.True(t, resp.Verification.HasSelfieImage)
+	assert.False(t, resp.Verification.HasBackImage)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewIdVerification_RejectRequiresReason(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.ReviewIdVerification(adminContext(5), &adminpb.ReviewIdVerificationRequest{
+		VerificationId: "9",
+		Approve:        false,
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewIdVerification_AlreadyReviewed(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT user_id, status FROM datifyy_v2_id_verifications WHERE id = \\$1 FOR UPDATE").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "status"}).AddRow(2, "REJECTED"))
+	mock.ExpectRollback()
+
+	_, err := service.ReviewIdVerification(adminContext(5), &adminpb.ReviewIdVerificationRequest{
+		VerificationId:  "9",
+		Approve:         false,
+		RejectionReason: "Blurry photo",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewIdVerification_RequiresAdminToken(t *testing.T) {
+	service, mock, db := setupTestAdminService(t)
+	defer db.Close()
+
+	_, err := service.ReviewIdVerification(context.Background(), &adminpb.ReviewIdVerificationRequest{
+		VerificationId: "9",
+		Approve:        true,
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
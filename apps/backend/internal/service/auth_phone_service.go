@@ -158,9 +158,10 @@ func (s *AuthService) LoginWithPhone(
 		return nil, fmt.Errorf("phone number not verified")
 	}
 
-	// Check account status
-	if user.AccountStatus != "active" {
-		return nil, fmt.Errorf("account is %s", user.AccountStatus)
+	// Check account status (logging in cancels a pending deletion)
+	accountRestored, err := s.checkLoginAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update last login
@@ -179,9 +180,10 @@ func (s *AuthService) LoginWithPhone(
 	userProfile := buildUserProfile(user)
 
 	return &authpb.LoginWithPhoneResponse{
-		User:    userProfile,
-		Tokens:  tokens,
-		Session: session,
+		User:            userProfile,
+		Tokens:          tokens,
+		Session:         session,
+		AccountRestored: accountRestored,
 	}, nil
 }
 
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,13 +11,16 @@ import (
 	"github.com/datifyy/backend/internal/auth"
 	"github.com/datifyy/backend/internal/repository"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AuthService handles authentication operations
 type AuthService struct {
 	authpb.UnimplementedAuthServiceServer
-	userRepo    *repository.UserRepository
-	db          *sql.DB
+	userRepo       *repository.UserRepository
+	moderationRepo *repository.ModerationRepository
+	db             *sql.DB
 	redis       *redis.Client
 	emailClient EmailSender
 }
@@ -31,8 +35,9 @@ type EmailSender interface {
 // NewAuthService creates a new auth service
 func NewAuthService(db *sql.DB, redisClient *redis.Client, emailClient EmailSender) *AuthService {
 	return &AuthService{
-		userRepo:    repository.NewUserRepository(db),
-		emailClient: emailClient,
+		userRepo:       repository.NewUserRepository(db),
+		moderationRepo: repository.NewModerationRepository(db),
+		emailClient:    emailClient,
 		db:       db,
 		redis:    redisClient,
 	}
@@ -155,9 +160,10 @@ func (s *AuthService) LoginWithEmail(
 		return nil, fmt.Errorf("invalid email or password")
 	}
 
-	// Check account status
-	if user.AccountStatus == "SUSPENDED" || user.AccountStatus == "BANNED" || user.AccountStatus == "DELETED" {
-		return nil, fmt.Errorf("account is %s", user.AccountStatus)
+	// Check account status (logging in cancels a pending deletion)
+	accountRestored, err := s.checkLoginAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create session and tokens
@@ -187,9 +193,10 @@ func (s *AuthService) LoginWithEmail(
 	}
 
 	return &authpb.LoginWithEmailResponse{
-		User:    userProfile,
-		Tokens:  tokens,
-		Session: sessionInfo,
+		User:            userProfile,
+		Tokens:          tokens,
+		Session:         sessionInfo,
+		AccountRestored: accountRestored,
 	}, nil
 }
 
@@ -566,6 +573,7 @@ func (s *AuthService) LoginWithOAuth(
 	// Check if user exists with this email
 	user, err := s.userRepo.GetByEmail(ctx, oauthEmail)
 	isNewUser := false
+	accountRestored := false
 
 	if err != nil {
 		// User doesn't exist - create new account
@@ -593,8 +601,9 @@ func (s *AuthService) LoginWithOAuth(
 		// oauth_accounts(user_id, provider, provider_user_id, connected_at)
 	} else {
 		// Existing user - verify account status
-		if user.AccountStatus != "active" {
-			return nil, fmt.Errorf("account is %s", user.AccountStatus)
+		accountRestored, err = s.checkLoginAccountStatus(ctx, user)
+		if err != nil {
+			return nil, err
 		}
 
 		// Update last login
@@ -613,13 +622,102 @@ func (s *AuthService) LoginWithOAuth(
 	userProfile := buildUserProfile(user)
 
 	return &authpb.LoginWithOAuthResponse{
-		User:      userProfile,
-		Tokens:    tokens,
-		Session:   session,
-		IsNewUser: isNewUser,
+		User:            userProfile,
+		Tokens:          tokens,
+		Session:         session,
+		IsNewUser:       isNewUser,
+		AccountRestored: accountRestored,
 	}, nil
 }
 
+// checkLoginAccountStatus rejects suspended, banned and deleted accounts.
+// An account pending deletion is restored, and restored is reported as true.
+func (s *AuthService) checkLoginAccountStatus(ctx context.Context, user *repository.User) (bool, error) {
+	if user.AccountStatus == "PENDING_DELETION" {
+		restoredStatus, err := s.userRepo.CancelDeletion(ctx, user.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to restore account: %w", err)
+		}
+		user.AccountStatus = restoredStatus
+		return true, nil
+	}
+
+	if user.AccountStatus == "SUSPENDED" || user.AccountStatus == "BANNED" {
+		return false, s.accountRestriction(ctx, user)
+	}
+
+	if user.AccountStatus == "DELETED" {
+		return false, fmt.Errorf("account is %s", user.AccountStatus)
+	}
+
+	return false, nil
+}
+
+// AccountRestrictedError is returned when a suspended or banned user tries to sign in.
+// It carries enough detail for the client to tell the user why and for how long.
+type AccountRestrictedError struct {
+	Status     string     // SUSPENDED or BANNED
+	Reason     string     // moderator notes, if any
+	Until      *time.Time // end of a timed suspension; nil when indefinite
+	Appealable bool       // whether AppealSuspension will accept an appeal
+}
+
+func (e *AccountRestrictedError) Error() string {
+	msg := fmt.Sprintf("account is %s", e.Status)
+	if e.Until != nil {
+		msg += fmt.Sprintf(" until %s", e.Until.UTC().Format(time.RFC3339))
+	}
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// GRPCStatus maps the error to PermissionDenied for gRPC clients
+func (e *AccountRestrictedError) GRPCStatus() *status.Status {
+	return status.New(codes.PermissionDenied, e.Error())
+}
+
+// accountRestriction describes why a suspended or banned user cannot sign in.
+// A suspension that has already expired but not yet been lifted by the
+// scheduled job is lifted here, and nil is returned so the login proceeds.
+func (s *AuthService) accountRestriction(ctx context.Context, user *repository.User) error {
+	restricted := &AccountRestrictedError{Status: user.AccountStatus}
+
+	enforcement, err := s.moderationRepo.GetActiveEnforcement(ctx, user.ID)
+	if errors.Is(err, repository.ErrNoActiveEnforcement) {
+		reactivated, liftErr := s.moderationRepo.LiftExpiredSuspensionsForUser(ctx, user.ID, time.Now())
+		if liftErr != nil {
+			fmt.Printf("Warning: failed to lift expired suspensions for user %d: %v\n", user.ID, liftErr)
+			return restricted
+		}
+		if reactivated {
+			user.AccountStatus = "ACTIVE"
+			return nil
+		}
+		return restricted
+	}
+	if err != nil {
+		fmt.Printf("Warning: failed to get active enforcement for user %d: %v\n", user.ID, err)
+		return restricted
+	}
+
+	restricted.Reason = enforcement.Notes.String
+	if enforcement.ExpiresAt.Valid {
+		until := enforcement.ExpiresAt.Time
+		restricted.Until = &until
+	}
+
+	// AppealSuspension accepts one appeal per action
+	appealed, err := s.moderationRepo.HasAppeal(ctx, enforcement.ID)
+	if err != nil {
+		fmt.Printf("Warning: failed to check appeal for user %d: %v\n", user.ID, err)
+		return restricted
+	}
+	restricted.Appealable = !appealed
+	return restricted
+}
+
 // createSessionAndTokens creates a session and generates access/refresh tokens
 func (s *AuthService) createSessionAndTokens(
 	ctx context.Context,
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/datifyy/backend/internal/auth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // setupTestAuthService creates a test auth service with a mock database
@@ -260,6 +263,21 @@ func TestLoginWithEmail_SuspendedAccount(t *testing.T) {
 		WithArgs(email).
 		WillReturnRows(rows)
 
+	// Mock the active suspension
+	suspendedUntil := now.Add(72 * time.Hour)
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_moderation_actions").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "report_id", "admin_id", "action", "duration_days", "expires_at", "notes",
+			"lifted_at", "lifted_reason", "created_at",
+		}).AddRow(
+			11, 1, nil, 5, "SUSPEND", 7, suspendedUntil, "Harassment",
+			nil, nil, now.Add(-96*time.Hour),
+		))
+	mock.ExpectQuery("SELECT EXISTS (.+) FROM datifyy_v2_enforcement_appeals WHERE moderation_action_id = \\$1").
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
 	req := &authpb.LoginWithEmailRequest{
 		Credentials: &authpb.EmailPasswordCredentials{
 			Email:    email,
@@ -274,6 +292,210 @@ func TestLoginWithEmail_SuspendedAccount(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, resp)
 	assert.Contains(t, err.Error(), "SUSPENDED")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	var restricted *AccountRestrictedError
+	require.True(t, errors.As(err, &restricted))
+	assert.Equal(t, "Harassment", restricted.Reason)
+	require.NotNil(t, restricted.Until)
+	assert.True(t, restricted.Until.Equal(suspendedUntil))
+	assert.True(t, restricted.Appealable)
+}
+
+func TestLoginWithEmail_SuspendedAccountAlreadyAppealed(t *testing.T) {
+	// Arrange
+	service, mock, db := setupTestAuthService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	email := "test@example.com"
+	password := "TestPass123!"
+	hashedPassword, _ := auth.HashPassword(password)
+	now := time.Now()
+
+	// Mock the GetByEmail query with SUSPENDED status - all 18 fields
+	rows := sqlmock.NewRows([]string{
+		"id", "email", "name", "password_hash", "phone_number",
+		"email_verified", "phone_verified", "account_status",
+		"verification_token", "verification_token_expires_at",
+		"password_reset_token", "password_reset_token_expires_at",
+		"last_login_at", "photo_url", "date_of_birth", "gender",
+		"created_at", "updated_at",
+	}).AddRow(
+		1, email, "Test User", hashedPassword, nil,
+		true, false, "SUSPENDED",
+		nil, nil,
+		nil, nil,
+		nil, nil, nil, nil,
+		now, now,
+	)
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE email").
+		WithArgs(email).
+		WillReturnRows(rows)
+
+	// Mock the active suspension
+	suspendedUntil := now.Add(72 * time.Hour)
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_moderation_actions").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "report_id", "admin_id", "action", "duration_days", "expires_at", "notes",
+			"lifted_at", "lifted_reason", "created_at",
+		}).AddRow(
+			11, 1, nil, 5, "SUSPEND", 7, suspendedUntil, "Harassment",
+			nil, nil, now.Add(-96*time.Hour),
+		))
+	mock.ExpectQuery("SELECT EXISTS (.+) FROM datifyy_v2_enforcement_appeals WHERE moderation_action_id = \\$1").
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	req := &authpb.LoginWithEmailRequest{
+		Credentials: &authpb.EmailPasswordCredentials{
+			Email:    email,
+			Password: password,
+		},
+	}
+
+	// Act
+	resp, err := service.LoginWithEmail(ctx, req)
+
+	// Assert
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	var restricted *AccountRestrictedError
+	require.True(t, errors.As(err, &restricted))
+	assert.False(t, restricted.Appealable)
+}
+
+func TestLoginWithEmail_ExpiredSuspensionIsLifted(t *testing.T) {
+	// Arrange
+	service, mock, db := setupTestAuthService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	email := "test@example.com"
+	password := "TestPass123!"
+	hashedPassword, _ := auth.HashPassword(password)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "email", "name", "password_hash", "phone_number",
+		"email_verified", "phone_verified", "account_status",
+		"verification_token", "verification_token_expires_at",
+		"password_reset_token", "password_reset_token_expires_at",
+		"last_login_at", "photo_url", "date_of_birth", "gender",
+		"created_at", "updated_at",
+	}).AddRow(
+		1, email, "Test User", hashedPassword, nil,
+		true, false, "SUSPENDED",
+		nil, nil,
+		nil, nil,
+		nil, nil, nil, nil,
+		now, now,
+	)
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE email").
+		WithArgs(email).
+		WillReturnRows(rows)
+
+	// The suspension expired before the background job lifted it
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_moderation_actions").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	// Only this user's suspensions are lifted; the scheduled job sweeps the rest
+	mock.ExpectQuery("UPDATE datifyy_v2_moderation_actions (.+) AND user_id = \\$2").
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery("UPDATE datifyy_v2_users u").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	mock.ExpectExec("INSERT INTO datifyy_v2_sessions").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_users SET last_login_at").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	req := &authpb.LoginWithEmailRequest{
+		Credentials: &authpb.EmailPasswordCredentials{
+			Email:    email,
+			Password: password,
+		},
+	}
+
+	// Act
+	resp, err := service.LoginWithEmail(ctx, req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.User.UserId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLoginWithEmail_RestoresPendingDeletion(t *testing.T) {
+	// Arrange
+	service, mock, db := setupTestAuthService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	email := "test@example.com"
+	password := "TestPass123!"
+	hashedPassword, _ := auth.HashPassword(password)
+	now := time.Now()
+
+	// Mock the GetByEmail query with PENDING_DELETION status - all 18 fields
+	rows := sqlmock.NewRows([]string{
+		"id", "email", "name", "password_hash", "phone_number",
+		"email_verified", "phone_verified", "account_status",
+		"verification_token", "verification_token_expires_at",
+		"password_reset_token", "password_reset_token_expires_at",
+		"last_login_at", "photo_url", "date_of_birth", "gender",
+		"created_at", "updated_at",
+	}).AddRow(
+		1, email, "Test User", hashedPassword, nil,
+		true, false, "PENDING_DELETION",
+		nil, nil,
+		nil, nil,
+		nil, nil, nil, nil,
+		now, now,
+	)
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE email").
+		WithArgs(email).
+		WillReturnRows(rows)
+
+	// Mock CancelDeletion
+	mock.ExpectQuery("UPDATE datifyy_v2_users SET account_status = CASE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_status"}).AddRow("ACTIVE"))
+
+	mock.ExpectExec("INSERT INTO datifyy_v2_sessions").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	mock.ExpectExec("UPDATE datifyy_v2_users SET last_login_at").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	req := &authpb.LoginWithEmailRequest{
+		Credentials: &authpb.EmailPasswordCredentials{
+			Email:    email,
+			Password: password,
+		},
+	}
+
+	// Act
+	resp, err := service.LoginWithEmail(ctx, req)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.True(t, resp.AccountRestored)
+	assert.Equal(t, "ACCOUNT_STATUS_ACTIVE", resp.User.AccountStatus.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 // ============================================================================
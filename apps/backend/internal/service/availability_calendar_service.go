@@ -0,0 +1,327 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"github.com/datifyy/backend/internal/ical"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxCalendarImportBytes bounds the size of an imported iCalendar file
+	maxCalendarImportBytes = 1 << 20
+
+	// calendarImportWindow is how far ahead busy periods are imported
+	calendarImportWindow = 180 * 24 * time.Hour
+
+	// calendarFeedWindow is how far ahead dates are listed in the feed; dates
+	// that ended up to a day ago are kept so they don't vanish mid-evening
+	calendarFeedWindow   = 365 * 24 * time.Hour
+	calendarFeedLookback = 24 * time.Hour
+)
+
+// CreateCalendarFeed creates a secret iCal feed URL for the user's upcoming
+// dates. Only a hash of the token is stored, so the URL can't be shown again;
+// creating a new one revokes the old.
+func (s *AvailabilityService) CreateCalendarFeed(
+	ctx context.Context,
+	req *availabilitypb.CreateCalendarFeedRequest,
+) (*availabilitypb.CreateCalendarFeedResponse, error) {
+	// Get user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate feed token")
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	if err := s.feedRepo.CreateFeedToken(ctx, userID, hashFeedToken(token)); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to create calendar feed: %v", err))
+	}
+
+	return &availabilitypb.CreateCalendarFeedResponse{
+		FeedUrl: fmt.Sprintf("%s/api/v1/calendar/feed/%s.ics", s.publicBaseURL, token),
+		Message: "Calendar feed created. Any previous feed URL no longer works.",
+	}, nil
+}
+
+// RevokeCalendarFeed revokes the user's calendar feed URL
+func (s *AvailabilityService) RevokeCalendarFeed(
+	ctx context.Context,
+	req *availabilitypb.RevokeCalendarFeedRequest,
+) (*availabilitypb.RevokeCalendarFeedResponse, error) {
+	// Get user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	revoked, err := s.feedRepo.RevokeFeedTokens(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to revoke calendar feed: %v", err))
+	}
+
+	message := "Calendar feed revoked"
+	if !revoked {
+		message = "No active calendar feed"
+	}
+
+	return &availabilitypb.RevokeCalendarFeedResponse{
+		Success: revoked,
+		Message: message,
+	}, nil
+}
+
+// CalendarFeed renders the iCalendar feed a feed token gives access to: the
+// owner's upcoming dates, in their timezone. It isn't an RPC; the feed is
+// fetched by calendar apps from a public URL.
+func (s *AvailabilityService) CalendarFeed(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, status.Error(codes.NotFound, "calendar feed not found")
+	}
+
+	userID, err := s.feedRepo.GetFeedUser(ctx, hashFeedToken(token))
+	if err != nil {
+		if err == repository.ErrFeedTokenNotFound {
+			return nil, status.Error(codes.NotFound, "calendar feed not found")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get calendar feed: %v", err))
+	}
+
+	now := time.Now()
+	dates, err := s.scheduledDatesRepo.ListActiveByUsersBetween(ctx, []int{userID},
+		now.Add(-calendarFeedLookback), now.Add(calendarFeedWindow))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get scheduled dates: %v", err))
+	}
+
+	partnerIDs := make([]int, len(dates))
+	for i, date := range dates {
+		partnerIDs[i] = dateCounterpart(date, userID)
+	}
+	partners, err := s.userRepo.GetByIDs(ctx, partnerIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get date partners: %v", err))
+	}
+
+	calendar := &ical.Calendar{
+		ProdID:   "-//Datifyy//Dates//EN",
+		Name:     "Datifyy dates",
+		Location: userLocation(ctx, s.profileRepo, userID),
+		Stamp:    now,
+		Events:   make([]ical.Event, len(dates)),
+	}
+	for i, date := range dates {
+		calendar.Events[i] = dateToCalendarEvent(date, partners[partnerIDs[i]])
+	}
+
+	var buf bytes.Buffer
+	if err := calendar.Encode(&buf); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to encode calendar feed: %v", err))
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ImportCalendar imports the busy periods of an iCalendar file in the coming
+// months as availability exclusions
+func (s *AvailabilityService) ImportCalendar(
+	ctx context.Context,
+	req *availabilitypb.ImportCalendarRequest,
+) (*availabilitypb.ImportCalendarResponse, error) {
+	// Get user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if strings.TrimSpace(req.IcsData) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ics_data is required")
+	}
+	if len(req.IcsData) > maxCalendarImportBytes {
+		return nil, status.Error(codes.InvalidArgument, "calendar file can't be larger than 1MB")
+	}
+
+	loc, err := s.requestLocation(ctx, req.Timezone, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	busy, warnings, err := ical.ParseBusy(strings.NewReader(req.IcsData), loc, now, now.Add(calendarImportWindow))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// A calendar may list the same period twice (e.g. an event and its
+	// free/busy entry under one UID)
+	inputs := make([]repository.CreateExclusionInput, 0, len(busy))
+	seen := make(map[string]bool, len(busy))
+	for _, b := range busy {
+		key := fmt.Sprintf("%s|%d", b.UID, b.Start.Unix())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		inputs = append(inputs, repository.CreateExclusionInput{
+			StartTime:   b.Start.Unix(),
+			EndTime:     b.End.Unix(),
+			ExternalUID: b.UID,
+		})
+	}
+
+	exclusions, removed, err := s.availabilityRepo.ImportExclusions(ctx, userID,
+		repository.ExclusionSourceICSImport, inputs, req.ReplaceExisting, now.Unix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to import calendar: %v", err))
+	}
+
+	// Slots starting before the window may still run into it
+	slots, err := s.availabilityRepo.GetByUserID(ctx, userID,
+		now.Add(-repository.MaxSlotDuration).Unix(), now.Add(calendarImportWindow).Unix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get availability: %v", err))
+	}
+
+	var conflicting []*availabilitypb.AvailabilitySlot
+	for _, slot := range slots {
+		if slot.EndTime > now.Unix() && overlapsExclusion(slot, exclusions) {
+			conflicting = append(conflicting, convertSlotToProto(slot, loc))
+		}
+	}
+
+	message := fmt.Sprintf("Imported %d busy periods", len(exclusions))
+	if removed > 0 {
+		message += fmt.Sprintf("; removed %d no longer in the calendar", removed)
+	}
+	if len(conflicting) > 0 {
+		message += fmt.Sprintf("; %d of your slots overlap them", len(conflicting))
+	}
+
+	return &availabilitypb.ImportCalendarResponse{
+		ImportedCount:    int32(len(exclusions)),
+		RemovedCount:     int32(removed),
+		Exclusions:       convertExclusionsToProto(exclusions, loc),
+		ConflictingSlots: conflicting,
+		Warnings:         warnings,
+		Message:          message,
+	}, nil
+}
+
+// deleteExclusion deletes an imported busy period
+func (s *AvailabilityService) deleteExclusion(ctx context.Context, exclusionIDStr string, userID int) (*availabilitypb.DeleteAvailabilityResponse, error) {
+	exclusionID, err := strconv.Atoi(exclusionIDStr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid exclusion_id format")
+	}
+
+	err = s.availabilityRepo.DeleteExclusion(ctx, exclusionID, userID)
+	if err != nil {
+		if err == repository.ErrExclusionNotFound {
+			return nil, status.Error(codes.NotFound, "exclusion not found or not owned by user")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete exclusion: %v", err))
+	}
+
+	return &availabilitypb.DeleteAvailabilityResponse{
+		Success: true,
+		Message: "Exclusion deleted successfully",
+	}, nil
+}
+
+// hashFeedToken hashes a feed token so it isn't stored in plain text
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// dateCounterpart returns the other participant of a date
+func dateCounterpart(date *repository.ScheduledDate, userID int) int {
+	if date.User1ID == userID {
+		return date.User2ID
+	}
+	return date.User1ID
+}
+
+// dateToCalendarEvent converts a scheduled date to a feed event. The UID is
+// derived from the date's ID only, so calendar apps update the event in place
+// when the date is rescheduled.
+func dateToCalendarEvent(date *repository.ScheduledDate, partner *repository.User) ical.Event {
+	summary := "Datifyy date"
+	if partner != nil {
+		if fields := strings.Fields(partner.Name); len(fields) > 0 {
+			summary = "Date with " + fields[0]
+		}
+	}
+
+	eventStatus := ical.StatusConfirmed
+	if date.Status == "scheduled" {
+		eventStatus = ical.StatusTentative
+	}
+
+	var location []string
+	for _, part := range []string{date.PlaceName.String, date.Address.String, date.City.String} {
+		if part != "" {
+			location = append(location, part)
+		}
+	}
+
+	description := "Online date"
+	if date.DateType != "online" {
+		description = "In-person date"
+	}
+
+	return ical.Event{
+		UID:          fmt.Sprintf("date-%d@datifyy.com", date.ID),
+		Start:        date.ScheduledTime,
+		End:          date.ScheduledTime.Add(time.Duration(date.DurationMinutes) * time.Minute),
+		Summary:      summary,
+		Description:  description,
+		Location:     strings.Join(location, ", "),
+		Status:       eventStatus,
+		LastModified: date.UpdatedAt,
+	}
+}
+
+// overlapsExclusion reports whether a slot overlaps any of the exclusions
+func overlapsExclusion(slot *repository.AvailabilitySlot, exclusions []*repository.AvailabilityExclusion) bool {
+	for _, exclusion := range exclusions {
+		if exclusion.StartTime < slot.EndTime && exclusion.EndTime > slot.StartTime {
+			return true
+		}
+	}
+	return false
+}
+
+// convertExclusionsToProto converts exclusions to proto, with local times in loc
+func convertExclusionsToProto(exclusions []*repository.AvailabilityExclusion, loc *time.Location) []*availabilitypb.AvailabilityExclusion {
+	pbExclusions := make([]*availabilitypb.AvailabilityExclusion, len(exclusions))
+	for i, exclusion := range exclusions {
+		pbExclusions[i] = &availabilitypb.AvailabilityExclusion{
+			ExclusionId:    strconv.Itoa(exclusion.ID),
+			StartTime:      exclusion.StartTime,
+			EndTime:        exclusion.EndTime,
+			Source:         exclusion.Source,
+			ExternalUid:    exclusion.ExternalUID,
+			LocalStartTime: formatLocal(exclusion.StartTime, loc),
+			LocalEndTime:   formatLocal(exclusion.EndTime, loc),
+		}
+	}
+	return pbExclusions
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCalendarFeed_CreateServeAndRevoke(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	service.publicBaseURL = "https://api.datifyy.com"
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE datifyy_v2_calendar_feed_tokens SET revoked_at = NOW\\(\\)").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_calendar_feed_tokens").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	created, err := service.CreateCalendarFeed(ctx, &availabilitypb.CreateCalendarFeedRequest{})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.FeedUrl, "https://api.datifyy.com/api/v1/calendar/feed/"))
+	require.True(t, strings.HasSuffix(created.FeedUrl, ".ics"))
+	token := strings.TrimSuffix(strings.TrimPrefix(created.FeedUrl, "https://api.datifyy.com/api/v1/calendar/feed/"), ".ics")
+	assert.Len(t, token, 43, "32 random bytes, base64url without padding")
+
+	// The feed is looked up by the token's hash, never the token itself
+	scheduled := time.Now().Add(72 * time.Hour).Truncate(time.Hour)
+	mock.ExpectQuery("UPDATE datifyy_v2_calendar_feed_tokens SET last_accessed_at = NOW\\(\\)").
+		WithArgs(hashFeedToken(token)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user1_id", "user2_id", "genie_id", "scheduled_time", "duration_minutes",
+			"status", "date_type", "place_name", "address", "city", "state", "country", "zipcode",
+			"latitude", "longitude", "notes", "admin_notes", "created_at", "updated_at",
+			"confirmed_at", "completed_at", "cancelled_at",
+		}).AddRow(
+			42, 2, 1, nil, scheduled, 90,
+			"confirmed", "offline", "Blue Tokai", nil, "Bengaluru", nil, nil, nil,
+			nil, nil, nil, nil, time.Now(), time.Now(),
+			nil, nil, nil,
+		))
+	mock.ExpectQuery("FROM datifyy_v2_users").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	expectUserTimezone(mock, 1, "UTC")
+
+	feed, err := service.CalendarFeed(context.Background(), token)
+	require.NoError(t, err)
+	ics := string(feed)
+	assert.Contains(t, ics, "UID:date-42@datifyy.com\r\n")
+	assert.Contains(t, ics, "DTSTART:"+scheduled.UTC().Format("20060102T150405Z")+"\r\n")
+	assert.Contains(t, ics, "DTEND:"+scheduled.Add(90*time.Minute).UTC().Format("20060102T150405Z")+"\r\n")
+	assert.Contains(t, ics, `LOCATION:Blue Tokai\, Bengaluru`+"\r\n")
+	assert.Contains(t, ics, "STATUS:CONFIRMED\r\n")
+
+	mock.ExpectExec("UPDATE datifyy_v2_calendar_feed_tokens SET revoked_at = NOW\\(\\)").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	revoked, err := service.RevokeCalendarFeed(ctx, &availabilitypb.RevokeCalendarFeedRequest{})
+	require.NoError(t, err)
+	assert.True(t, revoked.Success)
+
+	// Revoked tokens no longer match
+	mock.ExpectQuery("UPDATE datifyy_v2_calendar_feed_tokens SET last_accessed_at = NOW\\(\\)").
+		WithArgs(hashFeedToken(token)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	_, err = service.CalendarFeed(context.Background(), token)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportCalendar_StoresBusyPeriodsAndReportsConflicts(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	start := time.Now().Add(72 * time.Hour).Truncate(time.Hour).UTC()
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:dentist",
+		"DTSTART:" + start.Format("20060102T150405Z"),
+		"DURATION:PT1H",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_exclusions (.+) ON CONFLICT").
+		WithArgs(1, start.Unix(), start.Add(time.Hour).Unix(), "ics_import", "dentist").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "start_time", "end_time", "source", "external_uid", "created_at"}).
+			AddRow(7, 1, start.Unix(), start.Add(time.Hour).Unix(), "ics_import", "dentist", time.Now()))
+	mock.ExpectExec("DELETE FROM datifyy_v2_availability_exclusions").
+		WithArgs(1, "ics_import", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots").
+		WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(slotRow(10, start.Add(30*time.Minute).Unix(), 3600, 0).
+			AddRow(11, 1, start.Add(2*time.Hour).Unix(), start.Add(3*time.Hour).Unix(), "online",
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now()))
+
+	resp, err := service.ImportCalendar(ctx, &availabilitypb.ImportCalendarRequest{
+		IcsData:         ics,
+		Timezone:        "UTC",
+		ReplaceExisting: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.ImportedCount)
+	assert.Equal(t, int32(3), resp.RemovedCount)
+	require.Len(t, resp.Exclusions, 1)
+	assert.Equal(t, "7", resp.Exclusions[0].ExclusionId)
+	require.Len(t, resp.ConflictingSlots, 1)
+	assert.Equal(t, "10", resp.ConflictingSlots[0].SlotId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportCalendar_RejectsInvalidCalendars(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	for _, ics := range []string{"", "not a calendar", strings.Repeat("x", maxCalendarImportBytes+1)} {
+		_, err := service.ImportCalendar(ctx, &availabilitypb.ImportCalendarRequest{IcsData: ics, Timezone: "UTC"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAvailability_Exclusion(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectExec("DELETE FROM datifyy_v2_availability_exclusions WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs(7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	resp, err := service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{ExclusionId: "7"})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	mock.ExpectExec("DELETE FROM datifyy_v2_availability_exclusions WHERE id = \\$1 AND user_id = \\$2").
+		WithArgs(8, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{ExclusionId: "8"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// expiredSlotArchiveBatchSize is the number of slots archived per statement
+	expiredSlotArchiveBatchSize = 500
+	// maxExpiredSlotArchiveBatches bounds the work of one job run; the rest is
+	// archived on the next run
+	maxExpiredSlotArchiveBatches = 20
+
+	// availabilityReminderBatchSize is the number of reminders sent per job run
+	availabilityReminderBatchSize = 100
+	// availabilityReminderLookback is how recently availability must have run
+	// out for a reminder, so long-inactive users aren't emailed
+	availabilityReminderLookback = 30 * 24 * time.Hour
+
+	// availabilityRunningLow is how close the end of a user's availability must
+	// be before they are prompted to add more
+	availabilityRunningLow = 7 * 24 * time.Hour
+)
+
+// ArchiveExpiredSlots moves slots that ended more than the retention period
+// ago out of the live table, in batches.
+// It is run periodically by the background job runner.
+func (s *AvailabilityService) ArchiveExpiredSlots(ctx context.Context) error {
+	cutoff := time.Now().Add(-s.slotRetention).Unix()
+
+	archived := 0
+	for i := 0; i < maxExpiredSlotArchiveBatches; i++ {
+		n, err := s.availabilityRepo.ArchiveExpiredSlots(ctx, cutoff, expiredSlotArchiveBatchSize)
+		if err != nil {
+			return err
+		}
+		archived += n
+		if n < expiredSlotArchiveBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if archived > 0 {
+		log.Printf("Archived %d expired availability slots", archived)
+	}
+
+	return nil
+}
+
+// SendAvailabilityReminders emails users whose availability has run out,
+// once per lapse, unless they've turned email notifications off.
+// It is run periodically by the background job runner.
+func (s *AvailabilityService) SendAvailabilityReminders(ctx context.Context) error {
+	if s.emailClient == nil {
+		return nil
+	}
+
+	now := time.Now()
+	recipients, err := s.availabilityRepo.GetAvailabilityReminderRecipients(ctx,
+		now.Add(-availabilityReminderLookback).Unix(), now.Unix(), availabilityReminderBatchSize)
+	if err != nil {
+		return err
+	}
+
+	sent := 0
+	for _, recipient := range recipients {
+		if err := s.emailClient.SendAvailabilityReminderEmail(recipient.Email, recipient.Name, s.availabilityURL); err != nil {
+			// Log and continue; the user is retried on the next run
+			log.Printf("Failed to send availability reminder to user %d: %v", recipient.UserID, err)
+			continue
+		}
+		if err := s.availabilityRepo.MarkAvailabilityReminderSent(ctx, recipient.UserID, recipient.LastAvailableAt, now); err != nil {
+			log.Printf("Failed to record availability reminder for user %d: %v", recipient.UserID, err)
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		log.Printf("Sent %d availability reminders", sent)
+	}
+
+	return nil
+}
+
+// GetAvailabilityStatus summarizes the user's upcoming availability and
+// whether they should be prompted to add more
+func (s *AvailabilityService) GetAvailabilityStatus(
+	ctx context.Context,
+	req *availabilitypb.GetAvailabilityStatusRequest,
+) (*availabilitypb.GetAvailabilityStatusResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	loc, err := s.requestLocation(ctx, req.Timezone, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	availability, err := s.availabilityRepo.GetAvailabilityStatus(ctx, userID, now.Unix())
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get availability status: %v", err))
+	}
+
+	resp := &availabilitypb.GetAvailabilityStatusResponse{
+		HasSubmitted:      availability.HasSubmitted,
+		OpenSlotCount:     int32(availability.OpenSlots),
+		ReservedSlotCount: int32(availability.ReservedSlots),
+		RuleCount:         int32(availability.RuleCount),
+		Timezone:          loc.String(),
+	}
+
+	switch {
+	case !availability.HasSubmitted:
+		resp.NeedsAvailability = true
+		resp.Message = "Add your availability so we can start setting up dates for you"
+	case availability.OpenSlots == 0:
+		resp.NeedsAvailability = true
+		resp.Message = "Your availability has run out. Add more to keep getting matches"
+	default:
+		resp.NextAvailableTime = availability.AvailableFrom
+		resp.AvailableUntil = availability.AvailableTo
+		resp.LocalNextAvailableTime = formatLocal(availability.AvailableFrom, loc)
+		resp.LocalAvailableUntil = formatLocal(availability.AvailableTo, loc)
+		if time.Unix(availability.AvailableTo, 0).Before(now.Add(availabilityRunningLow)) {
+			resp.NeedsAvailability = true
+			resp.Message = "Your availability runs out soon. Add more to keep getting matches"
+		} else {
+			resp.Message = fmt.Sprintf("You have %d open availability slots", availability.OpenSlots)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAvailabilityEmailSender records availability reminders, failing for
+// addresses in fail
+type mockAvailabilityEmailSender struct {
+	remindedTo []string
+	urls       []string
+	fail       map[string]bool
+}
+
+func (m *mockAvailabilityEmailSender) SendAvailabilityReminderEmail(to, name, availabilityURL string) error {
+	if m.fail[to] {
+		return errors.New("mailer unavailable")
+	}
+	m.remindedTo = append(m.remindedTo, to)
+	m.urls = append(m.urls, availabilityURL)
+	return nil
+}
+
+func TestArchiveExpiredSlots_RunsBatchesUntilDone(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	service.slotRetention = 30 * 24 * time.Hour
+
+	archiveQuery := "DELETE FROM datifyy_v2_availability_slots s\\s+USING expired(.|\\n)+INSERT INTO datifyy_v2_availability_slots_archive"
+	mock.ExpectExec(archiveQuery).
+		WithArgs(sqlmock.AnyArg(), expiredSlotArchiveBatchSize).
+		WillReturnResult(sqlmock.NewResult(0, int64(expiredSlotArchiveBatchSize)))
+	mock.ExpectExec(archiveQuery).
+		WithArgs(sqlmock.AnyArg(), expiredSlotArchiveBatchSize).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	require.NoError(t, service.ArchiveExpiredSlots(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSendAvailabilityReminders_RecordsOnlySentReminders(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	emails := &mockAvailabilityEmailSender{fail: map[string]bool{"bounced@example.com": true}}
+	service.emailClient = emails
+	service.availabilityURL = "https://datifyy.com/availability"
+
+	lastAvailable := time.Now().Add(-48 * time.Hour).Unix()
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots_archive(.|\\n)+LEFT JOIN user_preferences p(.|\\n)+COALESCE\\(p.email_enabled, TRUE\\)").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), availabilityReminderBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "name", "last_available_at"}).
+			AddRow(1, "priya@example.com", "Priya", lastAvailable).
+			AddRow(2, "bounced@example.com", "Rahul", lastAvailable))
+	mock.ExpectExec("INSERT INTO datifyy_v2_availability_reminders (.+) ON CONFLICT \\(user_id\\) DO UPDATE").
+		WithArgs(1, lastAvailable, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, service.SendAvailabilityReminders(context.Background()))
+	assert.Equal(t, []string{"priya@example.com"}, emails.remindedTo)
+	assert.Equal(t, []string{"https://datifyy.com/availability"}, emails.urls)
+	assert.NoError(t, mock.ExpectationsWereMet(), "the failed reminder is retried next run")
+}
+
+func TestGetAvailabilityStatus(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	statusColumns := []string{"open", "reserved", "available_from", "available_to", "rules", "has_submitted"}
+
+	// Never submitted anything
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots s").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(0, 0, nil, nil, 0, false))
+	resp, err := service.GetAvailabilityStatus(ctx, &availabilitypb.GetAvailabilityStatusRequest{Timezone: "UTC"})
+	require.NoError(t, err)
+	assert.False(t, resp.HasSubmitted)
+	assert.True(t, resp.NeedsAvailability)
+
+	// A rule counts as submitted even before its occurrences are expanded
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots s").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(0, 1, nil, nil, 1, false))
+	resp, err = service.GetAvailabilityStatus(ctx, &availabilitypb.GetAvailabilityStatusRequest{Timezone: "UTC"})
+	require.NoError(t, err)
+	assert.True(t, resp.HasSubmitted)
+	assert.True(t, resp.NeedsAvailability)
+	assert.Equal(t, int32(1), resp.ReservedSlotCount)
+
+	// Availability running out within the week
+	next := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	expectUserTimezone(mock, 1, "Asia/Kolkata")
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots s").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(2, 0, next.Unix(), next.Add(26*time.Hour).Unix(), 0, true))
+	resp, err = service.GetAvailabilityStatus(ctx, &availabilitypb.GetAvailabilityStatusRequest{})
+	require.NoError(t, err)
+	assert.True(t, resp.NeedsAvailability)
+	assert.Equal(t, int32(2), resp.OpenSlotCount)
+	assert.Equal(t, next.Unix(), resp.NextAvailableTime)
+	assert.Equal(t, "Asia/Kolkata", resp.Timezone)
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	assert.Equal(t, next.In(kolkata).Format("2006-01-02T15:04"), resp.LocalNextAvailableTime)
+
+	// Plenty of availability
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots s").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(statusColumns).AddRow(8, 1, next.Unix(), next.Add(20*24*time.Hour).Unix(), 1, true))
+	resp, err = service.GetAvailabilityStatus(ctx, &availabilitypb.GetAvailabilityStatusRequest{Timezone: "UTC"})
+	require.NoError(t, err)
+	assert.False(t, resp.NeedsAvailability)
+	assert.Equal(t, next.Add(20*24*time.Hour).Unix(), resp.AvailableUntil)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
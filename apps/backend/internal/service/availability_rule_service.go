@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	"github.com/datifyy/backend/internal/recurrence"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/timezone"
+)
+
+// availabilityRuleExpansionBatchSize is the number of rules expanded per job run
+const availabilityRuleExpansionBatchSize = 100
+
+// createRule validates a recurring rule and creates it together with its
+// occurrences in the rolling window. Rules without a timezone use
+// requestLoc's.
+func (s *AvailabilityService) createRule(ctx context.Context, userID int, ruleInput *availabilitypb.AvailabilityRuleInput, requestLoc *time.Location) (*repository.AvailabilityRule, []*repository.AvailabilitySlot, error) {
+	newRule, err := s.prepareRule(userID, ruleInput, requestLoc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.availabilityRepo.CreateRule(ctx, newRule.Input, newRule.Starts, newRule.ExpandedUntil)
+}
+
+// prepareRule validates a recurring rule and expands its occurrences in the
+// rolling window, ready to be created
+func (s *AvailabilityService) prepareRule(userID int, ruleInput *availabilitypb.AvailabilityRuleInput, requestLoc *time.Location) (repository.NewRule, error) {
+	rule, err := recurrence.Parse(ruleInput.Rrule)
+	if err != nil {
+		return repository.NewRule{}, err
+	}
+
+	loc := requestLoc
+	if ruleInput.Timezone != "" {
+		if loc, err = timezone.Load(ruleInput.Timezone); err != nil {
+			return repository.NewRule{}, err
+		}
+	}
+
+	startTime := ruleInput.StartTime
+	if ruleInput.LocalStartTime != "" {
+		start, err := timezone.ParseLocal(ruleInput.LocalStartTime, loc)
+		if err != nil {
+			return repository.NewRule{}, fmt.Errorf("local_start_time: %w", err)
+		}
+		startTime = start.Unix()
+	}
+	if startTime <= 0 {
+		return repository.NewRule{}, repository.ErrInvalidTimeRange
+	}
+
+	input := repository.CreateRuleInput{
+		CreateSlotInput: slotInputFromProto(userID, &availabilitypb.AvailabilitySlotInput{
+			StartTime:       startTime,
+			EndTime:         startTime + int64(ruleInput.DurationMinutes)*60,
+			DateType:        ruleInput.DateType,
+			OfflineLocation: ruleInput.OfflineLocation,
+			Notes:           ruleInput.Notes,
+		}),
+		RRule:    rule.String(),
+		Timezone: loc.String(),
+		ExDates:  ruleInput.Exdates,
+	}
+	if err := s.availabilityRepo.ValidateSlotDetails(input.CreateSlotInput); err != nil {
+		return repository.NewRule{}, err
+	}
+
+	now := time.Now()
+	expandUntil := now.Add(s.ruleWindow)
+	dtstart := time.Unix(input.StartTime, 0).In(loc)
+	starts := expandRuleOccurrences(rule, dtstart, input.ExDates, now.Add(repository.SlotLeadTime), expandUntil)
+
+	return repository.NewRule{Input: input, Starts: starts, ExpandedUntil: expandUntil.Unix()}, nil
+}
+
+// expandRuleOccurrences returns the occurrence starts in [from, to) as Unix timestamps
+func expandRuleOccurrences(rule *recurrence.Rule, dtstart time.Time, exdates []int64, from, to time.Time) []int64 {
+	excluded := make([]time.Time, len(exdates))
+	for i, exdate := range exdates {
+		excluded[i] = time.Unix(exdate, 0)
+	}
+
+	occurrences := rule.Expand(dtstart, from, to, excluded)
+	starts := make([]int64, len(occurrences))
+	for i, occurrence := range occurrences {
+		starts[i] = occurrence.Unix()
+	}
+	return starts
+}
+
+// ExpandAvailabilityRules materializes occurrences of recurring availability
+// rules as slots, keeping each rule expanded across the rolling window.
+// It is run periodically by the background job runner.
+func (s *AvailabilityService) ExpandAvailabilityRules(ctx context.Context) error {
+	now := time.Now()
+	expandUntil := now.Add(s.ruleWindow)
+
+	rules, err := s.availabilityRepo.GetRulesToExpand(ctx, expandUntil.Unix(), availabilityRuleExpansionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	created := 0
+	for _, rule := range rules {
+		slots, err := s.expandRule(ctx, rule, now, expandUntil)
+		if err != nil {
+			// Log and continue; the rule is retried on the next run
+			log.Printf("Failed to expand availability rule %d: %v", rule.ID, err)
+			continue
+		}
+		created += len(slots)
+	}
+
+	if created > 0 {
+		log.Printf("Expanded %d availability slots from %d rules", created, len(rules))
+	}
+
+	return nil
+}
+
+// expandRule adds the occurrences of rule between where it was last expanded
+// (but no sooner than the slot lead time) and expandUntil
+func (s *AvailabilityService) expandRule(ctx context.Context, rule *repository.AvailabilityRule, now, expandUntil time.Time) ([]*repository.AvailabilitySlot, error) {
+	parsed, err := recurrence.Parse(rule.RRule)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := timezone.Load(rule.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	from := now.Add(repository.SlotLeadTime)
+	if expanded := time.Unix(rule.ExpandedUntil, 0); expanded.After(from) {
+		from = expanded
+	}
+
+	var starts []int64
+	if !parsed.Ended(from) {
+		starts = expandRuleOccurrences(parsed, time.Unix(rule.DTStart, 0).In(loc), rule.ExDates, from, expandUntil)
+	}
+
+	return s.availabilityRepo.ExtendRule(ctx, rule, starts, expandUntil.Unix())
+}
+
+// unexpandedOccurrences returns, by rule ID, the starts of the user's rule
+// occurrences overlapping [from, to) that haven't been expanded into slots yet
+func (s *AvailabilityService) unexpandedOccurrences(ctx context.Context, userID int, from, to int64) (map[int][]int64, error) {
+	rules, err := s.availabilityRepo.GetRulesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make(map[int][]int64)
+	for _, rule := range rules {
+		if rule.ExpandedUntil >= to {
+			continue
+		}
+
+		parsed, err := recurrence.Parse(rule.RRule)
+		if err != nil {
+			return nil, err
+		}
+		loc, err := timezone.Load(rule.Timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		// Occurrences starting a little before from still overlap the range
+		start := time.Unix(from-int64(rule.DurationSeconds)+1, 0)
+		if expanded := time.Unix(rule.ExpandedUntil, 0); expanded.After(start) {
+			start = expanded
+		}
+		if parsed.Ended(start) {
+			continue
+		}
+
+		starts := expandRuleOccurrences(parsed, time.Unix(rule.DTStart, 0).In(loc), rule.ExDates, start, time.Unix(to, 0))
+		if len(starts) > 0 {
+			occurrences[rule.ID] = starts
+		}
+	}
+
+	return occurrences, nil
+}
+
+// Helper function to convert repository rule to proto
+func convertRuleToProto(rule *repository.AvailabilityRule) *availabilitypb.AvailabilityRule {
+	pbRule := &availabilitypb.AvailabilityRule{
+		RuleId:          strconv.Itoa(rule.ID),
+		UserId:          strconv.Itoa(rule.UserID),
+		Rrule:           rule.RRule,
+		StartTime:       rule.DTStart,
+		DurationMinutes: int32(rule.DurationSeconds / 60),
+		Timezone:        rule.Timezone,
+		Exdates:         rule.ExDates,
+		DateType:        stringToDateType(rule.DateType),
+		Notes:           rule.Notes.String,
+		ExpandedUntil:   rule.ExpandedUntil,
+		CreatedAt: &commonpb.Timestamp{
+			Seconds: rule.CreatedAt.Unix(),
+		},
+		UpdatedAt: &commonpb.Timestamp{
+			Seconds: rule.UpdatedAt.Unix(),
+		},
+	}
+
+	// Add offline location if present
+	if rule.PlaceName.Valid || rule.Address.Valid {
+		pbRule.OfflineLocation = &availabilitypb.OfflineLocation{
+			PlaceName:     rule.PlaceName.String,
+			Address:       rule.Address.String,
+			City:          rule.City.String,
+			State:         rule.State.String,
+			Country:       rule.Country.String,
+			Zipcode:       rule.Zipcode.String,
+			Latitude:      rule.Latitude.Float64,
+			Longitude:     rule.Longitude.Float64,
+			GooglePlaceId: rule.GooglePlaceID.String,
+			GoogleMapsUrl: rule.GoogleMapsURL.String,
+		}
+	}
+
+	return pbRule
+}
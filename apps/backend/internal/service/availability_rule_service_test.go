@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestAvailabilityService(t *testing.T) (*AvailabilityService, sqlmock.Sqlmock, *sql.DB) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	service := NewAvailabilityService(db, nil)
+	service.ruleWindow = 14 * 24 * time.Hour
+	return service, mock, db
+}
+
+var availabilityRuleColumns = []string{
+	"id", "user_id", "rrule", "dtstart", "duration_seconds", "timezone", "exdates", "date_type",
+	"place_name", "address", "city", "state", "country", "zipcode",
+	"latitude", "longitude", "google_place_id", "google_maps_url", "notes",
+	"expanded_until", "created_at", "updated_at",
+}
+
+var availabilitySlotColumns = []string{
+	"id", "user_id", "start_time", "end_time", "date_type",
+	"place_name", "address", "city", "state", "country", "zipcode",
+	"latitude", "longitude", "google_place_id", "google_maps_url", "notes",
+	"rule_id", "reserved_date_id", "created_at", "updated_at",
+}
+
+func slotRow(id int, start int64, duration int64, ruleID int) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows(availabilitySlotColumns).AddRow(
+		id, 1, start, start+duration, "online",
+		nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		ruleID, nil, now, now)
+}
+
+func TestSubmitAvailability_RuleValidation(t *testing.T) {
+	start := time.Now().Add(72 * time.Hour).Unix()
+
+	tests := []struct {
+		name string
+		rule *availabilitypb.AvailabilityRuleInput
+	}{
+		{"unsupported rrule", &availabilitypb.AvailabilityRuleInput{Rrule: "FREQ=YEARLY", StartTime: start, DurationMinutes: 60}},
+		{"unknown timezone", &availabilitypb.AvailabilityRuleInput{Rrule: "FREQ=WEEKLY", StartTime: start, DurationMinutes: 60, Timezone: "Mars/Olympus"}},
+		{"missing start", &availabilitypb.AvailabilityRuleInput{Rrule: "FREQ=WEEKLY", DurationMinutes: 60}},
+		{"too short", &availabilitypb.AvailabilityRuleInput{Rrule: "FREQ=WEEKLY", StartTime: start, DurationMinutes: 15}},
+		{"too long", &availabilitypb.AvailabilityRuleInput{Rrule: "FREQ=WEEKLY", StartTime: start, DurationMinutes: 13 * 60}},
+		{"offline without location", &availabilitypb.AvailabilityRuleInput{
+			Rrule: "FREQ=WEEKLY", StartTime: start, DurationMinutes: 90, DateType: availabilitypb.DateType_DATE_TYPE_OFFLINE,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mock, db := setupTestAvailabilityService(t)
+			defer db.Close()
+
+			expectUserTimezone(mock, 1, "UTC")
+
+			ctx := context.WithValue(context.Background(), "userID", 1)
+			resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+				Rules: []*availabilitypb.AvailabilityRuleInput{tt.rule},
+			})
+
+			require.NoError(t, err)
+			assert.Empty(t, resp.CreatedRules)
+			assert.Contains(t, resp.RuleValidationErrors, int32(0))
+			// Nothing is written
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSubmitAvailability_CreatesRuleWithOccurrences(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	// A weekly 90 minute slot starting in two days; the 14 day window holds two
+	// occurrences, the second of which overlaps an existing slot
+	dtstart := time.Now().Add(48 * time.Hour).Truncate(time.Hour).UTC()
+	second := dtstart.AddDate(0, 0, 7).Unix()
+	now := time.Now()
+
+	expectUserTimezone(mock, 1, "UTC")
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_rules").
+		WithArgs(1, "FREQ=WEEKLY", dtstart.Unix(), int64(5400), "UTC", "{}", "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "Coffee?", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(availabilityRuleColumns).AddRow(
+			7, 1, "FREQ=WEEKLY", dtstart.Unix(), 5400, "UTC", "{}", "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "Coffee?",
+			now.Add(14*24*time.Hour).Unix(), now, now))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots (.+) WHERE NOT EXISTS").
+		WithArgs(1, dtstart.Unix(), dtstart.Unix()+5400, "online",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 7).
+		WillReturnRows(slotRow(100, dtstart.Unix(), 5400, 7))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots (.+) WHERE NOT EXISTS").
+		WithArgs(1, second, second+5400, "online",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 7).
+		WillReturnRows(sqlmock.NewRows(availabilitySlotColumns))
+	mock.ExpectCommit()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+		Rules: []*availabilitypb.AvailabilityRuleInput{{
+			Rrule:           "RRULE:FREQ=WEEKLY",
+			StartTime:       dtstart.Unix(),
+			DurationMinutes: 90,
+			Notes:           "Coffee?",
+		}},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.CreatedRules, 1)
+	assert.Equal(t, "7", resp.CreatedRules[0].RuleId)
+	assert.Equal(t, int32(90), resp.CreatedRules[0].DurationMinutes)
+	require.Len(t, resp.ExpandedSlots, 1)
+	assert.Equal(t, "7", resp.ExpandedSlots[0].RuleId)
+	assert.Empty(t, resp.RuleValidationErrors)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpandAvailabilityRules_ContinuesFromExpandedUntil(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	// A daily rule expanded up to 13 days out gets one more occurrence, less
+	// the excluded one
+	dtstart := time.Now().Add(-30*24*time.Hour - 30*time.Minute).Truncate(time.Hour).UTC()
+	expandedUntil := dtstart.AddDate(0, 0, 43)
+	excluded := expandedUntil.Unix()
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_availability_rules WHERE expanded_until < \\$1 AND user_id IN \\(SELECT id FROM datifyy_v2_users WHERE account_status = 'ACTIVE'\\)").
+		WillReturnRows(sqlmock.NewRows(availabilityRuleColumns).AddRow(
+			3, 1, "FREQ=DAILY", dtstart.Unix(), 3600, "UTC", fmt.Sprintf("{%d}", excluded), "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			expandedUntil.Unix(), now, now))
+
+	next := dtstart.AddDate(0, 0, 44).Unix()
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots").
+		WithArgs(1, next, next+3600, "online",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 3).
+		WillReturnRows(slotRow(200, next, 3600, 3))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_rules SET expanded_until").
+		WithArgs(3, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, service.ExpandAvailabilityRules(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubmitAvailability_LocalTimesInUserTimezone(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+
+	// Next year's spring-forward day, when 02:30 doesn't exist in New York
+	year := time.Now().Year() + 1
+	springForward := time.Date(year, 3, 1, 0, 0, 0, 0, ny)
+	for springForward.Weekday() != time.Sunday {
+		springForward = springForward.AddDate(0, 0, 1)
+	}
+	springForward = springForward.AddDate(0, 0, 7) // second Sunday of March
+	day := springForward.Format("2006-01-02")
+	start := time.Date(year, 3, springForward.Day(), 19, 0, 0, 0, ny).Unix()
+
+	expectUserTimezone(mock, 1, "America/New_York")
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, start, start+5400).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots").
+		WithArgs(1, start, start+5400, "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).
+		WillReturnRows(slotRow(1, start, 5400, 0).RowError(0, nil))
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+		Slots: []*availabilitypb.AvailabilitySlotInput{
+			{LocalStartTime: day + "T19:00", LocalEndTime: day + "T20:30"},
+			{LocalStartTime: day + "T02:30", LocalEndTime: day + "T04:00"},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.CreatedSlots, 1)
+	assert.Equal(t, start, resp.CreatedSlots[0].StartTime)
+	assert.Equal(t, day+"T19:00", resp.CreatedSlots[0].LocalStartTime)
+	assert.Contains(t, resp.ValidationErrors[1], "daylight saving")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
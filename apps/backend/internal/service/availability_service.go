@@ -3,28 +3,67 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"time"
 
 	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
 	commonpb "github.com/datifyy/backend/gen/common/v1"
 	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/timezone"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxClearAvailabilityRange is the longest range ClearAvailability accepts
+const maxClearAvailabilityRange = 366 * 24 * time.Hour
+
 // AvailabilityService handles availability operations
 type AvailabilityService struct {
 	availabilitypb.UnimplementedAvailabilityServiceServer
-	availabilityRepo *repository.AvailabilityRepository
-	db               *sql.DB
+	availabilityRepo   *repository.AvailabilityRepository
+	profileRepo        *repository.UserProfileRepository
+	userRepo           *repository.UserRepository
+	feedRepo           *repository.CalendarFeedRepository
+	scheduledDatesRepo *repository.ScheduledDatesRepository
+	emailClient        AvailabilityEmailSender
+	db                 *sql.DB
+
+	// ruleWindow is how far ahead occurrences of recurring rules are expanded into slots
+	ruleWindow time.Duration
+
+	// publicBaseURL is the API's public address, used to build calendar feed URLs
+	publicBaseURL string
+
+	// slotRetention is how long after they end slots stay in the live table
+	// before being archived
+	slotRetention time.Duration
+
+	// availabilityURL is the app page linked from availability reminders
+	availabilityURL string
+}
+
+// AvailabilityEmailSender interface for sending availability emails to users
+type AvailabilityEmailSender interface {
+	SendAvailabilityReminderEmail(to, name, availabilityURL string) error
 }
 
 // NewAvailabilityService creates a new availability service
-func NewAvailabilityService(db *sql.DB) *AvailabilityService {
+func NewAvailabilityService(db *sql.DB, emailClient AvailabilityEmailSender) *AvailabilityService {
 	return &AvailabilityService{
-		availabilityRepo: repository.NewAvailabilityRepository(db),
-		db:               db,
+		availabilityRepo:   repository.NewAvailabilityRepository(db),
+		profileRepo:        repository.NewUserProfileRepository(db),
+		userRepo:           repository.NewUserRepository(db),
+		feedRepo:           repository.NewCalendarFeedRepository(db),
+		scheduledDatesRepo: repository.NewScheduledDatesRepository(db),
+		emailClient:        emailClient,
+		db:                 db,
+		ruleWindow:         time.Duration(getEnvIntOrDefault("AVAILABILITY_RULE_WINDOW_DAYS", 56)) * 24 * time.Hour,
+		publicBaseURL:      getEnvOrDefault("PUBLIC_API_URL", "http://localhost:8080"),
+		slotRetention:      time.Duration(getEnvIntOrDefault("AVAILABILITY_SLOT_RETENTION_DAYS", 30)) * 24 * time.Hour,
+		availabilityURL:    getEnvOrDefault("APP_URL", "http://localhost:3000") + "/availability",
 	}
 }
 
@@ -50,16 +89,50 @@ func (s *AvailabilityService) GetAvailability(
 		}
 	}
 
+	// Render local times for whoever is asking, falling back to the slots' owner
+	viewerID := userID
+	if callerID, err := getUserIDFromContext(ctx); err == nil {
+		viewerID = callerID
+	}
+	loc, err := s.requestLocation(ctx, req.Timezone, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get slots from repository
 	slots, err := s.availabilityRepo.GetByUserID(ctx, userID, req.FromTime, req.ToTime)
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get availability: %v", err))
 	}
 
+	rules, err := s.availabilityRepo.GetRulesByUserID(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get availability rules: %v", err))
+	}
+
+	// Exclusions are only meaningful where they can still block a date
+	exclusionsFrom := req.FromTime
+	if now := time.Now().Unix(); exclusionsFrom < now {
+		exclusionsFrom = now
+	}
+	exclusionsTo := req.ToTime
+	if exclusionsTo <= 0 {
+		exclusionsTo = math.MaxInt64
+	}
+	exclusions, err := s.availabilityRepo.GetExclusions(ctx, userID, exclusionsFrom, exclusionsTo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get availability exclusions: %v", err))
+	}
+
 	// Convert to proto
 	pbSlots := make([]*availabilitypb.AvailabilitySlot, len(slots))
 	for i, slot := range slots {
-		pbSlots[i] = convertSlotToProto(slot)
+		pbSlots[i] = convertSlotToProto(slot, loc)
+	}
+
+	pbRules := make([]*availabilitypb.AvailabilityRule, len(rules))
+	for i, rule := range rules {
+		pbRules[i] = convertRuleToProto(rule)
 	}
 
 	return &availabilitypb.GetAvailabilityResponse{
@@ -67,10 +140,14 @@ func (s *AvailabilityService) GetAvailability(
 		Pagination: &commonpb.PaginationResponse{
 			TotalCount: int64(len(pbSlots)),
 		},
+		Rules:      pbRules,
+		Timezone:   loc.String(),
+		Exclusions: convertExclusionsToProto(exclusions, loc),
 	}, nil
 }
 
-// SubmitAvailability creates multiple availability slots
+// SubmitAvailability creates multiple availability slots and recurring rules.
+// Each rule's occurrences in the rolling window are created as slots right away.
 func (s *AvailabilityService) SubmitAvailability(
 	ctx context.Context,
 	req *availabilitypb.SubmitAvailabilityRequest,
@@ -81,38 +158,32 @@ func (s *AvailabilityService) SubmitAvailability(
 		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	if len(req.Slots) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "at least one slot is required")
+	if len(req.Slots) == 0 && len(req.Rules) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one slot or rule is required")
+	}
+
+	loc, err := s.requestLocation(ctx, req.Timezone, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Mode == availabilitypb.SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING {
+		return s.submitAllOrNothing(ctx, userID, req, loc)
 	}
 
 	var createdSlots []*availabilitypb.AvailabilitySlot
 	validationErrors := make(map[int32]string)
+	slotResults := make([]*availabilitypb.SubmitItemResult, len(req.Slots))
 
 	for i, slotInput := range req.Slots {
-		// Convert date type enum to string
-		dateTypeStr := dateTypeToString(slotInput.DateType)
-
-		// Build input
-		input := repository.CreateSlotInput{
-			UserID:    userID,
-			StartTime: slotInput.StartTime,
-			EndTime:   slotInput.EndTime,
-			DateType:  dateTypeStr,
-			Notes:     slotInput.Notes,
-		}
-
-		// Add offline location if provided
-		if slotInput.OfflineLocation != nil {
-			input.PlaceName = slotInput.OfflineLocation.PlaceName
-			input.Address = slotInput.OfflineLocation.Address
-			input.City = slotInput.OfflineLocation.City
-			input.State = slotInput.OfflineLocation.State
-			input.Country = slotInput.OfflineLocation.Country
-			input.Zipcode = slotInput.OfflineLocation.Zipcode
-			input.Latitude = slotInput.OfflineLocation.Latitude
-			input.Longitude = slotInput.OfflineLocation.Longitude
-			input.GooglePlaceID = slotInput.OfflineLocation.GooglePlaceId
-			input.GoogleMapsURL = slotInput.OfflineLocation.GoogleMapsUrl
+		slotResults[i] = &availabilitypb.SubmitItemResult{Index: int32(i)}
+
+		input := slotInputFromProto(userID, slotInput)
+		input.StartTime, input.EndTime, err = resolveSlotTimes(slotInput, loc)
+		if err != nil {
+			validationErrors[int32(i)] = err.Error()
+			slotResults[i].Error = err.Error()
+			continue
 		}
 
 		// Create slot
@@ -120,26 +191,292 @@ func (s *AvailabilityService) SubmitAvailability(
 		if err != nil {
 			// Record validation error but continue with other slots
 			validationErrors[int32(i)] = err.Error()
+			slotResults[i].Error = err.Error()
 			continue
 		}
 
-		createdSlots = append(createdSlots, convertSlotToProto(slot))
+		pbSlot := convertSlotToProto(slot, loc)
+		createdSlots = append(createdSlots, pbSlot)
+		slotResults[i].Created = true
+		slotResults[i].Slot = pbSlot
 	}
 
-	message := fmt.Sprintf("Successfully created %d out of %d slots", len(createdSlots), len(req.Slots))
-	if len(validationErrors) > 0 {
-		message += fmt.Sprintf(", %d failed", len(validationErrors))
+	var createdRules []*availabilitypb.AvailabilityRule
+	var expandedSlots []*availabilitypb.AvailabilitySlot
+	ruleValidationErrors := make(map[int32]string)
+	ruleResults := make([]*availabilitypb.SubmitItemResult, len(req.Rules))
+
+	for i, ruleInput := range req.Rules {
+		ruleResults[i] = &availabilitypb.SubmitItemResult{Index: int32(i)}
+
+		rule, slots, err := s.createRule(ctx, userID, ruleInput, loc)
+		if err != nil {
+			// Record validation error but continue with other rules
+			ruleValidationErrors[int32(i)] = err.Error()
+			ruleResults[i].Error = err.Error()
+			continue
+		}
+
+		pbRule := convertRuleToProto(rule)
+		createdRules = append(createdRules, pbRule)
+		ruleResults[i].Created = true
+		ruleResults[i].Rule = pbRule
+		for _, slot := range slots {
+			expandedSlots = append(expandedSlots, convertSlotToProto(slot, loc))
+		}
 	}
 
 	return &availabilitypb.SubmitAvailabilityResponse{
-		CreatedSlots:     createdSlots,
-		CreatedCount:     int32(len(createdSlots)),
-		ValidationErrors: validationErrors,
-		Message:          message,
+		CreatedSlots:         createdSlots,
+		CreatedCount:         int32(len(createdSlots)),
+		ValidationErrors:     validationErrors,
+		Message:              submitAvailabilityMessage(req, len(createdSlots), len(validationErrors), len(createdRules), len(ruleValidationErrors)),
+		CreatedRules:         createdRules,
+		ExpandedSlots:        expandedSlots,
+		RuleValidationErrors: ruleValidationErrors,
+		SlotResults:          slotResults,
+		RuleResults:          ruleResults,
+	}, nil
+}
+
+// submitAllOrNothing creates every slot and rule of a submission in a single
+// transaction, or none of them if any is invalid or can't be created
+func (s *AvailabilityService) submitAllOrNothing(
+	ctx context.Context,
+	userID int,
+	req *availabilitypb.SubmitAvailabilityRequest,
+	loc *time.Location,
+) (*availabilitypb.SubmitAvailabilityResponse, error) {
+	slotInputs := make([]repository.CreateSlotInput, len(req.Slots))
+	validationErrors := make(map[int32]string)
+
+	for i, slotInput := range req.Slots {
+		input := slotInputFromProto(userID, slotInput)
+		var err error
+		input.StartTime, input.EndTime, err = resolveSlotTimes(slotInput, loc)
+		if err == nil {
+			err = s.availabilityRepo.ValidateSlot(input)
+		}
+		if err != nil {
+			validationErrors[int32(i)] = err.Error()
+			continue
+		}
+		slotInputs[i] = input
+	}
+
+	newRules := make([]repository.NewRule, len(req.Rules))
+	ruleValidationErrors := make(map[int32]string)
+
+	for i, ruleInput := range req.Rules {
+		newRule, err := s.prepareRule(userID, ruleInput, loc)
+		if err != nil {
+			ruleValidationErrors[int32(i)] = err.Error()
+			continue
+		}
+		newRules[i] = newRule
+	}
+
+	if len(validationErrors) == 0 && len(ruleValidationErrors) == 0 {
+		slots, rules, occurrences, err := s.availabilityRepo.CreateBatch(ctx, slotInputs, newRules)
+
+		var batchErr *repository.SlotBatchError
+		if errors.As(err, &batchErr) {
+			validationErrors[int32(batchErr.Index)] = batchErr.Err.Error()
+		} else if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to submit availability: %v", err))
+		} else {
+			createdSlots := make([]*availabilitypb.AvailabilitySlot, len(slots))
+			slotResults := make([]*availabilitypb.SubmitItemResult, len(slots))
+			for i, slot := range slots {
+				createdSlots[i] = convertSlotToProto(slot, loc)
+				slotResults[i] = &availabilitypb.SubmitItemResult{Index: int32(i), Created: true, Slot: createdSlots[i]}
+			}
+
+			createdRules := make([]*availabilitypb.AvailabilityRule, len(rules))
+			ruleResults := make([]*availabilitypb.SubmitItemResult, len(rules))
+			var expandedSlots []*availabilitypb.AvailabilitySlot
+			for i, rule := range rules {
+				createdRules[i] = convertRuleToProto(rule)
+				ruleResults[i] = &availabilitypb.SubmitItemResult{Index: int32(i), Created: true, Rule: createdRules[i]}
+				for _, slot := range occurrences[i] {
+					expandedSlots = append(expandedSlots, convertSlotToProto(slot, loc))
+				}
+			}
+
+			return &availabilitypb.SubmitAvailabilityResponse{
+				CreatedSlots:         createdSlots,
+				CreatedCount:         int32(len(createdSlots)),
+				ValidationErrors:     validationErrors,
+				Message:              submitAvailabilityMessage(req, len(createdSlots), 0, len(createdRules), 0),
+				CreatedRules:         createdRules,
+				ExpandedSlots:        expandedSlots,
+				RuleValidationErrors: ruleValidationErrors,
+				SlotResults:          slotResults,
+				RuleResults:          ruleResults,
+			}, nil
+		}
+	}
+
+	return &availabilitypb.SubmitAvailabilityResponse{
+		ValidationErrors:     validationErrors,
+		RuleValidationErrors: ruleValidationErrors,
+		SlotResults:          rolledBackResults(len(req.Slots), validationErrors),
+		RuleResults:          rolledBackResults(len(req.Rules), ruleValidationErrors),
+		RolledBack:           true,
+		Message: fmt.Sprintf("Nothing created: %d out of %d slots and %d out of %d rules failed",
+			len(validationErrors), len(req.Slots), len(ruleValidationErrors), len(req.Rules)),
+	}, nil
+}
+
+// rolledBackResults returns the results of a rejected all-or-nothing
+// submission's items: their own errors, or that another item failed
+func rolledBackResults(count int, errs map[int32]string) []*availabilitypb.SubmitItemResult {
+	results := make([]*availabilitypb.SubmitItemResult, count)
+	for i := range results {
+		msg, failed := errs[int32(i)]
+		if !failed {
+			msg = "not created: another item in the request failed"
+		}
+		results[i] = &availabilitypb.SubmitItemResult{Index: int32(i), Error: msg}
+	}
+	return results
+}
+
+// submitAvailabilityMessage summarizes how many of a submission's slots and
+// rules were created
+func submitAvailabilityMessage(req *availabilitypb.SubmitAvailabilityRequest, createdSlots, failedSlots, createdRules, failedRules int) string {
+	message := fmt.Sprintf("Successfully created %d out of %d slots", createdSlots, len(req.Slots))
+	if failedSlots > 0 {
+		message += fmt.Sprintf(", %d failed", failedSlots)
+	}
+	if len(req.Rules) > 0 {
+		message += fmt.Sprintf("; %d out of %d rules", createdRules, len(req.Rules))
+		if failedRules > 0 {
+			message += fmt.Sprintf(", %d failed", failedRules)
+		}
+	}
+	return message
+}
+
+// UpdateAvailability moves or edits one of the user's slots. Slots reserved
+// by a scheduled date can't be changed.
+func (s *AvailabilityService) UpdateAvailability(
+	ctx context.Context,
+	req *availabilitypb.UpdateAvailabilityRequest,
+) (*availabilitypb.UpdateAvailabilityResponse, error) {
+	// Get user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	slotID, err := strconv.Atoi(req.SlotId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid slot_id format")
+	}
+
+	if req.Slot == nil {
+		return nil, status.Error(codes.InvalidArgument, "slot is required")
+	}
+
+	loc, err := s.requestLocation(ctx, req.Timezone, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := slotInputFromProto(userID, req.Slot)
+	input.StartTime, input.EndTime, err = resolveSlotTimes(req.Slot, loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slot, err := s.availabilityRepo.Update(ctx, slotID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrSlotNotFound):
+			return nil, status.Error(codes.NotFound, "slot not found or not owned by user")
+		case errors.Is(err, repository.ErrSlotLocked):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, repository.ErrOverlappingSlot):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, repository.ErrDatabaseError):
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update slot: %v", err))
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	return &availabilitypb.UpdateAvailabilityResponse{
+		Slot:    convertSlotToProto(slot, loc),
+		Message: "Slot updated successfully",
 	}, nil
 }
 
-// DeleteAvailability deletes an availability slot
+// ClearAvailability deletes the user's unreserved slots in a time range, such
+// as a vacation, and stops recurring rules from refilling it
+func (s *AvailabilityService) ClearAvailability(
+	ctx context.Context,
+	req *availabilitypb.ClearAvailabilityRequest,
+) (*availabilitypb.ClearAvailabilityResponse, error) {
+	// Get user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	loc, err := s.requestLocation(ctx, req.Timezone, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := req.FromTime, req.ToTime
+	if req.LocalFromTime != "" || req.LocalToTime != "" {
+		fromTime, err := timezone.ParseLocal(req.LocalFromTime, loc)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("local_from_time: %v", err))
+		}
+		toTime, err := timezone.ParseLocal(req.LocalToTime, loc)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("local_to_time: %v", err))
+		}
+		from, to = fromTime.Unix(), toTime.Unix()
+	}
+
+	if from <= 0 || to <= from {
+		return nil, status.Error(codes.InvalidArgument, "to_time must be after from_time")
+	}
+	if time.Duration(to-from)*time.Second > maxClearAvailabilityRange {
+		return nil, status.Error(codes.InvalidArgument, "range can't be longer than a year")
+	}
+
+	ruleExdates, err := s.unexpandedOccurrences(ctx, userID, from, to)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to clear availability: %v", err))
+	}
+
+	deleted, reserved, err := s.availabilityRepo.ClearRange(ctx, userID, from, to, ruleExdates)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to clear availability: %v", err))
+	}
+
+	pbReserved := make([]*availabilitypb.AvailabilitySlot, len(reserved))
+	for i, slot := range reserved {
+		pbReserved[i] = convertSlotToProto(slot, loc)
+	}
+
+	message := fmt.Sprintf("Cleared %d slots", deleted)
+	if len(reserved) > 0 {
+		message += fmt.Sprintf("; kept %d reserved by scheduled dates", len(reserved))
+	}
+
+	return &availabilitypb.ClearAvailabilityResponse{
+		DeletedCount:  int32(deleted),
+		ReservedSlots: pbReserved,
+		Message:       message,
+	}, nil
+}
+
+// DeleteAvailability deletes an availability slot, or a recurring rule and its upcoming occurrences
 func (s *AvailabilityService) DeleteAvailability(
 	ctx context.Context,
 	req *availabilitypb.DeleteAvailabilityRequest,
@@ -150,6 +487,13 @@ func (s *AvailabilityService) DeleteAvailability(
 		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
+	if req.RuleId != "" {
+		return s.deleteRule(ctx, req.RuleId, userID)
+	}
+	if req.ExclusionId != "" {
+		return s.deleteExclusion(ctx, req.ExclusionId, userID)
+	}
+
 	// Parse slot ID
 	slotID, err := strconv.Atoi(req.SlotId)
 	if err != nil {
@@ -159,10 +503,14 @@ func (s *AvailabilityService) DeleteAvailability(
 	// Delete slot
 	err = s.availabilityRepo.Delete(ctx, slotID, userID)
 	if err != nil {
-		if err == repository.ErrSlotNotFound {
+		switch {
+		case errors.Is(err, repository.ErrSlotNotFound):
 			return nil, status.Error(codes.NotFound, "slot not found or not owned by user")
+		case errors.Is(err, repository.ErrSlotLocked):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete slot: %v", err))
 		}
-		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete slot: %v", err))
 	}
 
 	return &availabilitypb.DeleteAvailabilityResponse{
@@ -171,6 +519,88 @@ func (s *AvailabilityService) DeleteAvailability(
 	}, nil
 }
 
+// deleteRule deletes a recurring rule and its upcoming occurrences that
+// aren't reserved by a scheduled date
+func (s *AvailabilityService) deleteRule(ctx context.Context, ruleIDStr string, userID int) (*availabilitypb.DeleteAvailabilityResponse, error) {
+	ruleID, err := strconv.Atoi(ruleIDStr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rule_id format")
+	}
+
+	err = s.availabilityRepo.DeleteRule(ctx, ruleID, userID)
+	if err != nil {
+		if err == repository.ErrRuleNotFound {
+			return nil, status.Error(codes.NotFound, "rule not found or not owned by user")
+		}
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to delete rule: %v", err))
+	}
+
+	return &availabilitypb.DeleteAvailabilityResponse{
+		Success: true,
+		Message: "Rule deleted successfully",
+	}, nil
+}
+
+// requestLocation returns the timezone a request's local times are in: the one
+// it names, or else the user's timezone preference
+func (s *AvailabilityService) requestLocation(ctx context.Context, name string, userID int) (*time.Location, error) {
+	if name == "" {
+		return userLocation(ctx, s.profileRepo, userID), nil
+	}
+
+	loc, err := timezone.Load(name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return loc, nil
+}
+
+// resolveSlotTimes returns a slot input's start and end, converting its local
+// wall-clock times in loc when they're given
+func resolveSlotTimes(slotInput *availabilitypb.AvailabilitySlotInput, loc *time.Location) (int64, int64, error) {
+	if slotInput.LocalStartTime == "" && slotInput.LocalEndTime == "" {
+		return slotInput.StartTime, slotInput.EndTime, nil
+	}
+
+	start, err := timezone.ParseLocal(slotInput.LocalStartTime, loc)
+	if err != nil {
+		return 0, 0, fmt.Errorf("local_start_time: %w", err)
+	}
+	end, err := timezone.ParseLocal(slotInput.LocalEndTime, loc)
+	if err != nil {
+		return 0, 0, fmt.Errorf("local_end_time: %w", err)
+	}
+
+	return start.Unix(), end.Unix(), nil
+}
+
+// Helper function to build repository slot input from proto
+func slotInputFromProto(userID int, slotInput *availabilitypb.AvailabilitySlotInput) repository.CreateSlotInput {
+	input := repository.CreateSlotInput{
+		UserID:    userID,
+		StartTime: slotInput.StartTime,
+		EndTime:   slotInput.EndTime,
+		DateType:  dateTypeToString(slotInput.DateType),
+		Notes:     slotInput.Notes,
+	}
+
+	// Add offline location if provided
+	if slotInput.OfflineLocation != nil {
+		input.PlaceName = slotInput.OfflineLocation.PlaceName
+		input.Address = slotInput.OfflineLocation.Address
+		input.City = slotInput.OfflineLocation.City
+		input.State = slotInput.OfflineLocation.State
+		input.Country = slotInput.OfflineLocation.Country
+		input.Zipcode = slotInput.OfflineLocation.Zipcode
+		input.Latitude = slotInput.OfflineLocation.Latitude
+		input.Longitude = slotInput.OfflineLocation.Longitude
+		input.GooglePlaceID = slotInput.OfflineLocation.GooglePlaceId
+		input.GoogleMapsURL = slotInput.OfflineLocation.GoogleMapsUrl
+	}
+
+	return input
+}
+
 // Helper function to convert date type enum to string
 func dateTypeToString(dt availabilitypb.DateType) string {
 	switch dt {
@@ -199,14 +629,16 @@ func stringToDateType(s string) availabilitypb.DateType {
 	}
 }
 
-// Helper function to convert repository slot to proto
-func convertSlotToProto(slot *repository.AvailabilitySlot) *availabilitypb.AvailabilitySlot {
+// Helper function to convert repository slot to proto, with local times in loc
+func convertSlotToProto(slot *repository.AvailabilitySlot, loc *time.Location) *availabilitypb.AvailabilitySlot {
 	pbSlot := &availabilitypb.AvailabilitySlot{
-		SlotId:    strconv.Itoa(slot.ID),
-		UserId:    strconv.Itoa(slot.UserID),
-		StartTime: slot.StartTime,
-		EndTime:   slot.EndTime,
-		DateType:  stringToDateType(slot.DateType),
+		SlotId:         strconv.Itoa(slot.ID),
+		UserId:         strconv.Itoa(slot.UserID),
+		StartTime:      slot.StartTime,
+		EndTime:        slot.EndTime,
+		DateType:       stringToDateType(slot.DateType),
+		LocalStartTime: formatLocal(slot.StartTime, loc),
+		LocalEndTime:   formatLocal(slot.EndTime, loc),
 		CreatedAt: &commonpb.Timestamp{
 			Seconds: slot.CreatedAt.Unix(),
 		},
@@ -220,6 +652,12 @@ func convertSlotToProto(slot *repository.AvailabilitySlot) *availabilitypb.Avail
 		pbSlot.Notes = slot.Notes.String
 	}
 
+	if slot.RuleID.Valid {
+		pbSlot.RuleId = strconv.FormatInt(slot.RuleID.Int64, 10)
+	}
+
+	pbSlot.Reserved = slot.ReservedDateID.Valid
+
 	// Add offline location if present
 	if slot.PlaceName.Valid || slot.Address.Valid {
 		pbSlot.OfflineLocation = &availabilitypb.OfflineLocation{
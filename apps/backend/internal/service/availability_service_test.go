@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	availabilitypb "github.com/datifyy/backend/gen/availability/v1"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubmitAvailability_BestEffortReportsEachSlot(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	start := time.Now().Add(72 * time.Hour).Truncate(time.Hour).Unix()
+
+	expectUserTimezone(mock, 1, "UTC")
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, start, start+3600).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots").
+		WillReturnRows(slotRow(10, start, 3600, 0))
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+		Slots: []*availabilitypb.AvailabilitySlotInput{
+			{StartTime: start, EndTime: start + 3600},
+			{StartTime: start + 7200, EndTime: start + 7200 + 600},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), resp.CreatedCount)
+	assert.False(t, resp.RolledBack)
+	require.Len(t, resp.SlotResults, 2)
+	assert.True(t, resp.SlotResults[0].Created)
+	assert.Equal(t, "10", resp.SlotResults[0].Slot.SlotId)
+	assert.False(t, resp.SlotResults[1].Created)
+	assert.Equal(t, int32(1), resp.SlotResults[1].Index)
+	assert.Contains(t, resp.SlotResults[1].Error, "30 minutes")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubmitAvailability_AllOrNothing(t *testing.T) {
+	start := time.Now().Add(72 * time.Hour).Truncate(time.Hour).Unix()
+	slots := []*availabilitypb.AvailabilitySlotInput{
+		{StartTime: start, EndTime: start + 3600},
+		{StartTime: start + 1800, EndTime: start + 5400},
+	}
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	t.Run("invalid slot creates nothing", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		expectUserTimezone(mock, 1, "UTC")
+
+		resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+			Mode: availabilitypb.SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING,
+			Slots: []*availabilitypb.AvailabilitySlotInput{
+				slots[0],
+				{StartTime: start + 7200, EndTime: start + 7200 + 600},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.RolledBack)
+		assert.Empty(t, resp.CreatedSlots)
+		require.Len(t, resp.SlotResults, 2)
+		assert.Contains(t, resp.SlotResults[0].Error, "another item")
+		assert.Contains(t, resp.SlotResults[1].Error, "30 minutes")
+		assert.Contains(t, resp.ValidationErrors, int32(1))
+		// Nothing is written
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("overlap rolls back", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		expectUserTimezone(mock, 1, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(1, start, start+3600).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots").
+			WillReturnRows(slotRow(10, start, 3600, 0))
+		// The second slot overlaps the first, inserted earlier in the transaction
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(1, start+1800, start+5400).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+			Mode:  availabilitypb.SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING,
+			Slots: slots,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.RolledBack)
+		assert.Empty(t, resp.CreatedSlots)
+		assert.Contains(t, resp.SlotResults[0].Error, "another item")
+		assert.Contains(t, resp.SlotResults[1].Error, "overlaps")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("commits together", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		later := start + 24*3600
+		expectUserTimezone(mock, 1, "UTC")
+		mock.ExpectBegin()
+		for i, s := range []int64{start, later} {
+			mock.ExpectQuery("SELECT EXISTS").
+				WithArgs(1, s, s+3600).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+			mock.ExpectQuery("INSERT INTO datifyy_v2_availability_slots").
+				WillReturnRows(slotRow(10+i, s, 3600, 0))
+		}
+		mock.ExpectCommit()
+
+		resp, err := service.SubmitAvailability(ctx, &availabilitypb.SubmitAvailabilityRequest{
+			Mode: availabilitypb.SubmitMode_SUBMIT_MODE_ALL_OR_NOTHING,
+			Slots: []*availabilitypb.AvailabilitySlotInput{
+				slots[0],
+				{StartTime: later, EndTime: later + 3600},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.RolledBack)
+		assert.Equal(t, int32(2), resp.CreatedCount)
+		require.Len(t, resp.SlotResults, 2)
+		assert.True(t, resp.SlotResults[1].Created)
+		assert.Equal(t, "11", resp.SlotResults[1].Slot.SlotId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUpdateAvailability(t *testing.T) {
+	oldStart := time.Now().Add(72 * time.Hour).Truncate(time.Hour).Unix()
+	start := oldStart + 3600
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	req := &availabilitypb.UpdateAvailabilityRequest{
+		SlotId: "5",
+		Slot:   &availabilitypb.AvailabilitySlotInput{StartTime: start, EndTime: start + 5400, Notes: "Later"},
+	}
+
+	t.Run("detaches rule occurrence", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		now := time.Now()
+		expectUserTimezone(mock, 1, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT rule_id, reserved_date_id, start_time FROM datifyy_v2_availability_slots .* FOR UPDATE").
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"rule_id", "reserved_date_id", "start_time"}).AddRow(3, nil, oldStart))
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(1, start, start+5400, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectExec("UPDATE datifyy_v2_availability_rules SET exdates = array_append").
+			WithArgs(int64(3), oldStart).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("UPDATE datifyy_v2_availability_slots SET start_time").
+			WithArgs(5, 1, start, start+5400, "online",
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "Later").
+			WillReturnRows(sqlmock.NewRows(availabilitySlotColumns).AddRow(
+				5, 1, start, start+5400, "online",
+				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "Later",
+				nil, nil, now, now))
+		mock.ExpectCommit()
+
+		resp, err := service.UpdateAvailability(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, start, resp.Slot.StartTime)
+		assert.Empty(t, resp.Slot.RuleId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reserved slot", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		expectUserTimezone(mock, 1, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"rule_id", "reserved_date_id", "start_time"}).AddRow(nil, 9, oldStart))
+		mock.ExpectRollback()
+
+		_, err := service.UpdateAvailability(ctx, req)
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not owned", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		expectUserTimezone(mock, 1, "UTC")
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"rule_id", "reserved_date_id", "start_time"}))
+		mock.ExpectRollback()
+
+		_, err := service.UpdateAvailability(ctx, req)
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeleteAvailability(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	deleteQuery := "DELETE FROM datifyy_v2_availability_slots WHERE id = \\$1 AND user_id = \\$2 AND reserved_date_id IS NULL"
+
+	t.Run("deletes slot", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(1, false))
+
+		resp, err := service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{SlotId: "5"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("reserved slot", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(0, true))
+
+		_, err := service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{SlotId: "5"})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not owned", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		mock.ExpectQuery(deleteQuery).
+			WithArgs(5, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(0, false))
+
+		_, err := service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{SlotId: "5"})
+
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rule keeps reserved occurrences", func(t *testing.T) {
+		service, mock, db := setupTestAvailabilityService(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM datifyy_v2_availability_slots WHERE rule_id = (.+) AND start_time > \\$3 AND reserved_date_id IS NULL").
+			WithArgs(3, 1, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec("DELETE FROM datifyy_v2_availability_rules WHERE id = \\$1 AND user_id = \\$2").
+			WithArgs(3, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		resp, err := service.DeleteAvailability(ctx, &availabilitypb.DeleteAvailabilityRequest{RuleId: "3"})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestClearAvailability_SkipsReservedAndExcludesRuleOccurrences(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	// A daily rule expanded two weeks out; the range runs three days past that
+	dtstart := time.Now().Add(-24 * time.Hour).Truncate(time.Hour).UTC()
+	day := func(n int) int64 { return dtstart.AddDate(0, 0, n).Unix() }
+	from := day(10) - 1800
+	to := day(17) + 1800
+	now := time.Now()
+
+	expectUserTimezone(mock, 1, "UTC")
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_availability_rules WHERE user_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(availabilityRuleColumns).AddRow(
+			3, 1, "FREQ=DAILY", dtstart.Unix(), 3600, "UTC", "{}", "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			day(14), now, now))
+	mock.ExpectBegin()
+	mock.ExpectQuery("DELETE FROM datifyy_v2_availability_slots (.+) AND reserved_date_id IS NULL").
+		WithArgs(1, from, to, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"rule_id", "start_time"}).
+			AddRow(3, day(11)).
+			AddRow(nil, day(12)+7200))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_rules SET exdates").
+		WithArgs(3, 1, pq.Array([]int64{day(14), day(15), day(16), day(17), day(11)})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_availability_slots (.+) AND reserved_date_id IS NOT NULL").
+		WithArgs(1, from, to, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(availabilitySlotColumns).AddRow(
+			20, 1, day(13), day(13)+3600, "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			3, 7, now, now))
+	mock.ExpectCommit()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	resp, err := service.ClearAvailability(ctx, &availabilitypb.ClearAvailabilityRequest{FromTime: from, ToTime: to})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.DeletedCount)
+	require.Len(t, resp.ReservedSlots, 1)
+	assert.True(t, resp.ReservedSlots[0].Reserved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClearAvailability_InvalidRange(t *testing.T) {
+	service, mock, db := setupTestAvailabilityService(t)
+	defer db.Close()
+
+	from := time.Now().Add(48 * time.Hour).Unix()
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	for _, req := range []*availabilitypb.ClearAvailabilityRequest{
+		{FromTime: from, ToTime: from},
+		{FromTime: from, ToTime: from + int64((400 * 24 * time.Hour).Seconds())},
+		{LocalFromTime: "next week", LocalToTime: "2030-01-01T00:00"},
+	} {
+		expectUserTimezone(mock, 1, "UTC")
+		_, err := service.ClearAvailability(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
+	userpb "github.com/datifyy/backend/gen/user/v1"
 	"github.com/datifyy/backend/internal/ai"
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/datifyy/backend/internal/kundli"
+	"github.com/datifyy/backend/internal/matchrules"
 	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/timezone"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
 )
 
 // DatesService handles curated dates and matching
@@ -26,6 +34,8 @@ type DatesService struct {
 	userRepo             *repository.UserRepository
 	profileRepo          *repository.UserProfileRepository
 	availabilityRepo     *repository.AvailabilityRepository
+	relationRepo         *repository.UserRelationshipRepository
+	discoveryRepo        *repository.DiscoveryRepository
 }
 
 // NewDatesService creates a new DatesService
@@ -62,6 +72,8 @@ func NewDatesService(db *sql.DB, redisClient *redis.Client) (*DatesService, erro
 		userRepo:             repository.NewUserRepository(db),
 		profileRepo:          repository.NewUserProfileRepository(db),
 		availabilityRepo:     repository.NewAvailabilityRepository(db),
+		relationRepo:         repository.NewUserRelationshipRepository(db),
+		discoveryRepo:        repository.NewDiscoveryRepository(db),
 	}, nil
 }
 
@@ -86,10 +98,40 @@ type CandidateUser struct {
 	WorkEmailVerified bool      `json:"work_email_verified"`
 	AvailableSlotsCount int     `json:"available_slots_count"`
 	NextAvailableDate *time.Time `json:"next_available_date,omitempty"`
+	DistanceKm        int        `json:"distance_km,omitempty"` // rounded, from the user being curated for
+	Horoscope         *kundli.Match `json:"horoscope,omitempty"` // with the user being curated for, when both have birth details
+	PreferenceRules   []matchrules.Result `json:"preference_rules,omitempty"` // the curated-for user's rules against this candidate
+	PreferenceScore   int           `json:"preference_score,omitempty"`
 }
 
-// GetCandidatesForCuration returns users available for dates from tomorrow onwards
-func (s *DatesService) GetCandidatesForCuration(ctx context.Context) ([]*CandidateUser, error) {
+// GetCandidatesForCuration returns users available for dates from tomorrow onwards.
+// When forUserID is set, that user and anyone they have blocked (or been blocked by) are excluded,
+// as are candidates outside their discovery radius or failing one of their
+// deal-breakers. Users who require horoscope matching only get candidates
+// whose Guna Milan is acceptable.
+func (s *DatesService) GetCandidatesForCuration(ctx context.Context, forUserID int) ([]*CandidateUser, error) {
+	// Distances are measured from the user being curated for
+	var center string
+	var radius int
+	var partnerPrefs *repository.PartnerPreferences
+	if forUserID != 0 {
+		var err error
+		center, err = s.discoveryRepo.GetCoarseLocation(ctx, forUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get location: %w", err)
+		}
+
+		userPrefs, err := s.profileRepo.GetUserPreferences(ctx, forUserID)
+		if err != nil {
+			userPrefs = nil
+		}
+		partnerPrefs, err = s.profileRepo.GetPartnerPreferences(ctx, forUserID)
+		if err != nil {
+			partnerPrefs = nil
+		}
+		radius = discoveryRadiusKm(userPrefs, partnerPrefs)
+	}
+
 	// Get tomorrow's date range (starting point)
 	tomorrow := time.Now().AddDate(0, 0, 1)
 	startOfDay := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, tomorrow.Location())
@@ -104,20 +146,40 @@ func (s *DatesService) GetCandidatesForCuration(ctx context.Context) ([]*Candida
 			COALESCE(u.gender, '') as gender,
 			COALESCE(up.completion_percentage, 0) as profile_completion,
 			u.email_verified,
+			COALESCE(vp.is_verified, FALSE) as id_verified,
+			COALESCE(vp.work_email_verified, FALSE) as work_email_verified,
 			COUNT(avail.id) as available_slots_count,
-			MIN(avail.start_time) as next_available_date
+			MIN(avail.start_time) as next_available_date,
+			vp.geohash,
+			vp.latitude,
+			vp.longitude
 		FROM datifyy_v2_users u
 		LEFT JOIN user_profiles up ON u.id = up.user_id
+		LEFT JOIN datifyy_v2_user_profiles vp ON u.id = vp.user_id
 		LEFT JOIN availability_slots avail ON u.id = avail.user_id
 		WHERE u.account_status = 'ACTIVE'
 			AND avail.start_time >= $1
+			AND ($2 = 0 OR (
+				u.id <> $2
+				AND NOT EXISTS (
+					SELECT 1 FROM user_blocks b
+					WHERE (b.blocker_user_id = $2 AND b.blocked_user_id = u.id)
+					   OR (b.blocker_user_id = u.id AND b.blocked_user_id = $2)
+				)
+				-- Users who only want verified matches only see ID-verified candidates
+				AND (
+					NOT COALESCE((SELECT pp.verified_only FROM datifyy_v2_partner_preferences pp WHERE pp.user_id = $2), FALSE)
+					OR COALESCE(vp.is_verified, FALSE)
+				)
+			))
 		GROUP BY u.id, u.email, u.name, u.date_of_birth, u.gender, up.completion_percentage,
-				 u.email_verified
+				 u.email_verified, vp.is_verified, vp.work_email_verified,
+				 vp.geohash, vp.latitude, vp.longitude
 		HAVING COUNT(avail.id) > 0
 		ORDER BY u.created_at DESC
 	`
 
-	rows, err := s.db.QueryContext(ctx, query, startOfDay.Unix())
+	rows, err := s.db.QueryContext(ctx, query, startOfDay.Unix(), forUserID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get candidates: %w", err)
 	}
@@ -127,6 +189,8 @@ func (s *DatesService) GetCandidatesForCuration(ctx context.Context) ([]*Candida
 	for rows.Next() {
 		candidate := &CandidateUser{}
 		var nextAvailUnix sql.NullInt64
+		var hash sql.NullString
+		var lat, lng sql.NullFloat64
 
 		err := rows.Scan(
 			&candidate.UserID,
@@ -136,16 +200,27 @@ func (s *DatesService) GetCandidatesForCuration(ctx context.Context) ([]*Candida
 			&candidate.Gender,
 			&candidate.ProfileCompletion,
 			&candidate.EmailVerified,
+			&candidate.AadharVerified,
+			&candidate.WorkEmailVerified,
 			&candidate.AvailableSlotsCount,
 			&nextAvailUnix,
+			&hash,
+			&lat,
+			&lng,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan candidate: %w", err)
 		}
 
-		// Set verification fields (to be implemented in schema later)
-		candidate.AadharVerified = false
-		candidate.WorkEmailVerified = false
+		if km, ok := geo.CoarseDistanceKm(center, repository.CoarseGeohash(hash, lat, lng)); ok {
+			if radius > 0 && km > float64(radius) {
+				continue
+			}
+			candidate.DistanceKm = geo.RoundDistanceKm(km)
+		} else if center != "" && radius > 0 {
+			// Candidates without a location can't be placed within the radius
+			continue
+		}
 
 		if nextAvailUnix.Valid {
 			t := time.Unix(nextAvailUnix.Int64, 0)
@@ -155,9 +230,88 @@ func (s *DatesService) GetCandidatesForCuration(ctx context.Context) ([]*Candida
 		candidates = append(candidates, candidate)
 	}
 
+	if forUserID != 0 && len(candidates) > 0 {
+		candidates, err = s.applyCandidateRules(ctx, forUserID, partnerPrefs, candidates)
+		if err != nil {
+			return nil, err
+		}
+		horoscopeRequired := partnerPrefs != nil && partnerPrefs.HoroscopeRequired
+		return s.matchCandidateHoroscopes(ctx, forUserID, candidates, horoscopeRequired)
+	}
+
 	return candidates, nil
 }
 
+// applyCandidateRules checks candidates against forUserID's partner
+// preferences, dropping those that fail a deal-breaker
+func (s *DatesService) applyCandidateRules(ctx context.Context, forUserID int, partnerPrefs *repository.PartnerPreferences, candidates []*CandidateUser) ([]*CandidateUser, error) {
+	rules, err := compileMatchRules(ctx, s.userRepo, s.profileRepo, forUserID, partnerPrefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile partner preferences: %w", err)
+	}
+	if rules.Len() == 0 {
+		return candidates, nil
+	}
+
+	ids := make([]int, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+	profiles, err := loadRuleProfiles(ctx, s.userRepo, s.profileRepo, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []*CandidateUser
+	for _, c := range candidates {
+		profile, ok := profiles[c.UserID]
+		if !ok {
+			continue
+		}
+		profile.DistanceKm = int32(c.DistanceKm)
+		eval := rules.Evaluate(profile)
+		if !eval.Passed() {
+			continue
+		}
+		c.PreferenceRules = eval.Results
+		c.PreferenceScore, _ = eval.Score()
+		kept = append(kept, c)
+	}
+	return kept, nil
+}
+
+// matchCandidateHoroscopes attaches each candidate's Guna Milan with
+// forUserID, keeping only acceptable matches when required is set
+func (s *DatesService) matchCandidateHoroscopes(ctx context.Context, forUserID int, candidates []*CandidateUser, required bool) ([]*CandidateUser, error) {
+	ids := make([]int, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+
+	matches, err := horoscopeMatches(ctx, s.discoveryRepo, forUserID, ids)
+	if errors.Is(err, errBirthDetailsMissing) {
+		if required {
+			return nil, err
+		}
+		return candidates, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*CandidateUser
+	for _, c := range candidates {
+		if match, ok := matches[c.UserID]; ok {
+			c.Horoscope = &match
+		}
+		if required && (c.Horoscope == nil || !c.Horoscope.Acceptable()) {
+			continue
+		}
+		matched = append(matched, c)
+	}
+	return matched, nil
+}
+
 // MatchCandidate represents a potential match with compatibility
 type MatchCandidate struct {
 	UserID             int      `json:"user_id"`
@@ -169,6 +323,10 @@ type MatchCandidate struct {
 	Reasoning          string   `json:"reasoning"`
 	MatchedAspects     []string `json:"matched_aspects"`
 	MismatchedAspects  []string `json:"mismatched_aspects"`
+	// Each user's partner preferences checked against the other
+	UserRules          []matchrules.Result `json:"user_rules,omitempty"`
+	CandidateRules     []matchrules.Result `json:"candidate_rules,omitempty"`
+	DealbreakersPassed bool                `json:"dealbreakers_passed"`
 }
 
 // AnalyzeCompatibility analyzes compatibility between a user and potential matches
@@ -197,6 +355,23 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 		}
 	}
 
+	// Guna Milan is only available when both users gave birth details
+	horoscopes, err := horoscopeMatches(ctx, s.discoveryRepo, userID, candidateIDs)
+	if err != nil && !errors.Is(err, errBirthDetailsMissing) {
+		log.Printf("Failed to match horoscopes for user %d: %v", userID, err)
+	}
+
+	// Deal-breakers and must-haves are checked both ways
+	ruleProfiles, err := loadRuleProfiles(ctx, s.userRepo, s.profileRepo, append([]int{userID}, candidateIDs...))
+	if err != nil {
+		log.Printf("Failed to load profiles for partner preferences of user %d: %v", userID, err)
+	}
+	userRules := matchrules.Compile(buildPartnerPreferencesFromDB(userPrefs), ruleProfiles[userID])
+	userLocation, err := s.discoveryRepo.GetCoarseLocation(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to load location for user %d: %v", userID, err)
+	}
+
 	var matches []*MatchCandidate
 
 	for _, candidateID := range candidateIDs {
@@ -205,6 +380,17 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 			continue
 		}
 
+		// Never pair users where either has blocked the other
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, candidateID)
+		if err != nil {
+			log.Printf("Failed to check block between users %d and %d: %v", userID, candidateID, err)
+			continue
+		}
+		if blocked {
+			log.Printf("Skipping candidate %d for user %d: blocked", candidateID, userID)
+			continue
+		}
+
 		// Check if match already exists
 		existingMatch, err := s.curatedMatchesRepo.GetByUserPair(ctx, userID, candidateID)
 		if err != nil {
@@ -231,7 +417,7 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 			}
 
 			// Return existing match with user info
-			matches = append(matches, &MatchCandidate{
+			match := &MatchCandidate{
 				UserID:             candidateID,
 				Name:               candidate.Name,
 				Age:                age,
@@ -241,7 +427,13 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 				Reasoning:          existingMatch.Reasoning,
 				MatchedAspects:     existingMatch.MatchedAspects,
 				MismatchedAspects:  existingMatch.MismatchedAspects,
-			})
+			}
+			candidatePrefs, err := s.profileRepo.GetPartnerPreferences(ctx, candidateID)
+			if err != nil {
+				candidatePrefs = nil
+			}
+			s.checkMatchRules(ctx, match, userID, userLocation, userRules, candidatePrefs, ruleProfiles)
+			matches = append(matches, match)
 			continue
 		}
 
@@ -273,6 +465,14 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 			User2Profile: s.profileToAIProfile(candidate, candidateProfile),
 			User2Preferences: s.preferencesToAIPreferences(candidatePrefs),
 		}
+		if match, ok := horoscopes[candidateID]; ok {
+			aiReq.Horoscope = &ai.HoroscopeCompatibility{
+				Gunas:             match.Total,
+				MaxGunas:          kundli.MaxGunas,
+				ManglikCompatible: match.ManglikCompatible,
+				Required:          userPrefs.HoroscopeRequired || candidatePrefs.HoroscopeRequired,
+			}
+		}
 
 		// Call AI to analyze compatibility
 		aiResp, err := s.aiProvider.CalculateCompatibility(ctx, aiReq)
@@ -314,7 +514,7 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 			gender = candidate.Gender.String
 		}
 
-		matches = append(matches, &MatchCandidate{
+		match := &MatchCandidate{
 			UserID:             candidateID,
 			Name:               candidate.Name,
 			Age:                age,
@@ -324,12 +524,56 @@ func (s *DatesService) AnalyzeCompatibility(ctx context.Context, userID int, can
 			Reasoning:          aiResp.Reasoning,
 			MatchedAspects:     aiResp.MatchedAspects,
 			MismatchedAspects:  aiResp.MismatchedAspects,
-		})
+		}
+		s.checkMatchRules(ctx, match, userID, userLocation, userRules, candidatePrefs, ruleProfiles)
+		matches = append(matches, match)
 	}
 
 	return matches, nil
 }
 
+// checkMatchRules evaluates the user's rules against the candidate and the
+// candidate's rules against the user. Like unknown rules, pairs whose
+// profiles couldn't be loaded count as passing.
+func (s *DatesService) checkMatchRules(
+	ctx context.Context,
+	match *MatchCandidate,
+	userID int,
+	userLocation string,
+	userRules matchrules.RuleSet,
+	candidatePrefs *repository.PartnerPreferences,
+	profiles map[int]*userpb.UserProfile,
+) {
+	match.DealbreakersPassed = true
+	userProfile, candidateProfile := profiles[userID], profiles[match.UserID]
+	if userProfile == nil || candidateProfile == nil {
+		return
+	}
+
+	candidateLocation, err := s.discoveryRepo.GetCoarseLocation(ctx, match.UserID)
+	if err != nil {
+		log.Printf("Failed to load location for user %d: %v", match.UserID, err)
+	}
+	var distanceKm int32
+	if km, ok := geo.CoarseDistanceKm(userLocation, candidateLocation); ok {
+		distanceKm = int32(geo.RoundDistanceKm(km))
+	}
+
+	// Evaluate copies so the shared profiles keep no per-pair distance
+	candidateView := proto.Clone(candidateProfile).(*userpb.UserProfile)
+	candidateView.DistanceKm = distanceKm
+	userView := proto.Clone(userProfile).(*userpb.UserProfile)
+	userView.DistanceKm = distanceKm
+
+	userEval := userRules.Evaluate(candidateView)
+	candidateRules := matchrules.Compile(buildPartnerPreferencesFromDB(candidatePrefs), candidateProfile)
+	candidateEval := candidateRules.Evaluate(userView)
+
+	match.UserRules = userEval.Results
+	match.CandidateRules = candidateEval.Results
+	match.DealbreakersPassed = userEval.Passed() && candidateEval.Passed()
+}
+
 // Helper function to convert repository profile to AI profile
 func (s *DatesService) profileToAIProfile(user *repository.User, profile *repository.UserProfile) ai.UserProfile {
 	// Calculate age from date of birth
@@ -381,14 +625,15 @@ func (s *DatesService) profileToAIProfile(user *repository.User, profile *reposi
 
 // Helper function to convert repository preferences to AI preferences
 func (s *DatesService) preferencesToAIPreferences(prefs *repository.PartnerPreferences) ai.PartnerPreferences {
+	pb := buildPartnerPreferencesFromDB(prefs)
 	return ai.PartnerPreferences{
-		AgeMin:             prefs.AgeRangeMin,
-		AgeMax:             prefs.AgeRangeMax,
-		GenderPreference:   string(prefs.LookingForGender),
-		LocationPreference: parseJSONBStringArray(prefs.LocationPreferences),
-		InterestsPreferred: parseJSONBStringArray(prefs.InterestPreferences),
-		EducationPreference: parseJSONBStringArray(prefs.EducationLevels),
-		LifestylePreference: buildLifestylePreferences(prefs),
+		AgeMin:              prefs.AgeRangeMin,
+		AgeMax:              prefs.AgeRangeMax,
+		GenderPreference:    strings.Join(enumNames(pb.LookingForGender), ", "),
+		LocationPreference:  pb.LocationPreferences,
+		InterestsPreferred:  enumNames(pb.InterestPreferences),
+		EducationPreference: enumNames(pb.EducationLevels),
+		LifestylePreference: buildLifestylePreferences(pb),
 	}
 }
 
@@ -528,29 +773,22 @@ func buildLifestyleString(profile *repository.UserProfile) string {
 }
 
 // buildLifestylePreferences builds lifestyle preferences string from partner preferences
-func buildLifestylePreferences(prefs *repository.PartnerPreferences) string {
+func buildLifestylePreferences(prefs *userpb.PartnerPreferences) string {
 	parts := []string{}
 
-	drinking := parseJSONBStringArray(prefs.DrinkingPreferences)
-	if len(drinking) > 0 {
-		parts = append(parts, "Drinking: "+drinking[0])
-	}
-
-	smoking := parseJSONBStringArray(prefs.SmokingPreferences)
-	if len(smoking) > 0 {
-		parts = append(parts, "Smoking: "+smoking[0])
+	if drinking := enumNames(prefs.DrinkingPreferences); len(drinking) > 0 {
+		parts = append(parts, "Drinking: "+strings.Join(drinking, "/"))
 	}
 
-	workout := parseJSONBStringArray(prefs.WorkoutPreferences)
-	if len(workout) > 0 {
-		parts = append(parts, "Workout: "+workout[0])
+	if smoking := enumNames(prefs.SmokingPreferences); len(smoking) > 0 {
+		parts = append(parts, "Smoking: "+strings.Join(smoking, "/"))
 	}
 
-	if len(parts) > 0 {
-		return fmt.Sprintf("%s", parts[0])
+	if workout := enumNames(prefs.WorkoutPreferences); len(workout) > 0 {
+		parts = append(parts, "Workout: "+strings.Join(workout, "/"))
 	}
 
-	return ""
+	return strings.Join(parts, ", ")
 }
 
 // UpdateCuratedMatchAction updates the status of a curated match based on admin action
@@ -596,6 +834,7 @@ type CuratedMatchWithUsers struct {
 	MatchedAspects      []string
 	MismatchedAspects   []string
 	Status              string
+	Source              string
 	CreatedByAdmin      *int
 	ScheduledDateID     *int
 	CreatedAt           time.Time
@@ -651,6 +890,7 @@ func (s *DatesService) GetCuratedMatchesByStatus(ctx context.Context, status str
 			MatchedAspects:      match.MatchedAspects,
 			MismatchedAspects:   match.MismatchedAspects,
 			Status:              match.Status,
+			Source:              match.Source,
 			CreatedByAdmin:      match.CreatedByAdmin,
 			ScheduledDateID:     match.ScheduledDateID,
 			CreatedAt:           match.CreatedAt,
@@ -676,6 +916,10 @@ func (s *DatesService) CreateSuggestionsFromMatch(ctx context.Context, matchID i
 		return fmt.Errorf("can only create suggestions from accepted matches, current status: %s", match.Status)
 	}
 
+	if err := s.ensureNotBlocked(ctx, match.User1ID, match.User2ID); err != nil {
+		return fmt.Errorf("cannot create suggestions: %w", err)
+	}
+
 	// Check if suggestions already exist for this match
 	existingSuggestions, err := s.suggestionsRepo.ListByUser(ctx, match.User1ID, "", 100, 0)
 	if err == nil {
@@ -721,7 +965,6 @@ type DateSuggestionWithUser struct {
 	ID                  int
 	SuggestedUserID     int
 	SuggestedUserName   string
-	SuggestedUserEmail  string
 	SuggestedUserAge    int
 	SuggestedUserGender string
 	CompatibilityScore  float64
@@ -741,6 +984,15 @@ func (s *DatesService) GetUserSuggestions(ctx context.Context, userID int, statu
 	// Enrich with suggested user details
 	var enriched []*DateSuggestionWithUser
 	for _, sugg := range suggestions {
+		// Hide suggestions of users blocked in either direction
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, sugg.SuggestedUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blocks: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
 		// Get suggested user details
 		suggestedUser, err := s.userRepo.GetByID(ctx, sugg.SuggestedUserID)
 		if err != nil {
@@ -748,12 +1000,23 @@ func (s *DatesService) GetUserSuggestions(ctx context.Context, userID int, statu
 			continue
 		}
 
+		// Respect the suggested user's privacy settings
+		visibility, err := s.suggestedUserVisibility(ctx, userID, sugg.SuggestedUserID)
+		if err != nil {
+			log.Printf("Failed to check visibility of suggested user %d: %v", sugg.SuggestedUserID, err)
+			continue
+		}
+
+		age := 0
+		if visibility.Age && suggestedUser.DateOfBirth.Valid {
+			age = calculateAge(suggestedUser.DateOfBirth.Time)
+		}
+
 		enriched = append(enriched, &DateSuggestionWithUser{
 			ID:                  sugg.ID,
 			SuggestedUserID:     sugg.SuggestedUserID,
 			SuggestedUserName:   suggestedUser.Name,
-			SuggestedUserEmail:  suggestedUser.Email,
-			SuggestedUserAge:    calculateAge(suggestedUser.DateOfBirth.Time),
+			SuggestedUserAge:    age,
 			SuggestedUserGender: suggestedUser.Gender.String,
 			CompatibilityScore:  sugg.CompatibilityScore,
 			Reasoning:           sugg.Reasoning,
@@ -765,6 +1028,36 @@ func (s *DatesService) GetUserSuggestions(ctx context.Context, userID int, statu
 	return enriched, nil
 }
 
+// errUsersBlocked is returned when pairing users where one has blocked the other
+var errUsersBlocked = errors.New("one user has blocked the other")
+
+// ensureNotBlocked returns an error if either user has blocked the other
+func (s *DatesService) ensureNotBlocked(ctx context.Context, user1ID, user2ID int) error {
+	blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, user1ID, user2ID)
+	if err != nil {
+		return fmt.Errorf("failed to check blocks: %w", err)
+	}
+	if blocked {
+		return errUsersBlocked
+	}
+	return nil
+}
+
+// suggestedUserVisibility returns what a suggestion's recipient may see of the suggested user
+func (s *DatesService) suggestedUserVisibility(ctx context.Context, viewerID, suggestedUserID int) (ProfileVisibility, error) {
+	relationship, err := resolveViewerRelationship(ctx, s.relationRepo, viewerID, suggestedUserID)
+	if err != nil {
+		return ProfileVisibility{}, err
+	}
+
+	prefs, err := s.profileRepo.GetUserPreferences(ctx, suggestedUserID)
+	if err != nil {
+		return ProfileVisibility{}, err
+	}
+
+	return profileVisibility(prefs, relationship), nil
+}
+
 // RespondToSuggestion allows a user to accept or reject a date suggestion
 func (s *DatesService) RespondToSuggestion(ctx context.Context, suggestionID int, userID int, accept bool) error {
 	// Get the suggestion
@@ -806,6 +1099,10 @@ func (s *DatesService) ScheduleDateFromMatch(ctx context.Context, matchID int, g
 		return nil, fmt.Errorf("failed to get curated match: %w", err)
 	}
 
+	if err := s.ensureNotBlocked(ctx, match.User1ID, match.User2ID); err != nil {
+		return nil, fmt.Errorf("cannot schedule date: %w", err)
+	}
+
 	// Verify both users have accepted their suggestions
 	user1Suggestions, err := s.suggestionsRepo.ListByUser(ctx, match.User1ID, "accepted", 100, 0)
 	if err != nil {
@@ -850,8 +1147,11 @@ func (s *DatesService) ScheduleDateFromMatch(ctx context.Context, matchID int, g
 	// Generate Google Meet link
 	meetLink := generateGoogleMeetLink(user1.Name, user2.Name, scheduledTime)
 
-	// Generate calendar invite text
-	calendarInfo := generateCalendarInviteText(user1.Name, user2.Name, scheduledTime, durationMinutes, meetLink)
+	// Generate calendar invite text, with the time in each participant's timezone
+	calendarInfo := generateCalendarInviteText(
+		inviteParticipant{Name: user1.Name, Location: userLocation(ctx, s.profileRepo, match.User1ID)},
+		inviteParticipant{Name: user2.Name, Location: userLocation(ctx, s.profileRepo, match.User2ID)},
+		scheduledTime, durationMinutes, meetLink)
 
 	// Create scheduled date
 	genieIDNullable := sql.NullInt64{Int64: int64(genieID), Valid: true}
@@ -884,6 +1184,27 @@ func (s *DatesService) ScheduleDateFromMatch(ctx context.Context, matchID int, g
 	return scheduledDate, nil
 }
 
+// FindCommonAvailability returns ranked windows in which both users are
+// available: their slots overlap with compatible date types and places,
+// neither has a date scheduled within opts.Buffer of the window, and neither
+// has marked it busy in an imported calendar
+func (s *DatesService) FindCommonAvailability(ctx context.Context, user1ID, user2ID int, opts CommonAvailabilityOptions) ([]CommonAvailabilityCandidate, error) {
+	if user1ID == user2ID {
+		return nil, errSameUser
+	}
+
+	opts, err := opts.withDefaults(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureNotBlocked(ctx, user1ID, user2ID); err != nil {
+		return nil, err
+	}
+
+	return commonAvailability(ctx, s.availabilityRepo, s.scheduledDatesRepo, user1ID, user2ID, opts)
+}
+
 // generateGoogleMeetLink generates a Google Meet link (simplified version)
 // In production, this would use the Google Calendar API to create actual meets
 func generateGoogleMeetLink(user1Name, user2Name string, scheduledTime time.Time) string {
@@ -894,25 +1215,42 @@ func generateGoogleMeetLink(user1Name, user2Name string, scheduledTime time.Time
 	return fmt.Sprintf("https://meet.google.com/%s", meetID[:20])
 }
 
-// generateCalendarInviteText generates calendar invite information
+// inviteParticipant is a date participant and the timezone they see times in
+type inviteParticipant struct {
+	Name     string
+	Location *time.Location
+}
+
+// generateCalendarInviteText generates calendar invite information. When the
+// participants are in different timezones the time is given in each.
 // In production, this would use Google Calendar API to send actual invites
-func generateCalendarInviteText(user1Name, user2Name string, scheduledTime time.Time, durationMinutes int, meetLink string) string {
+func generateCalendarInviteText(user1, user2 inviteParticipant, scheduledTime time.Time, durationMinutes int, meetLink string) string {
 	endTime := scheduledTime.Add(time.Duration(durationMinutes) * time.Minute)
 
+	var when strings.Builder
+	if user1.Location.String() == user2.Location.String() {
+		fmt.Fprintf(&when, "• When: %s\n• Duration: %d minutes\n• End Time: %s\n",
+			scheduledTime.In(user1.Location).Format(timezone.DisplayLayout),
+			durationMinutes,
+			endTime.In(user1.Location).Format("3:04 PM MST"))
+	} else {
+		for _, p := range []inviteParticipant{user1, user2} {
+			fmt.Fprintf(&when, "• When for %s: %s to %s\n", p.Name,
+				scheduledTime.In(p.Location).Format(timezone.DisplayLayout),
+				endTime.In(p.Location).Format("3:04 PM MST"))
+		}
+		fmt.Fprintf(&when, "• Duration: %d minutes\n", durationMinutes)
+	}
+
 	return fmt.Sprintf(`📅 Date Details:
-• When: %s
-• Duration: %d minutes
-• End Time: %s
-• Participants: %s & %s
+%s• Participants: %s & %s
 • Location: %s
 
 This is your Datifyy curated date! 💝
 `,
-		scheduledTime.Format("Monday, January 2, 2006 at 3:04 PM MST"),
-		durationMinutes,
-		endTime.Format("3:04 PM MST"),
-		user1Name,
-		user2Name,
+		when.String(),
+		user1.Name,
+		user2.Name,
 		meetLink,
 	)
 }
@@ -16,6 +16,7 @@ type LoveZoneService struct {
 	scheduledDatesRepo   *repository.ScheduledDatesRepository
 	userRepo             *repository.UserRepository
 	profileRepo          *repository.UserProfileRepository
+	relationRepo         *repository.UserRelationshipRepository
 }
 
 // NewLoveZoneService creates a new Love Zone service
@@ -26,6 +27,7 @@ func NewLoveZoneService(db *sql.DB) *LoveZoneService {
 		scheduledDatesRepo: repository.NewScheduledDatesRepository(db),
 		userRepo:           repository.NewUserRepository(db),
 		profileRepo:        repository.NewUserProfileRepository(db),
+		relationRepo:       repository.NewUserRelationshipRepository(db),
 	}
 }
 
@@ -56,6 +58,7 @@ type ScheduledDateDetail struct {
 	ID              int
 	OtherUser       *UserSummary
 	ScheduledTime   string
+	Timezone        string
 	DurationMinutes int
 	Status          string
 	DateType        string
@@ -100,8 +103,8 @@ type LoveZoneDashboard struct {
 	Statistics         *LoveZoneStatistics
 }
 
-// getUserSummary fetches a light user summary by ID
-func (s *LoveZoneService) getUserSummary(ctx context.Context, userID int) (*UserSummary, error) {
+// getUserSummary fetches a light user summary by ID, redacted for the given viewer
+func (s *LoveZoneService) getUserSummary(ctx context.Context, userID int, relationship ViewerRelationship) (*UserSummary, error) {
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user: %w", err)
@@ -153,9 +156,26 @@ func (s *LoveZoneService) getUserSummary(ctx context.Context, userID int) (*User
 		summary.PhotoURL = ""
 	}
 
+	// Respect the user's privacy settings
+	prefs, err := s.profileRepo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	applySummaryVisibility(summary, profileVisibility(prefs, relationship))
+
 	return summary, nil
 }
 
+// suggestionSummary fetches the summary of a suggested user as seen by the suggestion's recipient
+func (s *LoveZoneService) suggestionSummary(ctx context.Context, viewerID, suggestedUserID int) (*UserSummary, error) {
+	relationship, err := resolveViewerRelationship(ctx, s.relationRepo, viewerID, suggestedUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getUserSummary(ctx, suggestedUserID, relationship)
+}
+
 // GetLoveZoneDashboard retrieves the complete Love Zone dashboard for a user
 func (s *LoveZoneService) GetLoveZoneDashboard(ctx context.Context, userID int) (*LoveZoneDashboard, error) {
 	// Fetch all data in parallel (could be optimized with goroutines)
@@ -202,7 +222,15 @@ func (s *LoveZoneService) GetDateSuggestions(ctx context.Context, userID int, st
 
 	var details []*DateSuggestionDetail
 	for _, suggestion := range suggestions {
-		userSummary, err := s.getUserSummary(ctx, suggestion.SuggestedUserID)
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, suggestion.SuggestedUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blocks: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
+		userSummary, err := s.suggestionSummary(ctx, userID, suggestion.SuggestedUserID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user summary: %w", err)
 		}
@@ -227,6 +255,9 @@ func (s *LoveZoneService) GetUpcomingDates(ctx context.Context, userID int, limi
 		return nil, fmt.Errorf("failed to list upcoming dates: %w", err)
 	}
 
+	// Times are shown in the viewer's timezone
+	loc := userLocation(ctx, s.profileRepo, userID)
+
 	var details []*ScheduledDateDetail
 	for _, date := range dates {
 		// Determine which user is the "other user"
@@ -235,7 +266,16 @@ func (s *LoveZoneService) GetUpcomingDates(ctx context.Context, userID int, limi
 			otherUserID = date.User2ID
 		}
 
-		otherUser, err := s.getUserSummary(ctx, otherUserID)
+		// Blocked users disappear from each other's Love Zone
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, otherUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blocks: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
+		otherUser, err := s.getUserSummary(ctx, otherUserID, ViewerMatched)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get other user summary: %w", err)
 		}
@@ -243,11 +283,12 @@ func (s *LoveZoneService) GetUpcomingDates(ctx context.Context, userID int, limi
 		detail := &ScheduledDateDetail{
 			ID:              date.ID,
 			OtherUser:       otherUser,
-			ScheduledTime:   date.ScheduledTime.Format("2006-01-02T15:04:05Z07:00"),
+			ScheduledTime:   date.ScheduledTime.In(loc).Format("2006-01-02T15:04:05Z07:00"),
+			Timezone:        loc.String(),
 			DurationMinutes: date.DurationMinutes,
 			Status:          date.Status,
 			DateType:        date.DateType,
-			CreatedAt:       date.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CreatedAt:       date.CreatedAt.In(loc).Format("2006-01-02T15:04:05Z07:00"),
 		}
 
 		if date.PlaceName.Valid {
@@ -263,7 +304,7 @@ func (s *LoveZoneService) GetUpcomingDates(ctx context.Context, userID int, limi
 			detail.Notes = date.Notes.String
 		}
 		if date.ConfirmedAt.Valid {
-			confirmedStr := date.ConfirmedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+			confirmedStr := date.ConfirmedAt.Time.In(loc).Format("2006-01-02T15:04:05Z07:00")
 			detail.ConfirmedAt = &confirmedStr
 		}
 
@@ -280,6 +321,9 @@ func (s *LoveZoneService) GetPastDates(ctx context.Context, userID int, limit, o
 		return nil, fmt.Errorf("failed to list past dates: %w", err)
 	}
 
+	// Times are shown in the viewer's timezone
+	loc := userLocation(ctx, s.profileRepo, userID)
+
 	var details []*ScheduledDateDetail
 	for _, date := range dates {
 		// Determine which user is the "other user"
@@ -288,7 +332,16 @@ func (s *LoveZoneService) GetPastDates(ctx context.Context, userID int, limit, o
 			otherUserID = date.User2ID
 		}
 
-		otherUser, err := s.getUserSummary(ctx, otherUserID)
+		// Blocked users disappear from each other's Love Zone
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, otherUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blocks: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
+		otherUser, err := s.getUserSummary(ctx, otherUserID, ViewerMatched)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get other user summary: %w", err)
 		}
@@ -296,11 +349,12 @@ func (s *LoveZoneService) GetPastDates(ctx context.Context, userID int, limit, o
 		detail := &ScheduledDateDetail{
 			ID:              date.ID,
 			OtherUser:       otherUser,
-			ScheduledTime:   date.ScheduledTime.Format("2006-01-02T15:04:05Z07:00"),
+			ScheduledTime:   date.ScheduledTime.In(loc).Format("2006-01-02T15:04:05Z07:00"),
+			Timezone:        loc.String(),
 			DurationMinutes: date.DurationMinutes,
 			Status:          date.Status,
 			DateType:        date.DateType,
-			CreatedAt:       date.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			CreatedAt:       date.CreatedAt.In(loc).Format("2006-01-02T15:04:05Z07:00"),
 		}
 
 		if date.PlaceName.Valid {
@@ -316,11 +370,11 @@ func (s *LoveZoneService) GetPastDates(ctx context.Context, userID int, limit, o
 			detail.Notes = date.Notes.String
 		}
 		if date.ConfirmedAt.Valid {
-			confirmedStr := date.ConfirmedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+			confirmedStr := date.ConfirmedAt.Time.In(loc).Format("2006-01-02T15:04:05Z07:00")
 			detail.ConfirmedAt = &confirmedStr
 		}
 		if date.CompletedAt.Valid {
-			completedStr := date.CompletedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+			completedStr := date.CompletedAt.Time.In(loc).Format("2006-01-02T15:04:05Z07:00")
 			detail.CompletedAt = &completedStr
 		}
 
@@ -339,7 +393,15 @@ func (s *LoveZoneService) GetRejectedDates(ctx context.Context, userID int, limi
 
 	var details []*RejectedDateDetail
 	for _, suggestion := range suggestions {
-		userSummary, err := s.getUserSummary(ctx, suggestion.SuggestedUserID)
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, suggestion.SuggestedUserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check blocks: %w", err)
+		}
+		if blocked {
+			continue
+		}
+
+		userSummary, err := s.suggestionSummary(ctx, userID, suggestion.SuggestedUserID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get user summary: %w", err)
 		}
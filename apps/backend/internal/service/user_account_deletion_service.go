@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/datifyy/backend/internal/repository"
+)
+
+const (
+	// accountDeletionGracePeriod is how long a deleted account can be restored by logging in
+	accountDeletionGracePeriod = 30 * 24 * time.Hour
+
+	// accountPurgeBatchSize is the number of accounts purged per job run
+	accountPurgeBatchSize = 20
+)
+
+// PurgeDeletedAccounts permanently anonymizes accounts whose deletion grace period has ended.
+// It is run periodically by the background job runner.
+func (s *UserService) PurgeDeletedAccounts(ctx context.Context) error {
+	userIDs, err := s.purgeRepo.ListDueForPurge(ctx, time.Now(), accountPurgeBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if err := s.purgeAccount(ctx, userID); err != nil {
+			// Log and continue; the account is retried on the next run
+			log.Printf("Failed to purge account %d: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// purgeAccount scrubs a single account and cleans up its storage objects and dates
+func (s *UserService) purgeAccount(ctx context.Context, userID int) error {
+	result, err := s.purgeRepo.PurgeAccount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Delete stored files now that the database no longer references them
+	for _, photoID := range result.PhotoIDs {
+		s.deletePhotoFromStorage(ctx, userID, photoID)
+	}
+	for _, key := range result.ExportStorageKeys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			log.Printf("Failed to delete data export %s for user %d: %v", key, userID, err)
+		}
+	}
+	for _, key := range result.IDDocumentKeys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			log.Printf("Failed to delete identity document %s for user %d: %v", key, userID, err)
+		}
+	}
+
+	// Let the other party know their upcoming date is off
+	for _, date := range result.CancelledDates {
+		s.notifyDateCancelled(ctx, date)
+	}
+
+	log.Printf(
+		"Purged account %d: %d photos, %d suggestions withdrawn, %d matches withdrawn, %d dates cancelled, %d sessions revoked",
+		userID, len(result.PhotoIDs), result.WithdrawnSuggestions, result.WithdrawnMatches,
+		len(result.CancelledDates), result.RevokedSessions,
+	)
+
+	return nil
+}
+
+// notifyDateCancelled emails the other participant of a date cancelled on a user's behalf
+func (s *UserService) notifyDateCancelled(ctx context.Context, date repository.CancelledDate) {
+	if s.emailClient == nil {
+		return
+	}
+
+	other, err := s.userRepo.GetByID(ctx, date.OtherUserID)
+	if err != nil {
+		log.Printf("Failed to get user %d to notify about cancelled date %d: %v", date.OtherUserID, date.DateID, err)
+		return
+	}
+
+	reason := "Your match is no longer available."
+	scheduledTime := date.ScheduledTime.In(userLocation(ctx, s.profileRepo, date.OtherUserID))
+	if err := s.emailClient.SendDateCancelledEmail(other.Email, other.Name, scheduledTime, reason); err != nil {
+		log.Printf("Failed to send date cancellation email for date %d: %v", date.DateID, err)
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/datifyy/backend/internal/storage"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDeletedAccounts_ScrubsAccountAndNotifiesDates(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	emailSender := &mockUserEmailSender{}
+	service.emailClient = emailSender
+
+	ctx := context.Background()
+	now := time.Now()
+	dateTime := now.Add(48 * time.Hour)
+
+	// Stored files that should be removed after the purge
+	require.NoError(t, service.blobStore.Put(ctx, photoStorageKey(1, "photo_1_100"), []byte("img")))
+	require.NoError(t, service.blobStore.Put(ctx, "exports/1/datifyy-export-3.zip", []byte("zip")))
+	require.NoError(t, service.blobStore.Put(ctx, "id-documents/1/4/front", []byte("id")))
+
+	mock.ExpectQuery("SELECT id FROM datifyy_v2_users WHERE account_status = 'PENDING_DELETION'").
+		WithArgs(sqlmock.AnyArg(), accountPurgeBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT account_status FROM datifyy_v2_users WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_status"}).AddRow("PENDING_DELETION"))
+	mock.ExpectQuery("DELETE FROM user_photos WHERE user_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"photo_id"}).AddRow("photo_1_100"))
+	mock.ExpectQuery("DELETE FROM datifyy_v2_data_exports").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"storage_key"}).AddRow("exports/1/datifyy-export-3.zip"))
+	mock.ExpectQuery("DELETE FROM datifyy_v2_id_verifications").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"k"}).AddRow("id-documents/1/4/front"))
+	mock.ExpectExec("UPDATE datifyy_v2_date_suggestions SET status = 'withdrawn'").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE datifyy_v2_curated_matches SET status = 'withdrawn'").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE datifyy_v2_scheduled_dates d SET status = 'cancelled'").
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "other_user_id", "scheduled_time", "status"}).AddRow(5, 2, dateTime, "confirmed"))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_slots SET reserved_date_id = NULL").
+		WithArgs(pq.Array([]int{5})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WithArgs(5, "status_changed", "confirmed", "cancelled", sqlmock.AnyArg(), "system", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_sessions SET is_active = false").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM datifyy_v2_devices").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM user_blocks WHERE blocker_user_id = \\$1 OR blocked_user_id = \\$1").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	for _, table := range []string{"datifyy_v2_user_profiles", "datifyy_v2_partner_preferences", "user_preferences", "datifyy_v2_profile_changes", "datifyy_v2_availability_slots", "datifyy_v2_availability_rules", "datifyy_v2_availability_slots_archive", "datifyy_v2_availability_reminders", "datifyy_v2_availability_exclusions", "datifyy_v2_calendar_feed_tokens", "datifyy_v2_work_email_verifications", "datifyy_v2_date_reschedule_requests", "datifyy_v2_date_reliability"} {
+		mock.ExpectExec("DELETE FROM " + table + " WHERE").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec("UPDATE datifyy_v2_users SET email = 'deleted-user-'").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Other participant of the cancelled date
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "name", "password_hash", "phone_number",
+			"email_verified", "phone_verified", "account_status",
+			"verification_token", "verification_token_expires_at",
+			"password_reset_token", "password_reset_token_expires_at",
+			"last_login_at", "photo_url", "date_of_birth", "gender",
+			"created_at", "updated_at",
+		}).AddRow(
+			2, "match@example.com", "Match User", "hash", nil,
+			true, false, "ACTIVE",
+			nil, nil, nil, nil,
+			nil, nil, nil, "FEMALE",
+			now, now,
+		))
+	expectUserTimezone(mock, 2, "America/New_York")
+
+	err := service.PurgeDeletedAccounts(ctx)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = service.blobStore.Get(ctx, photoStorageKey(1, "photo_1_100"))
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	_, err = service.blobStore.Get(ctx, "exports/1/datifyy-export-3.zip")
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	_, err = service.blobStore.Get(ctx, "id-documents/1/4/front")
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+
+	assert.Equal(t, []string{"match@example.com"}, emailSender.dateCancelledRecipient)
+	require.Len(t, emailSender.dateCancelledTimes, 1)
+	assert.Equal(t, "America/New_York", emailSender.dateCancelledTimes[0].Location().String())
+}
+
+func TestPurgeDeletedAccounts_SkipsRestoredAccount(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT id FROM datifyy_v2_users WHERE account_status = 'PENDING_DELETION'").
+		WithArgs(sqlmock.AnyArg(), accountPurgeBatchSize).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	// User logged in after the job listed them
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT account_status FROM datifyy_v2_users WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"account_status"}).AddRow("ACTIVE"))
+	mock.ExpectRollback()
+
+	err := service.PurgeDeletedAccounts(ctx)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	commonpb "github.com/datifyy/backend/gen/common/v1"
@@ -45,7 +47,16 @@ func (s *UserService) BlockUser(
 		return nil, status.Error(codes.Internal, "failed to block user")
 	}
 
-	// TODO: Unmatch if previously matched
+	// Unmatch: withdraw pending suggestions and cancel upcoming dates between the pair
+	cancelledDates, err := s.relationRepo.WithdrawPairActivity(ctx, userID, blockedUserID, "Date cancelled by participant")
+	if err != nil {
+		// Log but don't fail - the block itself is enforced everywhere
+		log.Printf("Failed to withdraw activity between users %d and %d: %v", userID, blockedUserID, err)
+	}
+	for _, date := range cancelledDates {
+		s.notifyDateCancelled(ctx, date)
+	}
+
 	// TODO: Delete any active conversations
 
 	return &userpb.BlockUserResponse{
@@ -187,12 +198,15 @@ func (s *UserService) ReportUser(
 	reportID := fmt.Sprintf("report_%d_%d_%d", userID, reportedUserID, time.Now().Unix())
 
 	// Create report in database
+	reason := strings.TrimPrefix(req.Reason.String(), "REPORT_REASON_")
+	severity := reportSeverity(req.Reason)
 	err = s.profileRepo.CreateReport(
 		ctx,
 		userID,
 		reportedUserID,
 		reportID,
-		req.Reason.String(),
+		reason,
+		severity,
 		req.Details,
 		req.EvidenceUrls,
 	)
@@ -200,11 +214,77 @@ func (s *UserService) ReportUser(
 		return nil, status.Error(codes.Internal, "failed to create report")
 	}
 
-	// TODO: Notify moderation team
-	// TODO: Auto-block user if multiple reports (threshold-based)
+	// Notify moderation team of reports that need immediate attention
+	if severity == "HIGH" {
+		s.alertModerators(ctx, "High-severity user report", fmt.Sprintf("User %d was reported for %s", reportedUserID, reason), map[string]string{
+			"Report ID": reportID,
+			"Reason":    reason,
+			"Details":   req.Details,
+		})
+	}
+
+	s.escalateIfOverReported(ctx, reportedUserID, reportID)
 
 	return &userpb.ReportUserResponse{
 		ReportId: reportID,
 		Message:  "User reported successfully. Our team will review this report.",
 	}, nil
 }
+
+// reportSeverity ranks a report reason for the moderation queue
+func reportSeverity(reason userpb.ReportReason) string {
+	switch reason {
+	case userpb.ReportReason_REPORT_REASON_HARASSMENT,
+		userpb.ReportReason_REPORT_REASON_SCAM,
+		userpb.ReportReason_REPORT_REASON_UNDERAGE,
+		userpb.ReportReason_REPORT_REASON_HATE_SPEECH,
+		userpb.ReportReason_REPORT_REASON_VIOLENCE:
+		return "HIGH"
+	case userpb.ReportReason_REPORT_REASON_INAPPROPRIATE_CONTENT,
+		userpb.ReportReason_REPORT_REASON_FAKE_PROFILE,
+		userpb.ReportReason_REPORT_REASON_STOLEN_PHOTOS:
+		return "MEDIUM"
+	default:
+		return "LOW"
+	}
+}
+
+// escalateIfOverReported raises a user's open reports to HIGH severity once enough
+// distinct users have reported them within the escalation window. Moderators are
+// alerted when reportID is the report that takes the count to the threshold.
+func (s *UserService) escalateIfOverReported(ctx context.Context, reportedUserID int, reportID string) {
+	reporters, before, err := s.reportRepo.CountRecentReporters(ctx, reportedUserID, time.Now().Add(-s.reportEscalationWindow), reportID)
+	if err != nil {
+		log.Printf("Failed to count reports against user %d: %v", reportedUserID, err)
+		return
+	}
+	if s.reportEscalationThreshold <= 0 || reporters < s.reportEscalationThreshold {
+		return
+	}
+
+	if _, err := s.reportRepo.EscalateOpenReports(ctx, reportedUserID); err != nil {
+		log.Printf("Failed to escalate reports against user %d: %v", reportedUserID, err)
+		return
+	}
+
+	// Only alert when the threshold is first crossed, even if several reports
+	// arrived together or the threshold was lowered
+	if before < s.reportEscalationThreshold {
+		s.alertModerators(ctx, "User crossed report threshold", fmt.Sprintf("User %d has been reported by %d users", reportedUserID, reporters), map[string]string{
+			"User ID":   strconv.Itoa(reportedUserID),
+			"Reporters": strconv.Itoa(reporters),
+		})
+	}
+}
+
+// alertModerators posts an alert to the moderation channel, if configured
+func (s *UserService) alertModerators(ctx context.Context, title, message string, details map[string]string) {
+	if s.alerter == nil {
+		return
+	}
+
+	if err := s.alerter.SendAlert(ctx, title, message, details); err != nil {
+		// Log but don't fail
+		log.Printf("Failed to send moderation alert: %v", err)
+	}
+}
@@ -24,6 +24,19 @@ func TestBlockUser_Success(t *testing.T) {
 		WithArgs(sqlmock.AnyArg(), 2, "Spam").
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Mock withdrawal of pending activity between the pair
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE datifyy_v2_date_suggestions SET status = 'withdrawn'").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE datifyy_v2_curated_matches SET status = 'withdrawn'").
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("UPDATE datifyy_v2_scheduled_dates d SET status = 'cancelled'").
+		WithArgs(1, 2, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "scheduled_time", "status"}))
+	mock.ExpectCommit()
+
 	req := &userpb.BlockUserRequest{
 		UserId: "2",
 		Reason: "Spam",
@@ -167,14 +180,18 @@ func TestListBlockedUsers_Success(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		2, 2, nil, []byte("[]"), nil, nil, []byte("[]"),
 		nil, nil, []byte("{}"), nil, []byte("[]"), []byte("[]"),
 		[]byte("[]"), nil, nil, nil, nil,
 		nil, nil, nil, nil, nil,
 		nil, nil, nil, nil,
-		[]byte("[]"), 0, true, false,
+		[]byte("[]"), 0, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -213,14 +230,18 @@ func TestListBlockedUsers_Success(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		3, 3, nil, []byte("[]"), nil, nil, []byte("[]"),
 		nil, nil, []byte("{}"), nil, []byte("[]"), []byte("[]"),
 		[]byte("[]"), nil, nil, nil, nil,
 		nil, nil, nil, nil, nil,
 		nil, nil, nil, nil,
-		[]byte("[]"), 0, true, false,
+		[]byte("[]"), 0, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -257,9 +278,15 @@ func TestReportUser_Success(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock report insert
-	mock.ExpectExec("INSERT INTO user_reports").
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_reports").
+		WithArgs(1, 2, sqlmock.AnyArg(), "SPAM", "LOW", "Sending spam messages", sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
+	// Mock escalation threshold check (below threshold)
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT reporter_user_id\\)(.+) FROM datifyy_v2_user_reports").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "before"}).AddRow(1, 0))
+
 	req := &userpb.ReportUserRequest{
 		UserId:       "2",
 		Reason:       userpb.ReportReason_REPORT_REASON_SPAM,
@@ -278,6 +305,121 @@ func TestReportUser_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+// mockModerationAlerter records alerts instead of posting them to Slack
+type mockModerationAlerter struct {
+	titles []string
+}
+
+func (m *mockModerationAlerter) SendAlert(ctx context.Context, title, message string, details map[string]string) error {
+	m.titles = append(m.titles, title)
+	return nil
+}
+
+func TestReportUser_HighSeverityAlertsModerators(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	alerter := &mockModerationAlerter{}
+	service.alerter = alerter
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_reports").
+		WithArgs(1, 2, sqlmock.AnyArg(), "HARASSMENT", "HIGH", "Threatening messages", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT reporter_user_id\\)(.+) FROM datifyy_v2_user_reports").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "before"}).AddRow(1, 0))
+
+	req := &userpb.ReportUserRequest{
+		UserId:  "2",
+		Reason:  userpb.ReportReason_REPORT_REASON_HARASSMENT,
+		Details: "Threatening messages",
+	}
+
+	_, err := service.ReportUser(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"High-severity user report"}, alerter.titles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportUser_EscalatesWhenThresholdCrossed(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	alerter := &mockModerationAlerter{}
+	service.alerter = alerter
+	service.reportEscalationThreshold = 3
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_reports").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT COUNT\\(DISTINCT reporter_user_id\\)(.+) FROM datifyy_v2_user_reports").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "before"}).AddRow(3, 2))
+	mock.ExpectExec("UPDATE datifyy_v2_user_reports SET severity = 'HIGH'").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	req := &userpb.ReportUserRequest{
+		UserId: "2",
+		Reason: userpb.ReportReason_REPORT_REASON_SPAM,
+	}
+
+	_, err := service.ReportUser(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"User crossed report threshold"}, alerter.titles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportUser_EscalationAlertsOnlyOnCrossing(t *testing.T) {
+	tests := []struct {
+		name          string
+		total, before int
+		wantAlert     bool
+	}{
+		// Another report landed at the same time, so the count jumped past 3
+		{"jumped past threshold", 4, 2, true},
+		{"already over threshold", 4, 3, false},
+		// Reporting again doesn't add a reporter
+		{"repeat reporter", 3, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mock, db := setupTestUserService(t)
+			defer db.Close()
+
+			alerter := &mockModerationAlerter{}
+			service.alerter = alerter
+			service.reportEscalationThreshold = 3
+
+			ctx := context.WithValue(context.Background(), "userID", 1)
+
+			mock.ExpectExec("INSERT INTO datifyy_v2_user_reports").
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery("FILTER \\(WHERE report_id <> \\$3\\)").
+				WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"total", "before"}).AddRow(tt.total, tt.before))
+			mock.ExpectExec("UPDATE datifyy_v2_user_reports SET severity = 'HIGH'").
+				WithArgs(2).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			_, err := service.ReportUser(ctx, &userpb.ReportUserRequest{
+				UserId: "2",
+				Reason: userpb.ReportReason_REPORT_REASON_SPAM,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAlert, len(alerter.titles) == 1)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestReportUser_MissingUserID(t *testing.T) {
 	service, _, db := setupTestUserService(t)
 	defer db.Close()
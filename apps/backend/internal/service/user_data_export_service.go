@@ -0,0 +1,248 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// dataExportCooldown limits exports to one per user per day
+	dataExportCooldown = 24 * time.Hour
+
+	// dataExportLinkTTL is how long an emailed download link stays valid
+	dataExportLinkTTL = 72 * time.Hour
+
+	// dataExportBatchSize is the number of exports built per job run
+	dataExportBatchSize = 5
+)
+
+// RequestDataExport enqueues a personal data export for the authenticated user
+func (s *UserService) RequestDataExport(
+	ctx context.Context,
+	req *userpb.RequestDataExportRequest,
+) (*userpb.RequestDataExportResponse, error) {
+	// Extract user ID from auth context
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	// Rate limit: one export per user per day; exports that failed don't count
+	export, err := s.dataExportRepo.Create(ctx, userID, dataExportCooldown)
+	if errors.Is(err, repository.ErrDataExportTooSoon) {
+		message := "a data export is already being prepared"
+		latest, latestErr := s.dataExportRepo.GetLatestByUserID(ctx, userID)
+		if latestErr == nil && latest != nil && latest.Status != "pending" && latest.Status != "processing" {
+			message = fmt.Sprintf("a data export was already requested in the last 24 hours, try again after %s",
+				latest.RequestedAt.Add(dataExportCooldown).UTC().Format(time.RFC3339))
+		}
+		return nil, status.Error(codes.ResourceExhausted, message)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to request data export")
+	}
+
+	log.Printf("Data export %d requested by user %d", export.ID, userID)
+
+	return &userpb.RequestDataExportResponse{
+		ExportId:      strconv.Itoa(export.ID),
+		Status:        export.Status,
+		RequestedAt:   timeToProto(export.RequestedAt),
+		NextAllowedAt: timeToProto(export.RequestedAt.Add(dataExportCooldown)),
+		Message:       "Your data export has been requested. We'll email you a download link when it's ready.",
+	}, nil
+}
+
+// ProcessPendingDataExports builds queued exports and removes expired archives.
+// It is run periodically by the background job runner.
+func (s *UserService) ProcessPendingDataExports(ctx context.Context) error {
+	// Remove archives whose download link has expired
+	expired, err := s.dataExportRepo.ExpireCompleted(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, export := range expired {
+		if export.StorageKey.Valid {
+			if err := s.blobStore.Delete(ctx, export.StorageKey.String); err != nil {
+				log.Printf("Failed to delete expired data export %d: %v", export.ID, err)
+			}
+		}
+		log.Printf("Data export %d for user %d expired", export.ID, export.UserID)
+	}
+
+	// Build newly requested exports
+	exports, err := s.dataExportRepo.ClaimPending(ctx, dataExportBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if err := s.processDataExport(ctx, export); err != nil {
+			log.Printf("Data export %d for user %d failed: %v", export.ID, export.UserID, err)
+			if markErr := s.dataExportRepo.MarkFailed(ctx, export.ID, err.Error()); markErr != nil {
+				log.Printf("Failed to mark data export %d failed: %v", export.ID, markErr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// processDataExport builds, stores and emails a single export
+func (s *UserService) processDataExport(ctx context.Context, export *repository.DataExport) error {
+	user, err := s.userRepo.GetByID(ctx, export.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	archive, err := s.buildDataExportArchive(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	storageKey := fmt.Sprintf("exports/%d/datifyy-export-%d.zip", export.UserID, export.ID)
+	if err := s.blobStore.Put(ctx, storageKey, archive); err != nil {
+		return fmt.Errorf("failed to store archive: %w", err)
+	}
+
+	expiresAt := time.Now().Add(dataExportLinkTTL)
+	if err := s.dataExportRepo.MarkCompleted(ctx, export.ID, storageKey, int64(len(archive)), expiresAt); err != nil {
+		return err
+	}
+
+	log.Printf("Data export %d for user %d completed (%d bytes)", export.ID, export.UserID, len(archive))
+
+	// Email the signed download link
+	if s.emailClient != nil {
+		downloadURL := s.dataExportDownloadURL(export.ID, expiresAt)
+		localExpiry := expiresAt.In(userLocation(ctx, s.profileRepo, export.UserID))
+		if err := s.emailClient.SendDataExportEmail(user.Email, downloadURL, localExpiry); err != nil {
+			// Log but don't fail - the export itself is complete
+			log.Printf("Failed to send data export email for export %d: %v", export.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// buildDataExportArchive assembles a ZIP with one JSON file per data domain
+func (s *UserService) buildDataExportArchive(ctx context.Context, userID int) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := make([]string, 0, len(repository.DataExportDomains))
+	for _, domain := range repository.DataExportDomains {
+		records, err := s.dataExportRepo.CollectDomain(ctx, domain, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := domain.Name + ".json"
+		if err := writeJSONToZip(zw, fileName, records); err != nil {
+			return nil, err
+		}
+		files = append(files, fileName)
+	}
+
+	manifest := map[string]interface{}{
+		"user_id":      userID,
+		"generated_at": time.Now().UTC().Format(time.RFC3339),
+		"files":        files,
+	}
+	if err := writeJSONToZip(zw, "manifest.json", manifest); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeJSONToZip writes v as indented JSON into a new archive entry
+func writeJSONToZip(zw *zip.Writer, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// OpenDataExport verifies a signed download link and returns the export archive
+func (s *UserService) OpenDataExport(ctx context.Context, exportID int, expires int64, signature string) ([]byte, error) {
+	// Verify signature before touching the database
+	expected := s.signDataExport(exportID, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, status.Error(codes.PermissionDenied, "invalid download link")
+	}
+
+	if time.Now().Unix() > expires {
+		return nil, status.Error(codes.FailedPrecondition, "download link has expired")
+	}
+
+	export, err := s.dataExportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		if err == repository.ErrDataExportNotFound {
+			return nil, status.Error(codes.NotFound, "data export not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to get data export")
+	}
+
+	if export.Status != "completed" || !export.StorageKey.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "data export is no longer available")
+	}
+
+	archive, err := s.blobStore.Get(ctx, export.StorageKey.String)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read data export")
+	}
+
+	if err := s.dataExportRepo.RecordDownload(ctx, export.ID); err != nil {
+		// Log but don't fail
+		log.Printf("Failed to record download of data export %d: %v", export.ID, err)
+	}
+
+	log.Printf("Data export %d downloaded by user %d", export.ID, export.UserID)
+	return archive, nil
+}
+
+// dataExportDownloadURL builds the signed, expiring download link for an export
+func (s *UserService) dataExportDownloadURL(exportID int, expiresAt time.Time) string {
+	expires := expiresAt.Unix()
+	return fmt.Sprintf(
+		"%s/api/v1/user/data-export/download?id=%d&expires=%d&sig=%s",
+		s.publicBaseURL, exportID, expires, s.signDataExport(exportID, expires),
+	)
+}
+
+// signDataExport returns the HMAC-SHA256 signature for an export download link
+func (s *UserService) signDataExport(exportID int, expires int64) string {
+	mac := hmac.New(sha256.New, s.dataExportSigningKey)
+	fmt.Fprintf(mac, "data-export:%d:%d", exportID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var dataExportRowColumns = []string{
+	"id", "user_id", "status", "storage_key", "file_size", "error_message",
+	"expires_at", "downloaded_at", "download_count", "requested_at", "completed_at",
+}
+
+// mockUserEmailSender records emails sent by the user service
+type mockUserEmailSender struct {
+	dataExportTo           string
+	dataExportURL          string
+	dataExportExpiry       time.Time
+	deletionScheduledTo    string
+	dateCancelledRecipient []string
+	dateCancelledTimes     []time.Time
+	workEmailTo            string
+	workEmailCode          string
+	rescheduleRequestTo    string
+	rescheduleTimes        []time.Time
+	rescheduleAnsweredTo   string
+	rescheduleAccepted     bool
+	genieUpdateTo          []string
+}
+
+func (m *mockUserEmailSender) SendDataExportEmail(to, downloadURL string, expiresAt time.Time) error {
+	m.dataExportTo = to
+	m.dataExportURL = downloadURL
+	m.dataExportExpiry = expiresAt
+	return nil
+}
+
+func (m *mockUserEmailSender) SendAccountDeletionScheduledEmail(to, name string, scheduledFor time.Time) error {
+	m.deletionScheduledTo = to
+	return nil
+}
+
+func (m *mockUserEmailSender) SendDateCancelledEmail(to, name string, scheduledTime time.Time, reason string) error {
+	m.dateCancelledRecipient = append(m.dateCancelledRecipient, to)
+	m.dateCancelledTimes = append(m.dateCancelledTimes, scheduledTime)
+	return nil
+}
+
+func (m *mockUserEmailSender) SendWorkEmailVerificationCode(to, code string, expiresAt time.Time) error {
+	m.workEmailTo = to
+	m.workEmailCode = code
+	return nil
+}
+
+func (m *mockUserEmailSender) SendRescheduleRequestEmail(to, name, requesterName string, proposedTimes []time.Time, reason string) error {
+	m.rescheduleRequestTo = to
+	m.rescheduleTimes = proposedTimes
+	return nil
+}
+
+func (m *mockUserEmailSender) SendRescheduleAnsweredEmail(to, name string, accepted bool, scheduledTime time.Time) error {
+	m.rescheduleAnsweredTo = to
+	m.rescheduleAccepted = accepted
+	return nil
+}
+
+func (m *mockUserEmailSender) SendGenieDateUpdateEmail(to, name string, dateID int, update string) error {
+	m.genieUpdateTo = append(m.genieUpdateTo, to)
+	return nil
+}
+
+func TestRequestDataExport_Success(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	// No previous exports
+	mock.ExpectQuery("INSERT INTO datifyy_v2_data_exports (.+) WHERE NOT EXISTS").
+		WithArgs(1, int64(86400)).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns).
+			AddRow(7, 1, "pending", nil, nil, nil, nil, nil, 0, now, nil))
+
+	resp, err := service.RequestDataExport(ctx, &userpb.RequestDataExportRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "7", resp.ExportId)
+	assert.Equal(t, "pending", resp.Status)
+	assert.Equal(t, now.Add(24*time.Hour).Unix(), resp.NextAllowedAt.Seconds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestDataExport_RateLimited(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	requestedAt := time.Now().Add(-2 * time.Hour)
+
+	mock.ExpectQuery("INSERT INTO datifyy_v2_data_exports").
+		WithArgs(1, int64(86400)).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_data_exports WHERE user_id = \\$1 AND status <> 'failed'").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns).
+			AddRow(6, 1, "completed", "exports/1/a.zip", 100, nil, nil, nil, 0, requestedAt, requestedAt))
+
+	resp, err := service.RequestDataExport(ctx, &userpb.RequestDataExportRequest{})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), requestedAt.Add(24*time.Hour).UTC().Format(time.RFC3339))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestDataExport_AlreadyInProgress(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	requestedAt := time.Now().Add(-30 * time.Hour)
+
+	// A stuck export older than the cooldown still blocks a second one
+	mock.ExpectQuery("INSERT INTO datifyy_v2_data_exports (.+) ON CONFLICT \\(user_id\\) WHERE status IN \\('pending', 'processing'\\) DO NOTHING").
+		WithArgs(1, int64(86400)).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_data_exports").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns).
+			AddRow(6, 1, "processing", nil, nil, nil, nil, nil, 0, requestedAt, nil))
+
+	_, err := service.RequestDataExport(ctx, &userpb.RequestDataExportRequest{})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "already being prepared")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDataExportDomains_ReadLiveTables(t *testing.T) {
+	tables := map[string]string{}
+	for _, domain := range repository.DataExportDomains {
+		tables[domain.Name] = domain.Query
+	}
+
+	// Preferences, photos and blocks are written to the unprefixed tables
+	assert.Contains(t, tables["user_preferences"], "FROM user_preferences WHERE")
+	assert.Contains(t, tables["photos"], "FROM user_photos WHERE")
+	assert.Contains(t, tables["blocks"], "FROM user_blocks WHERE")
+}
+
+func TestRequestDataExport_Unauthenticated(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	resp, err := service.RequestDataExport(context.Background(), &userpb.RequestDataExportRequest{})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestProcessPendingDataExports_BuildsArchiveAndEmailsLink(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	emailSender := &mockUserEmailSender{}
+	service.emailClient = emailSender
+
+	ctx := context.Background()
+	now := time.Now()
+
+	// Nothing expired
+	mock.ExpectQuery("UPDATE datifyy_v2_data_exports SET status = 'expired'").
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns))
+
+	// One pending export claimed
+	mock.ExpectQuery("UPDATE datifyy_v2_data_exports SET status = 'processing'").
+		WithArgs(dataExportBatchSize).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns).
+			AddRow(9, 1, "processing", nil, nil, nil, nil, nil, 0, now, nil))
+
+	// User lookup for the email address
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "name", "password_hash", "phone_number",
+			"email_verified", "phone_verified", "account_status",
+			"verification_token", "verification_token_expires_at",
+			"password_reset_token", "password_reset_token_expires_at",
+			"last_login_at", "photo_url", "date_of_birth", "gender",
+			"created_at", "updated_at",
+		}).AddRow(
+			1, "test@example.com", "Test User", "hash", nil,
+			true, false, "ACTIVE",
+			nil, nil, nil, nil,
+			nil, nil, nil, "MALE",
+			now, now,
+		))
+
+	// One query per export domain
+	for _, domain := range repository.DataExportDomains {
+		rows := sqlmock.NewRows([]string{"name", "details"})
+		if domain.Name == "account" {
+			rows.AddRow("Test User", []byte(`{"city":"Pune"}`))
+		}
+		mock.ExpectQuery(".+").WithArgs(1).WillReturnRows(rows)
+	}
+
+	mock.ExpectExec("UPDATE datifyy_v2_data_exports SET status = 'completed'").
+		WithArgs(9, "exports/1/datifyy-export-9.zip", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectUserTimezone(mock, 1, "Asia/Kolkata")
+
+	err := service.ProcessPendingDataExports(ctx)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	// The link's expiry is shown in the user's timezone
+	assert.Equal(t, "Asia/Kolkata", emailSender.dataExportExpiry.Location().String())
+
+	// Archive contains one JSON file per domain plus a manifest
+	archive, err := service.blobStore.Get(ctx, "exports/1/datifyy-export-9.zip")
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	assert.Len(t, zr.File, len(repository.DataExportDomains)+1)
+	assert.Equal(t, "account.json", zr.File[0].Name)
+
+	// Signed link emailed to the user
+	assert.Equal(t, "test@example.com", emailSender.dataExportTo)
+	assert.Contains(t, emailSender.dataExportURL, "/api/v1/user/data-export/download?id=9")
+}
+
+func TestOpenDataExport_RejectsTamperedAndExpiredLinks(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+
+	link, err := url.Parse(service.dataExportDownloadURL(9, expiresAt))
+	require.NoError(t, err)
+	sig := link.Query().Get("sig")
+	expires, _ := strconv.ParseInt(link.Query().Get("expires"), 10, 64)
+
+	// Signature does not cover a different export
+	_, err = service.OpenDataExport(ctx, 10, expires, sig)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Signature does not cover a later expiry
+	_, err = service.OpenDataExport(ctx, 9, expires+3600, sig)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Correctly signed but already expired
+	past := time.Now().Add(-time.Minute).Unix()
+	_, err = service.OpenDataExport(ctx, 9, past, service.signDataExport(9, past))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestOpenDataExport_Success(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+
+	require.NoError(t, service.blobStore.Put(ctx, "exports/1/datifyy-export-9.zip", []byte("zip")))
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_data_exports WHERE id").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows(dataExportRowColumns).
+			AddRow(9, 1, "completed", "exports/1/datifyy-export-9.zip", 3, nil, expiresAt, nil, 0, now, now))
+	mock.ExpectExec("UPDATE datifyy_v2_data_exports SET downloaded_at").
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	archive, err := service.OpenDataExport(ctx, 9, expiresAt.Unix(), service.signDataExport(9, expiresAt.Unix()))
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("zip"), archive)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
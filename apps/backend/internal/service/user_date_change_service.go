@@ -0,0 +1,407 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/datestatus"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxDateChangeReasonLength caps cancellation and reschedule reasons
+	maxDateChangeReasonLength = 500
+
+	// maxProposedRescheduleTimes is how many new times a reschedule request
+	// may propose
+	maxProposedRescheduleTimes = 3
+
+	// rescheduleSearchLimit bounds the windows proposed times are checked
+	// against; more than enough for the default two-week search
+	rescheduleSearchLimit = 200
+)
+
+// CancelDate cancels one of the caller's upcoming dates. The other
+// participant and the date's genie are told, and the cancellation counts
+// against the caller's reliability, more so inside the late-cancellation
+// window.
+func (s *UserService) CancelDate(ctx context.Context, req *userpb.CancelDateRequest) (*userpb.CancelDateResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if len(reason) > maxDateChangeReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxDateChangeReasonLength)
+	}
+
+	date, err := s.participantDate(ctx, int(req.DateId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !date.ScheduledTime.After(now) {
+		return nil, status.Error(codes.FailedPrecondition, "date has already started; contact your genie")
+	}
+	late := date.ScheduledTime.Sub(now) < s.lateCancellationWindow
+
+	cancelled, err := s.datesRepo.CancelByUser(ctx, date.ID, userID, reason, late)
+	if errors.Is(err, datestatus.ErrInvalidTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel date: %v", err)
+	}
+
+	otherID := otherParticipant(date, userID)
+	s.notifyDateParticipant(ctx, otherID, repository.NotificationEventDateCancelled, userID, date.ID, 0,
+		func(other *repository.User, loc *time.Location) error {
+			return s.emailClient.SendDateCancelledEmail(other.Email, other.Name, date.ScheduledTime.In(loc), reason)
+		})
+	s.notifyGenie(ctx, date, fmt.Sprintf("User %d cancelled the date with user %d on %s. Reason: %s",
+		userID, otherID, date.ScheduledTime.UTC().Format(time.RFC1123), reason))
+
+	message := "Your date has been cancelled"
+	if late {
+		message = fmt.Sprintf("Your date has been cancelled. Cancelling within %d hours of a date counts as a late cancellation",
+			int(s.lateCancellationWindow.Hours()))
+	}
+
+	return &userpb.CancelDateResponse{
+		DateId:           int32(cancelled.ID),
+		Status:           cancelled.Status,
+		LateCancellation: late,
+		CancelledAt:      timestampFromNullTime(cancelled.CancelledAt),
+		Message:          message,
+	}, nil
+}
+
+// RequestReschedule proposes new times for one of the caller's upcoming
+// dates. Proposed times must be within both participants' availability; when
+// none are given, the best times they're both free are proposed. The date
+// only moves once the other participant accepts one of them.
+func (s *UserService) RequestReschedule(ctx context.Context, req *userpb.RequestRescheduleRequest) (*userpb.RequestRescheduleResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if len(reason) > maxDateChangeReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxDateChangeReasonLength)
+	}
+	if len(req.ProposedTimes) > maxProposedRescheduleTimes {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d times can be proposed", maxProposedRescheduleTimes)
+	}
+
+	date, err := s.participantDate(ctx, int(req.DateId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if (date.Status != datestatus.Scheduled && date.Status != datestatus.Confirmed) || !date.ScheduledTime.After(now) {
+		return nil, status.Error(codes.FailedPrecondition, "only upcoming dates can be rescheduled")
+	}
+
+	otherID := otherParticipant(date, userID)
+	duration := time.Duration(date.DurationMinutes) * time.Minute
+	opts, err := CommonAvailabilityOptions{
+		DateType:    date.DateType,
+		MinDuration: duration,
+		Limit:       rescheduleSearchLimit,
+	}.withDefaults(now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search availability: %v", err)
+	}
+	windows, err := commonAvailability(ctx, s.availRepo, s.datesRepo, userID, otherID, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search availability: %v", err)
+	}
+
+	proposed, err := proposedRescheduleTimes(req.ProposedTimes, windows, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &repository.RescheduleRequest{
+		DateID:        date.ID,
+		UserID:        userID,
+		ResponderID:   otherID,
+		ProposedTimes: proposed,
+		Reason:        toNullString(reason),
+	}
+	if err := s.datesRepo.CreateRescheduleRequest(ctx, request); err != nil {
+		if errors.Is(err, repository.ErrReschedulePending) {
+			return nil, status.Error(codes.FailedPrecondition, "this date already has a pending reschedule request")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to request reschedule: %v", err)
+	}
+
+	requester, err := s.userRepo.GetByID(ctx, userID)
+	requesterName := "Your match"
+	if err == nil {
+		requesterName = requester.Name
+	}
+	s.notifyDateParticipant(ctx, otherID, repository.NotificationEventRescheduleRequested, userID, date.ID, request.ID,
+		func(other *repository.User, loc *time.Location) error {
+			times := make([]time.Time, len(proposed))
+			for i, t := range proposed {
+				times[i] = time.Unix(t, 0).In(loc)
+			}
+			return s.emailClient.SendRescheduleRequestEmail(other.Email, other.Name, requesterName, times, reason)
+		})
+	s.notifyGenie(ctx, date, fmt.Sprintf("User %d asked user %d to reschedule their date on %s. Reason: %s",
+		userID, otherID, date.ScheduledTime.UTC().Format(time.RFC1123), reasonOrNone(reason)))
+
+	return &userpb.RequestRescheduleResponse{
+		Request: convertRescheduleRequest(request),
+		Message: "We've asked your match to pick one of the new times",
+	}, nil
+}
+
+// RespondToReschedule accepts one of the times proposed for a date, moving
+// the date there, or declines them, leaving the date as it was
+func (s *UserService) RespondToReschedule(ctx context.Context, req *userpb.RespondToRescheduleRequest) (*userpb.RespondToRescheduleResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	request, err := s.datesRepo.GetRescheduleRequest(ctx, int(req.RequestId))
+	if errors.Is(err, repository.ErrRescheduleNotFound) || (err == nil && request.ResponderID != userID) {
+		return nil, status.Error(codes.NotFound, "reschedule request not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reschedule request: %v", err)
+	}
+	if request.Status != repository.RescheduleStatusPending {
+		return nil, status.Error(codes.FailedPrecondition, "reschedule request has already been answered")
+	}
+
+	date, err := s.datesRepo.GetByID(ctx, request.DateID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get date: %v", err)
+	}
+
+	var scheduledTime time.Time
+	var eventType, update string
+	if req.Accept {
+		if req.SelectedTime == nil || !containsTime(request.ProposedTimes, req.SelectedTime.Seconds) {
+			return nil, status.Error(codes.InvalidArgument, "selected_time must be one of the proposed times")
+		}
+		scheduledTime = time.Unix(req.SelectedTime.Seconds, 0)
+
+		request, err = s.datesRepo.AcceptRescheduleRequest(ctx, request.ID, scheduledTime)
+		switch {
+		case errors.Is(err, repository.ErrRescheduleAnswered):
+			return nil, status.Error(codes.FailedPrecondition, "reschedule request has already been answered")
+		case errors.Is(err, repository.ErrDateNotActive):
+			return nil, status.Error(codes.FailedPrecondition, "date is no longer upcoming")
+		case errors.Is(err, repository.ErrRescheduleTimePassed):
+			return nil, status.Error(codes.FailedPrecondition, "that time has passed; decline and ask for new times")
+		case errors.Is(err, repository.ErrSlotReserved), errors.Is(err, repository.ErrDateConflict):
+			return nil, status.Error(codes.FailedPrecondition, "that time is no longer free; decline and ask for new times")
+		case err != nil:
+			return nil, status.Errorf(codes.Internal, "failed to accept reschedule request: %v", err)
+		}
+		eventType = repository.NotificationEventRescheduleAccepted
+		update = fmt.Sprintf("User %d accepted a new time for their date with user %d. It moved from %s to %s.",
+			userID, request.UserID, date.ScheduledTime.UTC().Format(time.RFC1123), scheduledTime.UTC().Format(time.RFC1123))
+	} else {
+		request, err = s.datesRepo.DeclineRescheduleRequest(ctx, request.ID)
+		if errors.Is(err, repository.ErrRescheduleAnswered) {
+			return nil, status.Error(codes.FailedPrecondition, "reschedule request has already been answered")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decline reschedule request: %v", err)
+		}
+		scheduledTime = date.ScheduledTime
+		eventType = repository.NotificationEventRescheduleDeclined
+		update = fmt.Sprintf("User %d declined user %d's request to reschedule their date on %s.",
+			userID, request.UserID, date.ScheduledTime.UTC().Format(time.RFC1123))
+	}
+
+	accepted := req.Accept
+	s.notifyDateParticipant(ctx, request.UserID, eventType, userID, date.ID, request.ID,
+		func(requester *repository.User, loc *time.Location) error {
+			return s.emailClient.SendRescheduleAnsweredEmail(requester.Email, requester.Name, accepted, scheduledTime.In(loc))
+		})
+	s.notifyGenie(ctx, date, update)
+
+	message := "You declined the new times; your date is unchanged"
+	if accepted {
+		message = "Your date has been moved to the new time"
+	}
+
+	return &userpb.RespondToRescheduleResponse{
+		Request:       convertRescheduleRequest(request),
+		ScheduledTime: &commonpb.Timestamp{Seconds: scheduledTime.Unix()},
+		Message:       message,
+	}, nil
+}
+
+// participantDate gets a date the user is a participant of. Other users'
+// dates are reported as not found.
+func (s *UserService) participantDate(ctx context.Context, dateID, userID int) (*repository.ScheduledDate, error) {
+	date, err := s.datesRepo.GetByID(ctx, dateID)
+	if errors.Is(err, repository.ErrDateNotFound) || (err == nil && date.User1ID != userID && date.User2ID != userID) {
+		return nil, status.Error(codes.NotFound, "date not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get date: %v", err)
+	}
+	return date, nil
+}
+
+// otherParticipant returns the participant of date who isn't userID
+func otherParticipant(date *repository.ScheduledDate, userID int) int {
+	if date.User1ID == userID {
+		return date.User2ID
+	}
+	return date.User1ID
+}
+
+// proposedRescheduleTimes checks the requested start times fall within the
+// windows both participants are free, or picks the best window starts when
+// none were requested
+func proposedRescheduleTimes(requested []*commonpb.Timestamp, windows []CommonAvailabilityCandidate, duration time.Duration) ([]int64, error) {
+	if len(requested) == 0 {
+		var proposed []int64
+		for _, w := range windows {
+			if !containsTime(proposed, w.Start.Unix()) {
+				proposed = append(proposed, w.Start.Unix())
+			}
+			if len(proposed) == maxProposedRescheduleTimes {
+				break
+			}
+		}
+		if len(proposed) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "you have no times in common in the next two weeks; ask your match to add availability")
+		}
+		return proposed, nil
+	}
+
+	proposed := make([]int64, 0, len(requested))
+	for _, t := range requested {
+		if t == nil {
+			return nil, status.Error(codes.InvalidArgument, "proposed times must be set")
+		}
+		start := time.Unix(t.Seconds, 0)
+		fits := false
+		for _, w := range windows {
+			if !start.Before(w.Start) && !start.Add(duration).After(w.End) {
+				fits = true
+				break
+			}
+		}
+		if !fits {
+			return nil, status.Errorf(codes.InvalidArgument, "%s isn't a time you're both free", start.UTC().Format(time.RFC3339))
+		}
+		if !containsTime(proposed, t.Seconds) {
+			proposed = append(proposed, t.Seconds)
+		}
+	}
+	return proposed, nil
+}
+
+func containsTime(times []int64, t int64) bool {
+	for _, v := range times {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+func reasonOrNone(reason string) string {
+	if reason == "" {
+		return "none given"
+	}
+	return reason
+}
+
+// notifyDateParticipant tells a participant about a change to their date:
+// an in-app notification if they get match notifications, and an email sent
+// by sendEmail in their timezone
+func (s *UserService) notifyDateParticipant(
+	ctx context.Context,
+	userID int,
+	eventType string,
+	actorID, dateID, requestID int,
+	sendEmail func(user *repository.User, loc *time.Location) error,
+) {
+	prefs, err := s.profileRepo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get preferences of user %d: %v", userID, err)
+	} else if prefs.NotifyMatches {
+		payload := map[string]interface{}{"date_id": dateID}
+		if requestID != 0 {
+			payload["reschedule_request_id"] = requestID
+		}
+		if err := s.notifyRepo.CreateEvent(ctx, userID, eventType, actorID, payload); err != nil {
+			log.Printf("Failed to create %s notification for user %d: %v", eventType, userID, err)
+		}
+	}
+
+	if s.emailClient == nil {
+		return
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get user %d to notify about date %d: %v", userID, dateID, err)
+		return
+	}
+	if err := sendEmail(user, userLocation(ctx, s.profileRepo, userID)); err != nil {
+		log.Printf("Failed to send %s email for date %d: %v", eventType, dateID, err)
+	}
+}
+
+// notifyGenie emails the genie assigned to a date about a change its
+// participants made
+func (s *UserService) notifyGenie(ctx context.Context, date *repository.ScheduledDate, update string) {
+	if s.emailClient == nil || !date.GenieID.Valid {
+		return
+	}
+
+	genie, err := s.adminRepo.GetAdminByID(ctx, int(date.GenieID.Int64))
+	if err != nil {
+		log.Printf("Failed to get genie %d of date %d: %v", date.GenieID.Int64, date.ID, err)
+		return
+	}
+	if err := s.emailClient.SendGenieDateUpdateEmail(genie.Email, genie.Name, date.ID, update); err != nil {
+		log.Printf("Failed to email genie %d about date %d: %v", genie.ID, date.ID, err)
+	}
+}
+
+func convertRescheduleRequest(req *repository.RescheduleRequest) *userpb.RescheduleRequest {
+	protoReq := &userpb.RescheduleRequest{
+		Id:          int32(req.ID),
+		DateId:      int32(req.DateID),
+		RequestedBy: int32(req.UserID),
+		Reason:      req.Reason.String,
+		Status:      req.Status,
+		CreatedAt:   &commonpb.Timestamp{Seconds: req.CreatedAt.Unix()},
+		RespondedAt: timestampFromNullTime(req.RespondedAt),
+	}
+	for _, t := range req.ProposedTimes {
+		protoReq.ProposedTimes = append(protoReq.ProposedTimes, &commonpb.Timestamp{Seconds: t})
+	}
+	if req.AcceptedTime.Valid {
+		protoReq.AcceptedTime = &commonpb.Timestamp{Seconds: req.AcceptedTime.Int64}
+	}
+	return protoReq
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// participantDateRows returns date 7 between users 1 and 2 with no genie
+func participantDateRows(dateStatus string, scheduledTime time.Time, cancelledAt interface{}) *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{
+		"id", "user1_id", "user2_id", "genie_id", "scheduled_time", "duration_minutes",
+		"status", "date_type", "place_name", "address", "city", "state", "country", "zipcode",
+		"latitude", "longitude", "notes", "admin_notes", "created_at", "updated_at",
+		"confirmed_at", "completed_at", "cancelled_at",
+	}).AddRow(
+		7, 1, 2, nil, scheduledTime, 60,
+		dateStatus, "online", nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, now, now,
+		nil, nil, cancelledAt,
+	)
+}
+
+func rescheduleRequestRows(requestStatus string, proposed []int64) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "date_id", "user_id", "responder_id", "proposed_times", "reason", "status",
+		"accepted_time", "created_at", "responded_at",
+	}).AddRow(3, 7, 1, 2, pq.Array(proposed), "Work trip", requestStatus, nil, time.Now(), nil)
+}
+
+func TestCancelDate_LateCancellationCounted(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	scheduledTime := time.Now().Add(2 * time.Hour)
+
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("confirmed", scheduledTime, nil))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status FROM datifyy_v2_scheduled_dates WHERE id = \\$1 FOR UPDATE").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("confirmed"))
+	mock.ExpectQuery("SET status = \\$1, cancelled_at = CURRENT_TIMESTAMP, cancelled_by_user_id = \\$3, cancellation_reason = \\$4").
+		WithArgs("cancelled", 7, sql.NullInt64{Int64: 1, Valid: true}, "Fell ill").
+		WillReturnRows(participantDateRows("cancelled", scheduledTime, time.Now()))
+	mock.ExpectExec("UPDATE datifyy_v2_availability_slots SET reserved_date_id = NULL").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_activity_log").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("SET late_cancellation = TRUE").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_date_reliability").
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_date_reschedule_requests SET status = 'withdrawn'").
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	// The other participant gets match notifications
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_notification_events").
+		WithArgs(2, repository.NotificationEventDateCancelled, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	resp, err := service.CancelDate(ctx, &userpb.CancelDateRequest{DateId: 7, Reason: " Fell ill "})
+
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", resp.Status)
+	assert.True(t, resp.LateCancellation)
+	assert.NotNil(t, resp.CancelledAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelDate_NotParticipant(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 3)
+
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("scheduled", time.Now().Add(72*time.Hour), nil))
+
+	_, err := service.CancelDate(ctx, &userpb.CancelDateRequest{DateId: 7, Reason: "Busy"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelDate_RequiresReason(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	_, err := service.CancelDate(ctx, &userpb.CancelDateRequest{DateId: 7, Reason: "  "})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestReschedule_RejectsTimeOutsideAvailability(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("scheduled", time.Now().Add(72*time.Hour), nil))
+	// Neither user has added availability
+	for _, userID := range []int{1, 2} {
+		mock.ExpectQuery("FROM datifyy_v2_availability_slots WHERE user_id = \\$1").
+			WithArgs(userID, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	_, err := service.RequestReschedule(ctx, &userpb.RequestRescheduleRequest{
+		DateId:        7,
+		ProposedTimes: []*commonpb.Timestamp{{Seconds: time.Now().Add(96 * time.Hour).Unix()}},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestReschedule_PastDate(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 2)
+
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("completed", time.Now().Add(-24*time.Hour), nil))
+
+	_, err := service.RequestReschedule(ctx, &userpb.RequestRescheduleRequest{DateId: 7})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProposedRescheduleTimes(t *testing.T) {
+	base := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	windows := []CommonAvailabilityCandidate{
+		{Start: base, End: base.Add(2 * time.Hour)},
+		{Start: base, End: base.Add(3 * time.Hour)},
+		{Start: base.Add(24 * time.Hour), End: base.Add(26 * time.Hour)},
+	}
+
+	// None requested: distinct window starts are proposed
+	proposed, err := proposedRescheduleTimes(nil, windows, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{base.Unix(), base.Add(24 * time.Hour).Unix()}, proposed)
+
+	// Requested times must leave room for the whole date
+	proposed, err = proposedRescheduleTimes([]*commonpb.Timestamp{{Seconds: base.Add(time.Hour).Unix()}}, windows, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{base.Add(time.Hour).Unix()}, proposed)
+
+	_, err = proposedRescheduleTimes([]*commonpb.Timestamp{{Seconds: base.Add(25*time.Hour + 30*time.Minute).Unix()}}, windows, time.Hour)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proposedRescheduleTimes(nil, nil, time.Hour)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestRespondToReschedule_Decline(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 2)
+	scheduledTime := time.Now().Add(72 * time.Hour)
+	proposed := []int64{time.Now().Add(96 * time.Hour).Unix()}
+
+	mock.ExpectQuery("FROM datifyy_v2_date_reschedule_requests WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRows("pending", proposed))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("scheduled", scheduledTime, nil))
+	mock.ExpectQuery("SET status = 'declined'").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRows("declined", proposed))
+
+	// The requester has match notifications on
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(1).
+		WillReturnRows(userPreferenceRows(1, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_notification_events").
+		WithArgs(1, repository.NotificationEventRescheduleDeclined, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	resp, err := service.RespondToReschedule(ctx, &userpb.RespondToRescheduleRequest{RequestId: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, "declined", resp.Request.Status)
+	assert.Equal(t, scheduledTime.Unix(), resp.ScheduledTime.Seconds)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRespondToReschedule_AcceptRequiresProposedTime(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 2)
+	proposed := []int64{time.Now().Add(96 * time.Hour).Unix()}
+
+	mock.ExpectQuery("FROM datifyy_v2_date_reschedule_requests WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRows("pending", proposed))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates WHERE id = \\$1").
+		WithArgs(7).
+		WillReturnRows(participantDateRows("scheduled", time.Now().Add(72*time.Hour), nil))
+
+	_, err := service.RespondToReschedule(ctx, &userpb.RespondToRescheduleRequest{
+		RequestId:    3,
+		Accept:       true,
+		SelectedTime: &commonpb.Timestamp{Seconds: proposed[0] + 3600},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRespondToReschedule_OnlyResponder(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	// The requester can't answer their own request
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("FROM datifyy_v2_date_reschedule_requests WHERE id = \\$1").
+		WithArgs(3).
+		WillReturnRows(rescheduleRequestRows("pending", []int64{time.Now().Add(96 * time.Hour).Unix()}))
+
+	_, err := service.RespondToReschedule(ctx, &userpb.RespondToRescheduleRequest{RequestId: 3, Accept: true})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,39 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log"
+	"time"
 
 	commonpb "github.com/datifyy/backend/gen/common/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/datifyy/backend/internal/repository"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxDiscoveryCandidates caps how many users a search or recommendation
+// query considers before distance filtering and pagination. Responses say
+// when the cap was reached, since their totals are then lower bounds.
+const maxDiscoveryCandidates = 500
+
 // SearchUsers searches for users based on filters
 func (s *UserService) SearchUsers(
 	ctx context.Context,
 	req *userpb.SearchUsersRequest,
 ) (*userpb.SearchUsersResponse, error) {
-	// TODO: Extract user ID from auth context for personalized results
-	// userID, _ := getUserIDFromContext(ctx)
-
-	// TODO: Implement actual database query with filters
-	// - Apply gender filters
-	// - Apply age range filters
-	// - Apply distance filters (requires location data)
-	// - Apply interest filters
-	// - Apply relationship goal filters
-	// - Apply education level filters
-	// - Apply verified_only filter
-	// - Apply online_only filter
-	// - Apply height range filters
-	// - Apply lifestyle filters (drinking, smoking, children)
-	// - Calculate compatibility scores
-	// - Exclude blocked users
-	// - Implement pagination
-
-	// Placeholder response
-	page := int(req.Pagination.Page)
-	pageSize := int(req.Pagination.PageSize)
+	// Anonymous searches are allowed; signed in users get their own
+	// location and discovery radius applied by default
+	viewerID, _ := getUserIDFromContext(ctx)
+
+	page := int(req.GetPagination().GetPage())
+	pageSize := int(req.GetPagination().GetPageSize())
 	if page < 1 {
 		page = 1
 	}
@@ -42,14 +37,96 @@ func (s *UserService) SearchUsers(
 		pageSize = 20
 	}
 
+	filters := req.GetFilters()
+	query := repository.DiscoveryQuery{
+		ViewerID:     viewerID,
+		Genders:      genderFilterValues(filters.GetGender()),
+		MinAge:       int(filters.GetAgeRange().GetMinAge()),
+		MaxAge:       int(filters.GetAgeRange().GetMaxAge()),
+		VerifiedOnly: filters.GetVerifiedOnly(),
+		MinHeight:    int(filters.GetHeightRange().GetMinHeight()),
+		MaxHeight:    int(filters.GetHeightRange().GetMaxHeight()),
+		Limit:        maxDiscoveryCandidates,
+	}
+	if filters.GetOnlineOnly() {
+		query.ActiveSince = time.Now().Add(-onlineWindow)
+	}
+
+	// Distances are measured from the searched location, or the viewer's own
+	var center string
+	if loc := filters.GetLocation(); loc != nil {
+		point, ok, err := resolveLocationPoint(ctx, s.geocoder, loc)
+		if errors.Is(err, errInvalidCoordinates) {
+			return nil, status.Error(codes.InvalidArgument, "invalid location coordinates")
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to resolve location")
+		}
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "location could not be resolved")
+		}
+		center = geo.EncodeGeohash(point, geo.CoarsePrecision)
+	} else if viewerID != 0 {
+		var err error
+		center, err = s.discoveryRepo.GetCoarseLocation(ctx, viewerID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to load location")
+		}
+	}
+
+	var partnerPrefs *repository.PartnerPreferences
+	radius := int(filters.GetDistance())
+	if viewerID != 0 {
+		var userPrefs *repository.UserPreferences
+		userPrefs, partnerPrefs = s.loadDiscoveryPreferences(ctx, viewerID)
+		if radius <= 0 {
+			radius = discoveryRadiusKm(userPrefs, partnerPrefs)
+		}
+	}
+	query.Bounds = discoveryBounds(center, radius)
+
+	candidates, err := s.discoveryRepo.FindCandidates(ctx, query)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search users")
+	}
+	nearby := filterByDistance(candidates, center, radius)
+	nearby, err = s.screenCandidates(ctx, viewerID, partnerPrefs, filters, nearby)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(nearby)
+	start := (page - 1) * pageSize
+	if start > totalCount {
+		start = totalCount
+	}
+	end := start + pageSize
+	if end > totalCount {
+		end = totalCount
+	}
+
+	profiles, err := s.buildDiscoveryProfiles(ctx, viewerID, nearby[start:end])
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load search results")
+	}
+	users := []*userpb.UserProfile{}
+	for _, candidate := range nearby[start:end] {
+		if profile, ok := profiles[candidate.UserID]; ok {
+			users = append(users, profile)
+		}
+	}
+
+	totalPages := (totalCount + pageSize - 1) / pageSize
+
 	return &userpb.SearchUsersResponse{
-		Users: []*userpb.UserProfile{},
+		Users: users,
 		Pagination: &commonpb.PaginationResponse{
 			Page:       int32(page),
 			PageSize:   int32(pageSize),
-			TotalCount: 0,
-			TotalPages: 0,
+			TotalCount: int64(totalCount),
+			TotalPages: int32(totalPages),
 		},
+		ResultsCapped: len(candidates) >= maxDiscoveryCandidates,
 	}, nil
 }
 
@@ -58,35 +135,311 @@ func (s *UserService) GetRecommendations(
 	ctx context.Context,
 	req *userpb.GetRecommendationsRequest,
 ) (*userpb.GetRecommendationsResponse, error) {
-	// TODO: Extract user ID from auth context
 	userID, err := getUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "authentication required")
 	}
 
-	// TODO: Implement recommendation algorithm
-	// - Get user's partner preferences
-	// - Find users matching preferences
-	// - Calculate compatibility scores based on:
-	//   * Shared interests
-	//   * Similar relationship goals
-	//   * Compatible lifestyle choices
-	//   * Location proximity
-	// - Apply machine learning/ranking algorithm
-	// - Exclude already seen/matched/rejected users
-	// - Exclude blocked users
-
 	// Default limit if not specified
-	limit := req.Limit
+	limit := int(req.Limit)
 	if limit <= 0 {
 		limit = 10
 	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	userPrefs, partnerPrefs := s.loadDiscoveryPreferences(ctx, userID)
 
-	_ = userID // Use the userID variable
+	query := repository.DiscoveryQuery{
+		ViewerID:     userID,
+		Genders:      partnerGenderFilterValues(partnerPrefs),
+		VerifiedOnly: userPrefs != nil && userPrefs.VerifiedOnly,
+		ExcludeSeen:  true,
+		Limit:        maxDiscoveryCandidates,
+	}
+	if partnerPrefs != nil {
+		query.MinAge = partnerPrefs.AgeRangeMin
+		query.MaxAge = partnerPrefs.AgeRangeMax
+		query.VerifiedOnly = query.VerifiedOnly || partnerPrefs.VerifiedOnly
+	}
+
+	center, err := s.discoveryRepo.GetCoarseLocation(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load location")
+	}
+	radius := discoveryRadiusKm(userPrefs, partnerPrefs)
+	query.Bounds = discoveryBounds(center, radius)
+
+	candidates, err := s.discoveryRepo.FindCandidates(ctx, query)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get recommendations")
+	}
+	nearby := filterByDistance(candidates, center, radius)
+	nearby, err = s.screenCandidates(ctx, userID, partnerPrefs, nil, nearby)
+	if err != nil {
+		return nil, err
+	}
+
+	top := nearby
+	if len(top) > limit {
+		top = top[:limit]
+	}
+	profiles, err := s.buildDiscoveryProfiles(ctx, userID, top)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load recommendations")
+	}
+	recommendations := []*userpb.UserProfile{}
+	for _, candidate := range top {
+		if profile, ok := profiles[candidate.UserID]; ok {
+			recommendations = append(recommendations, profile)
+		}
+	}
 
-	// Placeholder response
 	return &userpb.GetRecommendationsResponse{
-		Recommendations: []*userpb.UserProfile{},
-		TotalCount:      0,
+		Recommendations: recommendations,
+		TotalCount:      int32(len(nearby)),
+		ResultsCapped:   len(candidates) >= maxDiscoveryCandidates,
 	}, nil
 }
+
+// loadDiscoveryPreferences loads a user's app and partner preferences.
+// Either may be nil if it couldn't be loaded.
+func (s *UserService) loadDiscoveryPreferences(ctx context.Context, userID int) (*repository.UserPreferences, *repository.PartnerPreferences) {
+	userPrefs, err := s.profileRepo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		userPrefs = nil
+	}
+	partnerPrefs, err := s.profileRepo.GetPartnerPreferences(ctx, userID)
+	if err != nil {
+		partnerPrefs = nil
+	}
+	return userPrefs, partnerPrefs
+}
+
+// screenCandidates loads the candidates' profiles once, applies the search
+// filters and, for a signed in viewer, their partner preferences and horoscope
+// requirement, then ranks what's left by compatibility with the viewer.
+// Candidates without a profile are dropped.
+func (s *UserService) screenCandidates(ctx context.Context, viewerID int, partnerPrefs *repository.PartnerPreferences, filters *userpb.SearchFilters, nearby []nearbyCandidate) ([]nearbyCandidate, error) {
+	if len(nearby) == 0 {
+		return nearby, nil
+	}
+
+	ids := make([]int, 0, len(nearby)+1)
+	for _, c := range nearby {
+		ids = append(ids, c.UserID)
+	}
+	if viewerID != 0 {
+		ids = append(ids, viewerID)
+	}
+	profiles, err := loadRuleProfiles(ctx, s.userRepo, s.profileRepo, ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load candidate profiles")
+	}
+
+	var screened []nearbyCandidate
+	for _, c := range nearby {
+		if profile, ok := profiles[c.UserID]; ok && matchesSearchFilters(filters, profile) {
+			screened = append(screened, c)
+		}
+	}
+	if viewerID == 0 {
+		return screened, nil
+	}
+
+	screened, err = s.applyMatchRules(ctx, viewerID, partnerPrefs, screened, profiles)
+	if err != nil {
+		return nil, err
+	}
+	screened, err = s.matchHoroscopes(ctx, viewerID, partnerPrefs, screened)
+	if err != nil {
+		return nil, err
+	}
+	rankByCompatibility(profiles[viewerID], screened, profiles)
+
+	return screened, nil
+}
+
+// applyMatchRules checks candidates against the viewer's deal-breakers,
+// must-haves and preference lists, using their already loaded rule profiles.
+// Candidates failing a deal-breaker are dropped; the rest get a preference
+// score from the soft rules.
+func (s *UserService) applyMatchRules(ctx context.Context, viewerID int, partnerPrefs *repository.PartnerPreferences, nearby []nearbyCandidate, profiles map[int]*userpb.UserProfile) ([]nearbyCandidate, error) {
+	if len(nearby) == 0 {
+		return nearby, nil
+	}
+	rules, err := compileMatchRules(ctx, s.userRepo, s.profileRepo, viewerID, partnerPrefs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load partner preferences")
+	}
+	if rules.Len() == 0 {
+		return nearby, nil
+	}
+
+	var matched []nearbyCandidate
+	for _, c := range nearby {
+		profile, ok := profiles[c.UserID]
+		if !ok {
+			continue
+		}
+		if c.HasDistance {
+			profile.DistanceKm = roundedDistanceKm(c.DistanceKm)
+		}
+		eval := rules.Evaluate(profile)
+		if !eval.Passed() {
+			continue
+		}
+		if score, ok := eval.Score(); ok {
+			c.PreferenceScore = int32(score)
+		}
+		matched = append(matched, c)
+	}
+	return matched, nil
+}
+
+// matchHoroscopes attaches each candidate's Guna Milan with the viewer.
+// Viewers who require horoscope matching only keep acceptable matches, and
+// must have given their own birth details.
+func (s *UserService) matchHoroscopes(ctx context.Context, viewerID int, partnerPrefs *repository.PartnerPreferences, nearby []nearbyCandidate) ([]nearbyCandidate, error) {
+	if len(nearby) == 0 {
+		return nearby, nil
+	}
+	required := partnerPrefs != nil && partnerPrefs.HoroscopeRequired
+
+	ids := make([]int, len(nearby))
+	for i, c := range nearby {
+		ids[i] = c.UserID
+	}
+
+	matches, err := horoscopeMatches(ctx, s.discoveryRepo, viewerID, ids)
+	if errors.Is(err, errBirthDetailsMissing) {
+		if required {
+			return nil, status.Error(codes.FailedPrecondition, "add your birth time and place to use horoscope matching")
+		}
+		return nearby, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to match horoscopes")
+	}
+
+	var matched []nearbyCandidate
+	for _, c := range nearby {
+		if match, ok := matches[c.UserID]; ok {
+			c.Horoscope = &match
+		}
+		if required && (c.Horoscope == nil || !c.Horoscope.Acceptable()) {
+			continue
+		}
+		matched = append(matched, c)
+	}
+	return matched, nil
+}
+
+// buildDiscoveryProfiles builds candidates' profiles as the viewer may see
+// them, keyed by user ID. Everything is loaded in bulk; candidates whose user
+// or profile no longer exists are left out.
+func (s *UserService) buildDiscoveryProfiles(ctx context.Context, viewerID int, candidates []nearbyCandidate) (map[int]*userpb.UserProfile, error) {
+	built := make(map[int]*userpb.UserProfile, len(candidates))
+	if len(candidates) == 0 {
+		return built, nil
+	}
+
+	ids := make([]int, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+
+	users, err := s.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := s.profileRepo.GetProfilesByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// The rest is only loaded for users that still exist
+	ids = ids[:0]
+	for _, c := range candidates {
+		if users[c.UserID] != nil && profiles[c.UserID] != nil {
+			ids = append(ids, c.UserID)
+		}
+	}
+	if len(ids) == 0 {
+		return built, nil
+	}
+
+	photos, err := s.profileRepo.GetPhotosByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	userPrefs, err := s.profileRepo.GetUserPreferencesByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	partnerPrefs, err := s.profileRepo.GetPartnerPreferencesByUserIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	matched := map[int]bool{}
+	if viewerID != 0 {
+		matched, err = s.relationRepo.MatchedUserIDs(ctx, viewerID, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, candidate := range candidates {
+		user, profile := users[candidate.UserID], profiles[candidate.UserID]
+		if user == nil || profile == nil {
+			continue
+		}
+
+		prefs := userPrefs[candidate.UserID]
+		pbProfile, err := buildUserProfileFromDB(user, profile, photos[candidate.UserID], partnerPrefs[candidate.UserID], prefs)
+		if err != nil {
+			log.Printf("Failed to build profile of user %d: %v", candidate.UserID, err)
+			continue
+		}
+
+		relationship := ViewerStranger
+		switch {
+		case candidate.UserID == viewerID:
+			relationship = ViewerSelf
+		case matched[candidate.UserID]:
+			relationship = ViewerMatched
+		}
+		if candidate.HasDistance {
+			pbProfile.DistanceKm = roundedDistanceKm(candidate.DistanceKm)
+		}
+		pbProfile.HoroscopeMatch = horoscopeMatchToProto(candidate.Horoscope)
+		pbProfile.CompatibilityScore = candidate.PreferenceScore
+		applyProfileVisibility(pbProfile, profileVisibility(prefs, relationship))
+
+		built[candidate.UserID] = pbProfile
+	}
+
+	return built, nil
+}
+
+// distanceFromViewer returns the rounded distance between the viewer and a
+// profile, or 0 when either location is unknown
+func (s *UserService) distanceFromViewer(ctx context.Context, viewerID int, profile *repository.UserProfile) int32 {
+	ownerHash := repository.CoarseGeohash(profile.Geohash, profile.Latitude, profile.Longitude)
+	if ownerHash == "" {
+		return 0
+	}
+
+	viewerHash, err := s.discoveryRepo.GetCoarseLocation(ctx, viewerID)
+	if err != nil {
+		log.Printf("Failed to load location for user %d: %v", viewerID, err)
+		return 0
+	}
+
+	km, ok := geo.CoarseDistanceKm(viewerHash, ownerHash)
+	if !ok {
+		return 0
+	}
+	return roundedDistanceKm(km)
+}
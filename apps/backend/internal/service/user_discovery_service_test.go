@@ -2,20 +2,27 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	commonpb "github.com/datifyy/backend/gen/common/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestSearchUsers_Success(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.Background()
 
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+
 	req := &userpb.SearchUsersRequest{
 		Filters: &userpb.SearchFilters{
 			Gender: []userpb.Gender{userpb.Gender_GENDER_FEMALE},
@@ -44,11 +51,14 @@ func TestSearchUsers_Success(t *testing.T) {
 }
 
 func TestSearchUsers_DefaultPagination(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.Background()
 
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+
 	req := &userpb.SearchUsersRequest{
 		Pagination: &commonpb.PaginationRequest{
 			Page:     0, // Invalid
@@ -67,11 +77,14 @@ func TestSearchUsers_DefaultPagination(t *testing.T) {
 }
 
 func TestSearchUsers_MaxPageSize(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.Background()
 
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+
 	req := &userpb.SearchUsersRequest{
 		Pagination: &commonpb.PaginationRequest{
 			Page:     1,
@@ -89,11 +102,13 @@ func TestSearchUsers_MaxPageSize(t *testing.T) {
 }
 
 func TestGetRecommendations_Success(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
+	expectRecommendationQueries(mock)
+
 	req := &userpb.GetRecommendationsRequest{
 		Limit: 10,
 	}
@@ -105,15 +120,17 @@ func TestGetRecommendations_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.NotNil(t, resp.Recommendations)
-	assert.Equal(t, int32(0), resp.TotalCount) // Placeholder returns 0
+	assert.Equal(t, int32(0), resp.TotalCount)
 }
 
 func TestGetRecommendations_DefaultLimit(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
+	expectRecommendationQueries(mock)
+
 	req := &userpb.GetRecommendationsRequest{
 		Limit: 0, // Should use default
 	}
@@ -124,15 +141,17 @@ func TestGetRecommendations_DefaultLimit(t *testing.T) {
 	// Assert
 	require.NoError(t, err)
 	require.NotNil(t, resp)
-	// Placeholder implementation returns empty, but doesn't error
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestGetRecommendations_LargeLimit(t *testing.T) {
-	service, _, db := setupTestUserService(t)
+	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
+	expectRecommendationQueries(mock)
+
 	req := &userpb.GetRecommendationsRequest{
 		Limit: 100,
 	}
@@ -145,3 +164,164 @@ func TestGetRecommendations_LargeLimit(t *testing.T) {
 	require.NotNil(t, resp)
 	assert.NotNil(t, resp.Recommendations)
 }
+
+// expectRecommendationQueries mocks a viewer without preferences or a location
+// and no matching candidates
+func expectRecommendationQueries(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("FROM user_preferences").WillReturnError(errors.New("connection refused"))
+	mock.ExpectQuery("FROM datifyy_v2_partner_preferences").WillReturnError(errors.New("connection refused"))
+	mock.ExpectQuery("SELECT geohash, latitude, longitude FROM datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"geohash", "latitude", "longitude"}))
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+}
+
+func TestSearchUsers_FiltersByDistance(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+
+	// One candidate in Pune, one in Mumbai (~120km away) and one without a location
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}).
+			AddRow(2, "tek92", 18.5204, 73.8567).
+			AddRow(3, "te7ud", 19.0760, 72.8777).
+			AddRow(4, nil, nil, nil))
+
+	// Only the Pune candidate is screened and built, each in one batch
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(ruleUserRows(2))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(ruleProfileRows(map[int]string{2: "NEVER"}))
+	expectDiscoveryProfileBatch(mock, 2)
+
+	req := &userpb.SearchUsersRequest{
+		Filters: &userpb.SearchFilters{
+			Distance: 50,
+			Location: &commonpb.Location{City: "Pune"},
+		},
+		Pagination: &commonpb.PaginationRequest{Page: 1, PageSize: 20},
+	}
+
+	resp, err := service.SearchUsers(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.Pagination.TotalCount)
+	assert.False(t, resp.ResultsCapped)
+	require.Len(t, resp.Users, 1)
+	assert.Equal(t, "2", resp.Users[0].UserId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectDiscoveryProfileBatch mocks the bulk loads behind an anonymous
+// viewer's page of results for the given users
+func expectDiscoveryProfileBatch(mock sqlmock.Sqlmock, ids ...int) {
+	smoking := make(map[int]string)
+	for _, id := range ids {
+		smoking[id] = "NEVER"
+	}
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(ruleUserRows(ids...))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(ruleProfileRows(smoking))
+	mock.ExpectQuery("FROM user_photos WHERE user_id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("FROM user_preferences WHERE user_id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("FROM datifyy_v2_partner_preferences WHERE user_id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+func TestSearchUsers_UnknownLocation(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	req := &userpb.SearchUsersRequest{
+		Filters: &userpb.SearchFilters{
+			Distance: 25,
+			Location: &commonpb.Location{City: "Atlantis"},
+		},
+	}
+
+	resp, err := service.SearchUsers(context.Background(), req)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetRecommendations_Unauthenticated(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	resp, err := service.GetRecommendations(context.Background(), &userpb.GetRecommendationsRequest{})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestSearchUsers_HeightAndOnlineFiltersInQuery(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("AND p.height >= \\$2 AND p.height <= \\$3(.+)FROM datifyy_v2_sessions s(.+)s.last_active_at >= \\$4").
+		WithArgs(0, 160, 180, sqlmock.AnyArg(), maxDiscoveryCandidates).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+
+	resp, err := service.SearchUsers(context.Background(), &userpb.SearchUsersRequest{
+		Filters: &userpb.SearchFilters{
+			HeightRange: &userpb.HeightRange{MinHeight: 160, MaxHeight: 180},
+			OnlineOnly:  true,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchUsers_ReportsCandidateCap(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"})
+	for id := 2; id < maxDiscoveryCandidates+2; id++ {
+		rows.AddRow(id, nil, nil, nil)
+	}
+	mock.ExpectQuery("FROM datifyy_v2_users u\\s+JOIN datifyy_v2_user_profiles").
+		WillReturnRows(rows)
+	// None of them have a profile any more
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	resp, err := service.SearchUsers(context.Background(), &userpb.SearchUsersRequest{})
+
+	require.NoError(t, err)
+	assert.Zero(t, resp.Pagination.TotalCount)
+	assert.True(t, resp.ResultsCapped)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetRecommendations_ExcludesSeenUsers(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("FROM user_preferences").WillReturnError(errors.New("connection refused"))
+	mock.ExpectQuery("FROM datifyy_v2_partner_preferences").WillReturnError(errors.New("connection refused"))
+	mock.ExpectQuery("SELECT geohash, latitude, longitude FROM datifyy_v2_user_profiles").
+		WillReturnRows(sqlmock.NewRows([]string{"geohash", "latitude", "longitude"}))
+	mock.ExpectQuery("FROM datifyy_v2_user_likes l\\s+WHERE l.user_id = \\$1(.+)FROM datifyy_v2_scheduled_dates d").
+		WithArgs(1, maxDiscoveryCandidates).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "geohash", "latitude", "longitude"}))
+
+	resp, err := service.GetRecommendations(ctx, &userpb.GetRecommendationsRequest{})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Recommendations)
+	assert.False(t, resp.ResultsCapped)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/auth"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAppealMessageLength caps the text a user can submit with an appeal
+const maxAppealMessageLength = 2000
+
+// AppealSuspension files an appeal against the suspension or ban currently in force.
+// Restricted users cannot sign in, so an unauthenticated call identifies the user
+// by email and password instead.
+func (s *UserService) AppealSuspension(
+	ctx context.Context,
+	req *userpb.AppealSuspensionRequest,
+) (*userpb.AppealSuspensionResponse, error) {
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return nil, status.Error(codes.InvalidArgument, "message is required")
+	}
+	if len(message) > maxAppealMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "message must be at most %d characters", maxAppealMessageLength)
+	}
+
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		userID, err = s.authenticateAppeal(ctx, req.Email, req.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	enforcement, err := s.moderationRepo.GetActiveEnforcement(ctx, userID)
+	if errors.Is(err, repository.ErrNoActiveEnforcement) {
+		return nil, status.Error(codes.FailedPrecondition, "account has no active suspension or ban")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enforcement: %v", err)
+	}
+
+	appeal, err := s.moderationRepo.CreateAppeal(ctx, userID, enforcement.ID, message)
+	if errors.Is(err, repository.ErrAppealExists) {
+		return nil, status.Error(codes.AlreadyExists, "this suspension has already been appealed")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create appeal: %v", err)
+	}
+
+	s.alertModerators(ctx, "Enforcement appeal submitted", "A user appealed a suspension or ban", map[string]string{
+		"User ID":   strconv.Itoa(userID),
+		"Appeal ID": strconv.Itoa(appeal.ID),
+		"Action":    enforcement.Action,
+	})
+
+	return &userpb.AppealSuspensionResponse{
+		AppealId: strconv.Itoa(appeal.ID),
+		Message:  "Your appeal has been submitted and will be reviewed by our team",
+	}, nil
+}
+
+// authenticateAppeal verifies the credentials of a user who cannot sign in
+func (s *UserService) authenticateAppeal(ctx context.Context, email, password string) (int, error) {
+	if email == "" || password == "" {
+		return 0, status.Error(codes.Unauthenticated, "email and password are required")
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return 0, status.Error(codes.Unauthenticated, "invalid email or password")
+		}
+		return 0, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	if !user.PasswordHash.Valid || auth.VerifyPassword(user.PasswordHash.String, password) != nil {
+		return 0, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	return user.ID, nil
+}
+
+// LiftExpiredSuspensions reactivates accounts whose timed suspension has ended.
+// It is run periodically by the background job runner.
+func (s *UserService) LiftExpiredSuspensions(ctx context.Context) error {
+	reactivated, err := s.moderationRepo.LiftExpiredSuspensions(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if len(reactivated) > 0 {
+		log.Printf("Lifted expired suspensions for %d users", len(reactivated))
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var moderationActionColumns = []string{
+	"id", "user_id", "report_id", "admin_id", "action", "duration_days", "expires_at", "notes",
+	"lifted_at", "lifted_reason", "created_at",
+}
+
+func TestAppealSuspension_SuspendedUserWithCredentials(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	email := "test@example.com"
+	password := "TestPass123!"
+	hashedPassword, _ := auth.HashPassword(password)
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE email").
+		WithArgs(email).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "name", "password_hash", "phone_number",
+			"email_verified", "phone_verified", "account_status",
+			"verification_token", "verification_token_expires_at",
+			"password_reset_token", "password_reset_token_expires_at",
+			"last_login_at", "photo_url", "date_of_birth", "gender",
+			"created_at", "updated_at",
+		}).AddRow(
+			1, email, "Test User", hashedPassword, nil,
+			true, false, "SUSPENDED",
+			nil, nil,
+			nil, nil,
+			nil, nil, nil, nil,
+			now, now,
+		))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_moderation_actions").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(moderationActionColumns).AddRow(
+			11, 1, 7, 5, "SUSPEND", 7, now.Add(72*time.Hour), "Harassment",
+			nil, nil, now.Add(-96*time.Hour),
+		))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_enforcement_appeals").
+		WithArgs(1, 11, "It was a misunderstanding").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow(3, "PENDING", now))
+
+	resp, err := service.AppealSuspension(ctx, &userpb.AppealSuspensionRequest{
+		Email:    email,
+		Password: password,
+		Message:  "  It was a misunderstanding ",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "3", resp.AppealId)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppealSuspension_WrongPassword(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	hashedPassword, _ := auth.HashPassword("TestPass123!")
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE email").
+		WithArgs("test@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "name", "password_hash", "phone_number",
+			"email_verified", "phone_verified", "account_status",
+			"verification_token", "verification_token_expires_at",
+			"password_reset_token", "password_reset_token_expires_at",
+			"last_login_at", "photo_url", "date_of_birth", "gender",
+			"created_at", "updated_at",
+		}).AddRow(
+			1, "test@example.com", "Test User", hashedPassword, nil,
+			true, false, "SUSPENDED",
+			nil, nil,
+			nil, nil,
+			nil, nil, nil, nil,
+			now, now,
+		))
+
+	_, err := service.AppealSuspension(context.Background(), &userpb.AppealSuspensionRequest{
+		Email:    "test@example.com",
+		Password: "WrongPass123!",
+		Message:  "Please reconsider",
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppealSuspension_NoActiveEnforcement(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_moderation_actions").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(moderationActionColumns))
+
+	_, err := service.AppealSuspension(ctx, &userpb.AppealSuspensionRequest{Message: "Please reconsider"})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLiftExpiredSuspensions_ReactivatesUsers(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("UPDATE datifyy_v2_moderation_actions SET lifted_at = NOW\\(\\), lifted_reason = 'Suspension expired'").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1).AddRow(2))
+	// User 2 is still banned, so only user 1 comes back
+	mock.ExpectQuery("UPDATE datifyy_v2_users u SET account_status = 'ACTIVE'").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	err := service.LiftExpiredSuspensions(context.Background())
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// likeResult is the outcome of a like or super like
+type likeResult struct {
+	isMutual       bool
+	matchID        int
+	remainingToday int
+}
+
+// LikeUser expresses interest in another user
+func (s *UserService) LikeUser(
+	ctx context.Context,
+	req *userpb.LikeUserRequest,
+) (*userpb.LikeUserResponse, error) {
+	result, err := s.sendLike(ctx, req.UserId, repository.LikeActionLike)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userpb.LikeUserResponse{
+		IsMutual:       result.isMutual,
+		RemainingToday: int32(result.remainingToday),
+		Message:        "User liked",
+	}
+	if result.isMutual {
+		resp.MatchId = strconv.Itoa(result.matchID)
+		resp.Message = "It's mutual! Our genies will be in touch"
+	}
+	return resp, nil
+}
+
+// SuperLikeUser expresses strong interest in another user
+func (s *UserService) SuperLikeUser(
+	ctx context.Context,
+	req *userpb.SuperLikeUserRequest,
+) (*userpb.SuperLikeUserResponse, error) {
+	result, err := s.sendLike(ctx, req.UserId, repository.LikeActionSuperLike)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userpb.SuperLikeUserResponse{
+		IsMutual:       result.isMutual,
+		RemainingToday: int32(result.remainingToday),
+		Message:        "User super liked",
+	}
+	if result.isMutual {
+		resp.MatchId = strconv.Itoa(result.matchID)
+		resp.Message = "It's mutual! Our genies will be in touch"
+	}
+	return resp, nil
+}
+
+// PassUser records that the caller isn't interested in a user. Passing on
+// someone previously liked withdraws a mutual like match genies haven't acted on.
+func (s *UserService) PassUser(
+	ctx context.Context,
+	req *userpb.PassUserRequest,
+) (*userpb.PassUserResponse, error) {
+	userID, targetID, err := s.resolveLikeTarget(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.likeRepo.SetAction(ctx, userID, targetID, repository.LikeActionPass); err != nil {
+		return nil, status.Error(codes.Internal, "failed to pass on user")
+	}
+
+	if err := s.matchesRepo.WithdrawPendingMutualLike(ctx, userID, targetID); err != nil {
+		log.Printf("Failed to withdraw mutual like match for users %d and %d: %v", userID, targetID, err)
+	}
+
+	return &userpb.PassUserResponse{
+		Message: "User passed",
+	}, nil
+}
+
+// ListLikesReceived lists likes and super likes the caller hasn't responded to
+func (s *UserService) ListLikesReceived(
+	ctx context.Context,
+	req *userpb.ListLikesReceivedRequest,
+) (*userpb.ListLikesReceivedResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	page := int(req.GetPagination().GetPage())
+	pageSize := int(req.GetPagination().GetPageSize())
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (page - 1) * pageSize
+
+	received, totalCount, err := s.likeRepo.ListReceived(ctx, userID, pageSize, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get likes")
+	}
+
+	senders := make([]nearbyCandidate, len(received))
+	for i, like := range received {
+		senders[i] = nearbyCandidate{UserID: like.UserID}
+	}
+	profiles, err := s.buildDiscoveryProfiles(ctx, userID, senders)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load likes")
+	}
+
+	likes := make([]*userpb.ReceivedLike, 0, len(received))
+	for _, like := range received {
+		profile, ok := profiles[like.UserID]
+		if !ok {
+			continue
+		}
+
+		likes = append(likes, &userpb.ReceivedLike{
+			Profile:     profile,
+			IsSuperLike: like.Action == repository.LikeActionSuperLike,
+			LikedAt:     timeToProto(like.LikedAt),
+		})
+	}
+
+	totalPages := (totalCount + pageSize - 1) / pageSize
+
+	return &userpb.ListLikesReceivedResponse{
+		Likes: likes,
+		Pagination: &commonpb.PaginationResponse{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			TotalCount: int64(totalCount),
+			TotalPages: int32(totalPages),
+		},
+	}, nil
+}
+
+// sendLike records a like or super like, enforcing the daily quota, notifying
+// the other user and creating a curated match when the interest is mutual.
+// Repeating the same action is idempotent and doesn't use quota.
+func (s *UserService) sendLike(ctx context.Context, targetUserID string, action string) (*likeResult, error) {
+	userID, targetID, err := s.resolveLikeTarget(ctx, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := s.dailyLikeLimit
+	if action == repository.LikeActionSuperLike {
+		limit = s.dailySuperLikeLimit
+	}
+
+	// The quota resets at midnight in the user's own timezone (UTC if unset)
+	now := time.Now().In(userLocation(ctx, s.profileRepo, userID))
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	used, saved, err := s.likeRepo.SetActionWithinLimit(ctx, userID, targetID, action, startOfDay, limit)
+	if errors.Is(err, repository.ErrLikeLimitReached) {
+		if action == repository.LikeActionSuperLike {
+			return nil, status.Error(codes.ResourceExhausted, "daily super like limit reached")
+		}
+		return nil, status.Error(codes.ResourceExhausted, "daily like limit reached")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to save like")
+	}
+	if saved {
+		s.notifyLike(ctx, userID, targetID, action)
+	}
+
+	result := &likeResult{remainingToday: limit - used}
+	if result.remainingToday < 0 {
+		result.remainingToday = 0
+	}
+
+	theirs, err := s.likeRepo.GetAction(ctx, targetID, userID)
+	if err != nil {
+		log.Printf("Failed to check mutual like for users %d and %d: %v", userID, targetID, err)
+		return result, nil
+	}
+	if theirs != repository.LikeActionLike && theirs != repository.LikeActionSuperLike {
+		return result, nil
+	}
+
+	matchID, created, err := s.matchesRepo.CreateFromMutualLike(ctx, userID, targetID)
+	if err != nil {
+		log.Printf("Failed to create mutual like match for users %d and %d: %v", userID, targetID, err)
+		return result, nil
+	}
+	if created {
+		log.Printf("Created mutual like match %d for users %d and %d", matchID, userID, targetID)
+	}
+
+	result.isMutual = true
+	result.matchID = matchID
+	return result, nil
+}
+
+// resolveLikeTarget validates the caller and the user they're acting on.
+// Blocked and inactive users are reported as not found.
+func (s *UserService) resolveLikeTarget(ctx context.Context, targetUserID string) (userID, targetID int, err error) {
+	userID, err = getUserIDFromContext(ctx)
+	if err != nil {
+		return 0, 0, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	if targetUserID == "" {
+		return 0, 0, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	targetID, err = strconv.Atoi(targetUserID)
+	if err != nil {
+		return 0, 0, status.Error(codes.InvalidArgument, "invalid user_id format")
+	}
+	if targetID == userID {
+		return 0, 0, status.Error(codes.InvalidArgument, "cannot like or pass on yourself")
+	}
+
+	target, err := s.userRepo.GetByID(ctx, targetID)
+	if err != nil || target.AccountStatus != "ACTIVE" {
+		return 0, 0, status.Error(codes.NotFound, "user not found")
+	}
+
+	blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, userID, targetID)
+	if err != nil {
+		return 0, 0, status.Error(codes.Internal, "failed to check block status")
+	}
+	if blocked {
+		return 0, 0, status.Error(codes.NotFound, "user not found")
+	}
+
+	return userID, targetID, nil
+}
+
+// notifyLike queues a like notification if the recipient opted in
+func (s *UserService) notifyLike(ctx context.Context, userID, targetID int, action string) {
+	prefs, err := s.profileRepo.GetUserPreferences(ctx, targetID)
+	if err != nil {
+		log.Printf("Failed to get preferences of user %d: %v", targetID, err)
+		return
+	}
+
+	eventType := repository.NotificationEventLike
+	enabled := prefs.NotifyLikes
+	if action == repository.LikeActionSuperLike {
+		eventType = repository.NotificationEventSuperLike
+		enabled = prefs.NotifySuperLikes
+	}
+	if !enabled {
+		return
+	}
+
+	if err := s.notifyRepo.CreateEvent(ctx, targetID, eventType, userID, nil); err != nil {
+		log.Printf("Failed to create like notification for user %d: %v", targetID, err)
+	}
+}
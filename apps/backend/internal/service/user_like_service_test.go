@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// expectLikeTarget mocks loading an active, unblocked target user
+func expectLikeTarget(mock sqlmock.Sqlmock, targetID int) {
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id").
+		WithArgs(targetID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "name", "password_hash", "phone_number",
+			"email_verified", "phone_verified", "account_status",
+			"verification_token", "verification_token_expires_at",
+			"password_reset_token", "password_reset_token_expires_at",
+			"last_login_at", "photo_url", "date_of_birth", "gender",
+			"created_at", "updated_at",
+		}).AddRow(
+			targetID, "target@example.com", "Target", "hash", nil,
+			true, false, "ACTIVE",
+			nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			now, now,
+		))
+	mock.ExpectQuery("SELECT EXISTS").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+}
+
+// expectLikeQuota mocks the caller's timezone and the locked quota check,
+// where current is the caller's existing action on the target ("" for none)
+// and used is how much of today's quota they've already taken
+func expectLikeQuota(mock sqlmock.Sqlmock, timezone, current, action string, used int) {
+	mock.ExpectQuery("SELECT timezone FROM user_preferences").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow(timezone))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM datifyy_v2_users WHERE id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	if current == "" {
+		mock.ExpectQuery("SELECT action FROM datifyy_v2_user_likes").
+			WithArgs(1, 2).
+			WillReturnError(sql.ErrNoRows)
+	} else {
+		mock.ExpectQuery("SELECT action FROM datifyy_v2_user_likes").
+			WithArgs(1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"action"}).AddRow(current))
+	}
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(1, action, startOfDayIn{timezone}).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(used))
+}
+
+// startOfDayIn matches the current day's midnight in an IANA timezone
+type startOfDayIn struct {
+	timezone string
+}
+
+func (m startOfDayIn) Match(v driver.Value) bool {
+	since, ok := v.(time.Time)
+	if !ok {
+		return false
+	}
+	loc, err := time.LoadLocation(m.timezone)
+	if err != nil {
+		return false
+	}
+	now := time.Now().In(loc)
+	return since.Equal(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc))
+}
+
+func TestLikeUser_MutualCreatesMatch(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	expectLikeTarget(mock, 2)
+	expectLikeQuota(mock, "Asia/Kolkata", "", repository.LikeActionLike, 3)
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_likes").
+		WithArgs(1, 2, repository.LikeActionLike).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Target has like notifications on
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_notification_events").
+		WithArgs(2, repository.NotificationEventLike, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Target already liked the caller
+	mock.ExpectQuery("SELECT action FROM datifyy_v2_user_likes").
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"action"}).AddRow(repository.LikeActionSuperLike))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_curated_matches").
+		WithArgs(1, 2, repository.CuratedMatchSourceMutualLike).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	resp, err := service.LikeUser(ctx, &userpb.LikeUserRequest{UserId: "2"})
+
+	require.NoError(t, err)
+	assert.True(t, resp.IsMutual)
+	assert.Equal(t, "7", resp.MatchId)
+	assert.Equal(t, int32(46), resp.RemainingToday)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLikeUser_DailyLimitReached(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	expectLikeTarget(mock, 2)
+	expectLikeQuota(mock, "UTC", "", repository.LikeActionSuperLike, 1)
+	mock.ExpectRollback()
+
+	resp, err := service.SuperLikeUser(ctx, &userpb.SuperLikeUserRequest{UserId: "2"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLikeUser_RepeatLikeIsIdempotent(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	// Already liked today and at the limit: no error, nothing saved or sent
+	expectLikeTarget(mock, 2)
+	expectLikeQuota(mock, "America/New_York", repository.LikeActionLike, repository.LikeActionLike, 50)
+	mock.ExpectRollback()
+	mock.ExpectQuery("SELECT action FROM datifyy_v2_user_likes").
+		WithArgs(2, 1).
+		WillReturnError(sql.ErrNoRows)
+
+	resp, err := service.LikeUser(ctx, &userpb.LikeUserRequest{UserId: "2"})
+
+	require.NoError(t, err)
+	assert.False(t, resp.IsMutual)
+	assert.Zero(t, resp.RemainingToday)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLikeUser_Self(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	resp, err := service.LikeUser(ctx, &userpb.LikeUserRequest{UserId: "1"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPassUser_WithdrawsPendingMutualMatch(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	expectLikeTarget(mock, 2)
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_likes").
+		WithArgs(1, 2, repository.LikeActionPass).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE datifyy_v2_curated_matches SET status = 'withdrawn'").
+		WithArgs(1, 2, repository.CuratedMatchSourceMutualLike).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	resp, err := service.PassUser(ctx, &userpb.PassUserRequest{UserId: "2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "User passed", resp.Message)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListLikesReceived_Empty(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("SELECT COUNT").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery("SELECT l.user_id, l.action, l.acted_at").
+		WithArgs(1, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "action", "acted_at"}))
+
+	resp, err := service.ListLikesReceived(ctx, &userpb.ListLikesReceivedRequest{})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Likes)
+	assert.Equal(t, int32(1), resp.Pagination.Page)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
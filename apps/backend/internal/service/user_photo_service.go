@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	commonpb "github.com/datifyy/backend/gen/common/v1"
@@ -44,11 +45,16 @@ func (s *UserService) UploadProfilePhoto(
 		return nil, status.Error(codes.InvalidArgument, "invalid content_type: must be jpeg, jpg, png, or webp")
 	}
 
-	// TODO: Upload to cloud storage (S3, GCS, etc.)
 	// TODO: Generate thumbnail
 	// TODO: Validate image size (e.g., max 10MB)
 
 	photoID := fmt.Sprintf("photo_%d_%d", userID, time.Now().Unix())
+
+	// Upload to blob storage
+	if err := s.blobStore.Put(ctx, photoStorageKey(userID, photoID), req.PhotoData); err != nil {
+		return nil, status.Error(codes.Internal, "failed to store photo")
+	}
+
 	photoURL := fmt.Sprintf("https://cdn.datifyy.com/photos/%s.jpg", photoID)
 	thumbnailURL := fmt.Sprintf("https://cdn.datifyy.com/photos/%s_thumb.jpg", photoID)
 
@@ -65,6 +71,7 @@ func (s *UserService) UploadProfilePhoto(
 
 	err = s.profileRepo.CreatePhoto(ctx, photo)
 	if err != nil {
+		s.deletePhotoFromStorage(ctx, userID, photoID)
 		return nil, status.Error(codes.Internal, "failed to save photo")
 	}
 
@@ -107,10 +114,23 @@ func (s *UserService) DeleteProfilePhoto(
 		return nil, status.Error(codes.Internal, "failed to delete photo")
 	}
 
-	// TODO: Delete from cloud storage
+	// Delete from blob storage
+	s.deletePhotoFromStorage(ctx, userID, req.PhotoId)
 
 	return &userpb.DeleteProfilePhotoResponse{
 		Success: true,
 		Message: "Photo deleted successfully",
 	}, nil
 }
+
+// photoStorageKey returns the blob storage key for a profile photo
+func photoStorageKey(userID int, photoID string) string {
+	return fmt.Sprintf("photos/%d/%s", userID, photoID)
+}
+
+// deletePhotoFromStorage removes a photo's blob, logging rather than failing on errors
+func (s *UserService) deletePhotoFromStorage(ctx context.Context, userID int, photoID string) {
+	if err := s.blobStore.Delete(ctx, photoStorageKey(userID, photoID)); err != nil {
+		log.Printf("Failed to delete photo %s for user %d from storage: %v", photoID, userID, err)
+	}
+}
@@ -84,8 +84,8 @@ func TestUpdatePartnerPreferences_Success(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock UPSERT (INSERT ... ON CONFLICT)
-	mock.ExpectExec("INSERT INTO datifyy_v2_partner_preferences").
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	expectChangeLoggedUpdate(mock, "datifyy_v2_partner_preferences", "INSERT INTO datifyy_v2_partner_preferences",
+		`{"age_range_min": 21, "age_range_max": 35}`, `{"age_range_min": 25, "age_range_max": 35}`, 1)
 
 	// Mock get updated preferences with all columns
 	prefRows := sqlmock.NewRows([]string{
@@ -154,6 +154,46 @@ func TestUpdatePartnerPreferences_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUpdatePartnerPreferences_RecordsFieldChanges(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	// First save creates the row, so old values are null; unchanged fields aren't logged
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT to_jsonb\\(t\\) FROM datifyy_v2_partner_preferences t WHERE user_id = \\$1 FOR UPDATE").
+		WithArgs(1).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("INSERT INTO datifyy_v2_partner_preferences (.+) RETURNING to_jsonb\\(datifyy_v2_partner_preferences\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).
+			AddRow([]byte(`{"user_id": 1, "age_range_min": 25, "age_range_max": 40, "verified_only": null}`)))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_changes").
+		WithArgs(1, "datifyy_v2_partner_preferences", "age_range_max", "null", "40", "user", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_changes").
+		WithArgs(1, "datifyy_v2_partner_preferences", "age_range_min", "null", "25", "user", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_partner_preferences WHERE user_id").
+		WillReturnError(sql.ErrNoRows)
+
+	req := &userpb.UpdatePartnerPreferencesRequest{
+		Preferences: &userpb.PartnerPreferences{
+			AgeRange: &userpb.AgeRange{
+				MinAge: 25,
+				MaxAge: 40,
+			},
+		},
+	}
+
+	_, err := service.UpdatePartnerPreferences(ctx, req)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUpdatePartnerPreferences_InvalidAgeRange(t *testing.T) {
 	service, _, db := setupTestUserService(t)
 	defer db.Close()
@@ -209,11 +249,11 @@ func TestGetUserPreferences_Success(t *testing.T) {
 		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 		"discoverable", "global_mode", "verified_only", "distance_radius",
-		"recently_active_days", "app_language", "theme",
+		"recently_active_days", "app_language", "theme", "timezone",
 	}).AddRow(
 		1, 1, true, true, false, true, true, true, true,
 		false, true, true, true, true, false, false, true,
-		true, false, false, 50, 7, "en", "light",
+		true, false, false, 50, 7, "en", "light", "UTC",
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id").
@@ -250,11 +290,11 @@ func TestGetUserPreferences_CreatesDefault(t *testing.T) {
 		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 		"discoverable", "global_mode", "verified_only", "distance_radius",
-		"recently_active_days", "app_language", "theme",
+		"recently_active_days", "app_language", "theme", "timezone",
 	}).AddRow(
 		1, 1, true, true, false, true, true, true, true,
 		false, true, true, true, true, false, false, true,
-		true, false, false, 50, 7, "en", "light",
+		true, false, false, 50, 7, "en", "light", "UTC",
 	)
 
 	mock.ExpectQuery("INSERT INTO user_preferences").
@@ -279,8 +319,8 @@ func TestUpdateUserPreferences_Success(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock update
-	mock.ExpectExec("UPDATE user_preferences SET").
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChangeLoggedUpdate(mock, "user_preferences", "UPDATE user_preferences SET",
+		`{"push_enabled": false}`, `{"push_enabled": true}`, 1)
 
 	// Mock get updated preferences
 	prefRows := sqlmock.NewRows([]string{
@@ -289,11 +329,11 @@ func TestUpdateUserPreferences_Success(t *testing.T) {
 		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 		"discoverable", "global_mode", "verified_only", "distance_radius",
-		"recently_active_days", "app_language", "theme",
+		"recently_active_days", "app_language", "theme", "timezone",
 	}).AddRow(
 		1, 1, false, true, false, true, true, true, true,
 		false, false, false, true, true, false, true, true,
-		true, false, false, 50, 7, "es", "dark",
+		true, false, false, 50, 7, "es", "dark", "UTC",
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id").
@@ -327,6 +367,25 @@ func TestUpdateUserPreferences_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUpdateUserPreferences_Timezone(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	expectChangeLoggedUpdate(mock, "user_preferences", "UPDATE user_preferences SET timezone = \\$1 WHERE user_id = \\$2",
+		`{"timezone": "UTC"}`, `{"timezone": "Asia/Kolkata"}`, 1)
+	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id").
+		WillReturnRows(userPreferenceRows(1, false))
+
+	_, err := service.UpdateUserPreferences(ctx, &userpb.UpdateUserPreferencesRequest{
+		Preferences: &userpb.UserPreferences{Timezone: "Asia/Kolkata"},
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUpdateUserPreferences_MissingPreferences(t *testing.T) {
 	service, _, db := setupTestUserService(t)
 	defer db.Close()
@@ -369,8 +428,7 @@ func TestUpdatePartnerPreferences_DatabaseError(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock UPSERT with error
-	mock.ExpectExec("INSERT INTO datifyy_v2_partner_preferences").
-		WillReturnError(sql.ErrConnDone)
+	expectChangeLoggedUpdateError(mock, "datifyy_v2_partner_preferences", "INSERT INTO datifyy_v2_partner_preferences", sql.ErrConnDone)
 
 	req := &userpb.UpdatePartnerPreferencesRequest{
 		Preferences: &userpb.PartnerPreferences{
@@ -414,8 +472,7 @@ func TestUpdateUserPreferences_DatabaseError(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock update with error
-	mock.ExpectExec("UPDATE user_preferences SET").
-		WillReturnError(sql.ErrConnDone)
+	expectChangeLoggedUpdateError(mock, "user_preferences", "UPDATE user_preferences SET", sql.ErrConnDone)
 
 	req := &userpb.UpdateUserPreferencesRequest{
 		Preferences: &userpb.UserPreferences{
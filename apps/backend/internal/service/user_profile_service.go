@@ -3,12 +3,21 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
 	"strconv"
+	"strings"
+	"time"
 
+	commonpb "github.com/datifyy/backend/gen/common/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/datifyy/backend/internal/kundli"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // GetUserProfile gets a user profile by ID
@@ -27,6 +36,18 @@ func (s *UserService) GetUserProfile(
 		return nil, status.Error(codes.InvalidArgument, "invalid user_id format")
 	}
 
+	// Blocked users (in either direction) are indistinguishable from missing users
+	viewerID, _ := getUserIDFromContext(ctx)
+	if viewerID != 0 && viewerID != userID {
+		blocked, err := s.profileRepo.IsBlockedEitherWay(ctx, viewerID, userID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check profile visibility")
+		}
+		if blocked {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+	}
+
 	// Get user from database
 	user, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -66,6 +87,21 @@ func (s *UserService) GetUserProfile(
 		return nil, status.Error(codes.Internal, "failed to build profile")
 	}
 
+	// Redact fields according to the owner's privacy settings and the viewer
+	relationship, err := resolveViewerRelationship(ctx, s.relationRepo, viewerID, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check profile visibility")
+	}
+	visibility := profileVisibility(userPrefs, relationship)
+	if visibility.Distance && viewerID != 0 && viewerID != userID {
+		pbProfile.DistanceKm = s.distanceFromViewer(ctx, viewerID, profile)
+	}
+	applyProfileVisibility(pbProfile, visibility)
+
+	if viewerID != 0 && viewerID != userID {
+		s.recordProfileView(ctx, viewerID, userID, userPrefs)
+	}
+
 	return &userpb.GetUserProfileResponse{
 		Profile: pbProfile,
 	}, nil
@@ -211,12 +247,48 @@ func (s *UserService) UpdateProfile(
 			}
 		case "location":
 			if req.ProfileDetails != nil && req.ProfileDetails.Location != nil {
-				jsonData, _ := json.Marshal(req.ProfileDetails.Location)
+				location := proto.Clone(req.ProfileDetails.Location).(*commonpb.Location)
+
+				// Normalize coordinates, geocoding the city when none were sent
+				point, ok, err := resolveLocationPoint(ctx, s.geocoder, location)
+				if errors.Is(err, errInvalidCoordinates) {
+					return nil, status.Error(codes.InvalidArgument, "invalid location coordinates")
+				}
+				if err != nil {
+					return nil, status.Error(codes.Internal, "failed to resolve location")
+				}
+				if ok {
+					location.Latitude = point.Lat
+					location.Longitude = point.Lng
+					updates["latitude"] = point.Lat
+					updates["longitude"] = point.Lng
+					updates["geohash"] = geo.EncodeGeohash(point, geo.CoarsePrecision)
+				} else {
+					updates["latitude"] = nil
+					updates["longitude"] = nil
+					updates["geohash"] = nil
+				}
+
+				jsonData, _ := json.Marshal(location)
 				updates["location"] = jsonData
 			}
+		case "birth_details":
+			if req.CulturalInfo != nil {
+				birthUpdates, err := s.resolveBirthDetails(ctx, req.CulturalInfo)
+				if err != nil {
+					return nil, err
+				}
+				for column, value := range birthUpdates {
+					updates[column] = value
+				}
+			}
 		case "prompts":
 			if len(req.Prompts) > 0 {
-				jsonData, _ := json.Marshal(req.Prompts)
+				prompts, err := s.normalizeProfilePrompts(ctx, req.Prompts)
+				if err != nil {
+					return nil, err
+				}
+				jsonData, _ := json.Marshal(prompts)
 				updates["prompts"] = jsonData
 			}
 		// Lifestyle fields
@@ -309,6 +381,53 @@ func (s *UserService) UpdateProfile(
 	}, nil
 }
 
+// resolveBirthDetails validates a birth time, place and timezone, geocoding
+// the place for the chart. An empty place clears the birth details.
+func (s *UserService) resolveBirthDetails(ctx context.Context, info *userpb.CulturalInfo) (map[string]interface{}, error) {
+	place := strings.TrimSpace(info.BirthPlace)
+	if place == "" {
+		return map[string]interface{}{
+			"birth_time":      nil,
+			"birth_place":     nil,
+			"birth_latitude":  nil,
+			"birth_longitude": nil,
+			"birth_timezone":  nil,
+		}, nil
+	}
+
+	if _, err := kundli.ParseBirthTime(info.BirthTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "birth_time must be HH:MM")
+	}
+	if _, err := kundli.LoadTimezone(info.BirthTimezone); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown birth_timezone")
+	}
+
+	updates := map[string]interface{}{
+		"birth_time":      info.BirthTime,
+		"birth_place":     place,
+		"birth_latitude":  nil,
+		"birth_longitude": nil,
+		"birth_timezone":  nil,
+	}
+	if info.BirthTimezone != "" {
+		updates["birth_timezone"] = info.BirthTimezone
+	}
+
+	// Places that can't be resolved are kept, but no chart can be cast
+	if s.geocoder != nil {
+		found, err := s.geocoder.Geocode(ctx, place, "")
+		if err != nil && !errors.Is(err, geo.ErrPlaceNotFound) {
+			return nil, status.Error(codes.Internal, "failed to resolve birth place")
+		}
+		if err == nil {
+			updates["birth_latitude"] = found.Point.Lat
+			updates["birth_longitude"] = found.Point.Lng
+		}
+	}
+
+	return updates, nil
+}
+
 // DeleteAccount deletes a user account
 func (s *UserService) DeleteAccount(
 	ctx context.Context,
@@ -339,20 +458,35 @@ func (s *UserService) DeleteAccount(
 		}
 	}
 
-	// Soft delete: update account status to DELETED
-	err = s.userRepo.UpdateAccountStatus(ctx, userID, "DELETED")
+	if user.AccountStatus == "PENDING_DELETION" {
+		return nil, status.Error(codes.FailedPrecondition, "account deletion is already scheduled")
+	}
+
+	// Schedule deletion; the account is purged by a background job after the grace period
+	scheduledFor := time.Now().Add(accountDeletionGracePeriod)
+	err = s.userRepo.ScheduleDeletion(ctx, userID, scheduledFor, req.Reason)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to delete account")
 	}
 
-	// TODO: Additional cleanup:
-	// - Anonymize user data
-	// - Delete photos from storage
-	// - Remove from matches
-	// - Delete conversations
+	log.Printf("Account deletion scheduled for user %d on %s", userID, scheduledFor.Format(time.RFC3339))
+
+	// Tell the user the date in their own timezone
+	scheduledFor = scheduledFor.In(userLocation(ctx, s.profileRepo, userID))
+
+	if s.emailClient != nil {
+		if err := s.emailClient.SendAccountDeletionScheduledEmail(user.Email, user.Name, scheduledFor); err != nil {
+			// Log but don't fail
+			log.Printf("Failed to send account deletion email to user %d: %v", userID, err)
+		}
+	}
 
 	return &userpb.DeleteAccountResponse{
 		Success: true,
-		Message: "Account deleted successfully",
+		Message: fmt.Sprintf(
+			"Your account will be permanently deleted on %s. Log in before then to cancel the deletion.",
+			scheduledFor.Format("January 2, 2006"),
+		),
+		DeletionScheduledFor: timeToProto(scheduledFor),
 	}, nil
 }
@@ -3,29 +3,79 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/config"
+	"github.com/datifyy/backend/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// testConfig returns the configuration the defaults in config.Load give
+func testConfig() *config.Config {
+	return &config.Config{
+		StorageDir:                  "storage",
+		PublicAPIURL:                "http://localhost:8080",
+		DataExportSigningKey:        "test-signing-key",
+		ReportEscalationThreshold:   3,
+		ReportEscalationWindowDays:  30,
+		DailyLikeLimit:              50,
+		DailySuperLikeLimit:         1,
+		LateCancellationWindowHours: 24,
+		Environment:                 "test",
+	}
+}
+
 // setupTestUserService creates a test user service with a mock database
 func setupTestUserService(t *testing.T) (*UserService, sqlmock.Sqlmock, *sql.DB) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
 
-	service := NewUserService(db, nil)
+	service := NewUserService(db, nil, nil, testConfig())
+	service.blobStore = storage.NewLocalStore(t.TempDir())
 	return service, mock, db
 }
 
+// expectChangeLoggedUpdate mocks a change-logged write to one of user 1's rows.
+// before and after are the row as JSON; changes is how many fields differ.
+func expectChangeLoggedUpdate(mock sqlmock.Sqlmock, table, writePattern, before, after string, changes int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT to_jsonb\\(t\\) FROM " + table + " t WHERE (.+) FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(before)))
+	mock.ExpectQuery(writePattern).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(after)))
+	for i := 0; i < changes; i++ {
+		mock.ExpectExec("INSERT INTO datifyy_v2_profile_changes").
+			WillReturnResult(sqlmock.NewResult(int64(i+1), 1))
+	}
+	mock.ExpectCommit()
+}
+
+// expectChangeLoggedUpdateError mocks a change-logged write that fails
+func expectChangeLoggedUpdateError(mock sqlmock.Sqlmock, table, writePattern string, err error) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT to_jsonb\\(t\\) FROM " + table + " t WHERE (.+) FOR UPDATE").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"to_jsonb"}).AddRow([]byte(`{}`)))
+	mock.ExpectQuery(writePattern).
+		WillReturnError(err)
+	mock.ExpectRollback()
+}
+
 func TestGetUserProfile_Success(t *testing.T) {
 	service, mock, db := setupTestUserService(t)
 	defer db.Close()
 
-	ctx := context.Background()
+	// Viewing own profile, so contact info is included
+	ctx := context.WithValue(context.Background(), "userID", 1)
 	now := time.Now()
 
 	// Mock user query
@@ -55,14 +105,18 @@ func TestGetUserProfile_Success(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		1, 1, "Bio", []byte("[]"), "Company", "Engineer", []byte("[]"),
 		"School", 180, []byte("{}"), "Hometown", []byte("[]"), []byte("[]"),
 		[]byte("[]"), "NEVER", "NEVER", "OFTEN", "VEGETARIAN",
 		"HINDU", "IMPORTANT", "MODERATE", "DOG_LOVER", "DONT_HAVE_WANT",
 		"INTJ", "BIG_TIME_TEXTER", "QUALITY_TIME", "EARLY_BIRD",
-		[]byte("[]"), 75, true, false,
+		[]byte("[]"), 75, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -109,11 +163,11 @@ func TestGetUserProfile_Success(t *testing.T) {
 		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 		"discoverable", "global_mode", "verified_only", "distance_radius",
-		"recently_active_days", "app_language", "theme",
+		"recently_active_days", "app_language", "theme", "timezone",
 	}).AddRow(
 		1, 1, true, true, false, true, true, true, true,
 		false, true, true, true, true, false, false, true,
-		true, false, false, 50, 7, "en", "light",
+		true, false, false, 50, 7, "en", "light", "UTC",
 	)
 
 	mock.ExpectQuery("INSERT INTO user_preferences").
@@ -175,6 +229,30 @@ func TestGetUserProfile_UserNotFound(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetUserProfile_BlockedReturnsNotFound(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	// Viewer 2 looks up user 1 after one of them blocked the other
+	ctx := context.WithValue(context.Background(), "userID", 2)
+
+	mock.ExpectQuery("SELECT EXISTS(.+)user_blocks").
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	req := &userpb.GetUserProfileRequest{
+		UserId: "1",
+	}
+
+	resp, err := service.GetUserProfile(ctx, req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, err.Error(), "user not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetMyProfile_Success(t *testing.T) {
 	service, mock, db := setupTestUserService(t)
 	defer db.Close()
@@ -209,14 +287,18 @@ func TestGetMyProfile_Success(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		1, 1, "Bio", []byte("[]"), "Company", "Engineer", []byte("[]"),
 		"School", 180, []byte("{}"), "Hometown", []byte("[]"), []byte("[]"),
 		[]byte("[]"), "NEVER", "NEVER", "OFTEN", "VEGETARIAN",
 		"HINDU", "IMPORTANT", "MODERATE", "DOG_LOVER", "DONT_HAVE_WANT",
 		"INTJ", "BIG_TIME_TEXTER", "QUALITY_TIME", "EARLY_BIRD",
-		[]byte("[]"), 75, true, false,
+		[]byte("[]"), 75, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -256,11 +338,11 @@ func TestGetMyProfile_Success(t *testing.T) {
 		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 		"discoverable", "global_mode", "verified_only", "distance_radius",
-		"recently_active_days", "app_language", "theme",
+		"recently_active_days", "app_language", "theme", "timezone",
 	}).AddRow(
 		1, 1, true, true, false, true, true, true, true,
 		false, true, true, true, true, false, false, true,
-		true, false, false, 50, 7, "en", "light",
+		true, false, false, 50, 7, "en", "light", "UTC",
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id").
@@ -325,8 +407,8 @@ func TestUpdateProfile_Success(t *testing.T) {
 	now := time.Now()
 
 	// Mock profile update
-	mock.ExpectExec("UPDATE datifyy_v2_user_profiles SET").
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChangeLoggedUpdate(mock, "datifyy_v2_user_profiles", "UPDATE datifyy_v2_user_profiles SET",
+		`{"bio": "Old bio"}`, `{"bio": "Test"}`, 1)
 
 	// Mock getting updated profile
 	userRows := sqlmock.NewRows([]string{
@@ -353,14 +435,18 @@ func TestUpdateProfile_Success(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		1, 1, "Updated bio", []byte("[]"), "New Company", "Engineer", []byte("[]"),
 		"School", 180, []byte("{}"), "Hometown", []byte("[]"), []byte("[]"),
 		[]byte("[]"), nil, nil, nil, nil,
 		nil, nil, nil, nil, nil,
 		nil, nil, nil, nil,
-		[]byte("[]"), 80, true, false,
+		[]byte("[]"), 80, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -382,8 +468,8 @@ func TestUpdateProfile_Success(t *testing.T) {
 			"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 			"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 			"discoverable", "global_mode", "verified_only", "distance_radius",
-			"recently_active_days", "app_language", "theme",
-		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light"))
+			"recently_active_days", "app_language", "theme", "timezone",
+		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light", "UTC"))
 
 	req := &userpb.UpdateProfileRequest{
 		ProfileDetails: &userpb.ProfileDetails{
@@ -428,8 +514,8 @@ func TestUpdateProfile_AllProfileFields(t *testing.T) {
 	now := time.Now()
 
 	// Mock profile update with all fields
-	mock.ExpectExec("UPDATE datifyy_v2_user_profiles SET").
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChangeLoggedUpdate(mock, "datifyy_v2_user_profiles", "UPDATE datifyy_v2_user_profiles SET",
+		`{"bio": "Old bio", "company": "Old Company"}`, `{"bio": "Updated bio", "company": "New Company"}`, 2)
 
 	// Mock getting updated profile
 	userRows := sqlmock.NewRows([]string{
@@ -456,14 +542,18 @@ func TestUpdateProfile_AllProfileFields(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		1, 1, "Updated bio", []byte("[]"), "New Company", "Senior Engineer", []byte("[]"),
 		"MIT", 175, []byte("{}"), "Boston", []byte("[]"), []byte("[]"),
 		[]byte("[]"), nil, nil, nil, nil,
 		nil, nil, nil, nil, nil,
 		nil, nil, nil, nil,
-		[]byte("[]"), 80, true, false,
+		[]byte("[]"), 80, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -485,8 +575,8 @@ func TestUpdateProfile_AllProfileFields(t *testing.T) {
 			"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 			"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 			"discoverable", "global_mode", "verified_only", "distance_radius",
-			"recently_active_days", "app_language", "theme",
-		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light"))
+			"recently_active_days", "app_language", "theme", "timezone",
+		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light", "UTC"))
 
 	req := &userpb.UpdateProfileRequest{
 		ProfileDetails: &userpb.ProfileDetails{
@@ -518,8 +608,8 @@ func TestUpdateProfile_AllLifestyleFields(t *testing.T) {
 	now := time.Now()
 
 	// Mock profile update
-	mock.ExpectExec("UPDATE datifyy_v2_user_profiles SET").
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectChangeLoggedUpdate(mock, "datifyy_v2_user_profiles", "UPDATE datifyy_v2_user_profiles SET",
+		`{"drinking": "NEVER"}`, `{"drinking": "RARELY"}`, 1)
 
 	// Mock getting updated profile
 	userRows := sqlmock.NewRows([]string{
@@ -546,14 +636,18 @@ func TestUpdateProfile_AllLifestyleFields(t *testing.T) {
 		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
 		"religion", "religion_importance", "political_view", "pets", "children",
 		"personality_type", "communication_style", "love_language", "sleep_schedule",
-		"prompts", "completion_percentage", "is_public", "is_verified",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
 	}).AddRow(
 		1, 1, "Bio", []byte("[]"), "Company", "Engineer", []byte("[]"),
 		"School", 180, []byte("{}"), "Hometown", []byte("[]"), []byte("[]"),
 		[]byte("[]"), "RARELY", "NEVER", "OFTEN", "VEGETARIAN",
 		"HINDU", "IMPORTANT", "MODERATE", "DOG_LOVER", "DONT_HAVE_WANT",
 		"INTJ", "BIG_TIME_TEXTER", "QUALITY_TIME", "EARLY_BIRD",
-		[]byte("[]"), 75, true, false,
+		[]byte("[]"), 75, true, false, false, nil,
+		nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id").
@@ -575,8 +669,8 @@ func TestUpdateProfile_AllLifestyleFields(t *testing.T) {
 			"notify_profile_views", "public_profile", "show_online_status", "show_distance",
 			"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
 			"discoverable", "global_mode", "verified_only", "distance_radius",
-			"recently_active_days", "app_language", "theme",
-		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light"))
+			"recently_active_days", "app_language", "theme", "timezone",
+		}).AddRow(1, 1, true, true, false, true, true, true, true, false, true, true, true, true, false, false, true, true, false, false, 50, 7, "en", "light", "UTC"))
 
 	req := &userpb.UpdateProfileRequest{
 		LifestyleInfo: &userpb.LifestyleInfo{
@@ -629,8 +723,7 @@ func TestUpdateProfile_DatabaseError(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock profile update with error
-	mock.ExpectExec("UPDATE datifyy_v2_user_profiles SET").
-		WillReturnError(sql.ErrConnDone)
+	expectChangeLoggedUpdateError(mock, "datifyy_v2_user_profiles", "UPDATE datifyy_v2_user_profiles SET", sql.ErrConnDone)
 
 	req := &userpb.UpdateProfileRequest{
 		ProfileDetails: &userpb.ProfileDetails{
@@ -675,11 +768,13 @@ func TestDeleteAccount_Success(t *testing.T) {
 		WithArgs(1).
 		WillReturnRows(userRows)
 
-	// Mock update account status
-	mock.ExpectExec("UPDATE datifyy_v2_users SET account_status").
-		WithArgs("DELETED", 1).
+	// Mock schedule deletion
+	mock.ExpectExec("UPDATE datifyy_v2_users SET account_status = 'PENDING_DELETION'").
+		WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
+	expectUserTimezone(mock, 1, "Asia/Kolkata")
+
 	req := &userpb.DeleteAccountRequest{
 		Password: "password",
 	}
@@ -691,7 +786,9 @@ func TestDeleteAccount_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, resp)
 	assert.True(t, resp.Success)
-	assert.Equal(t, "Account deleted successfully", resp.Message)
+	assert.Contains(t, resp.Message, "Log in before then to cancel")
+	require.NotNil(t, resp.DeletionScheduledFor)
+	assert.InDelta(t, now.Add(accountDeletionGracePeriod).Unix(), resp.DeletionScheduledFor.Seconds, 5)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -751,3 +848,63 @@ func TestDeleteAccount_IncorrectPassword(t *testing.T) {
 	assert.Contains(t, err.Error(), "incorrect password")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestUpdateProfile_LocationGeocoded(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	// A city without coordinates is geocoded and stored with its coarse geohash
+	expectChangeLoggedUpdateError(mock, "datifyy_v2_user_profiles",
+		`UPDATE datifyy_v2_user_profiles SET .*geohash = \$`, errors.New("connection refused"))
+
+	req := &userpb.UpdateProfileRequest{
+		ProfileDetails: &userpb.ProfileDetails{
+			Location: &commonpb.Location{City: "Bangalore", Country: "India"},
+		},
+		UpdateFields: []string{"location"},
+	}
+
+	_, err := service.UpdateProfile(ctx, req)
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Zero(t, req.ProfileDetails.Location.Latitude) // request is not modified
+}
+
+func TestUpdateProfile_InvalidCoordinates(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	req := &userpb.UpdateProfileRequest{
+		ProfileDetails: &userpb.ProfileDetails{
+			Location: &commonpb.Location{City: "Pune", Latitude: 123, Longitude: 73.85},
+		},
+		UpdateFields: []string{"location"},
+	}
+
+	resp, err := service.UpdateProfile(ctx, req)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateProfile_InvalidBirthTime(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	req := &userpb.UpdateProfileRequest{
+		CulturalInfo: &userpb.CulturalInfo{BirthTime: "6:30 PM", BirthPlace: "Pune"},
+		UpdateFields: []string{"birth_details"},
+	}
+
+	resp, err := service.UpdateProfile(ctx, req)
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListProfileViewers lists the users who viewed the caller's profile
+func (s *UserService) ListProfileViewers(
+	ctx context.Context,
+	req *userpb.ListProfileViewersRequest,
+) (*userpb.ListProfileViewersResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	page := int(req.GetPagination().GetPage())
+	pageSize := int(req.GetPagination().GetPageSize())
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (page - 1) * pageSize
+
+	views, totalCount, err := s.viewRepo.ListViewers(ctx, userID, pageSize, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get profile viewers")
+	}
+
+	viewerCandidates := make([]nearbyCandidate, len(views))
+	for i, view := range views {
+		viewerCandidates[i] = nearbyCandidate{UserID: view.ViewerUserID}
+	}
+	profiles, err := s.buildDiscoveryProfiles(ctx, userID, viewerCandidates)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load profile viewers")
+	}
+
+	viewers := make([]*userpb.ProfileViewer, 0, len(views))
+	for _, view := range views {
+		profile, ok := profiles[view.ViewerUserID]
+		if !ok {
+			continue
+		}
+
+		viewers = append(viewers, &userpb.ProfileViewer{
+			Profile:  profile,
+			ViewedAt: timeToProto(view.LastViewedAt),
+		})
+	}
+
+	totalPages := (totalCount + pageSize - 1) / pageSize
+
+	return &userpb.ListProfileViewersResponse{
+		Viewers: viewers,
+		Pagination: &commonpb.PaginationResponse{
+			Page:       int32(page),
+			PageSize:   int32(pageSize),
+			TotalCount: int64(totalCount),
+			TotalPages: int32(totalPages),
+		},
+	}, nil
+}
+
+// recordProfileView records a view of ownerID's profile and notifies the owner
+// if they opted in. Views by incognito users are never recorded. Failures are
+// logged; they never fail the profile request.
+func (s *UserService) recordProfileView(ctx context.Context, viewerID, ownerID int, ownerPrefs *repository.UserPreferences) {
+	viewerPrefs, err := s.profileRepo.GetUserPreferences(ctx, viewerID)
+	if err != nil {
+		// Without the viewer's settings we can't tell whether they're incognito
+		log.Printf("Failed to get preferences of viewer %d: %v", viewerID, err)
+		return
+	}
+	if viewerPrefs.IncognitoMode {
+		return
+	}
+
+	recorded, err := s.viewRepo.RecordView(ctx, viewerID, ownerID)
+	if err != nil {
+		log.Printf("Failed to record view of user %d by user %d: %v", ownerID, viewerID, err)
+		return
+	}
+
+	// Repeat views the same day don't notify again
+	if !recorded || ownerPrefs == nil || !ownerPrefs.NotifyProfileViews {
+		return
+	}
+
+	if err := s.notifyRepo.CreateEvent(ctx, ownerID, repository.NotificationEventProfileView, viewerID, nil); err != nil {
+		log.Printf("Failed to create profile view notification for user %d: %v", ownerID, err)
+	}
+}
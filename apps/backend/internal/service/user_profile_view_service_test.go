@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userPreferenceRows returns a user_preferences row with likes notifications on
+func userPreferenceRows(userID int, incognito bool) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "user_id", "push_enabled", "email_enabled", "sms_enabled",
+		"notify_matches", "notify_messages", "notify_likes", "notify_super_likes",
+		"notify_profile_views", "public_profile", "show_online_status", "show_distance",
+		"show_age", "allow_search_engines", "incognito_mode", "read_receipts",
+		"discoverable", "global_mode", "verified_only", "distance_radius",
+		"recently_active_days", "app_language", "theme", "timezone",
+	}).AddRow(
+		userID, userID, true, true, false, true, true, true, true,
+		false, true, true, true, true, false, incognito, true,
+		true, false, false, 50, 7, "en", "light", "UTC",
+	)
+}
+
+func TestRecordProfileView_NotifiesOptedInOwner(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_views").
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_notification_events").
+		WithArgs(1, repository.NotificationEventProfileView, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	service.recordProfileView(context.Background(), 2, 1, &repository.UserPreferences{NotifyProfileViews: true})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordProfileView_RepeatViewDoesNotNotify(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_views").
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0)) // already viewed today
+
+	service.recordProfileView(context.Background(), 2, 1, &repository.UserPreferences{NotifyProfileViews: true})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordProfileView_OwnerOptedOut(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, false))
+	mock.ExpectExec("INSERT INTO datifyy_v2_profile_views").
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	service.recordProfileView(context.Background(), 2, 1, &repository.UserPreferences{NotifyProfileViews: false})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordProfileView_IncognitoViewerNotRecorded(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM user_preferences").
+		WithArgs(2).
+		WillReturnRows(userPreferenceRows(2, true))
+
+	service.recordProfileView(context.Background(), 2, 1, &repository.UserPreferences{NotifyProfileViews: true})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListProfileViewers_Success(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery(`SELECT COUNT\(DISTINCT v.viewer_user_id\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT v.viewer_user_id, MAX").
+		WithArgs(1, 20, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"viewer_user_id", "last_viewed_at"}).AddRow(2, time.Now()))
+
+	// Viewer's account is gone, so they're left out of the page
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	resp, err := service.ListProfileViewers(ctx, &userpb.ListProfileViewersRequest{
+		Pagination: &commonpb.PaginationRequest{Page: 1, PageSize: 20},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Viewers)
+	assert.Equal(t, int64(1), resp.Pagination.TotalCount)
+	assert.Equal(t, int32(1), resp.Pagination.TotalPages)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListProfileViewers_Unauthenticated(t *testing.T) {
+	service, _, db := setupTestUserService(t)
+	defer db.Close()
+
+	resp, err := service.ListProfileViewers(context.Background(), &userpb.ListProfileViewersRequest{})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPromptLocale is the locale every prompt has text for
+const defaultPromptLocale = "en"
+
+// ListPrompts lists the prompts that can currently be answered, with their
+// question text in the requested locale
+func (s *UserService) ListPrompts(
+	ctx context.Context,
+	req *userpb.ListPromptsRequest,
+) (*userpb.ListPromptsResponse, error) {
+	prompts, err := s.promptRepo.ListPrompts(ctx, strings.TrimSpace(req.Category), true)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list prompts")
+	}
+
+	resp := &userpb.ListPromptsResponse{}
+	for _, p := range prompts {
+		resp.Prompts = append(resp.Prompts, &userpb.PromptDefinition{
+			PromptId:        p.Key,
+			Text:            localizedPromptText(p.Text, req.Locale),
+			Category:        p.Category,
+			MinAnswerLength: int32(p.MinAnswerLength),
+			MaxAnswerLength: int32(p.MaxAnswerLength),
+			Question:        userpb.PromptQuestion(p.LegacyQuestion.Int32),
+		})
+	}
+
+	return resp, nil
+}
+
+// localizedPromptText picks the text for a locale such as "hi-IN", falling
+// back to its language ("hi") and then to English
+func localizedPromptText(text repository.PromptText, locale string) string {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if t, ok := text[locale]; ok && t != "" {
+		return t
+	}
+	if lang, _, found := strings.Cut(locale, "-"); found {
+		if t, ok := text[lang]; ok && t != "" {
+			return t
+		}
+	}
+	return text[defaultPromptLocale]
+}
+
+// normalizeProfilePrompts checks answers against the active prompts catalog
+// and returns them as stored: trimmed, and referencing their prompt by ID and
+// legacy question. Answers sent with only the legacy question are resolved to
+// its catalog prompt.
+func (s *UserService) normalizeProfilePrompts(ctx context.Context, answers []*userpb.ProfilePrompt) ([]*userpb.ProfilePrompt, error) {
+	prompts, err := s.promptRepo.ListPrompts(ctx, "", true)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load prompts")
+	}
+
+	byKey := make(map[string]*repository.Prompt, len(prompts))
+	byQuestion := make(map[int32]*repository.Prompt)
+	now := time.Now()
+	for _, p := range prompts {
+		// The query's clock may differ slightly from ours
+		if !p.IsActive(now) {
+			continue
+		}
+		byKey[p.Key] = p
+		if p.LegacyQuestion.Valid {
+			byQuestion[p.LegacyQuestion.Int32] = p
+		}
+	}
+
+	normalized := make([]*userpb.ProfilePrompt, 0, len(answers))
+	answered := make(map[string]bool)
+	for _, a := range answers {
+		var p *repository.Prompt
+		switch {
+		case a.PromptId != "":
+			p = byKey[a.PromptId]
+		case a.Question != userpb.PromptQuestion_PROMPT_UNSPECIFIED:
+			p = byQuestion[int32(a.Question)]
+		default:
+			return nil, status.Error(codes.InvalidArgument, "prompt_id is required for each prompt")
+		}
+		if p == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "prompt %s is not available", promptReference(a))
+		}
+		if answered[p.Key] {
+			return nil, status.Errorf(codes.InvalidArgument, "prompt %s is answered more than once", p.Key)
+		}
+		answered[p.Key] = true
+
+		// Media answers may leave the text empty
+		text := strings.TrimSpace(a.AnswerText)
+		length := utf8.RuneCountInString(text)
+		if length > p.MaxAnswerLength || (length < p.MinAnswerLength && a.AnswerMediaUrl == "") {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"answer to prompt %s must be between %d and %d characters", p.Key, p.MinAnswerLength, p.MaxAnswerLength)
+		}
+
+		normalized = append(normalized, &userpb.ProfilePrompt{
+			PromptId:       p.Key,
+			Question:       userpb.PromptQuestion(p.LegacyQuestion.Int32),
+			AnswerText:     text,
+			AnswerMediaUrl: a.AnswerMediaUrl,
+			Order:          a.Order,
+		})
+	}
+
+	return normalized, nil
+}
+
+func promptReference(a *userpb.ProfilePrompt) string {
+	if a.PromptId != "" {
+		return a.PromptId
+	}
+	return a.Question.String()
+}
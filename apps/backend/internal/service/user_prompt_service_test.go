@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// promptRows returns active catalog prompts: green_flag (legacy question 9,
+// up to 20 characters) and a hindi-translated weekend prompt
+func promptRows() *sqlmock.Rows {
+	now := time.Now()
+	return sqlmock.NewRows([]string{
+		"id", "key", "category", "text", "legacy_question", "min_answer_length", "max_answer_length",
+		"display_order", "active_from", "active_until", "updated_by", "created_at", "updated_at",
+	}).
+		AddRow(1, "green_flag", "dating", []byte(`{"en": "A green flag for me is..."}`), 9, 1, 20,
+			1, nil, nil, nil, now, now).
+		AddRow(2, "perfect_weekend", "about_me", []byte(`{"en": "My perfect weekend...", "hi": "मेरा परफेक्ट वीकेंड..."}`), nil, 1, 300,
+			2, now.Add(-time.Hour), now.Add(time.Hour), nil, now, now)
+}
+
+func TestListPrompts_Localized(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_prompts WHERE (.+) ORDER BY category, display_order").
+		WillReturnRows(promptRows())
+
+	resp, err := service.ListPrompts(context.Background(), &userpb.ListPromptsRequest{Locale: "hi_IN"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Prompts, 2)
+	assert.Equal(t, "A green flag for me is...", resp.Prompts[0].Text)
+	assert.Equal(t, userpb.PromptQuestion_PROMPT_GREEN_FLAG, resp.Prompts[0].Question)
+	assert.Equal(t, int32(20), resp.Prompts[0].MaxAnswerLength)
+	assert.Equal(t, "मेरा परफेक्ट वीकेंड...", resp.Prompts[1].Text)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNormalizeProfilePrompts(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_prompts").WillReturnRows(promptRows())
+
+	prompts, err := service.normalizeProfilePrompts(context.Background(), []*userpb.ProfilePrompt{
+		{Question: userpb.PromptQuestion_PROMPT_GREEN_FLAG, AnswerText: "  Kindness  ", Order: 1},
+		{PromptId: "perfect_weekend", AnswerMediaUrl: "https://cdn.example.com/hike.jpg", Order: 2},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, prompts, 2)
+	assert.Equal(t, "green_flag", prompts[0].PromptId)
+	assert.Equal(t, "Kindness", prompts[0].AnswerText)
+	assert.Equal(t, "perfect_weekend", prompts[1].PromptId)
+	assert.Equal(t, userpb.PromptQuestion_PROMPT_UNSPECIFIED, prompts[1].Question)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfile_RejectsInvalidPrompts(t *testing.T) {
+	tests := []struct {
+		name    string
+		prompts []*userpb.ProfilePrompt
+		message string
+	}{
+		{
+			name:    "unknown prompt",
+			prompts: []*userpb.ProfilePrompt{{PromptId: "retired_prompt", AnswerText: "Hello"}},
+			message: "prompt retired_prompt is not available",
+		},
+		{
+			name:    "legacy question without a catalog prompt",
+			prompts: []*userpb.ProfilePrompt{{Question: userpb.PromptQuestion_PROMPT_RED_FLAG, AnswerText: "Rudeness"}},
+			message: "prompt PROMPT_RED_FLAG is not available",
+		},
+		{
+			name:    "answer too long",
+			prompts: []*userpb.ProfilePrompt{{PromptId: "green_flag", AnswerText: "Someone who is always on time"}},
+			message: "answer to prompt green_flag must be between 1 and 20 characters",
+		},
+		{
+			name:    "empty answer",
+			prompts: []*userpb.ProfilePrompt{{PromptId: "green_flag", AnswerText: "   "}},
+			message: "answer to prompt green_flag must be between 1 and 20 characters",
+		},
+		{
+			name: "answered twice",
+			prompts: []*userpb.ProfilePrompt{
+				{PromptId: "green_flag", AnswerText: "Kindness"},
+				{Question: userpb.PromptQuestion_PROMPT_GREEN_FLAG, AnswerText: "Honesty"},
+			},
+			message: "prompt green_flag is answered more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mock, db := setupTestUserService(t)
+			defer db.Close()
+
+			mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_profile_prompts").WillReturnRows(promptRows())
+
+			ctx := context.WithValue(context.Background(), "userID", 1)
+			_, err := service.UpdateProfile(ctx, &userpb.UpdateProfileRequest{
+				Prompts:      tt.prompts,
+				UpdateFields: []string{"prompts"},
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, tt.message, status.Convert(err).Message())
+			// Nothing is written
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,28 +1,137 @@
 package service
 
 import (
+	"context"
 	"database/sql"
+	"os"
+	"strconv"
+	"time"
 
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/config"
+	"github.com/datifyy/backend/internal/geo"
 	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/slack"
+	"github.com/datifyy/backend/internal/storage"
 	"github.com/redis/go-redis/v9"
 )
 
 // UserService handles user profile management and discovery
 type UserService struct {
 	userpb.UnimplementedUserServiceServer
-	db        *sql.DB
-	redis     *redis.Client
-	userRepo  *repository.UserRepository
-	profileRepo *repository.UserProfileRepository
+	db             *sql.DB
+	redis          *redis.Client
+	emailClient    UserEmailSender
+	alerter        ModerationAlerter
+	blobStore      storage.BlobStore
+	userRepo       *repository.UserRepository
+	profileRepo    *repository.UserProfileRepository
+	dataExportRepo *repository.DataExportRepository
+	purgeRepo      *repository.AccountPurgeRepository
+	relationRepo   *repository.UserRelationshipRepository
+	reportRepo     *repository.UserReportRepository
+	moderationRepo *repository.ModerationRepository
+	verifyRepo     *repository.VerificationRepository
+	discoveryRepo  *repository.DiscoveryRepository
+	viewRepo       *repository.ProfileViewRepository
+	notifyRepo     *repository.NotificationRepository
+	likeRepo       *repository.LikeRepository
+	matchesRepo    *repository.CuratedMatchesRepository
+	promptRepo     *repository.PromptRepository
+	datesRepo      *repository.ScheduledDatesRepository
+	availRepo      *repository.AvailabilityRepository
+	adminRepo      *repository.AdminRepository
+	geocoder       geo.Geocoder
+
+	// Report escalation: users reported by this many distinct users within the window
+	reportEscalationThreshold int
+	reportEscalationWindow    time.Duration
+
+	// Daily quotas for likes and super likes
+	dailyLikeLimit      int
+	dailySuperLikeLimit int
+
+	// Signed download links for data exports
+	dataExportSigningKey []byte
+	publicBaseURL        string
+
+	// Cancelling a date closer to it than this is a late cancellation
+	lateCancellationWindow time.Duration
+}
+
+// UserEmailSender interface for sending account emails to users
+type UserEmailSender interface {
+	SendDataExportEmail(to, downloadURL string, expiresAt time.Time) error
+	SendAccountDeletionScheduledEmail(to, name string, scheduledFor time.Time) error
+	SendDateCancelledEmail(to, name string, scheduledTime time.Time, reason string) error
+	SendRescheduleRequestEmail(to, name, requesterName string, proposedTimes []time.Time, reason string) error
+	SendRescheduleAnsweredEmail(to, name string, accepted bool, scheduledTime time.Time) error
+	SendGenieDateUpdateEmail(to, name string, dateID int, update string) error
+	SendWorkEmailVerificationCode(to, code string, expiresAt time.Time) error
+}
+
+// ModerationAlerter posts events that need urgent moderator attention
+type ModerationAlerter interface {
+	SendAlert(ctx context.Context, title, message string, details map[string]string) error
 }
 
 // NewUserService creates a new UserService
-func NewUserService(db *sql.DB, redisClient *redis.Client) *UserService {
+func NewUserService(db *sql.DB, redisClient *redis.Client, emailClient UserEmailSender, cfg *config.Config) *UserService {
+	var alerter ModerationAlerter
+	if cfg.SlackWebhookURL != "" {
+		alerter = slack.NewSlackService(cfg.SlackWebhookURL)
+	}
+
 	return &UserService{
-		db:        db,
-		redis:     redisClient,
-		userRepo:  repository.NewUserRepository(db),
-		profileRepo: repository.NewUserProfileRepository(db),
+		db:             db,
+		redis:          redisClient,
+		emailClient:    emailClient,
+		alerter:        alerter,
+		blobStore:      storage.NewLocalStore(cfg.StorageDir),
+		userRepo:       repository.NewUserRepository(db),
+		profileRepo:    repository.NewUserProfileRepository(db),
+		dataExportRepo: repository.NewDataExportRepository(db),
+		purgeRepo:      repository.NewAccountPurgeRepository(db),
+		relationRepo:   repository.NewUserRelationshipRepository(db),
+		reportRepo:     repository.NewUserReportRepository(db),
+		moderationRepo: repository.NewModerationRepository(db),
+		verifyRepo:     repository.NewVerificationRepository(db),
+		discoveryRepo:  repository.NewDiscoveryRepository(db),
+		viewRepo:       repository.NewProfileViewRepository(db),
+		notifyRepo:     repository.NewNotificationRepository(db),
+		likeRepo:       repository.NewLikeRepository(db),
+		matchesRepo:    repository.NewCuratedMatchesRepository(db),
+		promptRepo:     repository.NewPromptRepository(db),
+		datesRepo:      repository.NewScheduledDatesRepository(db),
+		availRepo:      repository.NewAvailabilityRepository(db),
+		adminRepo:      repository.NewAdminRepository(db),
+		geocoder:       geo.NewGazetteerGeocoder(),
+
+		reportEscalationThreshold: cfg.ReportEscalationThreshold,
+		reportEscalationWindow:    time.Duration(cfg.ReportEscalationWindowDays) * 24 * time.Hour,
+
+		dailyLikeLimit:      cfg.DailyLikeLimit,
+		dailySuperLikeLimit: cfg.DailySuperLikeLimit,
+
+		dataExportSigningKey: []byte(cfg.DataExportSigningKey),
+		publicBaseURL:        cfg.PublicAPIURL,
+
+		lateCancellationWindow: time.Duration(cfg.LateCancellationWindowHours) * time.Hour,
+	}
+}
+
+// getEnvOrDefault returns the environment variable value or a fallback
+func getEnvOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvIntOrDefault returns the environment variable parsed as an int or a fallback
+func getEnvIntOrDefault(key string, fallback int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
 	}
+	return fallback
 }
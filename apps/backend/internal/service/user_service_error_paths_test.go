@@ -172,7 +172,7 @@ func TestReportUser_DatabaseInsertError(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "userID", 1)
 
 	// Mock report insert with error
-	mock.ExpectExec("INSERT INTO user_reports").
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_reports").
 		WillReturnError(sql.ErrConnDone)
 
 	req := &userpb.ReportUserRequest{
@@ -0,0 +1,350 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/auth"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// workEmailCodeTTL is how long a work email code can be used
+	workEmailCodeTTL = 15 * time.Minute
+	// workEmailResendCooldown is the minimum gap between codes sent to one user
+	workEmailResendCooldown = 60 * time.Second
+	// workEmailMaxAttempts is how many wrong codes are accepted before a new one is needed
+	workEmailMaxAttempts = 5
+	// maxIDImageSize caps each uploaded ID document image
+	maxIDImageSize = 10 * 1024 * 1024
+)
+
+// freeEmailDomains are consumer mailbox providers that don't prove an employer
+var freeEmailDomains = map[string]bool{
+	"gmail.com":      true,
+	"googlemail.com": true,
+	"yahoo.com":      true,
+	"yahoo.co.in":    true,
+	"outlook.com":    true,
+	"hotmail.com":    true,
+	"live.com":       true,
+	"msn.com":        true,
+	"icloud.com":     true,
+	"me.com":         true,
+	"aol.com":        true,
+	"protonmail.com": true,
+	"proton.me":      true,
+	"rediffmail.com": true,
+	"zoho.com":       true,
+	"yandex.com":     true,
+	"gmx.com":        true,
+	"mail.com":       true,
+}
+
+// SendWorkEmailVerification emails a one-time code to a corporate address
+func (s *UserService) SendWorkEmailVerification(
+	ctx context.Context,
+	req *userpb.SendWorkEmailVerificationRequest,
+) (*userpb.SendWorkEmailVerificationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.WorkEmail))
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 || !strings.Contains(email[at+1:], ".") {
+		return nil, status.Error(codes.InvalidArgument, "a valid work_email is required")
+	}
+	domain := email[at+1:]
+	if freeEmailDomains[domain] {
+		return nil, status.Error(codes.InvalidArgument, "personal email providers can't be used for work email verification")
+	}
+
+	latest, err := s.verifyRepo.GetLatestWorkEmailVerification(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrVerificationNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+	if latest != nil && !latest.VerifiedAt.Valid && time.Since(latest.CreatedAt) < workEmailResendCooldown {
+		return nil, status.Error(codes.ResourceExhausted, "please wait before requesting another code")
+	}
+
+	code, err := auth.GenerateVerificationCode()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to generate code")
+	}
+
+	verification := &repository.WorkEmailVerification{
+		UserID:    userID,
+		Email:     email,
+		Domain:    domain,
+		CodeHash:  hashVerificationCode(code),
+		ExpiresAt: time.Now().Add(workEmailCodeTTL),
+	}
+	if err := s.verifyRepo.CreateWorkEmailVerification(ctx, verification); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create verification: %v", err)
+	}
+
+	if s.emailClient != nil {
+		expiresAt := verification.ExpiresAt.In(userLocation(ctx, s.profileRepo, userID))
+		if err := s.emailClient.SendWorkEmailVerificationCode(email, code, expiresAt); err != nil {
+			log.Printf("Failed to send work email code to user %d: %v", userID, err)
+		}
+	}
+
+	return &userpb.SendWorkEmailVerificationResponse{
+		ExpiresAt: timeToProto(verification.ExpiresAt),
+		Message:   "A verification code has been sent to your work email",
+	}, nil
+}
+
+// VerifyWorkEmail confirms a work email code and grants the work email badge
+func (s *UserService) VerifyWorkEmail(
+	ctx context.Context,
+	req *userpb.VerifyWorkEmailRequest,
+) (*userpb.VerifyWorkEmailResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	verification, err := s.verifyRepo.GetLatestWorkEmailVerification(ctx, userID)
+	if errors.Is(err, repository.ErrVerificationNotFound) {
+		return nil, status.Error(codes.FailedPrecondition, "no work email verification in progress")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+	if verification.VerifiedAt.Valid {
+		return nil, status.Error(codes.FailedPrecondition, "no work email verification in progress")
+	}
+	if time.Now().After(verification.ExpiresAt) {
+		return nil, status.Error(codes.FailedPrecondition, "code has expired, please request a new one")
+	}
+	if verification.Attempts >= workEmailMaxAttempts {
+		return nil, status.Error(codes.ResourceExhausted, "too many attempts, please request a new code")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashVerificationCode(code)), []byte(verification.CodeHash)) != 1 {
+		if err := s.verifyRepo.IncrementWorkEmailAttempts(ctx, verification.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record attempt: %v", err)
+		}
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	if err := s.verifyRepo.ConfirmWorkEmail(ctx, verification); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify work email: %v", err)
+	}
+
+	return &userpb.VerifyWorkEmailResponse{
+		Domain:  verification.Domain,
+		Message: "Your work email has been verified",
+	}, nil
+}
+
+// SubmitIdVerification uploads a government ID for moderator review
+func (s *UserService) SubmitIdVerification(
+	ctx context.Context,
+	req *userpb.SubmitIdVerificationRequest,
+) (*userpb.SubmitIdVerificationResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	documentType := idDocumentTypeToString(req.DocumentType)
+	if documentType == "" {
+		return nil, status.Error(codes.InvalidArgument, "document_type is required")
+	}
+
+	idNumber := strings.ToUpper(strings.Join(strings.Fields(req.IdNumber), ""))
+	if len(idNumber) < 6 || len(idNumber) > 20 {
+		return nil, status.Error(codes.InvalidArgument, "id_number must be between 6 and 20 characters")
+	}
+
+	if len(req.FrontImage) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "front_image is required")
+	}
+	validContentTypes := map[string]bool{
+		"image/jpeg": true,
+		"image/jpg":  true,
+		"image/png":  true,
+		"image/webp": true,
+	}
+	if !validContentTypes[req.ContentType] {
+		return nil, status.Error(codes.InvalidArgument, "invalid content_type: must be jpeg, jpg, png, or webp")
+	}
+	for _, image := range [][]byte{req.FrontImage, req.BackImage, req.SelfieImage} {
+		if len(image) > maxIDImageSize {
+			return nil, status.Error(codes.InvalidArgument, "images must be at most 10MB")
+		}
+	}
+
+	// Store the images first; they are removed again if the submission fails
+	prefix := fmt.Sprintf("id-documents/%d/%d", userID, time.Now().UnixNano())
+	images := map[string][]byte{
+		"front":  req.FrontImage,
+		"back":   req.BackImage,
+		"selfie": req.SelfieImage,
+	}
+	var storedKeys []string
+	keys := map[string]sql.NullString{}
+	for name, data := range images {
+		if len(data) == 0 {
+			continue
+		}
+		key := prefix + "/" + name
+		if err := s.blobStore.Put(ctx, key, data); err != nil {
+			s.deleteIDDocuments(ctx, storedKeys)
+			return nil, status.Error(codes.Internal, "failed to store document")
+		}
+		storedKeys = append(storedKeys, key)
+		keys[name] = sql.NullString{String: key, Valid: true}
+	}
+
+	verification := &repository.IDVerification{
+		UserID:         userID,
+		DocumentType:   documentType,
+		IDNumberMasked: maskIDNumber(idNumber),
+		FrontImageKey:  keys["front"].String,
+		BackImageKey:   keys["back"],
+		SelfieImageKey: keys["selfie"],
+	}
+	if err := s.verifyRepo.CreateIDVerification(ctx, verification); err != nil {
+		s.deleteIDDocuments(ctx, storedKeys)
+		if errors.Is(err, repository.ErrVerificationPending) {
+			return nil, status.Error(codes.AlreadyExists, "an ID verification is already awaiting review")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to submit verification: %v", err)
+	}
+
+	return &userpb.SubmitIdVerificationResponse{
+		VerificationId: strconv.Itoa(verification.ID),
+		Status:         stringToIDVerificationStatus(verification.Status),
+		Message:        "Your document has been submitted and will be reviewed by our team",
+	}, nil
+}
+
+// GetVerificationStatus returns the user's verification badges and latest ID submission
+func (s *UserService) GetVerificationStatus(
+	ctx context.Context,
+	req *userpb.GetVerificationStatusRequest,
+) (*userpb.GetVerificationStatusResponse, error) {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "authentication required")
+	}
+
+	resp := &userpb.GetVerificationStatusResponse{
+		IdVerificationStatus: userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_NOT_SUBMITTED,
+	}
+
+	profile, err := s.profileRepo.GetProfileByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrProfileNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get profile: %v", err)
+	}
+	if profile != nil {
+		resp.WorkEmailVerified = profile.WorkEmailVerified
+		resp.WorkEmailDomain = profile.WorkEmailDomain.String
+		resp.IdVerified = profile.IsVerified
+	}
+
+	latest, err := s.verifyRepo.GetLatestIDVerification(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrVerificationNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get verification: %v", err)
+	}
+	if latest != nil {
+		resp.IdVerificationStatus = stringToIDVerificationStatus(latest.Status)
+		resp.IdNumberMasked = latest.IDNumberMasked
+		resp.RejectionReason = latest.RejectionReason.String
+	}
+
+	return resp, nil
+}
+
+// deleteIDDocuments removes stored ID images, logging rather than failing on errors
+func (s *UserService) deleteIDDocuments(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			log.Printf("Failed to delete identity document %s: %v", key, err)
+		}
+	}
+}
+
+// hashVerificationCode hashes a one-time code so it isn't stored in plain text
+func hashVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// maskIDNumber keeps only the last 4 characters of a document number
+func maskIDNumber(idNumber string) string {
+	if len(idNumber) <= 4 {
+		return strings.Repeat("X", len(idNumber))
+	}
+	return strings.Repeat("X", len(idNumber)-4) + idNumber[len(idNumber)-4:]
+}
+
+func idDocumentTypeToString(t userpb.IdDocumentType) string {
+	switch t {
+	case userpb.IdDocumentType_ID_DOCUMENT_TYPE_AADHAAR:
+		return "AADHAAR"
+	case userpb.IdDocumentType_ID_DOCUMENT_TYPE_PASSPORT:
+		return "PASSPORT"
+	case userpb.IdDocumentType_ID_DOCUMENT_TYPE_DRIVING_LICENSE:
+		return "DRIVING_LICENSE"
+	case userpb.IdDocumentType_ID_DOCUMENT_TYPE_VOTER_ID:
+		return "VOTER_ID"
+	case userpb.IdDocumentType_ID_DOCUMENT_TYPE_PAN:
+		return "PAN"
+	default:
+		return ""
+	}
+}
+
+func stringToIDDocumentType(t string) userpb.IdDocumentType {
+	switch t {
+	case "AADHAAR":
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_AADHAAR
+	case "PASSPORT":
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_PASSPORT
+	case "DRIVING_LICENSE":
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_DRIVING_LICENSE
+	case "VOTER_ID":
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_VOTER_ID
+	case "PAN":
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_PAN
+	default:
+		return userpb.IdDocumentType_ID_DOCUMENT_TYPE_UNSPECIFIED
+	}
+}
+
+func stringToIDVerificationStatus(s string) userpb.IdVerificationStatus {
+	switch s {
+	case "PENDING":
+		return userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_PENDING
+	case "APPROVED":
+		return userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_APPROVED
+	case "REJECTED":
+		return userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_REJECTED
+	default:
+		return userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var workEmailVerificationColumns = []string{
+	"id", "user_id", "email", "domain", "code_hash", "expires_at", "attempts", "verified_at", "created_at",
+}
+
+func TestSendWorkEmailVerification_RejectsFreeMailDomain(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	_, err := service.SendWorkEmailVerification(ctx, &userpb.SendWorkEmailVerificationRequest{
+		WorkEmail: "someone@Gmail.com",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSendWorkEmailVerification_Success(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	emailSender := &mockUserEmailSender{}
+	service.emailClient = emailSender
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_work_email_verifications").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(workEmailVerificationColumns))
+	mock.ExpectQuery("INSERT INTO datifyy_v2_work_email_verifications").
+		WithArgs(1, "jane@acme.io", "acme.io", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(4, now))
+	expectUserTimezone(mock, 1, "UTC")
+
+	resp, err := service.SendWorkEmailVerification(ctx, &userpb.SendWorkEmailVerificationRequest{
+		WorkEmail: " Jane@Acme.io ",
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, resp.ExpiresAt)
+	assert.Equal(t, "jane@acme.io", emailSender.workEmailTo)
+	assert.Len(t, emailSender.workEmailCode, 6)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSendWorkEmailVerification_Cooldown(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_work_email_verifications").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(workEmailVerificationColumns).AddRow(
+			4, 1, "jane@acme.io", "acme.io", hashVerificationCode("123456"), now.Add(workEmailCodeTTL), 0, nil, now.Add(-10*time.Second),
+		))
+
+	_, err := service.SendWorkEmailVerification(ctx, &userpb.SendWorkEmailVerificationRequest{
+		WorkEmail: "jane@acme.io",
+	})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerifyWorkEmail_WrongCodeCountsAttempt(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_work_email_verifications").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(workEmailVerificationColumns).AddRow(
+			4, 1, "jane@acme.io", "acme.io", hashVerificationCode("123456"), now.Add(10*time.Minute), 0, nil, now,
+		))
+	mock.ExpectExec("UPDATE datifyy_v2_work_email_verifications SET attempts = attempts \\+ 1").
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	_, err := service.VerifyWorkEmail(ctx, &userpb.VerifyWorkEmailRequest{Code: "654321"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVerifyWorkEmail_Success(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_work_email_verifications").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(workEmailVerificationColumns).AddRow(
+			4, 1, "jane@acme.io", "acme.io", hashVerificationCode("123456"), now.Add(10*time.Minute), 1, nil, now,
+		))
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE datifyy_v2_work_email_verifications SET verified_at = NOW\\(\\)").
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO datifyy_v2_user_profiles (.+) ON CONFLICT \\(user_id\\) DO UPDATE").
+		WithArgs(1, "acme.io").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	resp, err := service.VerifyWorkEmail(ctx, &userpb.VerifyWorkEmailRequest{Code: "123456"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "acme.io", resp.Domain)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubmitIdVerification_StoresMaskedNumberAndImages(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	storageDir := t.TempDir()
+	service.blobStore = storage.NewLocalStore(storageDir)
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+	now := time.Now()
+
+	// Only the last 4 characters of the number are kept
+	mock.ExpectQuery("INSERT INTO datifyy_v2_id_verifications").
+		WithArgs(1, "AADHAAR", "XXXXXXXX9012", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "created_at"}).AddRow(9, "PENDING", now))
+
+	resp, err := service.SubmitIdVerification(ctx, &userpb.SubmitIdVerificationRequest{
+		DocumentType: userpb.IdDocumentType_ID_DOCUMENT_TYPE_AADHAAR,
+		IdNumber:     "1234 5678 9012",
+		FrontImage:   []byte("front"),
+		SelfieImage:  []byte("selfie"),
+		ContentType:  "image/jpeg",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "9", resp.VerificationId)
+	assert.Equal(t, userpb.IdVerificationStatus_ID_VERIFICATION_STATUS_PENDING, resp.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	stored, err := filepath.Glob(filepath.Join(storageDir, "id-documents", "1", "*", "*"))
+	require.NoError(t, err)
+	assert.Len(t, stored, 2)
+}
+
+func TestSubmitIdVerification_AlreadyPendingRemovesImages(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	storageDir := t.TempDir()
+	service.blobStore = storage.NewLocalStore(storageDir)
+
+	ctx := context.WithValue(context.Background(), "userID", 1)
+
+	mock.ExpectQuery("INSERT INTO datifyy_v2_id_verifications").
+		WillReturnError(errors.New(`pq: duplicate key value violates unique constraint "idx_datifyy_v2_id_verifications_pending"`))
+
+	_, err := service.SubmitIdVerification(ctx, &userpb.SubmitIdVerificationRequest{
+		DocumentType: userpb.IdDocumentType_ID_DOCUMENT_TYPE_PASSPORT,
+		IdNumber:     "K1234567",
+		FrontImage:   []byte("front"),
+		ContentType:  "image/png",
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	stored, err := filepath.Glob(filepath.Join(storageDir, "id-documents", "1", "*", "*"))
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+}
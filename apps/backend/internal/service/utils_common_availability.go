@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/datifyy/backend/internal/repository"
+)
+
+const (
+	// Defaults for CommonAvailabilityOptions
+	defaultCommonAvailabilityWindow      = 14 * 24 * time.Hour
+	defaultCommonAvailabilityMinDuration = 60 * time.Minute
+	defaultDateBuffer                    = 30 * time.Minute
+	defaultMaxMeetingDistanceKm          = 25
+	defaultCommonAvailabilityLimit       = 20
+
+	// maxCommonAvailabilityWindow caps how far ahead a search may look
+	maxCommonAvailabilityWindow = 60 * 24 * time.Hour
+
+	// commonAvailabilityIdealLength is the window length that gets the full
+	// length score; longer windows don't rank any higher
+	commonAvailabilityIdealLength = 3 * time.Hour
+)
+
+// Weights of the parts of a candidate's score; they add up to 1
+const (
+	proximityScoreWeight = 0.5
+	lengthScoreWeight    = 0.3
+	soonnessScoreWeight  = 0.2
+)
+
+// sameCityProximity is the proximity score of places in the same city that
+// aren't known to be within the radius
+const sameCityProximity = 0.25
+
+var (
+	errInvalidCommonAvailabilityWindow = errors.New("to_time must be after from_time and at most 60 days later")
+	errSameUser                        = errors.New("the two users must be different")
+)
+
+// CommonAvailabilityOptions narrows down a search for times two users are
+// both free. Zero values use the defaults.
+type CommonAvailabilityOptions struct {
+	DateType      string // online, offline or offline_event; empty for any
+	From          time.Time
+	To            time.Time
+	MinDuration   time.Duration // shortest window worth returning
+	Buffer        time.Duration // kept free around dates already scheduled
+	MaxDistanceKm float64       // between the places of offline slots
+	Limit         int
+}
+
+// withDefaults fills in unset options and validates the search window
+func (o CommonAvailabilityOptions) withDefaults(now time.Time) (CommonAvailabilityOptions, error) {
+	if o.From.IsZero() || o.From.Before(now) {
+		o.From = now
+	}
+	if o.To.IsZero() {
+		o.To = o.From.Add(defaultCommonAvailabilityWindow)
+	}
+	if !o.To.After(o.From) || o.To.Sub(o.From) > maxCommonAvailabilityWindow {
+		return o, errInvalidCommonAvailabilityWindow
+	}
+	if o.MinDuration <= 0 {
+		o.MinDuration = defaultCommonAvailabilityMinDuration
+	}
+	if o.Buffer <= 0 {
+		o.Buffer = defaultDateBuffer
+	}
+	if o.MaxDistanceKm <= 0 {
+		o.MaxDistanceKm = defaultMaxMeetingDistanceKm
+	}
+	if o.Limit <= 0 {
+		o.Limit = defaultCommonAvailabilityLimit
+	}
+	return o, nil
+}
+
+// CommonAvailabilityCandidate is a window in which two users are both
+// available, from a pair of their slots with compatible date types and places
+type CommonAvailabilityCandidate struct {
+	Start       time.Time
+	End         time.Time
+	DateType    string
+	User1Slot   *repository.AvailabilitySlot
+	User2Slot   *repository.AvailabilitySlot
+	DistanceKm  float64 // between the slots' places, when HasDistance
+	HasDistance bool
+	SameCity    bool
+	Score       float64 // 0-1, higher is better
+}
+
+// timeInterval is a half-open [start, end) range of Unix seconds
+type timeInterval struct {
+	start int64
+	end   int64
+}
+
+// busyIntervals returns the times taken by scheduled dates, padded by buffer
+// on both sides, and by exclusions imported from calendars, sorted by start
+func busyIntervals(dates []*repository.ScheduledDate, exclusions []*repository.AvailabilityExclusion, buffer time.Duration) []timeInterval {
+	busy := make([]timeInterval, 0, len(dates)+len(exclusions))
+	for _, date := range dates {
+		end := date.ScheduledTime.Add(time.Duration(date.DurationMinutes) * time.Minute)
+		busy = append(busy, timeInterval{
+			start: date.ScheduledTime.Add(-buffer).Unix(),
+			end:   end.Add(buffer).Unix(),
+		})
+	}
+	for _, exclusion := range exclusions {
+		busy = append(busy, timeInterval{start: exclusion.StartTime, end: exclusion.EndTime})
+	}
+	sort.Slice(busy, func(i, j int) bool { return busy[i].start < busy[j].start })
+	return busy
+}
+
+// subtractIntervals returns what's left of free once the busy intervals
+// (sorted by start) are taken out of it
+func subtractIntervals(free timeInterval, busy []timeInterval) []timeInterval {
+	var remaining []timeInterval
+	cursor := free.start
+	for _, b := range busy {
+		if b.end <= cursor || b.start >= free.end {
+			continue
+		}
+		if b.start > cursor {
+			remaining = append(remaining, timeInterval{start: cursor, end: b.start})
+		}
+		if b.end > cursor {
+			cursor = b.end
+		}
+	}
+	if cursor < free.end {
+		remaining = append(remaining, timeInterval{start: cursor, end: free.end})
+	}
+	return remaining
+}
+
+// slotPoint returns a slot's coordinates, if it has valid ones
+func slotPoint(slot *repository.AvailabilitySlot) (geo.Point, bool) {
+	if !slot.Latitude.Valid || !slot.Longitude.Valid {
+		return geo.Point{}, false
+	}
+	point := geo.Point{Lat: slot.Latitude.Float64, Lng: slot.Longitude.Float64}
+	return point, point.Valid()
+}
+
+// sameCity reports whether two slots are in the same city. Countries are only
+// compared when both slots have one.
+func sameCity(a, b *repository.AvailabilitySlot) bool {
+	cityA, cityB := strings.TrimSpace(a.City.String), strings.TrimSpace(b.City.String)
+	if cityA == "" || !strings.EqualFold(cityA, cityB) {
+		return false
+	}
+	countryA, countryB := strings.TrimSpace(a.Country.String), strings.TrimSpace(b.Country.String)
+	return countryA == "" || countryB == "" || strings.EqualFold(countryA, countryB)
+}
+
+// slotsCompatible reports whether two users could meet in a pair of their
+// slots: same date type (matching dateType when set) and, for offline dates,
+// places in the same city or within maxDistanceKm of each other. It fills in
+// the candidate's place details and proximity score.
+func slotsCompatible(a, b *repository.AvailabilitySlot, dateType string, maxDistanceKm float64, candidate *CommonAvailabilityCandidate) (proximity float64, ok bool) {
+	if a.DateType != b.DateType || (dateType != "" && a.DateType != dateType) {
+		return 0, false
+	}
+	if a.DateType == "online" {
+		return 1, true
+	}
+
+	candidate.SameCity = sameCity(a, b)
+	if pointA, okA := slotPoint(a); okA {
+		if pointB, okB := slotPoint(b); okB {
+			candidate.DistanceKm = geo.DistanceKm(pointA, pointB)
+			candidate.HasDistance = true
+		}
+	}
+
+	withinRadius := candidate.HasDistance && candidate.DistanceKm <= maxDistanceKm
+	if !withinRadius && !candidate.SameCity {
+		return 0, false
+	}
+
+	if withinRadius {
+		proximity = 1 - candidate.DistanceKm/maxDistanceKm
+	}
+	if candidate.SameCity && proximity < sameCityProximity {
+		proximity = sameCityProximity
+	}
+	return proximity, true
+}
+
+// commonAvailability loads both users' slots, dates and calendar exclusions
+// around the search window and finds the windows in which they're both free.
+// opts must have its defaults filled in.
+func commonAvailability(
+	ctx context.Context,
+	availabilityRepo *repository.AvailabilityRepository,
+	scheduledDatesRepo *repository.ScheduledDatesRepository,
+	user1ID, user2ID int,
+	opts CommonAvailabilityOptions,
+) ([]CommonAvailabilityCandidate, error) {
+	// Slots starting before the window may still run into it
+	slotsFrom := opts.From.Add(-repository.MaxSlotDuration).Unix()
+	user1Slots, err := availabilityRepo.GetByUserID(ctx, user1ID, slotsFrom, opts.To.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user1 availability: %w", err)
+	}
+	user2Slots, err := availabilityRepo.GetByUserID(ctx, user2ID, slotsFrom, opts.To.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user2 availability: %w", err)
+	}
+	if len(user1Slots) == 0 || len(user2Slots) == 0 {
+		return nil, nil
+	}
+
+	dates, err := scheduledDatesRepo.ListActiveByUsersBetween(ctx, []int{user1ID, user2ID},
+		opts.From.Add(-opts.Buffer), opts.To.Add(opts.Buffer))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scheduled dates: %w", err)
+	}
+
+	exclusions, err := availabilityRepo.GetExclusionsForUsers(ctx, []int{user1ID, user2ID},
+		opts.From.Unix(), opts.To.Unix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get availability exclusions: %w", err)
+	}
+
+	return findCommonWindows(user1Slots, user2Slots, busyIntervals(dates, exclusions, opts.Buffer), opts), nil
+}
+
+// findCommonWindows intersects two users' unreserved slots, drops the busy
+// intervals and windows shorter than the minimum, and ranks what's left by a
+// weighted score of how close the places are, how long the window is and how
+// soon it starts. opts must have its defaults filled in.
+func findCommonWindows(slots1, slots2 []*repository.AvailabilitySlot, busy []timeInterval, opts CommonAvailabilityOptions) []CommonAvailabilityCandidate {
+	from, to := opts.From.Unix(), opts.To.Unix()
+	minSeconds := int64(opts.MinDuration.Seconds())
+	span := float64(to - from)
+
+	var candidates []CommonAvailabilityCandidate
+	for _, a := range slots1 {
+		if a.ReservedDateID.Valid {
+			continue
+		}
+		for _, b := range slots2 {
+			if b.ReservedDateID.Valid {
+				continue
+			}
+			overlap := timeInterval{start: max(a.StartTime, b.StartTime, from), end: min(a.EndTime, b.EndTime, to)}
+			if overlap.end-overlap.start < minSeconds {
+				continue
+			}
+
+			base := CommonAvailabilityCandidate{DateType: a.DateType, User1Slot: a, User2Slot: b}
+			proximity, ok := slotsCompatible(a, b, opts.DateType, opts.MaxDistanceKm, &base)
+			if !ok {
+				continue
+			}
+
+			for _, window := range subtractIntervals(overlap, busy) {
+				length := window.end - window.start
+				if length < minSeconds {
+					continue
+				}
+
+				candidate := base
+				candidate.Start = time.Unix(window.start, 0)
+				candidate.End = time.Unix(window.end, 0)
+
+				lengthScore := min(1, float64(length)/commonAvailabilityIdealLength.Seconds())
+				soonness := 1 - float64(window.start-from)/span
+				candidate.Score = proximityScoreWeight*proximity + lengthScoreWeight*lengthScore + soonnessScoreWeight*soonness
+
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Start.Before(candidates[j].Start)
+	})
+
+	if len(candidates) > opts.Limit {
+		candidates = candidates[:opts.Limit]
+	}
+	return candidates
+}
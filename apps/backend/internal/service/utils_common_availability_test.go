@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	adminpb "github.com/datifyy/backend/gen/admin/v1"
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testSlot(id int, start time.Time, hours int, dateType string) *repository.AvailabilitySlot {
+	return &repository.AvailabilitySlot{
+		ID:        id,
+		StartTime: start.Unix(),
+		EndTime:   start.Add(time.Duration(hours) * time.Hour).Unix(),
+		DateType:  dateType,
+	}
+}
+
+func offlineTestSlot(id int, start time.Time, hours int, city string, lat, lng float64) *repository.AvailabilitySlot {
+	slot := testSlot(id, start, hours, "offline")
+	slot.City = sql.NullString{String: city, Valid: city != ""}
+	slot.Latitude = sql.NullFloat64{Float64: lat, Valid: lat != 0}
+	slot.Longitude = sql.NullFloat64{Float64: lng, Valid: lng != 0}
+	return slot
+}
+
+func TestSubtractIntervals(t *testing.T) {
+	free := timeInterval{start: 0, end: 100}
+
+	assert.Equal(t, []timeInterval{{0, 100}}, subtractIntervals(free, nil))
+	assert.Equal(t, []timeInterval{{0, 20}, {40, 60}, {90, 100}},
+		subtractIntervals(free, []timeInterval{{20, 40}, {30, 35}, {60, 90}, {150, 200}}))
+	assert.Equal(t, []timeInterval{{50, 100}}, subtractIntervals(free, []timeInterval{{-10, 50}}))
+	assert.Empty(t, subtractIntervals(free, []timeInterval{{-10, 40}, {30, 120}}))
+}
+
+func TestFindCommonWindows_SubtractsBufferedDates(t *testing.T) {
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	opts, err := CommonAvailabilityOptions{From: from}.withDefaults(from)
+	require.NoError(t, err)
+
+	evening := from.Add(18 * time.Hour)
+	slots1 := []*repository.AvailabilitySlot{testSlot(1, evening, 4, "online")}
+	slots2 := []*repository.AvailabilitySlot{testSlot(2, evening.Add(time.Hour), 4, "online")}
+
+	// User 2 has an hour-long date at 20:00, blocking 19:30-21:30 with the buffer
+	busy := busyIntervals([]*repository.ScheduledDate{
+		{ScheduledTime: evening.Add(2 * time.Hour), DurationMinutes: 60},
+	}, nil, opts.Buffer)
+
+	candidates := findCommonWindows(slots1, slots2, busy, opts)
+	require.Len(t, candidates, 0, "19:00-19:30 and 21:30-22:00 are shorter than an hour")
+
+	opts.MinDuration = 30 * time.Minute
+	candidates = findCommonWindows(slots1, slots2, busy, opts)
+	require.Len(t, candidates, 2)
+	for _, c := range candidates {
+		assert.Equal(t, 30*time.Minute, c.End.Sub(c.Start))
+		assert.Equal(t, 1, c.User1Slot.ID)
+		assert.Equal(t, 2, c.User2Slot.ID)
+	}
+	assert.True(t, candidates[0].Start.Before(candidates[1].Start), "equal windows rank sooner first")
+}
+
+func TestFindCommonWindows_SubtractsExclusionsUnbuffered(t *testing.T) {
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	opts, err := CommonAvailabilityOptions{From: from}.withDefaults(from)
+	require.NoError(t, err)
+
+	evening := from.Add(18 * time.Hour)
+	slots1 := []*repository.AvailabilitySlot{testSlot(1, evening, 4, "online")}
+	slots2 := []*repository.AvailabilitySlot{testSlot(2, evening, 4, "online")}
+
+	// User 1 imported a busy period from 19:00 to 20:00; unlike dates, it
+	// isn't padded by the buffer
+	busy := busyIntervals(nil, []*repository.AvailabilityExclusion{
+		{StartTime: evening.Add(time.Hour).Unix(), EndTime: evening.Add(2 * time.Hour).Unix()},
+	}, opts.Buffer)
+
+	candidates := findCommonWindows(slots1, slots2, busy, opts)
+	require.Len(t, candidates, 2)
+	assert.Equal(t, evening.Add(2*time.Hour).Unix(), candidates[0].Start.Unix(), "longer windows rank first")
+	assert.Equal(t, evening.Add(4*time.Hour).Unix(), candidates[0].End.Unix())
+	assert.Equal(t, evening.Unix(), candidates[1].Start.Unix())
+	assert.Equal(t, evening.Add(time.Hour).Unix(), candidates[1].End.Unix())
+}
+
+func TestFindCommonWindows_LocationCompatibility(t *testing.T) {
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	opts, err := CommonAvailabilityOptions{From: from}.withDefaults(from)
+	require.NoError(t, err)
+
+	day := from.Add(24 * time.Hour)
+	user1 := []*repository.AvailabilitySlot{
+		offlineTestSlot(1, day.Add(10*time.Hour), 2, "Pune", 18.5204, 73.8567),
+		offlineTestSlot(2, day.Add(14*time.Hour), 2, "Mumbai", 0, 0),
+		offlineTestSlot(3, day.Add(18*time.Hour), 2, "Pune", 18.5204, 73.8567),
+		testSlot(4, day.Add(21*time.Hour), 2, "online"),
+	}
+	user2 := []*repository.AvailabilitySlot{
+		offlineTestSlot(11, day.Add(10*time.Hour), 2, "Pimpri", 18.6298, 73.7997), // ~14km away
+		offlineTestSlot(12, day.Add(14*time.Hour), 2, " mumbai ", 19.0760, 72.8777),
+		offlineTestSlot(13, day.Add(18*time.Hour), 2, "Lonavala", 18.7481, 73.4072), // ~53km away
+		testSlot(14, day.Add(21*time.Hour), 2, "offline"),
+	}
+
+	candidates := findCommonWindows(user1, user2, nil, opts)
+	require.Len(t, candidates, 2)
+
+	// Within the radius beats only sharing a city
+	assert.Equal(t, 11, candidates[0].User2Slot.ID)
+	assert.True(t, candidates[0].HasDistance)
+	assert.InDelta(t, 14, candidates[0].DistanceKm, 1)
+	assert.False(t, candidates[0].SameCity)
+
+	assert.Equal(t, 12, candidates[1].User2Slot.ID)
+	assert.True(t, candidates[1].SameCity)
+	assert.False(t, candidates[1].HasDistance)
+	assert.Greater(t, candidates[0].Score, candidates[1].Score)
+
+	// A wider radius takes in Lonavala; a date type filter drops the rest
+	opts.MaxDistanceKm = 60
+	opts.DateType = "offline"
+	candidates = findCommonWindows(user1, user2, nil, opts)
+	require.Len(t, candidates, 3)
+
+	opts.DateType = "online"
+	assert.Empty(t, findCommonWindows(user1, user2, nil, opts))
+}
+
+func TestCommonAvailabilityOptions_Defaults(t *testing.T) {
+	now := time.Now()
+
+	opts, err := CommonAvailabilityOptions{From: now.Add(-time.Hour)}.withDefaults(now)
+	require.NoError(t, err)
+	assert.Equal(t, now, opts.From)
+	assert.Equal(t, now.Add(defaultCommonAvailabilityWindow), opts.To)
+	assert.Equal(t, time.Hour, opts.MinDuration)
+	assert.Equal(t, 30*time.Minute, opts.Buffer)
+
+	_, err = CommonAvailabilityOptions{To: now.Add(-time.Hour)}.withDefaults(now)
+	assert.ErrorIs(t, err, errInvalidCommonAvailabilityWindow)
+	_, err = CommonAvailabilityOptions{To: now.Add(61 * 24 * time.Hour)}.withDefaults(now)
+	assert.ErrorIs(t, err, errInvalidCommonAvailabilityWindow)
+}
+
+func TestAdminFindCommonAvailability(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	service := &AdminService{
+		db: db,
+		datesService: &DatesService{
+			db:                 db,
+			profileRepo:        repository.NewUserProfileRepository(db),
+			availabilityRepo:   repository.NewAvailabilityRepository(db),
+			scheduledDatesRepo: repository.NewScheduledDatesRepository(db),
+		},
+	}
+
+	from := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	slotRows := func(id, userID int, start time.Time, hours int) *sqlmock.Rows {
+		return sqlmock.NewRows(availabilitySlotColumns).AddRow(id, userID, start.Unix(), start.Add(time.Duration(hours)*time.Hour).Unix(), "online",
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, time.Now(), time.Now())
+	}
+
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots").
+		WithArgs(1, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(slotRows(10, 1, from, 3))
+	mock.ExpectQuery("FROM datifyy_v2_availability_slots").
+		WithArgs(2, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(slotRows(20, 2, from.Add(time.Hour), 3))
+	mock.ExpectQuery("FROM datifyy_v2_scheduled_dates").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery("FROM datifyy_v2_availability_exclusions").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	resp, err := service.FindCommonAvailability(context.Background(), &adminpb.FindCommonAvailabilityRequest{
+		User1Id:  "1",
+		User2Id:  "2",
+		FromTime: &commonpb.Timestamp{Seconds: from.Unix()},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Candidates, 1)
+	assert.Equal(t, from.Add(time.Hour).Unix(), resp.Candidates[0].StartTime.Seconds)
+	assert.Equal(t, int64(120), resp.Candidates[0].DurationMinutes)
+	assert.Equal(t, "10", resp.Candidates[0].User1SlotId)
+	assert.Equal(t, "20", resp.Candidates[0].User2SlotId)
+	assert.Nil(t, resp.Candidates[0].User1Location)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// Blocked pairs and bad input are rejected before looking at availability
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	_, err = service.FindCommonAvailability(context.Background(), &adminpb.FindCommonAvailabilityRequest{User1Id: "1", User2Id: "2"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = service.FindCommonAvailability(context.Background(), &adminpb.FindCommonAvailabilityRequest{User1Id: "1", User2Id: "1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = service.FindCommonAvailability(context.Background(), &adminpb.FindCommonAvailabilityRequest{User1Id: "1", User2Id: "2", DateType: "picnic"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -17,6 +17,8 @@ func accountStatusToProto(status string) commonpb.AccountStatus {
 		return commonpb.AccountStatus_ACCOUNT_STATUS_SUSPENDED
 	case "BANNED":
 		return commonpb.AccountStatus_ACCOUNT_STATUS_BANNED
+	case "PENDING_DELETION":
+		return commonpb.AccountStatus_ACCOUNT_STATUS_PENDING_DELETION
 	case "DELETED":
 		return commonpb.AccountStatus_ACCOUNT_STATUS_DELETED
 	default:
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+)
+
+// onlineWindow is how recently a user must have been active in a session to
+// count as online for the online-only search filter
+const onlineWindow = 15 * time.Minute
+
+// matchesSearchFilters checks the search filters that can't be expressed in
+// the candidate query against a candidate's profile. A filter with values
+// only keeps profiles that state one of them.
+func matchesSearchFilters(filters *userpb.SearchFilters, p *userpb.UserProfile) bool {
+	if filters == nil {
+		return true
+	}
+
+	if len(filters.GetInterests()) > 0 {
+		var interests []userpb.InterestCategory
+		for _, i := range p.GetProfileDetails().GetInterests() {
+			interests = append(interests, i.GetCategory())
+		}
+		if !sharesAny(filters.GetInterests(), interests) {
+			return false
+		}
+	}
+
+	if len(filters.GetRelationshipGoals()) > 0 &&
+		!sharesAny(filters.GetRelationshipGoals(), p.GetProfileDetails().GetRelationshipGoals()) {
+		return false
+	}
+
+	if len(filters.GetEducationLevels()) > 0 {
+		var levels []userpb.EducationLevel
+		if highest := p.GetProfessionalInfo().GetHighestEducation(); highest != userpb.EducationLevel_EDUCATION_LEVEL_UNSPECIFIED {
+			levels = append(levels, highest)
+		}
+		for _, e := range p.GetProfileDetails().GetEducation() {
+			levels = append(levels, e.GetLevel())
+		}
+		if !sharesAny(filters.GetEducationLevels(), levels) {
+			return false
+		}
+	}
+
+	lifestyle := p.GetLifestyleInfo()
+	if len(filters.GetDrinking()) > 0 && !sharesAny(filters.GetDrinking(), []userpb.DrinkingHabit{lifestyle.GetDrinking()}) {
+		return false
+	}
+	if len(filters.GetSmoking()) > 0 && !sharesAny(filters.GetSmoking(), []userpb.SmokingHabit{lifestyle.GetSmoking()}) {
+		return false
+	}
+	if len(filters.GetChildren()) > 0 && !sharesAny(filters.GetChildren(), []userpb.ChildrenPreference{lifestyle.GetChildren()}) {
+		return false
+	}
+
+	return true
+}
+
+// sharesAny reports whether any wanted value is among the stated values
+func sharesAny[E comparable](wanted, values []E) bool {
+	for _, w := range wanted {
+		for _, v := range values {
+			if v == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sharedTraits counts the interest categories, relationship goals and
+// lifestyle habits two profiles have in common. Unstated values never count.
+func sharedTraits(viewer, candidate *userpb.UserProfile) int {
+	if viewer == nil || candidate == nil {
+		return 0
+	}
+
+	shared := 0
+
+	viewerInterests := make(map[userpb.InterestCategory]bool)
+	for _, i := range viewer.GetProfileDetails().GetInterests() {
+		if i.GetCategory() != userpb.InterestCategory_INTEREST_UNSPECIFIED {
+			viewerInterests[i.GetCategory()] = true
+		}
+	}
+	for _, i := range candidate.GetProfileDetails().GetInterests() {
+		if viewerInterests[i.GetCategory()] {
+			shared++
+			delete(viewerInterests, i.GetCategory())
+		}
+	}
+
+	viewerGoals := make(map[userpb.RelationshipGoal]bool)
+	for _, g := range viewer.GetProfileDetails().GetRelationshipGoals() {
+		if g != userpb.RelationshipGoal_RELATIONSHIP_GOAL_UNSPECIFIED {
+			viewerGoals[g] = true
+		}
+	}
+	for _, g := range candidate.GetProfileDetails().GetRelationshipGoals() {
+		if viewerGoals[g] {
+			shared++
+			delete(viewerGoals, g)
+		}
+	}
+
+	own, their := viewer.GetLifestyleInfo(), candidate.GetLifestyleInfo()
+	if own.GetDrinking() != userpb.DrinkingHabit_DRINKING_UNSPECIFIED && own.GetDrinking() == their.GetDrinking() {
+		shared++
+	}
+	if own.GetSmoking() != userpb.SmokingHabit_SMOKING_UNSPECIFIED && own.GetSmoking() == their.GetSmoking() {
+		shared++
+	}
+	if own.GetChildren() != userpb.ChildrenPreference_CHILDREN_UNSPECIFIED && own.GetChildren() == their.GetChildren() {
+		shared++
+	}
+
+	return shared
+}
+
+// rankByCompatibility orders candidates by the share of the viewer's soft
+// preferences they meet, then by how many traits they share with the viewer.
+// Ties keep their existing (nearest or most recently active first) order.
+func rankByCompatibility(viewer *userpb.UserProfile, nearby []nearbyCandidate, profiles map[int]*userpb.UserProfile) {
+	for i := range nearby {
+		nearby[i].SharedTraits = sharedTraits(viewer, profiles[nearby[i].UserID])
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool {
+		if nearby[i].PreferenceScore != nearby[j].PreferenceScore {
+			return nearby[i].PreferenceScore > nearby[j].PreferenceScore
+		}
+		return nearby[i].SharedTraits > nearby[j].SharedTraits
+	})
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"testing"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// discoveryTestProfile returns a profile with the given interests, goal and drinking habit
+func discoveryTestProfile(goal userpb.RelationshipGoal, drinking userpb.DrinkingHabit, interests ...userpb.InterestCategory) *userpb.UserProfile {
+	p := &userpb.UserProfile{
+		ProfileDetails: &userpb.ProfileDetails{
+			RelationshipGoals: []userpb.RelationshipGoal{goal},
+			Education:         []*userpb.EducationInfo{{Level: userpb.EducationLevel_EDUCATION_LEVEL_MASTERS}},
+		},
+		LifestyleInfo: &userpb.LifestyleInfo{Drinking: drinking},
+	}
+	for _, i := range interests {
+		p.ProfileDetails.Interests = append(p.ProfileDetails.Interests, &userpb.InterestInfo{Category: i})
+	}
+	return p
+}
+
+func TestMatchesSearchFilters(t *testing.T) {
+	profile := discoveryTestProfile(userpb.RelationshipGoal_RELATIONSHIP_GOAL_LONG_TERM,
+		userpb.DrinkingHabit_DRINKING_SOCIALLY, userpb.InterestCategory_INTEREST_TRAVEL)
+
+	tests := []struct {
+		name    string
+		filters *userpb.SearchFilters
+		want    bool
+	}{
+		{"no filters", nil, true},
+		{"shared interest", &userpb.SearchFilters{
+			Interests: []userpb.InterestCategory{userpb.InterestCategory_INTEREST_MUSIC, userpb.InterestCategory_INTEREST_TRAVEL},
+		}, true},
+		{"no shared interest", &userpb.SearchFilters{
+			Interests: []userpb.InterestCategory{userpb.InterestCategory_INTEREST_MUSIC},
+		}, false},
+		{"relationship goal", &userpb.SearchFilters{
+			RelationshipGoals: []userpb.RelationshipGoal{userpb.RelationshipGoal_RELATIONSHIP_GOAL_LONG_TERM},
+		}, true},
+		{"education level", &userpb.SearchFilters{
+			EducationLevels: []userpb.EducationLevel{userpb.EducationLevel_EDUCATION_LEVEL_MASTERS},
+		}, true},
+		{"drinking habit", &userpb.SearchFilters{
+			Drinking: []userpb.DrinkingHabit{userpb.DrinkingHabit_DRINKING_NEVER},
+		}, false},
+		{"unstated children preference", &userpb.SearchFilters{
+			Children: []userpb.ChildrenPreference{userpb.ChildrenPreference_CHILDREN_DONT_HAVE_WANT},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchesSearchFilters(tt.filters, profile))
+		})
+	}
+}
+
+func TestRankByCompatibility(t *testing.T) {
+	longTerm := userpb.RelationshipGoal_RELATIONSHIP_GOAL_LONG_TERM
+	socially := userpb.DrinkingHabit_DRINKING_SOCIALLY
+	viewer := discoveryTestProfile(longTerm, socially,
+		userpb.InterestCategory_INTEREST_TRAVEL, userpb.InterestCategory_INTEREST_MUSIC)
+
+	profiles := map[int]*userpb.UserProfile{
+		2: discoveryTestProfile(userpb.RelationshipGoal_RELATIONSHIP_GOAL_UNSPECIFIED, userpb.DrinkingHabit_DRINKING_NEVER),
+		3: discoveryTestProfile(longTerm, socially, userpb.InterestCategory_INTEREST_TRAVEL, userpb.InterestCategory_INTEREST_MUSIC),
+		4: discoveryTestProfile(longTerm, userpb.DrinkingHabit_DRINKING_NEVER),
+		5: discoveryTestProfile(userpb.RelationshipGoal_RELATIONSHIP_GOAL_UNSPECIFIED, userpb.DrinkingHabit_DRINKING_NEVER),
+	}
+	nearby := []nearbyCandidate{{UserID: 2}, {UserID: 3}, {UserID: 4}, {UserID: 5, PreferenceScore: 80}}
+
+	rankByCompatibility(viewer, nearby, profiles)
+
+	require.Len(t, nearby, 4)
+	// Soft preferences come first, then shared traits; ties keep their order
+	assert.Equal(t, []int{5, 3, 4, 2}, []int{nearby[0].UserID, nearby[1].UserID, nearby[2].UserID, nearby[3].UserID})
+	assert.Equal(t, 4, nearby[1].SharedTraits) // two interests, the goal and drinking
+	assert.Equal(t, 1, nearby[2].SharedTraits)
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/geo"
+	"github.com/datifyy/backend/internal/kundli"
+	"github.com/datifyy/backend/internal/repository"
+)
+
+// geohashCellMarginKm pads bounding boxes so users near a cell edge aren't
+// dropped before their coarse distance is computed
+const geohashCellMarginKm = 5
+
+// errInvalidCoordinates is returned for latitude/longitude outside the valid range
+var errInvalidCoordinates = errors.New("invalid coordinates")
+
+// resolveLocationPoint returns the coordinates of a location, geocoding its
+// city when none were given. ok is false when the place is unknown.
+func resolveLocationPoint(ctx context.Context, geocoder geo.Geocoder, loc *commonpb.Location) (point geo.Point, ok bool, err error) {
+	if loc == nil {
+		return geo.Point{}, false, nil
+	}
+
+	point = geo.Point{Lat: loc.Latitude, Lng: loc.Longitude}
+	if loc.Latitude != 0 || loc.Longitude != 0 {
+		if !point.Valid() {
+			return geo.Point{}, false, errInvalidCoordinates
+		}
+		return point, true, nil
+	}
+
+	if geocoder == nil || strings.TrimSpace(loc.City) == "" {
+		return geo.Point{}, false, nil
+	}
+
+	country := loc.CountryCode
+	if country == "" {
+		country = loc.Country
+	}
+	place, err := geocoder.Geocode(ctx, loc.City, country)
+	if errors.Is(err, geo.ErrPlaceNotFound) {
+		return geo.Point{}, false, nil
+	}
+	if err != nil {
+		return geo.Point{}, false, fmt.Errorf("failed to geocode location: %w", err)
+	}
+
+	return place.Point, true, nil
+}
+
+// discoveryRadiusKm returns how far from home a user wants to see people.
+// Zero means no limit (global mode, or no preference set).
+func discoveryRadiusKm(userPrefs *repository.UserPreferences, partnerPrefs *repository.PartnerPreferences) int {
+	if userPrefs != nil {
+		if userPrefs.GlobalMode {
+			return 0
+		}
+		if userPrefs.DistanceRadius > 0 {
+			return userPrefs.DistanceRadius
+		}
+	}
+	if partnerPrefs != nil && partnerPrefs.DistancePreference > 0 {
+		return partnerPrefs.DistancePreference
+	}
+	return 0
+}
+
+// genderFilterValues maps gender enums to the values stored on users. Older
+// rows store "FEMALE", newer ones the enum name "GENDER_FEMALE".
+func genderFilterValues(genders []userpb.Gender) []string {
+	var values []string
+	for _, g := range genders {
+		if g == userpb.Gender_GENDER_UNSPECIFIED {
+			continue
+		}
+		name := g.String()
+		values = append(values, name, strings.TrimPrefix(name, "GENDER_"))
+	}
+	return values
+}
+
+// partnerGenderFilterValues reads the looking_for_gender preference
+func partnerGenderFilterValues(prefs *repository.PartnerPreferences) []string {
+	if prefs == nil || len(prefs.LookingForGender) == 0 {
+		return nil
+	}
+
+	pbGenders := make([]userpb.Gender, len(prefs.LookingForGender))
+	for i, g := range prefs.LookingForGender {
+		pbGenders[i] = userpb.Gender(g)
+	}
+	return genderFilterValues(pbGenders)
+}
+
+// nearbyCandidate is a discovery candidate with its coarse distance from the center
+type nearbyCandidate struct {
+	UserID      int
+	DistanceKm  float64
+	HasDistance bool
+	Horoscope   *kundli.Match // with the viewer, when both have birth details
+	// PreferenceScore is the share of the viewer's soft preferences the
+	// candidate meets, 0 when none could be checked
+	PreferenceScore int32
+	// SharedTraits counts the interests, relationship goals and lifestyle
+	// habits the candidate has in common with the viewer
+	SharedTraits int
+}
+
+// filterByDistance keeps candidates within radiusKm of center (a coarse
+// geohash), nearest first. Without a center or radius every candidate is kept
+// in its original order; distances are still filled in where known.
+func filterByDistance(candidates []repository.DiscoveryCandidate, center string, radiusKm int) []nearbyCandidate {
+	var nearby []nearbyCandidate
+	for _, c := range candidates {
+		n := nearbyCandidate{UserID: c.UserID}
+		n.DistanceKm, n.HasDistance = geo.CoarseDistanceKm(center, c.Geohash)
+
+		if center != "" && radiusKm > 0 {
+			// Users without a location can't be placed within the radius
+			if !n.HasDistance || n.DistanceKm > float64(radiusKm) {
+				continue
+			}
+		}
+		nearby = append(nearby, n)
+	}
+
+	if center != "" && radiusKm > 0 {
+		sort.SliceStable(nearby, func(i, j int) bool {
+			return nearby[i].DistanceKm < nearby[j].DistanceKm
+		})
+	}
+
+	return nearby
+}
+
+// discoveryBounds returns the bounding box to prefilter a radius search with
+func discoveryBounds(center string, radiusKm int) *repository.GeoBounds {
+	if radiusKm <= 0 {
+		return nil
+	}
+	point, ok := geo.DecodeGeohash(center)
+	if !ok {
+		return nil
+	}
+
+	minLat, maxLat, minLng, maxLng := geo.BoundingBox(point, float64(radiusKm+geohashCellMarginKm))
+	return &repository.GeoBounds{MinLat: minLat, MaxLat: maxLat, MinLng: minLng, MaxLng: maxLng}
+}
+
+// roundedDistanceKm converts a coarse distance to the bucket shown to other users
+func roundedDistanceKm(km float64) int32 {
+	return int32(geo.RoundDistanceKm(km))
+}
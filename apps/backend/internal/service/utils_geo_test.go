@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoveryRadiusKm(t *testing.T) {
+	partnerPrefs := &repository.PartnerPreferences{DistancePreference: 50}
+
+	assert.Equal(t, 25, discoveryRadiusKm(&repository.UserPreferences{DistanceRadius: 25}, partnerPrefs))
+	assert.Equal(t, 50, discoveryRadiusKm(&repository.UserPreferences{}, partnerPrefs))
+	assert.Zero(t, discoveryRadiusKm(&repository.UserPreferences{GlobalMode: true, DistanceRadius: 25}, partnerPrefs))
+	assert.Zero(t, discoveryRadiusKm(nil, nil))
+}
+
+func TestFilterByDistance(t *testing.T) {
+	candidates := []repository.DiscoveryCandidate{
+		{UserID: 1, Geohash: "te7ud"}, // Mumbai, ~120km from Pune
+		{UserID: 2, Geohash: "tek92"}, // Pune
+		{UserID: 3},                   // no location
+	}
+
+	nearby := filterByDistance(candidates, "tek92", 200)
+	require.Len(t, nearby, 2)
+	assert.Equal(t, 2, nearby[0].UserID)
+	assert.Equal(t, 1, nearby[1].UserID)
+	assert.Equal(t, int32(200), roundedDistanceKm(nearby[1].DistanceKm))
+
+	// Without a radius everyone is kept in their original order
+	nearby = filterByDistance(candidates, "tek92", 0)
+	require.Len(t, nearby, 3)
+	assert.Equal(t, 1, nearby[0].UserID)
+	assert.True(t, nearby[0].HasDistance)
+	assert.False(t, nearby[2].HasDistance)
+}
+
+func TestGenderFilterValues(t *testing.T) {
+	values := partnerGenderFilterValues(&repository.PartnerPreferences{LookingForGender: repository.EnumList{2}})
+	assert.ElementsMatch(t, []string{"GENDER_FEMALE", "FEMALE"}, values)
+	assert.Nil(t, partnerGenderFilterValues(&repository.PartnerPreferences{}))
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/kundli"
+	"github.com/datifyy/backend/internal/repository"
+)
+
+// errBirthDetailsMissing is returned when horoscope matching is required but
+// the user hasn't given a date, time and place of birth
+var errBirthDetailsMissing = errors.New("birth details missing")
+
+// birthChart casts a user's chart from their stored birth details
+func birthChart(d repository.BirthDetails) (kundli.Chart, error) {
+	return kundli.NewChart(kundli.Birth{
+		Date:      d.DateOfBirth,
+		Time:      d.BirthTime,
+		Timezone:  d.Timezone,
+		Latitude:  d.Latitude,
+		Longitude: d.Longitude,
+	})
+}
+
+// profileBirthChart casts a chart from a loaded user and profile. ok is false
+// when the birth details are incomplete or invalid.
+func profileBirthChart(user *repository.User, profile *repository.UserProfile) (kundli.Chart, bool) {
+	if !user.DateOfBirth.Valid || !profile.BirthTime.Valid ||
+		!profile.BirthLatitude.Valid || !profile.BirthLongitude.Valid {
+		return kundli.Chart{}, false
+	}
+
+	chart, err := birthChart(repository.BirthDetails{
+		DateOfBirth: user.DateOfBirth.Time,
+		BirthTime:   profile.BirthTime.String,
+		Timezone:    profile.BirthTimezone.String,
+		Latitude:    profile.BirthLatitude.Float64,
+		Longitude:   profile.BirthLongitude.Float64,
+	})
+	if err != nil {
+		return kundli.Chart{}, false
+	}
+	return chart, true
+}
+
+// isFemaleGender reports whether a stored gender ("FEMALE" or "GENDER_FEMALE") is female
+func isFemaleGender(gender string) bool {
+	return strings.TrimPrefix(gender, "GENDER_") == "FEMALE"
+}
+
+// horoscopeMatch scores two users' charts. Ashtakoota is directional, so in
+// a man-woman couple the woman's chart is the bride's; for other couples a
+// is used as the bride.
+func horoscopeMatch(a, b repository.BirthDetails) (kundli.Match, error) {
+	if isFemaleGender(b.Gender) && !isFemaleGender(a.Gender) {
+		a, b = b, a
+	}
+
+	bride, err := birthChart(a)
+	if err != nil {
+		return kundli.Match{}, err
+	}
+	groom, err := birthChart(b)
+	if err != nil {
+		return kundli.Match{}, err
+	}
+	return kundli.Ashtakoota(bride, groom), nil
+}
+
+// horoscopeMatches scores userID against each candidate. Candidates without
+// usable birth details are left out, and so is everyone when userID has
+// none, in which case errBirthDetailsMissing is returned.
+func horoscopeMatches(ctx context.Context, discoveryRepo *repository.DiscoveryRepository, userID int, candidateIDs []int) (map[int]kundli.Match, error) {
+	details, err := discoveryRepo.GetBirthDetails(ctx, append([]int{userID}, candidateIDs...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load birth details: %w", err)
+	}
+
+	own, ok := details[userID]
+	if !ok {
+		return nil, errBirthDetailsMissing
+	}
+	if _, err := birthChart(own); err != nil {
+		return nil, errBirthDetailsMissing
+	}
+
+	matches := make(map[int]kundli.Match)
+	for _, id := range candidateIDs {
+		other, ok := details[id]
+		if !ok || id == userID {
+			continue
+		}
+		match, err := horoscopeMatch(own, other)
+		if err != nil {
+			continue
+		}
+		matches[id] = match
+	}
+	return matches, nil
+}
+
+// manglikStatusToProto converts a chart's Mangal dosha to the profile enum
+func manglikStatusToProto(m kundli.ManglikStatus) userpb.ManglikStatus {
+	switch m {
+	case kundli.Manglik:
+		return userpb.ManglikStatus_MANGLIK_STATUS_MANGLIK
+	case kundli.AnshikManglik:
+		return userpb.ManglikStatus_MANGLIK_STATUS_ANSHIK_MANGLIK
+	default:
+		return userpb.ManglikStatus_MANGLIK_STATUS_NON_MANGLIK
+	}
+}
+
+// horoscopeMatchToProto converts a match result, returning nil for no match
+func horoscopeMatchToProto(m *kundli.Match) *userpb.HoroscopeMatch {
+	if m == nil {
+		return nil
+	}
+	return &userpb.HoroscopeMatch{
+		Gunas:             m.Total,
+		MaxGunas:          kundli.MaxGunas,
+		ManglikCompatible: m.ManglikCompatible,
+		Acceptable:        m.Acceptable(),
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Pune, 14 March 1992 06:30 (Punarvasu, manglik)
+var testBrideBirth = repository.BirthDetails{
+	UserID: 1, Gender: "GENDER_FEMALE", DateOfBirth: time.Date(1992, 3, 14, 0, 0, 0, 0, time.UTC),
+	BirthTime: "06:30", Latitude: 18.5204, Longitude: 73.8567,
+}
+
+// birthDetailsRows returns rows for grooms born in Mumbai at 09:15 on the
+// given days of July 1990, keyed by user ID
+func birthDetailsRows(grooms map[int]int) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{"id", "gender", "date_of_birth", "birth_time", "birth_timezone", "birth_latitude", "birth_longitude"}).
+		AddRow(testBrideBirth.UserID, testBrideBirth.Gender, testBrideBirth.DateOfBirth, testBrideBirth.BirthTime, "", testBrideBirth.Latitude, testBrideBirth.Longitude)
+	for id, day := range grooms {
+		rows.AddRow(id, "MALE", time.Date(1990, 7, day, 0, 0, 0, 0, time.UTC), "09:15", "Asia/Kolkata", 19.0760, 72.8777)
+	}
+	return rows
+}
+
+func TestHoroscopeMatch_WomanIsTheBride(t *testing.T) {
+	groom := repository.BirthDetails{
+		UserID: 2, Gender: "MALE", DateOfBirth: time.Date(1990, 7, 1, 0, 0, 0, 0, time.UTC),
+		BirthTime: "09:15", Latitude: 19.0760, Longitude: 72.8777,
+	}
+
+	asViewer, err := horoscopeMatch(testBrideBirth, groom)
+	require.NoError(t, err)
+	asCandidate, err := horoscopeMatch(groom, testBrideBirth)
+	require.NoError(t, err)
+
+	assert.Equal(t, asViewer, asCandidate)
+	assert.Equal(t, 26.5, asViewer.Total)
+	assert.True(t, asViewer.Acceptable())
+}
+
+func TestMatchHoroscopes_RequiredKeepsAcceptableMatches(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	// Candidate 2 scores 26.5 gunas, 3 only 8 and 4 has no birth details
+	mock.ExpectQuery("SELECT u.id, (.+) p.birth_time").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(birthDetailsRows(map[int]int{2: 1, 3: 5}))
+
+	nearby := []nearbyCandidate{{UserID: 2}, {UserID: 3}, {UserID: 4}}
+	matched, err := service.matchHoroscopes(context.Background(), 1,
+		&repository.PartnerPreferences{HoroscopeRequired: true}, nearby)
+
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+	assert.Equal(t, 2, matched[0].UserID)
+	assert.Equal(t, 26.5, matched[0].Horoscope.Total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchHoroscopes_OptionalKeepsEveryone(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT u.id, (.+) p.birth_time").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(birthDetailsRows(map[int]int{3: 5}))
+
+	nearby := []nearbyCandidate{{UserID: 3}, {UserID: 4}}
+	matched, err := service.matchHoroscopes(context.Background(), 1, nil, nearby)
+
+	require.NoError(t, err)
+	require.Len(t, matched, 2)
+	require.NotNil(t, matched[0].Horoscope)
+	assert.False(t, horoscopeMatchToProto(matched[0].Horoscope).Acceptable)
+	assert.Nil(t, matched[1].Horoscope)
+}
+
+func TestMatchHoroscopes_RequiredWithoutBirthDetails(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT u.id, (.+) p.birth_time").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "gender", "date_of_birth", "birth_time", "birth_timezone", "birth_latitude", "birth_longitude"}))
+
+	_, err := service.matchHoroscopes(context.Background(), 1,
+		&repository.PartnerPreferences{HoroscopeRequired: true}, []nearbyCandidate{{UserID: 2}})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/matchrules"
+	"github.com/datifyy/backend/internal/repository"
+)
+
+// loadRuleProfiles builds the profiles partner-preference rules are checked
+// against, keyed by user ID. Users without a profile are left out.
+func loadRuleProfiles(
+	ctx context.Context,
+	userRepo *repository.UserRepository,
+	profileRepo *repository.UserProfileRepository,
+	userIDs []int,
+) (map[int]*userpb.UserProfile, error) {
+	users, err := userRepo.GetByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load users: %w", err)
+	}
+	profiles, err := profileRepo.GetProfilesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load profiles: %w", err)
+	}
+
+	ruleProfiles := make(map[int]*userpb.UserProfile, len(users))
+	for id, user := range users {
+		profile, ok := profiles[id]
+		if !ok {
+			continue
+		}
+		pbProfile, err := buildUserProfileFromDB(user, profile, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		ruleProfiles[id] = pbProfile
+	}
+	return ruleProfiles, nil
+}
+
+// compileMatchRules compiles a user's partner preferences. Relative rules
+// (same religion, age gap, diet) are resolved against their own profile.
+func compileMatchRules(
+	ctx context.Context,
+	userRepo *repository.UserRepository,
+	profileRepo *repository.UserProfileRepository,
+	userID int,
+	prefs *repository.PartnerPreferences,
+) (matchrules.RuleSet, error) {
+	if prefs == nil {
+		return matchrules.RuleSet{}, nil
+	}
+	pbPrefs := buildPartnerPreferencesFromDB(prefs)
+	if matchrules.Compile(pbPrefs, nil).Len() == 0 {
+		// Nothing to check, so skip loading the owner's profile
+		return matchrules.RuleSet{}, nil
+	}
+
+	owners, err := loadRuleProfiles(ctx, userRepo, profileRepo, []int{userID})
+	if err != nil {
+		return matchrules.RuleSet{}, err
+	}
+	return matchrules.Compile(pbPrefs, owners[userID]), nil
+}
+
+// ruleResultsToProto converts rule results for API responses
+func ruleResultsToProto(results []matchrules.Result) []*userpb.PreferenceRuleResult {
+	pbResults := make([]*userpb.PreferenceRuleResult, len(results))
+	for i, r := range results {
+		pbResults[i] = &userpb.PreferenceRuleResult{
+			Rule:        r.Rule,
+			Hard:        r.Hard,
+			Passed:      r.Passed,
+			Known:       r.Known,
+			Explanation: r.Explanation,
+			Weight:      int32(r.Weight),
+		}
+	}
+	return pbResults
+}
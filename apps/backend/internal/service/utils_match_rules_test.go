@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ruleUserRows returns user rows born in 1994 for the given IDs
+func ruleUserRows(ids ...int) *sqlmock.Rows {
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{
+		"id", "email", "name", "password_hash", "phone_number",
+		"email_verified", "phone_verified", "account_status",
+		"verification_token", "verification_token_expires_at",
+		"password_reset_token", "password_reset_token_expires_at",
+		"last_login_at", "photo_url", "date_of_birth", "gender",
+		"created_at", "updated_at",
+	})
+	for _, id := range ids {
+		rows.AddRow(id, "user@example.com", "User", "hash", nil,
+			true, false, "ACTIVE", nil, nil, nil, nil,
+			nil, nil, time.Date(1994, 1, 1, 0, 0, 0, 0, time.UTC), "FEMALE", now, now)
+	}
+	return rows
+}
+
+// ruleProfileRows returns profile rows with the given smoking habit per user ID
+func ruleProfileRows(smoking map[int]string) *sqlmock.Rows {
+	rows := sqlmock.NewRows([]string{
+		"id", "user_id", "bio", "occupation", "company", "job_title", "education",
+		"school", "height", "location", "hometown", "interests", "languages",
+		"relationship_goals", "drinking", "smoking", "workout", "dietary_preference",
+		"religion", "religion_importance", "political_view", "pets", "children",
+		"personality_type", "communication_style", "love_language", "sleep_schedule",
+		"prompts", "completion_percentage", "is_public", "is_verified", "work_email_verified", "work_email_domain",
+		"latitude", "longitude", "geohash",
+		"birth_time", "birth_place", "birth_latitude", "birth_longitude", "birth_timezone",
+	})
+	for id, habit := range smoking {
+		rows.AddRow(
+			id, id, "Bio", []byte("[]"), nil, nil, []byte("[]"),
+			nil, nil, []byte("{}"), nil, []byte("[]"), []byte("[]"),
+			[]byte("[]"), "NEVER", habit, "DAILY", nil,
+			nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			[]byte("[]"), 75, true, false, false, nil,
+			nil, nil, nil,
+			nil, nil, nil, nil, nil,
+		)
+	}
+	return rows
+}
+
+func TestApplyMatchRules_DealBreakerExcludes(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	// Candidates 2 (smokes) and 3 (doesn't), then the viewer's own profile
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(ruleUserRows(2, 3))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(ruleProfileRows(map[int]string{2: "REGULARLY", 3: "NEVER"}))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_users WHERE id = ANY").
+		WillReturnRows(ruleUserRows(1))
+	mock.ExpectQuery("SELECT (.+) FROM datifyy_v2_user_profiles WHERE user_id = ANY").
+		WillReturnRows(ruleProfileRows(map[int]string{1: "NEVER"}))
+
+	profiles, err := loadRuleProfiles(context.Background(), service.userRepo, service.profileRepo, []int{2, 3})
+	require.NoError(t, err)
+
+	prefs := &repository.PartnerPreferences{
+		DealBreakers:        repository.PreferenceRules{{Type: 1}},
+		MustHaves:           repository.PreferenceRules{{Type: 5, Priority: 3}},
+		DrinkingPreferences: repository.EnumList{3},
+	}
+	matched, err := service.applyMatchRules(context.Background(), 1, prefs,
+		[]nearbyCandidate{{UserID: 2}, {UserID: 3}}, profiles)
+
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+	assert.Equal(t, 3, matched[0].UserID)
+	// Physically fit (3 of 3), but never drinks (0 of 1)
+	assert.Equal(t, int32(75), matched[0].PreferenceScore)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyMatchRules_NoPreferences(t *testing.T) {
+	service, mock, db := setupTestUserService(t)
+	defer db.Close()
+
+	nearby := []nearbyCandidate{{UserID: 2}}
+	matched, err := service.applyMatchRules(context.Background(), 1, &repository.PartnerPreferences{}, nearby, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, nearby, matched)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
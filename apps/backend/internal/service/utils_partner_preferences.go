@@ -0,0 +1,271 @@
+package service
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// preferenceMapping is a partner preference column resolved to its proto field
+type preferenceMapping struct {
+	repository.PreferenceField
+	// path holds the nested message fields leading to the field, then the field
+	path []protoreflect.FieldDescriptor
+	kind reflect.Type // the repository struct field's type
+}
+
+func (m preferenceMapping) field() protoreflect.FieldDescriptor {
+	return m.path[len(m.path)-1]
+}
+
+// mutableParent returns the message holding the field, creating nested
+// messages as needed
+func (m preferenceMapping) mutableParent(msg protoreflect.Message) protoreflect.Message {
+	for _, fd := range m.path[:len(m.path)-1] {
+		msg = msg.Mutable(fd).Message()
+	}
+	return msg
+}
+
+// parent returns the message holding the field, or false when a nested
+// message on the way isn't set
+func (m preferenceMapping) parent(msg protoreflect.Message) (protoreflect.Message, bool) {
+	for _, fd := range m.path[:len(m.path)-1] {
+		if !msg.Has(fd) {
+			return nil, false
+		}
+		msg = msg.Get(fd).Message()
+	}
+	return msg, true
+}
+
+var partnerPreferenceMappings = resolvePartnerPreferenceMappings()
+
+// resolvePartnerPreferenceMappings resolves the repository's pref tags
+// against the proto. A tag naming an unknown field is a programming error.
+func resolvePartnerPreferenceMappings() []preferenceMapping {
+	structType := reflect.TypeOf(repository.PartnerPreferences{})
+
+	var mappings []preferenceMapping
+	for _, f := range repository.PartnerPreferenceFields() {
+		md := (&userpb.PartnerPreferences{}).ProtoReflect().Descriptor()
+		var path []protoreflect.FieldDescriptor
+		for _, name := range strings.Split(f.ProtoPath, ".") {
+			var fd protoreflect.FieldDescriptor
+			if md != nil {
+				fd = md.Fields().ByName(protoreflect.Name(name))
+			}
+			if fd == nil {
+				panic(fmt.Sprintf("partner preference column %s maps to unknown proto field %s", f.Column, f.ProtoPath))
+			}
+			path = append(path, fd)
+			md = fd.Message()
+		}
+		mappings = append(mappings, preferenceMapping{
+			PreferenceField: f,
+			path:            path,
+			kind:            structType.Field(f.Index).Type,
+		})
+	}
+	return mappings
+}
+
+// partnerPreferencesToProto maps every stored preference onto the proto
+func partnerPreferencesToProto(prefs *repository.PartnerPreferences) *userpb.PartnerPreferences {
+	pb := &userpb.PartnerPreferences{}
+	if prefs == nil {
+		return pb
+	}
+
+	msg := pb.ProtoReflect()
+	row := reflect.ValueOf(prefs).Elem()
+	for _, m := range partnerPreferenceMappings {
+		fd := m.field()
+		switch v := row.Field(m.Index).Interface().(type) {
+		case int:
+			if fd.Kind() == protoreflect.EnumKind {
+				m.mutableParent(msg).Set(fd, protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)))
+			} else {
+				m.mutableParent(msg).Set(fd, protoreflect.ValueOfInt32(int32(v)))
+			}
+		case bool:
+			m.mutableParent(msg).Set(fd, protoreflect.ValueOfBool(v))
+		case sql.NullInt32:
+			if v.Valid {
+				m.mutableParent(msg).Set(fd, protoreflect.ValueOfInt32(v.Int32))
+			}
+		case repository.EnumList:
+			if len(v) > 0 {
+				list := m.mutableParent(msg).Mutable(fd).List()
+				for _, n := range v {
+					list.Append(protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)))
+				}
+			}
+		case repository.StringList:
+			if len(v) > 0 {
+				list := m.mutableParent(msg).Mutable(fd).List()
+				for _, s := range v {
+					list.Append(protoreflect.ValueOfString(s))
+				}
+			}
+		case repository.PreferenceRules:
+			if len(v) > 0 {
+				list := m.mutableParent(msg).Mutable(fd).List()
+				for _, r := range v {
+					el := list.NewElement()
+					setPreferenceRule(el.Message(), r)
+					list.Append(el)
+				}
+			}
+		default:
+			panic(fmt.Sprintf("partner preference column %s has unsupported type %T", m.Column, v))
+		}
+	}
+	return pb
+}
+
+// partnerPreferenceUpdates converts preferences into column updates,
+// validating enum values and dropping unspecified, blank and duplicate list
+// entries. Empty lists, unset nested messages and zero omitempty fields are
+// left alone so clients can send partial preferences.
+func partnerPreferenceUpdates(pb *userpb.PartnerPreferences) (map[string]interface{}, error) {
+	updates := make(map[string]interface{})
+	msg := pb.ProtoReflect()
+	for _, m := range partnerPreferenceMappings {
+		parent, ok := m.parent(msg)
+		if !ok {
+			continue
+		}
+		fd := m.field()
+		if m.OmitEmpty && !parent.Has(fd) {
+			continue
+		}
+		value := parent.Get(fd)
+
+		switch reflect.Zero(m.kind).Interface().(type) {
+		case int, sql.NullInt32:
+			if fd.Kind() == protoreflect.EnumKind {
+				if err := validateEnumValue(fd, value.Enum()); err != nil {
+					return nil, err
+				}
+				updates[m.Column] = int32(value.Enum())
+			} else {
+				updates[m.Column] = int32(value.Int())
+			}
+		case bool:
+			updates[m.Column] = value.Bool()
+		case repository.EnumList:
+			list, err := normalizeEnumList(fd, value.List())
+			if err != nil {
+				return nil, err
+			}
+			if len(list) > 0 {
+				updates[m.Column] = list
+			}
+		case repository.StringList:
+			if list := normalizeStringList(value.List()); len(list) > 0 {
+				updates[m.Column] = list
+			}
+		case repository.PreferenceRules:
+			rules, err := normalizePreferenceRules(fd, value.List())
+			if err != nil {
+				return nil, err
+			}
+			if len(rules) > 0 {
+				updates[m.Column] = rules
+			}
+		default:
+			panic(fmt.Sprintf("partner preference column %s has unsupported type %s", m.Column, m.kind))
+		}
+	}
+	return updates, nil
+}
+
+func validateEnumValue(fd protoreflect.FieldDescriptor, n protoreflect.EnumNumber) error {
+	if fd.Enum().Values().ByNumber(n) == nil {
+		return fmt.Errorf("invalid %s value %d", fd.Name(), n)
+	}
+	return nil
+}
+
+func normalizeEnumList(fd protoreflect.FieldDescriptor, values protoreflect.List) (repository.EnumList, error) {
+	var list repository.EnumList
+	seen := make(map[protoreflect.EnumNumber]bool)
+	for i := 0; i < values.Len(); i++ {
+		n := values.Get(i).Enum()
+		if err := validateEnumValue(fd, n); err != nil {
+			return nil, err
+		}
+		if n == 0 || seen[n] {
+			continue
+		}
+		seen[n] = true
+		list = append(list, int32(n))
+	}
+	return list, nil
+}
+
+func normalizeStringList(values protoreflect.List) repository.StringList {
+	var list repository.StringList
+	seen := make(map[string]bool)
+	for i := 0; i < values.Len(); i++ {
+		s := strings.TrimSpace(values.Get(i).String())
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		list = append(list, s)
+	}
+	return list
+}
+
+// normalizePreferenceRules converts deal-breakers or must-haves, dropping
+// entries without a type
+func normalizePreferenceRules(fd protoreflect.FieldDescriptor, values protoreflect.List) (repository.PreferenceRules, error) {
+	var rules repository.PreferenceRules
+	for i := 0; i < values.Len(); i++ {
+		el := values.Get(i).Message()
+		fields := el.Descriptor().Fields()
+		typeField := fields.ByName("type")
+
+		n := el.Get(typeField).Enum()
+		if err := validateEnumValue(typeField, n); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", fd.Name(), err)
+		}
+		if n == 0 {
+			continue
+		}
+		rules = append(rules, repository.PreferenceRule{
+			Type:        int32(n),
+			Description: strings.TrimSpace(el.Get(fields.ByName("description")).String()),
+			Priority:    int32(el.Get(fields.ByName("priority")).Int()),
+		})
+	}
+	return rules, nil
+}
+
+// setPreferenceRule fills a DealBreaker or MustHave message
+func setPreferenceRule(msg protoreflect.Message, r repository.PreferenceRule) {
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("type"), protoreflect.ValueOfEnum(protoreflect.EnumNumber(r.Type)))
+	if r.Description != "" {
+		msg.Set(fields.ByName("description"), protoreflect.ValueOfString(r.Description))
+	}
+	if r.Priority != 0 {
+		msg.Set(fields.ByName("priority"), protoreflect.ValueOfInt32(r.Priority))
+	}
+}
+
+// enumNames returns the names of enum values, e.g. for AI prompts
+func enumNames[E fmt.Stringer](values []E) []string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = v.String()
+	}
+	return names
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"database/sql"
+	"testing"
+
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartnerPreferenceMappings_CoverEveryProtoField(t *testing.T) {
+	mapped := make(map[string]bool)
+	for _, m := range partnerPreferenceMappings {
+		mapped[string(m.path[0].Name())] = true
+	}
+
+	fields := (&userpb.PartnerPreferences{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		assert.True(t, mapped[string(fields.Get(i).Name())], "%s has no column", fields.Get(i).Name())
+	}
+}
+
+func TestPartnerPreferencesToProto(t *testing.T) {
+	pb := partnerPreferencesToProto(&repository.PartnerPreferences{
+		LookingForGender:   repository.EnumList{2},
+		AgeRangeMin:        25,
+		AgeRangeMax:        32,
+		HeightRangeMin:     sql.NullInt32{Int32: 160, Valid: true},
+		ReligionImportance: 3,
+		PersonalityTypes:   repository.StringList{"INTJ"},
+		HoroscopeRequired:  true,
+		DealBreakers:       repository.PreferenceRules{{Type: 1, Priority: 5}},
+	})
+
+	assert.Equal(t, []userpb.Gender{userpb.Gender_GENDER_FEMALE}, pb.LookingForGender)
+	assert.Equal(t, int32(25), pb.AgeRange.MinAge)
+	assert.Equal(t, int32(32), pb.AgeRange.MaxAge)
+	assert.Equal(t, int32(160), pb.HeightRange.MinHeight)
+	assert.Equal(t, userpb.Importance(3), pb.ReligionImportance)
+	assert.Equal(t, []string{"INTJ"}, pb.PersonalityTypes)
+	assert.True(t, pb.HoroscopeMatchingRequired)
+	require.Len(t, pb.DealBreakers, 1)
+	assert.Equal(t, userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING, pb.DealBreakers[0].Type)
+	assert.Equal(t, int32(5), pb.DealBreakers[0].Priority)
+	assert.Empty(t, pb.MustHaves)
+}
+
+func TestPartnerPreferenceUpdates_Normalizes(t *testing.T) {
+	updates, err := partnerPreferenceUpdates(&userpb.PartnerPreferences{
+		LookingForGender: []userpb.Gender{
+			userpb.Gender_GENDER_FEMALE, userpb.Gender_GENDER_UNSPECIFIED, userpb.Gender_GENDER_FEMALE,
+		},
+		CastePreferences: []string{" Iyer ", "", "Iyer"},
+		DealBreakers: []*userpb.DealBreaker{
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_SMOKING, Description: " no smokers "},
+			{Type: userpb.DealBreakerType_DEALBREAKER_TYPE_UNSPECIFIED},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.EnumList{2}, updates["looking_for_gender"])
+	assert.Equal(t, repository.StringList{"Iyer"}, updates["caste_preferences"])
+	assert.Equal(t, repository.PreferenceRules{{Type: 1, Description: "no smokers"}}, updates["deal_breakers"])
+
+	// Partial updates leave unset lists, messages and omitempty fields alone
+	assert.NotContains(t, updates, "religions")
+	assert.NotContains(t, updates, "age_range_min")
+	assert.NotContains(t, updates, "distance_preference")
+	assert.Equal(t, int32(0), updates["religion_importance"])
+}
+
+func TestPartnerPreferenceUpdates_RejectsUnknownEnumValues(t *testing.T) {
+	_, err := partnerPreferenceUpdates(&userpb.PartnerPreferences{
+		DrinkingPreferences: []userpb.DrinkingHabit{99},
+	})
+	assert.EqualError(t, err, "invalid drinking_preferences value 99")
+
+	_, err = partnerPreferenceUpdates(&userpb.PartnerPreferences{
+		MustHaves: []*userpb.MustHave{{Type: 99}},
+	})
+	assert.EqualError(t, err, "invalid must_haves: invalid type value 99")
+}
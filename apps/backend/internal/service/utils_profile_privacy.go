@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+)
+
+// ViewerRelationship describes how the viewer of a profile relates to its owner
+type ViewerRelationship int
+
+const (
+	// ViewerStranger has no relationship with the profile owner (also used for anonymous viewers)
+	ViewerStranger ViewerRelationship = iota
+	// ViewerMatched has a date or mutually accepted suggestion with the profile owner
+	ViewerMatched
+	// ViewerSelf is the profile owner
+	ViewerSelf
+)
+
+// ProfileVisibility is the set of profile fields a viewer is allowed to see
+type ProfileVisibility struct {
+	ContactInfo  bool // email and phone number
+	BirthDate    bool // exact date of birth
+	BirthDetails bool // birth time, place and timezone (owner only)
+	Age          bool
+	Coordinates  bool // precise coordinates (owner only)
+	Distance     bool // approximate distance from the viewer
+	OnlineStatus bool // online flag, last seen and last login
+	FullProfile  bool // bio, work, lifestyle, prompts and all photos
+	Preferences  bool // app settings (notifications, privacy, discovery)
+}
+
+// profileVisibility derives what a viewer may see from the owner's privacy settings.
+// Nil preferences are treated as the defaults (everything shared except contact info).
+// Admins and genies read profiles through the admin API, which isn't redacted.
+func profileVisibility(prefs *repository.UserPreferences, rel ViewerRelationship) ProfileVisibility {
+	if rel == ViewerSelf {
+		return ProfileVisibility{
+			ContactInfo:  true,
+			BirthDate:    true,
+			BirthDetails: true,
+			Age:          true,
+			Coordinates:  true,
+			Distance:     true,
+			OnlineStatus: true,
+			FullProfile:  true,
+			Preferences:  true,
+		}
+	}
+
+	if prefs == nil {
+		prefs = &repository.UserPreferences{
+			PublicProfile:    true,
+			ShowOnlineStatus: true,
+			ShowDistance:     true,
+			ShowAge:          true,
+		}
+	}
+
+	visibility := ProfileVisibility{
+		Age:          prefs.ShowAge,
+		Distance:     prefs.ShowDistance,
+		OnlineStatus: prefs.ShowOnlineStatus && !prefs.IncognitoMode,
+		FullProfile:  true,
+	}
+
+	// Strangers only see a limited card of private or incognito profiles
+	if rel == ViewerStranger && (!prefs.PublicProfile || prefs.IncognitoMode) {
+		visibility.FullProfile = false
+		visibility.Distance = false
+	}
+
+	return visibility
+}
+
+// resolveViewerRelationship determines how viewerID relates to ownerID.
+// A zero viewerID is an anonymous viewer and is treated as a stranger.
+func resolveViewerRelationship(
+	ctx context.Context,
+	relationshipRepo *repository.UserRelationshipRepository,
+	viewerID, ownerID int,
+) (ViewerRelationship, error) {
+	if viewerID == 0 {
+		return ViewerStranger, nil
+	}
+	if viewerID == ownerID {
+		return ViewerSelf, nil
+	}
+
+	matched, err := relationshipRepo.AreMatched(ctx, viewerID, ownerID)
+	if err != nil {
+		return ViewerStranger, err
+	}
+	if matched {
+		return ViewerMatched, nil
+	}
+
+	return ViewerStranger, nil
+}
+
+// applyProfileVisibility redacts a built profile in place
+func applyProfileVisibility(profile *userpb.UserProfile, v ProfileVisibility) {
+	if basic := profile.BasicInfo; basic != nil {
+		if !v.ContactInfo {
+			basic.Email = ""
+			basic.PhoneNumber = ""
+		}
+		if !v.BirthDate {
+			basic.DateOfBirth = nil
+		}
+		if !v.Age {
+			basic.Age = 0
+		}
+	}
+
+	if !v.OnlineStatus {
+		profile.IsOnline = false
+		profile.LastSeenAt = nil
+		if profile.Metadata != nil {
+			profile.Metadata.LastLoginAt = nil
+		}
+	}
+
+	if !v.Distance {
+		profile.DistanceKm = 0
+	}
+
+	// Nakshatra, raasi and manglik status stay visible for matching
+	if !v.BirthDetails && profile.CulturalInfo != nil {
+		profile.CulturalInfo.BirthTime = ""
+		profile.CulturalInfo.BirthPlace = ""
+		profile.CulturalInfo.BirthTimezone = ""
+	}
+
+	if !v.Coordinates && profile.ProfileDetails != nil && profile.ProfileDetails.Location != nil {
+		profile.ProfileDetails.Location = &commonpb.Location{
+			CountryCode: profile.ProfileDetails.Location.CountryCode,
+			Country:     profile.ProfileDetails.Location.Country,
+			City:        profile.ProfileDetails.Location.City,
+		}
+	}
+
+	if !v.Preferences {
+		profile.UserPreferences = nil
+	}
+
+	if !v.FullProfile {
+		// Limited card: name, gender, age (if shown) and primary photo
+		profile.ProfileDetails = nil
+		profile.LifestyleInfo = nil
+		profile.Prompts = nil
+		profile.CulturalInfo = nil
+		profile.AppearanceInfo = nil
+		profile.ProfessionalInfo = nil
+		profile.FamilyInfo = nil
+		profile.PartnerPreferences = nil
+		profile.CompletionPercentage = 0
+
+		var primary []*userpb.ProfilePhoto
+		for _, photo := range profile.Photos {
+			if photo.IsPrimary {
+				primary = append(primary, photo)
+				break
+			}
+		}
+		profile.Photos = primary
+	}
+}
+
+// applySummaryVisibility redacts a Love Zone user summary in place
+func applySummaryVisibility(summary *UserSummary, v ProfileVisibility) {
+	if !v.Age {
+		summary.Age = 0
+	}
+
+	if !v.FullProfile {
+		summary.Bio = ""
+		summary.Occupation = ""
+		summary.Location = ""
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+
+	commonpb "github.com/datifyy/backend/gen/common/v1"
+	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPrivacyTestProfile() *userpb.UserProfile {
+	return &userpb.UserProfile{
+		UserId: "2",
+		BasicInfo: &userpb.BasicInfo{
+			Name:        "Asha",
+			Email:       "asha@example.com",
+			PhoneNumber: "+919999999999",
+			DateOfBirth: &commonpb.Timestamp{Seconds: 631152000},
+			Age:         30,
+		},
+		ProfileDetails: &userpb.ProfileDetails{
+			Bio: "Bio",
+			Location: &commonpb.Location{
+				City:      "Pune",
+				Country:   "India",
+				Latitude:  18.52,
+				Longitude: 73.85,
+			},
+		},
+		LifestyleInfo: &userpb.LifestyleInfo{PersonalityType: "INTJ"},
+		Photos: []*userpb.ProfilePhoto{
+			{PhotoId: "a"},
+			{PhotoId: "b", IsPrimary: true},
+		},
+		Metadata:           &userpb.AccountMetadata{LastLoginAt: &commonpb.Timestamp{Seconds: 1}},
+		IsOnline:           true,
+		DistanceKm:         5,
+		LastSeenAt:         &commonpb.Timestamp{Seconds: 1},
+		PartnerPreferences: &userpb.PartnerPreferences{},
+		UserPreferences:    &userpb.UserPreferences{},
+	}
+}
+
+func TestApplyProfileVisibility_SelfSeesEverything(t *testing.T) {
+	profile := newPrivacyTestProfile()
+
+	applyProfileVisibility(profile, profileVisibility(&repository.UserPreferences{}, ViewerSelf))
+
+	assert.Equal(t, "asha@example.com", profile.BasicInfo.Email)
+	assert.Equal(t, int32(30), profile.BasicInfo.Age)
+	assert.True(t, profile.IsOnline)
+	assert.NotNil(t, profile.UserPreferences)
+	assert.Len(t, profile.Photos, 2)
+	assert.Equal(t, 18.52, profile.ProfileDetails.Location.Latitude)
+}
+
+func TestApplyProfileVisibility_StrangerNeverSeesContactInfo(t *testing.T) {
+	profile := newPrivacyTestProfile()
+
+	applyProfileVisibility(profile, profileVisibility(nil, ViewerStranger))
+
+	assert.Empty(t, profile.BasicInfo.Email)
+	assert.Empty(t, profile.BasicInfo.PhoneNumber)
+	assert.Nil(t, profile.BasicInfo.DateOfBirth)
+	assert.Nil(t, profile.UserPreferences)
+	assert.NotNil(t, profile.PartnerPreferences)
+
+	// Defaults still share age, online status and the full profile
+	assert.Equal(t, int32(30), profile.BasicInfo.Age)
+	assert.True(t, profile.IsOnline)
+	assert.Equal(t, "Bio", profile.ProfileDetails.Bio)
+	assert.Equal(t, int32(5), profile.DistanceKm)
+
+	// Other users only ever see the rounded distance, never coordinates
+	assert.Equal(t, "Pune", profile.ProfileDetails.Location.City)
+	assert.Zero(t, profile.ProfileDetails.Location.Latitude)
+	assert.Zero(t, profile.ProfileDetails.Location.Longitude)
+}
+
+func TestApplyProfileVisibility_HonorsPrivacySettings(t *testing.T) {
+	profile := newPrivacyTestProfile()
+	prefs := &repository.UserPreferences{
+		PublicProfile:    true,
+		ShowOnlineStatus: false,
+		ShowDistance:     false,
+		ShowAge:          false,
+	}
+
+	applyProfileVisibility(profile, profileVisibility(prefs, ViewerMatched))
+
+	assert.Zero(t, profile.BasicInfo.Age)
+	assert.False(t, profile.IsOnline)
+	assert.Nil(t, profile.LastSeenAt)
+	assert.Nil(t, profile.Metadata.LastLoginAt)
+	assert.Zero(t, profile.DistanceKm)
+	require.NotNil(t, profile.ProfileDetails.Location)
+	assert.Equal(t, "Pune", profile.ProfileDetails.Location.City)
+	assert.Zero(t, profile.ProfileDetails.Location.Latitude)
+	assert.Zero(t, profile.ProfileDetails.Location.Longitude)
+}
+
+func TestApplyProfileVisibility_PrivateProfileLimitedForStrangers(t *testing.T) {
+	prefs := &repository.UserPreferences{
+		PublicProfile:    false,
+		ShowOnlineStatus: true,
+		ShowDistance:     true,
+		ShowAge:          true,
+	}
+
+	// Stranger gets a limited card
+	profile := newPrivacyTestProfile()
+	applyProfileVisibility(profile, profileVisibility(prefs, ViewerStranger))
+
+	assert.Equal(t, "Asha", profile.BasicInfo.Name)
+	assert.Nil(t, profile.ProfileDetails)
+	assert.Nil(t, profile.LifestyleInfo)
+	assert.Nil(t, profile.PartnerPreferences)
+	require.Len(t, profile.Photos, 1)
+	assert.Equal(t, "b", profile.Photos[0].PhotoId)
+
+	// Matched user still sees the full profile
+	profile = newPrivacyTestProfile()
+	applyProfileVisibility(profile, profileVisibility(prefs, ViewerMatched))
+
+	assert.Equal(t, "Bio", profile.ProfileDetails.Bio)
+	assert.Len(t, profile.Photos, 2)
+}
+
+func TestApplyProfileVisibility_IncognitoHidesOnlineStatus(t *testing.T) {
+	prefs := &repository.UserPreferences{
+		PublicProfile:    true,
+		ShowOnlineStatus: true,
+		ShowDistance:     true,
+		ShowAge:          true,
+		IncognitoMode:    true,
+	}
+
+	profile := newPrivacyTestProfile()
+	applyProfileVisibility(profile, profileVisibility(prefs, ViewerMatched))
+	assert.False(t, profile.IsOnline)
+	assert.NotNil(t, profile.ProfileDetails)
+
+	profile = newPrivacyTestProfile()
+	applyProfileVisibility(profile, profileVisibility(prefs, ViewerStranger))
+	assert.Nil(t, profile.ProfileDetails)
+}
+
+func TestApplySummaryVisibility(t *testing.T) {
+	summary := &UserSummary{ID: 2, Name: "Asha", Age: 30, Bio: "Bio", Occupation: "Engineer", Location: "Pune"}
+	prefs := &repository.UserPreferences{PublicProfile: false, ShowAge: false}
+
+	applySummaryVisibility(summary, profileVisibility(prefs, ViewerStranger))
+
+	assert.Equal(t, "Asha", summary.Name)
+	assert.Zero(t, summary.Age)
+	assert.Empty(t, summary.Bio)
+	assert.Empty(t, summary.Occupation)
+	assert.Empty(t, summary.Location)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/datifyy/backend/internal/repository"
+	"github.com/datifyy/backend/internal/timezone"
+)
+
+// userLocation returns the location of a user's timezone preference. Times
+// shown to the user fall back to UTC if it's unset or can't be read.
+func userLocation(ctx context.Context, profileRepo *repository.UserProfileRepository, userID int) *time.Location {
+	name, err := profileRepo.GetUserTimezone(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get timezone for user %d: %v", userID, err)
+		return time.UTC
+	}
+	return timezone.LoadOrDefault(name)
+}
+
+// formatLocal formats a Unix timestamp as a local wall-clock time in loc
+func formatLocal(unix int64, loc *time.Location) string {
+	return time.Unix(unix, 0).In(loc).Format(timezone.LocalLayout)
+}
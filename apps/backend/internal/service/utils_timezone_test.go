@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// expectUserTimezone expects a lookup of a user's timezone preference
+func expectUserTimezone(mock sqlmock.Sqlmock, userID int, timezone string) {
+	mock.ExpectQuery("SELECT timezone FROM user_preferences WHERE user_id = \\$1").
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow(timezone))
+}
+
+func TestGenerateCalendarInviteText_ParticipantTimezones(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	scheduled := time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC)
+
+	shared := generateCalendarInviteText(
+		inviteParticipant{Name: "Asha", Location: kolkata},
+		inviteParticipant{Name: "Ravi", Location: kolkata},
+		scheduled, 60, "https://meet.google.com/abc")
+	assert.Contains(t, shared, "• When: Saturday, March 14, 2026 at 6:30 PM IST\n")
+	assert.Contains(t, shared, "• End Time: 7:30 PM IST\n")
+
+	split := generateCalendarInviteText(
+		inviteParticipant{Name: "Asha", Location: kolkata},
+		inviteParticipant{Name: "Ravi", Location: newYork},
+		scheduled, 60, "https://meet.google.com/abc")
+	assert.Contains(t, split, "• When for Asha: Saturday, March 14, 2026 at 6:30 PM IST to 7:30 PM IST\n")
+	assert.Contains(t, split, "• When for Ravi: Saturday, March 14, 2026 at 9:00 AM EDT to 10:00 AM EDT\n")
+}
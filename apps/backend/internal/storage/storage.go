@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrObjectNotFound is returned when a key does not exist in the store
+var ErrObjectNotFound = errors.New("object not found")
+
+// BlobStore stores binary objects such as photos, ID documents and data exports
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalStore is a BlobStore backed by the local filesystem
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore creates a filesystem blob store rooted at dir
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{root: dir}
+}
+
+// Put writes data under key, creating parent directories as needed
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+
+	return nil
+}
+
+// Get reads the object stored under key
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+// Delete removes the object stored under key. Deleting a missing key is not an error.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// path resolves key inside the store root, rejecting keys that escape it
+func (s *LocalStore) path(key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("storage key is required")
+	}
+
+	if strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+
+	return filepath.Join(s.root, filepath.Clean("/"+key)), nil
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore_PutGetDelete(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+	ctx := context.Background()
+
+	err := store.Put(ctx, "exports/1/export.zip", []byte("zip-data"))
+	require.NoError(t, err)
+
+	data, err := store.Get(ctx, "exports/1/export.zip")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("zip-data"), data)
+
+	require.NoError(t, store.Delete(ctx, "exports/1/export.zip"))
+
+	_, err = store.Get(ctx, "exports/1/export.zip")
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocalStore_DeleteMissingKey(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+
+	assert.NoError(t, store.Delete(context.Background(), "does/not/exist"))
+}
+
+func TestLocalStore_RejectsInvalidKeys(t *testing.T) {
+	store := NewLocalStore(t.TempDir())
+	ctx := context.Background()
+
+	assert.Error(t, store.Put(ctx, "", []byte("x")))
+	assert.Error(t, store.Put(ctx, "../outside", []byte("x")))
+	_, err := store.Get(ctx, "a/../../b")
+	assert.Error(t, err)
+}
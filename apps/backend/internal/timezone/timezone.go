@@ -0,0 +1,110 @@
+// Package timezone loads users' IANA timezones and converts between local
+// wall-clock times and instants.
+//
+// Times are stored as instants (Unix seconds or UTC timestamps). Wall-clock
+// input is resolved against the user's zone explicitly, so times skipped by a
+// DST change are rejected and times repeated by one resolve to the earlier
+// instant, rather than whatever time.Date happens to normalize them to.
+package timezone
+
+import (
+	"errors"
+	"time"
+)
+
+// Default is the timezone used when a user hasn't chosen one
+const Default = "UTC"
+
+const (
+	// LocalLayout is the accepted format for local wall-clock times; seconds
+	// are optional
+	LocalLayout = "2006-01-02T15:04"
+
+	// DisplayLayout formats user-facing date-times
+	DisplayLayout = "Monday, January 2, 2006 at 3:04 PM MST"
+)
+
+var (
+	ErrUnknownTimezone      = errors.New("unknown timezone, expected an IANA name such as Asia/Kolkata")
+	ErrInvalidLocalTime     = errors.New("invalid local time, expected YYYY-MM-DDTHH:MM")
+	ErrNonexistentLocalTime = errors.New("local time does not exist in this timezone because of a daylight saving change")
+)
+
+// Load returns the location for an IANA timezone name. An empty name loads
+// Default; "Local" is rejected since it depends on the server.
+func Load(name string) (*time.Location, error) {
+	if name == "" {
+		name = Default
+	}
+	if name == "Local" {
+		return nil, ErrUnknownTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrUnknownTimezone
+	}
+	return loc, nil
+}
+
+// LoadOrDefault is Load, falling back to Default for unknown names
+func LoadOrDefault(name string) *time.Location {
+	loc, err := Load(name)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ParseLocal parses a wall-clock time in LocalLayout (optionally with
+// seconds) and resolves it to an instant in loc
+func ParseLocal(value string, loc *time.Location) (time.Time, error) {
+	wall, err := time.Parse(LocalLayout+":05", value)
+	if err != nil {
+		wall, err = time.Parse(LocalLayout, value)
+	}
+	if err != nil {
+		return time.Time{}, ErrInvalidLocalTime
+	}
+	return Resolve(wall, loc)
+}
+
+// Resolve returns the instant at which clocks in loc show wall's date and
+// time of day (wall's own location is ignored). Wall-clock times skipped by a
+// DST change return ErrNonexistentLocalTime; repeated ones resolve to the
+// earlier instant.
+func Resolve(wall time.Time, loc *time.Location) (time.Time, error) {
+	y, m, d := wall.Date()
+	hour, min, sec := wall.Clock()
+	asUTC := time.Date(y, m, d, hour, min, sec, wall.Nanosecond(), time.UTC)
+
+	// A wall-clock time can only be reached at one of the offsets in effect
+	// around it; a day either side covers any transition nearby
+	approx := time.Date(y, m, d, hour, min, sec, wall.Nanosecond(), loc)
+	_, before := approx.Add(-24 * time.Hour).Zone()
+	_, after := approx.Add(24 * time.Hour).Zone()
+
+	var resolved time.Time
+	found := false
+	for _, offset := range []int{before, after} {
+		t := asUTC.Add(-time.Duration(offset) * time.Second).In(loc)
+		if !sameWallClock(t, asUTC) {
+			continue
+		}
+		if !found || t.Before(resolved) {
+			resolved, found = t, true
+		}
+	}
+
+	if !found {
+		return time.Time{}, ErrNonexistentLocalTime
+	}
+	return resolved, nil
+}
+
+func sameWallClock(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	ah, amin, as := a.Clock()
+	bh, bmin, bs := b.Clock()
+	return ay == by && am == bm && ad == bd && ah == bh && amin == bmin && as == bs
+}
@@ -0,0 +1,81 @@
+package timezone
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := Load(name)
+	if err != nil {
+		t.Skipf("timezone data for %s unavailable", name)
+	}
+	return loc
+}
+
+func TestLoad(t *testing.T) {
+	if loc, err := Load(""); err != nil || loc != time.UTC {
+		t.Errorf("expected UTC for an empty name, got %v, %v", loc, err)
+	}
+	for _, name := range []string{"Local", "Mars/Olympus", "not a zone"} {
+		if _, err := Load(name); !errors.Is(err, ErrUnknownTimezone) {
+			t.Errorf("Load(%q): expected ErrUnknownTimezone, got %v", name, err)
+		}
+	}
+	if LoadOrDefault("Mars/Olympus") != time.UTC {
+		t.Error("expected LoadOrDefault to fall back to UTC")
+	}
+}
+
+func TestParseLocal(t *testing.T) {
+	kolkata := mustLoad(t, "Asia/Kolkata")
+
+	got, err := ParseLocal("2026-03-14T18:30", kolkata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 3, 14, 13, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got.UTC())
+	}
+
+	if _, err := ParseLocal("2026-03-14T18:30:15", kolkata); err != nil {
+		t.Errorf("expected seconds to be accepted, got %v", err)
+	}
+	for _, value := range []string{"", "2026-03-14", "18:30", "2026-03-14 18:30", "2026-03-14T18:30Z"} {
+		if _, err := ParseLocal(value, kolkata); !errors.Is(err, ErrInvalidLocalTime) {
+			t.Errorf("ParseLocal(%q): expected ErrInvalidLocalTime, got %v", value, err)
+		}
+	}
+}
+
+func TestResolve_DSTTransitions(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	// Clocks jump from 02:00 to 03:00 on 2026-03-08
+	if _, err := ParseLocal("2026-03-08T02:30", ny); !errors.Is(err, ErrNonexistentLocalTime) {
+		t.Errorf("expected ErrNonexistentLocalTime in the spring-forward gap, got %v", err)
+	}
+
+	// Clocks fall back from 02:00 to 01:00 on 2026-11-01; 01:30 happens twice
+	got, err := ParseLocal("2026-11-01T01:30", ny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("expected the earlier (EDT) instant %v, got %v", want, got.UTC())
+	}
+
+	// Either side of the transitions resolve normally
+	for value, want := range map[string]time.Time{
+		"2026-03-08T01:59": time.Date(2026, 3, 8, 6, 59, 0, 0, time.UTC),
+		"2026-03-08T03:00": time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC),
+		"2026-11-01T02:00": time.Date(2026, 11, 1, 7, 0, 0, 0, time.UTC),
+	} {
+		got, err := ParseLocal(value, ny)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseLocal(%q): expected %v, got %v, %v", value, want, got.UTC(), err)
+		}
+	}
+}
@@ -31,24 +31,41 @@ func AvailabilitySlotToJSON(slot *availabilitypb.AvailabilitySlot) map[string]in
 		}
 	}
 
+	if slot.RuleId != "" {
+		result["ruleId"] = slot.RuleId
+	}
+
+	if slot.LocalStartTime != "" {
+		result["localStartTime"] = slot.LocalStartTime
+		result["localEndTime"] = slot.LocalEndTime
+	}
+
+	if slot.Reserved {
+		result["reserved"] = true
+	}
+
 	if slot.OfflineLocation != nil {
-		result["offlineLocation"] = map[string]interface{}{
-			"placeName":     slot.OfflineLocation.PlaceName,
-			"address":       slot.OfflineLocation.Address,
-			"city":          slot.OfflineLocation.City,
-			"state":         slot.OfflineLocation.State,
-			"country":       slot.OfflineLocation.Country,
-			"zipcode":       slot.OfflineLocation.Zipcode,
-			"latitude":      slot.OfflineLocation.Latitude,
-			"longitude":     slot.OfflineLocation.Longitude,
-			"googlePlaceId": slot.OfflineLocation.GooglePlaceId,
-			"googleMapsUrl": slot.OfflineLocation.GoogleMapsUrl,
-		}
+		result["offlineLocation"] = offlineLocationToJSON(slot.OfflineLocation)
 	}
 
 	return result
 }
 
+func offlineLocationToJSON(location *availabilitypb.OfflineLocation) map[string]interface{} {
+	return map[string]interface{}{
+		"placeName":     location.PlaceName,
+		"address":       location.Address,
+		"city":          location.City,
+		"state":         location.State,
+		"country":       location.Country,
+		"zipcode":       location.Zipcode,
+		"latitude":      location.Latitude,
+		"longitude":     location.Longitude,
+		"googlePlaceId": location.GooglePlaceId,
+		"googleMapsUrl": location.GoogleMapsUrl,
+	}
+}
+
 // AvailabilitySlotsToJSON converts multiple slots to JSON
 func AvailabilitySlotsToJSON(slots []*availabilitypb.AvailabilitySlot) []map[string]interface{} {
 	if slots == nil {
@@ -61,3 +78,75 @@ func AvailabilitySlotsToJSON(slots []*availabilitypb.AvailabilitySlot) []map[str
 	}
 	return result
 }
+
+// AvailabilityRuleToJSON converts protobuf AvailabilityRule to JSON map
+func AvailabilityRuleToJSON(rule *availabilitypb.AvailabilityRule) map[string]interface{} {
+	if rule == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"ruleId":          rule.RuleId,
+		"userId":          rule.UserId,
+		"rrule":           rule.Rrule,
+		"startTime":       rule.StartTime,
+		"durationMinutes": rule.DurationMinutes,
+		"timezone":        rule.Timezone,
+		"exdates":         rule.Exdates,
+		"dateType":        rule.DateType.String(),
+		"notes":           rule.Notes,
+		"expandedUntil":   rule.ExpandedUntil,
+	}
+
+	if rule.CreatedAt != nil {
+		result["createdAt"] = map[string]int64{
+			"seconds": rule.CreatedAt.Seconds,
+		}
+	}
+
+	if rule.UpdatedAt != nil {
+		result["updatedAt"] = map[string]int64{
+			"seconds": rule.UpdatedAt.Seconds,
+		}
+	}
+
+	if rule.OfflineLocation != nil {
+		result["offlineLocation"] = offlineLocationToJSON(rule.OfflineLocation)
+	}
+
+	return result
+}
+
+// AvailabilityRulesToJSON converts multiple rules to JSON
+func AvailabilityRulesToJSON(rules []*availabilitypb.AvailabilityRule) []map[string]interface{} {
+	if rules == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = AvailabilityRuleToJSON(rule)
+	}
+	return result
+}
+
+// AvailabilityExclusionsToJSON converts availability exclusions to JSON
+func AvailabilityExclusionsToJSON(exclusions []*availabilitypb.AvailabilityExclusion) []map[string]interface{} {
+	if exclusions == nil {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(exclusions))
+	for i, exclusion := range exclusions {
+		result[i] = map[string]interface{}{
+			"exclusionId":    exclusion.ExclusionId,
+			"startTime":      exclusion.StartTime,
+			"endTime":        exclusion.EndTime,
+			"localStartTime": exclusion.LocalStartTime,
+			"localEndTime":   exclusion.LocalEndTime,
+			"source":         exclusion.Source,
+			"externalUid":    exclusion.ExternalUid,
+		}
+	}
+	return result
+}
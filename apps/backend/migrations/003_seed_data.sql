@@ -100,14 +100,15 @@ BEGIN
          '[1, 2]'::jsonb, 'REGULARLY', 'NEVER', 'SOMETIMES', 'ANYTHING', 'ATHEIST', 'WANT_SOMEDAY', 'OPEN_TO_CHILDREN', 92)
     ON CONFLICT (user_id) DO NOTHING;
 
-    -- Insert partner preferences
-    INSERT INTO datifyy_v2_partner_preferences (user_id, looking_for_gender, age_range_min, age_range_max, distance_preference, relationship_goals, verified_only)
+    -- Insert partner preferences. user_id_4 keeps the older name format in
+    -- religions and dietary_preferences, which 019 resolves to enum numbers
+    INSERT INTO datifyy_v2_partner_preferences (user_id, looking_for_gender, age_range_min, age_range_max, distance_preference, relationship_goals, religions, dietary_preferences, verified_only)
     VALUES
-        (user_id_1, '[2]'::jsonb, 24, 32, 50, '[1, 2]'::jsonb, false),
-        (user_id_2, '[1]'::jsonb, 26, 35, 40, '[1]'::jsonb, true),
-        (user_id_3, '[2]'::jsonb, 26, 34, 30, '[2]'::jsonb, false),
-        (user_id_4, '[1]'::jsonb, 27, 38, 25, '[1, 6]'::jsonb, false),
-        (user_id_5, '[2]'::jsonb, 28, 36, 100, '[1, 2]'::jsonb, false)
+        (user_id_1, '[2]'::jsonb, 24, 32, 50, '[1, 2]'::jsonb, '[]'::jsonb, '[]'::jsonb, false),
+        (user_id_2, '[1]'::jsonb, 26, 35, 40, '[1]'::jsonb, '[]'::jsonb, '[]'::jsonb, true),
+        (user_id_3, '[2]'::jsonb, 26, 34, 30, '[2]'::jsonb, '[]'::jsonb, '[]'::jsonb, false),
+        (user_id_4, '[1]'::jsonb, 27, 38, 25, '[1, 6]'::jsonb, '["HINDU", "RELIGION_JAIN"]'::jsonb, '["VEGETARIAN"]'::jsonb, false),
+        (user_id_5, '[2]'::jsonb, 28, 36, 100, '[1, 2]'::jsonb, '[]'::jsonb, '[]'::jsonb, false)
     ON CONFLICT (user_id) DO NOTHING;
 
     -- Insert sample photos for some users
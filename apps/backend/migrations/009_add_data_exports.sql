@@ -0,0 +1,44 @@
+-- Migration: 009_add_data_exports.sql
+-- Description: Add table for personal data export requests (GDPR-style data portability)
+
+-- =============================================================================
+-- Data Exports Table (one row per export request, doubles as the export log)
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS datifyy_v2_data_exports (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+
+    -- Status
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, processing, completed, failed, expired
+
+    -- Archive details (set once the background job has built the ZIP)
+    storage_key TEXT,
+    file_size BIGINT,
+    error_message TEXT,
+
+    -- Download link expiry and usage
+    expires_at TIMESTAMP,
+    downloaded_at TIMESTAMP,
+    download_count INTEGER NOT NULL DEFAULT 0,
+
+    -- Tracking
+    requested_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT valid_data_export_status CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'expired'))
+);
+
+-- Indexes for data exports
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_data_exports_user ON datifyy_v2_data_exports(user_id, requested_at DESC);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_data_exports_status ON datifyy_v2_data_exports(status);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_data_exports_expires ON datifyy_v2_data_exports(expires_at) WHERE status = 'completed';
+
+-- Trigger to update updated_at
+DROP TRIGGER IF EXISTS update_datifyy_v2_data_exports_updated_at ON datifyy_v2_data_exports;
+CREATE TRIGGER update_datifyy_v2_data_exports_updated_at
+    BEFORE UPDATE ON datifyy_v2_data_exports
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE datifyy_v2_data_exports IS 'Personal data export requests; rate-limited to one per user per day';
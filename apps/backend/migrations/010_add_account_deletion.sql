@@ -0,0 +1,25 @@
+-- Migration: 010_add_account_deletion.sql
+-- Description: Add 30-day pending-deletion state for accounts before they are purged
+
+-- =============================================================================
+-- Account Deletion Tracking
+-- =============================================================================
+ALTER TABLE datifyy_v2_users
+ADD COLUMN IF NOT EXISTS deletion_requested_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS deletion_scheduled_for TIMESTAMP,
+ADD COLUMN IF NOT EXISTS deletion_reason TEXT,
+ADD COLUMN IF NOT EXISTS purged_at TIMESTAMP;
+
+-- Allow the PENDING_DELETION account status
+ALTER TABLE datifyy_v2_users DROP CONSTRAINT IF EXISTS check_account_status;
+ALTER TABLE datifyy_v2_users
+ADD CONSTRAINT check_account_status
+CHECK (account_status IN ('PENDING', 'ACTIVE', 'SUSPENDED', 'BANNED', 'PENDING_DELETION', 'DELETED'));
+
+-- Index for the purge job
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_users_deletion_scheduled
+ON datifyy_v2_users(deletion_scheduled_for)
+WHERE account_status = 'PENDING_DELETION';
+
+COMMENT ON COLUMN datifyy_v2_users.deletion_scheduled_for IS 'When a PENDING_DELETION account will be purged; logging in before then cancels the deletion';
+COMMENT ON COLUMN datifyy_v2_users.purged_at IS 'When PII was scrubbed by the account purge job';
@@ -0,0 +1,56 @@
+-- Migration: 011_add_report_moderation.sql
+-- Description: Add moderation workflow for user reports (claiming, severity, enforcement actions)
+
+-- =============================================================================
+-- Report Review Tracking
+-- =============================================================================
+ALTER TABLE datifyy_v2_user_reports
+ADD COLUMN IF NOT EXISTS severity VARCHAR(20) NOT NULL DEFAULT 'LOW',
+ADD COLUMN IF NOT EXISTS claimed_by INTEGER REFERENCES datifyy_v2_admin_users(id),
+ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS escalated_at TIMESTAMP;
+
+-- Allow the IN_REVIEW status for claimed reports
+ALTER TABLE datifyy_v2_user_reports DROP CONSTRAINT IF EXISTS check_report_status;
+ALTER TABLE datifyy_v2_user_reports
+ADD CONSTRAINT check_report_status
+CHECK (status IN ('PENDING', 'IN_REVIEW', 'REVIEWED', 'RESOLVED', 'DISMISSED'));
+
+ALTER TABLE datifyy_v2_user_reports DROP CONSTRAINT IF EXISTS check_report_severity;
+ALTER TABLE datifyy_v2_user_reports
+ADD CONSTRAINT check_report_severity
+CHECK (severity IN ('LOW', 'MEDIUM', 'HIGH'));
+
+-- Reports are reviewed by moderators, not app users
+ALTER TABLE datifyy_v2_user_reports DROP CONSTRAINT IF EXISTS datifyy_v2_user_reports_reviewed_by_fkey;
+ALTER TABLE datifyy_v2_user_reports
+ADD CONSTRAINT datifyy_v2_user_reports_reviewed_by_fkey
+FOREIGN KEY (reviewed_by) REFERENCES datifyy_v2_admin_users(id);
+
+-- Index for the moderation queue
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_reports_queue
+ON datifyy_v2_user_reports(severity, created_at)
+WHERE status IN ('PENDING', 'IN_REVIEW');
+
+-- =============================================================================
+-- Enforcement Actions
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS datifyy_v2_moderation_actions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    report_id INTEGER REFERENCES datifyy_v2_user_reports(id) ON DELETE SET NULL,
+    admin_id INTEGER REFERENCES datifyy_v2_admin_users(id),
+    action VARCHAR(20) NOT NULL,
+    duration_days INTEGER,
+    expires_at TIMESTAMP,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_moderation_action CHECK (action IN ('WARN', 'SUSPEND', 'BAN'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_moderation_actions_user ON datifyy_v2_moderation_actions(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_moderation_actions_report ON datifyy_v2_moderation_actions(report_id);
+
+COMMENT ON COLUMN datifyy_v2_user_reports.escalated_at IS 'When the reported user crossed the report threshold and the report was escalated';
+COMMENT ON COLUMN datifyy_v2_moderation_actions.expires_at IS 'End of a SUSPEND action; NULL for warnings and bans';
@@ -0,0 +1,43 @@
+-- Migration: 012_add_suspension_appeals.sql
+-- Description: Timed suspensions that lift automatically, reinstatements and enforcement appeals
+
+-- =============================================================================
+-- Enforcement Lifecycle
+-- =============================================================================
+ALTER TABLE datifyy_v2_moderation_actions
+ADD COLUMN IF NOT EXISTS lifted_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS lifted_reason TEXT;
+
+-- REINSTATE records an admin restoring an account
+ALTER TABLE datifyy_v2_moderation_actions DROP CONSTRAINT IF EXISTS check_moderation_action;
+ALTER TABLE datifyy_v2_moderation_actions
+ADD CONSTRAINT check_moderation_action
+CHECK (action IN ('WARN', 'SUSPEND', 'BAN', 'REINSTATE'));
+
+-- Index for the suspension expiry job
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_moderation_actions_expiry
+ON datifyy_v2_moderation_actions(expires_at)
+WHERE action = 'SUSPEND' AND lifted_at IS NULL;
+
+-- =============================================================================
+-- Enforcement Appeals
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS datifyy_v2_enforcement_appeals (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    moderation_action_id INTEGER NOT NULL REFERENCES datifyy_v2_moderation_actions(id) ON DELETE CASCADE,
+    message TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    reviewed_by INTEGER REFERENCES datifyy_v2_admin_users(id),
+    reviewed_at TIMESTAMP,
+    review_notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_appeal_status CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED'))
+);
+
+-- One appeal per enforcement action
+CREATE UNIQUE INDEX IF NOT EXISTS idx_datifyy_v2_enforcement_appeals_action ON datifyy_v2_enforcement_appeals(moderation_action_id);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_enforcement_appeals_queue ON datifyy_v2_enforcement_appeals(status, created_at);
+
+COMMENT ON COLUMN datifyy_v2_moderation_actions.lifted_at IS 'When a SUSPEND or BAN stopped applying (expired, appeal approved or reinstated)';
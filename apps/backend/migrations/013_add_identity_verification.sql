@@ -0,0 +1,57 @@
+-- Migration: 013_add_identity_verification.sql
+-- Description: Work email and government ID verification with a moderator review queue
+
+-- =============================================================================
+-- Verification Badges
+-- =============================================================================
+-- is_verified is the government ID badge; work_email_verified is set once a
+-- code sent to a corporate address is confirmed
+ALTER TABLE datifyy_v2_user_profiles
+ADD COLUMN IF NOT EXISTS work_email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS work_email_domain VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_profiles_verified ON datifyy_v2_user_profiles(user_id) WHERE is_verified = TRUE;
+
+-- =============================================================================
+-- Work Email Verification
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS datifyy_v2_work_email_verifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    domain VARCHAR(255) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    verified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_work_email_verifications_user ON datifyy_v2_work_email_verifications(user_id, created_at DESC);
+
+-- =============================================================================
+-- Government ID Verification
+-- =============================================================================
+CREATE TABLE IF NOT EXISTS datifyy_v2_id_verifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    document_type VARCHAR(30) NOT NULL,
+    id_number_masked VARCHAR(50) NOT NULL,
+    front_image_key TEXT NOT NULL,
+    back_image_key TEXT,
+    selfie_image_key TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    reviewed_by INTEGER REFERENCES datifyy_v2_admin_users(id),
+    reviewed_at TIMESTAMP,
+    rejection_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_id_document_type CHECK (document_type IN ('AADHAAR', 'PASSPORT', 'DRIVING_LICENSE', 'VOTER_ID', 'PAN')),
+    CONSTRAINT check_id_verification_status CHECK (status IN ('PENDING', 'APPROVED', 'REJECTED'))
+);
+
+-- One submission awaiting review per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_datifyy_v2_id_verifications_pending ON datifyy_v2_id_verifications(user_id) WHERE status = 'PENDING';
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_id_verifications_queue ON datifyy_v2_id_verifications(status, created_at);
+
+COMMENT ON COLUMN datifyy_v2_id_verifications.id_number_masked IS 'Document number with all but the last 4 characters masked; the full number is never stored';
@@ -0,0 +1,39 @@
+-- Migration: 014_add_profile_geolocation.sql
+-- Description: Normalized profile coordinates for distance-based matching
+
+-- =============================================================================
+-- Profile Coordinates
+-- =============================================================================
+-- latitude/longitude are only ever shown to the profile owner; other users see
+-- distances computed from the coarse geohash cell and rounded to buckets
+ALTER TABLE datifyy_v2_user_profiles
+ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS geohash VARCHAR(5);
+
+ALTER TABLE datifyy_v2_user_profiles
+DROP CONSTRAINT IF EXISTS check_profile_coordinates;
+
+ALTER TABLE datifyy_v2_user_profiles
+ADD CONSTRAINT check_profile_coordinates CHECK (
+    (latitude IS NULL AND longitude IS NULL)
+    OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+);
+
+-- Bounding-box prefilter for nearby searches
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_profiles_coordinates ON datifyy_v2_user_profiles(latitude, longitude) WHERE latitude IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_profiles_geohash ON datifyy_v2_user_profiles(geohash);
+
+-- Backfill coordinates already present in the location JSON. Rows without a
+-- geohash fall back to deriving it from the coordinates until the location is saved again.
+UPDATE datifyy_v2_user_profiles
+SET latitude = (location->>'latitude')::DOUBLE PRECISION,
+    longitude = (location->>'longitude')::DOUBLE PRECISION
+WHERE latitude IS NULL
+  AND jsonb_typeof(location->'latitude') = 'number'
+  AND jsonb_typeof(location->'longitude') = 'number'
+  AND (location->>'latitude')::DOUBLE PRECISION BETWEEN -90 AND 90
+  AND (location->>'longitude')::DOUBLE PRECISION BETWEEN -180 AND 180
+  AND NOT ((location->>'latitude')::DOUBLE PRECISION = 0 AND (location->>'longitude')::DOUBLE PRECISION = 0);
+
+COMMENT ON COLUMN datifyy_v2_user_profiles.geohash IS 'Coarse (~5km) geohash cell of the coordinates, used for distances shown to other users';
@@ -0,0 +1,40 @@
+-- Migration: 015_add_profile_views.sql
+-- Description: Profile view tracking ("who viewed me") and a notification event outbox
+
+-- =============================================================================
+-- Profile Views
+-- =============================================================================
+-- One row per viewer per viewed profile per day; repeat views the same day are ignored
+CREATE TABLE IF NOT EXISTS datifyy_v2_profile_views (
+    id SERIAL PRIMARY KEY,
+    viewer_user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    viewed_user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    view_date DATE NOT NULL DEFAULT CURRENT_DATE,
+    viewed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_profile_view_per_day UNIQUE (viewer_user_id, viewed_user_id, view_date),
+    CONSTRAINT check_profile_view_not_self CHECK (viewer_user_id <> viewed_user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_profile_views_viewed ON datifyy_v2_profile_views(viewed_user_id, viewed_at DESC);
+
+-- =============================================================================
+-- Notification Events
+-- =============================================================================
+-- Outbox of in-app/push notifications; a dispatcher delivers undelivered rows
+-- and sets delivered_at
+CREATE TABLE IF NOT EXISTS datifyy_v2_notification_events (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL,
+    actor_user_id INTEGER REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_notification_events_user ON datifyy_v2_notification_events(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_notification_events_pending ON datifyy_v2_notification_events(created_at) WHERE delivered_at IS NULL;
+
+COMMENT ON TABLE datifyy_v2_profile_views IS 'Profile views, deduplicated per viewer per day. Incognito viewers are never recorded.';
+COMMENT ON TABLE datifyy_v2_notification_events IS 'Notification outbox. Events are only written when the recipient has the matching notification preference enabled.';
@@ -0,0 +1,43 @@
+-- Migration: 016_add_user_likes.sql
+-- Description: Likes, super likes and passes, with mutual likes feeding genie curation
+
+-- =============================================================================
+-- User Likes
+-- =============================================================================
+-- A user's latest decision about another user. Changing one's mind (e.g. pass
+-- then like) updates the row and its acted_at.
+CREATE TABLE IF NOT EXISTS datifyy_v2_user_likes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    liked_user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    acted_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_user_like UNIQUE (user_id, liked_user_id),
+    CONSTRAINT check_user_like_not_self CHECK (user_id <> liked_user_id),
+    CONSTRAINT check_user_like_action CHECK (action IN ('LIKE', 'SUPER_LIKE', 'PASS'))
+);
+
+-- Daily quota checks and the likes received inbox
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_likes_quota ON datifyy_v2_user_likes(user_id, action, acted_at);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_user_likes_received ON datifyy_v2_user_likes(liked_user_id, acted_at DESC) WHERE action IN ('LIKE', 'SUPER_LIKE');
+
+-- =============================================================================
+-- Curated Match Source
+-- =============================================================================
+-- ai_analysis matches come from admin-run compatibility analysis; mutual_like
+-- matches are created automatically when two users like each other
+ALTER TABLE datifyy_v2_curated_matches
+ADD COLUMN IF NOT EXISTS source VARCHAR(30) NOT NULL DEFAULT 'ai_analysis';
+
+DO $$ BEGIN
+    ALTER TABLE datifyy_v2_curated_matches
+    ADD CONSTRAINT check_curated_match_source CHECK (source IN ('ai_analysis', 'mutual_like'));
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_curated_matches_source ON datifyy_v2_curated_matches(source, status);
+
+COMMENT ON TABLE datifyy_v2_user_likes IS 'Likes, super likes and passes between users. Daily quotas are enforced by the application.';
@@ -0,0 +1,32 @@
+-- Migration: 017_add_profile_change_log.sql
+-- Description: Field-level history of profile and preference changes, revertible by super admins
+
+-- =============================================================================
+-- Profile Changes
+-- =============================================================================
+-- One row per changed field. Values are stored as JSON so any column type can
+-- be recorded and written back on revert. actor_id is a user ID for 'user'
+-- changes, an admin ID for 'admin' changes and NULL for 'system' changes.
+CREATE TABLE IF NOT EXISTS datifyy_v2_profile_changes (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    table_name VARCHAR(100) NOT NULL,
+    field_name VARCHAR(100) NOT NULL,
+    old_value JSONB,
+    new_value JSONB,
+    source VARCHAR(20) NOT NULL,
+    actor_id INTEGER,
+
+    -- Set on the change written by a revert, pointing at the change it undid
+    reverts_change_id INTEGER REFERENCES datifyy_v2_profile_changes(id) ON DELETE SET NULL,
+    reverted_at TIMESTAMP,
+    reverted_by INTEGER REFERENCES datifyy_v2_admin_users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_profile_change_source CHECK (source IN ('user', 'admin', 'system'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_profile_changes_user ON datifyy_v2_profile_changes(user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_profile_changes_field ON datifyy_v2_profile_changes(user_id, table_name, field_name, created_at DESC);
+
+COMMENT ON TABLE datifyy_v2_profile_changes IS 'Field-level change log for user profiles, partner preferences, app preferences and basic info.';
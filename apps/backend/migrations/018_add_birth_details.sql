@@ -0,0 +1,34 @@
+-- Migration: 018_add_birth_details.sql
+-- Description: Birth time and place for horoscope (Kundli) matching
+
+-- =============================================================================
+-- Birth Details
+-- =============================================================================
+-- Together with the date of birth these cast the birth chart used for Guna
+-- Milan. birth_time is the local clock time (HH:MM) in birth_timezone; the
+-- coordinates are geocoded from birth_place. Only the owner and admins see them.
+ALTER TABLE datifyy_v2_user_profiles
+ADD COLUMN IF NOT EXISTS birth_time VARCHAR(5),
+ADD COLUMN IF NOT EXISTS birth_place VARCHAR(255),
+ADD COLUMN IF NOT EXISTS birth_latitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS birth_longitude DOUBLE PRECISION,
+ADD COLUMN IF NOT EXISTS birth_timezone VARCHAR(64);
+
+ALTER TABLE datifyy_v2_user_profiles
+DROP CONSTRAINT IF EXISTS check_profile_birth_time;
+
+ALTER TABLE datifyy_v2_user_profiles
+ADD CONSTRAINT check_profile_birth_time CHECK (
+    birth_time IS NULL OR birth_time ~ '^([01][0-9]|2[0-3]):[0-5][0-9]$'
+);
+
+ALTER TABLE datifyy_v2_user_profiles
+DROP CONSTRAINT IF EXISTS check_profile_birth_coordinates;
+
+ALTER TABLE datifyy_v2_user_profiles
+ADD CONSTRAINT check_profile_birth_coordinates CHECK (
+    (birth_latitude IS NULL AND birth_longitude IS NULL)
+    OR (birth_latitude BETWEEN -90 AND 90 AND birth_longitude BETWEEN -180 AND 180)
+);
+
+COMMENT ON COLUMN datifyy_v2_user_profiles.birth_time IS 'Local birth time (HH:MM) in birth_timezone, used to cast the birth chart';
@@ -0,0 +1,413 @@
+-- Migration: 019_normalize_partner_preferences.sql
+-- Description: Normalize partner preference JSONB lists to the typed shapes the backend reads
+
+-- =============================================================================
+-- Normalization Helpers
+-- =============================================================================
+-- Enum lists hold proto enum numbers. Older rows may hold names ("HINDU",
+-- "RELIGION_HINDU") or numeric strings, so names are resolved against the
+-- list's labels below (position = enum number) after stripping the enum prefix.
+CREATE TEMP TABLE partner_preference_enum_labels (
+    list_name TEXT PRIMARY KEY,
+    prefix TEXT NOT NULL,
+    labels TEXT[] NOT NULL
+);
+
+INSERT INTO partner_preference_enum_labels (list_name, prefix, labels) VALUES
+    ('looking_for_gender', 'GENDER_', ARRAY[
+        'MALE', 'FEMALE', 'NON_BINARY', 'TRANSGENDER_MALE', 'TRANSGENDER_FEMALE',
+        'GENDERQUEER', 'OTHER', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('relationship_goals', 'RELATIONSHIP_GOAL_', ARRAY[
+        'LONG_TERM', 'MARRIAGE', 'SHORT_TERM', 'FRIENDSHIP', 'CASUAL',
+        'FIGURING_IT_OUT', 'OPEN_TO_EVERYTHING'
+    ]),
+    ('education_levels', 'EDUCATION_LEVEL_', ARRAY[
+        'HIGH_SCHOOL', 'SOME_COLLEGE', 'ASSOCIATE_DEGREE', 'BACHELORS', 'MASTERS',
+        'MBA', 'PHD', 'PROFESSIONAL_DEGREE', 'DIPLOMA', 'TRADE_SCHOOL', 'OTHER'
+    ]),
+    ('occupations', 'OCCUPATION_', ARRAY[
+        'SOFTWARE_ENGINEER', 'DATA_SCIENTIST', 'PRODUCT_MANAGER', 'UI_UX_DESIGNER',
+        'CYBERSECURITY', 'DOCTOR', 'NURSE', 'PHARMACIST', 'THERAPIST', 'ACCOUNTANT',
+        'FINANCIAL_ANALYST', 'BANKER', 'CONSULTANT', 'ENTREPRENEUR', 'TEACHER',
+        'PROFESSOR', 'RESEARCHER', 'ARTIST', 'DESIGNER', 'PHOTOGRAPHER', 'MUSICIAN',
+        'WRITER', 'ACTOR', 'CIVIL_ENGINEER', 'MECHANICAL_ENGINEER',
+        'ELECTRICAL_ENGINEER', 'LAWYER', 'LEGAL_ADVISOR', 'GOVERNMENT_EMPLOYEE',
+        'CIVIL_SERVANT', 'MILITARY', 'POLICE', 'SALES_EXECUTIVE', 'MARKETING_MANAGER',
+        'PILOT', 'FLIGHT_ATTENDANT', 'CHEF', 'HOTEL_MANAGER', 'REALTOR', 'ARCHITECT',
+        'SCIENTIST', 'ATHLETE', 'STUDENT', 'HOMEMAKER', 'RETIRED', 'SELF_EMPLOYED',
+        'FREELANCER', 'OTHER'
+    ]),
+    ('religions', 'RELIGION_', ARRAY[
+        'AGNOSTIC', 'ATHEIST', 'BUDDHIST', 'CHRISTIAN', 'CATHOLIC', 'HINDU', 'JEWISH',
+        'MUSLIM', 'SIKH', 'JAIN', 'SPIRITUAL', 'OTHER', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('children_preferences', 'CHILDREN_', ARRAY[
+        'HAVE_AND_WANT_MORE', 'HAVE_DONT_WANT_MORE', 'DONT_HAVE_WANT',
+        'DONT_HAVE_DONT_WANT', 'OPEN_TO_CHILDREN', 'NOT_SURE', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('drinking_preferences', 'DRINKING_', ARRAY[
+        'NEVER', 'RARELY', 'SOCIALLY', 'REGULARLY', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('smoking_preferences', 'SMOKING_', ARRAY[
+        'NEVER', 'SOCIALLY', 'REGULARLY', 'TRYING_TO_QUIT', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('dietary_preferences', 'DIETARY_', ARRAY[
+        'ANYTHING', 'VEGETARIAN', 'VEGAN', 'PESCATARIAN', 'KOSHER', 'HALAL', 'JAIN',
+        'GLUTEN_FREE', 'KETO', 'PALEO', 'OTHER'
+    ]),
+    ('pet_preferences', 'PET_', ARRAY[
+        'DOG_LOVER', 'CAT_LOVER', 'BOTH', 'OTHER', 'ALLERGIC', 'NO_PETS', 'WANT_SOMEDAY'
+    ]),
+    ('workout_preferences', 'WORKOUT_', ARRAY[
+        'NEVER', 'RARELY', 'SOMETIMES', 'OFTEN', 'DAILY', 'ATHLETE'
+    ]),
+    ('communication_styles', 'COMMUNICATION_', ARRAY[
+        'BIG_TIME_TEXTER', 'PHONE_CALLER', 'VIDEO_CHATTER', 'IN_PERSON', 'BAD_TEXTER'
+    ]),
+    ('love_languages', 'LOVE_LANGUAGE_', ARRAY[
+        'WORDS_OF_AFFIRMATION', 'ACTS_OF_SERVICE', 'RECEIVING_GIFTS', 'QUALITY_TIME',
+        'PHYSICAL_TOUCH'
+    ]),
+    ('political_views', 'POLITICAL_', ARRAY[
+        'LIBERAL', 'MODERATE', 'CONSERVATIVE', 'APOLITICAL', 'OTHER',
+        'PREFER_NOT_TO_SAY'
+    ]),
+    ('sleep_schedules', 'SLEEP_SCHEDULE_', ARRAY[
+        'EARLY_BIRD', 'NIGHT_OWL', 'VARIES'
+    ]),
+    ('ethnicity_preferences', 'ETHNICITY_', ARRAY[
+        'ASIAN', 'BLACK_AFRICAN', 'BLACK_CARIBBEAN', 'CAUCASIAN_WHITE', 'EAST_ASIAN',
+        'HISPANIC_LATINO', 'MIDDLE_EASTERN', 'NATIVE_AMERICAN', 'PACIFIC_ISLANDER',
+        'SOUTH_ASIAN', 'SOUTHEAST_ASIAN', 'MIXED_MULTIRACIAL', 'OTHER',
+        'PREFER_NOT_TO_SAY'
+    ]),
+    ('body_type_preferences', 'BODY_TYPE_', ARRAY[
+        'SLIM', 'ATHLETIC', 'AVERAGE', 'MUSCULAR', 'CURVY', 'FEW_EXTRA_POUNDS',
+        'HEAVYSET', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('complexion_preferences', 'COMPLEXION_', ARRAY[
+        'VERY_FAIR', 'FAIR', 'WHEATISH', 'DUSKY', 'DARK', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('hair_color_preferences', 'HAIR_COLOR_', ARRAY[
+        'BLACK', 'BROWN', 'BLONDE', 'RED', 'GRAY', 'WHITE', 'BALD', 'OTHER',
+        'PREFER_NOT_TO_SAY'
+    ]),
+    ('eye_color_preferences', 'EYE_COLOR_', ARRAY[
+        'BROWN', 'BLACK', 'BLUE', 'GREEN', 'HAZEL', 'GRAY', 'OTHER', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('facial_hair_preferences', 'FACIAL_HAIR_', ARRAY[
+        'CLEAN_SHAVEN', 'BEARD', 'GOATEE', 'MUSTACHE', 'STUBBLE', 'OTHER'
+    ]),
+    ('income_preferences', 'INCOME_RANGE_', ARRAY[
+        'BELOW_3_LAKH', '3_TO_5_LAKH', '5_TO_7_LAKH', '7_TO_10_LAKH', '10_TO_15_LAKH',
+        '15_TO_20_LAKH', '20_TO_30_LAKH', '30_TO_50_LAKH', '50_LAKH_TO_1_CRORE',
+        'ABOVE_1_CRORE', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('employment_preferences', 'EMPLOYMENT_TYPE_', ARRAY[
+        'FULL_TIME', 'PART_TIME', 'SELF_EMPLOYED', 'ENTREPRENEUR', 'FREELANCER',
+        'CONTRACT', 'INTERN', 'STUDENT', 'HOMEMAKER', 'RETIRED', 'UNEMPLOYED',
+        'PREFER_NOT_TO_SAY'
+    ]),
+    ('family_type_preferences', 'FAMILY_TYPE_', ARRAY[
+        'NUCLEAR', 'JOINT', 'TRANSITIONAL_JOINT', 'EXTENDED', 'SINGLE_PARENT', 'OTHER'
+    ]),
+    ('family_values_preferences', 'FAMILY_VALUES_', ARRAY[
+        'TRADITIONAL', 'MODERATE', 'LIBERAL', 'ORTHODOX', 'OPEN_MINDED'
+    ]),
+    ('living_situation_preferences', 'LIVING_SITUATION_', ARRAY[
+        'WITH_PARENTS', 'WITH_FAMILY', 'INDEPENDENT', 'WITH_ROOMMATES', 'OWN_HOUSE',
+        'RENTED', 'OTHER'
+    ]),
+    ('family_affluence_preferences', 'FAMILY_AFFLUENCE_', ARRAY[
+        'LOWER_MIDDLE_CLASS', 'MIDDLE_CLASS', 'UPPER_MIDDLE_CLASS', 'AFFLUENT',
+        'VERY_AFFLUENT', 'PREFER_NOT_TO_SAY'
+    ]),
+    ('language_preferences', 'LANGUAGE_', ARRAY[
+        'ENGLISH', 'SPANISH', 'FRENCH', 'GERMAN', 'ITALIAN', 'PORTUGUESE', 'RUSSIAN',
+        'CHINESE_MANDARIN', 'JAPANESE', 'KOREAN', 'ARABIC', 'HINDI', 'BENGALI',
+        'TELUGU', 'MARATHI', 'TAMIL', 'GUJARATI', 'KANNADA', 'MALAYALAM', 'PUNJABI',
+        'URDU', 'ODIA', 'DUTCH', 'POLISH', 'TURKISH', 'GREEK', 'HEBREW', 'THAI',
+        'VIETNAMESE', 'INDONESIAN', 'SWEDISH', 'OTHER'
+    ]),
+    ('interest_preferences', 'INTEREST_', ARRAY[
+        'TRAVEL', 'PHOTOGRAPHY', 'MUSIC', 'MOVIES_TV', 'COOKING', 'FITNESS', 'YOGA',
+        'READING', 'WRITING', 'ART', 'DANCING', 'SPORTS', 'GAMING', 'HIKING', 'PETS',
+        'FASHION', 'VOLUNTEERING', 'ENTREPRENEURSHIP', 'WINE_TASTING', 'ASTROLOGY',
+        'OTHER'
+    ]);
+
+-- Returns the enum number for one list entry: 0 for null, blank and
+-- unspecified entries, NULL when the entry can't be resolved
+CREATE OR REPLACE FUNCTION resolve_enum_entry(e JSONB, labels TEXT[], prefix TEXT)
+RETURNS INTEGER AS $$
+    SELECT CASE
+        WHEN jsonb_typeof(e) = 'null' THEN 0
+        WHEN e #>> '{}' ~ '^[0-9]+$' THEN (e #>> '{}')::INTEGER
+        WHEN jsonb_typeof(e) <> 'string' THEN NULL
+        WHEN label IN ('', 'UNSPECIFIED') THEN 0
+        ELSE array_position(labels, label)
+    END
+    FROM (SELECT regexp_replace(upper(btrim(e #>> '{}')), '^' || prefix, '') AS label) entry;
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Unspecified and duplicate entries are dropped. An entry that can't be
+-- resolved fails the migration rather than being dropped with the user's data.
+CREATE OR REPLACE FUNCTION normalize_enum_list(value JSONB, list TEXT)
+RETURNS JSONB AS $$
+DECLARE
+    list_prefix TEXT;
+    list_labels TEXT[];
+    entries JSONB := CASE WHEN jsonb_typeof(value) = 'array' THEN value ELSE '[]'::jsonb END;
+    unresolved JSONB;
+    result JSONB;
+BEGIN
+    SELECT l.prefix, l.labels INTO list_prefix, list_labels
+    FROM partner_preference_enum_labels l
+    WHERE l.list_name = list;
+    IF NOT FOUND THEN
+        RAISE EXCEPTION 'no enum labels for partner preference list %', list;
+    END IF;
+
+    SELECT e INTO unresolved
+    FROM jsonb_array_elements(entries) AS t(e)
+    WHERE resolve_enum_entry(e, list_labels, list_prefix) IS NULL
+    LIMIT 1;
+    IF FOUND THEN
+        RAISE EXCEPTION 'cannot resolve % entry % in partner preferences', list, unresolved;
+    END IF;
+
+    SELECT COALESCE(jsonb_agg(n ORDER BY ord), '[]'::jsonb) INTO result
+    FROM (
+        SELECT DISTINCT ON (n) n, ord
+        FROM (
+            SELECT resolve_enum_entry(e, list_labels, list_prefix) AS n, ord
+            FROM jsonb_array_elements(entries) WITH ORDINALITY AS t(e, ord)
+        ) parsed
+        WHERE n > 0
+        ORDER BY n, ord
+    ) deduped;
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- String lists are trimmed, with blank and duplicate entries dropped
+CREATE OR REPLACE FUNCTION normalize_string_list(value JSONB)
+RETURNS JSONB AS $$
+    SELECT COALESCE(jsonb_agg(s ORDER BY ord), '[]'::jsonb)
+    FROM (
+        SELECT DISTINCT ON (s) s, ord
+        FROM (
+            SELECT btrim(e #>> '{}') AS s, ord
+            FROM jsonb_array_elements(
+                CASE WHEN jsonb_typeof(value) = 'array' THEN value ELSE '[]'::jsonb END
+            ) WITH ORDINALITY AS t(e, ord)
+            WHERE jsonb_typeof(e) IN ('string', 'number')
+        ) parsed
+        WHERE s <> ''
+        ORDER BY s, ord
+    ) deduped;
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- Deal-breakers and must-haves keep {type, description, priority}; entries
+-- without a type are dropped
+CREATE OR REPLACE FUNCTION normalize_preference_rules(value JSONB)
+RETURNS JSONB AS $$
+    SELECT COALESCE(jsonb_agg(jsonb_strip_nulls(jsonb_build_object(
+               'type', (e ->> 'type')::INTEGER,
+               'description', NULLIF(btrim(e ->> 'description'), ''),
+               'priority', CASE WHEN e ->> 'priority' ~ '^[1-9][0-9]*$' THEN (e ->> 'priority')::INTEGER END
+           )) ORDER BY ord), '[]'::jsonb)
+    FROM jsonb_array_elements(
+        CASE WHEN jsonb_typeof(value) = 'array' THEN value ELSE '[]'::jsonb END
+    ) WITH ORDINALITY AS t(e, ord)
+    WHERE jsonb_typeof(e) = 'object' AND e ->> 'type' ~ '^[1-9][0-9]*$';
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- =============================================================================
+-- Normalize Existing Rows
+-- =============================================================================
+UPDATE datifyy_v2_partner_preferences SET
+    looking_for_gender = normalize_enum_list(looking_for_gender, 'looking_for_gender'),
+    relationship_goals = normalize_enum_list(relationship_goals, 'relationship_goals'),
+    education_levels = normalize_enum_list(education_levels, 'education_levels'),
+    occupations = normalize_enum_list(occupations, 'occupations'),
+    religions = normalize_enum_list(religions, 'religions'),
+    children_preferences = normalize_enum_list(children_preferences, 'children_preferences'),
+    drinking_preferences = normalize_enum_list(drinking_preferences, 'drinking_preferences'),
+    smoking_preferences = normalize_enum_list(smoking_preferences, 'smoking_preferences'),
+    dietary_preferences = normalize_enum_list(dietary_preferences, 'dietary_preferences'),
+    pet_preferences = normalize_enum_list(pet_preferences, 'pet_preferences'),
+    workout_preferences = normalize_enum_list(workout_preferences, 'workout_preferences'),
+    communication_styles = normalize_enum_list(communication_styles, 'communication_styles'),
+    love_languages = normalize_enum_list(love_languages, 'love_languages'),
+    political_views = normalize_enum_list(political_views, 'political_views'),
+    sleep_schedules = normalize_enum_list(sleep_schedules, 'sleep_schedules'),
+    ethnicity_preferences = normalize_enum_list(ethnicity_preferences, 'ethnicity_preferences'),
+    body_type_preferences = normalize_enum_list(body_type_preferences, 'body_type_preferences'),
+    complexion_preferences = normalize_enum_list(complexion_preferences, 'complexion_preferences'),
+    hair_color_preferences = normalize_enum_list(hair_color_preferences, 'hair_color_preferences'),
+    eye_color_preferences = normalize_enum_list(eye_color_preferences, 'eye_color_preferences'),
+    facial_hair_preferences = normalize_enum_list(facial_hair_preferences, 'facial_hair_preferences'),
+    income_preferences = normalize_enum_list(income_preferences, 'income_preferences'),
+    employment_preferences = normalize_enum_list(employment_preferences, 'employment_preferences'),
+    family_type_preferences = normalize_enum_list(family_type_preferences, 'family_type_preferences'),
+    family_values_preferences = normalize_enum_list(family_values_preferences, 'family_values_preferences'),
+    living_situation_preferences = normalize_enum_list(living_situation_preferences, 'living_situation_preferences'),
+    family_affluence_preferences = normalize_enum_list(family_affluence_preferences, 'family_affluence_preferences'),
+    language_preferences = normalize_enum_list(language_preferences, 'language_preferences'),
+    interest_preferences = normalize_enum_list(interest_preferences, 'interest_preferences'),
+    personality_types = normalize_string_list(personality_types),
+    caste_preferences = normalize_string_list(caste_preferences),
+    sub_caste_preferences = normalize_string_list(sub_caste_preferences),
+    gotra_preferences = normalize_string_list(gotra_preferences),
+    mother_tongue_preferences = normalize_string_list(mother_tongue_preferences),
+    nationality_preferences = normalize_string_list(nationality_preferences),
+    industry_preferences = normalize_string_list(industry_preferences),
+    family_location_preferences = normalize_string_list(family_location_preferences),
+    location_preferences = normalize_string_list(location_preferences),
+    custom_dealbreakers = normalize_string_list(custom_dealbreakers),
+    deal_breakers = normalize_preference_rules(deal_breakers),
+    must_haves = normalize_preference_rules(must_haves);
+
+DROP FUNCTION normalize_enum_list(JSONB, TEXT);
+DROP FUNCTION resolve_enum_entry(JSONB, TEXT[], TEXT);
+DROP FUNCTION normalize_string_list(JSONB);
+DROP FUNCTION normalize_preference_rules(JSONB);
+DROP TABLE partner_preference_enum_labels;
+
+-- =============================================================================
+-- Constraints
+-- =============================================================================
+-- Every list column is a non-null JSON array from now on
+ALTER TABLE datifyy_v2_partner_preferences
+ALTER COLUMN looking_for_gender SET DEFAULT '[]'::jsonb,
+ALTER COLUMN looking_for_gender SET NOT NULL,
+ALTER COLUMN relationship_goals SET DEFAULT '[]'::jsonb,
+ALTER COLUMN relationship_goals SET NOT NULL,
+ALTER COLUMN education_levels SET DEFAULT '[]'::jsonb,
+ALTER COLUMN education_levels SET NOT NULL,
+ALTER COLUMN occupations SET DEFAULT '[]'::jsonb,
+ALTER COLUMN occupations SET NOT NULL,
+ALTER COLUMN religions SET DEFAULT '[]'::jsonb,
+ALTER COLUMN religions SET NOT NULL,
+ALTER COLUMN children_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN children_preferences SET NOT NULL,
+ALTER COLUMN drinking_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN drinking_preferences SET NOT NULL,
+ALTER COLUMN smoking_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN smoking_preferences SET NOT NULL,
+ALTER COLUMN dietary_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN dietary_preferences SET NOT NULL,
+ALTER COLUMN pet_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN pet_preferences SET NOT NULL,
+ALTER COLUMN workout_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN workout_preferences SET NOT NULL,
+ALTER COLUMN communication_styles SET DEFAULT '[]'::jsonb,
+ALTER COLUMN communication_styles SET NOT NULL,
+ALTER COLUMN love_languages SET DEFAULT '[]'::jsonb,
+ALTER COLUMN love_languages SET NOT NULL,
+ALTER COLUMN political_views SET DEFAULT '[]'::jsonb,
+ALTER COLUMN political_views SET NOT NULL,
+ALTER COLUMN sleep_schedules SET DEFAULT '[]'::jsonb,
+ALTER COLUMN sleep_schedules SET NOT NULL,
+ALTER COLUMN ethnicity_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN ethnicity_preferences SET NOT NULL,
+ALTER COLUMN body_type_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN body_type_preferences SET NOT NULL,
+ALTER COLUMN complexion_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN complexion_preferences SET NOT NULL,
+ALTER COLUMN hair_color_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN hair_color_preferences SET NOT NULL,
+ALTER COLUMN eye_color_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN eye_color_preferences SET NOT NULL,
+ALTER COLUMN facial_hair_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN facial_hair_preferences SET NOT NULL,
+ALTER COLUMN income_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN income_preferences SET NOT NULL,
+ALTER COLUMN employment_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN employment_preferences SET NOT NULL,
+ALTER COLUMN family_type_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN family_type_preferences SET NOT NULL,
+ALTER COLUMN family_values_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN family_values_preferences SET NOT NULL,
+ALTER COLUMN living_situation_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN living_situation_preferences SET NOT NULL,
+ALTER COLUMN family_affluence_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN family_affluence_preferences SET NOT NULL,
+ALTER COLUMN language_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN language_preferences SET NOT NULL,
+ALTER COLUMN interest_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN interest_preferences SET NOT NULL,
+ALTER COLUMN personality_types SET DEFAULT '[]'::jsonb,
+ALTER COLUMN personality_types SET NOT NULL,
+ALTER COLUMN caste_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN caste_preferences SET NOT NULL,
+ALTER COLUMN sub_caste_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN sub_caste_preferences SET NOT NULL,
+ALTER COLUMN gotra_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN gotra_preferences SET NOT NULL,
+ALTER COLUMN mother_tongue_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN mother_tongue_preferences SET NOT NULL,
+ALTER COLUMN nationality_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN nationality_preferences SET NOT NULL,
+ALTER COLUMN industry_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN industry_preferences SET NOT NULL,
+ALTER COLUMN family_location_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN family_location_preferences SET NOT NULL,
+ALTER COLUMN location_preferences SET DEFAULT '[]'::jsonb,
+ALTER COLUMN location_preferences SET NOT NULL,
+ALTER COLUMN custom_dealbreakers SET DEFAULT '[]'::jsonb,
+ALTER COLUMN custom_dealbreakers SET NOT NULL,
+ALTER COLUMN deal_breakers SET DEFAULT '[]'::jsonb,
+ALTER COLUMN deal_breakers SET NOT NULL,
+ALTER COLUMN must_haves SET DEFAULT '[]'::jsonb,
+ALTER COLUMN must_haves SET NOT NULL;
+
+ALTER TABLE datifyy_v2_partner_preferences
+DROP CONSTRAINT IF EXISTS check_partner_preferences_lists;
+
+ALTER TABLE datifyy_v2_partner_preferences
+ADD CONSTRAINT check_partner_preferences_lists CHECK (
+    jsonb_typeof(looking_for_gender) = 'array'
+    AND jsonb_typeof(relationship_goals) = 'array'
+    AND jsonb_typeof(education_levels) = 'array'
+    AND jsonb_typeof(occupations) = 'array'
+    AND jsonb_typeof(religions) = 'array'
+    AND jsonb_typeof(children_preferences) = 'array'
+    AND jsonb_typeof(drinking_preferences) = 'array'
+    AND jsonb_typeof(smoking_preferences) = 'array'
+    AND jsonb_typeof(dietary_preferences) = 'array'
+    AND jsonb_typeof(pet_preferences) = 'array'
+    AND jsonb_typeof(workout_preferences) = 'array'
+    AND jsonb_typeof(communication_styles) = 'array'
+    AND jsonb_typeof(love_languages) = 'array'
+    AND jsonb_typeof(political_views) = 'array'
+    AND jsonb_typeof(sleep_schedules) = 'array'
+    AND jsonb_typeof(ethnicity_preferences) = 'array'
+    AND jsonb_typeof(body_type_preferences) = 'array'
+    AND jsonb_typeof(complexion_preferences) = 'array'
+    AND jsonb_typeof(hair_color_preferences) = 'array'
+    AND jsonb_typeof(eye_color_preferences) = 'array'
+    AND jsonb_typeof(facial_hair_preferences) = 'array'
+    AND jsonb_typeof(income_preferences) = 'array'
+    AND jsonb_typeof(employment_preferences) = 'array'
+    AND jsonb_typeof(family_type_preferences) = 'array'
+    AND jsonb_typeof(family_values_preferences) = 'array'
+    AND jsonb_typeof(living_situation_preferences) = 'array'
+    AND jsonb_typeof(family_affluence_preferences) = 'array'
+    AND jsonb_typeof(language_preferences) = 'array'
+    AND jsonb_typeof(interest_preferences) = 'array'
+    AND jsonb_typeof(personality_types) = 'array'
+    AND jsonb_typeof(caste_preferences) = 'array'
+    AND jsonb_typeof(sub_caste_preferences) = 'array'
+    AND jsonb_typeof(gotra_preferences) = 'array'
+    AND jsonb_typeof(mother_tongue_preferences) = 'array'
+    AND jsonb_typeof(nationality_preferences) = 'array'
+    AND jsonb_typeof(industry_preferences) = 'array'
+    AND jsonb_typeof(family_location_preferences) = 'array'
+    AND jsonb_typeof(location_preferences) = 'array'
+    AND jsonb_typeof(custom_dealbreakers) = 'array'
+    AND jsonb_typeof(deal_breakers) = 'array'
+    AND jsonb_typeof(must_haves) = 'array'
+);
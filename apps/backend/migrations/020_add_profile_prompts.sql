@@ -0,0 +1,79 @@
+-- Migration: 020_add_profile_prompts.sql
+-- Description: Admin-managed catalog of profile prompts with localized text and active windows
+
+-- =============================================================================
+-- Profile Prompts
+-- =============================================================================
+-- Answers stored in datifyy_v2_user_profiles.prompts reference a prompt by its
+-- key (ProfilePrompt.prompt_id). Prompts are retired by closing their active
+-- window rather than deleted, so existing answers keep their question text.
+-- legacy_question is the PromptQuestion enum value for prompts that predate
+-- the catalog, so older clients sending only the enum keep working.
+CREATE TABLE IF NOT EXISTS datifyy_v2_profile_prompts (
+    id SERIAL PRIMARY KEY,
+    key VARCHAR(100) NOT NULL UNIQUE,
+    category VARCHAR(50) NOT NULL,
+    text JSONB NOT NULL,
+    legacy_question INTEGER UNIQUE,
+    min_answer_length INTEGER NOT NULL DEFAULT 1,
+    max_answer_length INTEGER NOT NULL DEFAULT 300,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    active_from TIMESTAMP,
+    active_until TIMESTAMP,
+    updated_by INTEGER REFERENCES datifyy_v2_admin_users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_prompt_text CHECK (jsonb_typeof(text) = 'object' AND text ? 'en'),
+    CONSTRAINT check_prompt_answer_length CHECK (min_answer_length >= 1 AND max_answer_length >= min_answer_length),
+    CONSTRAINT check_prompt_active_window CHECK (active_until IS NULL OR active_from IS NULL OR active_until > active_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_profile_prompts_order ON datifyy_v2_profile_prompts(category, display_order);
+
+COMMENT ON COLUMN datifyy_v2_profile_prompts.text IS 'Question text by locale, e.g. {"en": "...", "hi": "..."}; English is required as the fallback';
+
+-- Seed the prompts that were previously hard-coded in the PromptQuestion enum
+INSERT INTO datifyy_v2_profile_prompts (key, category, text, legacy_question, display_order) VALUES
+    ('typical_sunday', 'about_me', '{"en": "A typical Sunday for me is..."}', 1, 1),
+    ('looking_for', 'dating', '{"en": "I''m looking for..."}', 2, 1),
+    ('most_spontaneous_thing', 'fun', '{"en": "The most spontaneous thing I''ve done is..."}', 3, 1),
+    ('simple_pleasures', 'about_me', '{"en": "My simple pleasures..."}', 4, 2),
+    ('dating_me_is_like', 'dating', '{"en": "Dating me is like..."}', 5, 2),
+    ('best_travel_story', 'fun', '{"en": "My best travel story..."}', 6, 2),
+    ('together_we_could', 'dating', '{"en": "Together we could..."}', 7, 3),
+    ('ideal_first_date', 'dating', '{"en": "My ideal first date..."}', 8, 4),
+    ('green_flag', 'dating', '{"en": "A green flag for me is..."}', 9, 5),
+    ('red_flag', 'dating', '{"en": "A red flag for me is..."}', 10, 6),
+    ('unpopular_opinion', 'fun', '{"en": "My most unpopular opinion..."}', 11, 3),
+    ('obsessed_with', 'fun', '{"en": "I''m obsessed with..."}', 12, 4),
+    ('nerd_out_about', 'fun', '{"en": "I nerd out about..."}', 13, 5),
+    ('teach_you', 'fun', '{"en": "I can teach you..."}', 14, 6),
+    ('best_quality', 'about_me', '{"en": "My best quality is..."}', 15, 3),
+    ('worst_habit', 'about_me', '{"en": "My worst habit is..."}', 16, 4),
+    ('recent_accomplishment', 'about_me', '{"en": "A recent accomplishment I''m proud of..."}', 17, 5),
+    ('bucket_list', 'values', '{"en": "On my bucket list..."}', 18, 1),
+    ('life_goal', 'values', '{"en": "My life goal is..."}', 19, 2),
+    ('favorite_quote', 'values', '{"en": "My favorite quote..."}', 20, 3)
+ON CONFLICT (key) DO NOTHING;
+
+-- =============================================================================
+-- Existing Answers
+-- =============================================================================
+-- Answers saved before the catalog only carry the enum; point them at the
+-- seeded prompt
+UPDATE datifyy_v2_user_profiles p
+SET prompts = (
+    SELECT jsonb_agg(
+        CASE
+            WHEN COALESCE(answer->>'prompt_id', '') = '' AND c.key IS NOT NULL
+                THEN answer || jsonb_build_object('prompt_id', c.key)
+            ELSE answer
+        END
+        ORDER BY position
+    )
+    FROM jsonb_array_elements(p.prompts) WITH ORDINALITY AS a(answer, position)
+    LEFT JOIN datifyy_v2_profile_prompts c
+        ON answer->>'question' ~ '^[0-9]+$' AND c.legacy_question = (answer->>'question')::INTEGER
+)
+WHERE jsonb_typeof(p.prompts) = 'array' AND jsonb_array_length(p.prompts) > 0;
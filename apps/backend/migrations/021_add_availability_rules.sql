@@ -0,0 +1,61 @@
+-- Migration: 021_add_availability_rules.sql
+-- Description: Recurring availability rules and variable slot durations
+
+-- =============================================================================
+-- Availability Rules
+-- =============================================================================
+-- A rule is an RRULE (see internal/recurrence for the supported subset)
+-- starting at dtstart, whose wall-clock time is kept in timezone. Its
+-- occurrences are materialized as rows in datifyy_v2_availability_slots over
+-- a rolling window; expanded_until records how far expansion has reached.
+-- exdates are occurrence starts (Unix seconds) the user has removed.
+CREATE TABLE IF NOT EXISTS datifyy_v2_availability_rules (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    rrule TEXT NOT NULL,
+    dtstart BIGINT NOT NULL,
+    duration_seconds INTEGER NOT NULL,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    exdates BIGINT[] NOT NULL DEFAULT '{}',
+    date_type date_type NOT NULL DEFAULT 'online',
+
+    -- Offline location details, copied onto each occurrence
+    place_name VARCHAR(255),
+    address TEXT,
+    city VARCHAR(100),
+    state VARCHAR(100),
+    country VARCHAR(100),
+    zipcode VARCHAR(20),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    google_place_id VARCHAR(255),
+    google_maps_url TEXT,
+    notes TEXT,
+
+    expanded_until BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT valid_rule_duration CHECK (duration_seconds BETWEEN 1800 AND 43200)
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_rules_user_id ON datifyy_v2_availability_rules(user_id);
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_rules_expanded_until ON datifyy_v2_availability_rules(expanded_until);
+
+-- =============================================================================
+-- Availability Slots
+-- =============================================================================
+-- Slots may now last from 30 minutes to 12 hours
+ALTER TABLE datifyy_v2_availability_slots DROP CONSTRAINT IF EXISTS valid_duration;
+ALTER TABLE datifyy_v2_availability_slots
+ADD CONSTRAINT valid_duration CHECK (end_time - start_time BETWEEN 1800 AND 43200);
+
+-- Occurrences of a rule point back at it. Deleting a rule removes its future
+-- occurrences explicitly, so past ones are kept for history.
+ALTER TABLE datifyy_v2_availability_slots
+ADD COLUMN IF NOT EXISTS rule_id INTEGER REFERENCES datifyy_v2_availability_rules(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_slots_rule_id ON datifyy_v2_availability_slots(rule_id) WHERE rule_id IS NOT NULL;
+
+COMMENT ON COLUMN datifyy_v2_availability_slots.end_time IS 'End time as Unix timestamp in seconds (30 minutes to 12 hours after start_time)';
+COMMENT ON COLUMN datifyy_v2_availability_slots.rule_id IS 'Recurring rule this slot is an occurrence of; NULL for one-off slots';
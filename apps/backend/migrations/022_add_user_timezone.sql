@@ -0,0 +1,13 @@
+-- Migration: 022_add_user_timezone.sql
+-- Description: IANA timezone preference for local availability and user-facing times
+
+-- =============================================================================
+-- User Timezone
+-- =============================================================================
+-- Availability can be submitted as local wall-clock times, which are converted
+-- using this zone; emails, calendar invites and the Love Zone render times in
+-- it. Stored times stay in UTC.
+ALTER TABLE datifyy_v2_user_preferences
+ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+COMMENT ON COLUMN datifyy_v2_user_preferences.timezone IS 'IANA timezone name, e.g. Asia/Kolkata';
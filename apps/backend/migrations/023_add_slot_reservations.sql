@@ -0,0 +1,24 @@
+-- Migration: 023_add_slot_reservations.sql
+-- Description: Reserve participants' availability slots when a date is scheduled
+
+-- =============================================================================
+-- Slot Reservations
+-- =============================================================================
+-- Scheduling a date reserves the slot of each participant that covers it, in
+-- the same transaction as the insert. A reserved slot can't be used for
+-- another date; cancelling the date releases it.
+ALTER TABLE datifyy_v2_availability_slots
+ADD COLUMN IF NOT EXISTS reserved_date_id INTEGER REFERENCES datifyy_v2_scheduled_dates(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_slots_reserved_date_id ON datifyy_v2_availability_slots(reserved_date_id) WHERE reserved_date_id IS NOT NULL;
+
+COMMENT ON COLUMN datifyy_v2_availability_slots.reserved_date_id IS 'Scheduled date this slot is reserved for; NULL while the slot is free';
+
+-- The slots a date was scheduled into. NULL when a participant had no slot
+-- covering the date (genies may schedule outside submitted availability).
+ALTER TABLE datifyy_v2_scheduled_dates
+ADD COLUMN IF NOT EXISTS user1_slot_id INTEGER REFERENCES datifyy_v2_availability_slots(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS user2_slot_id INTEGER REFERENCES datifyy_v2_availability_slots(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN datifyy_v2_scheduled_dates.user1_slot_id IS 'Availability slot of user1 consumed by this date';
+COMMENT ON COLUMN datifyy_v2_scheduled_dates.user2_slot_id IS 'Availability slot of user2 consumed by this date';
@@ -0,0 +1,44 @@
+-- Migration: 024_add_calendar_sync.sql
+-- Description: iCal feed tokens and availability exclusions imported from calendars
+
+-- =============================================================================
+-- Calendar Feed Tokens
+-- =============================================================================
+-- A feed token is the secret in a user's iCal feed URL. Only its SHA-256 hash
+-- is stored. A user has at most one active token; creating a new one or
+-- revoking the feed sets revoked_at, after which the old URL stops working.
+CREATE TABLE IF NOT EXISTS datifyy_v2_calendar_feed_tokens (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_accessed_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_datifyy_v2_calendar_feed_tokens_active_user ON datifyy_v2_calendar_feed_tokens(user_id) WHERE revoked_at IS NULL;
+
+-- =============================================================================
+-- Availability Exclusions
+-- =============================================================================
+-- Busy periods imported from a user's own calendar (VEVENT or VFREEBUSY).
+-- They are subtracted from the user's availability when finding times to
+-- meet. external_uid is the UID of the imported component, so re-importing
+-- the same calendar updates periods instead of duplicating them.
+CREATE TABLE IF NOT EXISTS datifyy_v2_availability_exclusions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    start_time BIGINT NOT NULL,
+    end_time BIGINT NOT NULL,
+    source VARCHAR(32) NOT NULL DEFAULT 'ics_import',
+    external_uid VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT valid_exclusion_range CHECK (end_time > start_time),
+    CONSTRAINT unique_exclusion UNIQUE (user_id, external_uid, start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_exclusions_user_start ON datifyy_v2_availability_exclusions(user_id, start_time);
+
+COMMENT ON COLUMN datifyy_v2_availability_exclusions.start_time IS 'Start time as Unix timestamp in seconds';
+COMMENT ON COLUMN datifyy_v2_availability_exclusions.end_time IS 'End time as Unix timestamp in seconds';
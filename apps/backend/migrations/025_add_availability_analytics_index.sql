@@ -0,0 +1,18 @@
+-- Migration: 025_add_availability_analytics_index.sql
+-- Description: Covering index for the admin availability heatmap
+
+-- =============================================================================
+-- Availability Heatmap
+-- =============================================================================
+-- The heatmap aggregates every slot overlapping a date range:
+--
+--   WHERE start_time > from - 12h AND start_time < to AND end_time > from
+--
+-- Slots last at most 12 hours, so the lower bound on start_time turns the
+-- overlap test into a bounded range scan on this index. end_time filters
+-- inside the index, and the INCLUDE columns are everything the aggregate
+-- reads from the slot, so recently vacuumed tables get an index-only scan.
+-- Users and profiles are then joined by primary key and unique user_id.
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_slots_start_end
+ON datifyy_v2_availability_slots(start_time, end_time)
+INCLUDE (id, user_id, date_type, city, reserved_date_id);
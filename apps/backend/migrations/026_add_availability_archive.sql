@@ -0,0 +1,40 @@
+-- Migration: 026_add_availability_archive.sql
+-- Description: Archive for expired availability slots and availability reminders
+
+-- =============================================================================
+-- Availability Slot Archive
+-- =============================================================================
+-- A background job moves slots that ended more than the retention period ago
+-- out of datifyy_v2_availability_slots, in batches, so the live table only
+-- holds recent and upcoming availability. The archive keeps what history and
+-- analytics need (when, what kind of date, which city, which date it was
+-- reserved for) but not the slot's address or notes.
+CREATE TABLE IF NOT EXISTS datifyy_v2_availability_slots_archive (
+    id INTEGER PRIMARY KEY, -- ID the slot had in datifyy_v2_availability_slots
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    start_time BIGINT NOT NULL,
+    end_time BIGINT NOT NULL,
+    date_type date_type NOT NULL,
+    city VARCHAR(100),
+    rule_id INTEGER,
+    reserved_date_id INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_slots_archive_user_start ON datifyy_v2_availability_slots_archive(user_id, start_time);
+
+COMMENT ON TABLE datifyy_v2_availability_slots_archive IS 'Availability slots moved out of datifyy_v2_availability_slots after they expired';
+COMMENT ON COLUMN datifyy_v2_availability_slots_archive.reserved_date_id IS 'Scheduled date the slot was reserved for, if any';
+
+-- =============================================================================
+-- Availability Reminders
+-- =============================================================================
+-- Users whose availability has run out drop out of curation. They are emailed
+-- once each time it runs out; last_available_at is the start of the latest
+-- slot they had when reminded, so adding availability again re-arms it.
+CREATE TABLE IF NOT EXISTS datifyy_v2_availability_reminders (
+    user_id INTEGER PRIMARY KEY REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    last_available_at BIGINT NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,28 @@
+-- Migration: 027_add_date_lifecycle.sql
+-- Description: Record who changed a date in the date activity log
+
+-- =============================================================================
+-- Date Activity Log
+-- =============================================================================
+-- Every status transition of a scheduled date (and its creation) is logged
+-- with the actor that made it. Admins and genies are recorded in admin_id,
+-- participants in user_id; background jobs and account purges are 'system'.
+ALTER TABLE datifyy_v2_date_activity_log
+ADD COLUMN IF NOT EXISTS actor_type VARCHAR(20) NOT NULL DEFAULT 'admin',
+ADD COLUMN IF NOT EXISTS user_id INTEGER REFERENCES datifyy_v2_users(id) ON DELETE SET NULL;
+
+DO $$ BEGIN
+    ALTER TABLE datifyy_v2_date_activity_log
+    ADD CONSTRAINT valid_date_activity_actor CHECK (actor_type IN ('admin', 'user', 'system'));
+EXCEPTION
+    WHEN duplicate_object THEN NULL;
+END $$;
+
+-- 007's idx_datifyy_v2_date_activity_date_id indexes the legacy
+-- date_activity_log table, not this one, so it is left alone. History is read
+-- per date in order.
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_date_activity_log_date_created ON datifyy_v2_date_activity_log(date_id, created_at);
+
+COMMENT ON COLUMN datifyy_v2_date_activity_log.actor_type IS 'Who made the change: admin (admin_id), user (user_id) or system';
+COMMENT ON COLUMN datifyy_v2_date_activity_log.old_value IS 'Status before the change (NULL for created)';
+COMMENT ON COLUMN datifyy_v2_date_activity_log.new_value IS 'Status after the change';
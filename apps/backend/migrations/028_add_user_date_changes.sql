@@ -0,0 +1,53 @@
+-- Migration: 028_add_user_date_changes.sql
+-- Description: User cancellations, reschedule requests and date reliability counts
+
+-- =============================================================================
+-- User Cancellations
+-- =============================================================================
+-- cancelled_by references admin users; participants who cancel are recorded
+-- here instead. A cancellation close to the date is flagged as late.
+ALTER TABLE datifyy_v2_scheduled_dates
+ADD COLUMN IF NOT EXISTS cancelled_by_user_id INTEGER REFERENCES datifyy_v2_users(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS late_cancellation BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN datifyy_v2_scheduled_dates.cancelled_by_user_id IS 'Participant who cancelled the date, if a participant did';
+COMMENT ON COLUMN datifyy_v2_scheduled_dates.late_cancellation IS 'Cancelled by a participant within the late-cancellation window';
+
+-- =============================================================================
+-- Reschedule Requests
+-- =============================================================================
+-- A participant proposes new times for a date from the times both are free;
+-- the other participant accepts one of them or declines. A date has at most
+-- one pending request.
+CREATE TABLE IF NOT EXISTS datifyy_v2_date_reschedule_requests (
+    id SERIAL PRIMARY KEY,
+    date_id INTEGER NOT NULL REFERENCES datifyy_v2_scheduled_dates(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE, -- requester
+    responder_id INTEGER NOT NULL REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    proposed_times BIGINT[] NOT NULL,
+    reason TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    accepted_time BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    CONSTRAINT valid_reschedule_status CHECK (status IN ('pending', 'accepted', 'declined', 'withdrawn'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_datifyy_v2_date_reschedule_requests_pending ON datifyy_v2_date_reschedule_requests(date_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_date_reschedule_requests_responder ON datifyy_v2_date_reschedule_requests(responder_id, created_at DESC);
+
+COMMENT ON TABLE datifyy_v2_date_reschedule_requests IS 'New times proposed for a scheduled date by one participant, awaiting the other''s answer';
+COMMENT ON COLUMN datifyy_v2_date_reschedule_requests.proposed_times IS 'Proposed start times (Unix seconds)';
+
+-- =============================================================================
+-- Date Reliability
+-- =============================================================================
+-- Running counts of a user's cancellations, used for reliability scoring
+CREATE TABLE IF NOT EXISTS datifyy_v2_date_reliability (
+    user_id INTEGER PRIMARY KEY REFERENCES datifyy_v2_users(id) ON DELETE CASCADE,
+    cancellations INTEGER NOT NULL DEFAULT 0,
+    late_cancellations INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE datifyy_v2_date_reliability IS 'Dates each user has cancelled, and how many of those were late';
@@ -0,0 +1,22 @@
+-- Migration: 029_fix_data_export_requests.sql
+-- Description: At most one data export in progress per user
+
+-- Fail all but the newest in-flight export of users who queued several, so
+-- the index below can be built
+UPDATE datifyy_v2_data_exports e
+SET status = 'failed', error_message = 'Duplicate request', completed_at = NOW()
+WHERE e.status IN ('pending', 'processing')
+  AND EXISTS (
+      SELECT 1 FROM datifyy_v2_data_exports newer
+      WHERE newer.user_id = e.user_id
+        AND newer.status IN ('pending', 'processing')
+        AND newer.id > e.id
+  );
+
+-- Requests check the cooldown and insert in one statement; this index stops
+-- two concurrent requests from both being queued
+CREATE UNIQUE INDEX IF NOT EXISTS idx_datifyy_v2_data_exports_in_flight
+ON datifyy_v2_data_exports(user_id)
+WHERE status IN ('pending', 'processing');
+
+COMMENT ON TABLE datifyy_v2_data_exports IS 'Personal data export requests; rate-limited to one per user per day, failed exports excepted';
@@ -0,0 +1,8 @@
+-- Migration: 030_normalize_report_reasons.sql
+-- Description: Store report reasons without the REPORT_REASON_ prefix
+
+-- Reports used to store the full enum name (REPORT_REASON_SPAM); they now
+-- store the short form (SPAM), so the moderation queue has one format
+UPDATE datifyy_v2_user_reports
+SET reason = SUBSTRING(reason FROM LENGTH('REPORT_REASON_') + 1)
+WHERE reason LIKE 'REPORT\_REASON\_%';
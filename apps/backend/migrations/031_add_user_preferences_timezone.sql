@@ -0,0 +1,9 @@
+-- Migration: 031_add_user_preferences_timezone.sql
+-- Description: Add the timezone preference to the user_preferences table the app reads
+
+-- 022 added the column to datifyy_v2_user_preferences, but app preferences
+-- live in user_preferences, so timezone lookups failed there
+ALTER TABLE user_preferences
+ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+COMMENT ON COLUMN user_preferences.timezone IS 'IANA timezone name, e.g. Asia/Kolkata';
@@ -0,0 +1,8 @@
+-- Migration: 032_add_availability_archive_analytics_index.sql
+-- Description: Covering index for the admin availability heatmap on archived slots
+
+-- The heatmap reads archived slots alongside live ones, with the same bounded
+-- start_time range scan as idx_datifyy_v2_availability_slots_start_end (025)
+CREATE INDEX IF NOT EXISTS idx_datifyy_v2_availability_slots_archive_start_end
+ON datifyy_v2_availability_slots_archive(start_time, end_time)
+INCLUDE (id, user_id, date_type, city, reserved_date_id);
@@ -141,7 +141,7 @@ func TestGetCandidatesForCuration(t *testing.T) {
 	_ = createTestUserWithAvailability(t, db, "test_curation_3@test.com", "Charlie", "MALE", 25, false)
 
 	// Test: Get candidates for curation
-	candidates, err := datesService.GetCandidatesForCuration(ctx)
+	candidates, err := datesService.GetCandidatesForCuration(ctx, 0)
 	require.NoError(t, err)
 
 	// Should return only users with availability tomorrow
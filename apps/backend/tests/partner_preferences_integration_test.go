@@ -11,6 +11,7 @@ import (
 
 	authpb "github.com/datifyy/backend/gen/auth/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/config"
 	"github.com/datifyy/backend/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,7 +28,7 @@ func TestGetPartnerPreferences_Integration(t *testing.T) {
 
 	ctx := context.Background()
 	authService := service.NewAuthService(db, redisClient, nil)
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, nil, config.Load())
 
 	// Register a test user
 	testEmail := fmt.Sprintf("partner-prefs-get-%d@example.com", time.Now().Unix())
@@ -80,7 +81,7 @@ func TestUpdatePartnerPreferences_Integration(t *testing.T) {
 
 	ctx := context.Background()
 	authService := service.NewAuthService(db, redisClient, nil)
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, nil, config.Load())
 
 	// Register a test user
 	testEmail := fmt.Sprintf("partner-prefs-update-%d@example.com", time.Now().Unix())
@@ -170,7 +171,7 @@ func TestUpdatePartnerPreferences_Validation_Integration(t *testing.T) {
 
 	ctx := context.Background()
 	authService := service.NewAuthService(db, redisClient, nil)
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, nil, config.Load())
 
 	// Register a test user
 	testEmail := fmt.Sprintf("partner-prefs-validation-%d@example.com", time.Now().Unix())
@@ -239,7 +240,7 @@ func TestPartnerPreferences_Unauthenticated_Integration(t *testing.T) {
 	defer redisClient.Close()
 
 	ctx := context.Background() // No userID in context
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, nil, config.Load())
 
 	// Test get without auth
 	getReq := &userpb.GetPartnerPreferencesRequest{}
@@ -277,7 +278,7 @@ func TestUpdatePartnerPreferences_MultipleUpdates_Integration(t *testing.T) {
 
 	ctx := context.Background()
 	authService := service.NewAuthService(db, redisClient, nil)
-	userService := service.NewUserService(db, redisClient)
+	userService := service.NewUserService(db, redisClient, nil, config.Load())
 
 	// Register a test user
 	testEmail := fmt.Sprintf("partner-prefs-multi-%d@example.com", time.Now().Unix())
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/config"
 	"github.com/datifyy/backend/internal/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,7 +31,7 @@ func setupE2ETest(t *testing.T) (userpb.UserServiceClient, func()) {
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 	userpb.RegisterUserServiceServer(grpcServer, userService)
 
 	// Start server in goroutine
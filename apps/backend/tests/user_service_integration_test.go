@@ -12,6 +12,7 @@ import (
 
 	commonpb "github.com/datifyy/backend/gen/common/v1"
 	userpb "github.com/datifyy/backend/gen/user/v1"
+	"github.com/datifyy/backend/internal/config"
 	"github.com/datifyy/backend/internal/service"
 	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
@@ -36,7 +37,7 @@ func setupIntegrationTest(t *testing.T) (*sql.DB, func()) {
 	cleanup := func() {
 		// Clean up test data
 		db.Exec("DELETE FROM user_blocks WHERE blocker_user_id > 1000")
-		db.Exec("DELETE FROM user_reports WHERE reporter_user_id > 1000")
+		db.Exec("DELETE FROM datifyy_v2_user_reports WHERE reporter_user_id > 1000")
 		db.Exec("DELETE FROM user_photos WHERE user_id > 1000")
 		db.Exec("DELETE FROM user_preferences WHERE user_id > 1000")
 		db.Exec("DELETE FROM partner_preferences WHERE user_id > 1000")
@@ -83,7 +84,7 @@ func TestIntegration_GetUserProfile(t *testing.T) {
 	userID := createTestUser(t, db, fmt.Sprintf("integration-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	ctx := context.Background()
 	req := &userpb.GetUserProfileRequest{
@@ -109,7 +110,7 @@ func TestIntegration_UpdateProfile(t *testing.T) {
 	userID := createTestUser(t, db, fmt.Sprintf("integration-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	// Mock auth context with userID (in real implementation, this would come from JWT)
 	ctx := context.WithValue(context.Background(), "userID", userID)
@@ -158,7 +159,7 @@ func TestIntegration_BlockAndUnblockUser(t *testing.T) {
 	userID2 := createTestUser(t, db, fmt.Sprintf("blocked-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	// Mock auth context
 	ctx := context.WithValue(context.Background(), "userID", userID1)
@@ -224,7 +225,7 @@ func TestIntegration_ReportUser(t *testing.T) {
 	userID2 := createTestUser(t, db, fmt.Sprintf("reported-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	// Mock auth context
 	ctx := context.WithValue(context.Background(), "userID", userID1)
@@ -248,7 +249,7 @@ func TestIntegration_ReportUser(t *testing.T) {
 	// Verify report in database
 	var count int
 	err = db.QueryRow(`
-		SELECT COUNT(*) FROM user_reports
+		SELECT COUNT(*) FROM datifyy_v2_user_reports
 		WHERE reporter_user_id = $1 AND reported_user_id = $2
 	`, userID1, userID2).Scan(&count)
 	require.NoError(t, err)
@@ -263,7 +264,7 @@ func TestIntegration_PartnerPreferences(t *testing.T) {
 	userID := createTestUser(t, db, fmt.Sprintf("prefs-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	// Mock auth context
 	ctx := context.WithValue(context.Background(), "userID", userID)
@@ -313,7 +314,7 @@ func TestIntegration_UserPreferences(t *testing.T) {
 	userID := createTestUser(t, db, fmt.Sprintf("userprefs-%d@example.com", time.Now().Unix()))
 
 	// Create service
-	userService := service.NewUserService(db, nil)
+	userService := service.NewUserService(db, nil, nil, config.Load())
 
 	// Mock auth context
 	ctx := context.WithValue(context.Background(), "userID", userID)